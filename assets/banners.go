@@ -59,6 +59,23 @@ func (s *BannerStore) Get(board string, id int) (file File, ok bool) {
 	return files[id], true
 }
 
+// Hash returns a hash of the contents of all banners of a board. Empty, if
+// the board has no banners.
+func (s *BannerStore) Hash(board string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	files := s.m[board]
+	if len(files) == 0 {
+		return ""
+	}
+	buf := make([]byte, 0, len(files)*32)
+	for _, f := range files {
+		buf = append(buf, f.Hash...)
+	}
+	return util.HashBuffer(buf)
+}
+
 // Random returns a random banner for the board. If none found, ok == false.
 func (s *BannerStore) Random(board string) (int, string, bool) {
 	s.mu.RLock()
@@ -1,6 +1,10 @@
 package auth
 
 import (
+	"net/http"
+	"net/url"
+	"strings"
+
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -9,7 +13,31 @@ type SessionCreds struct {
 	UserID, Session string
 }
 
+// Ident is the identity of a client, that has authenticated or chosen to stay
+// anonymous
+type Ident struct {
+	// Account the client is logged in as. Empty for anonymous clients.
+	UserID string
+	IP     string
+}
+
+// IsAnonymous returns, if the client is not logged in
+func (i Ident) IsAnonymous() bool {
+	return i.UserID == ""
+}
+
 // BcryptCompare compares a bcrypt hash with a user-supplied string
 func BcryptCompare(password string, hash []byte) error {
 	return bcrypt.CompareHashAndPassword(hash, []byte(password))
 }
+
+// ExtractLoginCreds extracts login credentials from cookies
+func ExtractLoginCreds(r *http.Request) (creds SessionCreds) {
+	if c, err := r.Cookie("session"); err == nil {
+		creds.Session = c.Value
+	}
+	if c, err := r.Cookie("loginID"); err == nil {
+		creds.UserID, _ = url.QueryUnescape(strings.TrimSpace(c.Value))
+	}
+	return
+}
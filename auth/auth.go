@@ -3,13 +3,20 @@
 package auth
 
 import (
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
-	"github.com/bakape/meguca/config"
 	"net"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
 
 	"golang.org/x/crypto/bcrypt"
 )
@@ -79,7 +86,42 @@ func RandomID(length int) (string, error) {
 	return base64.RawStdEncoding.EncodeToString(buf), err
 }
 
+// PostSession returns the token of the session, that tracks the posts made by
+// the client. If the client does not have a valid session yet, a new token is
+// generated and a cookie for persisting it on the client is returned.
+func PostSession(r *http.Request) (token string, cookie *http.Cookie, err error) {
+	if c, err := r.Cookie("postSession"); err == nil &&
+		len(c.Value) == common.LenPostSession {
+		return c.Value, nil, nil
+	}
+
+	token, err = RandomID(32)
+	if err != nil {
+		return
+	}
+	cookie = &http.Cookie{
+		Name:  "postSession",
+		Value: token,
+		Path:  "/",
+		Expires: time.Now().
+			Add(time.Duration(config.Get().SessionExpiry) * time.Hour * 24),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	}
+	return
+}
+
 // BcryptHash generates a bcrypt hash from the passed string
 func BcryptHash(password string, rounds int) ([]byte, error) {
 	return bcrypt.GenerateFromPassword([]byte(password), rounds)
 }
+
+// PosterID generates an anonymous identifier for a poster, that is stable
+// within a thread, but differs between threads
+func PosterID(op uint64, ip string) string {
+	h := hmac.New(sha256.New, []byte(config.Get().Salt))
+	h.Write(strconv.AppendUint(nil, op, 10))
+	h.Write([]byte{':'})
+	h.Write([]byte(ip))
+	return hex.EncodeToString(h.Sum(nil))[:6]
+}
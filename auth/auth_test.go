@@ -120,3 +120,21 @@ func TestRandomID(t *testing.T) {
 		t.Fatalf("unexpected hash string length: %d", l)
 	}
 }
+
+func TestPosterID(t *testing.T) {
+	const ip = "207.178.71.93"
+
+	id := PosterID(1, ip)
+	if l := len(id); l != 6 {
+		t.Fatalf("unexpected poster ID length: %d", l)
+	}
+	if s := PosterID(1, ip); s != id {
+		LogUnexpected(t, id, s)
+	}
+	if PosterID(2, ip) == id {
+		t.Fatal("poster ID matches across threads")
+	}
+	if PosterID(1, "10.121.169.19") == id {
+		t.Fatal("poster ID matches across IPs")
+	}
+}
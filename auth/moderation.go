@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"net"
 	"time"
 
 	"github.com/bakape/meguca/common"
@@ -28,29 +29,79 @@ type BanRecord struct {
 	Expires          time.Time
 }
 
+// BanAppeal is a request by a banned poster to lift their ban
+type BanAppeal struct {
+	Resolved   bool      `json:"resolved"`
+	ID         uint64    `json:"id"`
+	ForPost    uint64    `json:"for_post"`
+	Created    time.Time `json:"created"`
+	Board      string    `json:"board"`
+	IP         string    `json:"ip"`
+	Text       string    `json:"text"`
+	Resolution string    `json:"resolution"`
+}
+
 // Report contains data of a reported post
 type Report struct {
+	Resolved      bool
 	ID, Target    uint64
 	Created       time.Time
 	Board, Reason string
 }
 
+// ModNote is a private moderator comment attached to a post
+type ModNote struct {
+	PostID  uint64    `json:"post_id"`
+	Created time.Time `json:"created"`
+	By      string    `json:"by"`
+	Text    string    `json:"text"`
+}
+
+// WordFilter is an automatic text replacement applied to new post bodies on
+// a board
+type WordFilter struct {
+	CaseSensitive bool   `json:"case_sensitive"`
+	ID            uint64 `json:"id"`
+	Board         string `json:"board"`
+	Pattern       string `json:"pattern"`
+	Replacement   string `json:"replacement"`
+}
+
+// Webhook is an external URL notified of events on a board. Deliveries are
+// signed with Secret, if set.
+type Webhook struct {
+	ID     uint64   `json:"id"`
+	Board  string   `json:"board"`
+	URL    string   `json:"url"`
+	Secret string   `json:"secret,omitempty"`
+	Events []string `json:"events"`
+}
+
 // DisconnectByBoardAndIP disconnects all banned
 // websocket clients matching IP from board.
 // /all/ board disconnects all clients globally.
 func DisconnectByBoardAndIP(ip, board string) {
+	if board == "all" {
+		disconnectBanned(common.GetClientsByIP(ip))
+	} else {
+		disconnectBanned(common.GetByIPAndBoard(ip, board))
+	}
+}
+
+// DisconnectByBoardAndRange disconnects all banned websocket clients with an
+// IP in ipRange from board. /all/ board disconnects all clients globally.
+func DisconnectByBoardAndRange(ipRange *net.IPNet, board string) {
+	disconnectBanned(common.GetByRangeAndBoard(ipRange, board))
+}
+
+// Notify clients of their ban and disconnect them
+func disconnectBanned(cls []common.Client) {
 	msg, err := common.EncodeMessage(common.MessageInvalid,
 		common.ErrBanned.Error())
 	if err != nil {
 		log.Error(err)
 		return
 	}
-	var cls []common.Client
-	if board == "all" {
-		cls = common.GetClientsByIP(ip)
-	} else {
-		cls = common.GetByIPAndBoard(ip, board)
-	}
 	for _, cl := range cls {
 		cl.Send(msg)
 		cl.Close(nil)
@@ -27,7 +27,7 @@ var ThreadFE = FrontEnd{
 	},
 
 	GetFresh: func(k Key) (interface{}, error) {
-		return db.GetThread(k.ID, int(k.LastN))
+		return db.GetThread(k.ID, int(k.LastN), 0)
 	},
 
 	RenderHTML: func(data interface{}, json []byte) []byte {
@@ -48,9 +48,9 @@ var CatalogFE = FrontEnd{
 
 	GetFresh: func(k Key) (interface{}, error) {
 		if k.Board == "all" {
-			return db.GetAllBoardCatalog()
+			return db.GetAllBoardCatalog(k.Order, k.boardFilter())
 		}
-		return db.GetBoardCatalog(k.Board)
+		return db.GetBoardCatalog(k.Board, k.Order)
 	},
 
 	RenderHTML: func(data interface{}, json []byte) []byte {
@@ -60,8 +60,10 @@ var CatalogFE = FrontEnd{
 	},
 }
 
-// BoardFE is for accessing cached board pages
-var BoardFE = FrontEnd{
+// BoardPageFE is for individual pages of a board index page. Board pages are
+// built as a list of individually fetched and cached threads with up to 5
+// replies each.
+var BoardPageFE = FrontEnd{
 	GetCounter: func(k Key) (uint64, error) {
 		if k.Board == "all" {
 			return db.AllBoardCounter()
@@ -69,139 +71,43 @@ var BoardFE = FrontEnd{
 		return db.BoardCounter(k.Board)
 	},
 
-	// Board pages are built as a list of individually fetched and cached
-	// threads with up to 5 replies each
 	GetFresh: func(k Key) (interface{}, error) {
-		// Get thread IDs in the right order
-		var (
-			ids []uint64
-			err error
-		)
+		var perPage int
 		if k.Board == "all" {
-			ids, err = db.GetAllThreadsIDs()
+			perPage = config.AllBoardConfigs.PageSize()
 		} else {
-			ids, err = db.GetThreadIDs(k.Board)
+			perPage = config.GetBoardConfigs(k.Board).PageSize()
 		}
+
+		ids, pages, count, err := db.GetThreadIDsPage(k.Board, int(k.Page),
+			perPage, k.Order, k.boardFilter())
 		if err != nil {
 			return nil, err
 		}
-
-		// Empty board
-		if len(ids) == 0 {
-			data := common.Board{Threads: []common.Thread{}}
-			buf, err := json.Marshal(data)
-			if err != nil {
-				return nil, err
-			}
-			return []PageStore{
-				{
-					PageNumber: 1,
-					JSON:       buf,
-					Data:       data,
-				},
-			}, nil
-		}
-
-		// Get data and JSON for these views and paginate
-		var (
-			pages = make([]PageStore, 0, len(ids)/15+1)
-			page  PageStore
-		)
-		closePage := func() {
-			if page.Data.Threads != nil {
-				pages = append(pages, page)
-			}
-		}
-
-		// Hide threads from NSFW boards, if enabled
-		var (
-			confs    map[string]config.BoardConfContainer
-			hideNSFW bool
-		)
-		if k.Board == "all" && config.Get().HideNSFW {
-			hideNSFW = true
-			confs = config.GetAllBoardConfigs()
-		}
-
-		for i, id := range ids {
-			// Start a new page
-			if i%15 == 0 {
-				closePage()
-				page = PageStore{
-					PageNumber: len(pages),
-					Data: common.Board{
-						Threads: make([]common.Thread, 0, 15),
-					},
-				}
-			}
-
-			k := ThreadKey(id, 5)
-			_, data, _, err := GetJSONAndData(k, ThreadFE)
-			if err != nil {
-				return nil, err
-			}
-			t := data.(common.Thread)
-
-			if hideNSFW && confs[t.Board].NSFW {
-				continue
-			}
-
-			page.Data.Threads = append(page.Data.Threads, t)
+		if len(ids) == 0 && k.Page != 0 {
+			return nil, ErrPageOverflow
 		}
-		closePage()
 
-		// Record total page count in all stores and generate JSON
-		l := len(pages)
-		if l == 0 { // Empty board
-			l = 1
-			pages = []PageStore{
-				{
-					JSON: []byte(`{"threads":[],"pages":1}`),
-				},
-			}
+		page := PageStore{
+			PageNumber: int(k.Page),
+			Data: common.Board{
+				Pages:       pages,
+				ThreadCount: count,
+				Threads:     make([]common.Thread, 0, len(ids)),
+			},
 		}
-		for i := range pages {
-			p := &pages[i]
-			p.Data.Pages = l
-			p.JSON, err = json.Marshal(p.Data)
+		for _, id := range ids {
+			_, data, _, err := GetJSONAndData(ThreadKey(id, 5), ThreadFE)
 			if err != nil {
 				return nil, err
 			}
+			page.Data.Threads = append(page.Data.Threads, data.(common.Thread))
 		}
-
-		return pages, nil
-	},
-
-	Size: func(data interface{}, _, _ []byte) (s int) {
-		for _, p := range data.([]PageStore) {
-			s += len(p.JSON) * 2
-		}
-		return
-	},
-}
-
-// BoardPageFE is for individual pages of a board index page
-var BoardPageFE = FrontEnd{
-	GetCounter: func(k Key) (uint64, error) {
-		// Get the counter of the parent board
-		k.Page = -1
-		_, _, ctr, err := GetJSONAndData(k, BoardFE)
-		return ctr, err
-	},
-
-	GetFresh: func(k Key) (interface{}, error) {
-		i := int(k.Page)
-		k.Page = -1
-		_, data, _, err := GetJSONAndData(k, BoardFE)
+		page.JSON, err = json.Marshal(page.Data)
 		if err != nil {
 			return nil, err
 		}
-
-		pages := data.([]PageStore)
-		if i > len(pages)-1 {
-			return nil, ErrPageOverflow
-		}
-		return pages[i], nil
+		return page, nil
 	},
 
 	EncodeJSON: func(data interface{}) ([]byte, error) {
@@ -213,10 +119,4 @@ var BoardPageFE = FrontEnd{
 		templates.WriteIndexThreads(&b, data.(PageStore).Data.Threads, json)
 		return b.Bytes()
 	},
-
-	Size: func(_ interface{}, _, html []byte) int {
-		// Only the HTML is owned by this store. All other data is just
-		// borrowed from board
-		return len(html)
-	},
 }
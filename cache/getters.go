@@ -71,7 +71,6 @@ func getData(s *store, f FrontEnd) (
 			return
 		}
 	}
-	s.updateCounter = ctr
 	data, err = f.GetFresh(s.key)
 	if err != nil {
 		return
@@ -86,6 +85,9 @@ func getData(s *store, f FrontEnd) (
 		return
 	}
 
+	// Only store the counter after a successful fetch, so a failed one is
+	// retried on the next request instead of serving stale data
+	s.updateCounter = ctr
 	s.lastChecked = time.Now()
 	return
 }
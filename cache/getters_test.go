@@ -1,6 +1,8 @@
 package cache
 
 import (
+	"errors"
+
 	. "github.com/bakape/meguca/test"
 	"testing"
 	"time"
@@ -118,3 +120,49 @@ func TestCounterExpiry(t *testing.T) {
 	assertCount(t, "fetches", 1, fetches)
 	assertCount(t, "counter checks", 2, counterChecks)
 }
+
+func TestFailedFetchRetried(t *testing.T) {
+	Clear()
+
+	var (
+		fetches int
+		ctr     uint64 = 1
+		fail    bool
+	)
+	f := FrontEnd{
+		GetCounter: func(k Key) (uint64, error) {
+			return ctr, nil
+		},
+		GetFresh: func(k Key) (interface{}, error) {
+			fetches++
+			if fail {
+				return nil, errors.New("connection reset")
+			}
+			return fetches, nil
+		},
+	}
+
+	k := ThreadKey(33, 0)
+	get := func() ([]byte, error) {
+		// Force a counter check on every call
+		getStore(k).lastChecked = time.Time{}
+		json, _, _, err := GetJSONAndData(k, f)
+		return json, err
+	}
+
+	if _, err := get(); err != nil {
+		t.Fatal(err)
+	}
+	ctr = 2
+	fail = true
+	if _, err := get(); err == nil {
+		t.Fatal("expected error")
+	}
+	fail = false
+	json, err := get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, string(json), "3")
+	assertCount(t, "fetches", 3, fetches)
+}
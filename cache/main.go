@@ -4,8 +4,11 @@ package cache
 
 import (
 	"container/list"
+	"strings"
 	"sync"
 	"time"
+
+	"github.com/bakape/meguca/common"
 )
 
 // Time for the cache to expire and need counter comparison
@@ -27,6 +30,18 @@ type Key struct {
 	Board string
 	ID    uint64
 	Page  int64
+	Order common.SortOrder
+	// Comma-separated whitelist of boards to include on the "/all/" metaboard.
+	// Empty string means all boards.
+	Boards string
+}
+
+// Returns the board whitelist of the key, if any
+func (k Key) boardFilter() []string {
+	if k.Boards == "" {
+		return nil
+	}
+	return strings.Split(k.Boards, ",")
 }
 
 // Single cache entry
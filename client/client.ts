@@ -3,7 +3,10 @@
 import { handlers, message, connSM, connEvent } from './connection'
 import { posts, page } from './state'
 import { Post, FormModel, PostView, lightenThread } from './posts'
-import { PostLink, Command, PostData, ImageData, ModerationEntry } from "./common"
+import {
+	PostLink, Command, PostData, ImageData, ModerationEntry, CodeBlock,
+	EmbedMeta,
+} from "./common"
 import { postAdded } from "./ui"
 import { incrementPostCount } from "./page"
 import { posterName } from "./options"
@@ -21,6 +24,7 @@ type CloseMessage = {
 	id: number
 	links: PostLink[] | null
 	commands: Command[] | null
+	code_blocks?: CodeBlock[]
 }
 
 // Message for inserting images into an open post
@@ -32,6 +36,19 @@ interface ModerationMessage extends ModerationEntry {
 	id: number
 }
 
+// Message with Open Graph previews of the URLs in a post's body
+type EmbedsMessage = {
+	id: number
+	embeds: EmbedMeta[]
+}
+
+// Message with SVGs rendered from a post's math markup
+type MathMessage = {
+	id: number
+	svgs: string[]
+	error?: string
+}
+
 // Run a function on a model, if it exists
 function handle(id: number, fn: (m: Post) => void) {
 	const model = posts.get(id)
@@ -118,7 +135,7 @@ export default () => {
 		handle(msg.id, m =>
 			m.splice(msg))
 
-	handlers[message.closePost] = ({ id, links, commands }: CloseMessage) =>
+	handlers[message.closePost] = ({ id, links, commands, code_blocks }: CloseMessage) =>
 		handle(id, m => {
 			if (links) {
 				m.links = links
@@ -127,6 +144,9 @@ export default () => {
 			if (commands) {
 				m.commands = commands
 			}
+			if (code_blocks) {
+				m.code_blocks = code_blocks
+			}
 			m.closePost()
 		})
 
@@ -134,6 +154,18 @@ export default () => {
 		handle(msg.id, m =>
 			m.applyModeration(msg))
 
+	handlers[message.mathRendered] = ({ id, svgs, error }: MathMessage) =>
+		handle(id, m => {
+			if (error) {
+				console.error(`post ${id}: ${error}`)
+			}
+			m.setMath(svgs)
+		})
+
+	handlers[message.embeds] = ({ id, embeds }: EmbedsMessage) =>
+		handle(id, m =>
+			m.setEmbeds(embeds))
+
 	handlers[message.redirect] = (url: string) =>
 		location.href = url
 
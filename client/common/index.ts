@@ -57,6 +57,8 @@ export interface PostData {
 	body: string
 	name: string
 	trip: string
+	poster_id?: string
+	is_own?: boolean
 	auth: ModerationLevel
 	board?: string
 	flag?: string
@@ -64,6 +66,33 @@ export interface PostData {
 	links?: PostLink[]
 	commands?: Command[]
 	moderation?: ModerationEntry[]
+	edit_history?: EditEntry[]
+	has_dice_roll?: boolean
+	math_svgs?: string[]
+	math_pending?: boolean
+	code_blocks?: CodeBlock[]
+	embeds?: EmbedMeta[]
+}
+
+// Syntax highlighted code block of a post body
+export interface CodeBlock {
+	lang: string
+	code: string
+	html: string
+}
+
+// Open Graph preview of a URL in a post body
+export interface EmbedMeta {
+	url: string
+	title?: string
+	description?: string
+	image_url?: string
+}
+
+// Previous body of a post edited after closing
+export interface EditEntry {
+	edited_at: number
+	body: string
 }
 
 // State of a post's text. Used for adding enclosing tags to the HTML while
@@ -94,18 +123,29 @@ export interface Command {
 
 // Data of an OP post
 export interface ThreadData extends PostData {
+	cyclic: boolean
+	saged: boolean
+	cyclic_max: number
+	max_replies: number
 	post_count: number
 	image_count: number
+	unique_posters: number
 	update_time: number
 	bump_time: number
+	last_reply_time: number
+	last_image_time: number | null
+	images_remaining?: number
+	viewers?: number
 	subject: string
 	board: string
+	tags?: string[]
 	posts?: PostData[]
 }
 
 // Data of a board page
 export type BoardData = {
 	pages: number
+	thread_count: number
 	threads: ThreadData[]
 }
 
@@ -124,6 +164,8 @@ export interface ImageData {
 	dims: [number, number, number, number]
 	md5: string
 	sha1: string
+	sha256: string
+	phash: string
 	name: string
 
 	// Added client-side
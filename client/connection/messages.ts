@@ -11,6 +11,8 @@ export const enum message {
 	insertImage,
 	spoiler,
 	moderatePost,
+	mathRendered,
+	embeds,
 
 	// >= 30 are miscellaneous and do not write to post models
 	synchronise = 30,
@@ -47,6 +49,42 @@ export const enum message {
 	// Used by the client to send it's protocol version and by the server to
 	// send server and board configurations
 	configs,
+
+	// Create a closed post or thread in one message
+	createPost,
+
+	// Acknowledgement of a post created with createPost
+	postCreated,
+
+	// Failure to create a post with createPost
+	createError,
+
+	// Board-scoped sequence number of the preceding post insertion
+	eventSeq,
+
+	// Missed events can not be replayed and the thread must be refetched
+	replayLost,
+
+	// Another poster started typing in the thread
+	typing,
+
+	// Another poster stopped typing in the thread
+	stopTyping,
+
+	// Number of clients currently viewing the thread
+	viewers,
+
+	// Authenticate the connection. Must be the first message sent.
+	auth,
+
+	// Thread was locked or unlocked by a moderator
+	threadLock,
+
+	// Server is shutting down. Reconnect after the sent delay.
+	reconnectAfter,
+
+	// One of the client's posts was quoted
+	youGotReply,
 }
 
 export type MessageHandler = (msg: {}) => void
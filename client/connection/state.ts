@@ -2,7 +2,7 @@ import { FSM } from "../util"
 import { debug, page } from "../state"
 import { message, handlers } from "./messages"
 import { renderStatus } from "./ui"
-import { synchronise } from "./synchronization"
+import { authenticate, synchronise } from "./synchronization"
 
 const path =
 	(location.protocol === 'https:' ? 'wss' : 'ws')
@@ -10,7 +10,11 @@ const path =
 
 let socket: WebSocket,
 	attempts: number,
-	attemptTimer: number
+	attemptTimer: number,
+	// Delay before reconnecting requested by the server on shutdown and the
+	// earliest time a reconnection may be attempted
+	reconnectDelay = 0,
+	reconnectNotBefore = 0
 
 // Websocket connection and synchronization with server states
 export const enum syncStatus {
@@ -109,6 +113,7 @@ function onMessage(data: string, extracted: boolean) {
 
 function prepareToSync(): connState {
 	renderStatus(syncStatus.connecting)
+	authenticate()
 	synchronise()
 	attemptTimer = setTimeout(resetAttempts, 10000) as any
 	return connState.syncing
@@ -150,6 +155,9 @@ function resetAttempts() {
 	attempts = 0
 }
 
+handlers[message.reconnectAfter] = ({ delay }: { delay: number }) =>
+	reconnectDelay = delay
+
 export function start() {
 	connSM.feed(connEvent.start)
 }
@@ -180,7 +188,13 @@ connSM.wildAct(connEvent.close, event => {
 	}
 
 	// Wait maxes out at ~1min
-	const wait = 500 * Math.pow(1.5, Math.min(Math.floor(++attempts / 2), 12))
+	let wait = 500 * Math.pow(1.5, Math.min(Math.floor(++attempts / 2), 12))
+	if (reconnectDelay) {
+		// Spread out reconnections to a restarting server
+		wait = reconnectDelay * 1000
+		reconnectNotBefore = Date.now() + wait
+		reconnectDelay = 0
+	}
 	setTimeout(connSM.feeder(connEvent.retry), wait)
 
 	return connSM.state === connState.desynced
@@ -189,7 +203,9 @@ connSM.wildAct(connEvent.close, event => {
 })
 
 connSM.act(connState.dropped, connEvent.retry, () => {
-	if (!navigator.onLine || !page.thread) {
+	if (!navigator.onLine || !page.thread
+		|| Date.now() < reconnectNotBefore
+	) {
 		return connState.dropped
 	}
 
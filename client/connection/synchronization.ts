@@ -7,6 +7,7 @@ import { page, posts, displayLoading } from "../state"
 import { trigger, extend } from "../util"
 import { PostData, ModerationEntry } from "../common"
 import { insertPost } from "../client"
+import { sessionToken } from "../mod/common"
 
 // Passed from the server to allow the client to synchronise state, before
 // consuming any incoming update messages.
@@ -23,12 +24,25 @@ type PostState = {
 	body: string
 }
 
+// Sequence numbers of the last received post insertion per board. Sent on
+// reconnection to replay any missed posts.
+const lastSeq: { [board: string]: number } = {}
+
+// Authenticate the connection with the login session, if any. Must be sent
+// before any other message.
+export function authenticate() {
+	send(message.auth, {
+		token: sessionToken() || "",
+	})
+}
+
 // Send a requests to the server to synchronise to the current page and
 // subscribe to the appropriate event feeds
 export function synchronise() {
 	send(message.synchronise, {
 		board: page.board,
 		thread: page.thread,
+		lastSeq: lastSeq[page.board] || 0,
 	})
 
 	// Reclaim a post lost after disconnecting, going on standby, resuming
@@ -111,6 +125,19 @@ handlers[message.reclaim] = (code: number) => {
 	}
 }
 
+// Sequence numbers can arrive out of order from the replay and the live feed
+handlers[message.eventSeq] = (seq: number) => {
+	if (seq > (lastSeq[page.board] || 0)) {
+		lastSeq[page.board] = seq
+	}
+}
+
+// Missed posts are no longer buffered on the server. Refetch the thread.
+handlers[message.replayLost] = () => {
+	delete lastSeq[page.board]
+	location.reload()
+}
+
 // Synchronise to the server and start receiving updates on the appropriate
 // channel. If there are any missed messages, fetch them.
 handlers[message.synchronise] = async (data: SyncData) => {
@@ -21,4 +21,17 @@ export function renderSyncCount(sync: syncNum) {
 		`${sync.active.toString()} / ${sync.total.toString()}` : ''
 }
 
+// Render the number of clients viewing the thread next to the sync counter
+function renderViewers(n: number) {
+	let el = document.getElementById("viewer-count")
+	if (!el) {
+		el = document.createElement("b")
+		el.id = "viewer-count"
+		el.classList.add("act", "hide-empty", "banner-float")
+		syncedCount.parentNode.insertBefore(el, syncedCount)
+	}
+	el.textContent = n ? `${n} ${lang.ui.viewing}` : ''
+}
+
 handlers[message.syncCount] = renderSyncCount
+handlers[message.viewers] = renderViewers
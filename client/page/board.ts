@@ -13,7 +13,7 @@ type SortFunction = (a: Post, b: Post) => number
 // Thread sort functions
 const sorts: { [name: string]: SortFunction } = {
 	bump: subtract("bump_time"),
-	lastReply: subtract("update_time"),
+	lastReply: subtract("last_reply_time"),
 	creation: subtract("time"),
 	replyCount: subtract("post_count"),
 	fileCount: subtract("image_count"),
@@ -244,6 +244,7 @@ export default {
 	"vu": "Vanuatu",
 	"wf": "Wallis and Futuna",
 	"ws": "Samoa",
+	"xx": "Private network",
 	"ye": "Yemen",
 	"yt": "Mayotte",
 	"za": "South Africa",
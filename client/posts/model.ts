@@ -6,7 +6,7 @@ import { SpliceResponse } from '../client'
 import { mine, seenPosts, storeSeenPost, posts, hidden } from "../state"
 import { notifyAboutReply } from "../ui"
 import {
-	PostData, TextState, PostLink, Command, ImageData,
+	PostData, TextState, PostLink, Command, ImageData, CodeBlock, EmbedMeta,
 	ModerationEntry, ModerationAction, ModerationLevel,
 } from "../common"
 import { hideRecursively } from "./hide"
@@ -43,6 +43,10 @@ export class Post extends Model implements PostData {
 	}
 	public links: PostLink[]
 	public moderation: ModerationEntry[]
+	public math_svgs: string[]
+	public math_pending: boolean
+	public code_blocks: CodeBlock[]
+	public embeds: EmbedMeta[]
 
 	constructor(attrs: PostData) {
 		super()
@@ -135,6 +139,12 @@ export class Post extends Model implements PostData {
 		this.view.reparseBody()
 	}
 
+	// Insert Open Graph previews of the URLs in the post's body
+	public setEmbeds(embeds: EmbedMeta[]) {
+		this.embeds = embeds
+		this.view.reparseBody()
+	}
+
 	// Extra method for code reuse in post forms
 	protected spliceText({ start, len, text }: SpliceResponse) {
 		// Must use arrays of chars to properly splice multibyte unicode
@@ -208,6 +218,13 @@ export class Post extends Model implements PostData {
 		this.view.closePost()
 	}
 
+	// Insert SVGs rendered from the post's math markup
+	public setMath(svgs: string[]) {
+		this.math_svgs = svgs
+		this.math_pending = false
+		this.view.reparseBody()
+	}
+
 	public applyModeration(entry: ModerationEntry) {
 		if (!this.moderation) {
 			this.moderation = [];
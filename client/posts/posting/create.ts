@@ -0,0 +1,95 @@
+// Creation of closed posts and threads in a single websocket message
+
+import { handlers, message, send } from "../../connection"
+import { FileData } from "./upload"
+
+// Request to create a closed post. A threadID of zero creates a new thread
+// on board instead. Thread creation fails with code 409, if similar threads
+// exist on the board, unless ignoreSimilar is set.
+export type PostCreationRequest = {
+	threadID: number
+	board?: string
+	subject?: string
+	tags?: string[]
+	sage?: boolean
+	ignoreSimilar?: boolean
+	body: string
+	name?: string
+	password?: string
+	image?: FileData
+}
+
+// ID of a post created by the server
+export type CreatedPost = {
+	id: number
+	op: number
+}
+
+// Existing thread with a subject similar to one being created
+export type SimilarThread = {
+	id: number
+	board: string
+	subject: string
+}
+
+// Error returned by the server, when post creation failed. code is the HTTP
+// status code equivalent of the error. similar contains the threads similar
+// to the one being created, if any.
+export class PostCreationError extends Error {
+	public code: number
+	public similar: SimilarThread[]
+
+	constructor(code: number, message: string, similar: SimilarThread[] = []) {
+		super(message)
+		this.code = code
+		this.similar = similar
+	}
+}
+
+type pendingDraft = {
+	resolve: (p: CreatedPost) => void
+	reject: (err: PostCreationError) => void
+}
+
+// Drafts sent to the server, that are awaiting a response, by draft ID
+const pending = new Map<string, pendingDraft>()
+let draftCounter = 0
+
+// Send a closed post to the server. Any image must already have been uploaded
+// over HTTP. The response is linked to the draft by a temporary draft ID.
+export function createPost(req: PostCreationRequest): Promise<CreatedPost> {
+	const draftID = `${Date.now()}-${++draftCounter}`
+	return new Promise((resolve, reject) => {
+		pending.set(draftID, { resolve, reject })
+		send(message.createPost, { ...req, draftID })
+	})
+}
+
+type postCreatedMessage = CreatedPost & {
+	draftID: string
+}
+
+type createErrorMessage = {
+	draftID: string
+	code: number
+	error: string
+	similar?: SimilarThread[]
+}
+
+export default () => {
+	handlers[message.postCreated] = ({ draftID, id, op }: postCreatedMessage) => {
+		const p = pending.get(draftID)
+		if (p) {
+			pending.delete(draftID)
+			p.resolve({ id, op })
+		}
+	}
+
+	handlers[message.createError] = ({ draftID, code, error, similar }: createErrorMessage) => {
+		const p = pending.get(draftID)
+		if (p) {
+			pending.delete(draftID)
+			p.reject(new PostCreationError(code, error, similar))
+		}
+	}
+}
@@ -12,6 +12,9 @@ import initPaste from "./paste"
 import initFullScreen from "./fullscreen"
 import initImageErr from "./image"
 import initThreads from "./threads"
+import initCreate from "./create"
+import initTyping from "./typing"
+import initLock from "./lock"
 import { renderCaptchaForm, captchaLoaded } from "../../ui/captcha";
 import * as page from "../../page";
 import options from "../../options";
@@ -19,6 +22,7 @@ import options from "../../options";
 export { default as FormModel } from "./model"
 export { default as identity } from "./identity"
 export { expandThreadForm } from "./threads"
+export { createPost, PostCreationError, SimilarThread } from "./create"
 
 type Selection = {
 	start: Node
@@ -79,6 +83,10 @@ export const enum postEvent {
 	captchaRequested,
 	// Captcha successfully solved
 	captchaSolved,
+	// Thread locked by a moderator
+	threadLock,
+	// Thread unlocked by a moderator
+	threadUnlock,
 }
 export const postSM = new FSM<postState, postEvent>(postState.none)
 
@@ -275,6 +283,33 @@ export default () => {
 	postSM.wildAct(postEvent.reset, () =>
 		postState.ready)
 
+	// Thread locked by a moderator. Any unallocated draft is preserved, but
+	// can not be allocated until the thread is unlocked. Already allocated
+	// posts can still be finished.
+	postSM.wildAct(postEvent.threadLock, () => {
+		switch (postSM.state) {
+			case postState.alloc:
+			case postState.allocating:
+			case postState.halted:
+			case postState.erred:
+				return postSM.state
+		}
+		stylePostControls(el =>
+			el.classList.add("disabled"))
+		postForm && postForm.renderLockNotice(true)
+		return postState.threadLocked
+	})
+
+	postSM.act(postState.threadLocked, postEvent.threadUnlock, () => {
+		stylePostControls(el =>
+			el.classList.remove("disabled"))
+		if (postForm) {
+			postForm.renderLockNotice(false)
+			return postState.draft
+		}
+		return postState.ready
+	})
+
 	// Transition a draft post into allocated state. All the logic for this is
 	// model- and view-side.
 	postSM.act(postState.allocating, postEvent.alloc, () =>
@@ -407,5 +442,8 @@ export default () => {
 	initFullScreen()
 	initImageErr()
 	initThreads()
+	initCreate()
+	initTyping()
+	initLock()
 	initIdentity()
 }
@@ -0,0 +1,24 @@
+// Live thread lock and unlock notifications
+
+import { handlers, message } from "../../connection"
+import { page, posts } from "../../state"
+import { postSM, postEvent } from "."
+
+type threadLockMessage = {
+	threadID: number
+	locked: boolean
+}
+
+export default () => {
+	handlers[message.threadLock] = ({ threadID, locked }: threadLockMessage) => {
+		if (threadID !== page.thread) {
+			return
+		}
+		const op = posts.get(threadID)
+		if (op) {
+			op.locked = locked
+			op.view.renderLocked()
+		}
+		postSM.feed(locked ? postEvent.threadLock : postEvent.threadUnlock)
+	}
+}
@@ -8,6 +8,7 @@ import { extend, modPaste } from "../../util"
 import { SpliceResponse } from "../../client"
 import { FileData } from "./upload"
 import { newAllocRequest } from "./identity"
+import { notifyTyping } from "./typing"
 
 // Form Model of an OP post
 export default class FormModel extends Post {
@@ -80,6 +81,7 @@ export default class FormModel extends Post {
 		if (old === val) { // Everything already submitted
 			return
 		}
+		notifyTyping()
 
 		const lenDiff = val.length - old.length;
 		if (postSM.state === postState.draft) {
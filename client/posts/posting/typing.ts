@@ -0,0 +1,76 @@
+// Typing indicators of posters in the current thread
+
+import { handlers, message, send } from "../../connection"
+import { page } from "../../state"
+import lang from "../../lang"
+
+// Minimum interval between sent typing messages
+const throttle = 1000
+
+// Inactivity, after which the user is considered to have stopped typing
+const stopAfter = 3000
+
+// Expiry of another poster's indicator, in case the stop message is lost
+const expireAfter = 6000
+
+let lastSent = 0,
+	stopTimer = 0
+
+// Poster IDs of other posters typing in the thread with their expiry timers
+const typists = new Map<string, number>()
+
+type typingMessage = {
+	id: string
+}
+
+// Notify the server, that the user is typing in the current thread
+export function notifyTyping() {
+	if (!page.thread) {
+		return
+	}
+
+	const now = Date.now()
+	if (now - lastSent >= throttle) {
+		lastSent = now
+		send(message.typing, null)
+	}
+
+	clearTimeout(stopTimer)
+	stopTimer = window.setTimeout(() => {
+		lastSent = 0
+		send(message.stopTyping, null)
+	}, stopAfter)
+}
+
+function removeTypist(id: string) {
+	clearTimeout(typists.get(id))
+	typists.delete(id)
+	render()
+}
+
+// Render the number of other posters typing next to the sync counter
+function render() {
+	let el = document.getElementById("typing-indicator")
+	if (!el) {
+		const counter = document.getElementById("sync-counter")
+		if (!counter) {
+			return
+		}
+		el = document.createElement("b")
+		el.id = "typing-indicator"
+		el.classList.add("act", "hide-empty", "banner-float")
+		counter.parentNode.insertBefore(el, counter)
+	}
+	el.textContent = typists.size ? `${typists.size} ${lang.ui.typing}` : ""
+}
+
+export default () => {
+	handlers[message.typing] = ({ id }: typingMessage) => {
+		clearTimeout(typists.get(id))
+		typists.set(id, window.setTimeout(() => removeTypist(id), expireAfter))
+		render()
+	}
+
+	handlers[message.stopTyping] = ({ id }: typingMessage) =>
+		removeTypist(id)
+}
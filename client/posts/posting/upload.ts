@@ -2,7 +2,7 @@ import lang from '../../lang';
 import { load, trigger } from '../../util';
 import { Post } from "../model";
 import { View } from "../../base";
-import { config } from "../../state";
+import { config, boardConfig } from "../../state";
 import { postSM, postEvent, postState } from ".";
 
 // Uploaded file data to be embedded in thread and reply creation or file
@@ -36,6 +36,12 @@ export default class UploadForm extends View<Post> {
             .querySelector("input[name=image]") as HTMLInputElement;
         this.button = el.querySelector("button");
 
+        // Only offer file types allowed on the board
+        const types = allowedFileTypes();
+        if (types.length) {
+            this.hiddenInput.accept = types.map(t => "." + t).join(",");
+        }
+
         this.button.addEventListener("click", () => {
             if (this.isUploading) {
                 this.reset();
@@ -242,3 +248,17 @@ function bufferToHex(buf: ArrayBuffer): string {
     }
     return res.join('');
 }
+
+// Returns the file extensions allowed on the current board. An empty array
+// means all supported types are allowed.
+function allowedFileTypes(): string[] {
+    const global = config.fileTypes || [],
+        board = (boardConfig && boardConfig.fileTypes) || [];
+    if (!board.length) {
+        return global;
+    }
+    if (!global.length) {
+        return board;
+    }
+    return board.filter(t => global.includes(t));
+}
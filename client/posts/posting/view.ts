@@ -220,6 +220,22 @@ export default class FormView extends PostView {
         this.input.setAttribute("contenteditable", "false")
     }
 
+    // Toggle the notice about the thread being locked, while the draft is
+    // preserved
+    public renderLockNotice(locked: boolean) {
+        let el = this.el.querySelector(".lock-notice")
+        if (!locked) {
+            el && el.remove()
+            return
+        }
+        if (!el) {
+            el = document.createElement("div")
+            el.classList.add("lock-notice")
+            el.textContent = lang.ui["threadLockedDraft"]
+            this.el.querySelector("blockquote").before(el)
+        }
+    }
+
     // Transition into allocated post
     public renderAlloc() {
         this.id = this.el.id = "p" + this.model.id
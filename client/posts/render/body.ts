@@ -1,6 +1,8 @@
 import { config, boards, boardConfig, posts } from '../../state'
 import { renderPostLink, renderTempLink } from './etc'
-import { PostData, PostLink, TextState, commandType } from '../../common'
+import {
+    PostData, PostLink, TextState, commandType, EmbedMeta,
+} from '../../common'
 import { escape, makeAttrs } from '../../util'
 import { parseEmbeds } from "../embed"
 import highlightSyntax from "./code"
@@ -27,10 +29,83 @@ export default function renderBody(data: PostData): string {
         successive_newlines: 0,
         iDice: 0,
     }
+
+    let html: string
+    if (!data.editing && data.code_blocks) {
+        html = renderCodeBlocks(data)
+    } else {
+        html = renderLines(data.body, data)
+    }
+
+    if (!data.editing) {
+        html = renderFortunes(html)
+        if (data.math_svgs) {
+            html = renderMath(html, data.math_svgs)
+        }
+        if (data.embeds) {
+            html += renderEmbedCards(data.embeds)
+        }
+    }
+
+    return html
+}
+
+// Highlight fortunes drawn by the server. The markup has already been escaped
+// at this point.
+function renderFortunes(html: string): string {
+    return html.replace(/&lt;fortune&gt;(.*?)&lt;\/fortune&gt;/g,
+        `<span class="fortune">$1</span>`)
+}
+
+// Render Open Graph previews of the URLs in a post body, fetched by the server
+function renderEmbedCards(embeds: EmbedMeta[]): string {
+    let html = ""
+    // The preview image is not rendered, as hot-linking it would leak the
+    // viewer's IP to third parties
+    for (let { url, title, description } of embeds) {
+        html += `<a class="embed-card"${makeAttrs({
+            href: escape(url),
+            target: "_blank",
+            rel: "noopener noreferrer",
+        })}>`
+        if (title) {
+            html += `<strong>${escape(title)}</strong>`
+        }
+        if (description) {
+            html += `<span>${escape(description)}</span>`
+        }
+        html += "</a>"
+    }
+    return html
+}
+
+// Render the server-side highlighted code blocks of a closed post in place of
+// their markup and parse the text around them normally
+function renderCodeBlocks(data: PostData): string {
+    const re = /\[code(?: lang=\w+)?\][\s\S]*?\[\/code\]/g
+    let html = "",
+        last = 0,
+        i = 0,
+        m: RegExpExecArray
+    while (m = re.exec(data.body)) {
+        html += renderLines(data.body.slice(last, m.index), data)
+        const block = data.code_blocks[i++]
+        html += block ? block.html : escape(m[0])
+        last = re.lastIndex
+    }
+    return html + renderLines(data.body.slice(last), data)
+}
+
+// Render text split into lines
+function renderLines(body: string, data: PostData): string {
+    const { state } = data
     let html = ""
+    if (!body) {
+        return html
+    }
 
     const fn = data.editing ? parseOpenLine : parseTerminatedLine
-    for (let l of data.body.split("\n")) {
+    for (let l of body.split("\n")) {
         state.quote = false
 
         // Prevent successive empty lines
@@ -89,6 +164,21 @@ export default function renderBody(data: PostData): string {
     return html
 }
 
+// Replace math markup with the SVGs rendered from it. Markup, that failed to
+// render, is left as is.
+function renderMath(html: string, svgs: string[]): string {
+    let i = 0
+    return html.replace(/\[math\](.+?)\[\/math\]/g, (m, expr) => {
+        const url = svgs[i++]
+        if (!url) {
+            return m
+        }
+        // Strip any formatting tags. The remaining text is already escaped.
+        const alt = expr.replace(/<[^>]*>/g, "")
+        return `<img class="math" src="${url}" alt="${alt}" title="${alt}">`
+    })
+}
+
 // Open and close any tags up to level, if they are set.
 // Increment level by 1 for each tag deeper you go.
 function wrapTags(level: number, state: TextState): string {
@@ -401,6 +491,14 @@ function parseFragment(frag: string, data: PostData): string {
                     matched = true
                     break;
                 }
+
+                // Cross-board post links
+                m = word.match(/^>>>(>*)\/(\w+)\/(\d+)$/)
+                if (m) {
+                    html += parseCrossLink(m, data.links)
+                    matched = true
+                    break
+                }
             default:
                 // Strip leading '>', if any
                 let leadingGT = 0;
@@ -456,6 +554,20 @@ function parsePostLink(m: string[], links: PostLink[]): string {
     return m[1] + renderPostLink(data)
 }
 
+// Verify and render a link to a post on a specific board
+function parseCrossLink(m: string[], links: PostLink[]): string {
+    if (!links) {
+        return m[0]
+    }
+    const id = parseInt(m[3])
+    for (let l of links) {
+        if (l.id === id && l.board === m[2]) {
+            return m[1] + renderPostLink(l)
+        }
+    }
+    return m[0]
+}
+
 // Parse internal or customly set reference URL
 function parseReference(m: string[]): string {
     let href: string
@@ -17,6 +17,7 @@ interface Configs {
 	defaultLang: string
 	defaultCSS: string
 	imageRootOverride: string
+	fileTypes: string[]
 	links: { [key: string]: string }
 }
 
@@ -27,9 +28,14 @@ export interface BoardConfigs {
 	forcedAnon: boolean
 	rbText: boolean
 	pyu: boolean
+	posterIDs: boolean
+	threadTags: boolean
+	imageLimit: number
 	title: string
 	notice: string
 	rules: string
+	fileTypes: string[]
+	tags: string[]
 	[index: string]: any
 }
 
@@ -1,10 +1,17 @@
-import { storeSeenReply, seenReplies, hidden } from "../state"
+import { storeSeenReply, seenReplies, hidden, page } from "../state"
 import * as options from "../options";
 import lang from "../lang"
 import { thumbPath, Post } from "../posts"
 import { repliedToMe } from "./tab"
 import * as util from "../util"
 import { View } from "../base"
+import { handlers, message } from "../connection"
+
+type youGotReplyMessage = {
+	quotingPostID: number
+	threadID: number
+	board: string
+}
 
 // Notify the user that one of their posts has been replied to
 export default function notifyAboutReply(post: Post) {
@@ -41,6 +48,32 @@ export default function notifyAboutReply(post: Post) {
 	};
 }
 
+// Notify the user that one of their posts has been replied to in a thread not
+// currently displayed. Replies in the current thread are handled on insertion.
+handlers[message.youGotReply] = (msg: youGotReplyMessage) => {
+	const { quotingPostID: id, threadID, board } = msg
+	if (threadID === page.thread || seenReplies.has(id) || hidden.has(id)) {
+		return
+	}
+	storeSeenReply(id, threadID)
+	const url = `/${board}/${threadID}#p${id}`
+
+	if (document.hidden && options.canNotify()) {
+		const opts = options.notificationOpts()
+		opts.data = url
+		const n = new Notification(lang.ui["quoted"], opts)
+		n.onclick = function () {
+			this.close()
+			window.focus()
+			location.href = this.data
+		}
+		return
+	}
+	new OverlayNotification(lang.ui["quoted"]).el
+		.addEventListener("click", () =>
+			location.href = url)
+}
+
 // Textual notification at the top of the page
 export class OverlayNotification extends View<null> {
 	constructor(text: string) {
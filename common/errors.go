@@ -24,6 +24,12 @@ var (
 
 	// The poster is almost certainly spamming
 	ErrSpamDected = ErrAccessDenied("spam detected")
+
+	// The poster exceeded the board's flood protection post limit
+	ErrFlood = StatusError{errors.New("posting too fast"), 429}
+
+	// Replying to a thread locked by a moderator
+	ErrThreadLocked = StatusError{errors.New("thread is locked"), 423}
 )
 
 // StatusError is a simple error with HTTP status code attached
@@ -41,12 +47,83 @@ func (e StatusError) Error() string {
 		prefix = "access denied"
 	case 404:
 		prefix = "not found"
+	case 409:
+		prefix = "conflict"
+	case 415:
+		prefix = "unsupported media type"
+	case 423:
+		prefix = "locked"
+	case 429:
+		prefix = "too many requests"
 	case 500:
 		prefix = "internal server error"
 	}
 	return fmt.Sprintf("%s: %s", prefix, e.Err)
 }
 
+// CooldownError is returned, when a poster has to wait for the board's post
+// cooldown to pass before posting again
+type CooldownError struct {
+	// Remaining cooldown in seconds
+	RetryAfter uint
+}
+
+func (e CooldownError) Error() string {
+	return fmt.Sprintf("post cooldown: retry after %d seconds", e.RetryAfter)
+}
+
+// ReadErrorCode specifies the reason reading a thread or post failed
+type ReadErrorCode uint8
+
+const (
+	// NotFound means the requested thread or post does not exist
+	NotFound ReadErrorCode = iota
+
+	// Forbidden means the client may not access the requested thread or post
+	Forbidden
+
+	// DBError means the read failed due to a database error
+	DBError
+)
+
+// ReadError is returned, when a thread or post could not be read
+type ReadError struct {
+	Code ReadErrorCode
+	Err  error
+}
+
+func (e ReadError) Error() string {
+	var prefix string
+	switch e.Code {
+	case NotFound:
+		prefix = "not found"
+	case Forbidden:
+		prefix = "access denied"
+	default:
+		prefix = "database error"
+	}
+	return fmt.Sprintf("%s: %s", prefix, e.Err)
+}
+
+// StatusCode returns the HTTP status code matching the error
+func (e ReadError) StatusCode() int {
+	switch e.Code {
+	case NotFound:
+		return 404
+	case Forbidden:
+		return 403
+	default:
+		return 503
+	}
+}
+
+// IsNotFound returns, if err is a ReadError caused by a nonexistent thread or
+// post
+func IsNotFound(err error) bool {
+	e, ok := err.(ReadError)
+	return ok && e.Code == NotFound
+}
+
 // ErrTooLong is passed, when a field exceeds the maximum string length for
 // that specific field
 func ErrTooLong(s string) error {
@@ -100,6 +177,10 @@ recheck:
 			strings.HasPrefix(err.Err.Error(), "YouTube") {
 			return true
 		}
+	case ReadError:
+		if err.(ReadError).Code != DBError {
+			return true
+		}
 	case *websocket.CloseError:
 		return true
 	case util.WrappedError:
@@ -113,6 +194,7 @@ recheck:
 	for _, suff := range [...]string{
 		"connection reset by peer",
 		"broken pipe",
+		"i/o timeout",
 		"Error extracting sts from embedded url response",
 		"Error parsing signature tokens",
 		"\": invalid syntax",
@@ -67,4 +67,10 @@ type ImageCommon struct {
 	Title     string    `json:"title"`
 	MD5       string    `json:"md5"`
 	SHA1      string    `json:"sha1"`
+	// Hexadecimal SHA-256 hash of the stored file. Empty for files uploaded
+	// before it was recorded.
+	SHA256 string `json:"sha256"`
+	// Hexadecimal perceptual hash of the thumbnail. Empty, if the file has
+	// no thumbnail.
+	PHash string `json:"phash"`
 }
@@ -0,0 +1,64 @@
+package common
+
+import "unicode/utf8"
+
+const (
+	// Number of last replies in a mobile thread, that keep their full body
+	MobileFullPosts = 5
+
+	// Maximum body length of any other replies in a mobile thread
+	MobileBodyLen = 200
+)
+
+// MobilePostSummary is a post with the fields heavy on payload, that mobile
+// clients can do without, stripped and its body possibly truncated
+type MobilePostSummary struct {
+	Post
+	// Body is truncated to MobileBodyLen characters
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// MobileThread is a thread with its posts reduced for mobile clients on slow
+// connections
+type MobileThread struct {
+	Thread
+	Posts []MobilePostSummary `json:"posts"`
+	// Some posts have been stripped of data or had their bodies truncated
+	MobileTruncated bool `json:"mobile_truncated"`
+}
+
+// NewMobileThread reduces a thread for mobile clients. All posts are stripped
+// of their edit history, code blocks and link previews. Only the OP and the
+// last MobileFullPosts replies retain their full body.
+func NewMobileThread(t Thread) (m MobileThread) {
+	m.Thread = t
+	m.Thread.Posts = nil
+	m.MobileTruncated = stripMobilePost(&m.Thread.Post)
+
+	m.Posts = make([]MobilePostSummary, len(t.Posts))
+	full := len(t.Posts) - MobileFullPosts
+	for i, p := range t.Posts {
+		s := MobilePostSummary{Post: p}
+		if stripMobilePost(&s.Post) {
+			m.MobileTruncated = true
+		}
+		if i < full && utf8.RuneCountInString(p.Body) > MobileBodyLen {
+			s.Body = string([]rune(p.Body)[:MobileBodyLen])
+			s.Truncated = true
+			m.MobileTruncated = true
+		}
+		m.Posts[i] = s
+	}
+	return
+}
+
+// Strip a post of fields not sent to mobile clients. Returns, if any data was
+// removed.
+func stripMobilePost(p *Post) bool {
+	stripped := len(p.EditHistory) != 0 || len(p.CodeBlocks) != 0 ||
+		len(p.Embeds) != 0
+	p.EditHistory = nil
+	p.CodeBlocks = nil
+	p.Embeds = nil
+	return stripped
+}
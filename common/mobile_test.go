@@ -0,0 +1,87 @@
+package common
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	. "github.com/bakape/meguca/test"
+)
+
+func TestNewMobileThread(t *testing.T) {
+	t.Parallel()
+
+	long := strings.Repeat("ä", MobileBodyLen+1)
+	thread := Thread{
+		Subject: "foo",
+		Post: Post{
+			ID:   1,
+			Body: long,
+			EditHistory: []EditEntry{
+				{Body: "bar"},
+			},
+		},
+	}
+	for i := 0; i < MobileFullPosts+2; i++ {
+		thread.Posts = append(thread.Posts, Post{
+			ID:   uint64(i + 2),
+			Body: long,
+			CodeBlocks: []CodeBlock{
+				{Code: "x"},
+			},
+			Embeds: []EmbedMeta{
+				{URL: "https://example.com"},
+			},
+		})
+	}
+
+	m := NewMobileThread(thread)
+	AssertDeepEquals(t, m.MobileTruncated, true)
+	AssertDeepEquals(t, m.Subject, "foo")
+	AssertDeepEquals(t, m.Body, long)
+	AssertDeepEquals(t, m.EditHistory, []EditEntry(nil))
+	AssertDeepEquals(t, len(m.Posts), MobileFullPosts+2)
+	for i, p := range m.Posts {
+		AssertDeepEquals(t, p.CodeBlocks, []CodeBlock(nil))
+		AssertDeepEquals(t, p.Embeds, []EmbedMeta(nil))
+		if i < 2 {
+			AssertDeepEquals(t, p.Truncated, true)
+			AssertDeepEquals(t, p.Body, long[:MobileBodyLen*len("ä")])
+		} else {
+			AssertDeepEquals(t, p.Truncated, false)
+			AssertDeepEquals(t, p.Body, long)
+		}
+	}
+
+	// Source thread must not be modified
+	AssertDeepEquals(t, len(thread.Posts[0].CodeBlocks), 1)
+	AssertDeepEquals(t, thread.Posts[0].Body, long)
+
+	// Posts of the mobile thread must shadow the posts of the embedded thread
+	buf, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var res struct {
+		Posts           []map[string]interface{} `json:"posts"`
+		MobileTruncated bool                     `json:"mobile_truncated"`
+	}
+	if err := json.Unmarshal(buf, &res); err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, len(res.Posts), MobileFullPosts+2)
+	AssertDeepEquals(t, res.Posts[0]["truncated"], true)
+	AssertDeepEquals(t, res.MobileTruncated, true)
+}
+
+func TestNewMobileThreadNoTruncation(t *testing.T) {
+	t.Parallel()
+
+	m := NewMobileThread(Thread{
+		Posts: []Post{
+			{ID: 2, Body: "foo"},
+		},
+	})
+	AssertDeepEquals(t, m.MobileTruncated, false)
+	AssertDeepEquals(t, m.Posts[0].Body, "foo")
+}
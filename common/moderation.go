@@ -22,6 +22,10 @@ const (
 	ShadowBinPost
 )
 
+// SelfDeletion is the author of moderation entries of posts deleted by their
+// own poster
+const SelfDeletion = "poster"
+
 // Contains fields of a post moderation log entry
 type ModerationEntry struct {
 	Type   ModerationAction `json:"type"`
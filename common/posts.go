@@ -6,11 +6,31 @@ package common
 // TODO: Clean up this function signature
 var ParseBody func([]byte, string, uint64, uint64, string, bool) ([]Link, []Command, error)
 
+// RollDice forwards parser.RollDice to avoid cyclic imports in db/upkeep
+var RollDice func(body, prev string) (string, bool, error)
+
+// DrawFortunes forwards parser.DrawFortunes to avoid cyclic imports in
+// db/upkeep
+var DrawFortunes func(body, prev, board string) (string, error)
+
+// HighlightCode forwards parser.HighlightCodeBlocks to avoid cyclic imports in
+// db
+var HighlightCode func(body string) []CodeBlock
+
+// FetchEmbeds forwards websockets.FetchPostEmbeds to avoid cyclic imports in
+// db/upkeep
+var FetchEmbeds func(id, op uint64, body string)
+
+// RenderMath forwards websockets.RenderPostMath to avoid cyclic imports in
+// db/upkeep
+var RenderMath func(id, op uint64, body string)
+
 // Board is defined to enable marshalling optimizations and sorting by sticky
 // threads
 type Board struct {
-	Pages   int      `json:"pages"`
-	Threads []Thread `json:"threads"`
+	Pages       int      `json:"pages"`
+	ThreadCount int      `json:"thread_count"`
+	Threads     []Thread `json:"threads"`
 }
 
 func (b Board) Len() int {
@@ -26,19 +46,80 @@ func (b Board) Less(i, j int) bool {
 	return b.Threads[i].Sticky
 }
 
-// Thread is a transport/export wrapper that stores both the thread metadata,
-// its opening post data and its contained posts. The composite type itself is
-// not stored in the database.
-type Thread struct {
-	Abbrev     bool   `json:"abbrev"`
+// SortOrder specifies the order threads are sorted in on board pages
+type SortOrder uint8
+
+// Available thread sort orders
+const (
+	SortBump SortOrder = iota
+	SortCreation
+	SortReplyCount
+	SortImageCount
+	SortLastReply
+)
+
+// ParseSortOrder parses a thread sort order from its string representation.
+// Defaults to SortBump.
+func ParseSortOrder(s string) SortOrder {
+	switch s {
+	case "creation":
+		return SortCreation
+	case "replyCount":
+		return SortReplyCount
+	case "imageCount":
+		return SortImageCount
+	case "lastReply":
+		return SortLastReply
+	default:
+		return SortBump
+	}
+}
+
+// CatalogEntry contains the minimal thread metadata needed to render a board
+// catalog
+type CatalogEntry struct {
 	Sticky     bool   `json:"sticky"`
-	Locked     bool   `json:"locked"`
+	ID         uint64 `json:"id"`
 	PostCount  uint32 `json:"post_count"`
 	ImageCount uint32 `json:"image_count"`
-	UpdateTime int64  `json:"update_time"`
 	BumpTime   int64  `json:"bump_time"`
 	Subject    string `json:"subject"`
-	Board      string `json:"board"`
+	Thumbnail  string `json:"thumbnail,omitempty"`
+}
+
+// SimilarThread is an existing thread with a subject similar to one being
+// created
+type SimilarThread struct {
+	ID      uint64 `json:"id"`
+	Board   string `json:"board"`
+	Subject string `json:"subject"`
+}
+
+// Thread is a transport/export wrapper that stores both the thread metadata,
+// its opening post data and its contained posts. The composite type itself is
+// not stored in the database.
+type Thread struct {
+	Abbrev          bool     `json:"abbrev"`
+	HasMore         bool     `json:"has_more"`
+	Sticky          bool     `json:"sticky"`
+	Locked          bool     `json:"locked"`
+	Archived        bool     `json:"archived"`
+	Cyclic          bool     `json:"cyclic"`
+	Saged           bool     `json:"saged"`
+	CyclicMax       uint16   `json:"cyclic_max"`
+	MaxReplies      int      `json:"max_replies"`
+	PostCount       uint32   `json:"post_count"`
+	ImageCount      uint32   `json:"image_count"`
+	UniquePosters   uint32   `json:"unique_posters"`
+	UpdateTime      int64    `json:"update_time"`
+	BumpTime        int64    `json:"bump_time"`
+	LastReplyTime   int64    `json:"last_reply_time"`
+	LastImageTime   *int64   `json:"last_image_time"`
+	ImagesRemaining *int     `json:"images_remaining,omitempty"`
+	Viewers         int      `json:"viewers,omitempty"`
+	Subject         string   `json:"subject"`
+	Board           string   `json:"board"`
+	Tags            []string `json:"tags,omitempty"`
 	Post
 	Posts []Post `json:"posts"`
 }
@@ -48,6 +129,7 @@ type Thread struct {
 type Post struct {
 	Editing    bool              `json:"editing"`
 	Moderated  bool              `json:"-"`
+	IsOwn      bool              `json:"is_own,omitempty"`
 	Sage       bool              `json:"sage"`
 	Auth       ModerationLevel   `json:"auth"`
 	ID         uint64            `json:"id"`
@@ -56,10 +138,54 @@ type Post struct {
 	Flag       string            `json:"flag"`
 	Name       string            `json:"name"`
 	Trip       string            `json:"trip"`
+	PosterID   string            `json:"poster_id,omitempty"`
 	Image      *Image            `json:"image"`
 	Links      []Link            `json:"links"`
+	ReplyIDs   []uint64          `json:"reply_ids,omitempty"`
 	Commands   []Command         `json:"commands"`
 	Moderation []ModerationEntry `json:"moderation"`
+	// Previous bodies of the post, most recent first
+	EditHistory []EditEntry `json:"edit_history,omitempty"`
+	// Body contains evaluated dice roll markup
+	HasDiceRoll bool `json:"has_dice_roll,omitempty"`
+	// URLs of SVGs rendered from the post's math markup in order of appearance.
+	// Markup, that failed to render, has an empty URL.
+	MathSVGs []string `json:"math_svgs,omitempty"`
+	// Math markup of the post is still being rendered
+	MathPending bool `json:"math_pending,omitempty"`
+	// Syntax highlighted code blocks in order of appearance
+	CodeBlocks []CodeBlock `json:"code_blocks,omitempty"`
+	// Open Graph previews of URLs in the post body
+	Embeds []EmbedMeta `json:"embeds,omitempty"`
+}
+
+// EmbedMeta is the Open Graph metadata of a URL posted in a post body
+type EmbedMeta struct {
+	URL         string `json:"url"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	ImageURL    string `json:"image_url"`
+}
+
+// CodeBlock is a [code lang=X]...[/code] block of a post body together with
+// its syntax highlighted HTML
+type CodeBlock struct {
+	Lang string `json:"lang"`
+	Code string `json:"code"`
+	HTML string `json:"html"`
+}
+
+// QuoteNode is a post together with the tree of posts replying to it
+type QuoteNode struct {
+	Post     Post        `json:"post"`
+	Children []QuoteNode `json:"children"`
+}
+
+// EditEntry is a previous version of a post's body, replaced by the poster
+// after the post was closed
+type EditEntry struct {
+	EditedAt int64  `json:"edited_at"`
+	Body     string `json:"body"`
 }
 
 // Return if post has been deleted by staff
@@ -0,0 +1,29 @@
+package common
+
+import (
+	"math"
+	"time"
+)
+
+// Exponent of the thread age in TrendingScore. Higher values make scores decay
+// faster.
+const trendingGravity = 1.8
+
+// TrendingScore ranks a thread by its recent activity. Like Hacker News
+// ranking, the number of replies is divided by a power of the thread's age in
+// hours, so new threads with some replies outrank old ones with many. Images
+// count as half a reply. The score is further divided by the hours since the
+// last reply plus one, so threads, that stopped receiving replies, drop off.
+func TrendingScore(postCtr, imageCtr uint32, lastReplyTime, createdAt time.Time,
+) float64 {
+	now := time.Now()
+	age := math.Max(now.Sub(createdAt).Hours(), 0)
+	idle := math.Max(now.Sub(lastReplyTime).Hours(), 0)
+
+	// Do not count the OP itself
+	activity := float64(imageCtr) / 2
+	if postCtr > 1 {
+		activity += float64(postCtr - 1)
+	}
+	return activity / math.Pow(age+2, trendingGravity) / (idle + 1)
+}
@@ -0,0 +1,44 @@
+package common
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	. "github.com/bakape/meguca/test"
+)
+
+func TestTrendingScore(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	hours := func(n int) time.Time {
+		return now.Add(-time.Duration(n) * time.Hour)
+	}
+
+	// Sorted by expected score descending
+	cases := [...]struct {
+		name               string
+		posts, images      uint32
+		lastReply, created time.Time
+	}{
+		{"new and busy", 100, 10, hours(0), hours(1)},
+		{"new with images", 20, 20, hours(0), hours(1)},
+		{"new", 20, 0, hours(0), hours(1)},
+		{"new and idle", 20, 0, hours(1), hours(2)},
+		{"old and busy", 500, 50, hours(0), hours(48)},
+		{"old and idle", 500, 50, hours(24), hours(48)},
+		{"no replies", 1, 0, hours(0), hours(0)},
+	}
+
+	scores := make([]float64, len(cases))
+	for i, c := range cases {
+		scores[i] = TrendingScore(c.posts, c.images, c.lastReply, c.created)
+	}
+	if !sort.SliceIsSorted(scores, func(i, j int) bool {
+		return scores[i] > scores[j]
+	}) {
+		t.Fatalf("unexpected order: %v", scores)
+	}
+	AssertDeepEquals(t, scores[len(scores)-1], float64(0))
+}
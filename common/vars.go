@@ -28,18 +28,46 @@ const (
 	MaxLenBoardTitle   = 100
 	MaxLenNotice       = 500
 	MaxLenRules        = 5000
+	MaxLenCustomCSS    = 64 << 10
 	MaxLenEightball    = 2000
+	MaxLenTag          = 20
+	MaxNumTags         = 8
+	MaxNumThreadTags   = 5
 	MaxLenReason       = 100
+	MaxLenModNote      = 1000
+	MaxLenAppeal       = 1000
+	MaxLenWordFilter   = 100
+	MaxLenSearchQuery  = 100
+	MaxLenWebhookURL   = 2000
+	MaxLenSecret       = 100
+	MaxNumWebhooks     = 10
 	MaxNumBanners      = 20
 	MaxAssetSize       = 100 << 10
 	MaxDiceSides       = 10000
+	MaxDiceMarkupRolls = 20
+	MaxDiceMarkup      = 10
+	MaxNumMath         = 10
+	MaxNumFortunes     = 1000
+	MaxLenFortune      = 300
+	MaxFortuneMarkup   = 10
+	MaxNumEmbeds       = 3
+	MaxLenMath         = 1000
+	MaxThreadsPerPage  = 100
+	MaxFloodPosts      = 1000
+	MaxFloodInterval   = 3600
+	MaxPostCooldown    = 3600
+	MaxDefaultLastN    = 100
+	MaxReplayBuffer    = 1024
 	BumpLimit          = 1000
+	MinCyclicMax       = 10
+	DefaultCyclicMax   = 500
 )
 
 // Various cryptographic token exact lengths
 const (
-	LenSession    = 171
-	LenImageToken = 86
+	LenSession     = 171
+	LenImageToken  = 86
+	LenPostSession = 43
 )
 
 // Available language packs and themes. Change this, when adding any new ones.
@@ -58,4 +86,25 @@ var (
 var (
 	CommandRegexp = regexp.MustCompile(`^#(flip|\d*d\d+|8ball|pyu|pcount|sw(?:\d+:)?\d+:\d+(?:[+-]\d+)?|roulette|rcount)$`)
 	DiceRegexp    = regexp.MustCompile(`(\d*)d(\d+)`)
+
+	// Dice roll markup in post bodies. DiceMarkupRegexp matches both
+	// unevaluated and evaluated rolls, DiceResultRegexp only evaluated ones.
+	DiceMarkupRegexp = regexp.MustCompile(`\[dice (\d+)d(\d+)(?:: [^\]]*)?\]`)
+	DiceResultRegexp = regexp.MustCompile(`\[dice \d+d\d+: [^\]]*\]`)
+
+	// LaTeX math markup in post bodies
+	MathRegexp = regexp.MustCompile(`\[math\](.+?)\[/math\]`)
+
+	// HTTP(S) URLs in post bodies
+	URLRegexp = regexp.MustCompile(`https?://[^\s<>"]+`)
+
+	// Fortune markup in post bodies and the fortunes it was replaced with
+	FortuneMarkupRegexp = regexp.MustCompile(`\[fortune\]`)
+	FortuneResultRegexp = regexp.MustCompile(`<fortune>[^<>]*</fortune>`)
+
+	// Code blocks with an optional language in post bodies
+	CodeBlockRegexp = regexp.MustCompile(`(?s)\[code(?: lang=(\w+))?\](.*?)\[/code\]`)
+
+	// Board and thread tags
+	TagRegexp = regexp.MustCompile(`^[a-z0-9\-]{1,20}$`)
 )
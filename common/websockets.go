@@ -3,6 +3,7 @@ package common
 import (
 	"bytes"
 	"encoding/json"
+	"net"
 	"strconv"
 )
 
@@ -23,6 +24,8 @@ const (
 	MessageInsertImage
 	MessageSpoiler
 	MessageModeratePost
+	MessageMathRendered
+	MessageEmbeds
 )
 
 // >= 30 are miscellaneous and do not write to post models
@@ -62,8 +65,54 @@ const (
 	// Used by the client to send it's protocol version and by the server to
 	// send server and board configurations
 	MessageConfigs
+
+	// Create a closed post or thread in one message
+	MessageCreatePost
+
+	// Acknowledge successful creation of a post from MessageCreatePost
+	MessagePostCreated
+
+	// Failure to create a post from MessageCreatePost
+	MessageCreateError
+
+	// Board-scoped sequence number of the preceding post insertion
+	MessageEventSeq
+
+	// Events since the client's last sequence number can not be replayed and
+	// the client must refetch the thread
+	MessageReplayLost
+
+	// Client started typing in a thread. Relayed to other clients in the
+	// thread with the poster ID of the typist.
+	MessageTyping
+
+	// Client stopped typing in a thread
+	MessageStopTyping
+
+	// Number of clients currently viewing a thread
+	MessageViewers
+
+	// Authenticates the connection with a login session token. Must be the
+	// first message sent by the client.
+	MessageAuth
+
+	// A thread was locked or unlocked by a moderator
+	MessageThreadLock
+
+	// Server is shutting down. The client should reconnect after the
+	// contained delay in seconds.
+	MessageReconnectAfter
+
+	// A post created within the client's post session was quoted
+	MessageYouGotReply
 )
 
+// ThreadLockMessage is the payload of MessageThreadLock
+type ThreadLockMessage struct {
+	ThreadID uint64 `json:"threadID"`
+	Locked   bool   `json:"locked"`
+}
+
 // Forwarded functions from "github.com/bakape/megucawebsockets/feeds" to avoid circular imports
 var (
 	// GetByIPAndBoard retrieves all Clients that match the passed IP on a board
@@ -72,11 +121,19 @@ var (
 	// GetClientsByIP returns connected clients with matching ips
 	GetClientsByIP func(ip string) []Client
 
+	// GetByRangeAndBoard retrieves all Clients with an IP in the passed range
+	// on a board
+	GetByRangeAndBoard func(ipRange *net.IPNet, board string) []Client
+
 	// SendTo sends a message to a feed, if it exists
 	SendTo func(id uint64, msg []byte)
 
 	// ClosePost closes a post in a feed, if it exists
-	ClosePost func(id, op uint64, links []Link, commands []Command) error
+	ClosePost func(id, op uint64, links []Link, commands []Command,
+		codeBlocks []CodeBlock) error
+
+	// EditPost replaces the body of a post in a feed, if it exists
+	EditPost func(id, op uint64, prev, body string) error
 )
 
 // Client exposes some globally accessible websocket client functionality
@@ -85,6 +142,7 @@ type Client interface {
 	Send([]byte)
 	Redirect(board string)
 	IP() string
+	Account() string
 	LastTime() int64
 	Close(error)
 }
@@ -4,6 +4,7 @@
 package config
 
 import (
+	"compress/flate"
 	"encoding/json"
 	"reflect"
 	"sort"
@@ -70,10 +71,13 @@ var (
 		MaxHeight:         6000,
 		MaxWidth:          6000,
 		SessionExpiry:     30,
+		PostEditWindow:    5,
 		CharScore:         170,
 		PostCreationScore: 15000,
 		ImageScore:        15000,
 		EmailErrPort:      587,
+		ReplayBufferSize:  64,
+		CompressionLevel:  flate.DefaultCompression,
 		Salt:              "LALALALALALALALALALALALALALALALALALALALA",
 		EmailErrMail:      "admin@email.com",
 		EmailErrPass:      "sluts",
@@ -105,6 +109,14 @@ var (
 	}
 )
 
+// DefaultThreadsPerPage is the number of threads displayed on a board index
+// page, unless overridden in the board's configuration
+const DefaultThreadsPerPage = 15
+
+// DefaultFloodInterval is the default time window in seconds, in which the
+// number of posts by one IP is limited by a board's flood protection
+const DefaultFloodInterval = 60
+
 // Default string for the FAQ panel
 const defaultFAQ = `Supported upload file types are JPEG, PNG, APNG, WEBM, MP3, FLAC, MP4, OGG, PDF, ZIP, 7Z, TAR.GZ, TAR.XZ, RAR, CBZ, CBR.
 <hr>Encase text in:
@@ -181,3 +181,47 @@ func TestGetBoardTitles(t *testing.T) {
 		},
 	})
 }
+
+func TestAllowedFileTypes(t *testing.T) {
+	Clear()
+	conf := Configs{
+		Public: Public{
+			FileTypes: []string{"jpg", "png", "webm"},
+		},
+	}
+	if err := Set(conf); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := [...]struct {
+		name       string
+		board, std []string
+		ext        string
+		allowsExt  bool
+	}{
+		{"no board whitelist", nil, conf.FileTypes, "png", true},
+		{"subset", []string{"png"}, []string{"png"}, "jpg", false},
+		{
+			"not in global whitelist",
+			[]string{"png", "gif"},
+			[]string{"png"},
+			"gif",
+			false,
+		},
+		{"allowed", []string{"jpg", "webm"}, []string{"jpg", "webm"}, "webm",
+			true},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			b := BoardConfigs{
+				BoardPublic: BoardPublic{
+					FileTypes: c.board,
+				},
+			}
+			AssertDeepEquals(t, b.AllowedFileTypes(), c.std)
+			AssertDeepEquals(t, b.AllowsFileType(c.ext), c.allowsExt)
+		})
+	}
+}
@@ -1,27 +1,47 @@
 package config
 
+import "github.com/bakape/meguca/common"
+
 // Configs stores the global server configuration
 type Configs struct {
 	Public
 	PruneBoards         bool   `json:"pruneBoards"`
+	ArchiveThreads      bool   `json:"archiveThreads"`
 	HideNSFW            bool   `json:"hideNSFW"`
 	EmailErr            bool   `json:"emailErr"`
+	NoDuplicateImages   bool   `json:"noDuplicateImages"`
 	MaxWidth            uint16 `json:"maxWidth"`
 	MaxHeight           uint16 `json:"maxHeight"`
 	BoardExpiry         uint   `json:"boardExpiry"`
 	SessionExpiry       uint   `json:"sessionExpiry"`
+	CORSCredentials     bool   `json:"corsCredentials"`
+	SubnetRateLimit     bool   `json:"subnetRateLimit"`
+	DisableGeoIP        bool   `json:"disableGeoIP"`
+	PostEditWindow      uint   `json:"postEditWindow"`
 	EmailErrPort        uint   `json:"emailErrPort"`
 	CharScore           uint   `json:"charScore"`
 	PostCreationScore   uint   `json:"postCreationScore"`
 	ImageScore          uint   `json:"imageScore"`
+	ReadRateLimit       uint   `json:"readRateLimit"`
+	ReadBurst           uint   `json:"readBurst"`
+	PostRateLimit       uint   `json:"postRateLimit"`
+	PostBurst           uint   `json:"postBurst"`
+	UploadRateLimit     uint   `json:"uploadRateLimit"`
+	UploadBurst         uint   `json:"uploadBurst"`
+	ReplayBufferSize    uint   `json:"replayBufferSize"`
+	CompressionLevel    int    `json:"compressionLevel"`
 	RootURL             string `json:"rootURL"`
 	Salt                string `json:"salt"`
 	EmailErrMail        string `json:"emailErrMail"`
 	EmailErrPass        string `json:"emailErrPass"`
 	EmailErrSub         string `json:"emailErrSub"`
 	FeedbackEmail       string `json:"feedbackEmail"`
+	MetricsToken        string `json:"metricsToken"`
+	GeoIPPath           string `json:"geoIPPath"`
+	FortunesPath        string `json:"fortunesPath"`
 	FAQ                 string
 	CaptchaTags         []string          `json:"captchaTags"`
+	CORSOrigins         []string          `json:"corsOrigins"`
 	OverrideCaptchaTags map[string]string `json:"overrideCaptchaTags"`
 }
 
@@ -37,15 +57,89 @@ type Public struct {
 	DefaultLang       string            `json:"defaultLang"`
 	DefaultCSS        string            `json:"defaultCSS"`
 	ImageRootOverride string            `json:"imageRootOverride"`
+	FileTypes         []string          `json:"fileTypes"`
 	Links             map[string]string `json:"links"`
 }
 
 // BoardConfigs stores board-specific configuration
 type BoardConfigs struct {
 	BoardPublic
-	DisableRobots bool     `json:"disableRobots"`
-	ID            string   `json:"id"`
-	Eightball     []string `json:"eightball"`
+	DisableRobots     bool     `json:"disableRobots"`
+	DisableAudio      bool     `json:"disableAudio"`
+	DisablePDF        bool     `json:"disablePDF"`
+	NoDuplicateImages bool     `json:"noDuplicateImages"`
+	ThreadsPerPage    uint     `json:"threadsPerPage"`
+	FloodPosts        uint     `json:"floodPosts"`
+	FloodInterval     uint     `json:"floodInterval"`
+	PostCooldown      uint     `json:"postCooldown"`
+	DefaultLastN      uint     `json:"defaultLastN"`
+	MaxReplies        uint     `json:"maxReplies"`
+	MaxVideoLength    uint     `json:"maxVideoLength"`
+	MaxPDFSize        uint     `json:"maxPDFSize"`
+	ID                string   `json:"id"`
+	CustomCSS         string   `json:"customCSS"`
+	Eightball         []string `json:"eightball"`
+}
+
+// PageSize returns the number of threads to display on a board index page
+func (c BoardConfigs) PageSize() int {
+	if c.ThreadsPerPage == 0 {
+		return DefaultThreadsPerPage
+	}
+	return int(c.ThreadsPerPage)
+}
+
+// ReplyLimit returns the number of posts in a thread, after which new replies
+// no longer bump it, unless overridden in the thread's configuration
+func (c BoardConfigs) ReplyLimit() int {
+	if c.MaxReplies == 0 {
+		return common.BumpLimit
+	}
+	return int(c.MaxReplies)
+}
+
+// AllowsFileType returns, if the file extension is in the global file type
+// whitelist
+func (c Configs) AllowsFileType(ext string) bool {
+	return inWhitelist(c.FileTypes, ext)
+}
+
+// AllowedFileTypes returns the file extensions that can be uploaded to the
+// board. The board's whitelist is limited to a subset of the global one.
+// Returns nil, if all supported file types are allowed.
+func (c BoardConfigs) AllowedFileTypes() []string {
+	global := Get().FileTypes
+	switch {
+	case len(c.FileTypes) == 0:
+		return global
+	case len(global) == 0:
+		return c.FileTypes
+	}
+	allowed := make([]string, 0, len(c.FileTypes))
+	for _, t := range c.FileTypes {
+		if inWhitelist(global, t) {
+			allowed = append(allowed, t)
+		}
+	}
+	return allowed
+}
+
+// AllowsFileType returns, if the file extension can be uploaded to the board
+func (c BoardConfigs) AllowsFileType(ext string) bool {
+	return Get().AllowsFileType(ext) && inWhitelist(c.FileTypes, ext)
+}
+
+// Empty whitelists allow everything
+func inWhitelist(list []string, ext string) bool {
+	if len(list) == 0 {
+		return true
+	}
+	for _, t := range list {
+		if t == ext {
+			return true
+		}
+	}
+	return false
 }
 
 // BoardPublic contains publically accessible board-specific configurations
@@ -57,11 +151,20 @@ type BoardPublic struct {
 	NSFW       bool
 	RbText     bool   `json:"rbText"`
 	Pyu        bool   `json:"pyu"`
+	PosterIDs  bool   `json:"posterIDs"`
+	ThreadTags bool   `json:"threadTags"`
+	ImageLimit uint   `json:"imageLimit"`
 	DefaultCSS string `json:"defaultCSS"`
 	Title      string `json:"title"`
 	Notice     string `json:"notice"`
 	Rules      string `json:"rules"`
 
+	// File extensions allowed on the board. Empty for all.
+	FileTypes []string `json:"fileTypes"`
+
+	// Topics the board can be discovered by
+	Tags []string `json:"tags"`
+
 	// Can't use []uint8, because it marshals to string
 	Banners []uint16 `json:"banners"`
 }
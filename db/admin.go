@@ -12,6 +12,15 @@ import (
 	"github.com/bakape/meguca/imager/assets"
 )
 
+const (
+	// ModLogPageSize is the number of moderation log entries on a single page
+	ModLogPageSize = 50
+
+	// MaxModLogPage is the last retrievable page of the moderation log. Keeps
+	// clients from forcing the database to scan arbitrarily large offsets.
+	MaxModLogPage = 200
+)
+
 // Write moderation action to board-level and post-level logs
 func logModeration(tx *sql.Tx, e auth.ModLogEntry) (err error) {
 	_, err = sq.Insert("mod_log").
@@ -153,6 +162,35 @@ func WriteStaff(tx *sql.Tx, board string,
 	return
 }
 
+// TransferBoard makes account the sole owner of a board. All previous owners
+// lose any positions on the board, as does account, before becoming owner.
+func TransferBoard(board, account string) error {
+	return InTransaction(false, func(tx *sql.Tx) (err error) {
+		_, err = sq.Delete("staff").
+			Where(squirrel.Or{
+				squirrel.Eq{"account": account},
+				squirrel.Expr(
+					`account in (
+						select account from staff
+						where board = ? and position = ?)`,
+					board, common.BoardOwner,
+				),
+			}).
+			Where("board = ?", board).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+		_, err = sq.Insert("staff").
+			Columns("board", "account", "position").
+			Values(board, account, common.BoardOwner).
+			RunWith(tx).
+			Exec()
+		return
+	})
+}
+
 // GetStaff retrieves all staff positions of a specific board
 func GetStaff(board string,
 ) (staff map[common.ModerationLevel][]string, err error) {
@@ -196,6 +234,100 @@ func CanPerform(account, board string, action common.ModerationLevel) (
 	return
 }
 
+// GetPostsByIP returns all posts made from an IP on a board. Only to be exposed
+// to moderators.
+func GetPostsByIP(ip, board string) ([]common.StandalonePost, error) {
+	return scanStandalonePosts(getStandalonePosts().
+		Where("ip = ? and board = ?", ip, board).
+		OrderBy("id asc"))
+}
+
+// IPPost is a post with the IP it was created from. Only to be exposed to
+// moderators.
+type IPPost struct {
+	common.StandalonePost
+	IP string `json:"ip"`
+}
+
+// GetIPHistory returns all posts made from an IP on any board within a time
+// range. Only to be exposed to moderators.
+func GetIPHistory(ip string, since, until time.Time) ([]IPPost, error) {
+	posts, err := scanStandalonePosts(getStandalonePosts().
+		Where("p.ip = ? and p.time between ? and ?",
+			ip, since.Unix(), until.Unix()).
+		OrderBy("p.id asc"))
+	return withIP(ip, posts), err
+}
+
+// GetIPBoardHistory returns all posts made from an IP on a board. Only to be
+// exposed to moderators.
+func GetIPBoardHistory(ip, board string) ([]IPPost, error) {
+	posts, err := GetPostsByIP(ip, board)
+	return withIP(ip, posts), err
+}
+
+func withIP(ip string, posts []common.StandalonePost) []IPPost {
+	res := make([]IPPost, len(posts))
+	for i, p := range posts {
+		res[i] = IPPost{p, ip}
+	}
+	return res
+}
+
+// GetThreadIPs returns all distinct IPs, that have posted in a thread. Only to
+// be exposed to moderators.
+func GetThreadIPs(id uint64) (ips []string, err error) {
+	ips = make([]string, 0, 16)
+	err = queryAll(
+		sq.Select("distinct ip").
+			From("posts").
+			Where("op = ? and ip is not null", id).
+			OrderBy("ip"),
+		func(r *sql.Rows) (err error) {
+			var ip string
+			err = r.Scan(&ip)
+			if err != nil {
+				return
+			}
+			ips = append(ips, ip)
+			return
+		},
+	)
+	return
+}
+
+// AddModNote attaches a private moderator comment to a post
+func AddModNote(id uint64, by, text string) (err error) {
+	_, err = sq.Insert("mod_notes").
+		Columns("post_id", "by", "text").
+		Values(id, by, text).
+		Exec()
+	return
+}
+
+// GetThreadModNotes returns all moderator comments on posts of a thread,
+// sorted by creation time. Only to be exposed to moderators.
+func GetThreadModNotes(id uint64) (notes []auth.ModNote, err error) {
+	notes = make([]auth.ModNote, 0, 8)
+	err = queryAll(
+		sq.Select("n.post_id", "n.created", "n.by", "n.text").
+			From("mod_notes as n").
+			Join("posts as p on p.id = n.post_id").
+			Where("p.op = ?", id).
+			OrderBy("n.id"),
+		func(r *sql.Rows) (err error) {
+			var n auth.ModNote
+			err = r.Scan(&n.PostID, &n.Created, &n.By, &n.Text)
+			if err != nil {
+				return
+			}
+			notes = append(notes, n)
+			return
+		},
+	)
+	return
+}
+
 // GetSameIPPosts returns posts with the same IP and on the same board as the
 // target post
 func GetSameIPPosts(id uint64, board string, by string) (
@@ -230,10 +362,10 @@ func GetSameIPPosts(id uint64, board string, by string) (
 		for _, id := range ids {
 			post, err = GetPost(id)
 
-			switch err {
-			case nil:
+			switch {
+			case err == nil:
 				posts = append(posts, post)
-			case sql.ErrNoRows: // Deleted in race
+			case common.IsNotFound(err): // Deleted in race
 				err = nil
 			default:
 				return
@@ -267,6 +399,54 @@ func DeletePostsByIP(id uint64, account string, keepDeleting time.Duration,
 	return
 }
 
+// DeleteAllByIP marks all not yet deleted posts made from an IP on a board as
+// deleted and returns the number of deleted posts. Moderation rights must be
+// asserted by the caller.
+func DeleteAllByIP(ip, board, by, reason string) (n int, err error) {
+	err = InTransaction(false, func(tx *sql.Tx) (err error) {
+		ids := make([]uint64, 0, 64)
+		err = queryAll(
+			sq.Select("id").
+				From("posts").
+				Where("ip = ? and board = ? and not is_deleted(id)", ip, board).
+				OrderBy("id").
+				RunWith(tx),
+			func(r *sql.Rows) (err error) {
+				var id uint64
+				err = r.Scan(&id)
+				if err != nil {
+					return
+				}
+				ids = append(ids, id)
+				return
+			},
+		)
+		if err != nil {
+			return
+		}
+
+		// The mod_log trigger records the deletion on each post and
+		// propagates it to the thread feeds
+		for _, id := range ids {
+			err = logModeration(tx, auth.ModLogEntry{
+				ModerationEntry: common.ModerationEntry{
+					Type: common.DeletePost,
+					By:   by,
+					Data: reason,
+				},
+				ID:    id,
+				Board: board,
+			})
+			if err != nil {
+				return
+			}
+		}
+		n = len(ids)
+		return
+	})
+	return
+}
+
 func castPermissionError(err *error) {
 	if extractException(*err) == "access denied" {
 		*err = common.ErrNoPermissions
@@ -290,6 +470,27 @@ func SetThreadSticky(id uint64, sticky bool) error {
 	return err
 }
 
+// SetThreadCyclic sets, if a thread only retains its latest max posts. The
+// oldest replies of a cyclic thread are deleted, when new replies are created.
+func SetThreadCyclic(id uint64, cyclic bool, max uint16) error {
+	_, err := sq.Update("threads").
+		Set("cyclic", cyclic).
+		Set("cyclicMax", max).
+		Where("id = ?", id).
+		Exec()
+	return err
+}
+
+// SetThreadMaxReplies overrides the board's reply limit for a thread. A max of
+// 0 restores the board default.
+func SetThreadMaxReplies(id uint64, max uint16) error {
+	_, err := sq.Update("threads").
+		Set("maxReplies", max).
+		Where("id = ?", id).
+		Exec()
+	return err
+}
+
 // SetThreadLock sets the ability of users to post in a specific thread
 func SetThreadLock(id uint64, locked bool, by string) error {
 	q := sq.Update("threads").
@@ -304,15 +505,25 @@ func SetThreadLock(id uint64, locked bool, by string) error {
 		&q)
 }
 
-// GetModLog retrieves the moderation log for a specific board
-func GetModLog(board string) (log []auth.ModLogEntry, err error) {
-	log = make([]auth.ModLogEntry, 0, 64)
+// GetModLog retrieves a page of the moderation log for a specific board.
+// Entries are sorted from newest to oldest, ModLogPageSize entries per page.
+// Pages past MaxModLogPage are always empty.
+func GetModLog(board string, page int) (log []auth.ModLogEntry, err error) {
+	switch {
+	case page < 0:
+		page = 0
+	case page > MaxModLogPage:
+		return []auth.ModLogEntry{}, nil
+	}
+	log = make([]auth.ModLogEntry, 0, ModLogPageSize)
 	e := auth.ModLogEntry{Board: board}
 	err = queryAll(
 		sq.Select("type", "post_id", "by", "created", "length", "data").
 			From("mod_log").
 			Where("board = ?", board).
-			OrderBy("created desc"),
+			OrderBy("created desc", "id desc").
+			Limit(ModLogPageSize).
+			Offset(uint64(page*ModLogPageSize)),
 		func(r *sql.Rows) (err error) {
 			err = r.Scan(&e.Type, &e.ID, &e.By, &e.Created, &e.Length,
 				&e.Data)
@@ -326,7 +537,7 @@ func GetModLog(board string) (log []auth.ModLogEntry, err error) {
 	return
 }
 
-// GetModLog retrieves the moderation log entry by ID
+// GetModLogEntry retrieves the moderation log entry by ID
 func GetModLogEntry(id uint64) (e auth.ModLogEntry, err error) {
 	err = sq.
 		Select("type", "board", "post_id", "by", "created", "length",
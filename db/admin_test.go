@@ -358,6 +358,38 @@ func TestStaff(t *testing.T) {
 	test.AssertDeepEquals(t, res, staff)
 }
 
+func TestTransferBoard(t *testing.T) {
+	prepareForModeration(t)
+	writeSampleUser(t)
+	err := InTransaction(false, func(tx *sql.Tx) (err error) {
+		err = RegisterAccount(tx, "456", samplePasswordHash)
+		if err != nil {
+			return
+		}
+		return WriteStaff(tx, "a", map[common.ModerationLevel][]string{
+			common.BoardOwner: {"admin"},
+			common.Moderator:  {sampleUserID, "456"},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = TransferBoard("a", sampleUserID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := GetStaff("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertDeepEquals(t, res, map[common.ModerationLevel][]string{
+		common.BoardOwner: {sampleUserID},
+		common.Moderator:  {"456"},
+	})
+}
+
 func TestGetSameIPPosts(t *testing.T) {
 	prepareForModeration(t)
 
@@ -370,13 +402,152 @@ func TestGetSameIPPosts(t *testing.T) {
 	}
 }
 
+func TestGetPostsByIP(t *testing.T) {
+	prepareForModeration(t)
+
+	cases := [...]struct {
+		name, ip, board string
+		count           int
+	}{
+		{"matching IP", "::1", "a", 1},
+		{"other IP", "::2", "a", 0},
+		{"other board", "::1", "c", 0},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			res, err := GetPostsByIP(c.ip, c.board)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n := len(res); n != c.count {
+				t.Fatalf("wrong post count: %d", n)
+			}
+		})
+	}
+}
+
+func TestDeleteAllByIP(t *testing.T) {
+	prepareForModeration(t)
+
+	cases := [...]struct {
+		name, ip, board string
+		count           int
+	}{
+		{"other IP", "::2", "a", 0},
+		{"other board", "::1", "c", 0},
+		{"matching IP", "::1", "a", 1},
+		{"already deleted", "::1", "a", 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			n, err := DeleteAllByIP(c.ip, c.board, "admin", "spam")
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertDeepEquals(t, n, c.count)
+		})
+	}
+
+	var reason string
+	err := db.QueryRow(
+		`select data from post_moderation where post_id = 1 and type = $1`,
+		common.DeletePost).
+		Scan(&reason)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertDeepEquals(t, reason, "spam")
+}
+
+func TestGetIPHistory(t *testing.T) {
+	prepareForModeration(t)
+
+	now := time.Now()
+	cases := [...]struct {
+		name, ip     string
+		since, until time.Time
+		count        int
+	}{
+		{"matching IP", "::1", now.Add(-time.Hour), now.Add(time.Hour), 1},
+		{"other IP", "::2", now.Add(-time.Hour), now.Add(time.Hour), 0},
+		{"before range", "::1", now.Add(time.Hour), now.Add(2 * time.Hour), 0},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			res, err := GetIPHistory(c.ip, c.since, c.until)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n := len(res); n != c.count {
+				t.Fatalf("wrong post count: %d", n)
+			}
+			for _, p := range res {
+				test.AssertDeepEquals(t, p.IP, c.ip)
+			}
+		})
+	}
+}
+
+func TestGetThreadIPs(t *testing.T) {
+	prepareForModeration(t)
+
+	ips, err := GetThreadIPs(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertDeepEquals(t, ips, []string{"::1"})
+}
+
+func TestModNotes(t *testing.T) {
+	prepareForModeration(t)
+
+	for _, text := range [...]string{"foo", "bar"} {
+		err := AddModNote(1, "admin", text)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	notes, err := GetThreadModNotes(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertDeepEquals(t, len(notes), 2)
+	for i, text := range [...]string{"foo", "bar"} {
+		n := notes[i]
+		test.AssertDeepEquals(t, n.PostID, uint64(1))
+		test.AssertDeepEquals(t, n.By, "admin")
+		test.AssertDeepEquals(t, n.Text, text)
+	}
+}
+
 func TestGetModLog(t *testing.T) {
 	t.Run("ban_unban", TestBanUnban) // So we have something in the log
 
-	_, err := GetModLog("a")
+	log, err := GetModLog("a", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(log) == 0 {
+		t.Fatal("no log entries")
+	}
+
+	log, err = GetModLog("a", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertDeepEquals(t, len(log), 0)
+
+	log, err = GetModLog("a", MaxModLogPage+1)
 	if err != nil {
 		t.Fatal(err)
 	}
+	test.AssertDeepEquals(t, len(log), 0)
 }
 
 func TestGetModLogEntry(t *testing.T) {
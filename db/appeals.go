@@ -0,0 +1,90 @@
+package db
+
+import (
+	"database/sql"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+)
+
+var errNoActiveBan = common.ErrInvalidInput("no active ban")
+
+// SubmitAppeal requests lifting a ban on a board, that was issued for a post.
+// The ban must still be in effect for the appealing IP. Repeated appeals of
+// the same ban by the same IP are ignored.
+func SubmitAppeal(board string, forPost uint64, ip, text string) (err error) {
+	var active bool
+	err = sq.Select("true").
+		From("bans").
+		Where(
+			`board = ?
+				and forPost = ?
+				and ip >>= ?
+				and type = 'classic'
+				and expires > now() at time zone 'utc'`,
+			board, forPost, ip).
+		Limit(1).
+		QueryRow().
+		Scan(&active)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		return errNoActiveBan
+	default:
+		return
+	}
+
+	_, err = sq.Insert("ban_appeals").
+		Columns("board", "forPost", "ip", "text").
+		Values(board, forPost, ip, text).
+		Suffix("on conflict (board, forPost, ip) do nothing").
+		Exec()
+	return
+}
+
+// GetPendingAppeals retrieves all unresolved ban appeals for a board, oldest
+// first. Only to be exposed to moderators.
+func GetPendingAppeals(board string) (appeals []auth.BanAppeal, err error) {
+	appeals = make([]auth.BanAppeal, 0, 16)
+	a := auth.BanAppeal{
+		Board: board,
+	}
+	err = queryAll(
+		sq.Select("id", "forPost", "created", "ip", "text").
+			From("ban_appeals").
+			Where("board = ? and not resolved", board).
+			OrderBy("id"),
+		func(r *sql.Rows) (err error) {
+			err = r.Scan(&a.ID, &a.ForPost, &a.Created, &a.IP, &a.Text)
+			if err != nil {
+				return
+			}
+			appeals = append(appeals, a)
+			return
+		},
+	)
+	return
+}
+
+// ResolveAppeal closes a ban appeal on a board with a resolution message. If
+// lift is true, the appealed ban is lifted.
+func ResolveAppeal(id uint64, board string, lift bool, resolution, by string,
+) (err error) {
+	var forPost uint64
+	err = sq.Update("ban_appeals").
+		SetMap(map[string]interface{}{
+			"resolved":   true,
+			"resolution": resolution,
+		}).
+		Where("id = ? and board = ? and not resolved", id, board).
+		Suffix("returning forPost").
+		QueryRow().
+		Scan(&forPost)
+	if err != nil {
+		return
+	}
+	if lift {
+		err = Unban(board, forPost, by)
+	}
+	return
+}
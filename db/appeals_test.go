@@ -0,0 +1,65 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	. "github.com/bakape/meguca/test"
+)
+
+func TestBanAppeals(t *testing.T) {
+	prepareForModeration(t)
+	assertTableClear(t, "ban_appeals")
+
+	err := SubmitAppeal("a", 1, "::1", "pls")
+	if err != errNoActiveBan {
+		UnexpectedError(t, err)
+	}
+
+	err = Ban("a", "test", "admin", time.Minute, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Second appeal is ignored
+	for i := 0; i < 2; i++ {
+		err = SubmitAppeal("a", 1, "::1", "pls")
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = SubmitAppeal("a", 1, "::2", "not me")
+	if err != errNoActiveBan {
+		UnexpectedError(t, err)
+	}
+
+	appeals, err := GetPendingAppeals("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, len(appeals), 1)
+	a := appeals[0]
+	AssertDeepEquals(t, a.ForPost, uint64(1))
+	AssertDeepEquals(t, a.IP, "::1")
+	AssertDeepEquals(t, a.Text, "pls")
+
+	err = ResolveAppeal(a.ID, "a", true, "ok", "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	appeals, err = GetPendingAppeals("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, len(appeals), 0)
+
+	_, err = GetBanInfo("::1", "a")
+	if err != sql.ErrNoRows {
+		UnexpectedError(t, err)
+	}
+
+	err = ResolveAppeal(a.ID, "a", true, "ok", "admin")
+	if err != sql.ErrNoRows {
+		UnexpectedError(t, err)
+	}
+}
@@ -4,8 +4,13 @@ import (
 	"database/sql"
 
 	"github.com/bakape/meguca/assets"
+	"github.com/bakape/meguca/common"
 )
 
+// ErrTooManyBanners is returned, when adding a banner to a board, that already
+// has common.MaxNumBanners banners
+var ErrTooManyBanners = common.ErrInvalidInput("too many banners")
+
 // Load all assets from and pass them to load. Start listening for changes.
 func loadAssets(table string,
 	load func(board string, files []assets.File),
@@ -43,36 +48,41 @@ func updateAssets(table string,
 	load func(board string, files []assets.File),
 ) func(string) error {
 	return func(board string) (err error) {
-		files := make([]assets.File, 0, 16)
-		err = queryAll(
-			sq.Select("data", "mime").
-				From(table).
-				Where("board  = ?", board),
-			func(r *sql.Rows) (err error) {
-				var (
-					data []byte
-					mime string
-				)
-				err = r.Scan(&data, &mime)
-				if err != nil {
-					return
-				}
-				files = append(files, assets.File{
-					Data: data,
-					Mime: mime,
-				})
-				return
-			},
-		)
+		files, err := getAssets(table, board)
 		if err != nil {
 			return
 		}
-
 		load(board, files)
 		return
 	}
 }
 
+// Read all assets of a board from table
+func getAssets(table, board string) (files []assets.File, err error) {
+	files = make([]assets.File, 0, 16)
+	err = queryAll(
+		sq.Select("data", "mime").
+			From(table).
+			Where("board  = ?", board),
+		func(r *sql.Rows) (err error) {
+			var (
+				data []byte
+				mime string
+			)
+			err = r.Scan(&data, &mime)
+			if err != nil {
+				return
+			}
+			files = append(files, assets.File{
+				Data: data,
+				Mime: mime,
+			})
+			return
+		},
+	)
+	return
+}
+
 func loadBanners() error {
 	return loadAssets("banners", assets.Banners.Set)
 }
@@ -132,6 +142,50 @@ func SetBanners(board string, banners []assets.File) error {
 	return setAssets("banners", board, banners)
 }
 
+// AddBanner appends a banner to a board's banners and returns the resulting
+// number of banners. Fails with ErrTooManyBanners, if the board already has
+// common.MaxNumBanners banners.
+func AddBanner(board string, banner assets.File) (n int, err error) {
+	err = InTransaction(false, func(tx *sql.Tx) (err error) {
+		// Lock the board row, so concurrent additions can not both pass the
+		// limit check
+		_, err = tx.Exec(`select 1 from boards where id = $1 for update`, board)
+		if err != nil {
+			return
+		}
+		err = sq.Select("count(*)").
+			From("banners").
+			Where("board = ?", board).
+			RunWith(tx).
+			QueryRow().
+			Scan(&n)
+		if err != nil {
+			return
+		}
+		if n >= common.MaxNumBanners {
+			return ErrTooManyBanners
+		}
+
+		_, err = sq.Insert("banners").
+			Columns("board", "data", "mime").
+			Values(board, banner.Data, banner.Mime).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+		n++
+		_, err = tx.Exec("select pg_notify('banners_updated', $1)", board)
+		return
+	})
+	return
+}
+
+// GetBanners retrieves all banners of a board from the DB
+func GetBanners(board string) ([]assets.File, error) {
+	return getAssets("banners", board)
+}
+
 // SetLoadingAnimation sets the loading animation for a specific board.
 // Nil file.Data means the default animation should be used.
 func SetLoadingAnimation(board string, file assets.File) error {
@@ -2,6 +2,7 @@ package db
 
 import (
 	"github.com/bakape/meguca/assets"
+	"github.com/bakape/meguca/common"
 	. "github.com/bakape/meguca/test"
 	"testing"
 )
@@ -51,6 +52,31 @@ func TestBanners(t *testing.T) {
 	}
 }
 
+func TestAddBanner(t *testing.T) {
+	assertTableClear(t, "boards")
+	writeSampleBoard(t)
+
+	f := assets.File{
+		Data: []byte{1, 2, 3},
+		Mime: "data/meme",
+	}
+	for i := 1; i <= common.MaxNumBanners; i++ {
+		n, err := AddBanner("a", f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertDeepEquals(t, n, i)
+	}
+	_, err := AddBanner("a", f)
+	AssertDeepEquals(t, err, ErrTooManyBanners)
+
+	banners, err := GetBanners("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, len(banners), common.MaxNumBanners)
+}
+
 func TestLoaadingAnimations(t *testing.T) {
 	assertTableClear(t, "boards")
 	writeSampleBoard(t)
@@ -33,6 +33,24 @@ func IsLoggedIn(user, session string) (loggedIn bool, err error) {
 	return
 }
 
+// GetSessionAccount returns the account of an unexpired login session token
+func GetSessionAccount(token string) (account string, err error) {
+	if len(token) != common.LenSession {
+		err = common.ErrInvalidCreds
+		return
+	}
+
+	err = sq.Select("account").
+		From("sessions").
+		Where("token = ? and expires > now()", token).
+		QueryRow().
+		Scan(&account)
+	if err == sql.ErrNoRows {
+		err = common.ErrInvalidCreds
+	}
+	return
+}
+
 // RegisterAccount writes the ID and password hash of a new user account to the
 // database
 func RegisterAccount(tx *sql.Tx, id string, hash []byte) error {
@@ -91,6 +91,32 @@ func TestLoginLogout(t *testing.T) {
 	assertLoggedIn(t, sampleUserID, sampleUserSession, false)
 }
 
+func TestGetSessionAccount(t *testing.T) {
+	assertTableClear(t, "accounts")
+	writeSampleUser(t)
+	writeSampleSession(t)
+
+	acc, err := GetSessionAccount(sampleUserSession)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, acc, sampleUserID)
+
+	cases := [...]struct {
+		name, token string
+	}{
+		{"wrong length", "foo"},
+		{"no session", GenString(common.LenSession)},
+	}
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			_, err := GetSessionAccount(c.token)
+			AssertDeepEquals(t, err, common.ErrInvalidCreds)
+		})
+	}
+}
+
 func assertLoggedIn(t *testing.T, user, session string, std bool) {
 	t.Helper()
 
@@ -182,4 +208,17 @@ func TestGetBanRecords(t *testing.T) {
 	bans[0].Expires = std.Expires
 	std.Type = "classic"
 	AssertDeepEquals(t, bans, []auth.BanRecord{std})
+
+	t.Run("global ban", func(t *testing.T) {
+		err := SystemBan("::2", "bot", time.Hour)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ban, err := GetBanInfo("::2", "a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertDeepEquals(t, ban.Board, "all")
+		AssertDeepEquals(t, ban.Reason, "bot")
+	})
 }
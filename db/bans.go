@@ -2,6 +2,9 @@ package db
 
 import (
 	"database/sql"
+	"fmt"
+	"net"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,7 +17,11 @@ import (
 var (
 	// board: IP: IsBanned
 	banCache = map[string]map[string]bool{}
-	bansMu   sync.RWMutex
+	// board: banned IP ranges
+	rangeBanCache = map[string][]*net.IPNet{}
+	bansMu        sync.RWMutex
+
+	errInvalidBanRange = common.ErrInvalidInput("ban range")
 )
 
 func writeBan(tx *sql.Tx, ip string, entry auth.ModLogEntry) (err error) {
@@ -73,6 +80,15 @@ func systemBanTx(tx *sql.Tx, ip, reason string, length time.Duration,
 // Ban IPs from accessing a specific board. Need to target posts. Returns all
 // banned IPs.
 func Ban(board, reason, by string, length time.Duration, id uint64,
+) (err error) {
+	return BanRange(board, reason, by, length, id, 0)
+}
+
+// BanRange bans the IP range of the target post's IP with the specified
+// CIDR prefix length from accessing a specific board. A prefix of 0 bans only
+// the IP itself.
+func BanRange(board, reason, by string, length time.Duration, id uint64,
+	prefix uint8,
 ) (err error) {
 	ip, err := GetIP(id)
 	switch err {
@@ -82,10 +98,17 @@ func Ban(board, reason, by string, length time.Duration, id uint64,
 	default:
 		return
 	}
+	target := ip
+	if prefix != 0 {
+		target, err = ipRange(ip, prefix)
+		if err != nil {
+			return
+		}
+	}
 
 	// Write ban messages to posts and ban table
 	err = InTransaction(false, func(tx *sql.Tx) (err error) {
-		return writeBan(tx, ip, auth.ModLogEntry{
+		return writeBan(tx, target, auth.ModLogEntry{
 			ModerationEntry: common.ModerationEntry{
 				Type:   common.BanPost,
 				Length: uint64(length / time.Second),
@@ -100,7 +123,44 @@ func Ban(board, reason, by string, length time.Duration, id uint64,
 		return
 	}
 
-	return propagateBans(board, ip)
+	if prefix == 0 {
+		return propagateBans(board, ip)
+	}
+	return propagateRangeBans(board, target)
+}
+
+// Same as propagateBans, but disconnects all IPs in a CIDR range
+func propagateRangeBans(board, cidr string) (err error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return
+	}
+	_, err = db.Exec(`notify bans_updated`)
+	if err != nil {
+		return
+	}
+	if !common.IsTest {
+		auth.DisconnectByBoardAndRange(ipNet, board)
+	}
+	return
+}
+
+// Returns the range of IPs, that share the first prefix bits with ip, in CIDR
+// notation. Ranges wider than a /16 for IPv4 and a /32 for IPv6 are rejected.
+func ipRange(ip string, prefix uint8) (string, error) {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return "", errInvalidBanRange
+	}
+	bits, min := 128, uint8(32)
+	if v4 := parsed.To4(); v4 != nil {
+		parsed, bits, min = v4, 32, 16
+	}
+	if prefix < min || int(prefix) > bits {
+		return "", errInvalidBanRange
+	}
+	mask := net.CIDRMask(int(prefix), bits)
+	return fmt.Sprintf("%s/%d", parsed.Mask(mask), prefix), nil
 }
 
 // Unban lifts a ban from a specific post on a specific board
@@ -164,7 +224,17 @@ func RefreshBanCache() (err error) {
 	}
 
 	new := map[string]map[string]bool{}
+	ranges := map[string][]*net.IPNet{}
 	for _, b := range bans {
+		if strings.IndexByte(b.IP, '/') != -1 {
+			_, r, err := net.ParseCIDR(b.IP)
+			if err != nil {
+				return err
+			}
+			ranges[b.Board] = append(ranges[b.Board], r)
+			continue
+		}
+
 		board, ok := new[b.Board]
 		if !ok {
 			board = map[string]bool{}
@@ -175,6 +245,7 @@ func RefreshBanCache() (err error) {
 
 	bansMu.Lock()
 	banCache = new
+	rangeBanCache = ranges
 	bansMu.Unlock()
 
 	return
@@ -187,10 +258,11 @@ func IsBanned(board, ip string) error {
 	global := banCache["all"]
 	ips := banCache[board]
 
-	if (global != nil && global[ip]) || (ips != nil && ips[ip]) {
+	if (global != nil && global[ip]) || (ips != nil && ips[ip]) ||
+		inBannedRange(board, ip) {
 		// Need to assert ban has not expired and cache is invalid
 
-		r, err := selectBans("board").Where("ip = ?", ip).Query()
+		r, err := selectBans("board").Where("ip >>= ?", ip).Query()
 		if err != nil {
 			return err
 		}
@@ -235,16 +307,41 @@ func IsBanned(board, ip string) error {
 	return nil
 }
 
-// GetBanInfo retrieves information about a specific ban
+// Returns, if ip is contained in any range banned on board or globally.
+// Requires bansMu to be held for reading.
+func inBannedRange(board, ip string) bool {
+	global := rangeBanCache["all"]
+	ranges := rangeBanCache[board]
+	if len(global) == 0 && len(ranges) == 0 {
+		return false
+	}
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, set := range [...][]*net.IPNet{global, ranges} {
+		for _, r := range set {
+			if r.Contains(parsed) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GetBanInfo retrieves information about the longest running ban of an IP on
+// a board. Global bans on the "all" board apply to all boards.
 func GetBanInfo(ip, board string) (b auth.BanRecord, err error) {
 	err = sq.Select("ip", "board", "forPost", "reason", "by", "expires").
 		From("bans").
 		Where(
 			`expires >= now() at time zone 'utc'
-					and ip = ?
-					and board = ?
+					and ip >>= ?
+					and board in (?, 'all')
 					and type = 'classic'`,
 			ip, board).
+		OrderBy("expires desc").
+		Limit(1).
 		QueryRow().
 		Scan(&b.IP, &b.Board, &b.ForPost, &b.Reason, &b.By, &b.Expires)
 	return
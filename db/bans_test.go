@@ -31,3 +31,75 @@ func TestBanUnban(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestRangeBan(t *testing.T) {
+	prepareForModeration(t)
+
+	err := BanRange("a", "test", "admin", time.Minute, 1, 8)
+	if err != errInvalidBanRange {
+		UnexpectedError(t, err)
+	}
+	err = BanRange("a", "test", "admin", time.Minute, 1, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = RefreshBanCache()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := [...]struct {
+		name, board, ip string
+		err             error
+	}{
+		{"same IP", "a", "::1", common.ErrBanned},
+		{"in range", "a", "::2", common.ErrBanned},
+		{"outside range", "a", "1::1", nil},
+		{"other board", "c", "::2", nil},
+	}
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			err := IsBanned(c.board, c.ip)
+			if err != c.err {
+				UnexpectedError(t, err)
+			}
+		})
+	}
+
+	ban, err := GetBanInfo("::2", "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, ban.IP, "::/64")
+}
+
+func TestIPRange(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		ip     string
+		prefix uint8
+		out    string
+		err    error
+	}{
+		{"1.2.3.4", 24, "1.2.3.0/24", nil},
+		{"1.2.3.4", 8, "", errInvalidBanRange},
+		{"1.2.3.4", 33, "", errInvalidBanRange},
+		{"2001:db8::1", 48, "2001:db8::/48", nil},
+		{"2001:db8::1", 16, "", errInvalidBanRange},
+		{"foo", 24, "", errInvalidBanRange},
+	}
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.ip, func(t *testing.T) {
+			t.Parallel()
+
+			out, err := ipRange(c.ip, c.prefix)
+			if err != c.err {
+				UnexpectedError(t, err)
+			}
+			AssertDeepEquals(t, out, c.out)
+		})
+	}
+}
@@ -56,8 +56,11 @@ func decodeConfigs(data string) (c config.Configs, err error) {
 func getBoardConfigs() squirrel.SelectBuilder {
 	return sq.Select(
 		"readOnly", "textOnly", "forcedAnon", "disableRobots", "flags", "NSFW",
-		"rbText", "pyu", "id", "defaultCSS", "title", "notice",
-		"rules", "eightball",
+		"rbText", "pyu", "posterIDs", "imageLimit", "threadsPerPage",
+		"floodPosts", "floodInterval", "postCooldown", "defaultLastN",
+		"maxReplies", "noDuplicateImages", "maxVideoLength", "disableAudio",
+		"disablePDF", "maxPDFSize", "id", "defaultCSS", "title", "notice",
+		"rules", "eightball", "fileTypes", "customCSS", "tags", "threadTags",
 	).
 		From("boards")
 }
@@ -79,13 +82,20 @@ func loadBoardConfigs() (err error) {
 }
 
 func scanBoardConfigs(r rowScanner) (c config.BoardConfigs, err error) {
-	var eightball pq.StringArray
+	var eightball, fileTypes, tags pq.StringArray
 	err = r.Scan(
 		&c.ReadOnly, &c.TextOnly, &c.ForcedAnon, &c.DisableRobots, &c.Flags,
-		&c.NSFW, &c.RbText, &c.Pyu,
-		&c.ID, &c.DefaultCSS, &c.Title, &c.Notice, &c.Rules, &eightball,
+		&c.NSFW, &c.RbText, &c.Pyu, &c.PosterIDs, &c.ImageLimit,
+		&c.ThreadsPerPage,
+		&c.FloodPosts, &c.FloodInterval, &c.PostCooldown, &c.DefaultLastN,
+		&c.MaxReplies, &c.NoDuplicateImages, &c.MaxVideoLength,
+		&c.DisableAudio, &c.DisablePDF, &c.MaxPDFSize, &c.ID, &c.DefaultCSS, &c.Title,
+		&c.Notice, &c.Rules, &eightball, &fileTypes, &c.CustomCSS,
+		&tags, &c.ThreadTags,
 	)
 	c.Eightball = []string(eightball)
+	c.FileTypes = []string(fileTypes)
+	c.Tags = []string(tags)
 	return
 }
 
@@ -95,14 +105,23 @@ func WriteBoard(tx *sql.Tx, c BoardConfigs) error {
 		Columns(
 			"id", "readOnly", "textOnly", "forcedAnon", "disableRobots",
 			"flags", "NSFW",
-			"rbText", "pyu", "created", "defaultCSS", "title",
-			"notice", "rules", "eightball",
+			"rbText", "pyu", "posterIDs", "imageLimit", "threadsPerPage",
+			"floodPosts", "floodInterval", "postCooldown", "defaultLastN",
+			"maxReplies", "noDuplicateImages", "maxVideoLength", "disableAudio",
+			"disablePDF", "maxPDFSize", "created",
+			"defaultCSS", "title", "notice", "rules", "eightball", "fileTypes",
+			"customCSS", "tags", "threadTags",
 		).
 		Values(
 			c.ID, c.ReadOnly, c.TextOnly, c.ForcedAnon, c.DisableRobots,
-			c.Flags, c.NSFW, c.RbText, c.Pyu,
-			c.Created, c.DefaultCSS, c.Title, c.Notice, c.Rules,
-			pq.StringArray(c.Eightball),
+			c.Flags, c.NSFW, c.RbText, c.Pyu, c.PosterIDs, c.ImageLimit,
+			c.ThreadsPerPage,
+			c.FloodPosts, c.FloodInterval, c.PostCooldown, c.DefaultLastN,
+			c.MaxReplies, c.NoDuplicateImages, c.MaxVideoLength,
+			c.DisableAudio, c.DisablePDF, c.MaxPDFSize, c.Created, c.DefaultCSS,
+			c.Title, c.Notice, c.Rules,
+			pq.StringArray(c.Eightball), encodeStringArray(c.FileTypes),
+			c.CustomCSS, encodeStringArray(c.Tags), c.ThreadTags,
 		).
 		RunWith(tx).
 		Exec()
@@ -113,19 +132,36 @@ func WriteBoard(tx *sql.Tx, c BoardConfigs) error {
 func UpdateBoard(c config.BoardConfigs) (err error) {
 	_, err = sq.Update("boards").
 		SetMap(map[string]interface{}{
-			"readOnly":      c.ReadOnly,
-			"textOnly":      c.TextOnly,
-			"forcedAnon":    c.ForcedAnon,
-			"disableRobots": c.DisableRobots,
-			"flags":         c.Flags,
-			"NSFW":          c.NSFW,
-			"rbText":        c.RbText,
-			"pyu":           c.Pyu,
-			"defaultCSS":    c.DefaultCSS,
-			"title":         c.Title,
-			"notice":        c.Notice,
-			"rules":         c.Rules,
-			"eightball":     pq.StringArray(c.Eightball),
+			"readOnly":          c.ReadOnly,
+			"textOnly":          c.TextOnly,
+			"forcedAnon":        c.ForcedAnon,
+			"disableRobots":     c.DisableRobots,
+			"flags":             c.Flags,
+			"NSFW":              c.NSFW,
+			"rbText":            c.RbText,
+			"pyu":               c.Pyu,
+			"posterIDs":         c.PosterIDs,
+			"imageLimit":        c.ImageLimit,
+			"threadsPerPage":    c.ThreadsPerPage,
+			"floodPosts":        c.FloodPosts,
+			"floodInterval":     c.FloodInterval,
+			"postCooldown":      c.PostCooldown,
+			"defaultLastN":      c.DefaultLastN,
+			"maxReplies":        c.MaxReplies,
+			"noDuplicateImages": c.NoDuplicateImages,
+			"maxVideoLength":    c.MaxVideoLength,
+			"disableAudio":      c.DisableAudio,
+			"disablePDF":        c.DisablePDF,
+			"maxPDFSize":        c.MaxPDFSize,
+			"defaultCSS":        c.DefaultCSS,
+			"title":             c.Title,
+			"notice":            c.Notice,
+			"rules":             c.Rules,
+			"eightball":         pq.StringArray(c.Eightball),
+			"fileTypes":         encodeStringArray(c.FileTypes),
+			"customCSS":         c.CustomCSS,
+			"tags":              encodeStringArray(c.Tags),
+			"threadTags":        c.ThreadTags,
 		}).
 		Where("id = ?", c.ID).
 		Exec()
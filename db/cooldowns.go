@@ -0,0 +1,38 @@
+package db
+
+import (
+	"database/sql"
+)
+
+// PostCooldown returns the remaining seconds of the post cooldown of an IP on
+// a board, given the board's cooldown in seconds
+func PostCooldown(board, ip string, cooldown uint) (remaining uint, err error) {
+	var elapsed float64
+	err = sq.Select("extract(epoch from now() - last_post)").
+		From("cooldowns").
+		Where("board = ? and ip = ?", board, ip).
+		QueryRow().
+		Scan(&elapsed)
+	switch err {
+	case nil:
+		if e := uint(elapsed); elapsed >= 0 && e < cooldown {
+			remaining = cooldown - e
+		}
+	case sql.ErrNoRows:
+		err = nil
+	}
+	return
+}
+
+// WriteCooldown records a post by an IP on a board for post cooldown
+// enforcement
+func WriteCooldown(tx *sql.Tx, board, ip string) (err error) {
+	_, err = sq.Insert("cooldowns").
+		Columns("board", "ip").
+		Values(board, ip).
+		Suffix(`on conflict (board, ip) do update
+			set last_post = now()`).
+		RunWith(tx).
+		Exec()
+	return
+}
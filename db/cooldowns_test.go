@@ -0,0 +1,38 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+
+	. "github.com/bakape/meguca/test"
+)
+
+func TestPostCooldown(t *testing.T) {
+	assertTableClear(t, "boards")
+	writeSampleBoard(t)
+
+	const ip = "::1"
+	assert := func(cooldown, std uint) {
+		t.Helper()
+		remaining, err := PostCooldown("a", ip, cooldown)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertDeepEquals(t, remaining, std)
+	}
+
+	assert(60, 0)
+
+	err := InTransaction(false, func(tx *sql.Tx) error {
+		return WriteCooldown(tx, "a", ip)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert(60, 60)
+
+	assertExec(t,
+		`update cooldowns set last_post = now() - interval '61 seconds'`)
+	assert(60, 0)
+	assert(120, 59)
+}
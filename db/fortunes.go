@@ -0,0 +1,41 @@
+package db
+
+import (
+	"database/sql"
+
+	"github.com/lib/pq"
+)
+
+// GetFortunes retrieves the custom fortune list of a board. Returns an empty
+// list, if the board has none.
+func GetFortunes(board string) (lines []string, err error) {
+	var arr pq.StringArray
+	err = sq.Select("lines").
+		From("fortunes").
+		Where("board = ?", board).
+		QueryRow().
+		Scan(&arr)
+	switch err {
+	case nil:
+		lines = []string(arr)
+	case sql.ErrNoRows:
+		err = nil
+		lines = []string{}
+	}
+	return
+}
+
+// SetFortunes replaces the custom fortune list of a board. Setting an empty
+// list reverts the board to the default fortunes.
+func SetFortunes(board string, lines []string) (err error) {
+	if len(lines) == 0 {
+		_, err = sq.Delete("fortunes").Where("board = ?", board).Exec()
+		return
+	}
+	_, err = sq.Insert("fortunes").
+		Columns("board", "lines").
+		Values(board, pq.StringArray(lines)).
+		Suffix("on conflict (board) do update set lines = excluded.lines").
+		Exec()
+	return
+}
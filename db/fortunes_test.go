@@ -0,0 +1,37 @@
+package db
+
+import (
+	"testing"
+
+	. "github.com/bakape/meguca/test"
+)
+
+func TestFortunes(t *testing.T) {
+	prepareForModeration(t)
+	assertTableClear(t, "fortunes")
+
+	assert := func(std []string) {
+		t.Helper()
+		lines, err := GetFortunes("a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertDeepEquals(t, lines, std)
+	}
+
+	assert([]string{})
+	for _, lines := range [...][]string{
+		{"foo", "bar"},
+		{"baz"},
+	} {
+		if err := SetFortunes("a", lines); err != nil {
+			t.Fatal(err)
+		}
+		assert(lines)
+	}
+
+	if err := SetFortunes("a", nil); err != nil {
+		t.Fatal(err)
+	}
+	assert([]string{})
+}
@@ -0,0 +1,41 @@
+package db
+
+import (
+	"database/sql"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// Ping asserts the database is reachable and can execute queries
+func Ping() error {
+	var n int
+	return db.QueryRow("select 1").Scan(&n)
+}
+
+// MissingTables returns which of the passed tables do not exist
+func MissingTables(tables ...string) (missing []string, err error) {
+	exist := make(map[string]bool, len(tables))
+	err = queryAll(
+		sq.Select("table_name").
+			From("information_schema.tables").
+			Where("table_schema = current_schema()").
+			Where(squirrel.Eq{"table_name": tables}),
+		func(r *sql.Rows) (err error) {
+			var t string
+			err = r.Scan(&t)
+			exist[t] = true
+			return
+		},
+	)
+	if err != nil {
+		return
+	}
+
+	missing = make([]string, 0, len(tables))
+	for _, t := range tables {
+		if !exist[t] {
+			missing = append(missing, t)
+		}
+	}
+	return
+}
@@ -0,0 +1,21 @@
+package db
+
+import (
+	"testing"
+
+	. "github.com/bakape/meguca/test"
+)
+
+func TestPing(t *testing.T) {
+	if err := Ping(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMissingTables(t *testing.T) {
+	missing, err := MissingTables("posts", "threads", "nonexistent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, missing, []string{"nonexistent"})
+}
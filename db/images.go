@@ -44,12 +44,13 @@ func writeImageTx(tx *sql.Tx, i common.ImageCommon) (err error) {
 		Insert("images").
 		Columns(
 			"audio", "video", "file_type", "thumb_type", "dims", "length",
-			"size", "MD5", "SHA1", "Title", "Artist",
+			"size", "MD5", "SHA1", "Title", "Artist", "phash",
+			"sha256",
 		).
 		Values(
 			i.Audio, i.Video, int(i.FileType), int(i.ThumbType),
 			pq.GenericArray{A: i.Dims}, i.Length, i.Size, i.MD5, i.SHA1,
-			i.Title, i.Artist,
+			i.Title, i.Artist, i.PHash, i.SHA256,
 		).
 		RunWith(tx).
 		Exec()
@@ -156,6 +157,47 @@ func InsertImage(tx *sql.Tx, postID uint64, token, name string, spoiler bool,
 	return
 }
 
+// FindDuplicateImage returns the ID of the first post in a thread, that
+// contains the image allocated by an image token, if any
+func FindDuplicateImage(op uint64, token string) (id uint64, err error) {
+	err = sq.Select("p.id").
+		From("posts as p").
+		Join("image_tokens as t on t.SHA1 = p.SHA1").
+		Where("t.token = ? and p.op = ?", token, op).
+		OrderBy("p.id").
+		Limit(1).
+		QueryRow().
+		Scan(&id)
+	if err == sql.ErrNoRows {
+		err = nil
+	}
+	return
+}
+
+// TokenMedia describes the media streams of a file allocated by an image
+// token
+type TokenMedia struct {
+	Audio, Video bool
+	FileType     uint8
+	Length       uint32
+	Size         int
+}
+
+// GetTokenMedia returns the media streams and length in seconds of the file
+// allocated by an image token
+func GetTokenMedia(token string) (m TokenMedia, err error) {
+	err = sq.Select("i.audio", "i.video", "i.file_type", "i.length", "i.size").
+		From("images as i").
+		Join("image_tokens as t on t.SHA1 = i.SHA1").
+		Where("t.token = ?", token).
+		QueryRow().
+		Scan(&m.Audio, &m.Video, &m.FileType, &m.Length, &m.Size)
+	if err == sql.ErrNoRows {
+		err = nil
+	}
+	return
+}
+
 // GetImage retrieves a thumbnailed image record from the DB.
 //
 // Only used in tests.
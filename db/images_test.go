@@ -306,3 +306,53 @@ func TestImageExists(t *testing.T) {
 	}
 	test.AssertDeepEquals(t, exists, true)
 }
+
+func TestFindDuplicateImage(t *testing.T) {
+	assertTableClear(t, "images", "boards")
+	prepareThreads(t)
+	token := newImageToken(t, assets.StdJPEG.SHA1)
+
+	cases := [...]struct {
+		name string
+		op   uint64
+		id   uint64
+	}{
+		{"in thread", 1, 1},
+		{"other thread", 3, 0},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			id, err := FindDuplicateImage(c.op, token)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertDeepEquals(t, id, c.id)
+		})
+	}
+}
+
+func TestGetTokenMedia(t *testing.T) {
+	assertTableClear(t, "images")
+
+	std := assets.StdJPEG.ImageCommon
+	std.Video = true
+	std.Length = 30
+	if err := WriteImage(std); err != nil {
+		t.Fatal(err)
+	}
+	token := newImageToken(t, std.SHA1)
+
+	m, err := GetTokenMedia(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertDeepEquals(t, m, TokenMedia{
+		Audio:    std.Audio,
+		Video:    true,
+		FileType: common.JPEG,
+		Length:   30,
+		Size:     std.Size,
+	})
+}
@@ -135,7 +135,8 @@ func loadDB(dbSuffix string) (err error) {
 	tasks = append(
 		tasks,
 		func() error {
-			tasks := []func() error{loadConfigs, loadBans, handleSpamScores}
+			tasks := []func() error{loadConfigs, loadBans, handleSpamScores,
+				loadWordFilters, loadWebhooks}
 			if config.ImagerMode != config.ImagerOnly {
 				tasks = append(tasks, openBoltDB(dbSuffix), loadBanners,
 					loadLoadingAnimations, loadThreadPostCounts)
@@ -1430,6 +1430,347 @@ var migrations = []func(*sql.Tx) error{
 			Exec()
 		return
 	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(
+			`alter table boards
+				add column threadsPerPage smallint not null default 15`,
+		)
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(
+			`alter table threads
+				add column archived bool not null default false`,
+		)
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(createIndex("posts", "board", "time"))
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(
+			`alter table boards
+				add column posterIDs bool not null default false`,
+		)
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(
+			`create table post_sessions (
+				token text not null,
+				post_id bigint not null references posts on delete cascade,
+				expires timestamp not null,
+				primary key (token, post_id)
+			)`,
+		)
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(
+			`alter table boards
+				add column floodPosts smallint not null default 0,
+				add column floodInterval smallint not null default 60`,
+		)
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(
+			`alter table boards
+				add column defaultLastN smallint not null default 0`,
+		)
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(
+			`alter table threads
+				add column cyclic bool not null default false,
+				add column cyclicMax smallint not null default 0`,
+		)
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`alter table boards
+				add column maxReplies smallint not null default 0`,
+			`alter table threads
+				add column maxReplies smallint not null default 0`,
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(
+			`alter table boards
+				add column imageLimit smallint not null default 0`,
+		)
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		err = execAll(tx,
+			`alter table reports
+				add column resolved bool not null default false`,
+			`delete from reports a
+				using reports b
+				where a.id > b.id and a.target = b.target and a.by = b.by`,
+			`create unique index reports_target_by_idx
+				on reports (target, by)`,
+			`update reports
+				set resolved = true
+				where is_deleted(target)`,
+		)
+		if err != nil {
+			return
+		}
+		return registerTriggers(tx, map[string][]triggerDescriptor{
+			"mod_log": {{after, tableInsert}},
+		})
+	},
+	func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`create table mod_notes (
+				id bigserial primary key,
+				post_id bigint not null references posts on delete cascade,
+				by text not null,
+				text varchar(1000) not null,
+				created timestamp not null default (now() at time zone 'utc')
+			)`,
+			createIndex("mod_notes", "post_id"),
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`create table ban_appeals (
+				id bigserial primary key,
+				board varchar(10) not null references boards on delete cascade,
+				forPost bigint not null,
+				ip inet not null,
+				text varchar(1000) not null,
+				created timestamp not null default (now() at time zone 'utc'),
+				resolved bool not null default false,
+				resolution varchar(100) not null default '',
+				unique (board, forPost, ip)
+			)`,
+			createIndex("ban_appeals", "board", "resolved"),
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`create table word_filters (
+				id bigserial primary key,
+				board varchar(10) not null references boards on delete cascade,
+				pattern varchar(100) not null,
+				replacement varchar(100) not null,
+				caseSensitive bool not null default false
+			)`,
+			createIndex("word_filters", "board"),
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`create table post_edits (
+				id bigserial primary key,
+				post_id bigint not null references posts on delete cascade,
+				body varchar(2000) not null,
+				edited bigint not null default extract(epoch from now())
+			)`,
+			createIndex("post_edits", "post_id"),
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`create table thread_watches (
+				token text not null,
+				thread_id bigint not null references threads on delete cascade,
+				last_seen bigint not null default 0,
+				expires timestamp not null,
+				primary key (token, thread_id)
+			)`,
+			createIndex("thread_watches", "expires"),
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(
+			`alter table boards
+				add column noDuplicateImages bool not null default false`,
+		)
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(
+			`alter table images
+				add column phash varchar(16) not null default ''`,
+		)
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(
+			`alter table boards
+				add column maxVideoLength bigint not null default 0`,
+		)
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(
+			`alter table boards
+				add column disableAudio bool not null default false`,
+		)
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`alter table boards
+				add column disablePDF bool not null default false`,
+			`alter table boards
+				add column maxPDFSize bigint not null default 0`,
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(
+			`alter table boards
+				add column fileTypes varchar(10)[] not null default '{}'`,
+		)
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		return loadSQL(tx, "triggers/posts", "triggers/threads")
+	},
+	func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`create table webhooks (
+				id bigserial primary key,
+				board varchar(10) not null references boards on delete cascade,
+				url varchar(2000) not null,
+				secret varchar(100) not null,
+				events text[] not null
+			)`,
+			createIndex("webhooks", "board"),
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(
+			`alter table boards
+				add column customCSS text not null default ''`,
+		)
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`alter table boards
+				add column tags varchar(20)[] not null default '{}'`,
+			`create index boards_tags_idx on boards using gin (tags)`,
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`alter table images
+				add column sha256 varchar(64) not null default ''`,
+			createIndex("images", "md5"),
+			createIndex("images", "sha256"),
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`alter table boards
+				add column threadTags bool not null default false`,
+			`alter table threads
+				add column tags varchar(20)[] not null default '{}'`,
+			`create index threads_tags_idx on threads using gin (tags)`,
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		err = execAll(tx,
+			`create table thread_trigrams (
+				thread_id bigint not null references threads on delete cascade,
+				trigram text not null,
+				primary key (thread_id, trigram)
+			)`,
+			createIndex("thread_trigrams", "trigram"),
+		)
+		if err != nil {
+			return
+		}
+
+		// Index subjects of existing threads
+		r, err := tx.Query(`select id, subject from threads`)
+		if err != nil {
+			return
+		}
+		defer r.Close()
+
+		subjects := make(map[uint64]string, 1<<10)
+		for r.Next() {
+			var (
+				id      uint64
+				subject string
+			)
+			err = r.Scan(&id, &subject)
+			if err != nil {
+				return
+			}
+			subjects[id] = subject
+		}
+		err = r.Err()
+		if err != nil {
+			return
+		}
+
+		for id, subject := range subjects {
+			err = writeThreadTrigrams(tx, id, subject)
+			if err != nil {
+				return
+			}
+		}
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`alter table posts
+				add column math_svgs text[] not null default '{}'`,
+			`alter table posts
+				add column math_pending bool not null default false`,
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(
+			`alter table posts
+				add column code_blocks jsonb`,
+		)
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(
+			`create table fortunes (
+				board varchar(10) primary key
+					references boards on delete cascade,
+				lines text[] not null
+			)`,
+		)
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`create table url_cache (
+				url text primary key,
+				title text not null,
+				description text not null,
+				image_url text not null,
+				fetched timestamptz not null default now()
+			)`,
+			`alter table posts
+				add column embeds jsonb`,
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`alter table boards
+				add column postCooldown smallint not null default 0`,
+			`create table cooldowns (
+				board varchar(10) not null references boards on delete cascade,
+				ip inet not null,
+				last_post timestamptz not null default now(),
+				primary key (board, ip)
+			)`,
+		)
+	},
 }
 
 func createIndex(table string, columns ...string) string {
@@ -0,0 +1,100 @@
+package db
+
+import (
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+)
+
+// Session contains the IDs of posts created by a client within the same post
+// session
+type Session struct {
+	Token string
+	Posts []uint64
+}
+
+// AddOwnPost records a post as created within the client's post session and
+// extends the session's expiry time
+func AddOwnPost(token string, id uint64) (err error) {
+	expires := time.Now().
+		Add(time.Duration(config.Get().SessionExpiry) * time.Hour * 24)
+	return InTransaction(false, func(tx *sql.Tx) (err error) {
+		_, err = sq.Insert("post_sessions").
+			Columns("token", "post_id", "expires").
+			Values(token, id, expires).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+		_, err = sq.Update("post_sessions").
+			Set("expires", expires).
+			Where("token = ?", token).
+			RunWith(tx).
+			Exec()
+		return
+	})
+}
+
+// GetSession retrieves a post session with the IDs of all posts created
+// within it
+func GetSession(token string) (s Session, err error) {
+	s = Session{
+		Token: token,
+		Posts: make([]uint64, 0, 16),
+	}
+	if len(token) != common.LenPostSession {
+		return
+	}
+	err = queryAll(
+		sq.Select("post_id").
+			From("post_sessions").
+			Where("token = ?", token).
+			OrderBy("post_id"),
+		func(r *sql.Rows) (err error) {
+			var id uint64
+			err = r.Scan(&id)
+			if err != nil {
+				return
+			}
+			s.Posts = append(s.Posts, id)
+			return
+		},
+	)
+	return
+}
+
+// GetOwnPosts retrieves all posts created within a post session, that still
+// exist. Posts are sorted by ID.
+func GetOwnPosts(token string) (posts []common.StandalonePost, err error) {
+	defer wrapReadError(&err)
+
+	s, err := GetSession(token)
+	if err != nil {
+		return
+	}
+
+	posts = make([]common.StandalonePost, 0, len(s.Posts))
+	for i := 0; i < len(s.Posts); i += MaxBatchPosts {
+		end := i + MaxBatchPosts
+		if end > len(s.Posts) {
+			end = len(s.Posts)
+		}
+		var batch map[uint64]common.StandalonePost
+		batch, err = GetPosts(s.Posts[i:end])
+		if err != nil {
+			return
+		}
+		for _, p := range batch {
+			p.IsOwn = true
+			posts = append(posts, p)
+		}
+	}
+	sort.Slice(posts, func(i, j int) bool {
+		return posts[i].ID < posts[j].ID
+	})
+	return
+}
@@ -0,0 +1,49 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestOwnPosts(t *testing.T) {
+	assertTableClear(t, "boards")
+	writeSampleBoard(t)
+	writeSampleThread(t)
+
+	const token = "ZlkHbNYfd7grqUvHJ5DMmD8MkbCLDqDmf8RnLaxvULw"
+	if len(token) != common.LenPostSession {
+		t.Fatal("invalid sample token length")
+	}
+
+	if err := AddOwnPost(token, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("session", func(t *testing.T) {
+		s, err := GetSession(token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertDeepEquals(t, s.Posts, []uint64{1})
+	})
+
+	t.Run("own posts", func(t *testing.T) {
+		posts, err := GetOwnPosts(token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(posts) != 1 || posts[0].ID != 1 || !posts[0].IsOwn {
+			t.Fatalf("unexpected posts: %#v", posts)
+		}
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		posts, err := GetOwnPosts("foo")
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertDeepEquals(t, len(posts), 0)
+	})
+}
@@ -0,0 +1,114 @@
+package db
+
+import (
+	"database/sql"
+	"math/bits"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/bakape/meguca/common"
+)
+
+// Time perceptual hashes of a board's images are cached for
+const phashCacheTTL = time.Minute
+
+// Perceptual hashes of images in posts of a board
+type boardPHashes struct {
+	loaded time.Time
+	posts  []postPHash
+}
+
+type postPHash struct {
+	id, hash uint64
+}
+
+var (
+	phashCache   = map[string]boardPHashes{}
+	phashCacheMu sync.Mutex
+)
+
+// Retrieve perceptual hashes of all images posted on a board, reloading them
+// from the database, if the cached ones are stale
+func getBoardPHashes(board string) (posts []postPHash, err error) {
+	phashCacheMu.Lock()
+	defer phashCacheMu.Unlock()
+
+	c, ok := phashCache[board]
+	if ok && time.Since(c.loaded) < phashCacheTTL {
+		return c.posts, nil
+	}
+
+	posts = make([]postPHash, 0, 64)
+	err = queryAll(
+		sq.Select("p.id", "i.phash").
+			From("posts as p").
+			Join("images as i on p.SHA1 = i.SHA1").
+			Where("p.board = ? and i.phash != ''", board),
+		func(r *sql.Rows) (err error) {
+			var (
+				p postPHash
+				s string
+			)
+			err = r.Scan(&p.id, &s)
+			if err != nil {
+				return
+			}
+			p.hash, err = strconv.ParseUint(s, 16, 64)
+			if err != nil {
+				return
+			}
+			posts = append(posts, p)
+			return
+		},
+	)
+	if err != nil {
+		return
+	}
+	phashCache[board] = boardPHashes{
+		loaded: time.Now(),
+		posts:  posts,
+	}
+	return
+}
+
+// FindSimilarImages retrieves posts on a board with images, whose perceptual
+// hash is within threshold hamming distance of phash. At most MaxBatchPosts
+// posts are returned sorted by ID.
+func FindSimilarImages(board, phash string, threshold int) (
+	posts []common.StandalonePost, err error,
+) {
+	hash, err := strconv.ParseUint(phash, 16, 64)
+	if err != nil {
+		err = common.ErrInvalidInput("perceptual hash")
+		return
+	}
+	hashes, err := getBoardPHashes(board)
+	if err != nil {
+		return
+	}
+
+	ids := make([]uint64, 0, 16)
+	for _, p := range hashes {
+		if bits.OnesCount64(p.hash^hash) <= threshold {
+			ids = append(ids, p.id)
+			if len(ids) == MaxBatchPosts {
+				break
+			}
+		}
+	}
+
+	found, err := GetPosts(ids)
+	if err != nil {
+		return
+	}
+	posts = make([]common.StandalonePost, 0, len(found))
+	for _, p := range found {
+		posts = append(posts, p)
+	}
+	sort.Slice(posts, func(i, j int) bool {
+		return posts[i].ID < posts[j].ID
+	})
+	return
+}
@@ -0,0 +1,54 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/imager/assets"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestFindSimilarImages(t *testing.T) {
+	assertTableClear(t, "images", "boards")
+	phashCache = map[string]boardPHashes{}
+	std := assets.StdJPEG
+	std.PHash = "f0f0f0f0f0f0f0f0"
+	err := WriteImage(std.ImageCommon)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeSampleBoard(t)
+	writeSampleThread(t)
+	insertSampleImage(t)
+
+	cases := [...]struct {
+		name, phash string
+		threshold   int
+		ids         []uint64
+	}{
+		{"identical", "f0f0f0f0f0f0f0f0", 0, []uint64{1}},
+		{"similar", "f0f0f0f0f0f0f0f3", 2, []uint64{1}},
+		{"different", "0f0f0f0f0f0f0f0f", 10, nil},
+	}
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			posts, err := FindSimilarImages("a", c.phash, c.threshold)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids []uint64
+			for _, p := range posts {
+				ids = append(ids, p.ID)
+			}
+			AssertDeepEquals(t, ids, c.ids)
+		})
+	}
+
+	t.Run("invalid hash", func(t *testing.T) {
+		_, err := FindSimilarImages("a", "foo", 10)
+		if _, ok := err.(common.StatusError); !ok {
+			UnexpectedError(t, err)
+		}
+	})
+}
@@ -10,13 +10,15 @@ import (
 func ClosePost(id, op uint64, body string, links []common.Link,
 	com []common.Command,
 ) (err error) {
+	code := highlightCode(body)
 	err = InTransaction(false, func(tx *sql.Tx) (err error) {
 		_, err = sq.Update("posts").
 			SetMap(map[string]interface{}{
-				"editing":  false,
-				"body":     body,
-				"commands": commandRow(com),
-				"password": nil,
+				"editing":      false,
+				"body":         body,
+				"commands":     commandRow(com),
+				"math_pending": common.MathRegexp.MatchString(body),
+				"code_blocks":  codeBlockRow(code),
 			}).
 			Where("id = ?", id).
 			RunWith(tx).
@@ -33,7 +35,7 @@ func ClosePost(id, op uint64, body string, links []common.Link,
 
 	if !common.IsTest {
 		// TODO: Propagate this with DB listener
-		err = common.ClosePost(id, op, links, com)
+		err = common.ClosePost(id, op, links, com, code)
 		if err != nil {
 			return
 		}
@@ -41,3 +43,16 @@ func ClosePost(id, op uint64, body string, links []common.Link,
 
 	return deleteOpenPostBody(id)
 }
+
+// SetPostMath stores the URLs of the SVGs rendered from a post's math markup
+// and clears its pending rendering flag
+func SetPostMath(id uint64, svgs []string) (err error) {
+	_, err = sq.Update("posts").
+		SetMap(map[string]interface{}{
+			"math_svgs":    encodeStringArray(svgs),
+			"math_pending": false,
+		}).
+		Where("id = ?", id).
+		Exec()
+	return
+}
@@ -3,9 +3,18 @@ package db
 
 import (
 	"database/sql"
+	"time"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/bakape/meguca/auth"
 	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+)
+
+var (
+	errEditingDisabled  = common.ErrInvalidInput("post editing disabled")
+	errPostOpen         = common.ErrInvalidInput("post still open")
+	errEditWindowPassed = common.ErrInvalidInput("post edit window passed")
 )
 
 // Post is for writing new posts to a database. It contains the Password
@@ -43,7 +52,7 @@ func getCounter(q squirrel.SelectBuilder) (uint64, error) {
 
 // BoardCounter retrieves the progress counter of a board
 func BoardCounter(board string) (uint64, error) {
-	q := sq.Select("max(update_time) + count(*)").
+	q := sq.Select("max(update_time) + count(*) filter (where not archived)").
 		From("threads").
 		Where("board = ?", board)
 	return getCounter(q)
@@ -51,7 +60,7 @@ func BoardCounter(board string) (uint64, error) {
 
 // AllBoardCounter retrieves the progress counter of the /all/ board
 func AllBoardCounter() (uint64, error) {
-	q := sq.Select("max(update_time) + count(*)").
+	q := sq.Select("max(update_time) + count(*) filter (where not archived)").
 		From("threads")
 	return getCounter(q)
 }
@@ -79,13 +88,13 @@ func WritePost(tx *sql.Tx, p Post) (err error) {
 			"editing", "spoiler", "id", "board", "op", "time", "body", "flag",
 			"name", "trip", "auth", "password", "ip",
 			"SHA1", "imageName",
-			"commands",
+			"commands", "code_blocks",
 		).
 		Values(
 			p.Editing, spoiler, p.ID, p.Board, p.OP, p.Time, p.Body, p.Flag,
 			p.Name, p.Trip, p.Auth, p.Password, ip,
 			img, imgName,
-			commandRow(p.Commands),
+			commandRow(p.Commands), codeBlockRow(p.CodeBlocks),
 		).
 		RunWith(tx).
 		Exec()
@@ -108,15 +117,21 @@ func WritePost(tx *sql.Tx, p Post) (err error) {
 // Thread OPs must have their post ID set to the thread ID.
 // Any images are to be inserted in a separate call.
 func InsertPost(tx *sql.Tx, p *Post) (err error) {
+	if !p.Editing {
+		p.CodeBlocks = highlightCode(p.Body)
+	}
+
 	args := make([]interface{}, 0, 16)
 	args = append(args,
-		p.Editing, p.Board, p.OP, p.Body, p.Flag,
-		p.Name, p.Trip, p.Auth, p.Password, p.IP)
+		p.Editing, p.Sage, p.Board, p.OP, p.Body, p.Flag,
+		p.Name, p.Trip, p.Auth, p.Password, p.IP, p.MathPending,
+		codeBlockRow(p.CodeBlocks))
 
 	q := sq.Insert("posts").
 		Columns(
-			"editing", "board", "op", "body", "flag",
-			"name", "trip", "auth", "password", "ip",
+			"editing", "sage", "board", "op", "body", "flag",
+			"name", "trip", "auth", "password", "ip", "math_pending",
+			"code_blocks",
 		)
 
 	if p.ID != 0 { // OP of a thread
@@ -133,6 +148,12 @@ func InsertPost(tx *sql.Tx, p *Post) (err error) {
 	if err != nil {
 		return
 	}
+	if p.ID != p.OP {
+		err = deleteCyclicPosts(tx, p.OP, p.Board)
+		if err != nil {
+			return
+		}
+	}
 
 	if p.Moderated {
 		// Read moderation log, if post deleted on insert
@@ -158,9 +179,178 @@ func GetPostPassword(id uint64) (p []byte, err error) {
 	return
 }
 
+// DeleteOwnPost marks a post as deleted by its author. The password must match
+// the one the post was created with and the request must originate from the
+// same IP as the post.
+func DeleteOwnPost(id uint64, password, ip string) (err error) {
+	var hash []byte
+	err = sq.Select("password").
+		From("posts").
+		Where("id = ? and ip = ? and not is_deleted(id)", id, ip).
+		QueryRow().
+		Scan(&hash)
+	switch {
+	case err == sql.ErrNoRows, err == nil && hash == nil:
+		return common.ErrNoPermissions
+	case err != nil:
+		return
+	}
+	if auth.BcryptCompare(password, hash) != nil {
+		return common.ErrInvalidCreds
+	}
+
+	return moderatePost(id,
+		common.ModerationEntry{
+			Type: common.DeletePost,
+			By:   common.SelfDeletion,
+		},
+		nil)
+}
+
+// CheckEditable returns an error, if the password does not match the one the
+// post was created with, the post is still open or the post is older than the
+// configured edit window
+func CheckEditable(id uint64, password string) (err error) {
+	window := int64(config.Get().PostEditWindow) * 60
+	if window == 0 {
+		return errEditingDisabled
+	}
+
+	var (
+		editing bool
+		created int64
+		hash    []byte
+	)
+	err = sq.Select("editing", "time", "password").
+		From("posts").
+		Where("id = ? and not is_deleted(id)", id).
+		QueryRow().
+		Scan(&editing, &created, &hash)
+	switch {
+	case err == sql.ErrNoRows, err == nil && hash == nil:
+		return common.ErrNoPermissions
+	case err != nil:
+		return
+	case editing:
+		return errPostOpen
+	case time.Now().Unix()-created > window:
+		return errEditWindowPassed
+	}
+	if auth.BcryptCompare(password, hash) != nil {
+		return common.ErrInvalidCreds
+	}
+	return
+}
+
+// EditPost replaces the body of a closed post. The previous body is recorded in
+// the post's edit history. links and com replace the links and commands parsed
+// from the previous body. The caller must check the post can be edited with
+// CheckEditable and apply word filters to body.
+func EditPost(id uint64, body string, links []common.Link,
+	com []common.Command,
+) (err error) {
+	var prev string
+	err = sq.Select("body").
+		From("posts").
+		Where("id = ?", id).
+		QueryRow().
+		Scan(&prev)
+	if err != nil {
+		return
+	}
+
+	return InTransaction(false, func(tx *sql.Tx) (err error) {
+		_, err = sq.Insert("post_edits").
+			Columns("post_id", "body").
+			Values(id, prev).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+		_, err = sq.Update("posts").
+			SetMap(map[string]interface{}{
+				"body":         body,
+				"commands":     commandRow(com),
+				"math_pending": common.MathRegexp.MatchString(body),
+				"code_blocks":  codeBlockRow(highlightCode(body)),
+				"embeds":       embedRow(nil),
+			}).
+			Where("id = ?", id).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+		_, err = sq.Delete("links").
+			Where("source = ?", id).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+		return writeLinks(tx, id, links)
+	})
+}
+
 // SetPostCounter sets the post counter.
 // Should only be used in tests.
 func SetPostCounter(c uint64) error {
 	_, err := db.Exec(`SELECT setval('post_id', $1)`, c)
 	return err
 }
+
+// Delete the oldest replies of a cyclic thread, that exceed the thread's post
+// limit. The deletions are written to the moderation log, which also propagates
+// them to the thread feed.
+func deleteCyclicPosts(tx *sql.Tx, op uint64, board string) (err error) {
+	ids := make([]uint64, 0, 4)
+	err = queryAll(
+		sq.Select("p.id").
+			From("posts as p").
+			Join("threads as t on t.id = p.op").
+			Where("p.op = ? and p.id != ? and t.cyclic", op, op).
+			OrderBy("p.id asc").
+			Suffix(
+				`limit greatest(
+					(select count(*) from posts where op = ?)
+						- (select cyclicMax from threads where id = ?),
+					0
+				)`,
+				op, op,
+			).
+			RunWith(tx),
+		func(r *sql.Rows) (err error) {
+			var id uint64
+			err = r.Scan(&id)
+			if err != nil {
+				return
+			}
+			ids = append(ids, id)
+			return
+		},
+	)
+	if err != nil || len(ids) == 0 {
+		return
+	}
+
+	for _, id := range ids {
+		err = logModeration(tx, auth.ModLogEntry{
+			ModerationEntry: common.ModerationEntry{
+				Type: common.DeletePost,
+				By:   "system",
+				Data: "cyclic thread",
+			},
+			ID:    id,
+			Board: board,
+		})
+		if err != nil {
+			return
+		}
+	}
+	_, err = sq.Delete("posts").
+		Where("id = any(?::bigint[])", encodeUint64Array(ids)).
+		RunWith(tx).
+		Exec()
+	return
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"database/sql"
+	"github.com/bakape/meguca/auth"
 	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/test"
@@ -116,6 +117,62 @@ func TestInsertPost(t *testing.T) {
 	}
 }
 
+func TestCyclicThread(t *testing.T) {
+	assertTableClear(t, "boards")
+	writeSampleBoard(t)
+	writeSampleThread(t)
+	if err := SetThreadCyclic(1, true, 3); err != nil {
+		t.Fatal(err)
+	}
+
+	// Prevent key collision
+	_, err := sq.Select("nextval('post_id')").Exec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := make([]uint64, 0, 4)
+	for i := 0; i < 4; i++ {
+		p := Post{
+			StandalonePost: common.StandalonePost{
+				OP:    1,
+				Board: "a",
+			},
+		}
+		err = InTransaction(false, func(tx *sql.Tx) error {
+			return InsertPost(tx, &p)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, p.ID)
+	}
+
+	thread, err := GetThread(1, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertDeepEquals(t, thread.Cyclic, true)
+	test.AssertDeepEquals(t, thread.PostCount, uint32(3))
+	res := make([]uint64, 0, len(thread.Posts))
+	for _, p := range thread.Posts {
+		res = append(res, p.ID)
+	}
+	test.AssertDeepEquals(t, res, ids[2:])
+
+	log, err := GetModLog("a", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deleted := make([]uint64, 0, len(log))
+	for _, e := range log {
+		if e.Type == common.DeletePost && e.By == "system" {
+			deleted = append(deleted, e.ID)
+		}
+	}
+	test.AssertDeepEquals(t, len(deleted), 2)
+}
+
 func TestGetPostPassword(t *testing.T) {
 	p := insertPost(t)
 	res, err := GetPostPassword(p.ID)
@@ -124,3 +181,116 @@ func TestGetPostPassword(t *testing.T) {
 	}
 	test.AssertDeepEquals(t, res, p.Password)
 }
+
+func TestDeleteOwnPost(t *testing.T) {
+	p := insertPost(t)
+	hash, err := auth.BcryptHash("123", 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = sq.Update("posts").
+		Set("password", hash).
+		Where("id = ?", p.ID).
+		Exec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := [...]struct {
+		name, password, ip string
+		err                error
+	}{
+		{"wrong IP", "123", "::2", common.ErrNoPermissions},
+		{"wrong password", "1234", "::1", common.ErrInvalidCreds},
+		{"valid", "123", "::1", nil},
+		{"already deleted", "123", "::1", common.ErrNoPermissions},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := DeleteOwnPost(p.ID, c.password, c.ip)
+			if err != c.err {
+				test.UnexpectedError(t, err)
+			}
+		})
+	}
+
+	post, err := GetPost(p.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !post.IsDeleted() {
+		t.Fatal("post not deleted")
+	}
+	test.AssertDeepEquals(t, post.Moderation[0].By, common.SelfDeletion)
+}
+
+func TestEditPost(t *testing.T) {
+	config.Set(config.Configs{
+		PostEditWindow: 5,
+	})
+	p := insertPost(t)
+	hash, err := auth.BcryptHash("123", 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = sq.Update("posts").
+		SetMap(map[string]interface{}{
+			"password": hash,
+			"time":     time.Now().Unix(),
+			"body":     "foo",
+		}).
+		Where("id = ?", p.ID).
+		Exec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("wrong password", func(t *testing.T) {
+		err := CheckEditable(p.ID, "1234")
+		if err != common.ErrInvalidCreds {
+			test.UnexpectedError(t, err)
+		}
+	})
+
+	for _, body := range [...]string{"bar", "baz"} {
+		if err := CheckEditable(p.ID, "123"); err != nil {
+			t.Fatal(err)
+		}
+		if err := EditPost(p.ID, body, nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	post, err := GetPost(p.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertDeepEquals(t, post.Body, "baz")
+	test.AssertDeepEquals(t, len(post.EditHistory), 2)
+	for i, body := range [...]string{"bar", "foo"} {
+		test.AssertDeepEquals(t, post.EditHistory[i].Body, body)
+	}
+
+	t.Run("window passed", func(t *testing.T) {
+		_, err := sq.Update("posts").
+			Set("time", time.Now().Add(-time.Hour).Unix()).
+			Where("id = ?", p.ID).
+			Exec()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = CheckEditable(p.ID, "123")
+		if err != errEditWindowPassed {
+			test.UnexpectedError(t, err)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		config.Set(config.Configs{})
+		err := CheckEditable(p.ID, "123")
+		if err != errEditingDisabled {
+			test.UnexpectedError(t, err)
+		}
+	})
+}
@@ -2,15 +2,43 @@ package db
 
 import (
 	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
+	"time"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/bakape/meguca/auth"
 	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/imager/assets"
+	"github.com/bakape/meguca/metrics"
 	"github.com/lib/pq"
 )
 
+const (
+	// MaxBatchPosts is the maximum number of posts retrievable with one
+	// GetPosts() call
+	MaxBatchPosts = 100
+
+	// MaxPostContextRadius is the maximum number of posts retrievable on each
+	// side of the target post with GetPostContext()
+	MaxPostContextRadius = 50
+
+	// MaxQuoteChainDepth is the maximum depth of a reply tree retrievable with
+	// GetQuoteChain()
+	MaxQuoteChainDepth = 10
+
+	// MaxRecentPosts is the maximum number of posts retrievable with
+	// GetRecentPosts()
+	MaxRecentPosts = 50
+)
+
+// ErrTooManyPosts is returned, when too many posts are requested at once
+var ErrTooManyPosts = common.ErrInvalidInput("too many posts requested")
+
 const (
 	postSelectsSQL = `p.editing, p.moderated, p.spoiler, p.sage, p.id,
 	p.time, p.body, p.flag, p.name, p.trip, p.auth,
@@ -20,7 +48,16 @@ const (
 		join threads as linked_thread on linked_post.op = linked_thread.id
 		where l.source = p.id
 	),
-	p.commands, p.imageName,
+	p.commands,
+	(select json_agg(
+			json_build_object('body', e.body, 'edited_at', e.edited)
+			order by e.id desc
+		)
+		from post_edits as e
+		where e.post_id = p.id
+	),
+	p.imageName, p.ip, p.op, p.board, p.math_svgs, p.math_pending,
+	p.code_blocks, p.embeds,
 	i.*`
 
 	threadSelectsSQL = `t.sticky, t.board,
@@ -35,7 +72,26 @@ const (
 		where t.id = posts.op
 			and posts.SHA1 is not null
 	),
-	t.update_time, t.bump_time, t.subject, t.locked, ` + postSelectsSQL
+	(
+		select count(distinct ip)
+		from posts
+		where t.id = posts.op
+	),
+	t.update_time, t.bump_time,
+	(
+		select max(time)
+		from posts
+		where t.id = posts.op
+	),
+	(
+		select max(time)
+		from posts
+		where t.id = posts.op
+			and posts.SHA1 is not null
+	),
+	t.subject, t.locked, t.archived, t.cyclic, t.cyclicMax, t.maxReplies,
+	t.tags,
+	` + postSelectsSQL
 
 	getOPSQL = `
 	select ` + threadSelectsSQL + `
@@ -49,18 +105,26 @@ const (
 		select ` + postSelectsSQL + `
 		from posts as p
 		left outer join images as i on p.SHA1 = i.SHA1
-		where p.op = $1 and p.id != $1
+		where p.op = $1 and p.id != $1 and p.id >= $3
 		order by p.id desc
 		limit $2
 	)
 	select * from thread
 	order by id asc`
+
+	streamThreadSQL = `
+	select ` + postSelectsSQL + `
+	from posts as p
+	left outer join images as i on p.SHA1 = i.SHA1
+	where p.op = $1
+	order by p.id asc`
 )
 
 type imageScanner struct {
 	Audio, Video, Spoiler             sql.NullBool
 	FileType, ThumbType, Length, Size sql.NullInt64
 	Name, SHA1, MD5, Title, Artist    sql.NullString
+	PHash, SHA256                     sql.NullString
 	Dims                              pq.Int64Array
 }
 
@@ -69,7 +133,8 @@ type imageScanner struct {
 func (i *imageScanner) ScanArgs() []interface{} {
 	return []interface{}{
 		&i.Audio, &i.Video, &i.FileType, &i.ThumbType, &i.Dims,
-		&i.Length, &i.Size, &i.MD5, &i.SHA1, &i.Title, &i.Artist,
+		&i.Length, &i.Size, &i.MD5, &i.SHA1, &i.Title, &i.Artist, &i.PHash,
+		&i.SHA256,
 	}
 }
 
@@ -96,8 +161,10 @@ func (i *imageScanner) Val() *common.Image {
 			Size:      int(i.Size.Int64),
 			MD5:       i.MD5.String,
 			SHA1:      i.SHA1.String,
+			SHA256:    i.SHA256.String,
 			Title:     i.Title.String,
 			Artist:    i.Artist.String,
+			PHash:     i.PHash.String,
 		},
 		Name: i.Name.String,
 	}
@@ -107,21 +174,114 @@ type postScanner struct {
 	common.Post
 	spoiler   bool
 	imageName string
+	ip        sql.NullString
+	op        uint64
+	board     string
 	links     linkScanner
 	commands  commandRow
+	edits     editHistory
+	mathSVGs  pq.StringArray
+	code      codeBlockRow
+	embeds    embedRow
+}
+
+// Scans a JSON array of previous post bodies
+type editHistory []common.EditEntry
+
+func (e *editHistory) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case []byte:
+		return json.Unmarshal(src, e)
+	case string:
+		return json.Unmarshal([]byte(src), e)
+	case nil:
+		*e = nil
+		return nil
+	default:
+		return fmt.Errorf("cannot convert %T to []common.EditEntry", src)
+	}
+}
+
+// Highlighted code blocks of a post stored as a JSON array
+type codeBlockRow []common.CodeBlock
+
+func (c *codeBlockRow) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case []byte:
+		return json.Unmarshal(src, c)
+	case string:
+		return json.Unmarshal([]byte(src), c)
+	case nil:
+		*c = nil
+		return nil
+	default:
+		return fmt.Errorf("cannot convert %T to []common.CodeBlock", src)
+	}
+}
+
+func (c codeBlockRow) Value() (driver.Value, error) {
+	if c == nil {
+		return nil, nil
+	}
+	buf, err := json.Marshal([]common.CodeBlock(c))
+	return string(buf), err
+}
+
+// Open Graph previews of a post stored as a JSON array
+type embedRow []common.EmbedMeta
+
+func (e *embedRow) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case []byte:
+		return json.Unmarshal(src, e)
+	case string:
+		return json.Unmarshal([]byte(src), e)
+	case nil:
+		*e = nil
+		return nil
+	default:
+		return fmt.Errorf("cannot convert %T to []common.EmbedMeta", src)
+	}
+}
+
+func (e embedRow) Value() (driver.Value, error) {
+	if e == nil {
+		return nil, nil
+	}
+	buf, err := json.Marshal([]common.EmbedMeta(e))
+	return string(buf), err
+}
+
+// Highlight the code blocks of a closed post's body for storage
+func highlightCode(body string) []common.CodeBlock {
+	if common.HighlightCode == nil { // Parser not imported in tests
+		return nil
+	}
+	return common.HighlightCode(body)
 }
 
 func (p *postScanner) ScanArgs() []interface{} {
 	return []interface{}{
 		&p.Editing, &p.Moderated, &p.spoiler, &p.Sage, &p.ID, &p.Time, &p.Body,
-		&p.Flag, &p.Name, &p.Trip, &p.Auth, &p.links, &p.commands,
-		&p.imageName,
+		&p.Flag, &p.Name, &p.Trip, &p.Auth, &p.links, &p.commands, &p.edits,
+		&p.imageName, &p.ip, &p.op, &p.board, &p.mathSVGs, &p.MathPending,
+		&p.code, &p.embeds,
 	}
 }
 
 func (p postScanner) Val() (common.Post, error) {
 	p.Links = []common.Link(p.links)
 	p.Commands = []common.Command(p.commands)
+	p.EditHistory = []common.EditEntry(p.edits)
+	p.CodeBlocks = []common.CodeBlock(p.code)
+	p.Embeds = []common.EmbedMeta(p.embeds)
+	if len(p.mathSVGs) != 0 {
+		p.MathSVGs = []string(p.mathSVGs)
+	}
+	p.HasDiceRoll = !p.Editing && common.DiceResultRegexp.MatchString(p.Body)
+	if p.ip.Valid && config.GetBoardConfigs(p.board).PosterIDs {
+		p.PosterID = auth.PosterID(p.op, p.ip.String)
+	}
 
 	return p.Post, nil
 }
@@ -139,14 +299,31 @@ type PostStats struct {
 	Body                         []byte
 }
 
-// GetThread retrieves public thread data from the database
-func GetThread(id uint64, lastN int) (t common.Thread, err error) {
+// GetThread retrieves public thread data from the database. lastN limits the
+// replies to the last N ones. A lastN of 0 retrieves all replies and a negative
+// lastN uses the default set in the board's configuration. If startFrom is
+// non-zero, only replies with an ID greater or equal to it are retrieved.
+func GetThread(id uint64, lastN int, startFrom uint64) (
+	t common.Thread, err error,
+) {
+	defer metrics.ObserveQuery("get_thread", time.Now())
+	defer wrapReadError(&err)
+	defer func() {
+		// Never return partially read threads
+		if err != nil {
+			t = common.Thread{}
+		}
+	}()
+
 	err = InTransaction(true, func(tx *sql.Tx) (err error) {
 		// Get thread metadata and OP
 		t, err = scanOP(tx.QueryRow(getOPSQL, id))
 		if err != nil {
 			return
 		}
+		if lastN < 0 {
+			lastN = int(config.GetBoardConfigs(t.Board).DefaultLastN)
+		}
 		t.Abbrev = lastN != 0
 
 		// Get replies
@@ -160,7 +337,7 @@ func GetThread(id uint64, lastN int) (t common.Thread, err error) {
 		} else {
 			cap = int(t.PostCount)
 		}
-		r, err := tx.Query(getThreadPostsSQL, id, limit)
+		r, err := tx.Query(getThreadPostsSQL, id, limit, startFrom)
 		if err != nil {
 			return
 		}
@@ -189,6 +366,7 @@ func GetThread(id uint64, lastN int) (t common.Thread, err error) {
 		if err != nil {
 			return
 		}
+		t.HasMore = len(t.Posts) < int(t.PostCount)-1
 
 		// Inject  moderation into affected posts
 		moderated := make([]*common.Post, 0, 64)
@@ -209,20 +387,126 @@ func GetThread(id uint64, lastN int) (t common.Thread, err error) {
 		filterOpen(&open, &t.Posts[i])
 	}
 	err = injectOpenBodies(open)
+	if err != nil {
+		return
+	}
+
+	injectReplyIDs(&t)
 	return
 }
 
-func scanOP(r rowScanner) (t common.Thread, err error) {
+// StreamThread reads all posts of a thread, including the OP, in ascending
+// order and sends them one by one over the returned post channel, without
+// loading the entire thread into memory. The post channel is closed, once
+// reading ends. After that the error channel receives exactly one value, that
+// is nil on success. Closing done aborts reading early.
+//
+// Unlike GetThread, reply IDs are not injected into the streamed posts.
+func StreamThread(id uint64, done <-chan struct{}) (
+	<-chan common.Post, <-chan error,
+) {
+	posts := make(chan common.Post)
+	errc := make(chan error, 1)
+	go func() {
+		err := streamThread(id, posts, done)
+		close(posts)
+		wrapReadError(&err)
+		errc <- err
+	}()
+	return posts, errc
+}
+
+func streamThread(id uint64, posts chan<- common.Post, done <-chan struct{},
+) (err error) {
+	r, err := db.Query(streamThreadSQL, id)
+	if err != nil {
+		return
+	}
+	defer r.Close()
+
 	var (
 		post  postScanner
 		img   imageScanner
-		pArgs = post.ScanArgs()
-		iArgs = img.ScanArgs()
-		args  = make([]interface{}, 0, 8+len(pArgs)+len(iArgs))
+		p     common.Post
+		found bool
+		args  = append(post.ScanArgs(), img.ScanArgs()...)
+	)
+	for r.Next() {
+		found = true
+		err = r.Scan(args...)
+		if err != nil {
+			return
+		}
+		p, err = extractPost(post, img)
+		if err != nil {
+			return
+		}
+		if p.Editing {
+			err = injectOpenBodies([]*common.Post{&p})
+			if err != nil {
+				return
+			}
+		}
+		if p.Moderated {
+			err = injectModeration([]*common.Post{&p}, nil)
+			if err != nil {
+				return
+			}
+		}
+
+		select {
+		case posts <- p:
+		case <-done:
+			return
+		}
+	}
+	err = r.Err()
+	if err == nil && !found {
+		err = sql.ErrNoRows
+	}
+	return
+}
+
+// Set the IDs of posts linking to each post in the thread. Only links from
+// posts contained in t are considered.
+func injectReplyIDs(t *common.Thread) {
+	byID := make(map[uint64]*common.Post, len(t.Posts)+1)
+	byID[t.ID] = &t.Post
+	for i := range t.Posts {
+		byID[t.Posts[i].ID] = &t.Posts[i]
+	}
+
+	for _, src := range byID {
+		for _, l := range src.Links {
+			if target := byID[l.ID]; target != nil {
+				target.ReplyIDs = append(target.ReplyIDs, src.ID)
+			}
+		}
+	}
+	for _, p := range byID {
+		if len(p.ReplyIDs) > 1 {
+			sort.Slice(p.ReplyIDs, func(i, j int) bool {
+				return p.ReplyIDs[i] < p.ReplyIDs[j]
+			})
+		}
+	}
+}
+
+func scanOP(r rowScanner) (t common.Thread, err error) {
+	var (
+		post       postScanner
+		img        imageScanner
+		lastImage  sql.NullInt64
+		maxReplies int
+		tags       pq.StringArray
+		pArgs      = post.ScanArgs()
+		iArgs      = img.ScanArgs()
+		args       = make([]interface{}, 0, 16+len(pArgs)+len(iArgs))
 	)
 	args = append(args,
-		&t.Sticky, &t.Board, &t.PostCount, &t.ImageCount, &t.UpdateTime,
-		&t.BumpTime, &t.Subject, &t.Locked,
+		&t.Sticky, &t.Board, &t.PostCount, &t.ImageCount, &t.UniquePosters,
+		&t.UpdateTime, &t.BumpTime, &t.LastReplyTime, &lastImage, &t.Subject,
+		&t.Locked, &t.Archived, &t.Cyclic, &t.CyclicMax, &maxReplies, &tags,
 	)
 	args = append(args, pArgs...)
 	args = append(args, iArgs...)
@@ -231,6 +515,27 @@ func scanOP(r rowScanner) (t common.Thread, err error) {
 	if err != nil {
 		return
 	}
+	if lastImage.Valid {
+		t.LastImageTime = &lastImage.Int64
+	}
+	if len(tags) != 0 {
+		t.Tags = []string(tags)
+	}
+
+	// Threads without their own reply limit inherit the board's
+	t.MaxReplies = maxReplies
+	if t.MaxReplies == 0 {
+		t.MaxReplies = config.GetBoardConfigs(t.Board).ReplyLimit()
+	}
+	t.Saged = int(t.PostCount) >= t.MaxReplies
+
+	if limit := config.GetBoardConfigs(t.Board).ImageLimit; limit != 0 {
+		rem := int(limit) - int(t.ImageCount)
+		if rem < 0 {
+			rem = 0
+		}
+		t.ImagesRemaining = &rem
+	}
 
 	t.Post, err = extractPost(post, img)
 	return
@@ -250,35 +555,14 @@ func extractPost(ps postScanner, is imageScanner) (p common.Post, err error) {
 
 // GetPost reads a single post from the database
 func GetPost(id uint64) (res common.StandalonePost, err error) {
-	var (
-		post  postScanner
-		img   imageScanner
-		pArgs = post.ScanArgs()
-		iArgs = img.ScanArgs()
-		args  = make([]interface{}, 2, 2+len(pArgs)+len(iArgs))
-	)
-	args[0] = &res.OP
-	args[1] = &res.Board
-	args = append(args, pArgs...)
-	args = append(args, iArgs...)
+	defer wrapReadError(&err)
 
-	err = sq.Select("p.op, p.board, "+postSelectsSQL).
-		From("posts as p").
-		LeftJoin("images as i on p.SHA1 = i.SHA1").
+	res, err = scanStandalonePost(getStandalonePosts().
 		Where("id = ?", id).
-		QueryRow().
-		Scan(args...)
+		QueryRow())
 	if err != nil {
 		return
 	}
-	res.Post, err = post.Val()
-	if err != nil {
-		return
-	}
-	res.Image = img.Val()
-	if res.Image != nil {
-		res.Image.Spoiler, res.Image.Name = post.Image()
-	}
 
 	if res.Editing {
 		res.Body, err = GetOpenBody(res.ID)
@@ -296,6 +580,231 @@ func GetPost(id uint64) (res common.StandalonePost, err error) {
 	return
 }
 
+// GetPosts reads several posts from the database in one query. Nonexistent
+// posts are omitted from the result.
+func GetPosts(ids []uint64) (posts map[uint64]common.StandalonePost, err error) {
+	if len(ids) > MaxBatchPosts {
+		return nil, ErrTooManyPosts
+	}
+	posts = make(map[uint64]common.StandalonePost, len(ids))
+	if len(ids) == 0 {
+		return
+	}
+
+	buf, err := scanStandalonePosts(getStandalonePosts().
+		Where("id = any(?::bigint[])", encodeUint64Array(ids)))
+	if err != nil {
+		return
+	}
+	for _, p := range buf {
+		posts[p.ID] = p
+	}
+	return
+}
+
+// GetPostContext retrieves a post together with up to radius posts before and
+// after it in the same thread, sorted by ID. radius is capped at
+// MaxPostContextRadius.
+func GetPostContext(id uint64, radius int) (
+	posts []common.StandalonePost, err error,
+) {
+	defer wrapReadError(&err)
+
+	switch {
+	case radius < 0:
+		radius = 0
+	case radius > MaxPostContextRadius:
+		radius = MaxPostContextRadius
+	}
+	min := uint64(0)
+	if id > uint64(radius) {
+		min = id - uint64(radius)
+	}
+
+	posts, err = scanStandalonePosts(getStandalonePosts().
+		Where("op = (select op from posts where id = ?)", id).
+		Where("id between ? and ?", min, id+uint64(radius)).
+		OrderBy("id asc"))
+	if err != nil {
+		return
+	}
+
+	// Target post must always be present
+	for _, p := range posts {
+		if p.ID == id {
+			return
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+// GetQuoteChain retrieves the tree of replies to a post within its thread.
+// Each reply is a child of every post it links to. Posts deleted by staff are
+// replaced with placeholders. maxDepth is capped at MaxQuoteChainDepth.
+func GetQuoteChain(id uint64, maxDepth int) (
+	node common.QuoteNode, err error,
+) {
+	defer wrapReadError(&err)
+
+	_, op, err := GetPostParenthood(id)
+	if err != nil {
+		return
+	}
+	// Fetch the entire thread once and build the tree in memory
+	t, err := GetThread(op, 0, 0)
+	if err != nil {
+		return
+	}
+	return buildQuoteChain(t, id, maxDepth)
+}
+
+// Build the tree of replies to a post from all posts of its thread
+func buildQuoteChain(t common.Thread, id uint64, maxDepth int) (
+	node common.QuoteNode, err error,
+) {
+	switch {
+	case maxDepth < 0:
+		maxDepth = 0
+	case maxDepth > MaxQuoteChainDepth:
+		maxDepth = MaxQuoteChainDepth
+	}
+
+	var (
+		posts   = make(map[uint64]common.Post, len(t.Posts)+1)
+		replies = make(map[uint64][]uint64, len(t.Posts))
+	)
+	posts[t.ID] = t.Post
+	for _, p := range t.Posts {
+		posts[p.ID] = p
+		for _, l := range p.Links {
+			// Skip cross-thread links and repeated links to the same post
+			r := replies[l.ID]
+			if l.OP == t.ID && (len(r) == 0 || r[len(r)-1] != p.ID) {
+				replies[l.ID] = append(r, p.ID)
+			}
+		}
+	}
+	if _, ok := posts[id]; !ok {
+		err = sql.ErrNoRows
+		return
+	}
+
+	var build func(id uint64, depth int) common.QuoteNode
+	build = func(id uint64, depth int) (n common.QuoteNode) {
+		n.Post = posts[id]
+		if n.Post.IsDeleted() {
+			n.Post = common.Post{
+				ID:   id,
+				Body: "[deleted]",
+			}
+		}
+		n.Children = make([]common.QuoteNode, 0, len(replies[id]))
+		if depth < maxDepth {
+			for _, r := range replies[id] {
+				n.Children = append(n.Children, build(r, depth+1))
+			}
+		}
+		return
+	}
+	node = build(id, 0)
+	return
+}
+
+// Convert any error returned from reading a thread or post to a
+// common.ReadError
+func wrapReadError(err *error) {
+	switch *err {
+	case nil:
+	case sql.ErrNoRows:
+		*err = common.ReadError{Code: common.NotFound, Err: *err}
+	default:
+		if _, ok := (*err).(common.ReadError); !ok {
+			*err = common.ReadError{Code: common.DBError, Err: *err}
+		}
+	}
+}
+
+// GetRecentPosts retrieves the latest posts across all boards, that are
+// visible on the "/all/" metaboard. Posts deleted by moderators are omitted.
+// limit is capped at MaxRecentPosts.
+func GetRecentPosts(limit int) ([]common.StandalonePost, error) {
+	if limit <= 0 || limit > MaxRecentPosts {
+		limit = MaxRecentPosts
+	}
+	q := getStandalonePosts().
+		Where(fmt.Sprintf(
+			`not exists (
+				select 1 from post_moderation
+				where post_id = p.id and type = %d)`,
+			common.DeletePost,
+		)).
+		OrderBy("p.time desc", "p.id desc").
+		Limit(uint64(limit))
+	if config.Get().HideNSFW {
+		q = q.Where("p.board not in (select id from boards where NSFW)")
+	}
+	return scanStandalonePosts(q)
+}
+
+func getStandalonePosts() squirrel.SelectBuilder {
+	return sq.Select("p.op, p.board, " + postSelectsSQL).
+		From("posts as p").
+		LeftJoin("images as i on p.SHA1 = i.SHA1")
+}
+
+// Scan multiple standalone posts and inject any open bodies and moderation
+func scanStandalonePosts(q squirrel.SelectBuilder) (
+	posts []common.StandalonePost, err error,
+) {
+	posts = make([]common.StandalonePost, 0, 16)
+	err = queryAll(q, func(r *sql.Rows) (err error) {
+		p, err := scanStandalonePost(r)
+		if err != nil {
+			return
+		}
+		posts = append(posts, p)
+		return
+	})
+	if err != nil {
+		return
+	}
+
+	open := make([]*common.Post, 0, 16)
+	moderated := make([]*common.Post, 0, 16)
+	for i := range posts {
+		ptr := &posts[i].Post
+		filterOpen(&open, ptr)
+		filterModerated(&moderated, ptr)
+	}
+	err = injectOpenBodies(open)
+	if err != nil {
+		return
+	}
+	err = injectModeration(moderated, nil)
+	return
+}
+
+func scanStandalonePost(r rowScanner) (res common.StandalonePost, err error) {
+	var (
+		post  postScanner
+		img   imageScanner
+		pArgs = post.ScanArgs()
+		iArgs = img.ScanArgs()
+		args  = make([]interface{}, 2, 2+len(pArgs)+len(iArgs))
+	)
+	args[0] = &res.OP
+	args[1] = &res.Board
+	args = append(args, pArgs...)
+	args = append(args, iArgs...)
+
+	err = r.Scan(args...)
+	if err != nil {
+		return
+	}
+	res.Post, err = extractPost(post, img)
+	return
+}
+
 func getOPs() squirrel.SelectBuilder {
 	return sq.Select(threadSelectsSQL).
 		From("threads as t").
@@ -303,49 +812,304 @@ func getOPs() squirrel.SelectBuilder {
 		LeftJoin("images as i on p.SHA1 = i.SHA1")
 }
 
-// GetBoardCatalog retrieves all OPs of a single board
-func GetBoardCatalog(board string) (b common.Board, err error) {
+// Returns the ORDER BY clause for sorting threads aliased as "t"
+func threadOrder(order common.SortOrder) string {
+	switch order {
+	case common.SortCreation:
+		return "t.id desc"
+	case common.SortReplyCount:
+		return `(select count(*) from posts where posts.op = t.id) desc,
+			t.bump_time desc`
+	case common.SortImageCount:
+		return `(select count(*)
+				from posts
+				where posts.op = t.id and posts.SHA1 is not null
+			) desc,
+			t.bump_time desc`
+	case common.SortLastReply:
+		return `(select max(time) from posts where posts.op = t.id) desc,
+			t.bump_time desc`
+	default:
+		return "t.bump_time desc"
+	}
+}
+
+// GetBoardCatalog retrieves all OPs of a single board in the specified order
+// with stickies first
+func GetBoardCatalog(board string, order common.SortOrder) (
+	b common.Board, err error,
+) {
+	defer metrics.ObserveQuery("get_board_catalog", time.Now())
+	defer wrapReadError(&err)
+
 	b, err = scanCatalog(getOPs().
-		Where("t.board = ?", board).
-		OrderBy("sticky desc, bump_time desc"))
+		Where("t.board = ? and not t.archived", board).
+		OrderBy("t.sticky desc", threadOrder(order)))
+	b.ThreadCount = len(b.Threads)
 	return
 }
 
-// GetThreadIDs retrieves all threads IDs on the board in bump order with stickies first
-func GetThreadIDs(board string) ([]uint64, error) {
-	return scanThreadIDs(sq.Select("id").
+// GetBoardArchive retrieves the OPs of archived threads on the specified page
+// of a board's archive in bump order. Pages are indexed from zero.
+func GetBoardArchive(board string, page, perPage int) (
+	b common.Board, err error,
+) {
+	if perPage <= 0 {
+		perPage = config.DefaultThreadsPerPage
+	}
+
+	var total int
+	err = sq.Select("count(*)").
 		From("threads").
-		Where("board = ?", board).
-		OrderBy("sticky desc, bump_time desc"))
+		Where("board = ? and archived", board).
+		QueryRow().
+		Scan(&total)
+	if err != nil {
+		return
+	}
+	if page < 0 || page*perPage >= total {
+		b.Threads = []common.Thread{}
+	} else {
+		b, err = scanCatalog(getOPs().
+			Where("t.board = ? and t.archived", board).
+			OrderBy("t.bump_time desc").
+			Limit(uint64(perPage)).
+			Offset(uint64(page * perPage)))
+		if err != nil {
+			return
+		}
+	}
+	b.Pages = (total + perPage - 1) / perPage
+	if b.Pages == 0 {
+		b.Pages = 1
+	}
+	return
 }
 
-// GetAllBoardCatalog retrieves all threads for the "/all/" meta-board
-func GetAllBoardCatalog() (board common.Board, err error) {
-	board, err = scanCatalog(getOPs().OrderBy("bump_time desc"))
+// GetThreadsByTag retrieves the OPs of unarchived threads with the specified
+// tag on the specified page of a board in bump order with stickies first.
+// Pages are indexed from zero.
+func GetThreadsByTag(board, tag string, page, perPage int) (
+	b common.Board, err error,
+) {
+	if perPage <= 0 {
+		perPage = config.DefaultThreadsPerPage
+	}
+
+	var total int
+	err = sq.Select("count(*)").
+		From("threads").
+		Where("board = ? and not archived and ? = any(tags)", board, tag).
+		QueryRow().
+		Scan(&total)
 	if err != nil {
 		return
 	}
+	if page < 0 || page*perPage >= total {
+		b.Threads = []common.Thread{}
+	} else {
+		b, err = scanCatalog(getOPs().
+			Where("t.board = ? and not t.archived and ? = any(t.tags)",
+				board, tag).
+			OrderBy("t.sticky desc", "t.bump_time desc").
+			Limit(uint64(perPage)).
+			Offset(uint64(page * perPage)))
+		if err != nil {
+			return
+		}
+	}
+	b.Pages = (total + perPage - 1) / perPage
+	if b.Pages == 0 {
+		b.Pages = 1
+	}
+	return
+}
 
-	// Hide threads from NSFW boards, if enabled
-	if config.Get().HideNSFW {
-		filtered := make([]common.Thread, 0, len(board.Threads))
-		confs := config.GetAllBoardConfigs()
-		for _, t := range board.Threads {
-			if !confs[t.Board].NSFW {
-				filtered = append(filtered, t)
+// GetThreadTags retrieves all tags used by unarchived threads on a board in
+// alphabetical order
+func GetThreadTags(board string) (tags []string, err error) {
+	tags = make([]string, 0, 16)
+	err = queryAll(
+		sq.Select("distinct unnest(tags) as tag").
+			From("threads").
+			Where("board = ? and not archived", board).
+			OrderBy("tag"),
+		func(r *sql.Rows) (err error) {
+			var t string
+			err = r.Scan(&t)
+			if err != nil {
+				return
 			}
+			tags = append(tags, t)
+			return
+		},
+	)
+	return
+}
+
+// GetBoardCatalogEntries retrieves minimal metadata of all threads on a board
+// in bump order with stickies first. Threads with deleted OPs are only
+// included, if showDeleted is set.
+func GetBoardCatalogEntries(board string, showDeleted bool) (
+	entries []common.CatalogEntry, err error,
+) {
+	q := sq.
+		Select(
+			"t.sticky", "t.id", "t.subject", "t.bump_time",
+			"(select count(*) from posts where posts.op = t.id)",
+			`(select count(*)
+				from posts
+				where posts.op = t.id and posts.SHA1 is not null)`,
+			"p.spoiler", "i.thumb_type", "i.SHA1",
+		).
+		From("threads as t").
+		Join("posts as p on t.id = p.id").
+		LeftJoin("images as i on p.SHA1 = i.SHA1").
+		Where("t.board = ? and not t.archived", board).
+		OrderBy("t.sticky desc, t.bump_time desc")
+	if !showDeleted {
+		q = q.Where(fmt.Sprintf(
+			`not exists (
+				select 1 from post_moderation
+				where post_id = t.id and type = %d)`,
+			common.DeletePost,
+		))
+	}
+
+	entries = make([]common.CatalogEntry, 0, 32)
+	err = queryAll(q, func(r *sql.Rows) (err error) {
+		var (
+			e         common.CatalogEntry
+			spoiler   sql.NullBool
+			thumbType sql.NullInt64
+			SHA1      sql.NullString
+		)
+		err = r.Scan(&e.Sticky, &e.ID, &e.Subject, &e.BumpTime, &e.PostCount,
+			&e.ImageCount, &spoiler, &thumbType, &SHA1)
+		if err != nil {
+			return
+		}
+		if SHA1.Valid && !spoiler.Bool {
+			e.Thumbnail = assets.ThumbPath(uint8(thumbType.Int64), SHA1.String)
 		}
-		board.Threads = filtered
+		entries = append(entries, e)
+		return
+	})
+	return
+}
+
+// GetThreadIDsPage retrieves thread IDs on the specified page of a board index
+// in the specified order with stickies first, the total number of pages on
+// the board and the total number of threads on the board. Pages are indexed from zero. Requesting a page past the last one
+// yields an empty ID slice. Passing "all" as the board retrieves threads from
+// all boards, without prioritising stickies. In that case boards can be used to
+// restrict the retrieved threads to a whitelist of boards.
+func GetThreadIDsPage(
+	board string,
+	page, perPage int,
+	order common.SortOrder,
+	boards []string,
+) (
+	ids []uint64, pages, total int, err error,
+) {
+	queryType := "get_board"
+	if board == "all" {
+		queryType = "get_all_board"
+	}
+	defer metrics.ObserveQuery(queryType, time.Now())
+	defer wrapReadError(&err)
+
+	if perPage <= 0 {
+		perPage = config.DefaultThreadsPerPage
+	}
+
+	q := sq.Select("t.id").
+		From("threads as t").
+		Where("not t.archived")
+	if board == "all" {
+		q = filterAllBoard(q, boards).OrderBy(threadOrder(order))
+		total, err = GetAllBoardThreadCount(boards)
+	} else {
+		q = q.Where("t.board = ?", board).
+			OrderBy("t.sticky desc", threadOrder(order))
+		total, err = GetThreadCount(board)
+	}
+	if err != nil {
+		return
+	}
+	pages = (total + perPage - 1) / perPage
+	if pages == 0 { // Empty board
+		pages = 1
+	}
+	if page < 0 || page*perPage >= total {
+		return []uint64{}, pages, total, nil
 	}
 
+	ids, err = scanThreadIDs(q.
+		Limit(uint64(perPage)).
+		Offset(uint64(page * perPage)))
 	return
 }
 
-// GetAllThreadsIDs retrieves all threads IDs in bump order
-func GetAllThreadsIDs() ([]uint64, error) {
-	return scanThreadIDs(sq.Select("id").
-		From("threads").
-		OrderBy("bump_time desc"))
+// GetThreadCount returns the number of threads on a board, excluding archived
+// ones
+func GetThreadCount(board string) (n int, err error) {
+	defer wrapReadError(&err)
+
+	err = sq.Select("count(*)").
+		From("threads as t").
+		Where("t.board = ? and not t.archived", board).
+		QueryRow().
+		Scan(&n)
+	return
+}
+
+// GetAllBoardThreadCount returns the number of threads visible on the "/all/"
+// metaboard. If boards is not empty, only threads from these boards are
+// counted.
+func GetAllBoardThreadCount(boards []string) (n int, err error) {
+	defer wrapReadError(&err)
+
+	err = filterAllBoard(
+		sq.Select("count(*)").
+			From("threads as t").
+			Where("not t.archived"),
+		boards,
+	).
+		QueryRow().
+		Scan(&n)
+	return
+}
+
+// GetAllBoardCatalog retrieves all threads for the "/all/" meta-board in the
+// specified order. If boards is not empty, only threads from these boards are
+// retrieved.
+func GetAllBoardCatalog(order common.SortOrder, boards []string) (
+	board common.Board, err error,
+) {
+	defer metrics.ObserveQuery("get_all_board_catalog", time.Now())
+	defer wrapReadError(&err)
+
+	board, err = scanCatalog(filterAllBoard(getOPs(), boards).
+		Where("not t.archived").
+		OrderBy(threadOrder(order)))
+	board.ThreadCount = len(board.Threads)
+	return
+}
+
+// Exclude threads aliased as "t", that should not be visible on the "/all/"
+// metaboard. This is the single place deciding board visibility on "/all/".
+// If boards is not empty, only threads from these boards are kept.
+func filterAllBoard(q squirrel.SelectBuilder, boards []string,
+) squirrel.SelectBuilder {
+	// Hide threads from NSFW boards, if enabled
+	if config.Get().HideNSFW {
+		q = q.Where("t.board not in (select id from boards where NSFW)")
+	}
+	if len(boards) != 0 {
+		q = q.Where(squirrel.Eq{"t.board": boards})
+	}
+	return q
 }
 
 func scanCatalog(q squirrel.SelectBuilder) (board common.Board, err error) {
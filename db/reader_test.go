@@ -11,6 +11,11 @@ import (
 	. "github.com/bakape/meguca/test"
 )
 
+var errNotFound = common.ReadError{
+	Code: common.NotFound,
+	Err:  sql.ErrNoRows,
+}
+
 var sampleModerationEntry = common.ModerationEntry{
 	Type:   common.BanPost,
 	Length: 0,
@@ -154,8 +159,164 @@ func TestReader(t *testing.T) {
 
 	t.Run("GetAllBoard", testGetAllBoard)
 	t.Run("GetBoard", testGetBoard)
+	t.Run("GetBoardCatalogEntries", testGetBoardCatalogEntries)
 	t.Run("GetPost", testGetPost)
+	t.Run("GetPosts", testGetPosts)
+	t.Run("GetPostContext", testGetPostContext)
+	t.Run("GetRecentPosts", testGetRecentPosts)
 	t.Run("GetThread", testGetThread)
+	t.Run("GetThreadCount", testGetThreadCount)
+	t.Run("GetThreadIDsPage", testGetThreadIDsPage)
+	t.Run("StreamThread", testStreamThread)
+}
+
+func TestGetThreadDefaultLastN(t *testing.T) {
+	prepareThreads(t)
+	config.ClearBoards()
+	defer config.ClearBoards()
+	_, err := config.SetBoardConfigs(config.BoardConfigs{
+		ID:           "a",
+		DefaultLastN: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	std, err := GetThread(1, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	thread, err := GetThread(1, -1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, thread, std)
+	AssertDeepEquals(t, len(thread.Posts), 1)
+}
+
+func TestInjectReplyIDs(t *testing.T) {
+	thread := common.Thread{
+		Post: common.Post{
+			ID: 1,
+		},
+		Posts: []common.Post{
+			{
+				ID: 2,
+				Links: []common.Link{
+					{ID: 1, OP: 1, Board: "a"},
+					{ID: 99, OP: 98, Board: "a"},
+				},
+			},
+			{
+				ID: 3,
+				Links: []common.Link{
+					{ID: 1, OP: 1, Board: "a"},
+					{ID: 2, OP: 1, Board: "a"},
+				},
+			},
+		},
+	}
+	injectReplyIDs(&thread)
+
+	AssertDeepEquals(t, thread.ReplyIDs, []uint64{2, 3})
+	AssertDeepEquals(t, thread.Posts[0].ReplyIDs, []uint64{3})
+	if thread.Posts[1].ReplyIDs != nil {
+		t.Fatalf("unexpected replies: %v", thread.Posts[1].ReplyIDs)
+	}
+}
+
+func TestStickyFirst(t *testing.T) {
+	assertTableClear(t, "boards")
+	writeSampleBoard(t)
+
+	// Mix of sticky and non-sticky threads with interleaved bump times
+	threads := [...]struct {
+		id       uint64
+		bumpTime int64
+		sticky   bool
+	}{
+		{1, 10, true},
+		{2, 40, false},
+		{3, 20, true},
+		{4, 30, false},
+	}
+	for _, th := range threads {
+		thread := Thread{
+			ID:         th.id,
+			Board:      "a",
+			UpdateTime: th.bumpTime,
+			BumpTime:   th.bumpTime,
+		}
+		op := Post{
+			StandalonePost: common.StandalonePost{
+				Post: common.Post{
+					ID: th.id,
+				},
+				OP:    th.id,
+				Board: "a",
+			},
+		}
+		if err := WriteThread(thread, op); err != nil {
+			t.Fatal(err)
+		}
+		if th.sticky {
+			if err := SetThreadSticky(th.id, true); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	std := []uint64{3, 1, 2, 4}
+
+	t.Run("bump order", func(t *testing.T) {
+		ids, _, _, err := GetThreadIDsPage("a", 0, 15, common.SortBump, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertDeepEquals(t, ids, std)
+
+		board, err := GetBoardCatalog("a", common.SortBump)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = make([]uint64, 0, len(board.Threads))
+		for _, th := range board.Threads {
+			ids = append(ids, th.ID)
+		}
+		AssertDeepEquals(t, ids, std)
+
+		entries, err := GetBoardCatalogEntries("a", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = make([]uint64, 0, len(entries))
+		for _, e := range entries {
+			ids = append(ids, e.ID)
+		}
+		AssertDeepEquals(t, ids, std)
+	})
+
+	t.Run("other orders", func(t *testing.T) {
+		for _, order := range [...]common.SortOrder{
+			common.SortCreation,
+			common.SortReplyCount,
+			common.SortImageCount,
+			common.SortLastReply,
+		} {
+			board, err := GetBoardCatalog("a", order)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(board.Threads) != len(threads) {
+				t.Fatalf("unexpected thread count: %d", len(board.Threads))
+			}
+			for i, th := range board.Threads {
+				if th.Sticky != (i < 2) {
+					t.Fatalf("sticky thread not first: %#v", board.Threads)
+				}
+			}
+		}
+	})
 }
 
 func testGetPost(t *testing.T) {
@@ -163,7 +324,7 @@ func testGetPost(t *testing.T) {
 
 	// Does not exist
 	post, err := GetPost(99)
-	if err != sql.ErrNoRows {
+	if !common.IsNotFound(err) {
 		UnexpectedError(t, err)
 	}
 	if !reflect.DeepEqual(post, common.StandalonePost{}) {
@@ -198,6 +359,120 @@ func testGetPost(t *testing.T) {
 	AssertDeepEquals(t, post, std)
 }
 
+func testGetPosts(t *testing.T) {
+	t.Parallel()
+
+	posts, err := GetPosts([]uint64{2, 4, 99})
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, posts, map[uint64]common.StandalonePost{
+		2: {
+			Post: common.Post{
+				ID:   2,
+				Body: "foo",
+			},
+			OP:    1,
+			Board: "a",
+		},
+		4: {
+			Post: common.Post{
+				ID: 4,
+			},
+			OP:    1,
+			Board: "a",
+		},
+	})
+
+	_, err = GetPosts(make([]uint64, MaxBatchPosts+1))
+	if err != ErrTooManyPosts {
+		UnexpectedError(t, err)
+	}
+}
+
+func testGetPostContext(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name   string
+		id     uint64
+		radius int
+		ids    []uint64
+		err    error
+	}{
+		{
+			name:   "whole thread",
+			id:     2,
+			radius: 10,
+			ids:    []uint64{1, 2, 4},
+		},
+		{
+			name:   "neighbours only",
+			id:     2,
+			radius: 1,
+			ids:    []uint64{1, 2},
+		},
+		{
+			name:   "does not cross threads",
+			id:     3,
+			radius: 1,
+			ids:    []uint64{3},
+		},
+		{
+			name: "nonexistent post",
+			id:   99,
+			err:  errNotFound,
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			posts, err := GetPostContext(c.id, c.radius)
+			if err != c.err {
+				UnexpectedError(t, err)
+			}
+			var ids []uint64
+			for _, p := range posts {
+				ids = append(ids, p.ID)
+			}
+			AssertDeepEquals(t, ids, c.ids)
+		})
+	}
+}
+
+func testGetRecentPosts(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name  string
+		limit int
+		ids   []uint64
+	}{
+		{"all", 0, []uint64{4, 3, 2, 1}},
+		{"limited", 2, []uint64{4, 3}},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			posts, err := GetRecentPosts(c.limit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids := make([]uint64, 0, len(posts))
+			for _, p := range posts {
+				ids = append(ids, p.ID)
+			}
+			AssertDeepEquals(t, ids, c.ids)
+		})
+	}
+}
+
 func testGetAllBoard(t *testing.T) {
 	t.Parallel()
 
@@ -223,6 +498,7 @@ func testGetAllBoard(t *testing.T) {
 			Board:      "c",
 			UpdateTime: 3,
 			BumpTime:   5,
+			MaxReplies: common.BumpLimit,
 		},
 		1: {
 			Post: common.Post{
@@ -231,15 +507,18 @@ func testGetAllBoard(t *testing.T) {
 				Moderated:  true,
 				Moderation: []common.ModerationEntry{sampleModerationEntry},
 			},
-			PostCount:  3,
-			ImageCount: 1,
-			Board:      "a",
-			UpdateTime: 1,
-			BumpTime:   1,
+			PostCount:     3,
+			ImageCount:    1,
+			UniquePosters: 1,
+			Board:         "a",
+			MaxReplies:    common.BumpLimit,
+			UpdateTime:    1,
+			BumpTime:      1,
+			LastImageTime: new(int64),
 		},
 	}
 
-	board, err := GetAllBoardCatalog()
+	board, err := GetAllBoardCatalog(common.SortBump, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -301,6 +580,7 @@ func testGetBoard(t *testing.T) {
 					Board:      "c",
 					UpdateTime: 3,
 					BumpTime:   5,
+					MaxReplies: common.BumpLimit,
 				},
 			},
 		},
@@ -316,7 +596,7 @@ func testGetBoard(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			t.Parallel()
 
-			board, err := GetBoardCatalog(c.id)
+			board, err := GetBoardCatalog(c.id, common.SortBump)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -331,6 +611,250 @@ func testGetBoard(t *testing.T) {
 	}
 }
 
+func testStreamThread(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name string
+		id   uint64
+		ids  []uint64
+		err  error
+	}{
+		{"with replies", 1, []uint64{1, 2, 4}, nil},
+		{"no replies", 3, []uint64{3}, nil},
+		{"nonexistent", 99, []uint64{}, errNotFound},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			posts, errc := StreamThread(c.id, nil)
+			ids := make([]uint64, 0, len(c.ids))
+			for p := range posts {
+				if p.ID == 1 && len(p.Moderation) == 0 {
+					t.Error("moderation not injected")
+				}
+				ids = append(ids, p.ID)
+			}
+			AssertDeepEquals(t, <-errc, c.err)
+			AssertDeepEquals(t, ids, c.ids)
+		})
+	}
+
+	t.Run("abort", func(t *testing.T) {
+		t.Parallel()
+
+		done := make(chan struct{})
+		posts, errc := StreamThread(1, done)
+		<-posts
+		close(done)
+		for range posts {
+		}
+		if err := <-errc; err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func testGetThreadCount(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name, board string
+		boards      []string
+		count       int
+	}{
+		{"board", "a", nil, 1},
+		{"empty board", "z", nil, 0},
+		{"all boards", "all", nil, 2},
+		{"board whitelist", "all", []string{"c"}, 1},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				n   int
+				err error
+			)
+			if c.board == "all" {
+				n, err = GetAllBoardThreadCount(c.boards)
+			} else {
+				n, err = GetThreadCount(c.board)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertDeepEquals(t, n, c.count)
+		})
+	}
+}
+
+func testGetThreadIDsPage(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name, board   string
+		page, perPage int
+		order         common.SortOrder
+		boards        []string
+		ids           []uint64
+		pages, total  int
+	}{
+		{
+			name:    "first page",
+			board:   "a",
+			perPage: 15,
+			ids:     []uint64{1},
+			pages:   1,
+			total:   1,
+		},
+		{
+			name:    "all boards",
+			board:   "all",
+			perPage: 1,
+			ids:     []uint64{3},
+			pages:   2,
+			total:   2,
+		},
+		{
+			name:    "second page",
+			board:   "all",
+			page:    1,
+			perPage: 1,
+			ids:     []uint64{1},
+			pages:   2,
+			total:   2,
+		},
+		{
+			name:    "by creation",
+			board:   "all",
+			perPage: 1,
+			order:   common.SortCreation,
+			ids:     []uint64{3},
+			pages:   2,
+			total:   2,
+		},
+		{
+			name:    "by reply count",
+			board:   "all",
+			perPage: 1,
+			order:   common.SortReplyCount,
+			ids:     []uint64{1},
+			pages:   2,
+			total:   2,
+		},
+		{
+			name:    "by image count",
+			board:   "all",
+			perPage: 1,
+			order:   common.SortImageCount,
+			ids:     []uint64{1},
+			pages:   2,
+			total:   2,
+		},
+		{
+			name:    "by last reply",
+			board:   "all",
+			perPage: 1,
+			order:   common.SortLastReply,
+			ids:     []uint64{3},
+			pages:   2,
+			total:   2,
+		},
+		{
+			name:    "board whitelist",
+			board:   "all",
+			perPage: 15,
+			boards:  []string{"a"},
+			ids:     []uint64{1},
+			pages:   1,
+			total:   1,
+		},
+		{
+			name:    "page overflow",
+			board:   "a",
+			page:    1,
+			perPage: 15,
+			ids:     []uint64{},
+			pages:   1,
+			total:   1,
+		},
+		{
+			name:    "empty board",
+			board:   "z",
+			perPage: 15,
+			ids:     []uint64{},
+			pages:   1,
+			total:   0,
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			ids, pages, total, err := GetThreadIDsPage(c.board, c.page,
+				c.perPage, c.order, c.boards)
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertDeepEquals(t, ids, c.ids)
+			AssertDeepEquals(t, pages, c.pages)
+			AssertDeepEquals(t, total, c.total)
+		})
+	}
+}
+
+func testGetBoardCatalogEntries(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name, id string
+		std      []common.CatalogEntry
+	}{
+		{
+			name: "with image",
+			id:   "a",
+			std: []common.CatalogEntry{
+				{
+					ID:         1,
+					PostCount:  3,
+					ImageCount: 1,
+					BumpTime:   1,
+					Thumbnail: assets.ThumbPath(
+						assets.StdJPEG.ThumbType,
+						assets.StdJPEG.SHA1,
+					),
+				},
+			},
+		},
+		{
+			name: "empty",
+			id:   "z",
+			std:  []common.CatalogEntry{},
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			entries, err := GetBoardCatalogEntries(c.id, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertDeepEquals(t, entries, c.std)
+		})
+	}
+}
+
 // Sync variables that are generated from external state and can not be easily
 // tested
 func syncThreadVariables(dst *common.Thread, src common.Thread) {
@@ -338,17 +862,21 @@ func syncThreadVariables(dst *common.Thread, src common.Thread) {
 	dst.UpdateTime = src.UpdateTime
 	dst.Time = src.Time
 	dst.BumpTime = src.BumpTime
+	dst.LastReplyTime = src.LastReplyTime
 }
 
 func testGetThread(t *testing.T) {
 	t.Parallel()
 
 	thread1 := common.Thread{
-		PostCount:  3,
-		ImageCount: 1,
-		UpdateTime: 1,
-		BumpTime:   1,
-		Board:      "a",
+		PostCount:     3,
+		ImageCount:    1,
+		UniquePosters: 1,
+		UpdateTime:    1,
+		MaxReplies:    common.BumpLimit,
+		BumpTime:      1,
+		LastImageTime: new(int64),
+		Board:         "a",
 		Post: common.Post{
 			ID:         1,
 			Image:      &assets.StdJPEG,
@@ -368,13 +896,18 @@ func testGetThread(t *testing.T) {
 	sliced := thread1
 	sliced.Posts = sliced.Posts[1:]
 	sliced.Abbrev = true
+	sliced.HasMore = true
+	offset := thread1
+	offset.Posts = offset.Posts[1:]
+	offset.HasMore = true
 
 	cases := [...]struct {
-		name  string
-		id    uint64
-		lastN int
-		std   common.Thread
-		err   error
+		name      string
+		id        uint64
+		lastN     int
+		startFrom uint64
+		std       common.Thread
+		err       error
 	}{
 		{
 			name: "full",
@@ -387,6 +920,12 @@ func testGetThread(t *testing.T) {
 			lastN: 1,
 			std:   sliced,
 		},
+		{
+			name:      "start from offset",
+			id:        1,
+			startFrom: 3,
+			std:       offset,
+		},
 		{
 			name: "no replies ;_;",
 			id:   3,
@@ -395,6 +934,7 @@ func testGetThread(t *testing.T) {
 				UpdateTime: 3,
 				BumpTime:   5,
 				PostCount:  1,
+				MaxReplies: common.BumpLimit,
 				Post: common.Post{
 					ID: 3,
 					Links: []common.Link{
@@ -417,7 +957,7 @@ func testGetThread(t *testing.T) {
 		{
 			name: "nonexistent thread",
 			id:   99,
-			err:  sql.ErrNoRows,
+			err:  errNotFound,
 		},
 	}
 
@@ -426,7 +966,7 @@ func testGetThread(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			t.Parallel()
 
-			thread, err := GetThread(c.id, c.lastN)
+			thread, err := GetThread(c.id, c.lastN, c.startFrom)
 			if err != c.err {
 				UnexpectedError(t, err)
 			}
@@ -436,3 +976,92 @@ func testGetThread(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildQuoteChain(t *testing.T) {
+	t.Parallel()
+
+	link := func(id uint64) common.Link {
+		return common.Link{ID: id, OP: 1, Board: "a"}
+	}
+	thread := common.Thread{
+		Post: common.Post{
+			ID:   1,
+			Body: "OP",
+		},
+		Posts: []common.Post{
+			{
+				ID:    2,
+				Body:  ">>1",
+				Links: []common.Link{link(1), link(1)},
+			},
+			{
+				ID:    3,
+				Links: []common.Link{link(2)},
+				Moderation: []common.ModerationEntry{
+					{Type: common.DeletePost, By: "admin"},
+				},
+			},
+			{
+				ID:    4,
+				Body:  ">>3 >>1",
+				Links: []common.Link{link(3), link(1)},
+			},
+			{
+				ID:    5,
+				Body:  ">>>/b/6",
+				Links: []common.Link{{ID: 6, OP: 6, Board: "b"}},
+			},
+		},
+	}
+	leaf := func(p common.Post) common.QuoteNode {
+		return common.QuoteNode{Post: p, Children: []common.QuoteNode{}}
+	}
+	reply4 := leaf(thread.Posts[2])
+	deleted := common.QuoteNode{
+		Post:     common.Post{ID: 3, Body: "[deleted]"},
+		Children: []common.QuoteNode{reply4},
+	}
+
+	t.Run("full tree", func(t *testing.T) {
+		t.Parallel()
+
+		node, err := buildQuoteChain(thread, 1, MaxQuoteChainDepth)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertDeepEquals(t, node, common.QuoteNode{
+			Post: thread.Post,
+			Children: []common.QuoteNode{
+				{
+					Post:     thread.Posts[0],
+					Children: []common.QuoteNode{deleted},
+				},
+				reply4,
+			},
+		})
+	})
+
+	t.Run("depth limited", func(t *testing.T) {
+		t.Parallel()
+
+		node, err := buildQuoteChain(thread, 2, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		deleted := deleted
+		deleted.Children = []common.QuoteNode{}
+		AssertDeepEquals(t, node, common.QuoteNode{
+			Post:     thread.Posts[0],
+			Children: []common.QuoteNode{deleted},
+		})
+	})
+
+	t.Run("not in thread", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := buildQuoteChain(thread, 6, 1)
+		if err != sql.ErrNoRows {
+			UnexpectedError(t, err)
+		}
+	})
+}
@@ -8,7 +8,8 @@ import (
 	"github.com/go-playground/log"
 )
 
-// Report a post for rule violations
+// Report a post for rule violations. Repeated reports of the same post by the
+// same IP are ignored.
 func Report(id uint64, board, reason, ip string, illegal bool) error {
 	// If the reported content is illegal, log an error so it will email
 	if illegal {
@@ -20,21 +21,24 @@ func Report(id uint64, board, reason, ip string, illegal bool) error {
 	_, err := sq.Insert("reports").
 		Columns("target", "board", "reason", "by", "illegal").
 		Values(id, board, reason, ip, illegal).
+		Suffix("on conflict (target, by) do nothing").
 		Exec()
 
 	return err
 }
 
-// GetReports reads reports for a specific board. Pass "all" for global reports.
-func GetReports(board string) (rep []auth.Report, err error) {
+// GetReports reads either resolved or unresolved reports for a specific board.
+// Pass "all" for global reports.
+func GetReports(board string, resolved bool) (rep []auth.Report, err error) {
 	tmp := auth.Report{
-		Board: board,
+		Board:    board,
+		Resolved: resolved,
 	}
 	rep = make([]auth.Report, 0, 64)
 	err = queryAll(
 		sq.Select("id", "target", "reason", "created").
 			From("reports").
-			Where("board = ?", board).
+			Where("board = ? and resolved = ?", board, resolved).
 			OrderBy("created desc"),
 		func(r *sql.Rows) (err error) {
 			err = r.Scan(&tmp.ID, &tmp.Target, &tmp.Reason, &tmp.Created)
@@ -47,3 +51,12 @@ func GetReports(board string) (rep []auth.Report, err error) {
 	)
 	return
 }
+
+// ResolveReport marks a report on a board as handled
+func ResolveReport(id uint64, board string) (err error) {
+	_, err = sq.Update("reports").
+		Set("resolved", true).
+		Where("id = ? and board = ?", id, board).
+		Exec()
+	return
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
 	. "github.com/bakape/meguca/test"
 	"testing"
 )
@@ -16,12 +17,15 @@ func TestReports(t *testing.T) {
 		Board:  "a",
 		Reason: "foo",
 	}
-	err := Report(std.Target, std.Board, std.Reason, "::1", false)
-	if err != nil {
-		t.Fatal(err)
+	// Second report by the same IP is ignored
+	for i := 0; i < 2; i++ {
+		err := Report(std.Target, std.Board, std.Reason, "::1", false)
+		if err != nil {
+			t.Fatal(err)
+		}
 	}
 
-	res, err := GetReports(std.Board)
+	res, err := GetReports(std.Board, false)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -29,4 +33,46 @@ func TestReports(t *testing.T) {
 	std.ID = res[0].ID
 	std.Created = res[0].Created
 	AssertDeepEquals(t, []auth.Report{std}, res)
+
+	err = ResolveReport(std.ID, std.Board)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err = GetReports(std.Board, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, res, []auth.Report{})
+
+	res, err = GetReports(std.Board, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	std.Resolved = true
+	AssertDeepEquals(t, []auth.Report{std}, res)
+}
+
+func TestResolveReportsOnDeletion(t *testing.T) {
+	p := insertPost(t)
+	assertTableClear(t, "reports")
+	err := Report(p.ID, "a", "foo", "::1", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = moderatePost(p.ID,
+		common.ModerationEntry{
+			Type: common.DeletePost,
+			By:   "admin",
+		},
+		nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := GetReports("a", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, len(res), 1)
 }
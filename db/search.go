@@ -0,0 +1,171 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+)
+
+const (
+	// SearchPageSize is the number of posts on one page of search results
+	SearchPageSize = 20
+
+	// MaxSearchPage is the last retrievable page of search results. Keeps
+	// clients from forcing the database to scan arbitrarily large offsets.
+	MaxSearchPage = 50
+
+	// Number of characters of post body to include around a search match
+	snippetContext = 20
+)
+
+// Escapes LIKE pattern wildcards, so user input only ever matches literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// SearchResult is a post, whose body matched a search query
+type SearchResult struct {
+	common.StandalonePost
+	// Subject of the post's thread
+	Subject string `json:"subject"`
+	// Part of the post body around the first match
+	Snippet string `json:"snippet"`
+}
+
+// SearchPosts retrieves a page of posts, whose bodies contain the query
+// string, sorted by time descending. Matching is case-insensitive. If board is
+// empty, posts from all boards visible on /all/ are searched. Pages past
+// MaxSearchPage are always empty.
+func SearchPosts(query, board string, page uint) (
+	res []SearchResult, err error,
+) {
+	if page > MaxSearchPage {
+		return []SearchResult{}, nil
+	}
+	defer wrapReadError(&err)
+
+	q := searchablePosts().
+		Where(`p.body ilike '%' || ? || '%'`, likeEscaper.Replace(query)).
+		OrderBy("p.time desc", "p.id desc").
+		Offset(uint64(page) * SearchPageSize).
+		Limit(SearchPageSize)
+	if board != "" {
+		q = q.Where("p.board = ?", board)
+	} else if config.Get().HideNSFW {
+		q = q.Where("p.board not in (select id from boards where NSFW)")
+	}
+	posts, err := scanStandalonePosts(q)
+	if err != nil {
+		return
+	}
+
+	ops := make([]uint64, 0, len(posts))
+	for _, p := range posts {
+		ops = append(ops, p.OP)
+	}
+	subjects := make(map[uint64]string, len(ops))
+	err = queryAll(
+		sq.Select("id", "subject").
+			From("threads").
+			Where("id = any(?::bigint[])", encodeUint64Array(ops)),
+		func(r *sql.Rows) (err error) {
+			var (
+				id      uint64
+				subject string
+			)
+			err = r.Scan(&id, &subject)
+			if err != nil {
+				return
+			}
+			subjects[id] = subject
+			return
+		},
+	)
+	if err != nil {
+		return
+	}
+
+	res = make([]SearchResult, len(posts))
+	for i, p := range posts {
+		res[i] = SearchResult{
+			StandalonePost: p,
+			Subject:        subjects[p.OP],
+			Snippet:        searchSnippet(p.Body, query),
+		}
+	}
+	return
+}
+
+// SearchImage retrieves the posts with an image matching the MD5 hash or, if
+// md5 is empty, the SHA-256 hash. Returns at most MaxBatchPosts posts sorted by
+// time descending.
+func SearchImage(md5, sha256 string) (
+	posts []common.StandalonePost, err error,
+) {
+	defer wrapReadError(&err)
+
+	if md5 == "" && sha256 == "" {
+		return []common.StandalonePost{}, nil
+	}
+	q := searchablePosts().
+		OrderBy("p.time desc", "p.id desc").
+		Limit(MaxBatchPosts)
+	if md5 != "" {
+		q = q.Where("i.md5 = ?", md5)
+	} else {
+		q = q.Where("i.sha256 = ?", sha256)
+	}
+	if config.Get().HideNSFW {
+		q = q.Where("p.board not in (select id from boards where NSFW)")
+	}
+	return scanStandalonePosts(q)
+}
+
+// Select posts, that have not been deleted by staff
+func searchablePosts() squirrel.SelectBuilder {
+	return getStandalonePosts().
+		Where(fmt.Sprintf(
+			`not exists (
+				select 1 from post_moderation
+				where post_id = p.id and type = %d)`,
+			common.DeletePost,
+		))
+}
+
+// Returns the first match of query in body with up to snippetContext
+// characters on each side. Falls back to the start of the body, if the body
+// no longer contains the query, because an open post was edited since.
+func searchSnippet(body, query string) string {
+	b := []rune(body)
+	q := []rune(query)
+	start, end := 0, 0
+	for i := 0; i+len(q) <= len(b); i++ {
+		if foldedEqual(b[i:i+len(q)], q) {
+			start, end = i, i+len(q)
+			break
+		}
+	}
+
+	start -= snippetContext
+	if start < 0 {
+		start = 0
+	}
+	end += snippetContext
+	if end > len(b) {
+		end = len(b)
+	}
+	return string(b[start:end])
+}
+
+// Compare rune slices of equal length case-insensitively
+func foldedEqual(a, b []rune) bool {
+	for i := range a {
+		if unicode.ToLower(a[i]) != unicode.ToLower(b[i]) {
+			return false
+		}
+	}
+	return true
+}
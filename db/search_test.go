@@ -0,0 +1,153 @@
+package db
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/imager/assets"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestSearchPosts(t *testing.T) {
+	assertTableClear(t, "boards")
+	writeSampleBoard(t)
+
+	err := WriteThread(
+		Thread{
+			ID:      1,
+			Board:   "a",
+			Subject: "Tea",
+		},
+		Post{
+			StandalonePost: common.StandalonePost{
+				Post: common.Post{
+					ID:   1,
+					Time: time.Now().Unix(),
+					Body: "Green tea is the best tea",
+				},
+				OP:    1,
+				Board: "a",
+			},
+			IP: "::1",
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = InTransaction(false, func(tx *sql.Tx) error {
+		return WritePost(tx, Post{
+			StandalonePost: common.StandalonePost{
+				Post: common.Post{
+					ID:   2,
+					Time: time.Now().Unix() + 1,
+					Body: "100% GREEN",
+				},
+				OP:    1,
+				Board: "a",
+			},
+			IP: "::1",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := [...]struct {
+		name, query, board string
+		page               uint
+		ids                []uint64
+	}{
+		{"case-insensitive", "green", "", 0, []uint64{2, 1}},
+		{"by board", "green", "a", 0, []uint64{2, 1}},
+		{"other board", "green", "c", 0, []uint64{}},
+		{"no match", "coffee", "", 0, []uint64{}},
+		{"literal wildcard", "0%", "", 0, []uint64{2}},
+		{"escaped wildcard", "t_a", "", 0, []uint64{}},
+		{"page overflow", "green", "", 1, []uint64{}},
+		{"past last page", "green", "", MaxSearchPage + 1, []uint64{}},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			res, err := SearchPosts(c.query, c.board, c.page)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids := make([]uint64, len(res))
+			for i, r := range res {
+				ids[i] = r.ID
+				AssertDeepEquals(t, r.Subject, "Tea")
+			}
+			AssertDeepEquals(t, ids, c.ids)
+		})
+	}
+}
+
+func TestSearchImage(t *testing.T) {
+	assertTableClear(t, "images", "boards")
+	std := assets.StdJPEG.ImageCommon
+	std.SHA256 = strings.Repeat("a", 64)
+	if err := WriteImage(std); err != nil {
+		t.Fatal(err)
+	}
+	writeSampleBoard(t)
+	writeSampleThread(t)
+	insertSampleImage(t)
+
+	cases := [...]struct {
+		name, md5, sha256 string
+		ids               []uint64
+	}{
+		{"by MD5", std.MD5, "", []uint64{1}},
+		{"by SHA-256", "", std.SHA256, []uint64{1}},
+		{"no match", GenString(22), "", []uint64{}},
+		{"no hash", "", "", []uint64{}},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			posts, err := SearchImage(c.md5, c.sha256)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids := make([]uint64, len(posts))
+			for i, p := range posts {
+				ids[i] = p.ID
+				AssertDeepEquals(t, p.Image.SHA256, std.SHA256)
+			}
+			AssertDeepEquals(t, ids, c.ids)
+		})
+	}
+}
+
+func TestSearchSnippet(t *testing.T) {
+	t.Parallel()
+
+	long := "The quick brown fox jumps over the lazy dog and runs far away"
+	cases := [...]struct {
+		name, body, query, snippet string
+	}{
+		{"short body", "Green tea", "tea", "Green tea"},
+		{
+			"context",
+			long,
+			"LAZY",
+			" fox jumps over the lazy dog and runs far aw",
+		},
+		{"no match", long, "cat", "The quick brown fox "},
+		{"multibyte", "日本語のテキスト", "テキ", "日本語のテキスト"},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			AssertDeepEquals(t, searchSnippet(c.body, c.query), c.snippet)
+		})
+	}
+}
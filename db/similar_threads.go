@@ -0,0 +1,107 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/bakape/meguca/common"
+	"github.com/lib/pq"
+)
+
+const (
+	// Minimum Jaccard similarity of subject trigram sets for threads to be
+	// considered similar
+	similarThreadThreshold = 0.6
+
+	// Maximum number of similar threads to return
+	maxSimilarThreads = 5
+)
+
+// FindSimilarThreads retrieves up to 5 unarchived threads on a board, whose
+// subjects are similar to the passed subject, sorted by similarity descending.
+// Only the ID, board and subject of the threads are set.
+func FindSimilarThreads(subject, board string) (threads []Thread, err error) {
+	threads = make([]Thread, 0, maxSimilarThreads)
+	trigrams := subjectTrigrams(subject)
+	if len(trigrams) == 0 {
+		return
+	}
+
+	err = queryAll(
+		sq.Select("t.id", "t.board", "t.subject").
+			From("threads as t").
+			Join(
+				`(select tt.thread_id,
+					count(*)::float / (
+						(select count(*)
+							from thread_trigrams as c
+							where c.thread_id = tt.thread_id)
+						+ ? - count(*)
+					) as similarity
+				from thread_trigrams as tt
+				where tt.trigram = any(?::text[])
+				group by tt.thread_id) as s on s.thread_id = t.id`,
+				len(trigrams), pq.StringArray(trigrams),
+			).
+			Where("t.board = ? and not t.archived", board).
+			Where("s.similarity > ?", similarThreadThreshold).
+			Where(fmt.Sprintf(
+				`not exists (
+					select 1 from post_moderation
+					where post_id = t.id and type = %d)`,
+				common.DeletePost,
+			)).
+			OrderBy("s.similarity desc", "t.bump_time desc").
+			Limit(maxSimilarThreads),
+		func(r *sql.Rows) (err error) {
+			var t Thread
+			err = r.Scan(&t.ID, &t.Board, &t.Subject)
+			if err != nil {
+				return
+			}
+			threads = append(threads, t)
+			return
+		},
+	)
+	return
+}
+
+// Write the trigram set of a thread's subject for similarity lookups
+func writeThreadTrigrams(tx *sql.Tx, id uint64, subject string) (err error) {
+	trigrams := subjectTrigrams(subject)
+	if len(trigrams) == 0 {
+		return
+	}
+	q := sq.Insert("thread_trigrams").Columns("thread_id", "trigram")
+	for _, t := range trigrams {
+		q = q.Values(id, t)
+	}
+	_, err = q.RunWith(tx).Exec()
+	return
+}
+
+// Split a subject into the sorted set of its case-insensitive word trigrams.
+// Like pg_trgm, each word is padded with two spaces in front and one at the
+// end, so short words and word boundaries still produce trigrams.
+func subjectTrigrams(s string) []string {
+	words := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	set := make(map[string]struct{}, len(s))
+	for _, w := range words {
+		r := []rune("  " + w + " ")
+		for i := 0; i+3 <= len(r); i++ {
+			set[string(r[i:i+3])] = struct{}{}
+		}
+	}
+
+	trigrams := make([]string, 0, len(set))
+	for t := range set {
+		trigrams = append(trigrams, t)
+	}
+	sort.Strings(trigrams)
+	return trigrams
+}
@@ -0,0 +1,73 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestSubjectTrigrams(t *testing.T) {
+	t.Parallel()
+
+	AssertDeepEquals(t, subjectTrigrams(""), []string{})
+	AssertDeepEquals(t, subjectTrigrams("Hi, HI!"), []string{
+		"  h", " hi", "hi ",
+	})
+}
+
+func TestFindSimilarThreads(t *testing.T) {
+	assertTableClear(t, "boards")
+	writeSampleBoard(t)
+
+	subjects := [...]string{
+		"Linux distro general",
+		"Who is your favourite touhou",
+		"Linux distro general thread",
+	}
+	for i, s := range subjects {
+		id := uint64(i + 1)
+		thread := Thread{
+			ID:      id,
+			Board:   "a",
+			Subject: s,
+		}
+		op := Post{
+			StandalonePost: common.StandalonePost{
+				Post: common.Post{
+					ID: id,
+				},
+				OP:    id,
+				Board: "a",
+			},
+		}
+		if err := WriteThread(thread, op); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cases := [...]struct {
+		name, subject, board string
+		ids                  []uint64
+	}{
+		{"similar", "linux distro general", "a", []uint64{1, 3}},
+		{"different", "Anime recommendations", "a", []uint64{}},
+		{"other board", "Linux distro general", "c", []uint64{}},
+		{"empty", "", "a", []uint64{}},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			threads, err := FindSimilarThreads(c.subject, c.board)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids := make([]uint64, 0, len(threads))
+			for _, th := range threads {
+				ids = append(ids, th.ID)
+			}
+			AssertDeepEquals(t, ids, c.ids)
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package db
+
+import (
+	"database/sql"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bakape/meguca/common"
+)
+
+const (
+	// Time to cache board statistics for
+	boardStatsExpiry = time.Minute
+
+	// Time to cache the activity of all boards for
+	boardActivityExpiry = 30 * time.Second
+
+	// Time to cache trending threads for
+	trendingExpiry = time.Minute
+
+	// MaxTrendingThreads is the maximum number of threads retrievable with
+	// GetTrendingThreads()
+	MaxTrendingThreads = 50
+)
+
+var (
+	boardStatsCache sync.Map
+
+	boardActivityCache   map[string]BoardActivity
+	boardActivityFetched time.Time
+	boardActivityCacheMu sync.Mutex
+
+	trendingCache   = make(map[string]cachedTrending)
+	trendingCacheMu sync.Mutex
+)
+
+// BoardStats contains aggregate post counts of a board
+type BoardStats struct {
+	Posts         uint64 `json:"posts"`
+	Images        uint64 `json:"images"`
+	UniquePosters uint64 `json:"unique_posters"`
+	PostsLastDay  uint64 `json:"posts_last_day"`
+}
+
+type cachedBoardStats struct {
+	BoardStats
+	fetched time.Time
+}
+
+// GetBoardStats retrieves aggregate post counts of a board. Results are cached
+// for a minute.
+func GetBoardStats(board string) (s BoardStats, err error) {
+	if v, ok := boardStatsCache.Load(board); ok {
+		cached := v.(cachedBoardStats)
+		if time.Since(cached.fetched) < boardStatsExpiry {
+			return cached.BoardStats, nil
+		}
+	}
+
+	err = sq.
+		Select("count(*)", "count(SHA1)", "count(distinct ip)").
+		Column(
+			"count(*) filter (where time > ?)",
+			time.Now().Add(-24*time.Hour).Unix(),
+		).
+		From("posts").
+		Where("board = ?", board).
+		QueryRow().
+		Scan(&s.Posts, &s.Images, &s.UniquePosters, &s.PostsLastDay)
+	if err != nil {
+		return
+	}
+	boardStatsCache.Store(board, cachedBoardStats{
+		BoardStats: s,
+		fetched:    time.Now(),
+	})
+	return
+}
+
+// BoardActivity contains the total post count of a board and the number of
+// posts made in the last hour
+type BoardActivity struct {
+	Posts, PostsPerHour uint64
+}
+
+// GetBoardActivity retrieves the activity of all boards, that have any posts.
+// Results are cached for 30 seconds. Do not modify the returned map.
+func GetBoardActivity() (act map[string]BoardActivity, err error) {
+	boardActivityCacheMu.Lock()
+	act = boardActivityCache
+	fresh := act != nil && time.Since(boardActivityFetched) < boardActivityExpiry
+	boardActivityCacheMu.Unlock()
+	if fresh {
+		return
+	}
+
+	act = make(map[string]BoardActivity, 64)
+	err = queryAll(
+		sq.Select("board", "count(*)").
+			Column(
+				"count(*) filter (where time > ?)",
+				time.Now().Add(-time.Hour).Unix(),
+			).
+			From("posts").
+			GroupBy("board"),
+		func(r *sql.Rows) (err error) {
+			var (
+				board string
+				a     BoardActivity
+			)
+			err = r.Scan(&board, &a.Posts, &a.PostsPerHour)
+			if err != nil {
+				return
+			}
+			act[board] = a
+			return
+		},
+	)
+	if err != nil {
+		return
+	}
+	boardActivityCacheMu.Lock()
+	boardActivityCache = act
+	boardActivityFetched = time.Now()
+	boardActivityCacheMu.Unlock()
+	return
+}
+
+type cachedTrending struct {
+	threads []common.Thread
+	fetched time.Time
+}
+
+// GetTrendingThreads retrieves the OPs of up to limit unarchived threads of a
+// board with the highest common.TrendingScore, sorted by score descending.
+// board can be "all". limit is capped at MaxTrendingThreads. Results are cached
+// for a minute. Do not modify the returned slice.
+func GetTrendingThreads(board string, limit int) (
+	threads []common.Thread, err error,
+) {
+	switch {
+	case limit <= 0:
+		return []common.Thread{}, nil
+	case limit > MaxTrendingThreads:
+		limit = MaxTrendingThreads
+	}
+
+	trendingCacheMu.Lock()
+	cached, ok := trendingCache[board]
+	trendingCacheMu.Unlock()
+
+	if !ok || time.Since(cached.fetched) >= trendingExpiry {
+		var b common.Board
+		if board == "all" {
+			b, err = GetAllBoardCatalog(common.SortBump, nil)
+		} else {
+			b, err = GetBoardCatalog(board, common.SortBump)
+		}
+		if err != nil {
+			return
+		}
+		cached = cachedTrending{
+			threads: rankTrending(b.Threads),
+			fetched: time.Now(),
+		}
+		trendingCacheMu.Lock()
+		trendingCache[board] = cached
+		trendingCacheMu.Unlock()
+	}
+
+	threads = cached.threads
+	if len(threads) > limit {
+		threads = threads[:limit]
+	}
+	return
+}
+
+// Sort threads by trending score descending and keep only the top
+// MaxTrendingThreads
+func rankTrending(threads []common.Thread) []common.Thread {
+	scores := make(map[uint64]float64, len(threads))
+	for _, t := range threads {
+		scores[t.ID] = common.TrendingScore(
+			t.PostCount,
+			t.ImageCount,
+			time.Unix(t.LastReplyTime, 0),
+			time.Unix(t.Time, 0),
+		)
+	}
+	sort.SliceStable(threads, func(i, j int) bool {
+		return scores[threads[i].ID] > scores[threads[j].ID]
+	})
+	if len(threads) > MaxTrendingThreads {
+		threads = threads[:MaxTrendingThreads]
+	}
+	return threads
+}
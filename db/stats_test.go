@@ -0,0 +1,90 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestGetBoardStats(t *testing.T) {
+	assertTableClear(t, "boards")
+	writeSampleBoard(t)
+	writeSampleThread(t)
+
+	s, err := GetBoardStats("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, s, BoardStats{
+		Posts:         1,
+		UniquePosters: 1,
+		PostsLastDay:  1,
+	})
+}
+
+func TestGetBoardActivity(t *testing.T) {
+	assertTableClear(t, "boards")
+	writeSampleBoard(t)
+	writeSampleThread(t)
+	boardActivityCache = nil
+
+	act, err := GetBoardActivity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, act, map[string]BoardActivity{
+		"a": {
+			Posts:        1,
+			PostsPerHour: 1,
+		},
+	})
+}
+
+func TestGetTrendingThreads(t *testing.T) {
+	assertTableClear(t, "boards")
+	writeSampleBoard(t)
+	writeSampleThread(t)
+	trendingCache = make(map[string]cachedTrending)
+
+	threads, err := GetTrendingThreads("a", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(threads) != 1 || threads[0].ID != 1 {
+		t.Fatalf("unexpected threads: %#v", threads)
+	}
+
+	threads, err = GetTrendingThreads("a", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, threads, []common.Thread{})
+}
+
+func TestRankTrending(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Unix()
+	thread := func(id uint64, posts uint32, age int64) common.Thread {
+		return common.Thread{
+			PostCount:     posts,
+			LastReplyTime: now,
+			Post: common.Post{
+				ID:   id,
+				Time: now - age*3600,
+			},
+		}
+	}
+	threads := rankTrending([]common.Thread{
+		thread(1, 1, 0),
+		thread(2, 50, 48),
+		thread(3, 50, 1),
+	})
+	ids := make([]uint64, 0, len(threads))
+	for _, t := range threads {
+		ids = append(ids, t.ID)
+	}
+	AssertDeepEquals(t, ids, []uint64{3, 2, 1})
+}
@@ -0,0 +1,103 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+)
+
+var (
+	errInvalidPostSession = common.ErrInvalidInput("invalid post session")
+	errNoThread           = common.ErrInvalidInput("thread does not exist")
+)
+
+// ThreadWatchSummary contains the post count of a thread watched within a
+// post session and the number of posts not yet seen by the session
+type ThreadWatchSummary struct {
+	ThreadID  uint64 `json:"id"`
+	PostCount uint64 `json:"post_count"`
+	NewPosts  int    `json:"new_posts"`
+	Board     string `json:"board"`
+}
+
+// Returns the expiry time of a thread watch refreshed now
+func watchExpiry() time.Time {
+	return time.Now().
+		Add(time.Duration(config.Get().SessionExpiry) * time.Hour * 24)
+}
+
+// WatchThread adds a thread to the threads watched within a post session.
+// All current posts of the thread are considered seen.
+func WatchThread(token string, id uint64) (err error) {
+	if len(token) != common.LenPostSession {
+		return errInvalidPostSession
+	}
+	_, err = db.Exec(
+		`insert into thread_watches (token, thread_id, last_seen, expires)
+		values ($1, $2, post_count($2), $3)
+		on conflict (token, thread_id) do update
+			set expires = excluded.expires`,
+		token, id, watchExpiry(),
+	)
+	if IsForeignKeyViolation(err) {
+		err = errNoThread
+	}
+	return
+}
+
+// UnwatchThread removes a thread from the threads watched within a post
+// session
+func UnwatchThread(token string, id uint64) (err error) {
+	_, err = sq.Delete("thread_watches").
+		Where("token = ? and thread_id = ?", token, id).
+		Exec()
+	return
+}
+
+// UpdateLastSeen records the number of posts in a watched thread the client
+// has acknowledged and extends the watch's expiry time
+func UpdateLastSeen(token string, id, count uint64) (err error) {
+	_, err = sq.Update("thread_watches").
+		SetMap(map[string]interface{}{
+			"last_seen": count,
+			"expires":   watchExpiry(),
+		}).
+		Where("token = ? and thread_id = ?", token, id).
+		Exec()
+	return
+}
+
+// GetWatchedThreads retrieves summaries of all threads watched within a post
+// session. Threads are sorted by ID.
+func GetWatchedThreads(token string) (watched []ThreadWatchSummary, err error) {
+	watched = make([]ThreadWatchSummary, 0, 16)
+	if len(token) != common.LenPostSession {
+		return
+	}
+	err = queryAll(
+		sq.Select("w.thread_id", "t.board", "post_count(w.thread_id)",
+			"w.last_seen").
+			From("thread_watches as w").
+			Join("threads as t on t.id = w.thread_id").
+			Where("w.token = ?", token).
+			OrderBy("w.thread_id"),
+		func(r *sql.Rows) (err error) {
+			var (
+				s        ThreadWatchSummary
+				lastSeen uint64
+			)
+			err = r.Scan(&s.ThreadID, &s.Board, &s.PostCount, &lastSeen)
+			if err != nil {
+				return
+			}
+			if s.PostCount > lastSeen {
+				s.NewPosts = int(s.PostCount - lastSeen)
+			}
+			watched = append(watched, s)
+			return
+		},
+	)
+	return
+}
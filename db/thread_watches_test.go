@@ -0,0 +1,68 @@
+package db
+
+import (
+	"testing"
+
+	. "github.com/bakape/meguca/test"
+)
+
+func TestThreadWatches(t *testing.T) {
+	assertTableClear(t, "boards")
+	writeSampleBoard(t)
+	writeSampleThread(t)
+
+	const token = "ZlkHbNYfd7grqUvHJ5DMmD8MkbCLDqDmf8RnLaxvULw"
+
+	if err := WatchThread("foo", 1); err != errInvalidPostSession {
+		UnexpectedError(t, err)
+	}
+	if err := WatchThread(token, 99); err != errNoThread {
+		UnexpectedError(t, err)
+	}
+	// Watching twice is a NOP
+	for i := 0; i < 2; i++ {
+		if err := WatchThread(token, 1); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	assertWatched := func(t *testing.T, std []ThreadWatchSummary) {
+		t.Helper()
+		res, err := GetWatchedThreads(token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertDeepEquals(t, res, std)
+	}
+
+	t.Run("all seen", func(t *testing.T) {
+		assertWatched(t, []ThreadWatchSummary{
+			{
+				ThreadID:  1,
+				Board:     "a",
+				PostCount: 1,
+			},
+		})
+	})
+
+	t.Run("new posts", func(t *testing.T) {
+		if err := UpdateLastSeen(token, 1, 0); err != nil {
+			t.Fatal(err)
+		}
+		assertWatched(t, []ThreadWatchSummary{
+			{
+				ThreadID:  1,
+				Board:     "a",
+				PostCount: 1,
+				NewPosts:  1,
+			},
+		})
+	})
+
+	t.Run("unwatch", func(t *testing.T) {
+		if err := UnwatchThread(token, 1); err != nil {
+			t.Fatal(err)
+		}
+		assertWatched(t, []ThreadWatchSummary{})
+	})
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/Masterminds/squirrel"
 	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
 )
 
 var (
@@ -141,6 +142,7 @@ type Thread struct {
 	PostCtr, ImageCtr    uint32
 	UpdateTime, BumpTime int64
 	Subject, Board       string
+	Tags                 []string
 }
 
 // ThreadCounter retrieves the progress counter of a thread
@@ -169,16 +171,21 @@ func ValidateOP(id uint64, board string) (valid bool, err error) {
 
 // InsertThread inserts a new thread into the database.
 // Sets ID, OP and time on inserted post.
-func InsertThread(tx *sql.Tx, subject string, p *Post) (err error) {
+func InsertThread(tx *sql.Tx, subject string, tags []string, p *Post,
+) (err error) {
 	err = sq.Insert("threads").
-		Columns("board", "subject").
-		Values(p.Board, subject).
+		Columns("board", "subject", "tags").
+		Values(p.Board, subject, encodeStringArray(tags)).
 		Suffix("returning id").
 		RunWith(tx).
 		Scan(&p.ID)
 	if err != nil {
 		return
 	}
+	err = writeThreadTrigrams(tx, p.ID, subject)
+	if err != nil {
+		return
+	}
 	p.OP = p.ID
 	return InsertPost(tx, p)
 }
@@ -188,26 +195,33 @@ func WriteThread(t Thread, p Post) (err error) {
 	return InTransaction(false, func(tx *sql.Tx) (err error) {
 		_, err = sq.
 			Insert("threads").
-			Columns("board", "id", "update_time", "bump_time", "subject").
+			Columns("board", "id", "update_time", "bump_time", "subject",
+				"tags").
 			Values(
 				t.Board,
 				t.ID,
 				t.UpdateTime,
 				t.BumpTime,
 				t.Subject,
+				encodeStringArray(t.Tags),
 			).
 			RunWith(tx).
 			Exec()
 		if err != nil {
 			return
 		}
+		err = writeThreadTrigrams(tx, t.ID, t.Subject)
+		if err != nil {
+			return
+		}
 		return WritePost(tx, p)
 	})
 }
 
-// CheckThreadLocked checks, if a thread has been locked by a moderator
+// CheckThreadLocked checks, if a thread has been locked by a moderator or
+// archived
 func CheckThreadLocked(id uint64) (locked bool, err error) {
-	err = sq.Select("locked").
+	err = sq.Select("locked or archived").
 		From("threads").
 		Where("id = ?", id).
 		QueryRow().
@@ -215,6 +229,44 @@ func CheckThreadLocked(id uint64) (locked bool, err error) {
 	return
 }
 
+// CheckThreadArchived checks, if a thread has been archived
+func CheckThreadArchived(id uint64) (archived bool, err error) {
+	err = sq.Select("archived").
+		From("threads").
+		Where("id = ?", id).
+		QueryRow().
+		Scan(&archived)
+	return
+}
+
+// GetThreadReplyLimit retrieves the current number of posts in a thread and
+// the number of posts, after which it stops being bumped by new replies
+func GetThreadReplyLimit(id uint64) (postCount, maxReplies int, err error) {
+	var board string
+	err = sq.Select("board", "maxReplies", "post_count(id)").
+		From("threads").
+		Where("id = ?", id).
+		QueryRow().
+		Scan(&board, &maxReplies, &postCount)
+	if err != nil {
+		return
+	}
+	if maxReplies == 0 {
+		maxReplies = config.GetBoardConfigs(board).ReplyLimit()
+	}
+	return
+}
+
+// GetThreadImageCount retrieves the number of images in a thread
+func GetThreadImageCount(id uint64) (n int, err error) {
+	err = sq.Select("count(*)").
+		From("posts").
+		Where("op = ? and SHA1 is not null", id).
+		QueryRow().
+		Scan(&n)
+	return
+}
+
 func Read() {
 
 }
@@ -19,6 +19,12 @@ func TestThreadBools(t *testing.T) {
 		t.Fatal(err)
 	}
 	test.AssertDeepEquals(t, false, locked)
+
+	archived, err := CheckThreadArchived(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertDeepEquals(t, false, archived)
 }
 
 func TestDiffPostCount(t *testing.T) {
@@ -114,7 +120,7 @@ func TestInsertThread(t *testing.T) {
 		Password: []byte("6+53653cs3ds"),
 	}
 	err := InTransaction(false, func(tx *sql.Tx) (err error) {
-		return InsertThread(tx, "test", &p)
+		return InsertThread(tx, "test", []string{"tech"}, &p)
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -129,3 +135,69 @@ func TestInsertThread(t *testing.T) {
 		t.Fatal(p.ID)
 	}
 }
+
+func TestThreadTags(t *testing.T) {
+	assertTableClear(t, "boards")
+	writeSampleBoard(t)
+
+	threads := [...]struct {
+		id       uint64
+		bumpTime int64
+		tags     []string
+	}{
+		{1, 10, []string{"tech", "news"}},
+		{2, 30, []string{"tech"}},
+		{3, 20, nil},
+	}
+	for _, th := range threads {
+		thread := Thread{
+			ID:         th.id,
+			Board:      "a",
+			UpdateTime: th.bumpTime,
+			BumpTime:   th.bumpTime,
+			Tags:       th.tags,
+		}
+		op := Post{
+			StandalonePost: common.StandalonePost{
+				Post: common.Post{
+					ID: th.id,
+				},
+				OP:    th.id,
+				Board: "a",
+			},
+		}
+		if err := WriteThread(thread, op); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	t.Run("by tag", func(t *testing.T) {
+		board, err := GetThreadsByTag("a", "tech", 0, 15)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids := make([]uint64, 0, len(board.Threads))
+		for _, th := range board.Threads {
+			ids = append(ids, th.ID)
+		}
+		test.AssertDeepEquals(t, ids, []uint64{2, 1})
+		test.AssertDeepEquals(t, board.Pages, 1)
+		test.AssertDeepEquals(t, board.Threads[1].Tags, []string{"tech", "news"})
+	})
+
+	t.Run("no matches", func(t *testing.T) {
+		board, err := GetThreadsByTag("a", "anime", 0, 15)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertDeepEquals(t, board.Threads, []common.Thread{})
+	})
+
+	t.Run("all tags", func(t *testing.T) {
+		tags, err := GetThreadTags("a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertDeepEquals(t, tags, []string{"news", "tech"})
+	})
+}
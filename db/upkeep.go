@@ -55,9 +55,11 @@ func runHalfTasks() {
 
 func runHourTasks() {
 	if config.ImagerMode != config.ImagerOnly {
-		expireRows("sessions")
+		expireRows("sessions", "post_sessions", "thread_watches")
 		expireBy("created < now() at time zone 'utc' + '-7 days'",
 			"mod_log", "reports")
+		expireBy("last_post < now() + '-1 hour'", "cooldowns")
+		expireBy("fetched < now() + '-1 day'", "url_cache")
 		logError("remove identity info", removeIdentityInfo())
 		logError("thread cleanup", deleteOldThreads())
 		logError("board cleanup", deleteUnusedBoards())
@@ -139,6 +141,20 @@ func closeDanglingPosts() error {
 		if err != nil {
 			return err
 		}
+		prev := body
+		if drawn, err := common.DrawFortunes(body, "", p.board); err == nil {
+			body = drawn
+		}
+		if rolled, _, err := common.RollDice(body, ""); err == nil {
+			body = rolled
+		}
+		if body != prev && !common.IsTest {
+			// Show the results to clients viewing the thread
+			err = common.EditPost(p.id, p.op, prev, body)
+			if err != nil {
+				return err
+			}
+		}
 
 		links, com, err := common.ParseBody([]byte(body), p.board, p.op, p.id, p.ip.String, true)
 		// Still close posts on invalid input
@@ -156,6 +172,12 @@ func closeDanglingPosts() error {
 		if err != nil {
 			return err
 		}
+		if common.MathRegexp.MatchString(body) {
+			common.RenderMath(p.id, p.op, body)
+		}
+		if common.URLRegexp.MatchString(body) {
+			common.FetchEmbeds(p.id, p.op, body)
+		}
 	}
 
 	return nil
@@ -229,7 +251,8 @@ func deleteBoard(tx *sql.Tx, id, by, reason string) (err error) {
 
 // Delete stale threads. Thread retention measured in a bump time threshold,
 // that is calculated as a function of post count till bump limit with an N days
-// floor and ceiling.
+// floor and ceiling. If thread archiving is enabled, stale threads are archived
+// instead, unless their OP was deleted by a moderator.
 func deleteOldThreads() (err error) {
 	conf := config.Get()
 	if !conf.PruneThreads {
@@ -243,6 +266,7 @@ func deleteOldThreads() (err error) {
 			min           = float64(conf.ThreadExpiryMin * 24 * 3600)
 			max           = float64(conf.ThreadExpiryMax * 24 * 3600)
 			toDel         = make([]uint64, 0, 16)
+			toArchive     = make([]uint64, 0, 16)
 			id, postCount uint64
 			bumpTime      int64
 			deleted       sql.NullBool
@@ -264,6 +288,7 @@ func deleteOldThreads() (err error) {
 				).
 				From("threads").
 				Join("posts on threads.id = posts.id").
+				Where("not threads.archived").
 				RunWith(tx),
 			func(r *sql.Rows) (err error) {
 				err = r.Scan(&id, &bumpTime, &postCount, &deleted)
@@ -280,7 +305,11 @@ func deleteOldThreads() (err error) {
 					threshold = min
 				}
 				if float64(now-bumpTime) > threshold {
-					toDel = append(toDel, id)
+					if conf.ArchiveThreads && !deleted.Bool {
+						toArchive = append(toArchive, id)
+					} else {
+						toDel = append(toDel, id)
+					}
 				}
 				return
 			},
@@ -303,6 +332,16 @@ func deleteOldThreads() (err error) {
 				}
 			}
 		}
+		if len(toArchive) != 0 {
+			_, err = sq.Update("threads").
+				SetMap(map[string]interface{}{
+					"archived":    true,
+					"update_time": now,
+				}).
+				Where("id = any(?::bigint[])", encodeUint64Array(toArchive)).
+				RunWith(tx).
+				Exec()
+		}
 
 		return
 	})
@@ -28,6 +28,12 @@ func TestOpenPostClosing(t *testing.T) {
 	) {
 		return nil, nil, nil
 	}
+	common.DrawFortunes = func(body, _, _ string) (string, error) {
+		return body, nil
+	}
+	common.RollDice = func(body, _ string) (string, bool, error) {
+		return body, false, nil
+	}
 
 	tooOld := time.Now().Add(-time.Minute * 31).Unix()
 	posts := [...]Post{
@@ -349,6 +355,56 @@ func TestDeleteOldThreads(t *testing.T) {
 	})
 }
 
+func TestArchiveOldThreads(t *testing.T) {
+	assertTableClear(t, "boards")
+	writeSampleBoard(t)
+	config.Set(config.Configs{
+		ArchiveThreads: true,
+		Public: config.Public{
+			PruneThreads:    true,
+			ThreadExpiryMin: 7,
+			ThreadExpiryMax: 7,
+		},
+	})
+	writeExpiringThreads(t, threadExpiryCases{
+		{1, "a", time.Now().Add(-eightDays)},
+		{2, "a", time.Now()},
+	})
+
+	if err := deleteOldThreads(); err != nil {
+		t.Fatal(err)
+	}
+	assertThreadDeleted(t, 1, false)
+	assertThreadDeleted(t, 2, false)
+
+	ids, _, _, err := GetThreadIDsPage("a", 0, 15, common.SortBump, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, ids, []uint64{2})
+
+	archive, err := GetBoardArchive("a", 0, 15)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(archive.Threads) != 1 || !archive.Threads[0].Archived {
+		t.Fatalf("thread not archived: %#v", archive.Threads)
+	}
+	AssertDeepEquals(t, archive.Threads[0].ID, uint64(1))
+
+	locked, err := CheckThreadLocked(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, locked, true)
+
+	archived, err := CheckThreadArchived(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, archived, true)
+}
+
 func TestDeleteBoard(t *testing.T) {
 	assertTableClear(t, "boards", "accounts")
 	writeSampleBoard(t)
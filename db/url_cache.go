@@ -0,0 +1,47 @@
+package db
+
+import (
+	"database/sql"
+
+	"github.com/bakape/meguca/common"
+)
+
+// GetURLCache retrieves the cached Open Graph metadata of a URL. ok is false,
+// if the URL was never fetched.
+func GetURLCache(url string) (meta common.EmbedMeta, ok bool, err error) {
+	err = sq.Select("url", "title", "description", "image_url").
+		From("url_cache").
+		Where("url = ?", url).
+		QueryRow().
+		Scan(&meta.URL, &meta.Title, &meta.Description, &meta.ImageURL)
+	switch err {
+	case nil:
+		ok = true
+	case sql.ErrNoRows:
+		err = nil
+	}
+	return
+}
+
+// WriteURLCache caches the Open Graph metadata of a URL
+func WriteURLCache(meta common.EmbedMeta) (err error) {
+	_, err = sq.Insert("url_cache").
+		Columns("url", "title", "description", "image_url").
+		Values(meta.URL, meta.Title, meta.Description, meta.ImageURL).
+		Suffix(`on conflict (url) do update
+			set title = excluded.title,
+				description = excluded.description,
+				image_url = excluded.image_url,
+				fetched = now()`).
+		Exec()
+	return
+}
+
+// SetPostEmbeds stores the Open Graph previews of the URLs in a post's body
+func SetPostEmbeds(id uint64, embeds []common.EmbedMeta) (err error) {
+	_, err = sq.Update("posts").
+		Set("embeds", embedRow(embeds)).
+		Where("id = ?", id).
+		Exec()
+	return
+}
@@ -0,0 +1,42 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestURLCache(t *testing.T) {
+	assertTableClear(t, "url_cache")
+
+	const url = "https://example.com"
+	_, ok, err := GetURLCache(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, ok, false)
+
+	for _, meta := range [...]common.EmbedMeta{
+		{
+			URL:   url,
+			Title: "foo",
+		},
+		{
+			URL:         url,
+			Title:       "bar",
+			Description: "baz",
+			ImageURL:    "https://example.com/a.png",
+		},
+	} {
+		if err := WriteURLCache(meta); err != nil {
+			t.Fatal(err)
+		}
+		res, ok, err := GetURLCache(url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertDeepEquals(t, ok, true)
+		AssertDeepEquals(t, res, meta)
+	}
+}
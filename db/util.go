@@ -61,6 +61,12 @@ func IsConflictError(err error) bool {
 	return pqErrorCode(err) == "unique_violation"
 }
 
+// IsForeignKeyViolation returns if an error is a foreign key constraint
+// violation. Used for detecting references to nonexistent rows.
+func IsForeignKeyViolation(err error) bool {
+	return pqErrorCode(err) == "foreign_key_violation"
+}
+
 // Extract error code, if error is a *pq.Error
 func pqErrorCode(err error) string {
 	if err, ok := err.(*pq.Error); ok {
@@ -198,3 +204,12 @@ func encodeUint64Array(arr []uint64) string {
 	}
 	return string(append(b, '}'))
 }
+
+// Encode []string to postgres format. Unlike pq.StringArray, nil slices are
+// encoded as empty arrays, so they can be written to non-null columns.
+func encodeStringArray(arr []string) pq.StringArray {
+	if arr == nil {
+		return pq.StringArray{}
+	}
+	return pq.StringArray(arr)
+}
@@ -0,0 +1,132 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/lib/pq"
+)
+
+var (
+	errTooManyWebhooks = common.ErrInvalidInput("too many webhooks")
+
+	// Webhooks by board
+	webhookCache   = map[string][]auth.Webhook{}
+	webhookCacheMu sync.RWMutex
+)
+
+func loadWebhooks() error {
+	if err := refreshWebhookCache(); err != nil {
+		return err
+	}
+	return Listen("webhooks_updated", func(_ string) error {
+		return refreshWebhookCache()
+	})
+}
+
+// Load all webhooks from the database for use in event dispatch
+func refreshWebhookCache() (err error) {
+	new := map[string][]auth.Webhook{}
+	err = queryAll(selectWebhooks(), func(r *sql.Rows) (err error) {
+		wh, err := scanWebhook(r)
+		if err != nil {
+			return
+		}
+		new[wh.Board] = append(new[wh.Board], wh)
+		return
+	})
+	if err != nil {
+		return
+	}
+
+	webhookCacheMu.Lock()
+	webhookCache = new
+	webhookCacheMu.Unlock()
+	return
+}
+
+func selectWebhooks() squirrel.SelectBuilder {
+	return sq.Select("id", "board", "url", "secret", "events").
+		From("webhooks").
+		OrderBy("id")
+}
+
+func scanWebhook(r rowScanner) (wh auth.Webhook, err error) {
+	var events pq.StringArray
+	err = r.Scan(&wh.ID, &wh.Board, &wh.URL, &wh.Secret, &events)
+	wh.Events = []string(events)
+	return
+}
+
+// BoardWebhooks returns the cached webhooks of a board. Do not modify the
+// returned slice.
+func BoardWebhooks(board string) []auth.Webhook {
+	webhookCacheMu.RLock()
+	defer webhookCacheMu.RUnlock()
+	return webhookCache[board]
+}
+
+// GetWebhooks retrieves all webhooks registered on a board
+func GetWebhooks(board string) (hooks []auth.Webhook, err error) {
+	hooks = make([]auth.Webhook, 0, common.MaxNumWebhooks)
+	err = queryAll(
+		selectWebhooks().Where("board = ?", board),
+		func(r *sql.Rows) (err error) {
+			wh, err := scanWebhook(r)
+			if err != nil {
+				return
+			}
+			hooks = append(hooks, wh)
+			return
+		},
+	)
+	return
+}
+
+// AddWebhook registers a new webhook on a board
+func AddWebhook(wh auth.Webhook) (err error) {
+	return InTransaction(false, func(tx *sql.Tx) (err error) {
+		var n int
+		err = sq.Select("count(*)").
+			From("webhooks").
+			Where("board = ?", wh.Board).
+			RunWith(tx).
+			QueryRow().
+			Scan(&n)
+		if err != nil {
+			return
+		}
+		if n >= common.MaxNumWebhooks {
+			return errTooManyWebhooks
+		}
+
+		_, err = sq.Insert("webhooks").
+			Columns("board", "url", "secret", "events").
+			Values(wh.Board, wh.URL, wh.Secret, pq.StringArray(wh.Events)).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+		_, err = tx.Exec("notify webhooks_updated")
+		return
+	})
+}
+
+// RemoveWebhook deletes a webhook from a board
+func RemoveWebhook(board string, id uint64) (err error) {
+	return InTransaction(false, func(tx *sql.Tx) (err error) {
+		_, err = sq.Delete("webhooks").
+			Where("id = ? and board = ?", id, board).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+		_, err = tx.Exec("notify webhooks_updated")
+		return
+	})
+}
@@ -0,0 +1,58 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestWebhooks(t *testing.T) {
+	prepareForModeration(t)
+	assertTableClear(t, "webhooks")
+
+	std := auth.Webhook{
+		Board:  "a",
+		URL:    "https://example.com/hook",
+		Secret: "foo",
+		Events: []string{"newPost", "newThread"},
+	}
+	if err := AddWebhook(std); err != nil {
+		t.Fatal(err)
+	}
+	if err := refreshWebhookCache(); err != nil {
+		t.Fatal(err)
+	}
+
+	hooks, err := GetWebhooks("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, len(hooks), 1)
+	std.ID = hooks[0].ID
+	AssertDeepEquals(t, hooks[0], std)
+	AssertDeepEquals(t, BoardWebhooks("a"), []auth.Webhook{std})
+	AssertDeepEquals(t, len(BoardWebhooks("c")), 0)
+
+	t.Run("limit", func(t *testing.T) {
+		for i := 1; i < common.MaxNumWebhooks; i++ {
+			if err := AddWebhook(std); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := AddWebhook(std); err != errTooManyWebhooks {
+			UnexpectedError(t, err)
+		}
+	})
+
+	err = RemoveWebhook("a", std.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hooks, err = GetWebhooks("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, len(hooks), common.MaxNumWebhooks-1)
+}
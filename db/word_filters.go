@@ -0,0 +1,148 @@
+package db
+
+import (
+	"database/sql"
+	"regexp"
+	"sync"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+)
+
+var (
+	errInvalidWordFilter = common.ErrInvalidInput("invalid word filter pattern")
+
+	// Compiled word filters by board
+	wordFilterCache   = map[string][]compiledWordFilter{}
+	wordFilterCacheMu sync.RWMutex
+)
+
+type compiledWordFilter struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+// Compile a word filter pattern, respecting case sensitivity
+func compileWordFilter(wf auth.WordFilter) (*regexp.Regexp, error) {
+	pattern := wf.Pattern
+	if !wf.CaseSensitive {
+		pattern = "(?i)" + pattern
+	}
+	return regexp.Compile(pattern)
+}
+
+func loadWordFilters() error {
+	if err := refreshWordFilterCache(); err != nil {
+		return err
+	}
+	return Listen("word_filters_updated", func(_ string) error {
+		return refreshWordFilterCache()
+	})
+}
+
+// Load all word filters from the database and compile them for use in post
+// creation
+func refreshWordFilterCache() (err error) {
+	new := map[string][]compiledWordFilter{}
+	err = queryAll(
+		selectWordFilters(),
+		func(r *sql.Rows) (err error) {
+			wf, err := scanWordFilter(r)
+			if err != nil {
+				return
+			}
+			re, err := compileWordFilter(wf)
+			if err != nil {
+				return
+			}
+			new[wf.Board] = append(new[wf.Board], compiledWordFilter{
+				pattern:     re,
+				replacement: wf.Replacement,
+			})
+			return
+		},
+	)
+	if err != nil {
+		return
+	}
+
+	wordFilterCacheMu.Lock()
+	wordFilterCache = new
+	wordFilterCacheMu.Unlock()
+	return
+}
+
+func selectWordFilters() squirrel.SelectBuilder {
+	return sq.Select("id", "board", "pattern", "replacement", "caseSensitive").
+		From("word_filters").
+		OrderBy("id")
+}
+
+func scanWordFilter(r rowScanner) (wf auth.WordFilter, err error) {
+	err = r.Scan(&wf.ID, &wf.Board, &wf.Pattern, &wf.Replacement,
+		&wf.CaseSensitive)
+	return
+}
+
+// FilterWords applies a board's word filters to text in order of creation
+func FilterWords(board, text string) string {
+	wordFilterCacheMu.RLock()
+	defer wordFilterCacheMu.RUnlock()
+
+	for _, f := range wordFilterCache[board] {
+		text = f.pattern.ReplaceAllString(text, f.replacement)
+	}
+	return text
+}
+
+// GetWordFilters retrieves all word filters configured for a board
+func GetWordFilters(board string) (filters []auth.WordFilter, err error) {
+	filters = make([]auth.WordFilter, 0, 16)
+	err = queryAll(
+		selectWordFilters().Where("board = ?", board),
+		func(r *sql.Rows) (err error) {
+			wf, err := scanWordFilter(r)
+			if err != nil {
+				return
+			}
+			filters = append(filters, wf)
+			return
+		},
+	)
+	return
+}
+
+// AddWordFilter validates and creates a new word filter on a board
+func AddWordFilter(wf auth.WordFilter) (err error) {
+	if _, err = compileWordFilter(wf); err != nil {
+		return errInvalidWordFilter
+	}
+	return InTransaction(false, func(tx *sql.Tx) (err error) {
+		_, err = sq.Insert("word_filters").
+			Columns("board", "pattern", "replacement", "caseSensitive").
+			Values(wf.Board, wf.Pattern, wf.Replacement, wf.CaseSensitive).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+		_, err = tx.Exec("notify word_filters_updated")
+		return
+	})
+}
+
+// RemoveWordFilter deletes a word filter from a board
+func RemoveWordFilter(board string, id uint64) (err error) {
+	return InTransaction(false, func(tx *sql.Tx) (err error) {
+		_, err = sq.Delete("word_filters").
+			Where("id = ? and board = ?", id, board).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+		_, err = tx.Exec("notify word_filters_updated")
+		return
+	})
+}
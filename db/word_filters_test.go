@@ -0,0 +1,72 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/bakape/meguca/auth"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestWordFilters(t *testing.T) {
+	prepareForModeration(t)
+	assertTableClear(t, "word_filters")
+
+	err := AddWordFilter(auth.WordFilter{
+		Board:   "a",
+		Pattern: "(",
+	})
+	if err != errInvalidWordFilter {
+		UnexpectedError(t, err)
+	}
+
+	for _, wf := range [...]auth.WordFilter{
+		{
+			Board:       "a",
+			Pattern:     `\bsmh\b`,
+			Replacement: "baka",
+		},
+		{
+			Board:         "a",
+			Pattern:       "Desu",
+			Replacement:   "desu",
+			CaseSensitive: true,
+		},
+	} {
+		if err := AddWordFilter(wf); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := refreshWordFilterCache(); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := [...]struct {
+		name, board, in, out string
+	}{
+		{"case insensitive", "a", "SMH my head", "baka my head"},
+		{"case sensitive", "a", "Desu DESU", "desu DESU"},
+		{"word boundary", "a", "smhh", "smhh"},
+		{"other board", "c", "smh", "smh"},
+	}
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			AssertDeepEquals(t, FilterWords(c.board, c.in), c.out)
+		})
+	}
+
+	filters, err := GetWordFilters("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, len(filters), 2)
+
+	err = RemoveWordFilter("a", filters[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := refreshWordFilterCache(); err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, FilterWords("a", "smh"), "smh")
+}
@@ -2,18 +2,29 @@ package geoip
 
 import (
 	"net"
+	"path/filepath"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/abh/geoip"
+	"github.com/bakape/meguca/config"
 	"github.com/go-playground/log"
 )
 
+// DefaultPath is the directory the GeoIP databases are loaded from, if not
+// overridden in the server configuration
+const DefaultPath = "/usr/share/GeoIP"
+
+// Private is the country code assigned to posters from private, loopback and
+// other non-public networks
+const Private = "xx"
+
 var (
-	once  sync.Once    // Ensure we only try to load the database once
-	gdbV4 *geoip.GeoIP // GeoIP database for IPv4
-	gdbV6 *geoip.GeoIP // GeoIP database for IPv6
+	mu         sync.Mutex   // Protects the databases and loadedPath
+	loadedPath *string      // Directory the databases were loaded from
+	gdbV4      *geoip.GeoIP // GeoIP database for IPv4
+	gdbV6      *geoip.GeoIP // GeoIP database for IPv6
 )
 
 // NY location
@@ -23,36 +34,34 @@ func init() {
 	NY, _ = time.LoadLocation("America/New_York")
 }
 
-// LookUp looks up the country ISO code of the IP
+// LookUp looks up the country ISO code of the IP. Returns an empty string, if
+// GeoIP is disabled in the server configuration or the lookup failed.
 func LookUp(ip string) (iso string) {
-	once.Do(func() {
-		open := func(path string) (db *geoip.GeoIP) {
-			db, err := geoip.Open(path)
-			if err != nil {
-				log.Warnf("geoip: could not load database %s: %s", path, err)
-			}
-			return
-		}
-
-		gdbV4 = open("/usr/share/GeoIP/GeoIP.dat")
-		gdbV6 = open("/usr/share/GeoIP/GeoIPv6.dat")
-	})
+	conf := config.Get()
+	if conf.DisableGeoIP {
+		return
+	}
 
 	dec := net.ParseIP(ip)
 	switch {
 	case dec == nil:
 		// All IPs, that make it till here should be valid, but best be safe
 		return
-	case dec.To4() != nil:
-		if gdbV4 == nil {
+	case isPrivate(dec):
+		return Private
+	}
+
+	v4, v6 := getDBs(conf.GeoIPPath)
+	if dec.To4() != nil {
+		if v4 == nil {
 			return
 		}
-		iso, _ = gdbV4.GetCountry(ip)
-	default:
-		if gdbV6 == nil {
+		iso, _ = v4.GetCountry(ip)
+	} else {
+		if v6 == nil {
 			return
 		}
-		iso, _ = gdbV6.GetCountry_v6(ip)
+		iso, _ = v6.GetCountry_v6(ip)
 	}
 
 	// Error returned
@@ -72,3 +81,64 @@ func LookUp(ip string) (iso string) {
 
 	return
 }
+
+// Return the databases, (re)loading them, if the configured directory changed
+// since the last load. Only one load is attempted per directory.
+func getDBs(dir string) (v4, v6 *geoip.GeoIP) {
+	if dir == "" {
+		dir = DefaultPath
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if loadedPath == nil || *loadedPath != dir {
+		open := func(name string) (db *geoip.GeoIP) {
+			path := filepath.Join(dir, name)
+			db, err := geoip.Open(path)
+			if err != nil {
+				log.Warnf("geoip: could not load database %s: %s", path, err)
+			}
+			return
+		}
+
+		gdbV4 = open("GeoIP.dat")
+		gdbV6 = open("GeoIPv6.dat")
+		loadedPath = &dir
+	}
+	return gdbV4, gdbV6
+}
+
+// Returns, if the IP does not belong to a public network and thus can not be
+// located
+func isPrivate(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() {
+		return true
+	}
+	for _, n := range privateNetworks {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+var privateNetworks = func() []*net.IPNet {
+	cidrs := [...]string{
+		"10.0.0.0/8",
+		"172.16.0.0/12",
+		"192.168.0.0/16",
+		"100.64.0.0/10", // Carrier-grade NAT
+		"fc00::/7",      // Unique local addresses
+	}
+	nets := make([]*net.IPNet, len(cidrs))
+	for i, c := range cidrs {
+		_, n, err := net.ParseCIDR(c)
+		if err != nil {
+			panic(err)
+		}
+		nets[i] = n
+	}
+	return nets
+}()
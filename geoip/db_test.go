@@ -0,0 +1,50 @@
+package geoip
+
+import (
+	"net"
+	"testing"
+
+	"github.com/bakape/meguca/config"
+)
+
+func TestLookUpPrivate(t *testing.T) {
+	config.Set(config.Configs{})
+
+	cases := [...]struct {
+		ip      string
+		private bool
+	}{
+		{"127.0.0.1", true},
+		{"::1", true},
+		{"10.1.2.3", true},
+		{"172.20.0.1", true},
+		{"192.168.1.1", true},
+		{"fd00::1", true},
+		{"fe80::1", true},
+		{"8.8.8.8", false},
+		{"2001:4860:4860::8888", false},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.ip, func(t *testing.T) {
+			if isPrivate(net.ParseIP(c.ip)) != c.private {
+				t.Fatal("unexpected result")
+			}
+			if c.private && LookUp(c.ip) != Private {
+				t.Fatal("private IP not marked")
+			}
+		})
+	}
+}
+
+func TestLookUpDisabled(t *testing.T) {
+	config.Set(config.Configs{
+		DisableGeoIP: true,
+	})
+	defer config.Set(config.Configs{})
+
+	if iso := LookUp("127.0.0.1"); iso != "" {
+		t.Fatal(iso)
+	}
+}
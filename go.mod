@@ -8,6 +8,7 @@ require (
 	github.com/PuerkitoBio/goquery v1.5.0 // indirect
 	github.com/Sirupsen/logrus v1.4.1 // indirect
 	github.com/abh/geoip v0.0.0-20160510155516-07cea4480daa
+	github.com/alecthomas/chroma v0.6.3
 	github.com/aquilax/tripcode v1.0.0
 	github.com/badoux/goscraper v0.0.0-20181207103713-9b4686c4b62c
 	github.com/bakape/captchouli v1.1.4
@@ -35,7 +36,7 @@ require (
 	github.com/valyala/fasthttp v1.2.0 // indirect
 	github.com/valyala/quicktemplate v1.0.2
 	golang.org/x/crypto v0.0.0-20190404164418-38d8ce5564a5
-	golang.org/x/net v0.0.0-20190404232315-eb5bcb51f2a3 // indirect
+	golang.org/x/net v0.0.0-20190404232315-eb5bcb51f2a3
 	golang.org/x/sys v0.0.0-20190405154228-4b34438f7a67 // indirect
 	gopkg.in/gomail.v2 v2.0.0-20160411212932-81ebce5c23df
 	gopkg.in/mholt/archiver.v2 v2.1.0
@@ -78,6 +78,17 @@ func RelativeThumbPath(thumbType uint8, SHA1 string) string {
 	)
 }
 
+// MathPath returns the file path of an SVG rendered from math markup
+func MathPath(hash string) string {
+	return filepath.Join("images", "math", hash+".svg")
+}
+
+// RelativeMathPath returns the path of an SVG rendered from math markup
+// relative to the root path
+func RelativeMathPath(hash string) string {
+	return util.ConcatStrings("/assets/images/math/", hash, ".svg")
+}
+
 // ImageSearchPath returns the relative path used for image search file lookups.
 // If files is not JPEG, PNG or GIF, returns the thumbnail instead of the source
 // file.
@@ -178,7 +189,7 @@ func Delete(SHA1 string, fileType, thumbType uint8) error {
 
 // CreateDirs creates directories for processed image storage
 func CreateDirs() error {
-	for _, dir := range [...]string{"src", "thumb"} {
+	for _, dir := range [...]string{"src", "thumb", "math"} {
 		path := filepath.Join("images", dir)
 		if err := os.MkdirAll(path, 0700); err != nil {
 			return err
@@ -0,0 +1,145 @@
+package imager
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+)
+
+// JPEG marker segments, that can contain poster-identifying metadata
+var jpegMetadataMarkers = map[byte]bool{
+	0xE1: true, // APP1: EXIF and XMP
+	0xED: true, // APP13: Photoshop IPTC
+	0xFE: true, // Comment
+}
+
+// Strip EXIF and other metadata segments from a JPEG file, that could be
+// used to deanonymize the poster, and return the resulting file with its
+// MD5 and SHA-256 hashes and size. Image data is not reencoded, so stripping
+// is lossless.
+func stripJPEGMetadata(rs io.ReadSeeker) (
+	res io.ReadSeeker, MD5, SHA256 string, size int, err error,
+) {
+	_, err = rs.Seek(0, 0)
+	if err != nil {
+		return
+	}
+	buf, err := ioutil.ReadAll(rs)
+	if err != nil {
+		return
+	}
+	buf = stripJPEGSegments(buf)
+	sum := md5.Sum(buf)
+	sum256 := sha256.Sum256(buf)
+	res = bytes.NewReader(buf)
+	MD5 = base64.RawURLEncoding.EncodeToString(sum[:])
+	SHA256 = hex.EncodeToString(sum256[:])
+	size = len(buf)
+	return
+}
+
+// Remove metadata segments from the header of a JPEG file. The EXIF
+// orientation is kept in a minimal EXIF segment, so the image is still
+// displayed the right way up. Malformed files are returned unchanged.
+func stripJPEGSegments(src []byte) []byte {
+	if len(src) < 2 || src[0] != 0xFF || src[1] != 0xD8 {
+		return src
+	}
+
+	w := make([]byte, 2, len(src))
+	copy(w, src[:2])
+	keptOrientation := false
+	i := 2
+	for {
+		// Skip any fill bytes before the marker
+		for i+1 < len(src) && src[i] == 0xFF && src[i+1] == 0xFF {
+			i++
+		}
+		if i+4 > len(src) || src[i] != 0xFF {
+			return src
+		}
+
+		marker := src[i+1]
+		if marker == 0xDA {
+			// Start of scan. The rest is entropy-coded image data.
+			return append(w, src[i:]...)
+		}
+		end := i + 2 + (int(src[i+2])<<8 | int(src[i+3]))
+		if end > len(src) {
+			return src
+		}
+		if !jpegMetadataMarkers[marker] {
+			w = append(w, src[i:end]...)
+		} else if marker == 0xE1 && !keptOrientation {
+			if o := exifOrientation(src[i+4 : end]); o > 1 {
+				w = append(w, orientationSegment(o)...)
+				keptOrientation = true
+			}
+		}
+		i = end
+	}
+}
+
+// Read the orientation tag from the first IFD of an APP1 EXIF segment's
+// payload. Returns 0, if the payload has no valid orientation.
+func exifOrientation(seg []byte) uint16 {
+	const header = "Exif\x00\x00"
+	if len(seg) < len(header)+8 || string(seg[:len(header)]) != header {
+		return 0
+	}
+	tiff := seg[len(header):]
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0
+	}
+	if order.Uint16(tiff[2:]) != 0x2A {
+		return 0
+	}
+
+	off := int(order.Uint32(tiff[4:]))
+	if off < 8 || off+2 > len(tiff) {
+		return 0
+	}
+	n := int(order.Uint16(tiff[off:]))
+	for i := 0; i < n; i++ {
+		e := off + 2 + i*12
+		if e+12 > len(tiff) {
+			return 0
+		}
+		// Orientation is a single SHORT stored inline in the value field
+		if order.Uint16(tiff[e:]) == 0x0112 && order.Uint16(tiff[e+2:]) == 3 {
+			o := order.Uint16(tiff[e+8:])
+			if o > 8 {
+				return 0
+			}
+			return o
+		}
+	}
+	return 0
+}
+
+// Build an APP1 EXIF segment containing only the orientation tag
+func orientationSegment(o uint16) []byte {
+	return []byte{
+		0xFF, 0xE1, 0, 34,
+		'E', 'x', 'i', 'f', 0, 0,
+		// Big-endian TIFF header with the first IFD at offset 8
+		'M', 'M', 0, 0x2A, 0, 0, 0, 8,
+		// One IFD entry: orientation, SHORT, count 1, inline value
+		0, 1,
+		0x01, 0x12, 0, 3, 0, 0, 0, 1, byte(o >> 8), byte(o), 0, 0,
+		// No next IFD
+		0, 0, 0, 0,
+	}
+}
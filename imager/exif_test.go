@@ -0,0 +1,60 @@
+package imager
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	"testing"
+
+	"github.com/bakape/meguca/test"
+)
+
+func TestStripJPEGSegments(t *testing.T) {
+	var w bytes.Buffer
+	err := jpeg.Encode(&w, image.NewGray(image.Rect(0, 0, 16, 16)), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	std := w.Bytes()
+
+	// Insert EXIF and comment segments after the start of image marker
+	withMeta := append([]byte{}, std[:2]...)
+	withMeta = append(withMeta, 0xFF, 0xE1, 0, 8, 'E', 'x', 'i', 'f', 0, 0)
+	withMeta = append(withMeta, 0xFF, 0xFE, 0, 5, 'f', 'o', 'o')
+	withMeta = append(withMeta, std[2:]...)
+
+	res := stripJPEGSegments(withMeta)
+	test.AssertDeepEquals(t, res, std)
+	if _, err := jpeg.Decode(bytes.NewReader(res)); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("orientation", func(t *testing.T) {
+		// Little-endian EXIF with a camera model and an orientation tag
+		exif := []byte{'E', 'x', 'i', 'f', 0, 0,
+			'I', 'I', 0x2A, 0, 8, 0, 0, 0,
+			2, 0,
+			0x10, 0x01, 2, 0, 4, 0, 0, 0, 'f', 'o', 'o', 0,
+			0x12, 0x01, 3, 0, 1, 0, 0, 0, 6, 0, 0, 0,
+			0, 0, 0, 0,
+		}
+		src := append([]byte{}, std[:2]...)
+		src = append(src, 0xFF, 0xE1, 0, byte(len(exif)+2))
+		src = append(src, exif...)
+		src = append(src, std[2:]...)
+
+		res := stripJPEGSegments(src)
+		seg := orientationSegment(6)
+		test.AssertDeepEquals(t, res[2:2+len(seg)], seg)
+		test.AssertDeepEquals(t, exifOrientation(seg[4:]), uint16(6))
+		test.AssertDeepEquals(t, res[2+len(seg):], std[2:])
+		if _, err := jpeg.Decode(bytes.NewReader(res)); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("not a JPEG", func(t *testing.T) {
+		src := []byte("foo bar")
+		test.AssertDeepEquals(t, stripJPEGSegments(src), src)
+	})
+}
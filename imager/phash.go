@@ -0,0 +1,85 @@
+package imager
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// Side of the greyscale downsample the perceptual hash is computed from
+const phashSampleSize = 32
+
+// Precomputed DCT-II cosine coefficients for the lowest 8 frequencies
+var phashCos = func() (c [8][phashSampleSize]float64) {
+	for u := range c {
+		for x := range c[u] {
+			c[u][x] = math.Cos(float64((2*x+1)*u) * math.Pi /
+				(2 * phashSampleSize))
+		}
+	}
+	return
+}()
+
+// Compute a 64 bit perceptual hash of an image, that is resistant to
+// scaling, recompression and minor colour changes, and encode it as
+// hexadecimal. Similar images have hashes with a low hamming distance.
+func perceptualHash(img image.Image) string {
+	// Box sample image into greyscale
+	var pixels [phashSampleSize][phashSampleSize]float64
+	b := img.Bounds()
+	for y := 0; y < phashSampleSize; y++ {
+		y0, y1 := sampleRange(b.Min.Y, b.Dy(), y)
+		for x := 0; x < phashSampleSize; x++ {
+			x0, x1 := sampleRange(b.Min.X, b.Dx(), x)
+			var sum float64
+			for i := y0; i < y1; i++ {
+				for j := x0; j < x1; j++ {
+					c := color.GrayModel.Convert(img.At(j, i))
+					sum += float64(c.(color.Gray).Y)
+				}
+			}
+			pixels[y][x] = sum / float64((y1-y0)*(x1-x0))
+		}
+	}
+
+	// Low frequencies of the 2D DCT
+	var coeffs [64]float64
+	for u := 0; u < 8; u++ {
+		for v := 0; v < 8; v++ {
+			var sum float64
+			for y := 0; y < phashSampleSize; y++ {
+				for x := 0; x < phashSampleSize; x++ {
+					sum += pixels[y][x] * phashCos[u][y] * phashCos[v][x]
+				}
+			}
+			coeffs[u*8+v] = sum
+		}
+	}
+
+	// Set bits for coefficients above the median. The DC coefficient only
+	// represents average brightness, so is excluded from the median.
+	sorted := make([]float64, 63)
+	copy(sorted, coeffs[1:])
+	sort.Float64s(sorted)
+	median := (sorted[31] + sorted[32]) / 2
+
+	var hash uint64
+	for i, c := range coeffs {
+		if c > median {
+			hash |= 1 << uint(i)
+		}
+	}
+	return strconv.FormatUint(hash, 16)
+}
+
+// Returns the range of source pixels to sample for a pixel of the sample
+func sampleRange(min, length, i int) (start, end int) {
+	start = min + i*length/phashSampleSize
+	end = min + (i+1)*length/phashSampleSize
+	if end <= start {
+		end = start + 1
+	}
+	return
+}
@@ -0,0 +1,58 @@
+package imager
+
+import (
+	"image"
+	"image/color"
+	"math/bits"
+	"strconv"
+	"testing"
+)
+
+// Draw a sample image with a diagonal gradient and a bright square
+func drawSample(w, h int, invert bool) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			v := uint8((x*255/w + y*255/h) / 2)
+			if x > w/4 && x < w/2 && y > h/4 && y < h/2 {
+				v = 255
+			}
+			if invert {
+				v = 255 - v
+			}
+			img.Set(x, y, color.RGBA{v, v, v, 255})
+		}
+	}
+	return img
+}
+
+func hashDistance(t *testing.T, a, b string) int {
+	t.Helper()
+	ai, err := strconv.ParseUint(a, 16, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bi, err := strconv.ParseUint(b, 16, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bits.OnesCount64(ai ^ bi)
+}
+
+func TestPerceptualHash(t *testing.T) {
+	std := perceptualHash(drawSample(150, 100, false))
+
+	t.Run("scaled", func(t *testing.T) {
+		d := hashDistance(t, std, perceptualHash(drawSample(300, 200, false)))
+		if d > 10 {
+			t.Fatalf("distance too large: %d", d)
+		}
+	})
+
+	t.Run("different", func(t *testing.T) {
+		d := hashDistance(t, std, perceptualHash(drawSample(150, 100, true)))
+		if d < 20 {
+			t.Fatalf("distance too small: %d", d)
+		}
+	})
+}
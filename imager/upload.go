@@ -4,8 +4,10 @@ package imager
 import (
 	"bytes"
 	"crypto/md5"
+	"crypto/sha256"
 	"database/sql"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"image"
 	"io"
@@ -55,7 +57,8 @@ var (
 	// MIME types from thumbnailer to accept
 	allowedMimeTypes map[string]bool
 
-	errTooLarge = errors.New("file too large")
+	errTooLarge           = errors.New("file too large")
+	errFileTypeNotAllowed = errors.New("file type not allowed")
 
 	// Large buffer pool of length=0 capacity=12+KB
 	largeBufPool = sync.Pool{
@@ -256,6 +259,19 @@ func newThumbnail(f multipart.File, SHA1 string) (
 		}
 		return
 	}
+	if !conf.AllowsFileType(common.Extensions[img.FileType]) {
+		err = common.StatusError{errFileTypeNotAllowed, 415}
+		return
+	}
+
+	// Strip metadata before the file is ever written to storage
+	var src io.ReadSeeker = f
+	if img.FileType == common.JPEG {
+		src, img.MD5, img.SHA256, img.Size, err = stripJPEGMetadata(f)
+		if err != nil {
+			return
+		}
+	}
 
 	// Being done in one transaction prevents the image DB record from getting
 	// garbage-collected between the calls
@@ -264,7 +280,7 @@ func newThumbnail(f multipart.File, SHA1 string) (
 		if thumb != nil {
 			thumbR = bytes.NewReader(thumb)
 		}
-		err = db.AllocateImage(tx, f, thumbR, img)
+		err = db.AllocateImage(tx, src, thumbR, img)
 		if err != nil && !db.IsConflictError(err) {
 			return
 		}
@@ -331,8 +347,14 @@ func processFile(f multipart.File, img *common.ImageCommon,
 	if err != nil {
 		return
 	}
+	img.SHA256, _, err = hashFile(f, sha256.New(), hex.EncodeToString)
+	if err != nil {
+		return
+	}
 
 	if thumbImage != nil {
+		img.PHash = perceptualHash(thumbImage)
+
 		w := bytes.NewBuffer(largeBufPool.Get().([]byte))
 		err = webp.Encode(w, thumbImage, &webp.Options{
 			Lossless: false,
@@ -107,6 +107,10 @@ func assertFiles(t *testing.T, src, id string, fileType, thumbType uint8) {
 		}
 	}
 
+	// Metadata is stripped from stored JPEG files
+	if fileType == common.JPEG {
+		data[0] = stripJPEGSegments(data[0])
+	}
 	test.AssertBufferEquals(t, data[0], data[1])
 	if len(data[1]) < len(data[2]) {
 		t.Error("unexpected file size difference")
@@ -165,7 +169,20 @@ func TestNewThumbnail(t *testing.T) {
 	assertCode(t, rec.Code, 200)
 
 	img := getImageRecord(t, assets.StdJPEG.SHA1)
-	test.AssertDeepEquals(t, img, assets.StdJPEG.ImageCommon)
+	if img.PHash == "" {
+		t.Fatal("no perceptual hash")
+	}
+
+	std := assets.StdJPEG.ImageCommon
+	std.PHash = img.PHash
+	f := test.OpenSample(t, "sample.jpg")
+	defer f.Close()
+	var err error
+	_, std.MD5, std.SHA256, std.Size, err = stripJPEGMetadata(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertDeepEquals(t, img, std)
 	assertFiles(t, "sample.jpg", assets.StdJPEG.SHA1, common.JPEG, common.WEBP)
 }
 
@@ -0,0 +1,333 @@
+// Package latex renders [math] markup in post bodies to SVG images by shelling
+// out to latex and dvisvgm
+package latex
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/imager/assets"
+)
+
+// Commands and environments allowed in math markup. Anything else could
+// access the file system, redefine the parser or otherwise escape the confines
+// of a single formula.
+var (
+	allowedCommands = wordSet(`
+		alpha beta gamma delta epsilon varepsilon zeta eta theta vartheta iota
+		kappa varkappa lambda mu nu xi omicron pi varpi rho varrho sigma
+		varsigma tau upsilon phi varphi chi psi omega Gamma Delta Theta Lambda
+		Xi Pi Sigma Upsilon Phi Psi Omega aleph beth gimel daleth
+
+		pm mp times div cdot cdotp ast star circ bullet oplus ominus otimes
+		oslash odot cap cup sqcap sqcup vee wedge setminus wr diamond amalg
+		bigtriangleup bigtriangledown triangleleft triangleright dagger ddagger
+		uplus
+
+		leq le geq ge neq ne equiv approx cong sim simeq asymp propto prec succ
+		preceq succeq ll gg subset supset subseteq supseteq subsetneq supsetneq
+		sqsubseteq sqsupseteq in ni notin not vdash dashv models perp mid
+		parallel smile frown bowtie doteq leqslant geqslant lesssim gtrsim nleq
+		ngeq colon
+
+		leftarrow rightarrow to gets uparrow downarrow updownarrow
+		leftrightarrow Leftarrow Rightarrow Uparrow Downarrow Updownarrow
+		Leftrightarrow iff implies impliedby longleftarrow longrightarrow
+		longleftrightarrow Longleftarrow Longrightarrow Longleftrightarrow
+		mapsto longmapsto hookleftarrow hookrightarrow nearrow searrow swarrow
+		nwarrow rightleftharpoons leftharpoonup rightharpoonup
+
+		infty nabla partial forall exists nexists neg lnot emptyset varnothing
+		Re Im wp ell hbar top bot angle triangle backslash prime surd flat
+		natural sharp clubsuit diamondsuit heartsuit spadesuit ldots cdots vdots
+		ddots dots therefore because imath jmath square blacksquare
+
+		sum prod coprod int iint iiint oint bigcup bigcap bigoplus bigotimes
+		bigodot bigvee bigwedge bigsqcup biguplus limits nolimits lim limsup
+		liminf sup inf max min arg det dim exp gcd hom ker lg ln log Pr deg sin
+		cos tan cot sec csc arcsin arccos arctan sinh cosh tanh coth bmod pmod
+
+		frac dfrac tfrac cfrac sqrt binom dbinom tbinom overline underline
+		overbrace underbrace overrightarrow overleftarrow widehat widetilde hat
+		check breve acute grave tilde bar vec dot ddot mathring stackrel overset
+		underset xrightarrow xleftarrow boxed substack
+
+		left right middle big Big bigg Bigg bigl bigr Bigl Bigr biggl biggr
+		Biggl Biggr langle rangle lceil rceil lfloor rfloor lvert rvert lVert
+		rVert vert Vert
+
+		mathrm mathbf mathit mathsf mathtt mathcal mathbb mathfrak boldsymbol
+		text textrm textbf textit operatorname displaystyle textstyle
+		scriptstyle scriptscriptstyle quad qquad
+
+		begin end
+	`)
+	allowedEnvironments = wordSet(`
+		matrix pmatrix bmatrix Bmatrix vmatrix Vmatrix smallmatrix cases array
+		aligned gathered split
+	`)
+
+	// Commands consisting of a single non-letter character, like \{
+	allowedSymbols = "{},;:! \\|#%&_$"
+)
+
+// Build a set of the space separated words in s
+func wordSet(s string) map[string]bool {
+	set := make(map[string]bool)
+	for _, w := range strings.Fields(s) {
+		set[w] = true
+	}
+	return set
+}
+
+var (
+	errNoLatex = errors.New("math rendering not available")
+
+	// Default is the renderer used for post math markup
+	Default = NewRenderer(10 * time.Second)
+)
+
+// Renderer renders LaTeX math expressions to SVG files stored in the image
+// directory. Rendered files are cached by the hash of their expression, so
+// each distinct expression is only ever rendered once.
+type Renderer struct {
+	timeout time.Duration
+	sem     chan struct{} // Limits concurrent renders
+}
+
+// NewRenderer creates a Renderer, that aborts renders taking longer than
+// timeout
+func NewRenderer(timeout time.Duration) *Renderer {
+	return &Renderer{
+		timeout: timeout,
+		sem:     make(chan struct{}, runtime.NumCPU()),
+	}
+}
+
+// Extract returns the expressions of all math markup in a post body in order
+// of appearance
+func Extract(body string) []string {
+	matches := common.MathRegexp.FindAllStringSubmatch(body, -1)
+	exprs := make([]string, len(matches))
+	for i, m := range matches {
+		exprs[i] = m[1]
+	}
+	return exprs
+}
+
+// Validate checks all math markup in a post body and returns a descriptive
+// error for the first invalid expression
+func Validate(body string) error {
+	exprs := Extract(body)
+	if len(exprs) > common.MaxNumMath {
+		return common.ErrInvalidInput(
+			fmt.Sprintf("math: too many expressions: max %d", common.MaxNumMath))
+	}
+	for _, e := range exprs {
+		if err := validateExpression(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateExpression(expr string) error {
+	fail := func(format string, args ...interface{}) error {
+		return common.ErrInvalidInput(fmt.Sprintf("math: %s: ", expr) +
+			fmt.Sprintf(format, args...))
+	}
+
+	if len(expr) > common.MaxLenMath {
+		return fail("expression too long: max %d bytes", common.MaxLenMath)
+	}
+
+	depth := 0
+	for i := 0; i < len(expr); i++ {
+		switch expr[i] {
+		case '\\':
+			j := i + 1
+			for j < len(expr) && isLetter(expr[j]) {
+				j++
+			}
+			if j == i+1 {
+				// Single character symbols like \{
+				if j == len(expr) ||
+					!strings.ContainsRune(allowedSymbols, rune(expr[j])) {
+					return fail("command at position %d not allowed", i)
+				}
+				i = j
+				continue
+			}
+
+			name := expr[i+1 : j]
+			if !allowedCommands[name] {
+				return fail("command \\%s not allowed", name)
+			}
+			if name == "begin" || name == "end" {
+				env := ""
+				if j < len(expr) && expr[j] == '{' {
+					if k := strings.IndexByte(expr[j:], '}'); k != -1 {
+						env = expr[j+1 : j+k]
+					}
+				}
+				if !allowedEnvironments[env] {
+					return fail("environment %q not allowed", env)
+				}
+			}
+			i = j - 1
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth < 0 {
+				return fail("unexpected '}' at position %d", i)
+			}
+		case '$':
+			return fail("'$' not allowed inside math markup")
+		}
+	}
+	if depth != 0 {
+		return fail("%d unclosed '{'", depth)
+	}
+
+	// ^^ notation can be used to smuggle in forbidden commands
+	if strings.Contains(expr, "^^") {
+		return fail("'^^' not allowed")
+	}
+	return nil
+}
+
+func isLetter(b byte) bool {
+	return b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z'
+}
+
+// Render renders an expression to SVG and returns its URL
+func (r *Renderer) Render(expr string) (url string, err error) {
+	err = validateExpression(expr)
+	if err != nil {
+		return
+	}
+
+	sum := sha256.Sum256([]byte(expr))
+	hash := hex.EncodeToString(sum[:])
+	path := assets.MathPath(hash)
+	url = assets.RelativeMathPath(hash)
+
+	_, err = os.Stat(path)
+	switch {
+	case err == nil:
+		return
+	case !os.IsNotExist(err):
+		return "", err
+	}
+
+	r.sem <- struct{}{}
+	defer func() { <-r.sem }()
+
+	svg, err := r.compile(expr)
+	if err != nil {
+		return "", err
+	}
+
+	// Write to a temporary file first, so concurrent renders of the same
+	// expression never expose a partially written file
+	tmp := fmt.Sprintf("%s.%d.tmp", path, time.Now().UnixNano())
+	err = ioutil.WriteFile(tmp, svg, 0600)
+	if err != nil {
+		return "", err
+	}
+	err = os.Rename(tmp, path)
+	if err != nil {
+		os.Remove(tmp)
+		return "", err
+	}
+	return
+}
+
+// Compile an expression to SVG in a temporary directory
+func (r *Renderer) compile(expr string) (svg []byte, err error) {
+	for _, bin := range [...]string{"latex", "dvisvgm"} {
+		if _, err = exec.LookPath(bin); err != nil {
+			return nil, errNoLatex
+		}
+	}
+
+	dir, err := ioutil.TempDir("", "meguca-latex-")
+	if err != nil {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	err = ioutil.WriteFile(filepath.Join(dir, "math.tex"), document(expr),
+		0600)
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	// Restrict reading and writing files to the working directory and
+	// disable shell escapes, in case anything slips past validation
+	env := append(os.Environ(), "openin_any=p", "openout_any=p",
+		"shell_escape=f")
+
+	cmd := exec.CommandContext(ctx, "latex",
+		"-no-shell-escape", "-interaction=nonstopmode", "-halt-on-error",
+		"math.tex")
+	cmd.Dir = dir
+	cmd.Env = env
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, errors.New("math: rendering timed out")
+		}
+		return nil, parseError(out)
+	}
+
+	cmd = exec.CommandContext(ctx, "dvisvgm",
+		"--no-fonts", "--exact", "--stdout", "math.dvi")
+	cmd.Dir = dir
+	cmd.Env = env
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	svg, err = cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("math: dvisvgm: %s: %s", err, stderr.String())
+	}
+	return
+}
+
+// Wrap the expression in a minimal standalone document
+func document(expr string) []byte {
+	return []byte(`\documentclass[12pt]{article}
+\usepackage{amsmath,amssymb}
+\pagestyle{empty}
+\begin{document}
+$\displaystyle ` + expr + `$
+\end{document}
+`)
+}
+
+// Extract the first error message from latex output. Errors are reported on
+// lines starting with "! ".
+func parseError(out []byte) error {
+	for _, l := range strings.Split(string(out), "\n") {
+		if strings.HasPrefix(l, "! ") {
+			return errors.New("math: " + strings.TrimSpace(l[2:]))
+		}
+	}
+	return errors.New("math: could not render expression")
+}
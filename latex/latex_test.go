@@ -0,0 +1,91 @@
+package latex
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestExtract(t *testing.T) {
+	t.Parallel()
+
+	AssertDeepEquals(t,
+		Extract(`a [math]x^2[/math] b [math]\frac{1}{2}[/math] [math][/math]`),
+		[]string{"x^2", `\frac{1}{2}`},
+	)
+	AssertDeepEquals(t, Extract("no math"), []string{})
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name, body string
+		valid      bool
+	}{
+		{"no math", "foo", true},
+		{"simple", `[math]\sum_{i=0}^{n} i^2[/math]`, true},
+		{"escaped brace", `[math]\{ x \}[/math]`, true},
+		{"allowed commands", `[math]\left( \alpha \leq \beta \right)[/math]`,
+			true},
+		{
+			"environment",
+			`[math]\begin{pmatrix} 1 & 0 \\ 0 & 1 \end{pmatrix}[/math]`,
+			true,
+		},
+		{"command prefix", `[math]\readonly + \letter[/math]`, false},
+		{"file read", `[math]\InputIfFileExists{/etc/passwd}{}{}[/math]`,
+			false},
+		{"unknown symbol", `[math]\@tempa[/math]`, false},
+		{"trailing backslash", `[math]x \[/math]`, false},
+		{
+			"forbidden environment",
+			`[math]\begin{filecontents}x\end{filecontents}[/math]`,
+			false,
+		},
+		{"unclosed brace", `[math]\frac{1}{2[/math]`, false},
+		{"unexpected brace", `[math]x}[/math]`, false},
+		{"dollar", `[math]x$ \input{/etc/passwd} $[/math]`, false},
+		{"input", `[math]\input{/etc/passwd}[/math]`, false},
+		{"def", `[math]\def\x{y}[/math]`, false},
+		{"write", `[math]\write18{ls}[/math]`, false},
+		{"caret notation", `[math]^^5cinput[/math]`, false},
+		{"end document", `[math]\end{document}[/math]`, false},
+		{
+			"too long",
+			"[math]" + strings.Repeat("x", common.MaxLenMath+1) + "[/math]",
+			false,
+		},
+		{
+			"too many",
+			strings.Repeat("[math]x[/math]", common.MaxNumMath+1),
+			false,
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Validate(c.body)
+			if c.valid {
+				if err != nil {
+					t.Fatal(err)
+				}
+			} else if err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	t.Parallel()
+
+	out := "This is pdfTeX\n(./math.tex\n! Undefined control sequence.\nl.5 ..."
+	AssertDeepEquals(t, parseError([]byte(out)).Error(),
+		"math: Undefined control sequence.")
+}
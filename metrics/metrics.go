@@ -0,0 +1,210 @@
+// Package metrics collects runtime statistics and exposes them in the
+// Prometheus text exposition format
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Upper bounds of the DB query duration histogram buckets in seconds
+var buckets = [...]float64{
+	.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10,
+}
+
+var (
+	requests = struct {
+		sync.Mutex
+		m map[requestLabels]uint64
+	}{
+		m: make(map[requestLabels]uint64),
+	}
+	queries = struct {
+		sync.Mutex
+		m map[string]*histogram
+	}{
+		m: make(map[string]*histogram),
+	}
+	compression = struct {
+		sync.Mutex
+		m map[string]*compressionStats
+	}{
+		m: make(map[string]*compressionStats),
+	}
+
+	labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+)
+
+type requestLabels struct {
+	method, path string
+	status       int
+}
+
+// Total payload and compressed bytes written to websocket clients on a board
+type compressionStats struct {
+	raw, compressed uint64
+}
+
+type histogram struct {
+	counts [len(buckets)]uint64
+	count  uint64
+	sum    float64
+}
+
+// CountRequest increments the counter of served HTTP requests
+func CountRequest(method, path string, status int) {
+	requests.Lock()
+	requests.m[requestLabels{method, path, status}]++
+	requests.Unlock()
+}
+
+// ObserveQuery records the duration of a database query started at start.
+// Meant to be deferred.
+func ObserveQuery(queryType string, start time.Time) {
+	d := time.Since(start).Seconds()
+
+	queries.Lock()
+	defer queries.Unlock()
+
+	h := queries.m[queryType]
+	if h == nil {
+		h = new(histogram)
+		queries.m[queryType] = h
+	}
+	h.count++
+	h.sum += d
+	for i, b := range buckets {
+		if d <= b {
+			h.counts[i]++
+		}
+	}
+}
+
+// ObserveCompression records the size of a websocket message sent to a client
+// on board before and after compression
+func ObserveCompression(board string, raw, compressed uint64) {
+	compression.Lock()
+	defer compression.Unlock()
+
+	s := compression.m[board]
+	if s == nil {
+		s = new(compressionStats)
+		compression.m[board] = s
+	}
+	s.raw += raw
+	s.compressed += compressed
+}
+
+// Write writes all collected metrics to w. connections contains the number
+// of active websocket connections per board.
+func Write(w io.Writer, connections map[string]int) error {
+	buf := bufio.NewWriter(w)
+
+	writeHeader(buf, "meguca_http_requests_total", "counter",
+		"Total number of served HTTP requests")
+	requests.Lock()
+	lines := make([]string, 0, len(requests.m))
+	for l, n := range requests.m {
+		lines = append(lines, fmt.Sprintf(
+			`meguca_http_requests_total{method="%s",path="%s",status="%d"} %d`,
+			escape(l.method), escape(l.path), l.status, n))
+	}
+	requests.Unlock()
+	writeLines(buf, lines)
+
+	writeHeader(buf, "meguca_db_query_duration_seconds", "histogram",
+		"Duration of database queries")
+	queries.Lock()
+	lines = lines[:0]
+	for typ, h := range queries.m {
+		lines = append(lines, formatHistogram(
+			"meguca_db_query_duration_seconds",
+			`query_type="`+escape(typ)+`"`,
+			h,
+		))
+	}
+	queries.Unlock()
+	writeLines(buf, lines)
+
+	writeHeader(buf, "meguca_websocket_connections_active", "gauge",
+		"Number of active websocket connections")
+	lines = lines[:0]
+	for board, n := range connections {
+		lines = append(lines, fmt.Sprintf(
+			`meguca_websocket_connections_active{board="%s"} %d`,
+			escape(board), n))
+	}
+	writeLines(buf, lines)
+
+	writeHeader(buf, "meguca_ws_compression_ratio", "gauge",
+		"Ratio of compressed to uncompressed websocket message bytes")
+	compression.Lock()
+	lines = lines[:0]
+	for board, s := range compression.m {
+		if s.raw == 0 {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf(
+			`meguca_ws_compression_ratio{board="%s"} %s`,
+			escape(board),
+			strconv.FormatFloat(float64(s.compressed)/float64(s.raw), 'g', -1,
+				64),
+		))
+	}
+	compression.Unlock()
+	writeLines(buf, lines)
+
+	return buf.Flush()
+}
+
+func writeHeader(w *bufio.Writer, name, typ, help string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+}
+
+// Write lines sorted for deterministic output
+func writeLines(w *bufio.Writer, lines []string) {
+	sort.Strings(lines)
+	for _, l := range lines {
+		w.WriteString(l)
+		w.WriteByte('\n')
+	}
+}
+
+// Format all series of a histogram. Bucket counts are cumulative.
+func formatHistogram(name, labels string, h *histogram) string {
+	var b strings.Builder
+	for i, le := range buckets {
+		fmt.Fprintf(&b, "%s_bucket{%s,le=\"%s\"} %d\n", name, labels,
+			strconv.FormatFloat(le, 'g', -1, 64), h.counts[i])
+	}
+	fmt.Fprintf(&b, "%s_bucket{%s,le=\"+Inf\"} %d\n", name, labels, h.count)
+	fmt.Fprintf(&b, "%s_sum{%s} %s\n", name, labels,
+		strconv.FormatFloat(h.sum, 'g', -1, 64))
+	fmt.Fprintf(&b, "%s_count{%s} %d", name, labels, h.count)
+	return b.String()
+}
+
+func escape(s string) string {
+	return labelEscaper.Replace(s)
+}
+
+// Clear resets all collected metrics. Only use in tests.
+func Clear() {
+	requests.Lock()
+	requests.m = make(map[requestLabels]uint64)
+	requests.Unlock()
+
+	queries.Lock()
+	queries.m = make(map[string]*histogram)
+	queries.Unlock()
+
+	compression.Lock()
+	compression.m = make(map[string]*compressionStats)
+	compression.Unlock()
+}
@@ -0,0 +1,56 @@
+package metrics
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWrite(t *testing.T) {
+	Clear()
+	CountRequest("GET", "/:board/:id", 200)
+	CountRequest("GET", "/:board/:id", 200)
+	CountRequest("POST", "/api/create-reply", 400)
+	ObserveQuery("get_thread", time.Now().Add(-30*time.Millisecond))
+	ObserveCompression("a", 300, 50)
+	ObserveCompression("a", 100, 50)
+
+	var w bytes.Buffer
+	err := Write(&w, map[string]int{"a": 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := w.String()
+
+	for _, s := range [...]string{
+		"# TYPE meguca_http_requests_total counter\n",
+		`meguca_http_requests_total{method="GET",path="/:board/:id",` +
+			`status="200"} 2` + "\n",
+		`meguca_http_requests_total{method="POST",` +
+			`path="/api/create-reply",status="400"} 1` + "\n",
+		"# TYPE meguca_db_query_duration_seconds histogram\n",
+		`meguca_db_query_duration_seconds_bucket{query_type="get_thread",` +
+			`le="0.025"} 0` + "\n",
+		`meguca_db_query_duration_seconds_bucket{query_type="get_thread",` +
+			`le="0.05"} 1` + "\n",
+		`meguca_db_query_duration_seconds_bucket{query_type="get_thread",` +
+			`le="+Inf"} 1` + "\n",
+		`meguca_db_query_duration_seconds_count{query_type="get_thread"} 1` +
+			"\n",
+		`meguca_websocket_connections_active{board="a"} 2` + "\n",
+		"# TYPE meguca_ws_compression_ratio gauge\n",
+		`meguca_ws_compression_ratio{board="a"} 0.25` + "\n",
+	} {
+		if !strings.Contains(out, s) {
+			t.Errorf("output missing line: %s\n%s", s, out)
+		}
+	}
+}
+
+func TestEscape(t *testing.T) {
+	const std = `a\\b\"c\n`
+	if s := escape("a\\b\"c\n"); s != std {
+		t.Fatalf("unexpected escape result: %s : %s", std, s)
+	}
+}
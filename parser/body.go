@@ -2,6 +2,7 @@
 package parser
 
 import (
+	"bytes"
 	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/util"
@@ -10,7 +11,10 @@ import (
 )
 
 var (
-	linkRegexp = regexp.MustCompile(`^>{2,}(\d+)$`)
+	errCommandsEdited = common.ErrInvalidInput("hash commands can not be edited")
+
+	linkRegexp      = regexp.MustCompile(`^>{2,}(\d+)$`)
+	crossLinkRegexp = regexp.MustCompile(`^>{3,}\/(\w+)\/(\d+)$`)
 )
 
 // Needed to avoid cyclic imports for the 'db' package
@@ -22,6 +26,64 @@ func init() {
 // internal: function was called by automated upkeep task
 func ParseBody(body []byte, board string, thread uint64, id uint64, ip string, internal bool) (
 	links []common.Link, com []common.Command, err error,
+) {
+	// Prevent #pyu duplication
+	isSlut := false
+
+	links, err = parseBody(body, board, internal, true,
+		func(match []byte) (err error) {
+			c, err := parseCommand(match, board, thread, id, ip, &isSlut)
+			switch err {
+			case nil:
+				com = append(com, c)
+			case errTooManyRolls, errDieTooBig:
+				// Consider command invalid
+				err = nil
+			}
+			return
+		})
+	return
+}
+
+// ParseEditedBody parses the links of an edited post body. Hash commands are
+// not run again, so the body must contain the same hash commands as the
+// previous body, whose results are kept.
+func ParseEditedBody(body, prev []byte, board string) (
+	links []common.Link, err error,
+) {
+	var old, edited [][]byte
+	_, err = parseBody(prev, board, true, false, func(match []byte) error {
+		old = append(old, match)
+		return nil
+	})
+	if err != nil {
+		return
+	}
+	links, err = parseBody(body, board, false, true, func(match []byte) error {
+		edited = append(edited, match)
+		return nil
+	})
+	if err != nil {
+		return
+	}
+
+	if len(old) != len(edited) {
+		return nil, errCommandsEdited
+	}
+	for i := range old {
+		if !bytes.Equal(old[i], edited[i]) {
+			return nil, errCommandsEdited
+		}
+	}
+	return
+}
+
+// Parse links and hash command matches of a post body. Each matched hash
+// command is passed to onCommand. Links are only parsed, if parseLinks = true.
+func parseBody(body []byte, board string, internal, parseLinks bool,
+	onCommand func(match []byte) error,
+) (
+	links []common.Link, err error,
 ) {
 	err = IsPrintableString(string(body), true)
 	if err != nil {
@@ -46,8 +108,6 @@ func ParseBody(body []byte, board string, thread uint64, id uint64, ip string, i
 
 	// Prevent link duplication
 	haveLink := make(map[uint64]bool)
-	// Prevent #pyu duplication
-	isSlut := false
 
 	for i, b := range body {
 		switch b {
@@ -68,12 +128,17 @@ func ParseBody(body []byte, board string, thread uint64, id uint64, ip string, i
 
 		switch word[0] {
 		case '>':
-			m := linkRegexp.FindSubmatch(word)
-			if m == nil {
+			if !parseLinks {
 				goto next
 			}
 			var l common.Link
-			l, err = parseLink(m)
+			if m := linkRegexp.FindSubmatch(word); m != nil {
+				l, err = parseLink(m)
+			} else if m := crossLinkRegexp.FindSubmatch(word); m != nil {
+				l, err = parseCrossLink(m)
+			} else {
+				goto next
+			}
 			switch {
 			case err != nil:
 				return
@@ -92,15 +157,8 @@ func ParseBody(body []byte, board string, thread uint64, id uint64, ip string, i
 			if m == nil {
 				goto next
 			}
-			var c common.Command
-			c, err = parseCommand(m[1], board, thread, id, ip, &isSlut)
-			switch err {
-			case nil:
-				com = append(com, c)
-			case errTooManyRolls, errDieTooBig:
-				// Consider command invalid
-				err = nil
-			default:
+			err = onCommand(m[1])
+			if err != nil {
 				return
 			}
 		}
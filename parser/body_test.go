@@ -128,3 +128,33 @@ func writeSampleThread(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestParseEditedBody(t *testing.T) {
+	config.SetBoardConfigs(config.BoardConfigs{
+		ID: "a",
+	})
+
+	cases := [...]struct {
+		name, body, prev string
+		err              error
+	}{
+		{"no commands", "foo", "bar", nil},
+		{"same commands", "#flip bar #d6", "#flip foo #d6", nil},
+		{"quoted commands", "foo\n>#flip", "foo", nil},
+		{"added command", "#flip #8ball", "#flip", errCommandsEdited},
+		{"removed command", "foo", "#flip", errCommandsEdited},
+		{"changed command", "#d20", "#d6", errCommandsEdited},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseEditedBody([]byte(c.body), []byte(c.prev), "a")
+			if err != c.err {
+				UnexpectedError(t, err)
+			}
+		})
+	}
+}
@@ -32,12 +32,16 @@ func ParseName(name string) (string, string, error) {
 	firstHash := strings.IndexByte(name, '#')
 	if firstHash > -1 {
 		password := name[firstHash+1:]
-		name = name[:firstHash]
-		if password[0] == '#' {
+		name = strings.TrimSpace(name[:firstHash])
+		switch {
+		case password == "", password == "#": // No password to hash
+			return name, "", nil
+		case password[0] == '#':
 			trip := tripcode.SecureTripcode(password[1:], config.Get().Salt)
 			return name, trip, nil
+		default:
+			return name, tripcode.Tripcode(password), nil
 		}
-		return name, tripcode.Tripcode(password), nil
 	}
 
 	return name, "", nil
@@ -21,6 +21,9 @@ func TestParseName(t *testing.T) {
 		{"secure trip", "##test", "", "mb8h72.d9g"},
 		{"name secure trip", "name##test", "name", "mb8h72.d9g"},
 		{"with padding spaces", "  name##test ", "name", "mb8h72.d9g"},
+		{"space before trip", "name #test", "name", ".CzKQna1OU"},
+		{"empty trip", "name#", "name", ""},
+		{"empty secure trip", "name##", "name", ""},
 	}
 
 	for i := range cases {
@@ -0,0 +1,107 @@
+// Inline [dice XdY] markup
+
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bakape/meguca/common"
+)
+
+var errTooManyDice = common.ErrInvalidInput("too many dice rolls")
+
+func init() {
+	common.RollDice = RollDice
+}
+
+// CheckDice asserts a post body contains at most common.MaxDiceMarkup dice
+// markup and that rolling it can never make the body longer than
+// common.MaxLenBody. Run on any change to the body, so the rolls on post
+// closure can not fail.
+func CheckDice(body string) error {
+	matches := common.DiceMarkupRegexp.FindAllStringSubmatch(body, -1)
+	if len(matches) > common.MaxDiceMarkup {
+		return errTooManyDice
+	}
+	n := utf8.RuneCountInString(body)
+	for _, m := range matches {
+		n += maxDiceLen(m) - len(m[0])
+	}
+	if n > common.MaxLenBody {
+		return common.ErrBodyTooLong
+	}
+	return nil
+}
+
+// Returns the maximum length of dice markup submatches after rolling
+func maxDiceLen(match []string) int {
+	rolls, err := strconv.Atoi(match[1])
+	if err != nil || rolls < 1 || rolls > common.MaxDiceMarkupRolls {
+		return len(unevaluatedDice(match))
+	}
+	sides, err := strconv.Atoi(match[2])
+	if err != nil || sides < 1 || sides > common.MaxDiceSides {
+		return len(unevaluatedDice(match))
+	}
+
+	// "[dice XdY: " + rolls joined by ", " + " = " + sum + "]"
+	return len(fmt.Sprintf("[dice %dd%d: ", rolls, sides)) +
+		rolls*len(strconv.Itoa(sides)) + (rolls-1)*2 +
+		len(" = ") + len(strconv.Itoa(rolls*sides)) + len("]")
+}
+
+// RollDice evaluates all "[dice XdY]" markup in a post body, replacing it with
+// the results, like "[dice 2d6: 3, 5 = 8]". Evaluated markup, that is already
+// present in prev, the previous version of the body, is kept as is. Any other
+// evaluated markup is rolled again, so results can not be forged by typing
+// them. Markup with more than common.MaxDiceMarkupRolls rolls or more than
+// common.MaxDiceSides sides is reset to its unevaluated form.
+// Returns the new body and, if it contains any evaluated rolls.
+func RollDice(body, prev string) (res string, rolled bool, err error) {
+	res = common.DiceMarkupRegexp.ReplaceAllStringFunc(body, func(m string,
+	) string {
+		if strings.Contains(prev, m) && common.DiceResultRegexp.MatchString(m) {
+			rolled = true
+			return m
+		}
+
+		match := common.DiceMarkupRegexp.FindStringSubmatch(m)
+		rolls, err := strconv.Atoi(match[1])
+		if err != nil || rolls < 1 || rolls > common.MaxDiceMarkupRolls {
+			return unevaluatedDice(match)
+		}
+		sides, err := strconv.Atoi(match[2])
+		if err != nil || sides < 1 || sides > common.MaxDiceSides {
+			return unevaluatedDice(match)
+		}
+
+		rolled = true
+		var (
+			sum int
+			w   strings.Builder
+		)
+		fmt.Fprintf(&w, "[dice %dd%d: ", rolls, sides)
+		for i := 0; i < rolls; i++ {
+			if i != 0 {
+				w.WriteString(", ")
+			}
+			roll := randInt(sides) + 1
+			sum += roll
+			w.WriteString(strconv.Itoa(roll))
+		}
+		fmt.Fprintf(&w, " = %d]", sum)
+		return w.String()
+	})
+	if utf8.RuneCountInString(res) > common.MaxLenBody {
+		err = common.ErrBodyTooLong
+	}
+	return
+}
+
+// Format dice markup submatches without results
+func unevaluatedDice(match []string) string {
+	return fmt.Sprintf("[dice %sd%s]", match[1], match[2])
+}
@@ -0,0 +1,144 @@
+package parser
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestRollDice(t *testing.T) {
+	t.Parallel()
+
+	result := regexp.MustCompile(`^\[dice 2d6: ([1-6]), ([1-6]) = (\d+)\]$`)
+
+	t.Run("no markup", func(t *testing.T) {
+		t.Parallel()
+
+		res, rolled, err := RollDice("foo [dice] 2d6", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertDeepEquals(t, res, "foo [dice] 2d6")
+		AssertDeepEquals(t, rolled, false)
+	})
+
+	t.Run("roll", func(t *testing.T) {
+		t.Parallel()
+
+		res, rolled, err := RollDice("[dice 2d6]", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertDeepEquals(t, rolled, true)
+		m := result.FindStringSubmatch(res)
+		if m == nil {
+			t.Fatalf("unexpected result: %s", res)
+		}
+		a, _ := strconv.Atoi(m[1])
+		b, _ := strconv.Atoi(m[2])
+		sum, _ := strconv.Atoi(m[3])
+		if a+b != sum {
+			t.Fatalf("invalid sum: %s", res)
+		}
+	})
+
+	t.Run("forged result", func(t *testing.T) {
+		t.Parallel()
+
+		const forged = "[dice 2d6: 6, 6 = 1000]"
+		res, _, err := RollDice(forged, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !result.MatchString(res) || strings.HasSuffix(res, "= 1000]") {
+			t.Fatalf("result not rerolled: %s", res)
+		}
+	})
+
+	t.Run("kept result", func(t *testing.T) {
+		t.Parallel()
+
+		const prev = "[dice 2d6: 6, 6 = 12]"
+		res, rolled, err := RollDice("edited "+prev, prev)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertDeepEquals(t, res, "edited "+prev)
+		AssertDeepEquals(t, rolled, true)
+	})
+
+	t.Run("out of bounds", func(t *testing.T) {
+		t.Parallel()
+
+		res, rolled, err := RollDice(
+			"[dice 21d6] [dice 1d10001] [dice 0d6: 0 = 0]",
+			"",
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertDeepEquals(t, res, "[dice 21d6] [dice 1d10001] [dice 0d6]")
+		AssertDeepEquals(t, rolled, false)
+	})
+
+	t.Run("too long", func(t *testing.T) {
+		t.Parallel()
+
+		body := strings.Repeat("[dice 20d10000]", 100)
+		_, _, err := RollDice(body, "")
+		if err != common.ErrBodyTooLong {
+			UnexpectedError(t, err)
+		}
+	})
+}
+
+func TestCheckDice(t *testing.T) {
+	t.Parallel()
+
+	// Longest possible result of [dice 20d10000]
+	longest := "[dice 20d10000: " +
+		strings.TrimSuffix(strings.Repeat("10000, ", 20), ", ") +
+		" = 200000]"
+
+	cases := [...]struct {
+		name, body string
+		err        error
+	}{
+		{"no markup", "foo", nil},
+		{"within limits", strings.Repeat("[dice 20d10000]", 10), nil},
+		{"out of bounds", strings.Repeat("[dice 21d6]", 10), nil},
+		{"too many", strings.Repeat("[dice 1d6]", 11), errTooManyDice},
+		{
+			"fits",
+			strings.Repeat("a", common.MaxLenBody-len(longest)) +
+				"[dice 20d10000]",
+			nil,
+		},
+		{
+			"too long",
+			strings.Repeat("a", common.MaxLenBody-len(longest)+1) +
+				"[dice 20d10000]",
+			common.ErrBodyTooLong,
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			AssertDeepEquals(t, CheckDice(c.body), c.err)
+		})
+	}
+
+	t.Run("matches rolls", func(t *testing.T) {
+		t.Parallel()
+
+		m := common.DiceMarkupRegexp.FindStringSubmatch("[dice 20d10000]")
+		AssertDeepEquals(t, maxDiceLen(m), len(longest))
+	})
+}
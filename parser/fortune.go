@@ -0,0 +1,157 @@
+// Inline [fortune] markup
+
+package parser
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/db"
+	"github.com/go-playground/log"
+)
+
+func init() {
+	common.DrawFortunes = DrawFortunes
+}
+
+// FortuneProvider draws random fortune messages
+type FortuneProvider interface {
+	Draw() string
+}
+
+// Fortunes is the provider used on boards without a custom fortune list. It
+// reads fortunes from the file set in the server configuration or uses the
+// built-in ones.
+var Fortunes FortuneProvider = new(fileFortunes)
+
+var defaultFortunes = fortuneList{
+	"Excellent luck",
+	"Good luck",
+	"Average luck",
+	"Bad luck",
+	"Very bad luck",
+	"Good news will come to you by mail",
+	"You will meet a dark handsome stranger",
+	"Better not tell you now",
+	"Outlook good",
+	"Godly luck",
+}
+
+// Draws fortunes from a fixed list
+type fortuneList []string
+
+func (f fortuneList) Draw() string {
+	return f[randInt(len(f))]
+}
+
+// Draws fortunes from a text file with one fortune per line. The file is
+// reread, when the configured path changes.
+type fileFortunes struct {
+	mu    sync.Mutex
+	path  *string
+	lines fortuneList
+}
+
+func (f *fileFortunes) Draw() string {
+	path := config.Get().FortunesPath
+
+	f.mu.Lock()
+	if f.path == nil || *f.path != path {
+		f.lines = nil
+		if path != "" {
+			var err error
+			f.lines, err = readFortunes(path)
+			if err != nil {
+				log.Warnf("fortune: could not load %s: %s", path, err)
+			}
+		}
+		f.path = &path
+	}
+	lines := f.lines
+	f.mu.Unlock()
+
+	if len(lines) == 0 {
+		return defaultFortunes.Draw()
+	}
+	return lines.Draw()
+}
+
+// Read fortunes from a file, skipping any empty or invalid lines
+func readFortunes(path string) (lines fortuneList, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	s := bufio.NewScanner(file)
+	for s.Scan() && len(lines) < common.MaxNumFortunes {
+		l := strings.TrimSpace(s.Text())
+		if l != "" && ValidateFortune(l) == nil {
+			lines = append(lines, l)
+		}
+	}
+	err = s.Err()
+	return
+}
+
+// ValidateFortune asserts a fortune can be embedded into a post body
+func ValidateFortune(s string) error {
+	switch {
+	case s == "":
+		return common.ErrInvalidInput("empty fortune")
+	case utf8.RuneCountInString(s) > common.MaxLenFortune:
+		return common.ErrTooLong("fortune")
+	case strings.ContainsAny(s, "<>\n"):
+		return common.ErrInvalidInput("fortune contains '<', '>' or newline")
+	}
+	return IsPrintableString(s, false)
+}
+
+// DrawFortunes replaces all "[fortune]" markup in a post body with a randomly
+// drawn fortune, like "<fortune>Good luck</fortune>". Boards with a custom
+// fortune list draw from that instead of the default provider. Fortunes, that
+// are already present in prev, the previous version of the body, are kept as
+// is. Any others are drawn again, so they can not be forged by typing them.
+// Only the first common.MaxFortuneMarkup fortunes are drawn.
+func DrawFortunes(body, prev, board string) (res string, err error) {
+	if !common.FortuneMarkupRegexp.MatchString(body) &&
+		!common.FortuneResultRegexp.MatchString(body) {
+		return body, nil
+	}
+
+	var provider FortuneProvider = Fortunes
+	custom, err := db.GetFortunes(board)
+	if err != nil {
+		return
+	}
+	if len(custom) != 0 {
+		provider = fortuneList(custom)
+	}
+
+	n := 0
+	draw := func(m string) string {
+		if n == common.MaxFortuneMarkup {
+			return "[fortune]"
+		}
+		n++
+		return "<fortune>" + provider.Draw() + "</fortune>"
+	}
+	res = common.FortuneResultRegexp.ReplaceAllStringFunc(body, func(m string,
+	) string {
+		if strings.Contains(prev, m) {
+			return m
+		}
+		return "[fortune]"
+	})
+	res = common.FortuneMarkupRegexp.ReplaceAllStringFunc(res, draw)
+	if utf8.RuneCountInString(res) > common.MaxLenBody {
+		err = common.ErrBodyTooLong
+	}
+	return
+}
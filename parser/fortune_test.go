@@ -0,0 +1,114 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestDrawFortunes(t *testing.T) {
+	t.Parallel()
+
+	result := regexp.MustCompile(`^foo <fortune>([^<>]+)</fortune>$`)
+	assertDefault := func(t *testing.T, res string) {
+		t.Helper()
+		m := result.FindStringSubmatch(res)
+		if m == nil {
+			t.Fatalf("unexpected result: %s", res)
+		}
+		for _, f := range defaultFortunes {
+			if f == m[1] {
+				return
+			}
+		}
+		t.Fatalf("unknown fortune: %s", m[1])
+	}
+
+	t.Run("no markup", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := DrawFortunes("foo [fortune", "", "a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertDeepEquals(t, res, "foo [fortune")
+	})
+
+	t.Run("draw", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := DrawFortunes("foo [fortune]", "", "a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertDefault(t, res)
+	})
+
+	t.Run("forged result", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := DrawFortunes("foo <fortune>Be rich</fortune>", "", "a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertDefault(t, res)
+	})
+
+	t.Run("kept from previous body", func(t *testing.T) {
+		t.Parallel()
+
+		const body = "foo <fortune>Be rich</fortune>"
+		res, err := DrawFortunes(body, body, "a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertDeepEquals(t, res, body)
+	})
+
+	t.Run("too many", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := DrawFortunes(
+			strings.Repeat("[fortune]", common.MaxFortuneMarkup+1), "", "a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasSuffix(res, "</fortune>[fortune]") {
+			t.Fatalf("unexpected result: %s", res)
+		}
+	})
+}
+
+func TestValidateFortune(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name, in string
+		valid    bool
+	}{
+		{"valid", "Good luck", true},
+		{"empty", "", false},
+		{"too long", strings.Repeat("a", common.MaxLenFortune+1), false},
+		{"tag", "<b>luck</b>", false},
+		{"newline", "good\nluck", false},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateFortune(c.in)
+			if c.valid {
+				if err != nil {
+					t.Fatal(err)
+				}
+			} else if err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+// Server-side syntax highlighting of [code lang=X]...[/code] blocks
+
+package parser
+
+import (
+	"bytes"
+	"html"
+	"strings"
+
+	"github.com/alecthomas/chroma"
+	chromahtml "github.com/alecthomas/chroma/formatters/html"
+	"github.com/alecthomas/chroma/lexers"
+	"github.com/alecthomas/chroma/styles"
+	"github.com/bakape/meguca/common"
+)
+
+// Supported code block languages mapped to their lexer names
+var codeLanguages = map[string]string{
+	"bash":       "bash",
+	"c":          "c",
+	"cpp":        "c++",
+	"cs":         "c#",
+	"css":        "css",
+	"go":         "go",
+	"haskell":    "haskell",
+	"html":       "html",
+	"java":       "java",
+	"js":         "javascript",
+	"javascript": "javascript",
+	"json":       "json",
+	"lua":        "lua",
+	"php":        "php",
+	"python":     "python",
+	"ruby":       "ruby",
+	"rust":       "rust",
+	"sh":         "bash",
+	"sql":        "sql",
+	"ts":         "typescript",
+	"typescript": "typescript",
+}
+
+var codeFormatter = chromahtml.New(chromahtml.WithClasses())
+
+func init() {
+	common.HighlightCode = HighlightCodeBlocks
+}
+
+// Highlight renders code as HTML with syntax highlighting CSS classes. Code
+// in unsupported languages is rendered as a plain <pre> block.
+func Highlight(lang, code string) (string, error) {
+	name, ok := codeLanguages[lang]
+	if !ok {
+		return plainCode(code), nil
+	}
+	lexer := lexers.Get(name)
+	if lexer == nil {
+		return plainCode(code), nil
+	}
+
+	it, err := chroma.Coalesce(lexer).Tokenise(nil, code)
+	if err != nil {
+		return "", err
+	}
+	var w bytes.Buffer
+	err = codeFormatter.Format(&w, styles.Fallback, it)
+	if err != nil {
+		return "", err
+	}
+	return w.String(), nil
+}
+
+func plainCode(code string) string {
+	return "<pre>" + html.EscapeString(code) + "</pre>"
+}
+
+// HighlightCodeBlocks extracts and highlights all code blocks in a closed
+// post's body in order of appearance. Blocks, that fail to highlight, fall
+// back to plain <pre> blocks.
+func HighlightCodeBlocks(body string) []common.CodeBlock {
+	matches := common.CodeBlockRegexp.FindAllStringSubmatch(body, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	blocks := make([]common.CodeBlock, len(matches))
+	for i, m := range matches {
+		b := common.CodeBlock{
+			Lang: m[1],
+			Code: strings.Trim(m[2], "\n"),
+		}
+		var err error
+		b.HTML, err = Highlight(b.Lang, b.Code)
+		if err != nil {
+			b.HTML = plainCode(b.Code)
+		}
+		blocks[i] = b
+	}
+	return blocks
+}
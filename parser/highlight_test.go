@@ -0,0 +1,52 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestHighlight(t *testing.T) {
+	t.Parallel()
+
+	t.Run("supported language", func(t *testing.T) {
+		t.Parallel()
+
+		html, err := Highlight("go", "package main")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(html, `<pre class="chroma">`) ||
+			!strings.Contains(html, `<span class="kn">package</span>`) {
+			t.Fatalf("unexpected output: %s", html)
+		}
+	})
+
+	t.Run("unsupported language", func(t *testing.T) {
+		t.Parallel()
+
+		html, err := Highlight("brainfuck", "<>+-")
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertDeepEquals(t, html, "<pre>&lt;&gt;+-</pre>")
+	})
+}
+
+func TestHighlightCodeBlocks(t *testing.T) {
+	t.Parallel()
+
+	AssertDeepEquals(t, HighlightCodeBlocks("no code"), []common.CodeBlock(nil))
+
+	blocks := HighlightCodeBlocks(
+		"foo [code lang=python]\nx = 1\n[/code] bar [code]<b>[/code]")
+	AssertDeepEquals(t, len(blocks), 2)
+	AssertDeepEquals(t, blocks[0].Lang, "python")
+	AssertDeepEquals(t, blocks[0].Code, "x = 1")
+	AssertDeepEquals(t, blocks[1], common.CodeBlock{
+		Code: "<b>",
+		HTML: "<pre>&lt;b&gt;</pre>",
+	})
+}
@@ -10,19 +10,34 @@ import (
 
 // Extract post links from a text fragment, verify and retrieve their
 // parenthood
-func parseLink(match [][]byte) (link common.Link, err error) {
-	id, err := strconv.ParseUint(string(match[1]), 10, 64)
+func parseLink(match [][]byte) (common.Link, error) {
+	return findLink(match[1], "")
+}
+
+// Extract cross-board post links of the >>>/board/id form. The link is only
+// valid, if the post is on the specified board.
+func parseCrossLink(match [][]byte) (common.Link, error) {
+	return findLink(match[2], string(match[1]))
+}
+
+// Verify a post exists and retrieve its parenthood. If board is not empty,
+// the post must also belong to it.
+func findLink(idStr []byte, board string) (link common.Link, err error) {
+	id, err := strconv.ParseUint(string(idStr), 10, 64)
 	if err != nil {
 		return
 	}
 
-	board, op, err := db.GetPostParenthood(id)
+	postBoard, op, err := db.GetPostParenthood(id)
 	switch err {
 	case nil:
+		if board != "" && board != postBoard {
+			return
+		}
 		link = common.Link{
 			ID:    id,
 			OP:    op,
-			Board: board,
+			Board: postBoard,
 		}
 	case sql.ErrNoRows: // Points to invalid post. Ignore.
 		err = nil
@@ -65,6 +65,14 @@ func TestParseLinks(t *testing.T) {
 			},
 		},
 		{"all links invalid", " >>88 >>2 >>33", nil},
+		{
+			"cross-board links",
+			">>>/a/6 >>>/c/8 >>>>/a/8",
+			[]common.Link{
+				{6, 1, "a"},
+				{8, 1, "a"},
+			},
+		},
 	}
 
 	for i := range cases {
@@ -267,7 +267,7 @@ func isLoggedIn(w http.ResponseWriter, r *http.Request,
 ) (
 	creds auth.SessionCreds, err error,
 ) {
-	creds = extractLoginCreds(r)
+	creds = auth.ExtractLoginCreds(r)
 	if creds.UserID == "" || creds.Session == "" {
 		err = errAccessDenied
 		return
@@ -283,17 +283,6 @@ func isLoggedIn(w http.ResponseWriter, r *http.Request,
 	return
 }
 
-// Extract login credentials from cookies
-func extractLoginCreds(r *http.Request) (creds auth.SessionCreds) {
-	if c, err := r.Cookie("session"); err == nil {
-		creds.Session = c.Value
-	}
-	if c, err := r.Cookie("loginID"); err == nil {
-		creds.UserID, _ = url.QueryUnescape(strings.TrimSpace(c.Value))
-	}
-	return
-}
-
 // Trim spaces from loginID
 func trimLoginID(id *string) {
 	*id = strings.TrimSpace(*id)
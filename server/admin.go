@@ -6,7 +6,9 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"net/url"
 	"regexp"
 	"strconv"
 	"time"
@@ -15,27 +17,61 @@ import (
 	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/parser"
 	"github.com/bakape/meguca/templates"
+	"github.com/bakape/meguca/webhooks"
 	"github.com/bakape/meguca/websockets/feeds"
 )
 
 const (
 	maxAnswers      = 100  // Maximum number of eightball answers
 	maxEightballLen = 2000 // Total chars in eightball
+
+	// Default maximum hamming distance of similar image hashes
+	defaultPHashThreshold = 10
 )
 
 var (
-	errEightballTooLong = common.ErrTooLong("eightball")
-	errTitleTooLong     = common.ErrTooLong("board title")
-	errNoticeTooLong    = common.ErrTooLong("notice")
-	errRulesTooLong     = common.ErrTooLong("rules")
-	errReasonTooLong    = common.ErrTooLong("reason")
-	errTooManyAnswers   = common.ErrInvalidInput("too many eightball answers")
-	errInvalidBoardName = common.ErrInvalidInput("invalid board name")
-	errBoardNameTaken   = common.ErrInvalidInput("board name taken")
-	errNoReason         = common.ErrInvalidInput("no reason provided")
-	errNoDuration       = common.ErrInvalidInput("no ban duration provided")
-	errAccessDenied     = common.ErrAccessDenied("missing permissions")
+	errEightballTooLong  = common.ErrTooLong("eightball")
+	errTitleTooLong      = common.ErrTooLong("board title")
+	errNoticeTooLong     = common.ErrTooLong("notice")
+	errRulesTooLong      = common.ErrTooLong("rules")
+	errCustomCSSTooLong  = common.ErrTooLong("custom CSS")
+	errReasonTooLong     = common.ErrTooLong("reason")
+	errModNoteTooLong    = common.ErrTooLong("moderator note")
+	errAppealTooLong     = common.ErrTooLong("appeal")
+	errWordFilterTooLong = common.ErrTooLong("word filter")
+	errNoWordFilter      = common.ErrInvalidInput("no word filter pattern")
+	errTooManyFortunes   = common.ErrInvalidInput("too many fortunes")
+	errWebhookURLTooLong = common.ErrTooLong("webhook URL")
+	errSecretTooLong     = common.ErrTooLong("webhook secret")
+	errInvalidWebhookURL = common.ErrInvalidInput("invalid webhook URL")
+	errNoWebhookEvents   = common.ErrInvalidInput("no webhook events")
+	errBadWebhookEvent   = common.ErrInvalidInput("unknown webhook event")
+	errInvalidThreshold  = common.ErrInvalidInput("similarity threshold")
+	errNoPHash           = common.ErrInvalidInput("post has no hashable image")
+	errTooManyAnswers    = common.ErrInvalidInput("too many eightball answers")
+	errTooManyThreads    = common.ErrInvalidInput("too many threads per page")
+	errInvalidFlood      = common.ErrInvalidInput("invalid flood protection")
+	errInvalidCooldown   = common.ErrInvalidInput("post cooldown too long")
+	errInvalidLastN      = common.ErrInvalidInput("default reply count too big")
+	errInvalidMaxReplies = common.ErrInvalidInput("reply limit too big")
+	errInvalidImageLimit = common.ErrInvalidInput("image limit too big")
+	errInvalidCyclicMax  = common.ErrInvalidInput("invalid cyclic post limit")
+	errInvalidBoardName  = common.ErrInvalidInput("invalid board name")
+	errInvalidTag        = common.ErrInvalidInput("invalid board tag")
+	errTooManyTags       = common.ErrInvalidInput("too many board tags")
+	errDuplicateTag      = common.ErrInvalidInput("duplicate board tag")
+	errInvalidOrigin     = common.ErrInvalidInput("invalid CORS origin")
+	errBadFileType       = common.ErrInvalidInput("unsupported file type")
+	errFileTypeOff       = common.ErrInvalidInput("file type disabled globally")
+	errBoardNameTaken    = common.ErrInvalidInput("board name taken")
+	errNoReason          = common.ErrInvalidInput("no reason provided")
+	errNoModNote         = common.ErrInvalidInput("no note provided")
+	errNoAppeal          = common.ErrInvalidInput("no appeal text provided")
+	errNoDuration        = common.ErrInvalidInput("no ban duration provided")
+	errInvalidIP         = common.ErrInvalidInput("invalid IP")
+	errAccessDenied      = common.ErrAccessDenied("missing permissions")
 
 	boardNameValidation = regexp.MustCompile(`^[a-z0-9]{1,10}$`)
 )
@@ -53,7 +89,7 @@ type boardCreationRequest struct {
 func configureBoard(w http.ResponseWriter, r *http.Request) {
 	err := func() (err error) {
 		var msg config.BoardConfigs
-		err = decodeJSON(r, &msg)
+		err = decodeJSONLimit(r, &msg, boardConfigLimit)
 		if err != nil {
 			return
 		}
@@ -145,7 +181,36 @@ func validateBoardConfigs(w http.ResponseWriter, conf config.BoardConfigs,
 		err = errRulesTooLong
 	case len(conf.Title) > common.MaxLenBoardTitle:
 		err = errTitleTooLong
+	case len(conf.CustomCSS) > common.MaxLenCustomCSS:
+		err = errCustomCSSTooLong
+	case conf.ThreadsPerPage > common.MaxThreadsPerPage:
+		err = errTooManyThreads
+	case conf.FloodPosts > common.MaxFloodPosts,
+		conf.FloodInterval > common.MaxFloodInterval:
+		err = errInvalidFlood
+	case conf.PostCooldown > common.MaxPostCooldown:
+		err = errInvalidCooldown
+	case conf.DefaultLastN > common.MaxDefaultLastN:
+		err = errInvalidLastN
+	case conf.MaxReplies > common.BumpLimit:
+		err = errInvalidMaxReplies
+	case conf.ImageLimit > common.BumpLimit:
+		err = errInvalidImageLimit
+	case len(conf.Tags) > common.MaxNumTags:
+		err = errTooManyTags
+	}
+	if err != nil {
+		return
+	}
+	err = validateFileTypes(conf.FileTypes, true)
+	if err != nil {
+		return
 	}
+	err = validateTags(conf.Tags)
+	if err != nil {
+		return
+	}
+	err = validateCustomCSS(conf.CustomCSS)
 	if err != nil {
 		return
 	}
@@ -163,6 +228,43 @@ func validateBoardConfigs(w http.ResponseWriter, conf config.BoardConfigs,
 	return
 }
 
+// Assert all file types in a whitelist are supported and, if checkGlobal,
+// are a subset of the global whitelist
+func validateFileTypes(types []string, checkGlobal bool) error {
+	for _, t := range types {
+		supported := false
+		for _, ext := range common.Extensions {
+			if t == ext {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return errBadFileType
+		}
+		if checkGlobal && !config.Get().AllowsFileType(t) {
+			return errFileTypeOff
+		}
+	}
+	return nil
+}
+
+// Assert board tags are lowercase alphanumeric strings with dashes and
+// contain no duplicates
+func validateTags(tags []string) error {
+	for i, t := range tags {
+		if !common.TagRegexp.MatchString(t) {
+			return errInvalidTag
+		}
+		for _, prev := range tags[:i] {
+			if t == prev {
+				return errDuplicateTag
+			}
+		}
+	}
+	return nil
+}
+
 // Serve the current board configurations to the client, including publically
 // unexposed ones. Intended to be used before setting the the configs with
 // configureBoard().
@@ -235,23 +337,8 @@ func createBoard(w http.ResponseWriter, r *http.Request) {
 		switch {
 		case creds.UserID != "admin" && config.Get().DisableUserBoards:
 			err = errAccessDenied
-		case !boardNameValidation.MatchString(msg.ID),
-			msg.ID == "",
-			len(msg.ID) > common.MaxLenBoardID,
-			// Returns, if the board name, matches a reserved ID
-			func() bool {
-				for _, s := range [...]string{
-					"html", "json", "api", "assets", "all",
-				} {
-					if msg.ID == s {
-						return true
-					}
-				}
-				return false
-			}():
-			err = errInvalidBoardName
-		case len(msg.Title) > 100:
-			err = errTitleTooLong
+		default:
+			err = validateBoardCreation(msg.ID, msg.Title)
 		}
 		if err != nil {
 			return
@@ -270,40 +357,76 @@ func createBoard(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		err = db.InTransaction(false, func(tx *sql.Tx) (err error) {
-			err = db.WriteBoard(tx, db.BoardConfigs{
-				Created: time.Now().UTC(),
-				BoardConfigs: config.BoardConfigs{
-					BoardPublic: config.BoardPublic{
-						Title:      msg.Title,
-						DefaultCSS: config.Get().DefaultCSS,
-					},
-					ID:        msg.ID,
-					Eightball: config.EightballDefaults,
-				},
-			})
-			switch {
-			case err == nil:
-			case db.IsConflictError(err):
-				err = errBoardNameTaken
-				return
-			default:
-				return
-			}
-
-			return db.WriteStaff(tx, msg.ID,
-				map[common.ModerationLevel][]string{
-					common.BoardOwner: []string{creds.UserID},
-				})
-		})
-
-		return
+		return writeNewBoard(newBoardConfigs(msg.ID, msg.Title), creds.UserID)
 	}()
 	if err != nil {
 		httpError(w, r, err)
 	}
 }
 
+// Validate the ID and title of a board to be created
+func validateBoardCreation(id, title string) error {
+	switch {
+	case !boardNameValidation.MatchString(id),
+		id == "",
+		len(id) > common.MaxLenBoardID,
+		// Returns, if the board name, matches a reserved ID
+		func() bool {
+			for _, s := range [...]string{
+				"html", "json", "api", "assets", "all", "boards", "feed",
+				"metrics", "health", "ready", "amp",
+			} {
+				if id == s {
+					return true
+				}
+			}
+			return false
+		}():
+		return errInvalidBoardName
+	case len(title) > 100:
+		return errTitleTooLong
+	default:
+		return nil
+	}
+}
+
+// Default configurations of a newly created board
+func newBoardConfigs(id, title string) config.BoardConfigs {
+	return config.BoardConfigs{
+		BoardPublic: config.BoardPublic{
+			Title:      title,
+			DefaultCSS: config.Get().DefaultCSS,
+		},
+		ID:             id,
+		ThreadsPerPage: config.DefaultThreadsPerPage,
+		FloodInterval:  config.DefaultFloodInterval,
+		Eightball:      config.EightballDefaults,
+	}
+}
+
+// Write a new board to the database and assign owner as its owner
+func writeNewBoard(conf config.BoardConfigs, owner string) error {
+	return db.InTransaction(false, func(tx *sql.Tx) (err error) {
+		err = db.WriteBoard(tx, db.BoardConfigs{
+			Created:      time.Now().UTC(),
+			BoardConfigs: conf,
+		})
+		switch {
+		case err == nil:
+		case db.IsConflictError(err):
+			err = errBoardNameTaken
+			return
+		default:
+			return
+		}
+
+		return db.WriteStaff(tx, conf.ID,
+			map[common.ModerationLevel][]string{
+				common.BoardOwner: []string{owner},
+			})
+	})
+}
+
 // Set the server configuration to match the one sent from the admin account
 // user
 func configureServer(w http.ResponseWriter, r *http.Request) {
@@ -322,6 +445,14 @@ func configureServer(w http.ResponseWriter, r *http.Request) {
 			err = common.StatusError{errors.New("too few captcha tags"), 400}
 			return
 		}
+		err = validateFileTypes(msg.FileTypes, false)
+		if err != nil {
+			return
+		}
+		err = validateOrigins(msg.CORSOrigins)
+		if err != nil {
+			return
+		}
 		err = db.WriteConfigs(msg)
 		return
 	}()
@@ -386,6 +517,44 @@ func deletePostsByIP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// Delete all posts made from an IP on a board and respond with the number of
+// deleted posts
+func deleteAllByIP(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		var req struct {
+			IP, Board, Reason string
+		}
+		err = decodeJSON(r, &req)
+		switch {
+		case err != nil:
+			return
+		case net.ParseIP(req.IP) == nil:
+			return errInvalidIP
+		case len(req.Reason) > common.MaxLenReason:
+			return errReasonTooLong
+		}
+
+		if !assertNotBanned(w, r, req.Board) {
+			return
+		}
+
+		creds, err := canPerform(w, r, req.Board, common.Janitor, true)
+		if err != nil {
+			return
+		}
+		n, err := db.DeleteAllByIP(req.IP, req.Board, creds.UserID,
+			req.Reason)
+		if err != nil {
+			return
+		}
+		serveJSON(w, r, "", n)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
 // Same as moderatePost, but works on an array of posts
 func moderatePosts(w http.ResponseWriter, r *http.Request,
 	fn func(ids []uint64, userID string) error,
@@ -462,6 +631,7 @@ func ban(w http.ResponseWriter, r *http.Request) {
 	err := func() (err error) {
 		var msg struct {
 			Global       bool
+			Prefix       uint8
 			ID, Duration uint64
 			Reason       string
 		}
@@ -498,9 +668,9 @@ func ban(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		// Apply ban
-		return db.Ban(board, msg.Reason, creds.UserID,
-			time.Minute*time.Duration(msg.Duration), msg.ID)
+		// Apply ban. A non-zero prefix bans the IP's surrounding range.
+		return db.BanRange(board, msg.Reason, creds.UserID,
+			time.Minute*time.Duration(msg.Duration), msg.ID, msg.Prefix)
 	}()
 	if err != nil {
 		httpError(w, r, err)
@@ -611,6 +781,196 @@ func getSameIPPosts(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// Retrieve posts on the same board with images perceptually similar to the
+// image of a post. The maximum hamming distance of the image hashes can be
+// set with the "threshold" query parameter.
+func getSimilarImages(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		id, err := extractID(r)
+		if err != nil {
+			return
+		}
+		threshold := defaultPHashThreshold
+		if s := r.URL.Query().Get("threshold"); s != "" {
+			threshold, err = strconv.Atoi(s)
+			if err != nil || threshold < 0 || threshold > 64 {
+				return errInvalidThreshold
+			}
+		}
+
+		board, _, err := canModeratePost(w, r, id, common.Moderator)
+		if err != nil {
+			return
+		}
+
+		post, err := db.GetPost(id)
+		if err != nil {
+			return
+		}
+		if post.Image == nil || post.Image.PHash == "" {
+			return errNoPHash
+		}
+		posts, err := db.FindSimilarImages(board, post.Image.PHash, threshold)
+		if err != nil {
+			return
+		}
+		serveJSON(w, r, "", posts)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Retrieve all posts made from an IP on a board
+func getPostsByIP(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		board := extractParam(r, "board")
+		_, err = canPerform(w, r, board, common.Moderator, false)
+		if err != nil {
+			return
+		}
+
+		var msg struct {
+			IP string
+		}
+		err = decodeJSON(r, &msg)
+		if err != nil {
+			return
+		}
+		if net.ParseIP(msg.IP) == nil {
+			return errInvalidIP
+		}
+
+		posts, err := db.GetIPBoardHistory(msg.IP, board)
+		if err != nil {
+			return
+		}
+		serveJSON(w, r, "", posts)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Retrieve all posts made from an IP on any board within a time range. Both
+// ends of the range are Unix timestamps. If until is omitted, defaults to the
+// current time. Requires global moderator rights.
+func getIPHistory(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		_, err = canPerform(w, r, "all", common.Moderator, false)
+		if err != nil {
+			return
+		}
+
+		var msg struct {
+			IP           string
+			Since, Until int64
+		}
+		err = decodeJSON(r, &msg)
+		if err != nil {
+			return
+		}
+		if net.ParseIP(msg.IP) == nil {
+			return errInvalidIP
+		}
+		until := time.Now()
+		if msg.Until != 0 {
+			until = time.Unix(msg.Until, 0)
+		}
+
+		posts, err := db.GetIPHistory(msg.IP, time.Unix(msg.Since, 0), until)
+		if err != nil {
+			return
+		}
+		serveJSON(w, r, "", posts)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Retrieve all IPs, that have posted in a thread
+func getThreadIPs(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		id, err := extractID(r)
+		if err != nil {
+			return
+		}
+
+		_, _, err = canModeratePost(w, r, id, common.Moderator)
+		if err != nil {
+			return
+		}
+
+		ips, err := db.GetThreadIPs(id)
+		if err != nil {
+			return
+		}
+		serveJSON(w, r, "", ips)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Attach a private moderator comment to a post
+func addModNote(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		var msg struct {
+			ID   uint64
+			Text string
+		}
+		err = decodeJSON(r, &msg)
+		if err != nil {
+			return
+		}
+		switch {
+		case msg.Text == "":
+			return errNoModNote
+		case len(msg.Text) > common.MaxLenModNote:
+			return errModNoteTooLong
+		}
+
+		_, userID, err := canModeratePost(w, r, msg.ID, common.Moderator)
+		if err != nil {
+			return
+		}
+		return db.AddModNote(msg.ID, userID, msg.Text)
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Retrieve all moderator comments on posts of a thread
+func getThreadModNotes(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		id, err := extractID(r)
+		if err != nil {
+			return
+		}
+
+		_, _, err = canModeratePost(w, r, id, common.Moderator)
+		if err != nil {
+			return
+		}
+
+		notes, err := db.GetThreadModNotes(id)
+		if err != nil {
+			return
+		}
+		serveJSON(w, r, "", notes)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
 // Set the sticky flag of a thread
 func setThreadSticky(w http.ResponseWriter, r *http.Request) {
 	handleBoolRequest(w, r, func(id uint64, val bool, _ string) error {
@@ -618,6 +978,79 @@ func setThreadSticky(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// Set a thread to only retain its latest posts
+func setThreadCyclic(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		var msg struct {
+			ID  uint64
+			Val bool
+			Max uint16
+		}
+		err = decodeJSON(r, &msg)
+		if err != nil {
+			return
+		}
+
+		board, _, err := canModeratePost(w, r, msg.ID, common.Moderator)
+		if err != nil {
+			return
+		}
+		valid, err := db.ValidateOP(msg.ID, board)
+		switch {
+		case err != nil:
+			return
+		case !valid:
+			return common.ErrInvalidThread(msg.ID, board)
+		}
+
+		switch {
+		case msg.Max == 0:
+			msg.Max = common.DefaultCyclicMax
+		case msg.Max < common.MinCyclicMax, msg.Max > common.BumpLimit:
+			return errInvalidCyclicMax
+		}
+		return db.SetThreadCyclic(msg.ID, msg.Val, msg.Max)
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Override the board's reply limit for a thread. A max of 0 restores the board
+// default.
+func setThreadMaxReplies(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		var msg struct {
+			ID  uint64
+			Max uint16
+		}
+		err = decodeJSON(r, &msg)
+		if err != nil {
+			return
+		}
+
+		board, _, err := canModeratePost(w, r, msg.ID, common.Moderator)
+		if err != nil {
+			return
+		}
+		valid, err := db.ValidateOP(msg.ID, board)
+		switch {
+		case err != nil:
+			return
+		case !valid:
+			return common.ErrInvalidThread(msg.ID, board)
+		}
+
+		if msg.Max > common.BumpLimit {
+			return errInvalidMaxReplies
+		}
+		return db.SetThreadMaxReplies(msg.ID, msg.Max)
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
 // Handle moderation request, that takes a boolean parameter,
 // fn is the database call to be used for performing this operation.
 func handleBoolRequest(w http.ResponseWriter, r *http.Request,
@@ -645,9 +1078,26 @@ func handleBoolRequest(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// Set the locked flag of a thread
+// Set the locked flag of a thread and notify all clients in the thread
 func setThreadLock(w http.ResponseWriter, r *http.Request) {
-	handleBoolRequest(w, r, db.SetThreadLock)
+	handleBoolRequest(w, r, lockThread)
+}
+
+func lockThread(id uint64, locked bool, by string) (err error) {
+	err = db.SetThreadLock(id, locked, by)
+	if err != nil {
+		return
+	}
+	msg, err := common.EncodeMessage(common.MessageThreadLock,
+		common.ThreadLockMessage{
+			ThreadID: id,
+			Locked:   locked,
+		})
+	if err != nil {
+		return
+	}
+	feeds.BroadcastToThread(id, msg)
+	return
 }
 
 // Render list of bans on a board with unban links for authenticated staff
@@ -669,6 +1119,306 @@ func banList(w http.ResponseWriter, r *http.Request) {
 		detectCanPerform(r, board, common.Moderator))
 }
 
+// Appeal a ban on a board
+func submitAppeal(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		var msg struct {
+			ForPost     uint64
+			Board, Text string
+		}
+		err = decodeJSON(r, &msg)
+		if err != nil {
+			return
+		}
+		switch {
+		case !auth.IsBoard(msg.Board):
+			return errInvalidBoardName
+		case msg.Text == "":
+			return errNoAppeal
+		case len(msg.Text) > common.MaxLenAppeal:
+			return errAppealTooLong
+		}
+
+		ip, err := auth.GetIP(r)
+		if err != nil {
+			return common.StatusError{err, 400}
+		}
+		return db.SubmitAppeal(msg.Board, msg.ForPost, ip, msg.Text)
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Retrieve unresolved ban appeals for a board
+func getPendingAppeals(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		board := extractParam(r, "board")
+		_, err = canPerform(w, r, board, common.Moderator, false)
+		if err != nil {
+			return
+		}
+
+		appeals, err := db.GetPendingAppeals(board)
+		if err != nil {
+			return
+		}
+		serveJSON(w, r, "", appeals)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Resolve a ban appeal and optionally lift the ban
+func resolveAppeal(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		var msg struct {
+			Lift              bool
+			ID                uint64
+			Board, Resolution string
+		}
+		err = decodeJSON(r, &msg)
+		if err != nil {
+			return
+		}
+		if len(msg.Resolution) > common.MaxLenReason {
+			return errReasonTooLong
+		}
+
+		creds, err := canPerform(w, r, msg.Board, common.Moderator, false)
+		if err != nil {
+			return
+		}
+		return db.ResolveAppeal(msg.ID, msg.Board, msg.Lift, msg.Resolution,
+			creds.UserID)
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Retrieve the word filters configured for a board
+func getWordFilters(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		board := extractParam(r, "board")
+		_, err = canPerform(w, r, board, common.Moderator, false)
+		if err != nil {
+			return
+		}
+
+		filters, err := db.GetWordFilters(board)
+		if err != nil {
+			return
+		}
+		serveJSON(w, r, "", filters)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Add a word filter to a board
+func addWordFilter(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		var msg auth.WordFilter
+		err = decodeJSON(r, &msg)
+		if err != nil {
+			return
+		}
+		switch {
+		case msg.Pattern == "":
+			return errNoWordFilter
+		case len(msg.Pattern) > common.MaxLenWordFilter,
+			len(msg.Replacement) > common.MaxLenWordFilter:
+			return errWordFilterTooLong
+		}
+
+		_, err = canPerform(w, r, msg.Board, common.Moderator, false)
+		if err != nil {
+			return
+		}
+		return db.AddWordFilter(msg)
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Remove a word filter from a board
+func removeWordFilter(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		var msg struct {
+			ID    uint64
+			Board string
+		}
+		err = decodeJSON(r, &msg)
+		if err != nil {
+			return
+		}
+
+		_, err = canPerform(w, r, msg.Board, common.Moderator, false)
+		if err != nil {
+			return
+		}
+		return db.RemoveWordFilter(msg.Board, msg.ID)
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Retrieve the custom fortune list of a board
+func getFortunes(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		board := extractParam(r, "board")
+		_, err = canPerform(w, r, board, common.Moderator, false)
+		if err != nil {
+			return
+		}
+
+		lines, err := db.GetFortunes(board)
+		if err != nil {
+			return
+		}
+		serveJSON(w, r, "", lines)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Replace the custom fortune list of a board. An empty list reverts the board
+// to the default fortunes.
+func setFortunes(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		var msg struct {
+			Board    string
+			Fortunes []string
+		}
+		err = decodeJSON(r, &msg)
+		if err != nil {
+			return
+		}
+		if len(msg.Fortunes) > common.MaxNumFortunes {
+			return errTooManyFortunes
+		}
+		for _, f := range msg.Fortunes {
+			err = parser.ValidateFortune(f)
+			if err != nil {
+				return
+			}
+		}
+
+		_, err = canPerform(w, r, msg.Board, common.Moderator, false)
+		if err != nil {
+			return
+		}
+		return db.SetFortunes(msg.Board, msg.Fortunes)
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Retrieve the webhooks registered on a board
+func getWebhooks(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		board := extractParam(r, "board")
+		_, err = canPerform(w, r, board, common.Moderator, false)
+		if err != nil {
+			return
+		}
+
+		hooks, err := db.GetWebhooks(board)
+		if err != nil {
+			return
+		}
+		// Secrets are write-only. Exposing them would allow any moderator to
+		// forge signed deliveries.
+		for i := range hooks {
+			hooks[i].Secret = ""
+		}
+		serveJSON(w, r, "", hooks)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Register a webhook on a board
+func addWebhook(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		var msg auth.Webhook
+		err = decodeJSON(r, &msg)
+		if err != nil {
+			return
+		}
+		err = validateWebhook(msg)
+		if err != nil {
+			return
+		}
+
+		_, err = canPerform(w, r, msg.Board, common.Moderator, false)
+		if err != nil {
+			return
+		}
+		return db.AddWebhook(msg)
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+func validateWebhook(wh auth.Webhook) error {
+	switch {
+	case len(wh.URL) > common.MaxLenWebhookURL:
+		return errWebhookURLTooLong
+	case len(wh.Secret) > common.MaxLenSecret:
+		return errSecretTooLong
+	case len(wh.Events) == 0:
+		return errNoWebhookEvents
+	}
+	u, err := url.Parse(wh.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") ||
+		u.Host == "" {
+		return errInvalidWebhookURL
+	}
+	for _, e := range wh.Events {
+		if !webhooks.IsEvent(e) {
+			return errBadWebhookEvent
+		}
+	}
+	return nil
+}
+
+// Remove a webhook from a board
+func removeWebhook(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		var msg struct {
+			ID    uint64
+			Board string
+		}
+		err = decodeJSON(r, &msg)
+		if err != nil {
+			return
+		}
+
+		_, err = canPerform(w, r, msg.Board, common.Moderator, false)
+		if err != nil {
+			return
+		}
+		return db.RemoveWebhook(msg.Board, msg.ID)
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
 // Detect, if a  client can perform moderation on a board. Unlike canPerform,
 // this will not send any errors to the client, if no access rights detected.
 func detectCanPerform(
@@ -678,7 +1428,7 @@ func detectCanPerform(
 ) (
 	can bool,
 ) {
-	creds := extractLoginCreds(r)
+	creds := auth.ExtractLoginCreds(r)
 	if creds.UserID == "" || creds.Session == "" {
 		return
 	}
@@ -752,7 +1502,8 @@ func modLog(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	log, err := db.GetModLog(board)
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	log, err := db.GetModLog(board, page)
 	if err != nil {
 		httpError(w, r, err)
 		return
@@ -824,9 +1575,7 @@ func redirectByThread(w http.ResponseWriter, r *http.Request) {
 		if err != nil {
 			return
 		}
-		for _, c := range feeds.GetByThread(id) {
-			c.Send(msg)
-		}
+		feeds.BroadcastToThread(id, msg)
 		return
 	}()
 	if err != nil {
@@ -193,6 +193,56 @@ func TestValidateBoardConfigs(t *testing.T) {
 			},
 			errTitleTooLong,
 		},
+		{
+			"unsupported file type",
+			config.BoardConfigs{
+				BoardPublic: config.BoardPublic{
+					FileTypes: []string{"jpg", "exe"},
+				},
+			},
+			errBadFileType,
+		},
+		{
+			"too many tags",
+			config.BoardConfigs{
+				BoardPublic: config.BoardPublic{
+					DefaultCSS: "moe",
+					Tags: []string{"a", "b", "c", "d", "e", "f", "g", "h",
+						"i"},
+				},
+			},
+			errTooManyTags,
+		},
+		{
+			"invalid tag",
+			config.BoardConfigs{
+				BoardPublic: config.BoardPublic{
+					DefaultCSS: "moe",
+					Tags:       []string{"Tech"},
+				},
+			},
+			errInvalidTag,
+		},
+		{
+			"duplicate tag",
+			config.BoardConfigs{
+				BoardPublic: config.BoardPublic{
+					DefaultCSS: "moe",
+					Tags:       []string{"tech", "anime", "tech"},
+				},
+			},
+			errDuplicateTag,
+		},
+		{
+			"valid tags",
+			config.BoardConfigs{
+				BoardPublic: config.BoardPublic{
+					DefaultCSS: "moe",
+					Tags:       []string{"tech", "retro-games"},
+				},
+			},
+			nil,
+		},
 	}
 
 	for i := range cases {
@@ -243,6 +293,12 @@ func TestValidateBoardCreation(t *testing.T) {
 			title: "foo",
 			err:   errInvalidBoardName,
 		},
+		{
+			name:  "reserved board name",
+			id:    "feed",
+			title: "foo",
+			err:   errInvalidBoardName,
+		},
 		{
 			name:  "title too long",
 			id:    "b",
@@ -578,8 +634,8 @@ func writeSampleThread(t *testing.T) {
 	t.Helper()
 
 	thread := db.Thread{
-		ID:        1,
-		Board:     "a",
+		ID:         1,
+		Board:      "a",
 		UpdateTime: 11,
 	}
 	op := db.Post{
@@ -597,3 +653,69 @@ func writeSampleThread(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestValidateWebhook(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name string
+		auth.Webhook
+		err error
+	}{
+		{
+			name: "valid",
+			Webhook: auth.Webhook{
+				URL:    "https://example.com/hook",
+				Events: []string{"newPost", "newThread"},
+			},
+		},
+		{
+			name: "no events",
+			Webhook: auth.Webhook{
+				URL: "https://example.com/hook",
+			},
+			err: errNoWebhookEvents,
+		},
+		{
+			name: "unknown event",
+			Webhook: auth.Webhook{
+				URL:    "https://example.com/hook",
+				Events: []string{"newPost", "deletePost"},
+			},
+			err: errBadWebhookEvent,
+		},
+		{
+			name: "bad scheme",
+			Webhook: auth.Webhook{
+				URL:    "ftp://example.com/hook",
+				Events: []string{"newPost"},
+			},
+			err: errInvalidWebhookURL,
+		},
+		{
+			name: "no host",
+			Webhook: auth.Webhook{
+				URL:    "http:///hook",
+				Events: []string{"newPost"},
+			},
+			err: errInvalidWebhookURL,
+		},
+		{
+			name: "secret too long",
+			Webhook: auth.Webhook{
+				URL:    "https://example.com/hook",
+				Secret: GenString(common.MaxLenSecret + 1),
+				Events: []string{"newPost"},
+			},
+			err: errSecretTooLong,
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			AssertDeepEquals(t, validateWebhook(c.Webhook), c.err)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/cache"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/templates"
+)
+
+// Serve the AMP version of a board's first index page
+func ampBoardHTML(w http.ResponseWriter, r *http.Request) {
+	b := extractParam(r, "board")
+	if !auth.IsBoard(b) {
+		text404(w)
+		return
+	}
+	if !assertNotBanned(w, r, b) {
+		return
+	}
+
+	k, f, err := boardCacheArgs(r, b, false)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	_, data, _, err := cache.GetJSONAndData(k, f)
+	switch err {
+	case nil:
+	case cache.ErrPageOverflow:
+		text404(w)
+		return
+	default:
+		httpError(w, r, err)
+		return
+	}
+
+	setHTMLHeaders(w)
+	err = templates.AMPBoard(w, b, data.(cache.PageStore).Data.Threads)
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Serve the AMP version of a thread with only the last replies
+func ampThreadHTML(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(extractParam(r, "id"), 10, 64)
+	if err != nil {
+		text404(w)
+		return
+	}
+	board, op, err := db.GetPostParenthood(id)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	if op != id {
+		text404(w)
+		return
+	}
+	if !assertNotBanned(w, r, board) {
+		return
+	}
+
+	k := cache.ThreadKey(id, templates.AMPLastN)
+	_, data, _, err := cache.GetJSONAndData(k, cache.ThreadFE)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+
+	setHTMLHeaders(w)
+	err = templates.AMPThread(w, data.(common.Thread))
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
@@ -3,15 +3,21 @@
 package server
 
 import (
+	"github.com/bakape/meguca/auth"
 	"github.com/bakape/meguca/cache"
+	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/db"
 	"net/http"
+	"sort"
 	"strconv"
+	"strings"
 )
 
+var errNoValidBoards = common.ErrInvalidInput("no valid boards")
+
 // Returns arguments for accessing the board page JSON/HTML cache
 func boardCacheArgs(r *http.Request, board string, catalog bool) (
-	k cache.Key, f cache.FrontEnd,
+	k cache.Key, f cache.FrontEnd, err error,
 ) {
 	var page int64
 	if !catalog {
@@ -22,6 +28,13 @@ func boardCacheArgs(r *http.Request, board string, catalog bool) (
 	}
 
 	k = cache.BoardKey(board, page, !catalog)
+	k.Order = common.ParseSortOrder(r.URL.Query().Get("sort"))
+	if board == "all" {
+		k.Boards, err = parseBoardFilter(r.URL.Query().Get("boards"))
+		if err != nil {
+			return
+		}
+	}
 	if catalog {
 		f = cache.CatalogFE
 	} else {
@@ -30,6 +43,34 @@ func boardCacheArgs(r *http.Request, board string, catalog bool) (
 	return
 }
 
+// Normalize a comma-separated list of boards to show on the "/all/" metaboard.
+// Nonexistent boards and duplicates are discarded and the rest sorted, so
+// equivalent lists share the same cache entry. Returns an error, if s is not
+// empty, but contains no valid boards.
+func parseBoardFilter(s string) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	boards := make([]string, 0, 8)
+	for _, b := range strings.Split(s, ",") {
+		if auth.IsNonMetaBoard(b) {
+			boards = append(boards, b)
+		}
+	}
+	sort.Strings(boards)
+	n := 0
+	for i, b := range boards {
+		if i == 0 || b != boards[n-1] {
+			boards[n] = b
+			n++
+		}
+	}
+	if n == 0 {
+		return "", errNoValidBoards
+	}
+	return strings.Join(boards[:n], ","), nil
+}
+
 // Start cache upkeep proccesses. Requires a ready DB connection.
 func listenToThreadDeletion() error {
 	return db.Listen("thread_deleted", func(msg string) (err error) {
@@ -0,0 +1,41 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/bakape/meguca/config"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestParseBoardFilter(t *testing.T) {
+	config.ClearBoards()
+	for _, b := range [...]string{"a", "c"} {
+		_, err := config.SetBoardConfigs(config.BoardConfigs{
+			ID: b,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cases := [...]struct {
+		name, in, out string
+		err           error
+	}{
+		{"empty", "", "", nil},
+		{"single", "a", "a", nil},
+		{"sorted", "c,a", "a,c", nil},
+		{"duplicates", "a,c,a", "a,c", nil},
+		{"nonexistent", "a,x,all", "a", nil},
+		{"no valid boards", "x,all", "", errNoValidBoards},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			res, err := parseBoardFilter(c.in)
+			AssertDeepEquals(t, err, c.err)
+			AssertDeepEquals(t, res, c.out)
+		})
+	}
+}
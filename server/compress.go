@@ -0,0 +1,103 @@
+package server
+
+import (
+	"compress/gzip"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Responses smaller than this are not worth compressing
+const minGzipSize = 512
+
+var gzipWriters = sync.Pool{
+	New: func() interface{} {
+		return gzip.NewWriter(nil)
+	},
+}
+
+// Compress responses with gzip, if the client supports it and the body exceeds
+// minGzipSize. A no-op, when all traffic is already compressed by enableGzip.
+// Must not wrap streaming handlers, as the body is buffered until the
+// threshold is reached.
+func compressResponses(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if enableGzip {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Add("Vary", "Accept-Encoding")
+		if !strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		gw := &gzipResponseWriter{
+			ResponseWriter: w,
+			code:           200,
+		}
+		defer gw.close()
+		h.ServeHTTP(gw, r)
+	})
+}
+
+// Buffers the response until it is known, if it should be compressed
+type gzipResponseWriter struct {
+	http.ResponseWriter
+	code        int
+	wroteHeader bool
+	buf         []byte
+	gz          *gzip.Writer
+}
+
+func (w *gzipResponseWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.code = code
+		w.wroteHeader = true
+	}
+}
+
+func (w *gzipResponseWriter) Write(p []byte) (int, error) {
+	if w.gz != nil {
+		return w.gz.Write(p)
+	}
+	w.buf = append(w.buf, p...)
+	if len(w.buf) >= minGzipSize &&
+		w.Header().Get("Content-Encoding") == "" {
+		if err := w.startGzip(); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Switch to writing the response through a gzip writer
+func (w *gzipResponseWriter) startGzip() (err error) {
+	head := w.Header()
+	head.Set("Content-Encoding", "gzip")
+	head.Del("Content-Length")
+	w.ResponseWriter.WriteHeader(w.code)
+
+	w.gz = gzipWriters.Get().(*gzip.Writer)
+	w.gz.Reset(w.ResponseWriter)
+	_, err = w.gz.Write(w.buf)
+	w.buf = nil
+	return
+}
+
+// Flush any buffered data to the client
+func (w *gzipResponseWriter) close() {
+	if w.gz != nil {
+		w.gz.Close()
+		w.gz.Reset(nil)
+		gzipWriters.Put(w.gz)
+		return
+	}
+
+	// Response too small to compress
+	w.ResponseWriter.WriteHeader(w.code)
+	if len(w.buf) != 0 {
+		w.ResponseWriter.Write(w.buf)
+	}
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	. "github.com/bakape/meguca/test"
+)
+
+func TestCompressResponses(t *testing.T) {
+	t.Parallel()
+
+	big := bytes.Repeat([]byte("a"), minGzipSize*2)
+	small := []byte(`{"a":1}`)
+
+	cases := [...]struct {
+		name       string
+		body       []byte
+		acceptGzip bool
+		compressed bool
+		code       int
+		setLength  bool
+	}{
+		{
+			name:       "compressed",
+			body:       big,
+			acceptGzip: true,
+			compressed: true,
+			code:       200,
+			setLength:  true,
+		},
+		{
+			name:       "below threshold",
+			body:       small,
+			acceptGzip: true,
+			code:       200,
+			setLength:  true,
+		},
+		{
+			name: "no gzip support",
+			body: big,
+			code: 200,
+		},
+		{
+			name:       "no body",
+			acceptGzip: true,
+			code:       304,
+		},
+		{
+			name:       "error status",
+			body:       big,
+			acceptGzip: true,
+			compressed: true,
+			code:       404,
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := compressResponses(http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					if c.setLength {
+						w.Header().Set("Content-Length",
+							strconv.Itoa(len(c.body)))
+					}
+					w.WriteHeader(c.code)
+					w.Write(c.body)
+				},
+			))
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/", nil)
+			if c.acceptGzip {
+				req.Header.Set("Accept-Encoding", "gzip, deflate")
+			}
+			h.ServeHTTP(rec, req)
+
+			assertCode(t, rec, c.code)
+			AssertDeepEquals(t, rec.Header().Get("Vary"), "Accept-Encoding")
+
+			body := rec.Body.Bytes()
+			if c.compressed {
+				AssertDeepEquals(t, rec.Header().Get("Content-Encoding"),
+					"gzip")
+				AssertDeepEquals(t, rec.Header().Get("Content-Length"), "")
+				r, err := gzip.NewReader(rec.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body, err = ioutil.ReadAll(r)
+				if err != nil {
+					t.Fatal(err)
+				}
+			} else {
+				AssertDeepEquals(t, rec.Header().Get("Content-Encoding"), "")
+			}
+			AssertDeepEquals(t, len(body), len(c.body))
+		})
+	}
+}
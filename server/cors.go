@@ -0,0 +1,85 @@
+package server
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/bakape/meguca/config"
+)
+
+// Allow configured external origins to make cross-origin requests to the API
+func handleCORS(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/api/") {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		origin := r.Header.Get("Origin")
+		if origin != "" {
+			setCORSHeaders(w.Header(), r, origin)
+		}
+
+		// Preflight request
+		if r.Method == "OPTIONS" &&
+			r.Header.Get("Access-Control-Request-Method") != "" {
+			w.WriteHeader(204)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+func setCORSHeaders(head http.Header, r *http.Request, origin string) {
+	conf := config.Get()
+	head.Add("Vary", "Origin")
+
+	wildcard := false
+	allowed := false
+	for _, o := range conf.CORSOrigins {
+		switch o {
+		case "*":
+			wildcard = true
+		case origin:
+			allowed = true
+		}
+	}
+	switch {
+	case wildcard:
+		// Browsers refuse credentialed requests to wildcard origins anyway
+		head.Set("Access-Control-Allow-Origin", "*")
+	case allowed:
+		head.Set("Access-Control-Allow-Origin", origin)
+		if conf.CORSCredentials {
+			head.Set("Access-Control-Allow-Credentials", "true")
+		}
+	default:
+		return
+	}
+
+	if r.Method == "OPTIONS" {
+		head.Set("Access-Control-Allow-Methods", "GET, POST, DELETE")
+		if h := r.Header.Get("Access-Control-Request-Headers"); h != "" {
+			head.Set("Access-Control-Allow-Headers", h)
+		}
+		head.Set("Access-Control-Max-Age", "600")
+	}
+}
+
+// Validate configured CORS origins are either "*" or bare scheme and host
+// pairs, as sent in the Origin header
+func validateOrigins(origins []string) error {
+	for _, o := range origins {
+		if o == "*" {
+			continue
+		}
+		u, err := url.Parse(o)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") ||
+			u.Host == "" || u.Path != "" || u.RawQuery != "" ||
+			u.Fragment != "" || u.User != nil {
+			return errInvalidOrigin
+		}
+	}
+	return nil
+}
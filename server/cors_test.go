@@ -0,0 +1,121 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/bakape/meguca/config"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestCORS(t *testing.T) {
+	conf := *config.Get()
+	defer config.Set(conf)
+
+	const origin = "https://example.com"
+
+	cases := [...]struct {
+		name, method, path, origin string
+		origins                    []string
+		credentials                bool
+		allowOrigin, allowCreds    string
+		code                       int
+	}{
+		{
+			name:        "allowed origin",
+			method:      "GET",
+			path:        "/api/v1/openapi.json",
+			origin:      origin,
+			origins:     []string{"https://foo.com", origin},
+			credentials: true,
+			allowOrigin: origin,
+			allowCreds:  "true",
+			code:        200,
+		},
+		{
+			name:    "unknown origin",
+			method:  "GET",
+			path:    "/api/v1/openapi.json",
+			origin:  "https://bar.com",
+			origins: []string{origin},
+			code:    200,
+		},
+		{
+			name:        "wildcard",
+			method:      "GET",
+			path:        "/api/v1/openapi.json",
+			origin:      origin,
+			origins:     []string{"*"},
+			credentials: true,
+			allowOrigin: "*",
+			code:        200,
+		},
+		{
+			name:        "preflight",
+			method:      "OPTIONS",
+			path:        "/api/create-reply",
+			origin:      origin,
+			origins:     []string{origin},
+			allowOrigin: origin,
+			code:        204,
+		},
+		{
+			name:    "not API",
+			method:  "GET",
+			path:    "/robots.txt",
+			origin:  origin,
+			origins: []string{"*"},
+			code:    200,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			conf := conf
+			conf.CORSOrigins = c.origins
+			conf.CORSCredentials = c.credentials
+			config.Set(conf)
+
+			rec, req := newPair(c.path)
+			req.Method = c.method
+			req.Header.Set("Origin", c.origin)
+			if c.method == "OPTIONS" {
+				req.Header.Set("Access-Control-Request-Method", "POST")
+			}
+			router.ServeHTTP(rec, req)
+
+			assertCode(t, rec, c.code)
+			head := rec.Header()
+			AssertDeepEquals(t, head.Get("Access-Control-Allow-Origin"),
+				c.allowOrigin)
+			AssertDeepEquals(t, head.Get("Access-Control-Allow-Credentials"),
+				c.allowCreds)
+			if c.code == 204 {
+				AssertDeepEquals(t, head.Get("Access-Control-Allow-Methods"),
+					"GET, POST, DELETE")
+			}
+		})
+	}
+}
+
+func TestValidateOrigins(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name    string
+		origins []string
+		err     error
+	}{
+		{"empty", nil, nil},
+		{"valid", []string{"*", "https://example.com", "http://a:8000"}, nil},
+		{"path", []string{"https://example.com/"}, errInvalidOrigin},
+		{"scheme", []string{"example.com"}, errInvalidOrigin},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			AssertDeepEquals(t, validateOrigins(c.origins), c.err)
+		})
+	}
+}
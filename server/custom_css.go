@@ -0,0 +1,220 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/util"
+)
+
+var (
+	errCSSImport      = common.ErrInvalidInput("@import in custom CSS")
+	errCSSExternalURL = common.ErrInvalidInput("external URL in custom CSS")
+)
+
+// Serve the custom CSS of a board
+func serveCustomCSS(w http.ResponseWriter, r *http.Request) {
+	b := extractParam(r, "board")
+	if !auth.IsNonMetaBoard(b) {
+		text404(w)
+		return
+	}
+	css := config.GetBoardConfigs(b).CustomCSS
+	if css == "" {
+		text404(w)
+		return
+	}
+
+	etag := util.HashBuffer([]byte(css))
+	if checkClientEtag(w, r, etag) {
+		return
+	}
+	head := w.Header()
+	for key, val := range vanillaHeaders {
+		head.Set(key, val)
+	}
+	head.Set("ETag", etag)
+	head.Set("Content-Type", "text/css; charset=utf-8")
+	writeData(w, r, []byte(css))
+}
+
+// Reject custom CSS, that can load resources from other hosts. Any @import
+// rules and absolute or protocol-relative URLs are rejected, both in url() and
+// in strings, as these can be passed to image-set() and other functions.
+// Escape sequences are decoded before matching.
+func validateCustomCSS(css string) error {
+	for i := 0; i < len(css); {
+		switch c := css[i]; {
+		case strings.HasPrefix(css[i:], "/*"):
+			end := strings.Index(css[i+2:], "*/")
+			if end == -1 {
+				return nil
+			}
+			i += end + 4
+		case c == '"' || c == '\'':
+			var s string
+			s, i = readCSSString(css, i)
+			if isExternalURL(s) {
+				return errCSSExternalURL
+			}
+		case c == '@':
+			var name string
+			name, i = readCSSIdent(css, i+1)
+			if strings.ToLower(name) == "import" {
+				return errCSSImport
+			}
+		case isCSSIdentStart(css, i):
+			var name string
+			name, i = readCSSIdent(css, i)
+			if i < len(css) && css[i] == '(' &&
+				strings.ToLower(name) == "url" {
+				var arg string
+				arg, i = readCSSURL(css, i+1)
+				if isExternalURL(arg) {
+					return errCSSExternalURL
+				}
+			}
+		default:
+			i++
+		}
+	}
+	return nil
+}
+
+// Returns, if a string is an absolute or protocol-relative URL with a host
+func isExternalURL(s string) bool {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if strings.HasPrefix(s, "//") {
+		return true
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == ':':
+			return i != 0 && strings.HasPrefix(s[i+1:], "//")
+		case c >= 'a' && c <= 'z', i != 0 && (c >= '0' && c <= '9' ||
+			c == '+' || c == '-' || c == '.'):
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// Returns, if an identifier starts at i
+func isCSSIdentStart(css string, i int) bool {
+	c := css[i]
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_' ||
+		c == '-' || c == '\\' || c >= utf8.RuneSelf
+}
+
+func isCSSIdentChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' ||
+		c >= '0' && c <= '9' || c == '_' || c == '-' || c >= utf8.RuneSelf
+}
+
+// Read an identifier starting at i and return it with escapes decoded and
+// the index after it
+func readCSSIdent(css string, i int) (string, int) {
+	var w strings.Builder
+	for i < len(css) {
+		c := css[i]
+		switch {
+		case c == '\\':
+			var r rune
+			r, i = decodeCSSEscape(css, i+1)
+			w.WriteRune(r)
+		case isCSSIdentChar(c):
+			w.WriteByte(c)
+			i++
+		default:
+			return w.String(), i
+		}
+	}
+	return w.String(), i
+}
+
+// Read a quoted string starting at i and return its decoded contents and the
+// index after it
+func readCSSString(css string, i int) (string, int) {
+	var w strings.Builder
+	quote := css[i]
+	i++
+	for i < len(css) {
+		c := css[i]
+		switch c {
+		case quote:
+			return w.String(), i + 1
+		case '\\':
+			var r rune
+			r, i = decodeCSSEscape(css, i+1)
+			w.WriteRune(r)
+		default:
+			w.WriteByte(c)
+			i++
+		}
+	}
+	return w.String(), i
+}
+
+// Read the argument of url(), starting after the opening parenthesis. Quoted
+// arguments are left to be read as strings.
+func readCSSURL(css string, i int) (string, int) {
+	for i < len(css) && strings.IndexByte(" \t\n\r\f", css[i]) != -1 {
+		i++
+	}
+	if i < len(css) && (css[i] == '"' || css[i] == '\'') {
+		return "", i
+	}
+
+	var w strings.Builder
+	for i < len(css) {
+		c := css[i]
+		switch c {
+		case ')':
+			return w.String(), i + 1
+		case '\\':
+			var r rune
+			r, i = decodeCSSEscape(css, i+1)
+			w.WriteRune(r)
+		default:
+			w.WriteByte(c)
+			i++
+		}
+	}
+	return w.String(), i
+}
+
+// Decode an escape sequence starting after the backslash at i. Returns the
+// decoded rune and the index after the sequence.
+func decodeCSSEscape(css string, i int) (rune, int) {
+	if i >= len(css) {
+		return utf8.RuneError, i
+	}
+
+	j := i
+	for j < len(css) && j-i < 6 && isHex(css[j]) {
+		j++
+	}
+	if j == i {
+		// Escaped literal character
+		r, size := utf8.DecodeRuneInString(css[i:])
+		return r, i + size
+	}
+
+	n, _ := strconv.ParseUint(css[i:j], 16, 32)
+	// A single whitespace character terminates the sequence
+	if j < len(css) && strings.IndexByte(" \t\n\r\f", css[j]) != -1 {
+		j++
+	}
+	return rune(n), j
+}
+
+func isHex(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/bakape/meguca/config"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestValidateCustomCSS(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name, css string
+		err       error
+	}{
+		{"empty", "", nil},
+		{"plain", "body { color: red; }", nil},
+		{"relative URL", "div { background: url(/assets/a.png) }", nil},
+		{"quoted relative URL", `div { background: url("a.png") }`, nil},
+		{"data URL", "div { background: url(data:image/png;base64,AA) }", nil},
+		{"URL in comment", "/* url(http://a.com/a.png) */", nil},
+		{"text content", `a::after { content: "a: b" }`, nil},
+		{"import", `@import "a.css";`, errCSSImport},
+		{"uppercase import", `@IMPORT url(a.css);`, errCSSImport},
+		{"escaped import", `@\69mport "a.css";`, errCSSImport},
+		{
+			"external URL",
+			"div { background: url(https://a.com/a.png) }",
+			errCSSExternalURL,
+		},
+		{
+			"protocol-relative URL",
+			"div { background: url( //a.com/a.png ) }",
+			errCSSExternalURL,
+		},
+		{
+			"quoted external URL",
+			`div { background: url('http://a.com/a.png') }`,
+			errCSSExternalURL,
+		},
+		{
+			"image-set",
+			`div { background: image-set("http://a.com/a.png" 1x) }`,
+			errCSSExternalURL,
+		},
+		{
+			"escaped URL function",
+			`div { background: u\72l(http://a.com/a.png) }`,
+			errCSSExternalURL,
+		},
+		{
+			"escaped scheme",
+			`div { background: url(\68ttp://a.com/a.png) }`,
+			errCSSExternalURL,
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			AssertDeepEquals(t, validateCustomCSS(c.css), c.err)
+		})
+	}
+}
+
+func TestServeCustomCSS(t *testing.T) {
+	config.ClearBoards()
+	for _, c := range [...]config.BoardConfigs{
+		{ID: "a", CustomCSS: "body { color: red; }"},
+		{ID: "c"},
+	} {
+		if _, err := config.SetBoardConfigs(c); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cases := [...]struct {
+		name, board string
+		code        int
+	}{
+		{"no such board", "b", 404},
+		{"no custom CSS", "c", 404},
+		{"custom CSS", "a", 200},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rec, req := newPair("/boards/" + c.board + "/custom.css")
+			router.ServeHTTP(rec, req)
+			assertCode(t, rec, c.code)
+			if c.code != 200 {
+				return
+			}
+
+			assertBody(t, rec, "body { color: red; }")
+			AssertDeepEquals(t, rec.Header().Get("Content-Type"),
+				"text/css; charset=utf-8")
+
+			etag := rec.Header().Get("ETag")
+			rec, req = newPair("/boards/" + c.board + "/custom.css")
+			req.Header.Set("If-None-Match", etag)
+			router.ServeHTTP(rec, req)
+			assertCode(t, rec, 304)
+		})
+	}
+}
@@ -27,6 +27,7 @@ func init() {
 		case "debug":
 			mlog.Init(mlog.Console)
 			mlog.ConsoleHandler.SetDisplayColor(true)
+			go handleShutdownSignals()
 			startServer()
 		case "stop":
 			killDaemon()
@@ -65,6 +66,10 @@ func daemonize() {
 	defer daemonContext.Release()
 	log.Info("Server started ------------------------------------")
 
+	daemon.SetSigHandler(func(os.Signal) error {
+		GracefulShutdown(shutdownTimeout)
+		return daemon.ErrStop
+	}, syscall.SIGTERM)
 	go startServer()
 	if err := daemon.ServeSignals(); err != nil {
 		log.Fatalf("daemon runtime error: %s\n", err)
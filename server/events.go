@@ -0,0 +1,241 @@
+// Server-sent event streams of board activity
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/db"
+)
+
+const (
+	// Number of most recent events kept for replaying to reconnecting clients
+	eventBufferSize = 256
+
+	// Interval of keep-alive comments sent on idle streams
+	eventKeepAlive = 30 * time.Second
+)
+
+// Types of board events
+const (
+	eventNewThread     = "new_thread"
+	eventNewPost       = "new_post"
+	eventThreadDeleted = "thread_deleted"
+)
+
+// Activity on a board
+type boardEvent struct {
+	id     uint64
+	Type   string `json:"type"`
+	Board  string `json:"board"`
+	Thread uint64 `json:"thread"`
+	Post   uint64 `json:"post,omitempty"`
+}
+
+// Distributes board events to subscribed streams and retains the most recent
+// events in a ring buffer
+var boardEvents = eventHub{
+	subs: make(map[chan boardEvent]string),
+}
+
+type eventHub struct {
+	mu     sync.Mutex
+	lastID uint64
+	// Ring buffer of recent events. Event with ID n is stored at
+	// n % eventBufferSize.
+	buffer [eventBufferSize]boardEvent
+	// Subscribed channels and the board they are subscribed to
+	subs map[chan boardEvent]string
+}
+
+// Returns, if an event should be sent to a subscriber of board
+func (e boardEvent) matches(board string) bool {
+	return board == "all" || board == e.Board
+}
+
+// Assign an ID to the event, buffer it and send it to all matching
+// subscribers. Slow subscribers, that have a full channel, are skipped and
+// can catch up through Last-Event-ID.
+func (h *eventHub) publish(e boardEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.lastID++
+	e.id = h.lastID
+	h.buffer[e.id%eventBufferSize] = e
+	for ch, board := range h.subs {
+		if e.matches(board) {
+			select {
+			case ch <- e:
+			default:
+			}
+		}
+	}
+}
+
+// Subscribe to events on a board. Returns any buffered events after lastID.
+func (h *eventHub) subscribe(board string, lastID uint64) (
+	ch chan boardEvent, missed []boardEvent,
+) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ch = make(chan boardEvent, 32)
+	h.subs[ch] = board
+
+	if lastID == 0 || lastID >= h.lastID {
+		return
+	}
+	start := lastID + 1
+	if h.lastID-lastID > eventBufferSize {
+		start = h.lastID - eventBufferSize + 1
+	}
+	for id := start; id <= h.lastID; id++ {
+		e := h.buffer[id%eventBufferSize]
+		if e.matches(board) {
+			missed = append(missed, e)
+		}
+	}
+	return
+}
+
+func (h *eventHub) unsubscribe(ch chan boardEvent) {
+	h.mu.Lock()
+	delete(h.subs, ch)
+	h.mu.Unlock()
+}
+
+// Publish board events from database notifications
+func listenToBoardEvents() (err error) {
+	err = db.Listen("thread_inserted", func(msg string) (err error) {
+		board, id, err := db.SplitBoardAndID(msg)
+		if err != nil {
+			return
+		}
+		boardEvents.publish(boardEvent{
+			Type:   eventNewThread,
+			Board:  board,
+			Thread: id,
+		})
+		return
+	})
+	if err != nil {
+		return
+	}
+
+	err = db.Listen("thread_deleted", func(msg string) (err error) {
+		board, id, err := db.SplitBoardAndID(msg)
+		if err != nil {
+			return
+		}
+		boardEvents.publish(boardEvent{
+			Type:   eventThreadDeleted,
+			Board:  board,
+			Thread: id,
+		})
+		return
+	})
+	if err != nil {
+		return
+	}
+
+	return db.Listen("post_inserted", func(msg string) (err error) {
+		board, ids, err := splitPostInsertion(msg)
+		if err != nil {
+			return
+		}
+		boardEvents.publish(boardEvent{
+			Type:   eventNewPost,
+			Board:  board,
+			Thread: ids[0],
+			Post:   ids[1],
+		})
+		return
+	})
+}
+
+// Split a "board,op,id" post insertion message
+func splitPostInsertion(msg string) (board string, ids []uint64, err error) {
+	i := strings.IndexByte(msg, ',')
+	if i == -1 {
+		err = db.ErrMsgParse(msg)
+		return
+	}
+	board = msg[:i]
+	ids, err = db.SplitUint64s(msg[i+1:], 2)
+	return
+}
+
+// Stream new threads, posts and thread deletions on a board as server-sent
+// events. Events missed since the Last-Event-ID are replayed, if still
+// buffered.
+func serveBoardEvents(w http.ResponseWriter, r *http.Request) {
+	board := extractParam(r, "board")
+	if !auth.IsBoard(board) {
+		jsonError(w, 404, "no such board")
+		return
+	}
+	if !assertNotBanned(w, r, board) {
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		jsonError(w, 500, "streaming not supported")
+		return
+	}
+
+	lastID, _ := strconv.ParseUint(r.Header.Get("Last-Event-ID"), 10, 64)
+	ch, missed := boardEvents.subscribe(board, lastID)
+	defer boardEvents.unsubscribe(ch)
+
+	head := w.Header()
+	head.Set("Content-Type", "text/event-stream")
+	head.Set("Cache-Control", "no-cache")
+	head.Set("X-Accel-Buffering", "no") // Disable nginx response buffering
+	w.WriteHeader(200)
+
+	for _, e := range missed {
+		if !writeEvent(w, r, e) {
+			return
+		}
+	}
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(eventKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case e := <-ch:
+			if !writeEvent(w, r, e) {
+				return
+			}
+		case <-keepAlive.C:
+			if _, err := w.Write([]byte(":\n\n")); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+// Write an event to the stream. Returns false, if the stream should be
+// closed.
+func writeEvent(w http.ResponseWriter, r *http.Request, e boardEvent) bool {
+	buf, err := json.Marshal(e)
+	if err != nil {
+		logError(r, err)
+		return false
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.id, e.Type,
+		buf)
+	return err == nil
+}
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"testing"
+
+	. "github.com/bakape/meguca/test"
+)
+
+func TestSplitPostInsertion(t *testing.T) {
+	board, ids, err := splitPostInsertion("a,1,2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, board, "a")
+	AssertDeepEquals(t, ids, []uint64{1, 2})
+
+	for _, msg := range [...]string{"a", "a,1", "a,1,b"} {
+		if _, _, err := splitPostInsertion(msg); err == nil {
+			t.Errorf("expected error for message: %s", msg)
+		}
+	}
+}
+
+func TestEventHub(t *testing.T) {
+	h := eventHub{
+		subs: make(map[chan boardEvent]string),
+	}
+	a, _ := h.subscribe("a", 0)
+	all, _ := h.subscribe("all", 0)
+	defer h.unsubscribe(a)
+	defer h.unsubscribe(all)
+
+	h.publish(boardEvent{Type: eventNewThread, Board: "a", Thread: 1})
+	h.publish(boardEvent{Type: eventNewThread, Board: "c", Thread: 2})
+
+	e := <-a
+	AssertDeepEquals(t, e.Thread, uint64(1))
+	if len(a) != 0 {
+		t.Fatal("event from other board received")
+	}
+	AssertDeepEquals(t, (<-all).Thread, uint64(1))
+	AssertDeepEquals(t, (<-all).Thread, uint64(2))
+
+	t.Run("replay", func(t *testing.T) {
+		ch, missed := h.subscribe("a", 0)
+		h.unsubscribe(ch)
+		AssertDeepEquals(t, len(missed), 0)
+
+		h.publish(boardEvent{Type: eventNewPost, Board: "a", Thread: 1,
+			Post: 3})
+		ch, missed = h.subscribe("a", 1)
+		h.unsubscribe(ch)
+		AssertDeepEquals(t, len(missed), 1)
+		AssertDeepEquals(t, missed[0].Post, uint64(3))
+	})
+
+	t.Run("buffer overflow", func(t *testing.T) {
+		for i := 0; i < eventBufferSize*2; i++ {
+			h.publish(boardEvent{Board: "a"})
+		}
+		ch, missed := h.subscribe("a", 1)
+		h.unsubscribe(ch)
+		AssertDeepEquals(t, len(missed), eventBufferSize)
+	})
+}
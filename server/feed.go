@@ -0,0 +1,163 @@
+// Atom feeds of board threads
+
+package server
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/cache"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/util"
+)
+
+// Number of most recently bumped threads to include in a feed
+const feedLength = 20
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Link    atomLink    `xml:"link"`
+	Author  atomAuthor  `xml:"author"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Link    atomLink    `xml:"link"`
+	Content atomContent `xml:"content"`
+}
+
+type atomContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+// Serve an Atom feed of the most recently bumped threads on a board. The
+// board parameter must have an ".xml" suffix.
+func serveFeed(w http.ResponseWriter, r *http.Request) {
+	board := extractParam(r, "board")
+	if !strings.HasSuffix(board, ".xml") {
+		text404(w)
+		return
+	}
+	board = strings.TrimSuffix(board, ".xml")
+	if !auth.IsBoard(board) {
+		text404(w)
+		return
+	}
+	if !assertNotBanned(w, r, board) {
+		return
+	}
+
+	buf, err := buildFeed(board)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+
+	etag := util.HashBuffer(buf)
+	if checkClientEtag(w, r, etag) {
+		return
+	}
+	head := w.Header()
+	for key, val := range vanillaHeaders {
+		head.Set(key, val)
+	}
+	head.Set("ETag", etag)
+	head.Set("Content-Type", "application/atom+xml; charset=utf-8")
+	writeData(w, r, buf)
+}
+
+// Generate an Atom feed document for a board
+func buildFeed(board string) (buf []byte, err error) {
+	ids, _, _, err := db.GetThreadIDsPage(board, 0, feedLength, common.SortBump,
+		nil)
+	if err != nil {
+		return
+	}
+
+	var title string
+	if board == "all" {
+		title = config.AllBoardConfigs.Title
+	} else {
+		title = config.GetBoardConfigs(board).Title
+	}
+	root := config.Get().RootURL
+	feed := atomFeed{
+		ID:    fmt.Sprintf("%s/%s/", root, board),
+		Title: fmt.Sprintf("/%s/ - %s", board, title),
+		Link: atomLink{
+			Href: fmt.Sprintf("%s/%s/", root, board),
+		},
+		Author: atomAuthor{
+			Name: "Anonymous",
+		},
+		Entries: make([]atomEntry, 0, len(ids)),
+	}
+
+	var updated int64
+	for _, id := range ids {
+		var data interface{}
+		_, data, _, err = cache.GetJSONAndData(cache.ThreadKey(id, 5),
+			cache.ThreadFE)
+		if err != nil {
+			return
+		}
+		t := data.(common.Thread)
+		if t.BumpTime > updated {
+			updated = t.BumpTime
+		}
+
+		url := fmt.Sprintf("%s/%s/%d", root, t.Board, t.ID)
+		title := t.Subject
+		if title == "" {
+			title = fmt.Sprintf("No. %d", t.ID)
+		}
+		feed.Entries = append(feed.Entries, atomEntry{
+			ID:      url,
+			Title:   title,
+			Updated: formatFeedTime(t.BumpTime),
+			Link: atomLink{
+				Href: url,
+			},
+			Content: atomContent{
+				Type: "text",
+				Body: t.Body,
+			},
+		})
+	}
+	if updated == 0 {
+		updated = time.Now().Unix()
+	}
+	feed.Updated = formatFeedTime(updated)
+
+	buf, err = xml.Marshal(feed)
+	if err != nil {
+		return
+	}
+	buf = append([]byte(xml.Header), buf...)
+	return
+}
+
+func formatFeedTime(t int64) string {
+	return time.Unix(t, 0).UTC().Format(time.RFC3339)
+}
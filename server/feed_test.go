@@ -0,0 +1,49 @@
+package server
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/bakape/meguca/cache"
+	"github.com/bakape/meguca/config"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestServeFeed(t *testing.T) {
+	setupPosts(t)
+	setBoards(t, "a")
+	cache.Clear()
+
+	cases := [...]struct {
+		name, url string
+		code      int
+	}{
+		{"no suffix", "/a", 404},
+		{"nonexistent board", "/nope.xml", 404},
+		{"board", "/a.xml", 200},
+		{"all boards", "/all.xml", 200},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec, req := newPair("/feed" + c.url)
+			router.ServeHTTP(rec, req)
+			assertCode(t, rec, c.code)
+			if c.code != 200 {
+				return
+			}
+
+			var feed atomFeed
+			err := xml.Unmarshal(rec.Body.Bytes(), &feed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertDeepEquals(t, len(feed.Entries), 1)
+			AssertDeepEquals(t, feed.Entries[0].Link.Href,
+				config.Get().RootURL+"/a/1")
+		})
+	}
+}
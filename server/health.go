@@ -0,0 +1,94 @@
+// Health and readiness checks for load balancers and orchestrators
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/bakape/meguca/db"
+)
+
+// Maximum duration of a database health check
+const healthCheckTimeout = 500 * time.Millisecond
+
+// Tables, that must exist for the server to be ready to serve requests
+var requiredTables = [...]string{"posts", "threads", "bans"}
+
+type healthStatus struct {
+	Status        string   `json:"status"`
+	DB            string   `json:"db"`
+	MissingTables []string `json:"missing_tables,omitempty"`
+}
+
+// Report, if the database is reachable
+func serveHealth(w http.ResponseWriter, r *http.Request) {
+	var s healthStatus
+	s.DB = checkDB(r, func() error {
+		return db.Ping()
+	})
+	writeHealth(w, r, s)
+}
+
+// Report, if the database is reachable and has all required tables
+func serveReadiness(w http.ResponseWriter, r *http.Request) {
+	var (
+		s       healthStatus
+		missing []string
+	)
+	s.DB = checkDB(r, func() (err error) {
+		missing, err = db.MissingTables(requiredTables[:]...)
+		return
+	})
+	// Only safe to read, if the check completed in time
+	if s.DB == "ok" && len(missing) != 0 {
+		s.DB = "missing tables"
+		s.MissingTables = missing
+	}
+	writeHealth(w, r, s)
+}
+
+// Run a database check in a separate goroutine, so a hung connection can not
+// block the response past healthCheckTimeout. Returns the status of the
+// database.
+func checkDB(r *http.Request, fn func() error) string {
+	done := make(chan error, 1)
+	go func() {
+		done <- fn()
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			logError(r, err)
+			return "error"
+		}
+		return "ok"
+	case <-time.After(healthCheckTimeout):
+		return "timeout"
+	}
+}
+
+func writeHealth(w http.ResponseWriter, r *http.Request, s healthStatus) {
+	code := 200
+	if s.DB == "ok" {
+		s.Status = "ok"
+	} else {
+		s.Status = "degraded"
+		code = 503
+	}
+
+	buf, err := json.Marshal(s)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	head := w.Header()
+	for key, val := range vanillaHeaders {
+		head.Set(key, val)
+	}
+	head.Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	writeData(w, r, buf)
+}
@@ -0,0 +1,28 @@
+package server
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/bakape/meguca/test"
+)
+
+func TestHealthChecks(t *testing.T) {
+	for _, path := range [...]string{"/health", "/ready"} {
+		t.Run(path, func(t *testing.T) {
+			rec, req := newPair(path)
+			router.ServeHTTP(rec, req)
+			assertCode(t, rec, 200)
+
+			var s healthStatus
+			err := json.Unmarshal(rec.Body.Bytes(), &s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertDeepEquals(t, s, healthStatus{
+				Status: "ok",
+				DB:     "ok",
+			})
+		})
+	}
+}
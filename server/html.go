@@ -41,7 +41,12 @@ func boardHTML(w http.ResponseWriter, r *http.Request, b string, catalog bool) {
 		return
 	}
 
-	html, data, ctr, err := cache.GetHTML(boardCacheArgs(r, b, catalog))
+	k, f, err := boardCacheArgs(r, b, catalog)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	html, data, ctr, err := cache.GetHTML(k, f)
 	switch err {
 	case nil:
 	case cache.ErrPageOverflow:
@@ -112,7 +117,7 @@ func threadHTML(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	lastN := detectLastN(r)
+	lastN := detectLastN(r, b)
 	k := cache.ThreadKey(id, lastN)
 	html, data, ctr, err := cache.GetHTML(k, cache.ThreadFE)
 	if err != nil {
@@ -150,7 +155,7 @@ func extractPosition(w http.ResponseWriter, r *http.Request) (
 ) {
 	ok = true
 	pos = common.NotLoggedIn
-	creds := extractLoginCreds(r)
+	creds := auth.ExtractLoginCreds(r)
 	if creds.UserID == "" {
 		return
 	}
@@ -180,6 +180,7 @@ func Start() error {
 	if isWindows {
 		switch arg {
 		case "debug", "start":
+			go handleShutdownSignals()
 			startServer()
 		case "init": // For internal use only
 			os.Exit(0)
@@ -254,7 +255,8 @@ func startServer() {
 		tasks []func() error
 	)
 	if config.ImagerMode != config.ImagerOnly {
-		tasks = append(tasks, templates.Compile, listenToThreadDeletion)
+		tasks = append(tasks, templates.Compile, listenToThreadDeletion,
+			listenToBoardEvents)
 		go ass.WatchVideoDir()
 	}
 	if config.ImagerMode != config.NoImager {
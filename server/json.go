@@ -66,15 +66,16 @@ func writeJSON(
 
 // Validate the client's last N posts to display setting. To allow for better
 // caching the only valid values are 5 and 50. 5 is for index-like thread
-// previews and 50 is for short threads.
-func detectLastN(r *http.Request) int {
+// previews and 50 is for short threads. 0 explicitly requests all posts.
+// Without a valid setting the board's default is used.
+func detectLastN(r *http.Request, board string) int {
 	if q := r.URL.Query().Get("last"); q != "" {
 		n, err := strconv.Atoi(q)
-		if err == nil && (n == 100 || n == 5) {
+		if err == nil && (n == 100 || n == 5 || n == 0) {
 			return n
 		}
 	}
-	return 0
+	return int(config.GetBoardConfigs(board).DefaultLastN)
 }
 
 // Serve public configuration information as JSON
@@ -99,6 +100,117 @@ func servePost(w http.ResponseWriter, r *http.Request) {
 	serveJSON(w, r, "", post)
 }
 
+// Serve a post together with surrounding posts in the same thread. The number
+// of surrounding posts is controlled with the "radius" query parameter.
+func servePostContext(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(extractParam(r, "post"), 10, 64)
+	if err != nil {
+		httpError(w, r, common.StatusError{err, 400})
+		return
+	}
+	radius := 10
+	if q := r.URL.Query().Get("radius"); q != "" {
+		radius, err = strconv.Atoi(q)
+		if err != nil {
+			httpError(w, r, common.StatusError{err, 400})
+			return
+		}
+	}
+
+	posts, err := db.GetPostContext(id, radius)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	serveJSON(w, r, "", posts)
+}
+
+// Serve the tree of replies to a post. The depth of the tree is controlled with
+// the "depth" query parameter.
+func serveQuoteChain(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(extractParam(r, "post"), 10, 64)
+	if err != nil {
+		httpError(w, r, common.StatusError{err, 400})
+		return
+	}
+	depth := db.MaxQuoteChainDepth
+	if q := r.URL.Query().Get("depth"); q != "" {
+		depth, err = strconv.Atoi(q)
+		if err != nil {
+			httpError(w, r, common.StatusError{err, 400})
+			return
+		}
+	}
+
+	node, err := db.GetQuoteChain(id, depth)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	serveJSON(w, r, "", node)
+}
+
+// Serve the latest posts across all boards. The number of posts is controlled
+// with the "limit" query parameter.
+func serveRecentPosts(w http.ResponseWriter, r *http.Request) {
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	posts, err := db.GetRecentPosts(limit)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	serveJSON(w, r, "", posts)
+}
+
+// Serve all posts created within the client's post session
+func serveOwnPosts(w http.ResponseWriter, r *http.Request) {
+	var token string
+	if c, err := r.Cookie("postSession"); err == nil {
+		token = c.Value
+	}
+	posts, err := db.GetOwnPosts(token)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	serveJSON(w, r, "", posts)
+}
+
+// Serve summaries of threads watched within the client's post session
+func serveWatchedThreads(w http.ResponseWriter, r *http.Request) {
+	var token string
+	if c, err := r.Cookie("postSession"); err == nil {
+		token = c.Value
+	}
+	watched, err := db.GetWatchedThreads(token)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	serveJSON(w, r, "", watched)
+}
+
+// Serve several posts at once. The request body contains a JSON array of
+// post IDs.
+func servePosts(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		ids, err := decodePostIDArray(r)
+		if err != nil {
+			return
+		}
+
+		posts, err := db.GetPosts(ids)
+		if err != nil {
+			return
+		}
+		serveJSON(w, r, "", posts)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
 // Serve board-specific configuration JSON
 func serveBoardConfigs(
 	w http.ResponseWriter,
@@ -125,14 +237,90 @@ func threadJSON(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	k := cache.ThreadKey(id, detectLastN(r))
+	k := cache.ThreadKey(id, detectLastN(r, extractParam(r, "board")))
 	data, _, ctr, err := cache.GetJSONAndData(k, cache.ThreadFE)
 	if err != nil {
 		httpError(w, r, err)
 		return
 	}
 
-	writeJSON(w, r, formatEtag(ctr, "", common.NotLoggedIn), data)
+	viewers := feeds.ViewerCount(id)
+	writeJSON(w, r, formatEtag(ctr, viewerEtag(viewers), common.NotLoggedIn),
+		withViewers(data, viewers))
+}
+
+// Add the live viewer count of a thread to its cached JSON. The count changes
+// independently of the thread, so it is never cached with it.
+func withViewers(buf []byte, n int) []byte {
+	if n == 0 || len(buf) < 2 || buf[0] != '{' {
+		return buf
+	}
+	res := make([]byte, 0, len(buf)+32)
+	res = append(res, `{"viewers":`...)
+	res = strconv.AppendInt(res, int64(n), 10)
+	if buf[1] != '}' {
+		res = append(res, ',')
+	}
+	return append(res, buf[1:]...)
+}
+
+// ETag component for the viewer count of a thread
+func viewerEtag(n int) string {
+	return "v" + strconv.Itoa(n)
+}
+
+// Stream all posts of a thread as a JSON array. Posts are flushed to the client
+// as they are read, so even huge threads are never fully buffered in memory.
+func streamThreadJSON(w http.ResponseWriter, r *http.Request) {
+	id, ok := validateThread(w, r)
+	if !ok {
+		return
+	}
+
+	posts, errc := db.StreamThread(id, r.Context().Done())
+	flusher, _ := w.(http.Flusher)
+	started := false
+	for p := range posts {
+		buf, err := json.Marshal(p)
+		if err != nil {
+			// Let the reading goroutine exit
+			for range posts {
+			}
+			<-errc
+			if !started {
+				httpError(w, r, err)
+			} else {
+				logError(r, err)
+			}
+			return
+		}
+
+		if !started {
+			started = true
+			head := w.Header()
+			head.Set("Content-Type", "application/json")
+			head.Set("Cache-Control", "no-cache")
+			w.Write([]byte{'['})
+		} else {
+			w.Write([]byte{','})
+		}
+		w.Write(buf)
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+
+	err := <-errc
+	switch {
+	case !started:
+		httpError(w, r, err)
+	case err != nil:
+		// Headers are already sent. Leave the JSON truncated, so the client
+		// can tell the response is incomplete.
+		logError(r, err)
+	default:
+		w.Write([]byte{']'})
+	}
 }
 
 // Confirms a the thread exists on the board and returns its ID. If an error
@@ -174,7 +362,12 @@ func boardJSON(w http.ResponseWriter, r *http.Request, catalog bool) {
 		return
 	}
 
-	data, _, ctr, err := cache.GetJSONAndData(boardCacheArgs(r, b, catalog))
+	k, f, err := boardCacheArgs(r, b, catalog)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	data, _, ctr, err := cache.GetJSONAndData(k, f)
 	switch err {
 	case nil:
 		writeJSON(w, r, formatEtag(ctr, "", common.NotLoggedIn), data)
@@ -185,11 +378,48 @@ func boardJSON(w http.ResponseWriter, r *http.Request, catalog bool) {
 	}
 }
 
+// Serves a page of a board's thread archive as JSON
+func boardArchiveJSON(w http.ResponseWriter, r *http.Request) {
+	b := extractParam(r, "board")
+	if !auth.IsBoard(b) {
+		text404(w)
+		return
+	}
+	if !assertNotBanned(w, r, b) {
+		return
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	board, err := db.GetBoardArchive(b, page,
+		config.GetBoardConfigs(b).PageSize())
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	serveJSON(w, r, "", board)
+}
+
 // Serve a JSON array of all available boards and their titles
 func serveBoardList(res http.ResponseWriter, req *http.Request) {
 	serveJSON(res, req, "", config.GetBoardTitles())
 }
 
+// Serve aggregate post counts of a board
+func serveBoardStats(w http.ResponseWriter, r *http.Request) {
+	board := extractParam(r, "board")
+	if !auth.IsBoard(board) {
+		text404(w)
+		return
+	}
+
+	stats, err := db.GetBoardStats(board)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	serveJSON(w, r, "", stats)
+}
+
 // Serve map of internal file type enums to extensions. Needed for
 // version-independent backwards compatibility with external applications.
 func serveExtensionMap(w http.ResponseWriter, r *http.Request) {
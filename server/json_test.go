@@ -35,17 +35,27 @@ func TestServeConfigs(t *testing.T) {
 }
 
 func TestDetectLastN(t *testing.T) {
-	t.Parallel()
+	config.ClearBoards()
+	_, err := config.SetBoardConfigs(config.BoardConfigs{
+		ID:           "c",
+		DefaultLastN: 50,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	cases := [...]struct {
-		name, in string
-		out      int
+		name, board, in string
+		out             int
 	}{
-		{"no query string", "/a/1", 0},
-		{"unparsable", "/a/1?last=addsa", 0},
-		{"5", "/a/1?last=5", 5},
-		{"100", "/a/1?last=100", 100},
-		{"invalid number", "/a/1?last=1000", 0},
+		{"no query string", "a", "/a/1", 0},
+		{"unparsable", "a", "/a/1?last=addsa", 0},
+		{"5", "a", "/a/1?last=5", 5},
+		{"100", "a", "/a/1?last=100", 100},
+		{"invalid number", "a", "/a/1?last=1000", 0},
+		{"board default", "c", "/c/1", 50},
+		{"invalid with board default", "c", "/c/1?last=1000", 50},
+		{"all posts", "c", "/c/1?last=0", 0},
 	}
 
 	for i := range cases {
@@ -54,13 +64,37 @@ func TestDetectLastN(t *testing.T) {
 			t.Parallel()
 
 			req := newRequest(c.in)
-			if n := detectLastN(req); n != c.out {
+			if n := detectLastN(req, c.board); n != c.out {
 				LogUnexpected(t, c.out, n)
 			}
 		})
 	}
 }
 
+func TestWithViewers(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name, in, out string
+		viewers       int
+	}{
+		{"no viewers", `{"id":1}`, `{"id":1}`, 0},
+		{"viewers", `{"id":1}`, `{"viewers":3,"id":1}`, 3},
+		{"empty object", `{}`, `{"viewers":3}`, 3},
+		{"not an object", `[]`, `[]`, 3},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			res := string(withViewers([]byte(c.in), c.viewers))
+			AssertDeepEquals(t, res, c.out)
+		})
+	}
+}
+
 func TestPostJSON(t *testing.T) {
 	setupPosts(t)
 	setBoards(t, "a")
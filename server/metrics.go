@@ -0,0 +1,153 @@
+// Prometheus metrics collection and exposition
+
+package server
+
+import (
+	"bufio"
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/metrics"
+	"github.com/bakape/meguca/websockets/feeds"
+	"github.com/dimfeld/httptreemux"
+)
+
+var errInvalidMetricsToken = common.StatusError{
+	Err:  errors.New("invalid metrics token"),
+	Code: 403,
+}
+
+// Records the status code of the response
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusRecorder) WriteHeader(code int) {
+	if s.status == 0 {
+		s.status = code
+	}
+	s.ResponseWriter.WriteHeader(code)
+}
+
+func (s *statusRecorder) Write(buf []byte) (int, error) {
+	if s.status == 0 {
+		s.status = 200
+	}
+	return s.ResponseWriter.Write(buf)
+}
+
+// Flush implements http.Flusher
+func (s *statusRecorder) Flush() {
+	if f, ok := s.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack implements http.Hijacker. Needed for websocket upgrades.
+func (s *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := s.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer can not be hijacked")
+	}
+	s.status = http.StatusSwitchingProtocols
+	return h.Hijack()
+}
+
+// Context key of the route pattern holder set by countRequests
+type routeKey struct{}
+
+// Count all requests served by h. Requests are labeled with the pattern of the
+// matched route or "other", if no route matched, to keep label cardinality low.
+func countRequests(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := &statusRecorder{ResponseWriter: w}
+		route := new(string)
+		h.ServeHTTP(rec, r.WithContext(
+			context.WithValue(r.Context(), routeKey{}, route)))
+		if rec.status == 0 {
+			rec.status = 200
+		}
+		if *route == "" {
+			*route = "other"
+		}
+		metrics.CountRequest(r.Method, *route, rec.status)
+	})
+}
+
+// Router group, that records the pattern of the matched route for
+// countRequests
+type routeGroup struct {
+	*httptreemux.ContextGroup
+	prefix string
+}
+
+func (g routeGroup) NewGroup(path string) routeGroup {
+	return routeGroup{
+		ContextGroup: g.ContextGroup.NewGroup(path),
+		prefix:       g.prefix + strings.TrimSuffix(path, "/"),
+	}
+}
+
+func (g routeGroup) Handle(method, path string, h http.HandlerFunc) {
+	pattern := g.prefix + path
+	g.ContextGroup.Handle(method, path,
+		func(w http.ResponseWriter, r *http.Request) {
+			if route, ok := r.Context().Value(routeKey{}).(*string); ok {
+				*route = pattern
+			}
+			h(w, r)
+		})
+}
+
+func (g routeGroup) Handler(method, path string, h http.Handler) {
+	g.Handle(method, path, h.ServeHTTP)
+}
+
+func (g routeGroup) GET(path string, h http.HandlerFunc) {
+	g.Handle("GET", path, h)
+}
+
+func (g routeGroup) POST(path string, h http.HandlerFunc) {
+	g.Handle("POST", path, h)
+}
+
+func (g routeGroup) PATCH(path string, h http.HandlerFunc) {
+	g.Handle("PATCH", path, h)
+}
+
+func (g routeGroup) DELETE(path string, h http.HandlerFunc) {
+	g.Handle("DELETE", path, h)
+}
+
+// Serve collected metrics in the Prometheus text format. Disabled, unless a
+// metrics token is configured, which must be sent as a bearer token.
+func serveMetrics(w http.ResponseWriter, r *http.Request) {
+	token := config.Get().MetricsToken
+	if token == "" {
+		text404(w)
+		return
+	}
+	header := r.Header.Get("Authorization")
+	if subtle.ConstantTimeCompare([]byte(header),
+		[]byte("Bearer "+token)) != 1 {
+		httpError(w, r, errInvalidMetricsToken)
+		return
+	}
+
+	head := w.Header()
+	for key, val := range vanillaHeaders {
+		head.Set(key, val)
+	}
+	head.Set("Content-Type", "text/plain; version=0.0.4")
+	err := metrics.Write(w, feeds.ClientsByBoard())
+	if err != nil {
+		logError(r, err)
+	}
+}
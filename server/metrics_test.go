@@ -0,0 +1,77 @@
+package server
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/metrics"
+	"github.com/dimfeld/httptreemux"
+)
+
+func TestCountRequests(t *testing.T) {
+	metrics.Clear()
+	mux := httptreemux.NewContextMux()
+	r := routeGroup{ContextGroup: mux.ContextGroup}
+	ok := func(w http.ResponseWriter, _ *http.Request) {}
+	r.GET("/:board/:thread", ok)
+	r.NewGroup("/api/").POST("/create-reply", ok)
+	h := countRequests(mux)
+
+	for _, c := range [...]struct{ method, path string }{
+		{"GET", "/a/22"},
+		{"GET", "/b/33"},
+		{"POST", "/api/create-reply"},
+		{"GET", "/foo/bar/baz"},
+	} {
+		h.ServeHTTP(httptest.NewRecorder(),
+			httptest.NewRequest(c.method, c.path, nil))
+	}
+
+	var w bytes.Buffer
+	if err := metrics.Write(&w, nil); err != nil {
+		t.Fatal(err)
+	}
+	for _, l := range [...]string{
+		`{method="GET",path="/:board/:thread",status="200"} 2`,
+		`{method="POST",path="/api/create-reply",status="200"} 1`,
+		`{method="GET",path="other",status="404"} 1`,
+	} {
+		if !strings.Contains(w.String(), l) {
+			t.Errorf("no %s in:\n%s", l, w.String())
+		}
+	}
+}
+
+func TestServeMetrics(t *testing.T) {
+	conf := *config.Get()
+	conf.MetricsToken = "foo"
+	config.Set(conf)
+	defer func() {
+		conf.MetricsToken = ""
+		config.Set(conf)
+	}()
+
+	cases := [...]struct {
+		name, header string
+		code         int
+	}{
+		{"no token", "", 403},
+		{"invalid token", "Bearer bar", 403},
+		{"valid token", "Bearer foo", 200},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rec, req := newPair("/metrics")
+			if c.header != "" {
+				req.Header.Set("Authorization", c.header)
+			}
+			router.ServeHTTP(rec, req)
+			assertCode(t, rec, c.code)
+		})
+	}
+}
@@ -0,0 +1,368 @@
+// OpenAPI specification of the versioned REST API
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/db"
+)
+
+var (
+	openAPIOnce sync.Once
+	openAPIJSON []byte
+	openAPIErr  error
+
+	jsonMarshaler = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// OpenAPI parameter description
+type openAPIParam struct {
+	Name        string                 `json:"name"`
+	In          string                 `json:"in"`
+	Description string                 `json:"description,omitempty"`
+	Required    bool                   `json:"required,omitempty"`
+	Schema      map[string]interface{} `json:"schema"`
+}
+
+// Documented REST API route
+type openAPIRoute struct {
+	path, summary string
+	params        []openAPIParam
+	response      interface{}
+}
+
+// Routes of the versioned REST API. Only GET routes are exposed.
+var openAPIRoutes = [...]openAPIRoute{
+	{
+		path:    "/api/v1/thread/{thread}",
+		summary: "Retrieve a thread",
+		params: []openAPIParam{
+			pathParam("thread", "integer", "thread ID"),
+			queryParam("last", "integer",
+				"number of last replies to include: 0, 5 or 100"),
+			queryParam("mobile", "integer", "set to 1 to reduce the payload "+
+				"for mobile clients"),
+		},
+		response: common.Thread{},
+	},
+	{
+		path:    "/api/v1/boards",
+		summary: "Retrieve the directory of all boards",
+		params: []openAPIParam{
+			queryParam("tag", "string", "only include boards with this tag"),
+			{
+				Name: "sort",
+				In:   "query",
+				Schema: map[string]interface{}{
+					"type": "string",
+					"enum": []string{"id", "activity"},
+				},
+			},
+		},
+		response: []boardListingV1{},
+	},
+	{
+		path:    "/api/v1/board/{board}",
+		summary: "Retrieve a page of a board's thread index",
+		params: []openAPIParam{
+			pathParam("board", "string", "board ID"),
+			queryParam("page", "integer",
+				"zero-based page number. Pages past 50 are always empty."),
+			queryParam("tag", "string",
+				"only include threads with this tag. Ignores sort."),
+			queryParam("mobile", "integer", "set to 1 to reduce the payload "+
+				"for mobile clients"),
+			{
+				Name: "sort",
+				In:   "query",
+				Schema: map[string]interface{}{
+					"type": "string",
+					"enum": []string{"bump", "creation", "replyCount",
+						"imageCount", "lastReply"},
+				},
+			},
+		},
+		response: boardPageV1{},
+	},
+	{
+		path:     "/api/v1/board/{board}/banners",
+		summary:  "Retrieve the URLs of a board's banners",
+		params:   []openAPIParam{pathParam("board", "string", "board ID")},
+		response: []string{},
+	},
+	{
+		path:     "/api/v1/board/{board}/tags",
+		summary:  "Retrieve the tags used by a board's threads",
+		params:   []openAPIParam{pathParam("board", "string", "board ID")},
+		response: []string{},
+	},
+	{
+		path:    "/api/v1/board/{board}/trending",
+		summary: "Retrieve the threads of a board with the most recent activity",
+		params: []openAPIParam{
+			pathParam("board", "string", "board ID"),
+			queryParam("limit", "integer",
+				"number of threads to retrieve. Defaults to 10, maximum 50."),
+		},
+		response: []common.Thread{},
+	},
+	{
+		path:    "/api/v1/search",
+		summary: "Search post bodies",
+		params: []openAPIParam{
+			{
+				Name:        "q",
+				In:          "query",
+				Description: "case-insensitive text to search for",
+				Required:    true,
+				Schema:      map[string]interface{}{"type": "string"},
+			},
+			queryParam("board", "string", "only search posts on this board"),
+			queryParam("page", "integer",
+				"zero-based page number. Pages past 50 are always empty."),
+		},
+		response: []db.SearchResult{},
+	},
+	{
+		path:    "/api/v1/search/image",
+		summary: "Retrieve the posts with an image of a specific hash",
+		params: []openAPIParam{
+			queryParam("md5", "string",
+				"unpadded base64url MD5 hash. Exactly one of md5 and sha256 "+
+					"must be set."),
+			queryParam("sha256", "string",
+				"hexadecimal SHA-256 hash. Exactly one of md5 and sha256 "+
+					"must be set."),
+		},
+		response: []imageSearchResultV1{},
+	},
+	{
+		path:    "/api/v1/openapi.json",
+		summary: "Retrieve this specification",
+	},
+}
+
+// Types, that are always included in the specification's schemas, and their
+// names
+var openAPISchemas = [...]struct {
+	name string
+	typ  interface{}
+}{
+	{"Thread", common.Thread{}},
+	{"Post", common.Post{}},
+	{"Board", common.Board{}},
+	{"Image", common.Image{}},
+	{"BoardPage", boardPageV1{}},
+	{"SearchResult", db.SearchResult{}},
+}
+
+func pathParam(name, typ, desc string) openAPIParam {
+	return openAPIParam{
+		Name:        name,
+		In:          "path",
+		Description: desc,
+		Required:    true,
+		Schema:      map[string]interface{}{"type": typ},
+	}
+}
+
+func queryParam(name, typ, desc string) openAPIParam {
+	return openAPIParam{
+		Name:        name,
+		In:          "query",
+		Description: desc,
+		Schema:      map[string]interface{}{"type": typ},
+	}
+}
+
+// Serve the OpenAPI specification of the REST API
+func serveOpenAPI(w http.ResponseWriter, r *http.Request) {
+	openAPIOnce.Do(func() {
+		openAPIJSON, openAPIErr = json.Marshal(buildOpenAPISpec())
+	})
+	if openAPIErr != nil {
+		httpError(w, r, openAPIErr)
+		return
+	}
+	writeJSON(w, r, "", openAPIJSON)
+}
+
+// Generate the OpenAPI specification document from the route table and the
+// JSON encoding of the response types
+func buildOpenAPISpec() map[string]interface{} {
+	g := schemaGenerator{
+		names:   make(map[reflect.Type]string, len(openAPISchemas)),
+		schemas: make(map[string]interface{}, len(openAPISchemas)),
+	}
+	for _, s := range openAPISchemas {
+		g.names[reflect.TypeOf(s.typ)] = s.name
+	}
+	for _, s := range openAPISchemas {
+		g.schemaOf(reflect.TypeOf(s.typ))
+	}
+	g.schemas["ReadError"] = map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"error": map[string]interface{}{"type": "string"},
+		},
+	}
+
+	errResponse := map[string]interface{}{
+		"description": "error",
+		"content": map[string]interface{}{
+			"application/json": map[string]interface{}{
+				"schema": schemaRef("ReadError"),
+			},
+		},
+	}
+	paths := make(map[string]interface{}, len(openAPIRoutes))
+	for _, route := range openAPIRoutes {
+		var schema map[string]interface{}
+		if route.response != nil {
+			schema = g.schemaOf(reflect.TypeOf(route.response))
+		} else {
+			schema = map[string]interface{}{"type": "object"}
+		}
+		op := map[string]interface{}{
+			"summary": route.summary,
+			"responses": map[string]interface{}{
+				"200": map[string]interface{}{
+					"description": "success",
+					"content": map[string]interface{}{
+						"application/json": map[string]interface{}{
+							"schema": schema,
+						},
+					},
+				},
+				"304":     map[string]interface{}{"description": "not modified"},
+				"default": errResponse,
+			},
+		}
+		if len(route.params) != 0 {
+			op["parameters"] = route.params
+		}
+		paths[route.path] = map[string]interface{}{"get": op}
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":   "meguca",
+			"version": "1",
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": g.schemas,
+		},
+	}
+}
+
+func schemaRef(name string) map[string]interface{} {
+	return map[string]interface{}{"$ref": "#/components/schemas/" + name}
+}
+
+// Derives JSON schemas from Go types using their JSON struct tags
+type schemaGenerator struct {
+	names   map[reflect.Type]string
+	schemas map[string]interface{}
+}
+
+// Returns the schema of a type. Named structs are added to the schema
+// components and referenced.
+func (g *schemaGenerator) schemaOf(t reflect.Type) map[string]interface{} {
+	if t.Implements(jsonMarshaler) {
+		// Custom encoding. Can not be derived.
+		return map[string]interface{}{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16,
+		reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Ptr:
+		s := g.schemaOf(t.Elem())
+		if _, ok := s["$ref"]; ok {
+			// Siblings of $ref are ignored in OpenAPI 3.0
+			return map[string]interface{}{
+				"allOf":    []interface{}{s},
+				"nullable": true,
+			}
+		}
+		s["nullable"] = true
+		return s
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{
+			"type":  "array",
+			"items": g.schemaOf(t.Elem()),
+		}
+	case reflect.Map:
+		return map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": g.schemaOf(t.Elem()),
+		}
+	case reflect.Struct:
+		name := g.names[t]
+		if name == "" {
+			name = t.Name()
+		}
+		if name == "" {
+			return g.structSchema(t)
+		}
+		if _, ok := g.schemas[name]; !ok {
+			g.schemas[name] = nil // Guard against recursion
+			g.schemas[name] = g.structSchema(t)
+		}
+		return schemaRef(name)
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+func (g *schemaGenerator) structSchema(t reflect.Type) map[string]interface{} {
+	props := make(map[string]interface{}, t.NumField())
+	g.addFields(t, props)
+	return map[string]interface{}{
+		"type":       "object",
+		"properties": props,
+	}
+}
+
+// Add struct fields as properties. Untagged embedded structs are flattened,
+// as they are by encoding/json.
+func (g *schemaGenerator) addFields(
+	t reflect.Type,
+	props map[string]interface{},
+) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			g.addFields(f.Type, props)
+			continue
+		}
+		if f.PkgPath != "" { // Unexported
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		props[name] = g.schemaOf(f.Type)
+	}
+}
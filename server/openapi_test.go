@@ -0,0 +1,55 @@
+package server
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestServeOpenAPI(t *testing.T) {
+	rec, req := newPair("/api/v1/openapi.json")
+	router.ServeHTTP(rec, req)
+	assertCode(t, rec, 200)
+
+	var spec struct {
+		Paths      map[string]interface{}
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]interface{}
+			}
+		}
+	}
+	err := json.Unmarshal(rec.Body.Bytes(), &spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, p := range [...]string{
+		"/api/v1/thread/{thread}",
+		"/api/v1/board/{board}",
+	} {
+		if _, ok := spec.Paths[p]; !ok {
+			t.Errorf("path not documented: %s", p)
+		}
+	}
+
+	cases := [...]struct {
+		schema, property string
+	}{
+		{"Thread", "posts"},
+		{"Thread", "body"}, // Flattened from embedded Post
+		{"Post", "image"},
+		{"Board", "threads"},
+		{"Image", "sha1"}, // Flattened from embedded ImageCommon
+		{"ReadError", "error"},
+	}
+	for _, c := range cases {
+		s, ok := spec.Components.Schemas[c.schema]
+		if !ok {
+			t.Errorf("schema not defined: %s", c.schema)
+			continue
+		}
+		if _, ok := s.Properties[c.property]; !ok {
+			t.Errorf("schema %s missing property %s", c.schema, c.property)
+		}
+	}
+}
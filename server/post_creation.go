@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
 	"unicode/utf8"
 
 	"github.com/bakape/meguca/auth"
@@ -15,8 +16,12 @@ import (
 	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/db"
 	"github.com/bakape/meguca/imager"
+	"github.com/bakape/meguca/latex"
+	"github.com/bakape/meguca/parser"
+	"github.com/bakape/meguca/templates"
 	"github.com/bakape/meguca/websockets"
 	"github.com/bakape/meguca/websockets/feeds"
+	"github.com/go-playground/log"
 )
 
 // Create a thread with a closed OP
@@ -32,15 +37,30 @@ func createThread(w http.ResponseWriter, r *http.Request) {
 		req := websockets.ThreadCreationRequest{
 			Subject:              f.Get("subject"),
 			Board:                f.Get("board"),
+			Tags:                 parseThreadTags(f.Get("tags")),
+			IgnoreSimilar:        f.Get("ignoreSimilar") != "",
 			ReplyCreationRequest: repReq,
 		}
 
 		post, err := websockets.CreateThread(req, ip)
-		if err != nil {
+		if e, ok := err.(websockets.SimilarThreadsError); ok {
+			// Let the client confirm the thread is not a duplicate first
+			serveSimilarThreads(w, e)
+			return nil
+		}
+		switch {
+		case err == common.ErrFlood, err == common.ErrThreadLocked,
+			err == common.ErrBanned, isCooldown(err),
+			isStatusCode(err, 409), isStatusCode(err, 413),
+			isStatusCode(err, 415):
+			return
+		case err != nil:
 			// TODO: Not all codes are actually 400. Need to differentiate
 			return common.StatusError{err, 400}
 		}
 
+		addOwnPost(w, r, post.StandalonePost)
+
 		// Let the JS add the ID of the post to "mine"
 		http.SetCookie(w, &http.Cookie{
 			Name:  "addMine",
@@ -58,6 +78,40 @@ func createThread(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// Render a page with the threads similar to one being created and 409
+// Conflict. The client can resubmit the form with "ignoreSimilar" set to
+// create the thread anyway.
+func serveSimilarThreads(w http.ResponseWriter,
+	err websockets.SimilarThreadsError,
+) {
+	head := w.Header()
+	for key, val := range vanillaHeaders {
+		head.Set(key, val)
+	}
+	head.Set("Content-Type", "text/html")
+	head.Set("Cache-Control", "no-store")
+	w.WriteHeader(409)
+	templates.WriteSimilarThreads(w, err.SimilarThreads())
+}
+
+// Split a comma or space separated list of thread tags into lowercase unique
+// tags
+func parseThreadTags(s string) (tags []string) {
+	split := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+outer:
+	for _, t := range split {
+		for _, prev := range tags {
+			if prev == t {
+				continue outer
+			}
+		}
+		tags = append(tags, t)
+	}
+	return
+}
+
 // ok = false, if failed and caller should return
 func parsePostCreationForm(w http.ResponseWriter, r *http.Request,
 ) (req websockets.ReplyCreationRequest, ip string, err error) {
@@ -99,12 +153,13 @@ func parsePostCreationForm(w http.ResponseWriter, r *http.Request,
 	req = websockets.ReplyCreationRequest{
 		// HTTP uses "\r\n" for newlines, but "\r" is considered non-printable
 		// and raises parser.ErrContainsNonPrintable during parsing.
-		Body: strings.Replace(f.Get("body"), "\r", "", -1),
-		Name: f.Get("name"),
-		Sage: f.Get("sage") == "on",
+		Body:     strings.Replace(f.Get("body"), "\r", "", -1),
+		Name:     f.Get("name"),
+		Password: f.Get("password"),
+		Sage:     f.Get("sage") == "on",
 	}
 	if f.Get("staffTitle") == "on" {
-		req.SessionCreds = extractLoginCreds(r)
+		req.SessionCreds = auth.ExtractLoginCreds(r)
 	}
 
 	// Handle image, if any, and extract file name
@@ -156,12 +211,19 @@ func createReply(w http.ResponseWriter, r *http.Request) {
 		}
 
 		post, msg, err := websockets.CreatePost(op, board, ip, req)
-		if err != nil {
+		switch {
+		case err == common.ErrFlood, err == common.ErrThreadLocked,
+			err == common.ErrBanned, isCooldown(err),
+			isStatusCode(err, 409), isStatusCode(err, 413),
+			isStatusCode(err, 415):
+			return
+		case err != nil:
 			// TODO: Not all codes are actually 400. Need to differentiate
 			return common.StatusError{err, 400}
 		}
 
 		feeds.InsertPostInto(post.StandalonePost, msg)
+		addOwnPost(w, r, post.StandalonePost)
 		http.Redirect(w, r,
 			fmt.Sprintf(`/%s/%d?last100=true#bottom`, board, op), 303)
 		incrementSpamscore(ip, req.Body, false)
@@ -173,6 +235,178 @@ func createReply(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// Delete a post created by the client. Requires the post's password.
+func deleteOwnPost(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		var msg struct {
+			ID       uint64
+			Password string
+		}
+		err = decodeJSON(r, &msg)
+		if err != nil {
+			return
+		}
+
+		ip, err := auth.GetIP(r)
+		if err != nil {
+			return common.StatusError{err, 400}
+		}
+		return db.DeleteOwnPost(msg.ID, msg.Password, ip)
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Replace the body of a closed post with the post's password
+func editPost(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		var msg struct {
+			ID             uint64
+			Body, Password string
+		}
+		err = decodeJSON(r, &msg)
+		if err != nil {
+			return
+		}
+		if utf8.RuneCountInString(msg.Body) > common.MaxLenBody {
+			return common.ErrBodyTooLong
+		}
+		err = parser.IsPrintableString(msg.Body, true)
+		if err != nil {
+			return
+		}
+		err = parser.CheckDice(msg.Body)
+		if err != nil {
+			return
+		}
+		ip, err := auth.GetIP(r)
+		if err != nil {
+			return common.StatusError{err, 400}
+		}
+
+		p, err := db.GetPost(msg.ID)
+		if err != nil {
+			return
+		}
+		err = db.IsBanned(p.Board, ip)
+		if err != nil {
+			return
+		}
+		err = db.CheckEditable(msg.ID, msg.Password)
+		if err != nil {
+			return
+		}
+
+		// Keep dice rolls and fortunes, that were already present before the
+		// edit
+		msg.Body = db.FilterWords(p.Board, msg.Body)
+		msg.Body, err = parser.DrawFortunes(msg.Body, p.Body, p.Board)
+		if err != nil {
+			return
+		}
+		msg.Body, _, err = parser.RollDice(msg.Body, p.Body)
+		if err != nil {
+			return
+		}
+		if utf8.RuneCountInString(msg.Body) > common.MaxLenBody {
+			return common.ErrBodyTooLong
+		}
+		err = latex.Validate(msg.Body)
+		if err != nil {
+			return
+		}
+
+		// Hash commands are not run again and keep their previous results
+		links, err := parser.ParseEditedBody([]byte(msg.Body), []byte(p.Body),
+			p.Board)
+		if err != nil {
+			return
+		}
+
+		err = db.EditPost(msg.ID, msg.Body, links, p.Commands)
+		if err != nil {
+			return
+		}
+		incrementSpamscore(ip, msg.Body, false)
+		p, err = db.GetPost(msg.ID)
+		if err != nil {
+			return
+		}
+		err = feeds.EditPost(p.ID, p.OP, p.EditHistory[0].Body, p.Body)
+		if err != nil {
+			return
+		}
+		websockets.RenderPostMath(p.ID, p.OP, p.Body)
+		websockets.FetchPostEmbeds(p.ID, p.OP, p.Body)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Add or remove a thread from the threads watched within the client's post
+// session or mark its current posts as seen
+func modifyThreadWatch(w http.ResponseWriter, r *http.Request,
+	fn func(token string, id, count uint64) error,
+) {
+	err := func() (err error) {
+		var msg struct {
+			ID, Count uint64
+		}
+		err = decodeJSON(r, &msg)
+		if err != nil {
+			return
+		}
+
+		token, cookie, err := auth.PostSession(r)
+		if err != nil {
+			return
+		}
+		if cookie != nil {
+			http.SetCookie(w, cookie)
+		}
+		return fn(token, msg.ID, msg.Count)
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+func watchThread(w http.ResponseWriter, r *http.Request) {
+	modifyThreadWatch(w, r, func(token string, id, _ uint64) error {
+		return db.WatchThread(token, id)
+	})
+}
+
+func unwatchThread(w http.ResponseWriter, r *http.Request) {
+	modifyThreadWatch(w, r, func(token string, id, _ uint64) error {
+		return db.UnwatchThread(token, id)
+	})
+}
+
+func markWatchedThreadSeen(w http.ResponseWriter, r *http.Request) {
+	modifyThreadWatch(w, r, db.UpdateLastSeen)
+}
+
+// Record a post as created within the client's post session. The post has
+// already been created at this point, so failures are only logged.
+func addOwnPost(w http.ResponseWriter, r *http.Request,
+	p common.StandalonePost,
+) {
+	token, cookie, err := auth.PostSession(r)
+	if err == nil {
+		if cookie != nil {
+			http.SetCookie(w, cookie)
+		}
+		err = websockets.AddOwnPost(token, p)
+	}
+	if err != nil {
+		log.Errorf("recording own post %d: %s", p.ID, err)
+	}
+}
+
 func incrementSpamscore(ip, body string, isOP bool) {
 	conf := config.Get()
 	s := conf.CharScore * uint(utf8.RuneCountInString(body))
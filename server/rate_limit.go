@@ -0,0 +1,169 @@
+package server
+
+import (
+	"errors"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+)
+
+// Route groups with separately configured rate limits
+type rateGroup uint8
+
+const (
+	readRequests rateGroup = iota
+	postRequests
+	uploadRequests
+)
+
+// Time after which an unused bucket is discarded
+const rateLimitIdle = 5 * time.Minute
+
+var (
+	errRateLimited = errors.New("rate limit exceeded")
+
+	// Rate limiting of HTTP requests shared by all routes
+	rateLimits = newRateLimiter()
+)
+
+type rateKey struct {
+	group rateGroup
+	ip    string
+}
+
+// Token bucket. Tokens are refilled lazily on each request.
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// Limits the rate of requests by IP or subnet per route group with token
+// buckets
+type rateLimiter struct {
+	mu      sync.Mutex
+	buckets map[rateKey]*tokenBucket
+}
+
+// Creates a new rateLimiter and starts the periodic eviction of idle buckets
+func newRateLimiter() *rateLimiter {
+	l := &rateLimiter{
+		buckets: make(map[rateKey]*tokenBucket),
+	}
+	go func() {
+		for range time.Tick(rateLimitIdle) {
+			l.evict(time.Now())
+		}
+	}()
+	return l
+}
+
+// Consume a token from the bucket of k, refilled at perMinute tokens per minute
+// up to burst tokens. Returns the time until the next token is available, if
+// the bucket is empty.
+func (l *rateLimiter) allow(k rateKey, perMinute, burst uint, now time.Time,
+) (ok bool, retryAfter time.Duration) {
+	if burst == 0 {
+		burst = 1
+	}
+	rate := float64(perMinute) / 60 // Per second
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b := l.buckets[k]
+	if b == nil {
+		b = &tokenBucket{
+			tokens: float64(burst),
+			last:   now,
+		}
+		l.buckets[k] = b
+	} else {
+		b.tokens += now.Sub(b.last).Seconds() * rate
+		if b.tokens > float64(burst) {
+			b.tokens = float64(burst)
+		}
+		b.last = now
+	}
+
+	if b.tokens < 1 {
+		wait := (1 - b.tokens) / rate
+		return false, time.Duration(wait * float64(time.Second))
+	}
+	b.tokens--
+	return true, 0
+}
+
+// Remove buckets not used within rateLimitIdle. These have been refilled by
+// now in all practical configurations.
+func (l *rateLimiter) evict(now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for k, b := range l.buckets {
+		if now.Sub(b.last) > rateLimitIdle {
+			delete(l.buckets, k)
+		}
+	}
+}
+
+// Returns the configured rate and burst of a route group
+func groupLimits(conf *config.Configs, g rateGroup) (perMinute, burst uint) {
+	switch g {
+	case readRequests:
+		return conf.ReadRateLimit, conf.ReadBurst
+	case postRequests:
+		return conf.PostRateLimit, conf.PostBurst
+	default:
+		return conf.UploadRateLimit, conf.UploadBurst
+	}
+}
+
+// Respond with 429, if the client exceeds the route group's rate limit
+func limitRate(g rateGroup, h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		conf := config.Get()
+		perMinute, burst := groupLimits(conf, g)
+		if perMinute == 0 {
+			h(w, r)
+			return
+		}
+
+		ip, err := auth.GetIP(r)
+		if err != nil {
+			httpError(w, r, common.StatusError{err, 400})
+			return
+		}
+		if conf.SubnetRateLimit {
+			ip = subnet(ip)
+		}
+
+		ok, wait := rateLimits.allow(rateKey{g, ip}, perMinute, burst,
+			time.Now())
+		if !ok {
+			secs := int(math.Ceil(wait.Seconds()))
+			w.Header().Set("Retry-After", strconv.Itoa(secs))
+			httpError(w, r, common.StatusError{errRateLimited, 429})
+			return
+		}
+		h(w, r)
+	}
+}
+
+// Returns the /24 subnet of an IPv4 or /64 subnet of an IPv6 address
+func subnet(ip string) string {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return ip
+	}
+	if v4 := parsed.To4(); v4 != nil {
+		return v4.Mask(net.CIDRMask(24, 32)).String() + "/24"
+	}
+	return parsed.Mask(net.CIDRMask(64, 128)).String() + "/64"
+}
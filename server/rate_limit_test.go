@@ -0,0 +1,89 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bakape/meguca/config"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	l := &rateLimiter{
+		buckets: make(map[rateKey]*tokenBucket),
+	}
+	k := rateKey{postRequests, "::1"}
+	now := time.Now()
+
+	// Burst of 2 at 6 requests per minute
+	for i := 0; i < 2; i++ {
+		ok, _ := l.allow(k, 6, 2, now)
+		if !ok {
+			t.Fatal(i)
+		}
+	}
+	ok, wait := l.allow(k, 6, 2, now)
+	if ok {
+		t.Fatal("not limited")
+	}
+	AssertDeepEquals(t, wait, 10*time.Second)
+
+	// Other groups and IPs have separate buckets
+	if ok, _ := l.allow(rateKey{readRequests, "::1"}, 6, 2, now); !ok {
+		t.Fatal("group limited")
+	}
+	if ok, _ := l.allow(rateKey{postRequests, "::2"}, 6, 2, now); !ok {
+		t.Fatal("IP limited")
+	}
+
+	// Refilled
+	now = now.Add(10 * time.Second)
+	if ok, _ := l.allow(k, 6, 2, now); !ok {
+		t.Fatal("not refilled")
+	}
+
+	// Only k was used within the idle period
+	l.evict(now.Add(rateLimitIdle))
+	AssertDeepEquals(t, len(l.buckets), 1)
+	l.evict(now.Add(rateLimitIdle + time.Second))
+	AssertDeepEquals(t, len(l.buckets), 0)
+}
+
+func TestSubnet(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		in, out string
+	}{
+		{"192.168.1.122", "192.168.1.0/24"},
+		{"2001:db8:1:2:3:4:5:6", "2001:db8:1:2::/64"},
+		{"invalid", "invalid"},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.in, func(t *testing.T) {
+			t.Parallel()
+			AssertDeepEquals(t, subnet(c.in), c.out)
+		})
+	}
+}
+
+func TestLimitRate(t *testing.T) {
+	conf := *config.Get()
+	defer config.Set(conf)
+	limited := conf
+	limited.ReadRateLimit = 1
+	config.Set(limited)
+
+	rec, req := newPair("/api/v1/openapi.json")
+	router.ServeHTTP(rec, req)
+	assertCode(t, rec, 200)
+
+	rec, req = newPair("/api/v1/openapi.json")
+	router.ServeHTTP(rec, req)
+	assertCode(t, rec, 429)
+	AssertDeepEquals(t, rec.Header().Get("Retry-After"), "60")
+}
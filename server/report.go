@@ -79,7 +79,7 @@ func reportForm(w http.ResponseWriter, r *http.Request) {
 	templates.WriteReportForm(w, id)
 }
 
-// Render a list of reports for the board
+// Render a list of reports for the board. Only visible to moderators.
 func reportList(w http.ResponseWriter, r *http.Request) {
 	board := extractParam(r, "board")
 	if !auth.IsNonMetaBoard(board) {
@@ -87,7 +87,13 @@ func reportList(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	rep, err := db.GetReports(board)
+	_, err := canPerform(w, r, board, common.Moderator, false)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+
+	rep, err := db.GetReports(board, r.URL.Query().Get("resolved") == "true")
 	if err != nil {
 		httpError(w, r, err)
 		return
@@ -95,3 +101,26 @@ func reportList(w http.ResponseWriter, r *http.Request) {
 	setHTMLHeaders(w)
 	templates.WriteReportList(w, rep)
 }
+
+// Mark a report as handled
+func resolveReport(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		var msg struct {
+			ID    uint64
+			Board string
+		}
+		err = decodeJSON(r, &msg)
+		if err != nil {
+			return
+		}
+
+		_, err = canPerform(w, r, msg.Board, common.Janitor, false)
+		if err != nil {
+			return
+		}
+		return db.ResolveReport(msg.ID, msg.Board)
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
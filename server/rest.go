@@ -0,0 +1,536 @@
+// Versioned REST API
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bakape/meguca/assets"
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/cache"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/db"
+	imgassets "github.com/bakape/meguca/imager/assets"
+	"github.com/bakape/meguca/util"
+	"github.com/bakape/meguca/websockets/feeds"
+)
+
+// Serve a thread as JSON. Unlike threadJSON, the board is not part of the
+// path and is looked up from the thread instead.
+func threadJSONV1(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(extractParam(r, "thread"), 10, 64)
+	if err != nil {
+		text404(w)
+		return
+	}
+	board, op, err := db.GetPostParenthood(id)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	if op != id {
+		text404(w)
+		return
+	}
+	if !assertNotBanned(w, r, board) {
+		return
+	}
+	k := cache.ThreadKey(id, detectLastN(r, board))
+	viewers := feeds.ViewerCount(id)
+	if notModifiedV1(w, r, k, cache.ThreadFE, viewerEtag(viewers), 5) {
+		return
+	}
+	data, t, ctr, err := cache.GetJSONAndData(k, cache.ThreadFE)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	if isMobile(r) {
+		data, err = json.Marshal(common.NewMobileThread(t.(common.Thread)))
+		if err != nil {
+			httpError(w, r, err)
+			return
+		}
+	}
+
+	writeV1JSON(w, r, v1Etag(r, ctr, viewerEtag(viewers)),
+		withViewers(data, viewers), 5)
+}
+
+// Response envelope of a board index page
+type boardPageV1 struct {
+	Page    int             `json:"page"`
+	Pages   int             `json:"pages"`
+	Banners []string        `json:"banners"`
+	Threads []common.Thread `json:"threads"`
+}
+
+// Response envelope of a board index page with threads reduced for mobile
+// clients
+type mobileBoardPageV1 struct {
+	boardPageV1
+	Threads []common.MobileThread `json:"threads"`
+}
+
+// Client requested the reduced mobile version of threads
+func isMobile(r *http.Request) bool {
+	return r.URL.Query().Get("mobile") == "1"
+}
+
+// Encode a board index page, converting its threads to their mobile version,
+// if requested by the client
+func encodeBoardPageV1(r *http.Request, p boardPageV1) ([]byte, error) {
+	if !isMobile(r) {
+		return json.Marshal(p)
+	}
+	m := mobileBoardPageV1{
+		boardPageV1: p,
+		Threads:     make([]common.MobileThread, len(p.Threads)),
+	}
+	for i, t := range p.Threads {
+		m.Threads[i] = common.NewMobileThread(t)
+	}
+	m.boardPageV1.Threads = nil
+	return json.Marshal(m)
+}
+
+// Format the etag of a cached versioned API response. hash identifies any
+// uncached content of the response and can be empty.
+func v1Etag(r *http.Request, ctr uint64, hash string) string {
+	if isMobile(r) {
+		if hash != "" {
+			hash = "mobile-" + hash
+		} else {
+			hash = "mobile"
+		}
+	}
+	return formatEtag(ctr, hash, common.NotLoggedIn)
+}
+
+// Serve a page of a board's thread index as JSON
+func boardJSONV1(w http.ResponseWriter, r *http.Request) {
+	b := extractParam(r, "board")
+	if !auth.IsBoard(b) {
+		jsonError(w, 404, "no such board")
+		return
+	}
+	if !assertNotBanned(w, r, b) {
+		return
+	}
+	if tag := r.URL.Query().Get("tag"); tag != "" {
+		taggedBoardJSONV1(w, r, b, tag)
+		return
+	}
+
+	k, f, err := boardCacheArgs(r, b, false)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	if notModifiedV1(w, r, k, f, assets.Banners.Hash(b), 10) {
+		return
+	}
+	_, data, ctr, err := cache.GetJSONAndData(k, f)
+	switch err {
+	case nil:
+	case cache.ErrPageOverflow:
+		jsonError(w, 404, "no such page")
+		return
+	default:
+		httpError(w, r, err)
+		return
+	}
+
+	page := data.(cache.PageStore)
+	buf, err := encodeBoardPageV1(r, boardPageV1{
+		Page:    page.PageNumber,
+		Pages:   page.Data.Pages,
+		Banners: bannerURLs(b),
+		Threads: page.Data.Threads,
+	})
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	writeV1JSON(w, r, v1Etag(r, ctr, assets.Banners.Hash(b)), buf, 10)
+}
+
+// Serve a page of a board's threads with the specified tag. These pages are
+// not cached, as they are not used by the client's board index.
+func taggedBoardJSONV1(w http.ResponseWriter, r *http.Request, b, tag string) {
+	switch {
+	case b == "all":
+		jsonError(w, 400, "tags not supported on /all/")
+		return
+	case !common.TagRegexp.MatchString(tag):
+		jsonError(w, 400, "invalid tag")
+		return
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	board, err := db.GetThreadsByTag(b, tag, page,
+		config.GetBoardConfigs(b).PageSize())
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	if page < 0 || page >= board.Pages {
+		jsonError(w, 404, "no such page")
+		return
+	}
+	buf, err := encodeBoardPageV1(r, boardPageV1{
+		Page:    page,
+		Pages:   board.Pages,
+		Banners: bannerURLs(b),
+		Threads: board.Threads,
+	})
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	writeV1JSON(w, r, util.HashBuffer(buf), buf, 10)
+}
+
+// Serve all tags used by unarchived threads on a board
+func threadTagsV1(w http.ResponseWriter, r *http.Request) {
+	b := extractParam(r, "board")
+	if !auth.IsNonMetaBoard(b) {
+		jsonError(w, 404, "no such board")
+		return
+	}
+	if !assertNotBanned(w, r, b) {
+		return
+	}
+
+	tags, err := db.GetThreadTags(b)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	buf, err := json.Marshal(tags)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	writeV1JSON(w, r, util.HashBuffer(buf), buf, 30)
+}
+
+// Serve the currently trending threads of a board. The number of threads is
+// controlled with the "limit" query parameter.
+func trendingThreadsV1(w http.ResponseWriter, r *http.Request) {
+	b := extractParam(r, "board")
+	if !auth.IsBoard(b) {
+		jsonError(w, 404, "no such board")
+		return
+	}
+	if !assertNotBanned(w, r, b) {
+		return
+	}
+
+	limit := 10
+	if q := r.URL.Query().Get("limit"); q != "" {
+		var err error
+		limit, err = strconv.Atoi(q)
+		if err != nil || limit < 1 {
+			jsonError(w, 400, "invalid limit")
+			return
+		}
+	}
+	threads, err := db.GetTrendingThreads(b, limit)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	buf, err := json.Marshal(threads)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	writeV1JSON(w, r, util.HashBuffer(buf), buf, 60)
+}
+
+// Entry of the board directory
+type boardListingV1 struct {
+	ID           string   `json:"id"`
+	Title        string   `json:"title"`
+	NSFW         bool     `json:"nsfw"`
+	Tags         []string `json:"tags"`
+	Posts        uint64   `json:"posts"`
+	PostsPerHour uint64   `json:"postsPerHour"`
+}
+
+// Serve the directory of all boards as JSON. The listing can be filtered by
+// a tag and sorted by the number of posts made in the last hour.
+func boardListV1(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	tag := q.Get("tag")
+	byActivity := false
+	switch q.Get("sort") {
+	case "", "id":
+	case "activity":
+		byActivity = true
+	default:
+		jsonError(w, 400, "invalid sort order")
+		return
+	}
+
+	act, err := db.GetBoardActivity()
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	hideNSFW := config.Get().HideNSFW
+	confs := config.GetAllBoardConfigs()
+	boards := make([]boardListingV1, 0, len(confs))
+	for id, c := range confs {
+		if id == "all" || (hideNSFW && c.NSFW) ||
+			(tag != "" && !hasTag(c.Tags, tag)) {
+			continue
+		}
+		tags := c.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+		a := act[id]
+		boards = append(boards, boardListingV1{
+			ID:           id,
+			Title:        c.Title,
+			NSFW:         c.NSFW,
+			Tags:         tags,
+			Posts:        a.Posts,
+			PostsPerHour: a.PostsPerHour,
+		})
+	}
+	sort.Slice(boards, func(i, j int) bool {
+		if byActivity && boards[i].PostsPerHour != boards[j].PostsPerHour {
+			return boards[i].PostsPerHour > boards[j].PostsPerHour
+		}
+		return boards[i].ID < boards[j].ID
+	})
+
+	buf, err := json.Marshal(boards)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	writeV1JSON(w, r, util.HashBuffer(buf), buf, 30)
+}
+
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// Minimum number of characters in a search query. Shorter queries match too
+// many posts to be useful.
+const minLenSearchQuery = 3
+
+// Serve a page of posts, whose bodies contain the "q" query parameter. The
+// search can be limited to one board with the "board" parameter.
+func searchV1(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	query := strings.TrimSpace(q.Get("q"))
+	if utf8.RuneCountInString(query) < minLenSearchQuery ||
+		len(query) > common.MaxLenSearchQuery {
+		jsonError(w, 400, "invalid search query")
+		return
+	}
+	board := q.Get("board")
+	if board != "" && !auth.IsNonMetaBoard(board) {
+		jsonError(w, 404, "no such board")
+		return
+	}
+	var page uint64
+	if p := q.Get("page"); p != "" {
+		var err error
+		page, err = strconv.ParseUint(p, 10, 32)
+		if err != nil {
+			jsonError(w, 400, "invalid page number")
+			return
+		}
+	}
+	banBoard := board
+	if banBoard == "" {
+		banBoard = "all"
+	}
+	if !assertNotBanned(w, r, banBoard) {
+		return
+	}
+
+	res, err := db.SearchPosts(query, board, uint(page))
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	serveJSON(w, r, "", res)
+}
+
+// Formats of image hashes accepted by imageSearchV1
+var (
+	md5Validation    = regexp.MustCompile(`^[A-Za-z0-9_\-]{22}$`)
+	sha256Validation = regexp.MustCompile(`^[a-f0-9]{64}$`)
+)
+
+// Post with an image matching an image search
+type imageSearchResultV1 struct {
+	common.StandalonePost
+	// URL of the image's thumbnail. Empty, if the file has none.
+	Thumbnail string `json:"thumbnail,omitempty"`
+}
+
+// Serve the posts with an image matching either the "md5" or "sha256" query
+// parameter
+func imageSearchV1(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	md5, sha256 := q.Get("md5"), strings.ToLower(q.Get("sha256"))
+	switch {
+	case md5 != "" && sha256 != "":
+		jsonError(w, 400, "only one image hash allowed")
+		return
+	case md5 != "" && !md5Validation.MatchString(md5),
+		sha256 != "" && !sha256Validation.MatchString(sha256),
+		md5 == "" && sha256 == "":
+		jsonError(w, 400, "invalid image hash")
+		return
+	}
+	if !assertNotBanned(w, r, "all") {
+		return
+	}
+
+	posts, err := db.SearchImage(md5, sha256)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	res := make([]imageSearchResultV1, len(posts))
+	for i, p := range posts {
+		res[i].StandalonePost = p
+		if img := p.Image; img != nil && img.ThumbType != common.NoFile {
+			res[i].Thumbnail = imgassets.ThumbPath(img.ThumbType, img.SHA1)
+		}
+	}
+	serveJSON(w, r, "", res)
+}
+
+// Respond with 304, if the client's ETag matches the resource's current update
+// counter and the hash of its uncached content. Only the counter is read, so
+// clients with fresh copies never cause the resource to be fetched or encoded.
+// Returns, if a response was sent.
+func notModifiedV1(w http.ResponseWriter, r *http.Request, k cache.Key,
+	f cache.FrontEnd, hash string, maxAge int,
+) bool {
+	clientEtag := r.Header.Get("If-None-Match")
+	if clientEtag == "" {
+		return false
+	}
+	ctr, err := f.GetCounter(k)
+	if err != nil {
+		// Let the full request handle and report the error
+		return false
+	}
+	etag := v1Etag(r, ctr, hash)
+	if etag != clientEtag {
+		return false
+	}
+	if setV1Headers(w, r, etag, maxAge) {
+		w.WriteHeader(304)
+	}
+	return true
+}
+
+// Write JSON to the client. Responses to anonymous clients can be cached by
+// reverse proxies for maxAge seconds.
+func writeV1JSON(w http.ResponseWriter, r *http.Request, etag string,
+	buf []byte, maxAge int,
+) {
+	if !setV1Headers(w, r, etag, maxAge) || checkClientEtag(w, r, etag) {
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	writeData(w, r, buf)
+}
+
+// Set caching headers of a versioned API response. Returns false, if an error
+// was sent to the client instead.
+func setV1Headers(w http.ResponseWriter, r *http.Request, etag string,
+	maxAge int,
+) bool {
+	authed, err := isAuthenticated(r)
+	if err != nil {
+		httpError(w, r, err)
+		return false
+	}
+
+	head := w.Header()
+	for key, val := range vanillaHeaders {
+		head.Set(key, val)
+	}
+	if authed {
+		head.Set("Cache-Control", "no-store")
+	} else {
+		head.Set("Cache-Control", "public, max-age="+strconv.Itoa(maxAge))
+	}
+	head.Set("ETag", etag)
+	return true
+}
+
+// Send a JSON-encoded error message to the client
+func jsonError(w http.ResponseWriter, code int, msg string) {
+	buf, _ := json.Marshal(struct {
+		Error string `json:"error"`
+	}{msg})
+	head := w.Header()
+	head.Set("Content-Type", "application/json")
+	head.Set("Cache-Control", "no-cache")
+	w.WriteHeader(code)
+	w.Write(buf)
+}
+
+// Returns, if the client has a valid login session
+func isAuthenticated(r *http.Request) (bool, error) {
+	creds := auth.ExtractLoginCreds(r)
+	if creds.UserID == "" {
+		return false, nil
+	}
+	ok, err := db.IsLoggedIn(creds.UserID, creds.Session)
+	if err == common.ErrInvalidCreds {
+		return false, nil
+	}
+	return ok, err
+}
+
+// Serve the URLs of a board's banners
+func serveBannersV1(w http.ResponseWriter, r *http.Request) {
+	b := extractParam(r, "board")
+	if !auth.IsNonMetaBoard(b) {
+		jsonError(w, 404, "no such board")
+		return
+	}
+	serveJSON(w, r, "", bannerURLs(b))
+}
+
+// Returns the URLs the banners of a board are served at
+func bannerURLs(board string) []string {
+	return formatBannerURLs(board, len(assets.Banners.FileTypes(board)))
+}
+
+// Format the URLs of n banners of a board
+func formatBannerURLs(board string, n int) []string {
+	urls := make([]string, n)
+	for i := range urls {
+		urls[i] = fmt.Sprintf("/assets/banners/%s/%d", board, i)
+	}
+	return urls
+}
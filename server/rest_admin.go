@@ -0,0 +1,348 @@
+package server
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/websockets/feeds"
+	"github.com/bakape/thumbnailer"
+)
+
+var (
+	errNoSuchAccount   = common.ErrInvalidInput("no such account")
+	errTransferToSelf  = common.ErrInvalidInput("already board owner")
+	errNoNewBoardOwner = common.ErrInvalidInput("no new owner provided")
+)
+
+// Board IDs created through the API must also start with a letter and be at
+// most 8 characters long
+var boardIDV1 = regexp.MustCompile(`^[a-z][a-z0-9]{0,7}$`)
+
+// JSON keys of board configurations, that can be updated with
+// patchBoardV1. Banners are set with their own endpoint and the ID is
+// immutable.
+var patchableBoardFields = func() map[string]bool {
+	m := make(map[string]bool, 32)
+	var add func(t reflect.Type)
+	add = func(t reflect.Type) {
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.Anonymous {
+				add(f.Type)
+				continue
+			}
+			name := f.Name
+			tag := strings.Split(f.Tag.Get("json"), ",")[0]
+			if tag == "-" {
+				continue
+			}
+			if tag != "" {
+				name = tag
+			}
+			// encoding/json matches keys case-insensitively
+			m[strings.ToLower(name)] = true
+		}
+	}
+	add(reflect.TypeOf(config.BoardConfigs{}))
+	delete(m, "id")
+	delete(m, "banners")
+	return m
+}()
+
+// Board creation request to the versioned admin API
+type boardCreationV1 struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	NSFW  bool   `json:"nsfw"`
+}
+
+// Create a board owned by the admin account and respond with its
+// configurations
+func createBoardV1(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		var msg boardCreationV1
+		err = decodeJSON(r, &msg)
+		if err != nil {
+			return
+		}
+		err = isAdmin(w, r)
+		if err != nil {
+			return
+		}
+		if !boardIDV1.MatchString(msg.ID) {
+			return errInvalidBoardName
+		}
+		err = validateBoardCreation(msg.ID, msg.Title)
+		if err != nil {
+			return
+		}
+
+		conf := newBoardConfigs(msg.ID, msg.Title)
+		conf.NSFW = msg.NSFW
+		err = writeNewBoard(conf, "admin")
+		if err != nil {
+			return
+		}
+
+		buf, err := json.Marshal(conf)
+		if err != nil {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(201)
+		w.Write(buf)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Delete a board and redirect any clients currently on it to /all/
+func deleteBoardV1(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		err = isAdmin(w, r)
+		if err != nil {
+			return
+		}
+		board := extractParam(r, "board")
+		if !auth.IsNonMetaBoard(board) {
+			jsonError(w, 404, "no such board")
+			return
+		}
+
+		err = db.DeleteBoard(board, "admin")
+		if err != nil {
+			return
+		}
+		for _, c := range feeds.GetByBoard(board) {
+			c.Redirect("all")
+		}
+		w.WriteHeader(204)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Update only the board configuration fields present in the request body and
+// respond with the resulting configurations
+func patchBoardV1(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		board := extractParam(r, "board")
+		_, err = canPerform(w, r, board, common.BoardOwner, false)
+		if err != nil {
+			return
+		}
+
+		var fields map[string]json.RawMessage
+		err = decodeJSONLimit(r, &fields, boardConfigLimit)
+		if err != nil {
+			return
+		}
+		for k, v := range fields {
+			if !patchableBoardFields[strings.ToLower(k)] {
+				return common.ErrInvalidInput("field not patchable: " + k)
+			}
+			if string(v) == "null" {
+				return common.ErrInvalidInput("null value: " + k)
+			}
+		}
+
+		conf, err := db.GetBoardConfigs(board)
+		if err != nil {
+			return
+		}
+		// Decoding onto the current configs only overwrites present fields
+		buf, err := json.Marshal(fields)
+		if err != nil {
+			return
+		}
+		err = json.Unmarshal(buf, &conf)
+		if err != nil {
+			return common.StatusError{err, 400}
+		}
+		conf.ID = board
+
+		err = validateBoardConfigs(w, conf)
+		if err != nil {
+			return
+		}
+		err = db.UpdateBoard(conf)
+		if err != nil {
+			return
+		}
+		serveJSON(w, r, "", conf)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Board ownership transfer request to the versioned admin API
+type boardTransferV1 struct {
+	NewOwner string `json:"newOwner"`
+}
+
+// Make another account the sole owner of a board. Board owners can transfer
+// their own boards and the admin account can transfer any board. The new
+// owner is notified on all clients they are logged in on.
+func transferBoardV1(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		board := extractParam(r, "board")
+		if !auth.IsNonMetaBoard(board) {
+			jsonError(w, 404, "no such board")
+			return
+		}
+		creds, err := canPerform(w, r, board, common.BoardOwner, false)
+		if err != nil {
+			return
+		}
+
+		var msg boardTransferV1
+		err = decodeJSON(r, &msg)
+		if err != nil {
+			return
+		}
+		trimLoginID(&msg.NewOwner)
+		switch msg.NewOwner {
+		case "":
+			return errNoNewBoardOwner
+		case creds.UserID:
+			return errTransferToSelf
+		}
+		_, err = db.GetPassword(msg.NewOwner)
+		switch err {
+		case nil:
+		case sql.ErrNoRows:
+			return errNoSuchAccount
+		default:
+			return
+		}
+
+		err = db.TransferBoard(board, msg.NewOwner)
+		if err != nil {
+			return
+		}
+		buf, err := common.EncodeMessage(common.MessageNotification,
+			fmt.Sprintf("You are now the owner of /%s/", board))
+		if err != nil {
+			return
+		}
+		for _, c := range feeds.GetByAccount(msg.NewOwner) {
+			c.Send(buf)
+		}
+		w.WriteHeader(204)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Options for processing banners uploaded through the API
+var bannerOptsV1 = thumbnailer.Options{
+	MaxSourceDims: thumbnailer.Dims{
+		Width:  300,
+		Height: 100,
+	},
+	ThumbDims: thumbnailer.Dims{
+		Width:  300,
+		Height: 100,
+	},
+	AcceptedMimeTypes: map[string]bool{
+		"image/jpeg": true,
+		"image/png":  true,
+		"image/gif":  true,
+	},
+}
+
+// Append a banner uploaded in the "banner" field of a multipart form to a
+// board's banners and respond with the URLs of all banners
+func addBannerV1(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		board := extractParam(r, "board")
+		_, err = canPerform(w, r, board, common.BoardOwner, false)
+		if err != nil {
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, common.MaxAssetSize+1<<10)
+		err = r.ParseMultipartForm(0)
+		if err != nil {
+			return common.StatusError{err, 400}
+		}
+		file, h, err := r.FormFile("banner")
+		if err != nil {
+			return common.StatusError{err, 400}
+		}
+		out, err := readAssetFile(w, r, file, h, bannerOptsV1)
+		if err != nil {
+			return
+		}
+		if out.Data == nil {
+			return newFileError(h, "empty")
+		}
+
+		n, err := db.AddBanner(board, out)
+		if err != nil {
+			return
+		}
+
+		buf, err := json.Marshal(formatBannerURLs(board, n))
+		if err != nil {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(201)
+		w.Write(buf)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Remove a banner from a board by its position in the banner list
+func removeBannerV1(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		board := extractParam(r, "board")
+		_, err = canPerform(w, r, board, common.BoardOwner, false)
+		if err != nil {
+			return
+		}
+
+		banners, err := db.GetBanners(board)
+		if err != nil {
+			return
+		}
+		id, err := strconv.Atoi(extractParam(r, "id"))
+		if err != nil || id < 0 || id >= len(banners) {
+			jsonError(w, 404, "no such banner")
+			return nil
+		}
+
+		banners = append(banners[:id], banners[id+1:]...)
+		err = db.SetBanners(board, banners)
+		if err != nil {
+			return
+		}
+		w.WriteHeader(204)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
@@ -0,0 +1,272 @@
+package server
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/bakape/meguca/assets"
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/db"
+	. "github.com/bakape/meguca/test"
+	"github.com/bakape/meguca/test/test_db"
+)
+
+func TestCreateBoardV1(t *testing.T) {
+	test_db.ClearTables(t, "boards", "accounts")
+	writeSampleUser(t)
+	writeAdminAccount(t)
+	config.Set(config.Defaults)
+
+	cases := [...]struct {
+		name string
+		auth.SessionCreds
+		msg  boardCreationV1
+		code int
+	}{
+		{
+			name:         "not admin",
+			SessionCreds: sampleLoginCreds,
+			msg:          boardCreationV1{ID: "g", Title: "Technology"},
+			code:         403,
+		},
+		{
+			name:         "starts with digit",
+			SessionCreds: adminLoginCreds,
+			msg:          boardCreationV1{ID: "1g", Title: "Technology"},
+			code:         400,
+		},
+		{
+			name:         "too long",
+			SessionCreds: adminLoginCreds,
+			msg:          boardCreationV1{ID: "abcdefghi", Title: "Technology"},
+			code:         400,
+		},
+		{
+			name:         "reserved",
+			SessionCreds: adminLoginCreds,
+			msg:          boardCreationV1{ID: "api", Title: "Technology"},
+			code:         400,
+		},
+		{
+			name:         "valid",
+			SessionCreds: adminLoginCreds,
+			msg: boardCreationV1{
+				ID:    "g",
+				Title: "Technology",
+				NSFW:  true,
+			},
+			code: 201,
+		},
+		{
+			name:         "taken",
+			SessionCreds: adminLoginCreds,
+			msg:          boardCreationV1{ID: "g", Title: "Technology"},
+			code:         400,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rec, req := newJSONPair(t, "/api/v1/admin/boards", c.msg)
+			setLoginCookies(req, c.SessionCreds)
+			router.ServeHTTP(rec, req)
+			assertCode(t, rec, c.code)
+
+			if c.code == 201 {
+				var res config.BoardConfigs
+				err := json.Unmarshal(rec.Body.Bytes(), &res)
+				if err != nil {
+					t.Fatal(err)
+				}
+				AssertDeepEquals(t, res.ID, c.msg.ID)
+				AssertDeepEquals(t, res.Title, c.msg.Title)
+				AssertDeepEquals(t, res.NSFW, true)
+
+				conf, err := db.GetBoardConfigs(c.msg.ID)
+				if err != nil {
+					t.Fatal(err)
+				}
+				AssertDeepEquals(t, conf.NSFW, true)
+			}
+		})
+	}
+}
+
+func TestDeleteBoardV1(t *testing.T) {
+	test_db.ClearTables(t, "accounts", "boards")
+	writeSampleBoard(t)
+	writeAllBoard(t)
+	writeAdminAccount(t)
+	setBoards(t, "a")
+
+	cases := [...]struct {
+		name, board string
+		code        int
+	}{
+		{"nonexistent", "b", 404},
+		{"existing", "a", 204},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rec, req := newJSONPair(t, "/api/v1/admin/boards/"+c.board, nil)
+			req.Method = "DELETE"
+			setLoginCookies(req, adminLoginCreds)
+			router.ServeHTTP(rec, req)
+			assertCode(t, rec, c.code)
+		})
+	}
+}
+
+func TestPatchBoardV1(t *testing.T) {
+	test_db.ClearTables(t, "accounts", "boards")
+	writeSampleUser(t)
+	writeSampleBoard(t)
+	writeSampleBoardOwner(t)
+	setBoards(t, "a")
+
+	cases := [...]struct {
+		name, body string
+		code       int
+	}{
+		{"ID", `{"id":"b"}`, 400},
+		{"ID with other case", `{"ID":"b"}`, 400},
+		{"banners", `{"banners":[1]}`, 400},
+		{"unknown field", `{"foo":1}`, 400},
+		{"null", `{"title":null}`, 400},
+		{"invalid type", `{"title":1}`, 400},
+		{"invalid custom CSS", `{"customCSS":"@import \"a.css\";"}`, 400},
+		{
+			"valid",
+			`{"title":"Technology","posterIDs":true,"defaultCSS":"moe",` +
+				`"customCSS":"body { color: red; }","nsfw":true}`,
+			200,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rec, req := newPair("/api/v1/admin/boards/a")
+			req.Method = "PATCH"
+			req.Body = ioutil.NopCloser(strings.NewReader(c.body))
+			setLoginCookies(req, sampleLoginCreds)
+			router.ServeHTTP(rec, req)
+			assertCode(t, rec, c.code)
+		})
+	}
+
+	conf, err := db.GetBoardConfigs("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, conf.Title, "Technology")
+	AssertDeepEquals(t, conf.PosterIDs, true)
+	AssertDeepEquals(t, conf.CustomCSS, "body { color: red; }")
+	AssertDeepEquals(t, conf.NSFW, true)
+	AssertDeepEquals(t, conf.Eightball, []string{"yes"})
+}
+
+func TestTransferBoardV1(t *testing.T) {
+	test_db.ClearTables(t, "accounts", "boards")
+	writeSampleUser(t)
+	writeAdminAccount(t)
+	writeAccount(t, "user2", []byte{1, 2, 3})
+	writeSampleBoard(t)
+	writeSampleBoardOwner(t)
+	setBoards(t, "a")
+
+	cases := [...]struct {
+		name, board, newOwner string
+		auth.SessionCreds
+		code int
+	}{
+		{"nonexistent board", "b", "user2", sampleLoginCreds, 404},
+		{"no new owner", "a", " ", sampleLoginCreds, 400},
+		{"to self", "a", "user1", sampleLoginCreds, 400},
+		{"nonexistent account", "a", "user3", sampleLoginCreds, 400},
+		{"by owner", "a", "user2", sampleLoginCreds, 204},
+		{"by former owner", "a", "user1", sampleLoginCreds, 403},
+		{"by admin", "a", "user1", adminLoginCreds, 204},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rec, req := newJSONPair(t,
+				"/api/v1/admin/boards/"+c.board+"/transfer",
+				boardTransferV1{NewOwner: c.newOwner})
+			setLoginCookies(req, c.SessionCreds)
+			router.ServeHTTP(rec, req)
+			assertCode(t, rec, c.code)
+		})
+	}
+
+	staff, err := db.GetStaff("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, staff, map[common.ModerationLevel][]string{
+		common.BoardOwner: {"user1"},
+	})
+}
+
+func TestBannersV1(t *testing.T) {
+	test_db.ClearTables(t, "accounts", "boards")
+	writeSampleUser(t)
+	writeSampleBoard(t)
+	writeSampleBoardOwner(t)
+	setBoards(t, "a")
+
+	banners := []assets.File{
+		{Data: []byte{1}, Mime: "image/png"},
+		{Data: []byte{2}, Mime: "image/gif"},
+	}
+	if err := db.SetBanners("a", banners); err != nil {
+		t.Fatal(err)
+	}
+	assets.Banners.Set("a", banners)
+
+	t.Run("list", func(t *testing.T) {
+		rec, req := newPair("/api/v1/board/a/banners")
+		router.ServeHTTP(rec, req)
+		assertCode(t, rec, 200)
+
+		var urls []string
+		err := json.Unmarshal(rec.Body.Bytes(), &urls)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertDeepEquals(t, urls, []string{
+			"/assets/banners/a/0",
+			"/assets/banners/a/1",
+		})
+	})
+
+	cases := [...]struct {
+		name, id string
+		code     int
+	}{
+		{"invalid ID", "x", 404},
+		{"out of bounds", "2", 404},
+		{"existing", "0", 204},
+	}
+	for _, c := range cases {
+		t.Run("remove "+c.name, func(t *testing.T) {
+			rec, req := newPair("/api/v1/admin/boards/a/banners/" + c.id)
+			req.Method = "DELETE"
+			setLoginCookies(req, sampleLoginCreds)
+			router.ServeHTTP(rec, req)
+			assertCode(t, rec, c.code)
+		})
+	}
+
+	res, err := db.GetBanners("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, len(res), 1)
+	AssertDeepEquals(t, res[0].Data, []byte{2})
+}
@@ -0,0 +1,326 @@
+package server
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/bakape/meguca/cache"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestThreadJSONV1(t *testing.T) {
+	setupPosts(t)
+	setBoards(t, "a")
+	cache.Clear()
+
+	cases := [...]struct {
+		name, url string
+		code      int
+	}{
+		{"invalid thread number", "/www", 404},
+		{"nonexistent thread", "/22", 404},
+		{"existing thread", "/1", 200},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec, req := newPair("/api/v1/thread" + c.url)
+			router.ServeHTTP(rec, req)
+			assertCode(t, rec, c.code)
+		})
+	}
+
+	t.Run("anonymous caching", func(t *testing.T) {
+		rec, req := newPair("/api/v1/thread/1")
+		router.ServeHTTP(rec, req)
+		assertCode(t, rec, 200)
+		const std = "public, max-age=5"
+		if s := rec.Header().Get("Cache-Control"); s != std {
+			t.Errorf("unexpected Cache-Control: %s : %s", std, s)
+		}
+
+		etag := rec.Header().Get("ETag")
+		rec, req = newPair("/api/v1/thread/1")
+		req.Header.Set("If-None-Match", etag)
+		router.ServeHTTP(rec, req)
+		assertCode(t, rec, 304)
+		AssertDeepEquals(t, rec.Header().Get("ETag"), etag)
+		AssertDeepEquals(t, rec.Body.Len(), 0)
+
+		rec, req = newPair("/api/v1/thread/1")
+		req.Header.Set("If-None-Match", `W/"stale"`)
+		router.ServeHTTP(rec, req)
+		assertCode(t, rec, 200)
+	})
+
+	t.Run("mobile", func(t *testing.T) {
+		rec, req := newPair("/api/v1/thread/1")
+		router.ServeHTTP(rec, req)
+		assertCode(t, rec, 200)
+		etag := rec.Header().Get("ETag")
+
+		// Must not be served the full version from the client's cache
+		rec, req = newPair("/api/v1/thread/1?mobile=1")
+		req.Header.Set("If-None-Match", etag)
+		router.ServeHTTP(rec, req)
+		assertCode(t, rec, 200)
+
+		var res common.MobileThread
+		err := json.Unmarshal(rec.Body.Bytes(), &res)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertDeepEquals(t, res.ID, uint64(1))
+		if rec.Header().Get("ETag") == etag {
+			t.Fatal("mobile and full responses share etag")
+		}
+	})
+}
+
+func TestBoardJSONV1(t *testing.T) {
+	setupPosts(t)
+	setBoards(t, "a")
+	cache.Clear()
+
+	cases := [...]struct {
+		name, url string
+		code      int
+	}{
+		{"nonexistent board", "/nope", 404},
+		{"page overflow", "/a?page=3", 404},
+		{"first page", "/a?page=0&sort=bump", 200},
+		{"invalid tag", "/a?tag=N%40pe", 400},
+		{"tagged page", "/a?tag=tech", 200},
+		{"tagged page overflow", "/a?tag=tech&page=1", 404},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec, req := newPair("/api/v1/board" + c.url)
+			router.ServeHTTP(rec, req)
+			assertCode(t, rec, c.code)
+		})
+	}
+
+	t.Run("envelope", func(t *testing.T) {
+		rec, req := newPair("/api/v1/board/a")
+		router.ServeHTTP(rec, req)
+		assertCode(t, rec, 200)
+
+		var res boardPageV1
+		err := json.Unmarshal(rec.Body.Bytes(), &res)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertDeepEquals(t, res.Page, 0)
+		AssertDeepEquals(t, res.Pages, 1)
+		AssertDeepEquals(t, len(res.Threads), 1)
+	})
+
+	t.Run("mobile envelope", func(t *testing.T) {
+		rec, req := newPair("/api/v1/board/a?mobile=1")
+		router.ServeHTTP(rec, req)
+		assertCode(t, rec, 200)
+
+		var res mobileBoardPageV1
+		err := json.Unmarshal(rec.Body.Bytes(), &res)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertDeepEquals(t, res.Pages, 1)
+		AssertDeepEquals(t, len(res.Threads), 1)
+	})
+
+	t.Run("not modified", func(t *testing.T) {
+		rec, req := newPair("/api/v1/board/a")
+		router.ServeHTTP(rec, req)
+		assertCode(t, rec, 200)
+
+		etag := rec.Header().Get("ETag")
+		rec, req = newPair("/api/v1/board/a")
+		req.Header.Set("If-None-Match", etag)
+		router.ServeHTTP(rec, req)
+		assertCode(t, rec, 304)
+		AssertDeepEquals(t, rec.Body.Len(), 0)
+	})
+}
+
+func TestThreadTagsV1(t *testing.T) {
+	setupPosts(t)
+	setBoards(t, "a")
+
+	t.Run("nonexistent board", func(t *testing.T) {
+		rec, req := newPair("/api/v1/board/nope/tags")
+		router.ServeHTTP(rec, req)
+		assertCode(t, rec, 404)
+	})
+
+	t.Run("no tags", func(t *testing.T) {
+		rec, req := newPair("/api/v1/board/a/tags")
+		router.ServeHTTP(rec, req)
+		assertCode(t, rec, 200)
+		assertBody(t, rec, "[]")
+	})
+}
+
+func TestTrendingThreadsV1(t *testing.T) {
+	setupPosts(t)
+	setBoards(t, "a")
+
+	cases := [...]struct {
+		name, url string
+		code      int
+	}{
+		{"nonexistent board", "/nope/trending", 404},
+		{"invalid limit", "/a/trending?limit=-1", 400},
+		{"board", "/a/trending?limit=5", 200},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			rec, req := newPair("/api/v1/board" + c.url)
+			router.ServeHTTP(rec, req)
+			assertCode(t, rec, c.code)
+		})
+	}
+}
+
+func TestBoardListV1(t *testing.T) {
+	setupPosts(t)
+	config.ClearBoards()
+	for _, c := range [...]config.BoardConfigs{
+		{ID: "a", BoardPublic: config.BoardPublic{Tags: []string{"tech"}}},
+		{ID: "c", BoardPublic: config.BoardPublic{Tags: []string{"tech", "me"}}},
+		{ID: "d"},
+		config.AllBoardConfigs.BoardConfigs,
+	} {
+		if _, err := config.SetBoardConfigs(c); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cases := [...]struct {
+		name, query string
+		code        int
+		boards      []string
+	}{
+		{"all boards", "", 200, []string{"a", "c", "d"}},
+		{"by tag", "?tag=tech", 200, []string{"a", "c"}},
+		{"no matches", "?tag=nope", 200, []string{}},
+		{"by activity", "?sort=activity", 200, []string{"a", "c", "d"}},
+		{"tag and activity", "?tag=me&sort=activity", 200, []string{"c"}},
+		{"invalid sort", "?sort=nope", 400, nil},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec, req := newPair("/api/v1/boards" + c.query)
+			router.ServeHTTP(rec, req)
+			assertCode(t, rec, c.code)
+			if c.code != 200 {
+				return
+			}
+
+			var res []boardListingV1
+			err := json.Unmarshal(rec.Body.Bytes(), &res)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids := make([]string, len(res))
+			for i, b := range res {
+				ids[i] = b.ID
+			}
+			AssertDeepEquals(t, ids, c.boards)
+		})
+	}
+
+	t.Run("activity", func(t *testing.T) {
+		rec, req := newPair("/api/v1/boards?tag=tech")
+		router.ServeHTTP(rec, req)
+		assertCode(t, rec, 200)
+
+		var res []boardListingV1
+		err := json.Unmarshal(rec.Body.Bytes(), &res)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res[0].Posts == 0 {
+			t.Fatalf("no posts on board a: %+v", res[0])
+		}
+		AssertDeepEquals(t, res[1], boardListingV1{
+			ID:   "c",
+			Tags: []string{"tech", "me"},
+		})
+	})
+}
+
+func TestSearchV1(t *testing.T) {
+	setupPosts(t)
+	setBoards(t, "a")
+
+	cases := [...]struct {
+		name, query string
+		code        int
+	}{
+		{"no query", "", 400},
+		{"too short", "?q=ab", 400},
+		{"too long", "?q=" + GenString(101), 400},
+		{"nonexistent board", "?q=abc&board=nope", 404},
+		{"invalid page", "?q=abc&page=-1", 400},
+		{"all boards", "?q=abc", 200},
+		{"one board", "?q=abc&board=a&page=1", 200},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec, req := newPair("/api/v1/search" + c.query)
+			router.ServeHTTP(rec, req)
+			assertCode(t, rec, c.code)
+		})
+	}
+}
+
+func TestImageSearchV1(t *testing.T) {
+	setupPosts(t)
+
+	md5 := GenString(22)
+	sha256 := strings.Repeat("a", 64)
+	cases := [...]struct {
+		name, query string
+		code        int
+	}{
+		{"no hash", "", 400},
+		{"both hashes", "?md5=" + md5 + "&sha256=" + sha256, 400},
+		{"invalid MD5", "?md5=abc", 400},
+		{"invalid SHA-256", "?sha256=" + strings.Repeat("z", 64), 400},
+		{"by MD5", "?md5=" + md5, 200},
+		{"by SHA-256", "?sha256=" + strings.ToUpper(sha256), 200},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec, req := newPair("/api/v1/search/image" + c.query)
+			router.ServeHTTP(rec, req)
+			assertCode(t, rec, c.code)
+		})
+	}
+}
@@ -54,12 +54,22 @@ func startWebServer() (err error) {
 	fmt.Fprintf(&w, "://%s", address)
 	log.Info(w.String())
 
+	webServer = &http.Server{
+		Addr:    address,
+		Handler: r,
+	}
 	if ssl {
-		err = http.ListenAndServeTLS(address, sslCert, sslKey, r)
+		err = webServer.ListenAndServeTLS(sslCert, sslKey)
 	} else {
-		err = http.ListenAndServe(address, r)
+		err = webServer.ListenAndServe()
 	}
-	if err != nil {
+	switch err {
+	case nil:
+	case http.ErrServerClosed:
+		// Let the graceful shutdown finish closing client connections
+		<-shutdownDone
+		err = nil
+	default:
 		return util.WrapError("error starting web server", err)
 	}
 	return
@@ -77,23 +87,31 @@ func handlePanic(w http.ResponseWriter, r *http.Request, err interface{}) {
 // Create the monolithic router for routing HTTP requests. Separated into own
 // function for easier testability.
 func createRouter() http.Handler {
-	r := httptreemux.NewContextMux()
-	r.NotFoundHandler = func(w http.ResponseWriter, _ *http.Request) {
+	mux := httptreemux.NewContextMux()
+	mux.NotFoundHandler = func(w http.ResponseWriter, _ *http.Request) {
 		text404(w)
 	}
-	r.PanicHandler = handlePanic
+	mux.PanicHandler = handlePanic
+	r := routeGroup{ContextGroup: mux.ContextGroup}
 
 	r.GET("/robots.txt", serveRobotsTXT)
+	r.GET("/metrics", serveMetrics)
+	r.GET("/health", serveHealth)
+	r.GET("/ready", serveReadiness)
 
 	api := r.NewGroup("/api")
 	api.GET("/health-check", healthCheck)
 	assets := r.NewGroup("/assets")
 	if config.ImagerMode != config.NoImager {
 		// All upload images
-		api.POST("/upload", imager.NewImageUpload)
-		api.POST("/upload-hash", imager.UploadImageHash)
-		api.POST("/create-thread", createThread)
-		api.POST("/create-reply", createReply)
+		api.POST("/upload", limitRate(uploadRequests, imager.NewImageUpload))
+		api.POST("/upload-hash",
+			limitRate(uploadRequests, imager.UploadImageHash))
+		api.POST("/create-thread", limitRate(postRequests, createThread))
+		api.POST("/create-reply", limitRate(postRequests, createReply))
+		api.POST("/delete-own-post",
+			limitRate(postRequests, deleteOwnPost))
+		api.POST("/edit-post", limitRate(postRequests, editPost))
 
 		assets.GET("/images/*path", serveImages)
 
@@ -119,6 +137,10 @@ func createRouter() http.Handler {
 		})
 		r.GET("/:board/:thread", threadHTML)
 		r.GET("/all/:id", crossRedirect)
+		r.GET("/feed/:board", serveFeed)
+		r.GET("/amp/board/:board", ampBoardHTML)
+		r.GET("/amp/thread/:id", ampThreadHTML)
+		r.GET("/boards/:board/custom.css", serveCustomCSS)
 
 		html := r.NewGroup("/html")
 		html.GET("/board-navigation", boardNavigation)
@@ -146,15 +168,52 @@ func createRouter() http.Handler {
 		) {
 			boardJSON(w, r, true)
 		})
+		boards.GET("/:board/archive", boardArchiveJSON)
 		boards.GET("/:board/:thread", threadJSON)
+		boards.GET("/:board/:thread/stream", streamThreadJSON)
 		json.GET("/post/:post", servePost)
+		json.GET("/post/:post/context", servePostContext)
+		json.GET("/post/:post/quotes", serveQuoteChain)
+		json.POST("/posts", servePosts)
+		json.GET("/recent-posts", serveRecentPosts)
+		json.GET("/own-posts", serveOwnPosts)
+		json.GET("/watched-threads", serveWatchedThreads)
 		json.GET("/config", serveConfigs)
 		json.GET("/extensions", serveExtensionMap)
 		json.GET("/board-config/:board", serveBoardConfigs)
 		json.GET("/board-list", serveBoardList)
+		json.GET("/board-stats/:board", serveBoardStats)
 		json.GET("/ip-count", serveIPCount)
 		json.POST("/thread-updates", serveThreadUpdates)
 
+		// Versioned REST API
+		v1 := api.NewGroup("/v1")
+		gz := func(path string, h http.HandlerFunc) {
+			v1.Handler("GET", path,
+				compressResponses(limitRate(readRequests, h)))
+		}
+		gz("/thread/:thread", threadJSONV1)
+		gz("/board/:board", boardJSONV1)
+		gz("/boards", boardListV1)
+		gz("/board/:board/banners", serveBannersV1)
+		gz("/board/:board/tags", threadTagsV1)
+		gz("/board/:board/trending", trendingThreadsV1)
+		gz("/search", searchV1)
+		gz("/search/image", imageSearchV1)
+		gz("/openapi.json", serveOpenAPI)
+
+		// Event streams are flushed incrementally and can not be buffered
+		v1.GET("/board/:board/events",
+			limitRate(readRequests, serveBoardEvents))
+
+		v1Admin := v1.NewGroup("/admin")
+		v1Admin.POST("/boards", createBoardV1)
+		v1Admin.PATCH("/boards/:board", patchBoardV1)
+		v1Admin.DELETE("/boards/:board", deleteBoardV1)
+		v1Admin.POST("/boards/:board/transfer", transferBoardV1)
+		v1Admin.POST("/boards/:board/banners", addBannerV1)
+		v1Admin.DELETE("/boards/:board/banners/:id", removeBannerV1)
+
 		// Internal API
 		api.GET("/socket", func(w http.ResponseWriter, r *http.Request) {
 			err := websockets.Handler(w, r)
@@ -177,18 +236,42 @@ func createRouter() http.Handler {
 		api.POST("/delete-board", deleteBoard)
 		api.POST("/delete-posts", deletePosts)
 		api.POST("/delete-posts/by-ip", deletePostsByIP)
+		api.POST("/delete-posts/all-by-ip", deleteAllByIP)
 		api.POST("/delete-image", deleteImage)
 		api.POST("/spoiler-image", modSpoilerImage)
 		api.POST("/ban", ban)
 		api.POST("/notification", sendNotification)
 		api.POST("/assign-staff", assignStaff)
 		api.POST("/same-IP/:id", getSameIPPosts)
+		api.POST("/posts-by-IP/:board", getPostsByIP)
+		api.POST("/IP-history", getIPHistory)
+		api.POST("/similar-images/:id", getSimilarImages)
+		api.POST("/thread-IPs/:id", getThreadIPs)
+		api.POST("/mod-notes", addModNote)
+		api.POST("/mod-notes/:id", getThreadModNotes)
 		api.POST("/sticky", setThreadSticky)
+		api.POST("/cyclic", setThreadCyclic)
+		api.POST("/max-replies", setThreadMaxReplies)
 		api.POST("/lock-thread", setThreadLock)
 		api.POST("/unban/:board", unban)
+		api.POST("/appeal", submitAppeal)
+		api.POST("/appeals/:board", getPendingAppeals)
+		api.POST("/resolve-appeal", resolveAppeal)
+		api.POST("/word-filters/:board", getWordFilters)
+		api.POST("/add-word-filter", addWordFilter)
+		api.POST("/remove-word-filter", removeWordFilter)
+		api.POST("/fortunes/:board", getFortunes)
+		api.POST("/set-fortunes", setFortunes)
+		api.POST("/webhooks/:board", getWebhooks)
+		api.POST("/add-webhook", addWebhook)
+		api.POST("/remove-webhook", removeWebhook)
 		api.POST("/set-banners", setBanners)
 		api.POST("/set-loading", setLoadingAnimation)
+		api.POST("/watch-thread", watchThread)
+		api.POST("/unwatch-thread", unwatchThread)
+		api.POST("/watched-thread-seen", markWatchedThreadSeen)
 		api.POST("/report", report)
+		api.POST("/resolve-report", resolveReport)
 		api.POST("/purge-post", purgePost)
 
 		redir := api.NewGroup("/redirect")
@@ -201,12 +284,12 @@ func createRouter() http.Handler {
 		assets.GET("/*path", serveAssets)
 	}
 
-	h := http.Handler(r)
+	h := http.Handler(mux)
 	if enableGzip {
 		h = handlers.CompressHandlerLevel(h, gzip.DefaultCompression)
 	}
 
-	return h
+	return countRequests(handleCORS(h))
 }
 
 // Redirects to / requests to /all/ board
@@ -0,0 +1,67 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/bakape/meguca/websockets"
+	"github.com/go-playground/log"
+)
+
+// Time to wait for connections to close on shutdown
+const shutdownTimeout = 10 * time.Second
+
+var (
+	// Running web server. Nil, if not yet started.
+	webServer *http.Server
+
+	// Closed, once GracefulShutdown completes
+	shutdownDone = make(chan struct{})
+	shutdownOnce sync.Once
+)
+
+// GracefulShutdown stops accepting new requests, sends all websocket clients a
+// randomized reconnection delay hint, waits for in-flight requests and
+// websocket writes to complete and then closes all connections. Any
+// connections still open after timeout are closed forcefully. Subsequent calls
+// are no-ops.
+func GracefulShutdown(timeout time.Duration) {
+	shutdownOnce.Do(func() {
+		gracefulShutdown(timeout)
+	})
+}
+
+func gracefulShutdown(timeout time.Duration) {
+	defer close(shutdownDone)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if webServer != nil {
+		// Hijacked websocket connections are not closed by this
+		if err := webServer.Shutdown(ctx); err != nil {
+			log.Errorf("web server shutdown: %s", err)
+		}
+	}
+
+	remaining := timeout
+	if deadline, ok := ctx.Deadline(); ok {
+		remaining = time.Until(deadline)
+	}
+	websockets.Shutdown(remaining)
+}
+
+// Shut down gracefully on SIGINT or SIGTERM, when running attached to a shell
+func handleShutdownSignals() {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, os.Interrupt, syscall.SIGTERM)
+	<-ch
+	log.Info("shutting down")
+	GracefulShutdown(shutdownTimeout)
+	os.Exit(0)
+}
@@ -21,6 +21,10 @@ const (
 	// Body size limit for POST request JSON. Should never exceed 32 KB.
 	// Consider anything bigger an attack.
 	jsonLimit = 1 << 15
+
+	// Body size limit for board configuration JSON, which can contain custom
+	// CSS. Allows for escaping of the CSS.
+	boardConfigLimit = jsonLimit + 2*common.MaxLenCustomCSS
 )
 
 var (
@@ -103,11 +107,17 @@ func httpError(w http.ResponseWriter, r *http.Request, err error) {
 	if err == nil {
 		return
 	}
+	if e, ok := err.(common.CooldownError); ok {
+		serveCooldown(w, e)
+		return
+	}
 
 	code := 500
 	switch err.(type) {
 	case common.StatusError:
 		code = err.(common.StatusError).Code
+	case common.ReadError:
+		code = err.(common.ReadError).StatusCode()
 	default:
 		if err == sql.ErrNoRows {
 			code = 404
@@ -120,6 +130,28 @@ func httpError(w http.ResponseWriter, r *http.Request, err error) {
 	}
 }
 
+// Returns, if err is a common.StatusError with the passed HTTP status code
+func isStatusCode(err error, code int) bool {
+	se, ok := err.(common.StatusError)
+	return ok && se.Code == code
+}
+
+// Returns, if err is a common.CooldownError
+func isCooldown(err error) bool {
+	_, ok := err.(common.CooldownError)
+	return ok
+}
+
+// Respond with 429 and the remaining seconds of the board's post cooldown
+func serveCooldown(w http.ResponseWriter, err common.CooldownError) {
+	retryAfter := strconv.FormatUint(uint64(err.RetryAfter), 10)
+	h := w.Header()
+	h.Set("Retry-After", retryAfter)
+	h.Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+	fmt.Fprintf(w, `{"error":"cooldown","retryAfter":%s}`, retryAfter)
+}
+
 // Check client is not banned on specific board. Returns true, if all clear.
 // Renders ban page and returns false otherwise.
 func assertNotBanned(w http.ResponseWriter, r *http.Request, board string,
@@ -172,7 +204,13 @@ func extractParam(r *http.Request, id string) string {
 // Decode JSON sent in a request with a read limit of 8 KB. Returns if the
 // decoding succeeded.
 func decodeJSON(r *http.Request, dest interface{}) (err error) {
-	err = json.NewDecoder(io.LimitReader(r.Body, jsonLimit)).Decode(dest)
+	return decodeJSONLimit(r, dest, jsonLimit)
+}
+
+// Like decodeJSON, but with a custom read limit in bytes
+func decodeJSONLimit(r *http.Request, dest interface{}, limit int64,
+) (err error) {
+	err = json.NewDecoder(io.LimitReader(r.Body, limit)).Decode(dest)
 	if err != nil {
 		err = common.StatusError{err, 400}
 	}
@@ -12,6 +12,13 @@ begin
 			returning posts.op into op;
 		perform pg_notify('post_moderated',
 			concat_ws(',', op, new.id));
+
+		-- Reports of deleted or purged posts need no further action
+		if new.type in (2, 8) then
+			update reports
+				set resolved = true
+				where target = new.post_id;
+		end if;
 	end if;
 	return null;
 end;
@@ -7,6 +7,10 @@ begin
 	-- +1, because new post is not inserted yet
 	perform pg_notify('new_post_in_thread',
 		new.op || ',' || post_count(new.op) + 1);
+	if new.id != new.op then
+		perform pg_notify('post_inserted',
+			new.board || ',' || new.op || ',' || new.id);
+	end if;
 
 	-- Delete post, if IP blacklisted
 	select b.by into to_delete_by
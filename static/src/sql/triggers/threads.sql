@@ -6,6 +6,8 @@ begin
 	-- Init Russian roulette
 	insert into roulette (id, scount, rcount) values (new.id, 6, 0);
 
+	perform pg_notify('thread_inserted', new.board || ',' || new.id);
+
 	return null;
 end;
 $$ language plpgsql;
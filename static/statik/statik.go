@@ -8,6 +8,6 @@ import (
 )
 
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\xb8\x8cwN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x12\x00	\x00assets/loading.gifUT\x05\x00\x01\xedn\x96\\\xbc\xbauP\\_\xd75x\xbb\xa1\x1bM\x82k\x08\x1d\xdc5h\x80\xe0\x10,\x90\xe0\x16\xdc\xdd\x9d\xc6\xdd\xdd\x1d\x9a\xe0n\x01\x1a	\xee\x04h\xdc=\x04'\x10c\xea\xf7\xce\xf3|\xdfWoM\xbd\xf3\xc7\xd4<\xe7\xaf{\xf7]k\xaf\xb3\xcf9\xb5k\xd5\xad#\xf7VVP\xc8x\x0b\x80\x03\x0b\xac\x00\x17\x00\xbb\xbf\xbf\x87\x17\"\xb6T\xbd\x10\x88\x19#\x988B\xd0(\x12\xc6u\xb1\x852\xc2cB\xb5\xab\x1a9\xc1\xf1\xf0\xb8`L\x82\xe2\x00\x93\xb6\xb6\x95\xbc\xaa\x11\xaa0\xd3\xc8\n\x01c\x92\xc7\x93\xd7NtbB\x18Y\x01\x00\x80\xaa\x1fAxe\x92Y\xc1\xb7\x8e\x8e\xeeG.\xb6\xbc\x10\xf7\x88\xfa{#\x04J\xd0	\x81\xb8\xaf\x97w\x1a\xd1VE\x90	r\xc9;11\xa9^\xd4\xabj\x0b\x92\x89\xab\xd6\xc3\xb8\xe0\xc0\xff\xb7\xf1\xf2\x11GEF\xfd\x83\x94\x84\xaa\x0c\x0f\x07\x17\x1a\xe8\xff\x0ei+\xab\xc2\xa4\x8d\xdd\x8c\xb5\x95UE\xdex9\x19\x9b\xda\x9a\xbb\xc1L\xcc-\xad\x1dDi\xce\xbbzi`\xd6f\xa24Z|\xca\\\xcaNR\xe6V\xd6\xf2>.\xe6\x1f|T\xd4M}lM\x85\xcch\xde\x88\xc1D\xbc\x84\xbd\xec\x9d\xec\xcd\xdd\x8ca^\xf6v\x0e\xae\xc2^\xa24\xc6f\x8e&\xe6\xc2\x0e\xae\xc2\xff\x849i`\xff\x05q\xb3\x15\xa5\x91\xf8\xe7\x03\xec\x1fM)G\x17s\x18\x1f\x07\x17\xbb)\x17?\x17\x8c\x9f\x9b\x83\x9b\xf7\x95\x80\x80\x00\x1b\x8c\x87\x8b\x9b\x8b\x93\x8b\x87\x93\x9b\x87\x9d[@\x98\x97G\x98\x8b\x0b\xf6\xafA#\x06\x13q1\xb3\x10~/-\xfb/-\x173\x0bQ\x1a+77'aNNOOO\x0eO^\x0eG\x17KNn!!\xa1\x7fr\xf0\xf0\xb0\xbb\x98Y\xb0\xbbz;\xb8\x19{\xb1;\xb8\xd2\xfe;\x83\xb4\xb9\xab\xa9\x8b\xb5\x93\x9b\xb5\xa3\x03\xec\x9fwc\x13Gw7Q\x1a\x9a\x7f\x97`\xef\xa4\xac\xfc\xbf\x12;\xb8r\xfcWA\x1c\xa6\x8e\xf6\x9c^\xc6N\x9c\xdc\x1c\\\x9c\xf6\xf6\x9c\xffF\xbb\xba\xbd7\xb7\xf8\x9f\xd1\xae\xea\xdeN\xe6\x9c\xef\xcd]\x1d\xdd]L\xcd\xdf\x9b[\xd0\xfe\x1fR\xff3\xf5\x1f\xa0\x93\xb2\xb2\xb0\xb4\xa3\xa9\xbb\xbd\xb9\x83\xdb[iQ\x1a/{'\x0e3k3a)I^\x01!.I\x1e\x19A~\x1enn\x99WB\xfcB\xfcB\xbc\xb2|\xdc<\x02\x02\x02\x122R\xff\xe6\xbeupu3v05\xff7\xd7\xfa\x7fs\xb9\xffG\xae\xb0\x94\x8b\xb9\xb1\x9b\xa3\x8b\xba\xa3\xa3\xdd\xbfwO\xd5\xca\xd1\xcd\xd1\xd5\xca\xd1	&\xf5\x81\x0f\xa6e\xed`\xe6\xe8\xe9\xfa\xcf\xc2\xfek\x9e\xe6.\xd6\x1e\xe6f\xb2.\x8e\xf6\xb0\xffZ\x19a\xeb\xff\xa6\xfe\xcf\xcc\x05\x04\x05\xb8\xa5\xf8$\xa5\xf9yd\x04e^qsKJH\xcaJqq\xf1\x08H\xc9pI\x08HH\xd1\xfc\x8bk\xf6\xffP\xf5\xff+\x97S\x0c&\xc2\xf9\xdf\xb6\xf9\xdf\xa1\xf7\xd2\xb2\xff<\xfe\xafC+\x06\xfb\xdf\xc7\xde\xdc\xc1L\x94\xc6\x85\xe6\x8d\x18\xe8\xf1\xef\x9f\xdf\xbf~>\xdc\xff\xb8\xbb\xbd\xb9\xbe\xba\xbc8\xff~\xf6\xed\xf4\xe4\xf8\xe8\xf0`\x7fowg{ksc}mue\x19\xb5\xb4\xb8\xf0u~nvfzjrb|ltdx\xe8\xcb\xe0@\x7f\x1f\xb2\xb7\xa7\xfbsWgG{[kKsScC}]mMu\xd5\xa7JDEyYiIqQaA~^nNvVfFzZjJrRbB|\\lLtTdDxXhHp\x10<0\xc0\xdf\xcf\xd7\xc7\xdb\xcb\xd3\xc3\xdd\xcd\xd5\xc5\xd9\xc9\xd1\xc1\xde\xce\xd6\xc6\xda\xca\xd2\xc2\xdc\xcc\xd4\xc4\xd8\xe8\xa3\xa1\x81\xbe\x9e\xae\x8e\xb6\x96\xa6\x86\xfa\x87\xf7j\xaa\xefT\x94\x95\x14\x15\xde\xca\xcb\xc9\xcaHKIJ\x88\xbf\x11\x13\x15y-,$(\xc0\xcf\xf7\x8a\x97\x87\x9b\x8b\x93\x83\x9d\x8d\x95\x85\x99\x89\x91\x81\x9e\x8e\x96\xe6%\x8c\xfa\x05\xd5sJ\nr2R\x12b\"B\x02|\xbcgO\x9f\xe0\xe2`cab@!\xe8h`\x10\x00\xbc|@\xc7\xc2\x02X\x01\xb6\x7f:\xc7?\x0d\x10\x80>\"\xd3\x9c\xe4\xd9(\x12FJ]F\xed\x84\x0bf\x10n\n5\xbau\xa8j\x8f\xf13\x9f\xde\xadz/%\x8e\x9c\xe9\xa3f\x9fI\x87\xae\xcd\x8bv?\x95\xba\xd5\xf3\xfb\xcf\x01\xd3\xe7\xbf\x1e\xe1A\xc1!\xa1a\xe1\x11\x91Q\xd11\xb1q\xf1	\x89I\xc9)\xa9i\xe9\x19\x99Y\xd9\x16@^~N\xa1Q>\xa4\xb4\xac\xa0\x08\xa1\x99\x07\x81\xa2\xd5\xd6B\xcb y\x95-\xef\xaaj\xea:\xeb\xcb\x81\xd6^y\xa0\xbak\xb0\xae\xa1\xb4\x079\xc6\nt|\x99\x1en\x1a\xef\x9d\x9c^\xfc\xdc\x94?:\x9f\xbd\xb0T?\xdb\x08\xe0\x12\xee\xe36\xafe\x02\x9bh\xdb\x8dM{\xfbW\x87+\xc7\xc9\x1b\x8b\xe7\xe7\xbbW\x7fp\x1f\x1f[\x97]\x9b\xbfg\xf7\x18\xee\xfd\x9d\x9c\x1f\xc2[GJ\xf7\xe3\xa6\xf3\x88\xcf\x8aWx\x01h\xd8)\x1aI&\xfcA\xcf0\xb6i\xf9\x1bvd\\\xc9_\x0b\x0d\x1cL\x94\x0c$\x06a\x93\xd0.\xcc\xd2\x0c\xc5\x1ej\x12\x94 \xc6\xe9\xe5\x1f3}iHb4x\x17\x05s\x0fQ}'X\xa7\x8c\xaa\"\xb4\xee\x0d\x9f\xac\xb1+\x94\xca\xba\\t\xfc\xc6\x0em\xa6+\x89\xbfP\x9d\xed\x8d\xa1i\xda\xeau\x1c\\\xda\xc4~b\xd6d\x19\xcf\xefN'\xd1\xeeny2z\xe7f\xabP\x11\xd1\xdb5\xf14\x13\xa1N\xc1\x07\xc1fQ\x17\xa4\x1f&\x0c\x85$\xd2r\xc2\xb7\xb0\xf4\xb5\x9d!\xe0\xc7u\xb66\x83\x03SaOa\x08\x04p\x86\x0cF\x917\x1d\xdfh\xc3O\x97\xea!Jw_\x9d\x0b>\x1a\xe4@\x9e\x0d\xdcM\xd6\xb3\xbf\xab \xcc\xd1\x9c\xc4B\x06\xa3F\x92\xa6\x1d\xca\x1dK\xb7\x90\x9f\x0f\xf8\xf3\xa0\xcfo/2Lb\x197\xf3\xfd\xfa>\xb5\x89\xd4\x1d?\x9d\xac\xe5\xb8U\xe9S\xe3\x1c\xda\x80\x00?~\xac\x0bL\xfd\x8c\xe5\xbb\x06\x90\xaf\xdf\x9a\xdf\xe6\xd5v\xde\xde\x96T\xd2 \xa1\x94H\xcb-[Jo\xa9\x8d\x80:\xa7V\x02\xb5\x1fY2j\xf0\xf1\xfb\x8a\x17\xce\x1a\xedr[A\xcf\xb1a\x8f\xb8b8=\x8d\xabZ\xf8\xad\xb4D\x82D.\xa6\xdb\xee\xcc\x1c\xc6k\xe1T.dZz\x0f\xa1\x1e;F>-\xbcE\xbaR\xd5\x99\x14\xbe\xcaV\xdaF\xb1<\xe1\xd0m-a\x9d\xaf\x04\xf6\xe9-\xf7\x89	\xc0F#\x10$E`]\xe89\xd3\xce[\xcc\xcaK\xd1\x12\x1d\xff\xfa\xca\x0b=\x95\x8c\xe2\xa5Y\x9a&\x0b\x06\x08\xbdV\x86\xef\xab\xba\xe8\x17\xbe\"H3\xbey~\xa6E\xb1\xe5zB\x89\xb5\x16,\xd6\x9aa\xb8\x1cHM\xc38d\x05f\xac\x93!n\x9fJ\xe9\x02\xbd\xa0%\x87\x81\x83\x1d\x18\x94\x85\xd6\xba'\x14f\x8d\x1d\x1d\x1f\xbd\x18\x0c\x9d\xfaB\xf1\xa5g\x98\\'\x05\xc9+\xf1\xcc\xe6\xc0\xc8\xc2\xe1\xeep\xeb\xd8<\xe4\xd0\x81\xdd\xcbl\xcc\x136\xb7h\xddO\x95\x9b5\xc5'\x9fDU|\x8a\x9csi\x04N=BZ+\xd7\xcb\x93\xb0\xd0l4'/\xcc\xf0K\xf2\xa4\x9d$*K\xee\xc1t\x0d\x12\x14\xbfl\xcc\xd6\xd4\x1et\x9br\xf8O\x1f\x0dj\x0e%[\xd5\xe4\xbej\x9a\xd1\xb4\xf6:\xb0\xb2Um\x19\x82\x9e76\xbc\x10\xd4\xb1\xab\xa3AY\xf5&x\x1f\xe2k\xf3\xbd\xedB V\xff\xfa\x8c\xd5?tj;\xf5B[\xa1\xbbM\x1b\xa0U\xbb\xbe\x05\x12\xf3\xe4\x12Y\x1d\xe4E+\xa64\xe0<\xa8rAi\xda\x84\xee\xc3\xf8\xc9\x93\xc4\xc9\xf6\xd8\xec\xbb\x8f\xdd\xfbQl\xb4\xc23\x08\x88+\x16\x003\x96\xb05tQ\xfd\xb5em\xec\x19c\xa3\x9c<]\xb1\xc3\x07\xa3\x92}@ \xd1\x98\x9f\xae3\x92\x8cA\xd5\xe59=K\x14vu\xb5\x95\xd7\x10\xc1\xeb_d\xe6\x13(\\\xe0\xab[\x7f\x19;\x08r\xf1\xbcoa\xeb \xcc=\xc1\xc46\xfb\x9b\xc7\xab\x81\x03*s\xe1i{\xce\xde!\xfb\x12'9[^\x9b+\xb62(\x170\xfd\x06\xad\xdb\x86\x91@\x95\n\xb9\x8f.?\x83G\xb42\xa8\xc0\xe1i\xfb\x1e\xf7~\xc45'\x96\xcd\xdc\x8a4\xa6~Ju\\\xa4m\xd7L.\xe0\x87c\xfaG\x87\xc7_g\x99\xb6\x01\xa4\x84+\xfeSvGF\xe7\"<_\xf3\xd8\xb4*\xfdb\x1f'\xce\xd60qI:\xf6L\xc0\xf0_7_\x0f{\xba\xb2\x08\xcf\xe3\x9eo\xad$(\xb7\xac\x897\x1dD	\xe16P\xd8\xed\xad\xfdU\x1a\xbbnZ\xa2\xf1\xbf\xbd\xd8\xcd\x17\n\xffmCMi\xcep~F\x05\x16\xf9\xf1\x1c\xb9P7\x07}\xe0\x82\x07\xe1\x9a!\x1dl}\xf3\xc1\xe6\x02\x1d\xf7B3\x1e\xe8\x99\xd4\xc2C\xfbT\xbf\xba-\xf8\xc5W)\xcd\xef\xb5\xf8\x96\xbd%=@}\xc83\xaeDq@\x1e\x06\xc2\x92\xdf\x02/\x0bt\xac\x88r\x05\xe0\xa7\x1a\xa1\xa2\xe5\x1ap\xa2\xd9C\xfe\x98\xac\xff0\xc1x5\xe8\x15\xacw\x88Wt&\x7f\xcf+\x8c\x0f\x03e\x9b\xe6_\x91\x95}\xbe\xfbs\x106\x1fcDD\x8bx\xfd\xee\xe64:\xa5\x8f\xba\x91?\x0d9q\xc9\xe5wl-\x0f\xc5\x94G\xa2S\xe0G1+\xa5V\x12-!:%\x92|\xb8\xdd\x8c\x9f\xd3\xa2^\xc7\x9a?&\xf1\xb8),B\xf1\xf1\x00,\xbfY\xb7!\x93YP\xa0\xf9 !\x01R/\xa7$\x98\x90\x12\x04\x90\xf6\xe0\x07\xbb\xc6\xee-\xfa`d\xf1\xed\xb3\xebT\xef\x9ab)\x14\x8a?4\xb3\xa8Y*!_\xae\x12\xc2\x1d\xedw\xfc\x13rM\xe8\x8e\x8f\xf2\xa4\x14\x86\x92\xd3\xd7v\xfe\xd2X\xf2\xdd\xbd\xe4\x12e\x93\xdcf\xea\x80\x8f\x19tr\xa6]\xe8\xd1S\xe9\x90\x93kG\xd7d\xb2\xe8\xbf\x9cQ\x1d\x99\xb0\x08h\x14}\xe6T\xc4\xcc\x19\xa4`\xa4\xf9.3\x82-\x93;\xe1\x98\x83\x9e\x85\x10\xc0\xc0\xac\x10\xcc\x89\xea\xb74\x01\x01\xcf\xe4\x0f\xd1\x1c\x0e\x15\xe7\x04\xe7\xb2?\xe5\xee.\xb6zw\x9a\xf1\\z3\x10V\x88=\x13\x9fr\x81&\xbf#\x7f_!\\\xd61\"\xf9\x8a \xffY*\x9dy#\xd6x\x0dsR\xe2\x11\xda\xa1\xd8\xc0\xf2\xb4E427\x97\x10!\xbe\xac\xa3*\xa9NX\xd4\x17k\xcaxD\xa6\xb8\x0b\xc4\xbb\xf7\xb2h\x940(\xccF3\x88\xa3?R\x1d!e\xb2.\xa7\x99\xcap\x81'3V\xc2X>c\x9e\xc1\xaf\xf2#b\x8d\x81\xa6\xe5\x11)\x0b\xc5\xb2<#kE\xb5\x91wD\xfd7\xc5y\x99\x95\x0f\x00\\\x11\xde9B(\xb4\xc0,\xd7\x85?\\\x10\xee\x96&\xc3\xc7B5P\xf9\xa4\x8c_\xa3`\"\xd4\x9aQ\x1c\xa9y\xe7\xc7\x93\xf4jAV\x132\xf6\xc1\x1ds\xbe\xeaj\xc0Bu\xd8f\xb1\xa7\xbc\x05\x0d\x00\xfa0\xa0\xbf@x\xe8V2\xd1o\xed \xaa\xf0\xe0\x08op\x98\xab\xc6\xa1fe\xe5R\x97\x0fq\x0e\xc1\x19\xabb\x1e\xcb<2\x18\x8e\xee\x94F\xa4Q\xb3\x0bX\x08#A\xb1\xfel\xba\x84\x85\xf3\x9d\x9a\x92>i\xb7\xc7\xe6\xf4\xcbA\xe8V\x1e\x05XG\x00\x86 \xa0M\x00'I5uNfT\xdd\x05\xfc\xf3=\x1a\x80zv\x07\xda\x9c\xa9\xd1\xf7;\x85U\x83Uh\x87\x88\x8e\xf5E\xcd\x0e\x8bIvGO\x1c\xf8\xe6\xd0\x84\xd9\xdfL\x06|\x85\x90\xcc\xb9\xf77\xed1\xcb\x8fv\xe0\xcc\xd3\x15_m\x95\xe89e\x84<\xa8pu\xfdj\xb1\x8c\xb3\xa6\xceA\xf4\xd2\xc0\xe86\xc4\x8f\xf9\xc3\xb45\xa1\x93.\x06\x13CS\xbby\x8dl\xde\xe9\xc6\xbddRgd\xa8\xb5\x92)K0\xf6\xc0\xd7\xce;\x88]`\x10_\xcf\xe3S\xdb\xe6r\xe6\x0b\xeb\x0f)\x06\x07;*\x9f\xf1\x84\x10!u\xcc\xa3\x97sK=dS\xf5\xeeg\x16H\xb4\xa1\x12jj\xe8e~x\xca\xfa\xed\\Z_\xd2\xd1`\xf42\xb3R\x85\x9bd/Wy\xbd\x0d\xc0%\xcb\xf3\xe2\xcb\x80\x9a\xf838\x99\x89aY\xb1S_\xdb\xa9\x95\x96\xa6\xbe\x0e\xb6\xb7d\xbf\x02\xb4\xf6\x81`f\x8f\x87\xc5\xec\xe5\x87\x8b\xd0\xc8%\x11#V\xb9\xd6n\x89![\xbb\xd5\xca\xd0p\xaeh\x95W\xf6\xe5xh\xe2\x92U\x88\xe0Ix\xed\x95\x0d-\x8e\x99\xbc\x1fQfC\x0d\xcd90\x17\xf5Z :\x17:\xe1\xa2P\xac/\x06\xa0\x0b\xcf\xa0\xec\x8f\x13\xec#v\xa4\x10\xa8\xec\xb4\x85<\xacG\xd9\xbc\xe2\x12.\xdd\x96\x1d:X\x96\xa3'\xd2\x19\xfdI\xc5\x93Hw4a\xe7c]\x9c\x95\xe1\xe4\xb0\xc2\xf4\"\x9a\xd5c\x93\x0f_K\x9e\xb6f|\xe8\x07,\xa2\xaf]\x94\x95\x91\x98\x96\x18\xb6:\xaf_/\xb6\x0e\xb9}8\xc4\xb6\x94%r\xef\xf46\xa0\xaf\xdf\xb7\n\x16\xd3)C\x8d\xc9\xdc\xbd\xc7B\xd9h\x88\xfcZ;\n\xf7hV\xac\xda:8\x11\x1e\xb9\xc83i}\x89\x0bW\xeb\xd5\xa2t\xfb\x9e\xa4\xc1\x00\x97t\xfe\xd5\xe4\xf7S\xa1\x0d\x89\xb1\x01W\xfc\xf0z\xbc+\x7f\xa0\xa5I\xf4\x9c\xec\xa4\xd6\xa5S\x85\x08\x19cd#\xfb\x0e\x0e\xdfa\x9b\x17\xa2\xe2\x00\x05\xfb\x8a\xacC\xa6l\xd0\xb0\xd9#?\x0f\xe2\xb9\x1a\xd0\xf3\xf8\xc5\xe9\n\xb1\\e\xa8d\xcfS\xbc\x07\x90\x8e\xfe\x0c\xb9;\xae\x07\xf7\xc3o\x0c\xed\x11{\xf4\xe8\xe2\xcf|0\xfb\x85\xe5\xc21\xe0\xfb\x04\xf2?\xc7)\xd3n\x08\xc8\xc8\x99\x0f\x11B:\xc3\xe27\xb9\x11\x9d\xbb\xaf\x19\x1f(\x038\x1e\xdd\x8a\xde\xd5\x9d\\\xca\n\x1d|#\xd1\x1a\x93qJ\x81u`~\xb5\xcew\xe6\x81\xaf\x9f8\xd5\xb1\xf5\xb7\xd5\xc6\xd5\xfav\xfbH\xfd\x90\xa6\xdb\x17\xb8\xf3a\x14=\xd8\xb5\x17\x02\xf1\xeb\x80\x85\xef\x10p\xe2\xa5\x11t&\x9fk\x91\x973\x84o\x13\xba\xf6\xce\xbbo\xf1D\xb8\xce\x9dj\x84\x9f33\x06\x19y\xae*\x85r\x8aT\xe8\xc8\xc8R\xf2f\x93o9|\xa6\xa1\x9e\xf6\xe9\x92y\xd1\xf4\xa9\xb7rf\xd4\xf00U*\xf9:P\xcb\x87k\xe3\xb8\x86\x876\xff\xc5\x97\x93f9=\xce\xdf\\\xfa\xe7$\x8c\x87L\xdf]\x9fy\x91&\xa8\x07\xe4]\x88\xee\x98\x16\xf0\xed\xbd\x9eC0\x98\xfd\xdd\xc4\xb7\xee1\xcc \x08S\xae\xbd\x04U\xf5\xba\x0bI\x1a\xfeZ\xb5\x1c\xc2\xbc\xf9\xfe\xe7<\xa4\x17\x0e-\x15{\xf0\xae\xf8\xfd\xed\x83\xc0\x1f\xa0\x10\xf9s\x00{`/9k\xe2R \xd0~F\xb1\x96\xc6+	\xdd\x0b(\x04\xd1:\x81\x8d0\xfc\xfff\x80&^\x05\x80\xdb\xa1\x8f\xe7!\xfe\x16\x15\xf6\xb5\xc9p\nq\x10.\xa6\xef[w\x00\xef\xe8\x1d\xa1|\x10nC`\x9d\xc0\xc7\x15q\x10E}06*\x88i\x84e;\x144A\x17H\x9b\xc9C[\x1f\xc0\xc8$AT\x18\xcc:\x12\"z\x14\xacH\x12\xa2,\x13\xc2GF\xf73\"d\xf5\x90\xfd\xf5H\xb0X{\x90\xc0\x91\xa6\xe4\xc8;i\xed\xd0\xcfM!\xefF\xc8\x1e\x86C\x14GB\x7f\xfe \x1c%\x0e\xfb \x1f\xe6\xde\x1a\xa2\xe9\x15r\xbaD+&\x1fnf\x15nH\x16\xc6L\x17\xaeW\x11n\x9b\x89\xfb0\x1cL\x19)NT\xa1O\xc6\xe5\xeet\x04\xd5A\x84[\x8e\xb0(1\xf9\xed\x02\xc1\xf6K\x11U/#\x88.\x08(\xb4\xfd\x10\xc3\x00\xb9Sd$\x02\x1a\xd8\x1ebx\x14\xf9\xe1\x0b\xb0K\x14\x9e2\xc2\x12\xed\xe5\x1a\xc6\x14\x96\x17	M\x93\x0f/\xf4\xf2)\x19\xc1\x8a\xb6\x8a2t\xf2\xab\xb4\xf2\xcb\xbb\x88\xaaC`6XE7\xb5c\x12z\xc6t\xb6\xc7|F\xc5\xe0\xf7\xdc\xc7 \xc9b\xfb\x05c\x07\xb5c\x87\x8e0$\xfec.9'7/\xbf\xc0\x00(..,{W\x82(\x95\x04\xa0P\xc8\xa7\xf2\x06\xe9\x12HMkM]iu;\xd0\xd8X\xdc\x82\xd6?0\x88V[\xd2Z\xd7S\x88\x84~\x99\x1e\x1cn\xf9<\x9e\x07\xf4\xcdL\xb7\xd5B\xe6\xc7\x16\xb2\xab\x97\xbf\xac\xad\xaf\xb7\x1f\xacwoe\xec\xec\x0e\x1d_\x1ev\x94|K\xff\xbe\xbc\x7fu\\\x8cK\xf8\x88\xfb~\xa1\xa9\xedh\xa5\xcb\xff\xea\xe7\x1f=\xcc\xc4 \xa9\x88SL\x86\xfe\x1fl\x1b\xad\x9a\xd8\xe4<~\xf7\\\xc5\xd2R\xd0\xa6\xbc\xaeH|xo8##:Q\xe4\x89\xe9\xcb\xe8\xc1\xf2\xa4WF\x04\xf4\xc3i$\x8e\xb3\xae\xe4\x98\xf0\x91\xc7,\xb2?\xeb\xa1Lc:\xcd\xae\x03^\xde{Y\x8ctBzCn=\x14\x9dsG\xed\x9a\xb3\xf1\xec\xe6,\x11eL\xc6\xaa%\xc5\xbc\x96\x8d\xb4\x13\x9e\x9aqA\xebci\x93L\x988;c\x06\x80\xb1\xbaf\xa8\xf9tO\x88E\x9a\xfd*o\xf1\x166\x99R{=\xd09\x82\xf7\xe9\x97\xc2\xc4\xc0\xc60\xda\xef\xbf\xc4\xb46A\xdb\xe3\x19\xf8\x1fW=\xd9\xcf\x80\xe1Dj\xc5\xa9\xaf.*\xe6\x13\x08nN\x80\xe4Yy\xc4\xd2\x97\xe7\x19\xd7\"\x0e7\xa2\x90vV\\74\xa4\xcb\xc9f\x96@\x15+\xde\xaf\x16g\xe3\xc2\x10\xe7\x92\x9aI~z\xa0\x9dX}\xa4\xfb\xf5\x13x\x1f%?\xb0\xa9\xa2\xdd~j<d\xdc%\xac\xe0Ww}X\\~\x98n\x987q\xfbm\xa5c|S	\xdf\xcd\xce\x18%\xf9\xec\xd7\x10[\xb7\xcfI}\xe8\xa5\xa1\xc7\x151\x938\xef\x04\x1f4!`/7b\x94\xe9\x11D\xb6E\xd2\xddGZ\x10\xb4F\x9ej\xc4\xfd\xb0?\xff\x18\xc7\xff\xfbO\xb2\xa1\xdb\xec1\xff6\xf19\x968,\xfc:\xe8V\xdb\xbb`\xf9\x87\x92\xfc\xce\xac\xd9\xb3P:\x85H\x990\x94\xc1\x08\x8dSU\x87\x17q,\xf7\x18I	W,\xbdy\x89\xb4\xe0\x81Uq\x88\x9c\xbe\x16*\xfa\x8d\x05\x14\x84\x1e\xf2\x80\xae\x19L\xf5}	&\xd6*n\x1d'\x8e\x7f\x82	\x01\xb4)d\xf7\x13\xde\x13\xa4[\x18\xc8\x95\xedc\xdbKSH\xc5$+\x06\xd3F\xa3\xa3\xe3\xc1@YB\x8d\x9e4\x04\xddO\x0e(\x8dm)\x98\x14\x8c{ \x0f\xa92\xc4\xe6ac\xe9d\x0dhp\xf4L\x05<d9\xe8>K!+8>\xbc\xdc\xdc\xda0\xfd\xc88#\xadS\xb7(T'\x1c%a`BX\x0e\xf1\xb0\xa7\xb5\x10\xcc\"@2W\xe4\x17\x83OR\xd4\xa6n)\xfd\xc2\xd3u\xed<K\x88\xc2\xcd\xf5u\xff\xd2U(\x0dk\x8b\xdb\xaa\xb5?\x97\x9a)\xcb\xfc\xfe\xc9\xbe\"\xf0\xe7\xc9\x9eC\xc9\x9cy\xa1\x11+\xb6\x07\x190\xffB\xf8Q\xab	f\xc27\xc1G\xaa\x9f\xa8\x88\x8e\xaf\xd4\x85\xa3\xec\xbd\x11YY\xbbj\xb3j\x9bB_LS\xed\xb7s\xc7\x047\xaa\xd2Q\xe5\xcc\xae\xe9\xdfg\xfa\xd8E\x17\xa6\xf0\xf9\x86C\xe9\x8c\xf5\xe5I\x95u\xef\x9f\xbf\xafi\xa2:G\xf9\xbe\x17}\x19\xd2\xd3\xfaRAu\xe048\x19v\xab\xf0\xe5\xc6P\x9c\x93Ew\xfd\xe7h\xd9\xf3\xc3\xb5J\x0e\xea\x88U\x0b\xfd^b}\x14\x02O5V\x1c=\x88x\x9c\xady\xd6\xed\x00W\x99K\x8b\x8d\x97e\x0c5\x11;%LX\x88\xcd\xe2D\xcc\xe223d\xe1M\x0fpS\xe7\xd9\xe9\xb3rz\xce\xb2\xb6%RI|\xe6)\xf9\xdc\xcf\xd7\xad=\x89\xdb\xab\xedZ\xed.m\xa5y\xe7n\xe3\x1e\xd6\xbd2\x8a\x0f\x83#\xbf\x06\xcc\x87\x91\xa9\xbeZ\xffu\xb5\xeaD\x1a\x7f\xb3\xe6\x91\x03\x16/\xf0\xb6\xec\x9e\xd2\x0b,	'\x1b\xc5\x88\x01\xb7MX0n\xacv\xd3%\x9d5\xaf^B\x81\xb6\xed\xb4\xc79\xc6\x8d,\xcf\xe8\xc7\xb6E\xd7\xe9u!\x1b\xd3\x10\x95\xbb\xa3K\x1a#\xce\x15\xff\x12\x87\xaa\x8b\xae\xfa\xda\xd3S\xcc\xc1\x17\xe3\xfbMKS\xa7\xdf\xfc]\x9e\xde8\x9a6\xd90\x01\x11\x9c\x81n\xd7%V\xad\x0fJk\xf3\xba8\xcb\xeb\xa4N\x12@\x98\xc0\x1b\x1d\xfc\x199\xce\xee\xb3\xaf\xb6\xdd]i\x9fRb\xff\xfe\xac\xa5|\x1a`\xe4\x9e\x03i\xe6\xa2\x11\xb9.\xe2\x04\x83\xc8\x90?\x7f\x1f\x8a\x8c\xaf\xc7{dU\x15\xfc\xa9\xb5D\xa8/\x98\xab\xd7\xecll\xeb{\x82\xa0S5\x1c\x1d!\x05\xf3\\\xef\x05q\xe0u\xfeX\xdf\n16\x05\xd0\x01;\x9c`\xef\xaa\x8f\x8d\xba\x10\xe1N\xfd\xc4`w\xee\xb5+\xf5\xa3\x9e\x9a\x96\x08\xbe\x13+\xeao\xbf\x05\xe0\xe0\xfbi\x7f\xb6\\J\xdc\xd7|o~N\xaa(T\xd1d=q\xf4b\xbay\x89|'\"\xbd\xc3B\xd2\x98\x1c4\xb3C,'b\"\x10\x8fBV\xf1q[\xf2\xe8\xa7\xfd\xb1\xf2\xa2gq\x98\x0e\x04%\x8a\x93$i\n\xa8\x01\x8f\xe8\x00\x19R\x1c\xca\xb3E\xfc$\xfc2\xc1\xfc\xf32y\xd3\xdd\xe0}\x03\xb6\xbd}\xf5\xf8mu$\x1cH-D7F\x87;\x90a\xe2\xe3~\xc3F\xa3\xf8\xac\x0e&\xd8\x1a~S{\xe1\x9fAI\xf7\xb2E\xb6[J\xa5\xc1\xb3\xf6do\xbb\x11%\x02\x895\xb1\x005\xd0UpY\x0b\xea\xe2\xc3@\x81\x04;\xb3\xa5\xe6\xed\xa3\x0d3a\xc5\xcf\xcd\x993\xdc\x15\xd4ke3\xf2\xed\xcc\xdd\xd0\x93\x83D\x0e\xd4BZ\x07\xe9\x19g58\xadu\xd8\xa2\\q\x18\x16\xe9\x04\xfbLX\x92uFn\xe8\x90`\x06l1\x16;c\x7f\x05\xe0\xbbv0\xcd\xb8\xb5k\x0eO\xa8\xe2\x16\xc12\xe5\xe0Qr\x86\\iI[\x0f=\xb6\xb5\x81CJ\xfbu0\x0e\x86L6}\x8f\xab\xcf\xc0\xe7\xe7\xd9+\xda\xef\xd40\x1bL\x18\xbe\xd1\x03\xfcog\xad\x15xT\xdf\x81\xad`\xdb\xc3Uq={\x85\xfdkz\xb7d+9\x9d\xdf\x94]\xb8r\x16A\x9acs\xd1\xdfSr\x1f_\xd8o\xa3Y\xc9\xcd\xf8\xc2\x89\xf2\x17|\x8b\xdf\xfe\xd2\x94o\xd3\x89-\x13\xb7\xb7\x96a\xd7e0\xb2\xfe\xc7\xaa\x9f\xa0y\x10\xcf(\x87^\x95\xbf\x9c\xb4\xbe\xc5\xedx\xaf\x8e\xce\x87\x90\x9a<\x11\x94\"~]\xca\xc2W\xf9*\xee\x98\x1a\x8e\xa5q\x9c\xd6\x98\xaa\xa5|\nb\xc1V\x8f\x8e.D\xa3\xc2\xebS4\x16\x86\xd4\x05\xf0U*\x11\xdbR\xe7\xebj6)\xe0\x016O\xaaU7)\x96\xd4F\xdc\x89\xa9\xd1t|\xd3a\xd1\xd05\xe2\xea\xaeO\xd6t\xf7+K\x98c\xaa\xf5A\xcap\xcc\x0d\x16-\x00\xbe\xd5D\xb8b\x83\xc8\x86c\x0f\x8b\xf1\xd7A\xf8\xac\xcc?2\x0d<\xa5\x03\x9a\xec\xc3l\x8dE\x7f\x05=\x83\x17\xb6\x86\x10\x9c\xa5\xa0\xa2Psb\xfc\xd5\xcf\xfa\xd7l\x9cW*\xde8\xc3\xd1j\xfd\xed-^;\xad=\x8d\x0cFo\xab\xb1w\xb3g%_\xd7\xdc\xea\\\x92\x01a\x7f\x16\x8cV\xce\xbe\xabBtG\xfc\x0e\xafj{\xcf\xf3\xa3}D\xf9\xd1A\x13\xcbW\x1f\x10\xff\xd1\xd4\xf2\x07\xc0\xda\xc1\xd1\x1db\xe0\x17~Z\x88@{^\xb8\xfe\xbd\x95\x0f\xd4\x1bWmx\x86\xf7f\xa1V3<<\x8c\xd5g\xb7sy2?\xa3#\xbf\xfb\xccm?\x07\x9b \xd72\xf7)p\x12\xc0Zo\xb0\xdfz\xdb\xa5\xf0\xa4\x8e\x0e\x9bM\xff\xca\x85\x10H`\xebc\x9a\xca1\xbc\xa1i\xeb\xc0O\xafu\xfb\xc5\x9c\xc8k@$\xf0``\x0d\xe6\xaf\xcei\xf6\xf9Q\x8b\xf4\x7fK-\xd37I\xa0\xd7:\"\x9f\xbf^\xf0\"J@\xfd\xf3\xc2\x17\xa736\xbf2[c\x1b\x95q\xecb\xa6\xd9\x00\xcf	S\xe4O2\xa4'\xf1%\x0c\xc0\x0b\xe6\xc9\xe9\x98\xa0;\xb0\xc0M\x998px[\x88\x8e\xb7\x96\xcbd\xb6\xba\xfcc\xae\xad\x80)\x043\xfa\xef\x87\xa0??\xb3D\xb3 \x97\xef!\xbf\x93\xf1\xacN+\xed\xf8\xad\x8aS\xd6j\xc4D]\xf4\x14\xc9\xaf\x98\xa6\x84x\xc3\x05\xcf{6\xc7\xec\xc0\xe2J]\xe5\xd8\x92D\xe1\x07DI\x8f\x02iV\xe5\x1c\xd99\xeb\x14a\x05[\xb8\xc7t\x85\x8a\xba\xf5\xe5\xc4e\xa0\xc2$\xacW3o\x0cG\x1bt\x05\xc8\x0fF\x82\x07Y\x7f\xb34V\xbeS\xeeV\x9f6!\xfa\xf3\xc9\xa1\xdaX\xd4ZwI\x18\xa6C\x16VP!j\xff\xcc4m>\xd4b\x101+oG\xcf\xe9A\xacx\xd3\x8c\xf9k\xf4\x9bF\xa94-%\x04hY:\xb3\xb4=\xf5\x9a\xdf:C\xd0\xb6\xc6\xa9\x9aT\xe8\xaao\x93\xa4\xe9 \xf7\xed\xe2\xc4\xbbj+3)\xbb0jm\x8b\x02\xf8FV\xca\xdb\x08c\xfc\x1c\xe7\x1c\xbe\xcd\xb42\x8f+\xd3\x18\xd8\xea}\x00\x01OF\xbc\x86d$.\xe2I\x9fo\xbcL\xffA\xab\n\x1a\xd8YDa\xa5\xae\x93[W6\xa6\x05p\x0e:\x90-\xb5O\xf0}\xbf\x0c\xea\xbd\x1bN$\xbe\x85w\xcd\x0d+\x19x\x11\xf9a\xb7\x9a\xe919\xc7\xd7\x971x~\xbe\x9f\xc8\x98\xba\x89]\xf2\x1b7\x1a\xaa\x1a\x91\x8b\xafQ\xd2\xf9\xf3\x8e\x89\xba/_\x81\xfc\x91>]u\x7fS-\xdb\xcb G\xe5\xaeQ\x00\xbe\xb5)\xe3%\x11lhWP`\x82\xce\xd8\xfe\x95\x10k_I,\xc0`:\x94\xa0\x8bh'\x82\xb9\xe9W\xf7q\x00\xbf=\xda`,fG\x89\xcfQS!\x1c\xaa\x82\xe7\xa9$\xe3C49Q\xb2~nh\xd0-\xf7r26\x1d\xb9\xf9X{\x97Q\xdf\x8d\x8a\x8c(\xd8!\xa5\xf6\xd4\x8e,.N\nd\xd8'\xef:\xef\xf6\xf4\x9d\xf5\xdf\x14\xd9\x1e\xc6v\xc3\xf28\xbd\x02\n\x91Go\xeb\xdc\xc7\xcc\xf9n}\xd8\x84\xd5\xbe\xe1x\x8bu\xfe\xbd\x1d\xe1l\x99zS\xe3>\x90\xb3f\x01\xcc\xc0\xa1n\x9c\xfe\xc7\x02\x81\xc5\xec\xeb\xbb/\xe8{\x87\x8b#^\x00\xab\x05\xfb5tOn\xa6\x19\x03gK7\x84\xc8\x00.p\xcd\xc7u\x9b\xd9:\xb1[\xbe\x99\x00\xce:\xf5+\xc4\x92\x18\xb5\xa2\xd4\x8a\x88\xe1\xfb\xba=Rxe=\xa7<\x89\x8f\x81\x04\xe8\xf6\xd0\xc3]\xc3\x7f\xae\xc2\xb7\x04\xf9\xde\xbbYS\xaa,\xf0q\xa8\xcd\xf3/c\x80/=\xdc\xb7\xcc\x17\xf3( \x8d0\x10\xd7\x8a \xc7\x0d\xc0g\n\"\x81\x04\xac[\xc2/\\\x83@x~(7\xef\xe7\xa8 \x162z\\\x04\xe8\x8fj0\x81\x95\xcf\xa6\x076Q\xfb\xdb\x936\xdc\x0e\xd2\x10\x9eH\x9a\x97L\xc1\xec|!\x96\x8do\xc9\xc5\xd5\xde\xdc\xe3H\"|\xbb\xdbh\xaf,C5d\xc8\x15FB9\xe5u\xdfE\xc2\x83R}\x17\x96\x9eiX\x85\xe5\x84\x82l\x17B\xd3\xafC\xe5G\xb4?Z\x11\x18[\x85\xd1!L-Fp%1\xe1\x08$ v\xf4bL&\x1c\x03/\xc2e$H\xb9=\x04|\xab\xeb\xd3\x1e\xa13\x82.!\x0f\xf2\xc3$c%\x06\xd8\xd2\xc2\xed\x9d##Q\x98\xa8\xe1H9\xcc\xc8\xb7\xe8QQVP\xb8L\x94vZ\xd4\xb3\x9d\x88k\xfep-\x88G\x98\x97]\xfc\x08\x06!_$\xc6\xc5\xb3|\xedh'.5\xac\xfa\xd0jTt\xed=\xb4>\x13`U\x8b\xc9M$\xed<\xc2zQ\x81\xfa?\\\xb2W\xecHf\xecX{\xec\x04*v\xea>V\x1a\x0f\xfd?b\x99\xe3\x81\xec\x1c 3\xdf\"\x1b\x02-)\x81\xe4\x14 \x0c\x81b\xb4\x9a\xda\x1ahy^e\xb3&\x00\xad\xeb\xa8m\xc8\x96njA\xbe\x05:\x07\xbb\xca%\xdbz\xfb\xc6Y\xb3\xbfL\xa3}n\xfb<\xd1\x97\xdd\xdeQ\nY]E\x0d\xadC\xc7\x16\xf2\x97:K\x0f\xd7\xd66\x1a\xd6\x87w\xf76\xba\x0e\xaf\xbf\x1d\xa3\xbe\x95\xec\x9c\xa7\x0c,\xdf<\xde~M#\xd8w\xa0~\xe3Z\x82\xf7Wq)\xbeP,$\xeegw\xb4\x1c.L*x\xf5\x85\xa4:\x1d\xbenC\x18)\xaf\x83\x9f\xdb\xf8\xae\x1c\xfcV\xf0\xd6f\xc8z\xaf(\x9e\xc1\xb8h\xa2\"\xaa/4\x07\x97\x9f\x1b\x955o^\xf4\x98\xcc\xbd\x1f\x96\nA\x1eL\x96\x08z_}=r}\xa6\x94\x00\"_\x94V\xe0\xab\x9c\xcbW\x06\xd1\x7f\xe8`^U\xd5\x91e\xd4\x17\xcdN\xb1\x1d\xafV\x11\x90\xd0F\xb6\x9e\x811\x0d \xb2\xec,w's\xaaw3y\x92\x8arg`\x10\x1c\x0d\x07\xfa\xdbc^\xbc\xd2\xe3\xbc1\x1b\xa5|\xa4\xd0\xe3\xf0\xaa\x86J\xa9\xc3e[\xe3e\x8c!;\x06{\x91iy\xe2\x17\xab\x9fz\x1dn\xc7\x10\xafy\xeb\x8dQ!\x8b\xcf\xbe\x85jy\xfeh\xf0\x9b\xf0'\xe5\xae\xcfd\xe3\xb2\xf4f\x17%&;|pq\xe1\xc1\xbb\xc4\x91U\x95\xec[\xfc\x0e\xeb3h%\x9aO\xbe\xa6ElM\xf7\xb3\xf3\xc6\xebK\\\xa1\x8c2_'\xa8\x88q\x1dh\x10\xd5\x99F@{\x92\xcb\xb1\x89\xe3}7_K\x9b\x93^\xb6\x8d\x9f\x92\xd6\x7f\xf2a}\x06\x1c\xb8\x18\x88\x125\x0cO\xf8\xaeO\x0ec\x81@\xaf\xe4\x01\xffVq\x00\xe5\xf5+\xad-\x1a\x0eX\xdc\x7fd\x85 \xe7\x1e3\xdf\xaePE\x16\xfaa\x03\xb0\xbe(\x82W\x88\x9d\x901\x99\x97\x86\xae\x0f\x1a\xf2/\x94N\x9e$\x1a\xc3\x1b\xb8\x16\x8b\xde\x9e%\x85l10\x95#v\xa2\xf1\x84A\xf0\xd0\xe0/L\xb4n\x08\x1d\xb9X\x9f\x98\x1a\x01q\x89s;\x82\x02\x06F\x1e\xc1\xbdD6\xc9\xbe5#\x05\x0ef\x94^\xca\xe5\xe1,\xfc\xb5\x02\xb9rB\xf3o\xaf\x9dt'\x8f\xd1\xc1\xa4\xd2\xa0\x87\xb5d\xe0[8\x9d>\x03;I\xa1\xd0\xaa\xba4\xbd4\x8b5\x16c\x06;\x89\x9a^^j\xd8h\xa9\xb776\xbfNJ^\x99\xf5n\xc1\xa5\xfe\xfd&\xfc%\x7fKTQ\x04\xc1\xe8\xd7\xe29\x8d\x03\x04 \xaf\xc0\xb2ne\xaa\xa0M\xf6J\xed\xa0\xd1\x14\x8e\x94\x99\xb7\xd6\x04\xf0\x00\x1b\xc4\xd3V\xf9\xc3\x8f\x8e\xfb\xb2\xdbf\xdcP\x1c2\xc6\xd8\xce\xe8l\xcb\xce\xc1\x93\xe82[\x1b\x16B>\xbf\xfd\xb1\xd3\xa2g,'\x9a\x9f\x94\xca\xb373\x102\xf5\xa3\x99\xf6\xb7\x84\xe3\xb63.\xf7\x8f\x14\x8c\xa7\x89\xfd\x07A\x9d\x86\xbeG\xa61\xc5\xe5\x1f\xb3\xaa\xc08\xe2\xe8\xf8\xdaH\x1b#\xdbn\x96\x14\xd1\xc9))u\xa6\x17\x9d8:\xb5\x97c]\xe6\xad\x93\xf5\xc4\xcc\xdeC\xd8\x1e{\x10\xd2o\x94 \xf0\xce\x0bf\xeb\x8e\xe0\xf7n\x0e\xcc\xd9\xda[\x8eZ\xb6,\xfa5\xb5]\xc2\xcf\xc5[[\xbc\x95\xc8\xf4:\xb6\x15QIN\xc6\xb1\xc0pN\xe2\x1d\xc9\x08\xbcV\x91\x07 \xb9\xf1m\xe4}\x10l\xbe\x18\x7f\xd2\xc5vb\xe5 a\xc9\x1b\xe8d\x1d\x8b\x84\x88(z\xf0\xce\x18\xb6ta\x0f\xc9\\\xafrv\xcd\xadEW\x85\xb0wM\x14\xeb\xc5\xe6\x0f\xd5\x00\x9f\xc3\xba\xaf\x17\xa8l\xc1\x026\xb6\x97\xd6OU\xa5\x08\xe3\x1aPr\xef\xe6\xdf\x06\x16\x81\xeaMVP4\x14|n\x1f%\xc78\x87\x02-\x9f\xb8/\x8ck\xbe\x8a\xab\x91\x8e\xf9#\xd8\xdb\xad\xef\xaaT,F\xdeS<\xafT\xa5}\x1e\x1b\x1b\xee\xcb\xb7'\x8c\xc1\xd1\xc0\xec\x94\xc7;\xfb\x8a\xce\x96\xe3\xf1\x0eBE{\xfd\xd0\xb5\xa0\xfdZ\xf8\x98\x0dC\x9c\xd1\xdb\x89\x1e\x079E\xca\x15y;\x94\xb7\xa4\xd9rXL\x16k(f,U\xfb\xc0YXB/|\xfc\xac\xe9w\x9d\x9b}\x89\xf0go\xca\x8f\xeb\xb0\xc5\xd7un{[\xcd\x9cn\xcabmz\xd3\xbc<E\xe4\xdb\xaf\x7f\xac\x9f\n_\xaeoK\xa9\xfa\xe5!\xb2V!\xe0\xc2\xa6\x9b\x05\x0c_\xdeeR\x1f\xa5\xcbv\x90\xe4\x14\xfc\xf6u\xff\xddEF\xaf\xfc\xa5E \xfcr\xf3q\x82W\x00\x9d\xe9\x99?ff\x98\xd8\x8a@\xc2\xee\x03\xebs\x99\xae\x15\x8c\xd9\xe1\xae$\xa5\xc4\xe0\x08_<\xc7\x9b\n\xc6\xc7$l\x87\xe1\x1cJ\x10\x1d\xd0\x8f\xc7\x12\x14\xfa]\x12\xdeK\x16)\x02&2\x82\xef\xdbn\xcb\x1abJ5[\xf7\xfbV\xceoA\xbf,\xf5\x01\x17\xf5!;?\x8do\xa6\x8c\xfbS\xd2\xfe\xcb\xab\x03\x87\xe5\xd2\x92|\xdc*l!\xc5n\xa5\xed}\x80f\x03:\xfdQ\xe1\xb3@A\xd1{\xacG\xee\xd0})q2_m\xd9\x90\xb5\xf3X+E\xda\xf6\x91\xd2>\x9f>\x99\xf8\xaa\x14S\xdf\xf7R\x837\xeeN\nT\xdfJ\xa8\xf1#z_\x9d<\xd3\x0ee3\xeb\xc0\x1b\x1d\x15zQ/\x9f3\"\xcf\xd8\x86?\x8aI\x13d\x9c\x8a\x9e\x0fC\x1b\x92\xa5thL\x89\xbe\x81\xe8L\xf4\x13k\xa7\xcf\xa5\x12(\xd1\xc2\xb2\xa02\xed\xcd.j\x11\xa9%\xa3{\xda\x02\xe9\x00\xc0\x08 \xe1<\xbc\xf5u7oa \xac\x91\"vz\xa1\xb7\x8a`\x97\xb4\x8e\xfe\xac'\xc7\xcdXlW\xe3`WBR\x97\xd2\xca,\xb3O\x98hB7\xa4 \x02X\x90E\xb8k\xe6\xf5@\xd9\x1f\xb6\xe5Wd\xd8s\xbe\x1a\xf3\xd4\xfa7\x19R\xabylb\xc3B\x1b\xa8\x80\x90 \xd7r^\xado\xb1\x16\x07J\x9a\xcd)\xa3\x1f\x82\xf5\xe7\xf6\xc9\x8f\x1f`\x12\xc5\xc92\x14\x94\x08\x85\xda\xc14\\\xaf\xd4,\xe3\x0f\x87\xc2\xc6Ci\x11\x07\x04z}9L2\xdd\x8c\xf2fv\xe0\xecG\xae\xb0\x83\xc8\xbd\xeb\xbcdn\xa2\",x\xe6\xdb\x1c\xe4\xe0\xef3\x9eb\"\x7fk\xf0S\xf1\xaf\xc5\x04\xc4\xe5\x9a4I\xc1pt\xe5\xbc\xefn**U\x87\x1c!\xc2\xea%\xba-\x08ne\x96(\x9b1\x15\x1b\x12\x8e\xb47\xdd$\xa2 \xa7\x85\xc6\xa1WU\xbcT\x96w\xcd\xf5\x8bJ\x99_H\x8d\xedw\xb4\xc7\x15\x96J\xf25\x8a\x00\x80IyU\x172{\xe9\x8e\x0f`\x06\x19at\x15\xb6\xaf\xcc'\x9b\xd5T\xa9\x99\xb2\x80\xf1\xdd\xb6\x1a\xe2\xcf\xe0\x98s\x9d\xea\x1cJ3\x95\xbf1\x83\xa2\xa1\x94\xc1\xf9.\xd3\xd9\x05-f\xaf\x8b\xdeje\xf2\xdf5$\xe6U+PT-t\xffu\xc7\x02\xbc\x80\xdc\xcf?1\x94\xad\x16\xdd\x8c\xf6\x17\x14>\x9cp\xcd\x82a-qO\x1c\xcc\x84\xc4\xd7\x9e\x12y\x12\xd2\x01A.o\xc5\x83\xbb\x9b5k\xccc\xcf\xd0^\xd4W-1\x04\xa7\x07\x12\x9d:h}\xd2Ko!\x04\x06\x9fpb\xc4R\x1a^\xc5\xb7\xa1\x8d@\x1e\xfb0\xe4/\x82\xee\x1aI\xd1'5\x86\\\x89\xf7\x8a\x8fW\xb4Z#\x15 Zs\xad\xeb\x7f\xef~t\x01LA\x0c\x13\x8aF#.4\x1d'\xee\x8e\xbeK\xab\x1b\xfb\xf1\\u\n5\x95I\xaf\x9c?\x9e8\x8cv&\xdd\x13Q\xfc9\xdd$d\x8f\x04\xe8\xb7\x18=@p\xa3}\x86\xf6>\x1c\x15.\x17j\x9e\x8fX\xf3I\xfd\xc4\x01d\xf6uU\xdbd.\x82q/6\xd0\x0f\xc9\xd0Mh\x96\x13\x06\x194\xbf\x07\xbfq2\xa1\x19\xf6\xe4'(\xb5SV\xb4\x99PVo\xef\xe4=\x90\x8f\xb3W\x9a}nK\x93 b\x8b\xf7\xfc\xfa\x97)\xfa\xb3\xac\x11f\x0e\x9du\x92,\xcd\xfb\xcc\x0e0\x93x\xd0\xcfW\xa0y2\xd7\x0e\x850\xd1\xdc\xb4\xe3\x12S\xe7K\xc1AR\x12hb\xc8\xb7\xad	\xe7\xda\xb0\x1aw@\x89j\x0b\xfe\xaai\xf9\x13\x96\x97K\xbe\x81\xbcb\xf8O\xd6Q\xb6\xd5\xab\xe2[\xbb\xf4z\xe7\xef\x0c\xb3\xfb\xf1\xe0\xe7F\xea\x92%B\x0d1\xca7\xfe\x8f\x9c\xcf\xf7q\xdb\xe6g\x19\xd0&\x19.D\x8e\xa9\x85W\xa63\xc4\x14\xa8\xf9\xd8\x8fJ|\x84\x85,4\x9a\x13\x0c\x98\x820\x8a\xb2'\x9a\xf6\x8f\xeb\x06<\x94>M\xe8\x8a\x85\x863\x14QqQ7E\xaf\xf4\x94\x1a\x16c\xddg\x9d\xbc\xe0\x9e]\x19\xe0\xf0\xd0\xb0x2\x83\xcd\xe6\xbb<\xe9q\xe7/\x11\xb6\xbc\x91e\xb4\xf2Z\xba\x91\xab\xb0\xdaA\xa6\xe8\xa7\x99F_3;\xac\xfak\xf7\xe2\xed\xf2|\xdc\x9d\xa0\x12]\x90\xeb\x0f\x9f\x88\xb3\xdc\x94\xc4\xc8P\x8d\xcf\xf1^\x84\x0b\xf1\x02\x9a?}.>E\xaf\xf6\xecq\xdc*\xcey9\x10zeV,|\x817\x15U*\xe1r\xb9\xf2\x99\x93\xeb\x7f.\xc1\x85;\xe6\xacmk}\x11\x08P\xd6\xcae\x8c\xbe[[\x8c\xbf\x07	\xc7\x07u1\xc0\x0f&\x9ac0\xdb\xba\xc3\x92s~\x1f2q\xde\xb7G\x84\x1a3\xe7X\xb5\x81\x0e\xad\xeb\xb0zP	\xc2T\x870\xcb'<\xfd4\xe9\xef\x7f\xfd>\x14\x7f'\xd4\xb3\x89\xfb\x18\xf5\xc1h\xb4\xd7\xae\xf3(\x04\xdb\xe1\x1a\xdd\x0c~\xa0\x96\xe1\xc3\xeb\x16\x00\xa95\xfas6*{3\xa3\xccx)\x04\xc0\xc1\xb4\xf7\xc2\xa8\xeeP\xd3\xc69\x9e\xf2\x17\x06\x16+\xbe\xc9\x1eu\xe0BP\xe4;\x91\x9d\xedx\xf7T)\xd7\xaf]\x16\x83\xeb\x97S\xe70\xf3\xca\xf3w@\xf2\x8fbo\x05 s\xfd8\xc4\x92zu\xb5\xf6~.'\xff\xd2\xf9\xcd\xc9\x1a\xa5\xfa\x0f~\xab`p\xfdt\xd7\xbe\xe5G:T<\xec\xfc\xb7\x03+\x90\x9b\xef\xdazn\xc8h\xde\xd7\xbf\xad\xfc\xee\xf7N\xaf\xffL\xa2\xa5\xff\xe9x!\x01\xea\xf5\xc7\xbb\xf4\xb6\x04\xa2\xefb\xc1\x84\xb9\x85\xeb\xd1s\x83r\xb6\xc5\xa9}y\xeb\xbb\xd4\x9c\x9d\xe81\xef\xfe^q\x06,\x8f\x85{\xed\xb5\xec\xfc\xbe\x1b\xee\xb9j)\x10\x83\xbc*\x0c:)\x80\x9d\x13\x96\xd3\xf3\xa4v\xd0\xbba\x7fl&0\n\x00{\xf9<\x01\xec\xddH1S\x0e\x03\x1a\"|\xe9\x04\x02o*@\xa9\xc3\x9a\xbf\x05\xe8\x1e'n\x02\x05\xc2MA\x82\xf0rK\xe3\xee\xfe\x00\xec{\xbf{K\x9dg\x98A/\x99\xfc,\x97l\xfc\xdd\x83X\x10\xac\x8c\x92\x01\xd2m~\x1f\x13\xe1S\x8c\xfe\xe9\xfb\xf0[9\x97\x02-\xd5\xea\x0c\x08\xad\x877+\"\xf8\xb4\x02\x83\x87)\x80c.@\x13C\x99\xfc\xc2\x9b\x98\x89\x02c\x98X\x0c3\xf4\xf5\x08\x06\x16f\xc8\xed6\xbc\xde3\xd4\"B\xddc&T\xe5\xdeQW2\xd4\x8a\xcb[]\x9b\x9a\x163\xc8\xc8\xea\x8d<\xd3\x87W\xe5\x81\xdex\x81\xdc\xda\xe17r Kmj\x81\x8a\xb0w\xf4\xa1\xfc\xda\xe1\xe1\x9e\xae\x18HoO\xa6\xd0\xdc\xc4\x08_2\xc0_0<\xd0J\x07%\xe0\x8f%\x1e\x19\xe0\x15\xb9\x07(\xc7ZE\xc6\xb7\x87%\xca\xab'\x0bF\xf9\xabE\xa5GFely\xfbi\xabg\x8cD5\xf79k\xf2E\x143\x85)l;\xc5yE;\xb4`\xc978U3a)\x91\xc44k\xc7\xb4z\xc5\xb4g\xfe\xeb\xc72\xb4\xe7>&\xe2?b\x8d5\x81\xcc\xac,\xe9\x9c\xf4|\xa3L\x08\x14\xad\xb4\x14\n\xc9\x03\x10@A\x8d.PR\xd6XV\x91\xc9\xfa	Q\xdb\xa9\x9a]\xdf\xd4\xdb\\U\x0f\xad\xee\x1a\x92\xcd.\x86\x8e\x8f#\xa7Z&\xbe\x0cwe\x15O5O@VV\x1a\x9a\x07\x06\xe7k\x8b\xd6\x97\x1a'\x96W\xd7+\xc6;\xb6\xd32\xf7\xf6\x91\x87\xdf\x8e\xcb\xc7\xe6\xbe'\xf5\\\xfdA\xbb\xa9\xf8Vc[q\x8e\xff&\xf0\x0f\x00\xdd\x1bb\xff\xd8'\x83\x81\xbf\xa8X\xf1t\x8b_m\xf5\xb9\x98\x88[\xfdru\xd8\xd8\x91\x01n\xa5S)\x7f\x1c%\xd6\xe55Yl\xb1\xa4\x1b\xe9\xc7l\xe9,\x9e\xc4Ojl\x8f_\xfct\xe6J\xbf<&\xbd\xb2\xfd\xf9P\xeeP!\x9f\xb2\xc6\x10/\x92\xad\x86\x18K\xfc\xa1\x1f,\xad\xd0b\xc3\xa9,\xe5#\xb4p\xad\\1\xfb\xc9\xa8\xd2\xc2\x84\xab\xa8z\x19\x0bw\x88G\x9b\xd2\xed4'\x87\x8b<<\xcf\x17\x9fp\xb9\xc9k\xbcv=g\xf1\x8c\xaa\xd8\x0d\x8d\x16\xe2\x94\xe1h\xd8\xed\xf7\xebg\x19\x9a\xe7'\xa3\xbeP\xc2s\xbe\xf1;\x96\xc5/N=\xa2\x1b\xfaZW\x1bf\xb2\xcb\xee\xfd\xd5P\x0e\x83\xf1\"\x98b-\xc9\xb4\xed\x17\x8d\xd2\x86f\x19\x06\xec%%e<\x92\xa3\x8dn@\xb2e\xbe\xc0\x91\x81\x8e\xd1\xc7\xe3\x1b}k6B!\x0f\xff\x83\x03h\xa6D1a\xe3W\xdb\x82R\xcd>\xbe\x80\xf9oA\x02\x80\xa0S\xef\xe3\xad_\xe7\xc3\xf5\x82\xfd\xb3\xa9\xf8\x19\xfd\xca\xbd\x0do|\xae)l\xd2r\xc8\xe1\xf5)i=\xdc{}\xf1E\xb3\xe7\x13\xc1KQ\xd0\x86\xdfXQ0\xb5\x1b\xa5]\xfdf\xc8\xd7\x07R|f\x10\xb6\xb6\x8a'\x04\x1b$\x0e\xec\x80\xf0\x1e\x0dB\x9eJ2\xd1\x04\xb6\x82.b0\x98\xa6\xde\x17\x89b\x1ako\x85c\xd2\x0c\xc3\"i\x17\x99\x90.\x10D\xe4N<Qrr\x89\xed_\xbc\xcc\"\x02J\x17\xa6\x12\x00\x16n\xb5\x1by\x81`\x1a\x8f\x07\xfdE%\xbfA\x7f\x12\x85\x0b\x02\xa1\xe3\xed\xe2\x03[\xa4&!\x8a+dja*\xba\xa6E\x02_c\x87d\x92t\xe6\xd0\x01\xf4\xf9 r\x8b8\xc2|/\xec0\xb3V\xad\x18A\xec\x1b\x9d\xa0\xecF\xf3\xe5\x03O\x1c\x05\xc6\xc3\\\xbc\xbb\xa5\x84,\xb4\x8b\xfb$\xfek\xccc,\xc0\x9fE5\xa8 C\x03\x04\xa4\x07;d\x11\xe7\x1eRE\x1f\xb9u\x07\x9d\xe8	y>e\x8aa)+m\x8f\x8d\x895z\xdfW\x16`\x82\xfd\x91\xc8\x0e\xfb\x93Z\xc6\xcf(\x8a\xf2\x88\xf9\xa0\x15j_4.\x9b\xa7\xa5qPT\xa9\x90\xefI\xf1hu\xda\n\xb6mm\x9e\xf0 \xab[\x18\xc5\xa7\xd2\xb8? E\xaa\x80\x85}\xb7iN\xd0\x17)\xdc\xaa	\x85\xecG\x06\xa0Y\xf6\x17c\xa1\x8c\x11\xcd\xd1\xe7\x90\x80N\xa3\xd6\x83\x87\xb1\x02\xef\xc2\x8d#\xfd\xfa\xdf\xcc\x9f>\x08\x0c\xfdd\xfa\xde\x91YZ\xf5\x9d;\xab\xf9\xb46;\xcbI\xc1\xb1E\xe7l\xf1<\xe0\x156\xf0)w\xff\x96\xb4\xafwv\xbf<'\new\x1d\xc3\xd3\xa7\xaf1[\xc5,JY\xd3\x9c\xa3\xc2\xd6\x94Dq\xe2q\x19I*\x80\xa2l\x07\xa4/.\xfd7W\x94\xae\x1a\x0e\x80\xf4(\x88\xbc\x96\xe9\x98P}4\xed\xe98\xf7\xb4\x88+\xb7Az\x84}\xb1\xc0&\x93\xcb4>\xcc\x0842\x13\xc5V\xcff!;\x8f3'&w\xc1\xe7\x81\xd6\xf4Mk\x00[\x9a\xfd\xf6k\xdb\xfd]M\xa4\xa6\xe9\xaf\x03\x08\xe9},\x00~\xe3[u\xe9 \xb5\x19\xf2\x81\x1f\x80M\xb6A\xac\xab\xec\xc7\x05\x044\x1eZ?\xbbN\xe7a\xb5wF\xa1r\xbc\x1dM\x08@\x9b\x81\x18\x86\x08TX\xdb\xa4\xdbn\xc1\x8f\xb3\xeb\x95\xb6\xad\xa6\\O\x17\xad\x0d\xeb\x16\x83\xef\xe1\x8f\xbc=\xeb<\x1b|`R ?\xaa\xbe\xf4\x8d\xc2\xb3%D\xe0wW\x16\xdf\x8dh\x9do\x8d\x96\xa3\xcc\xa0\xebe\x15\x9b\x00\x1d\xdf\x9e_\x9b\x9f1\xa6N\xa7hy\x07\xd6\x8f\xbfT\xac\x0b\xf8\xd5m\x12P_O\xdaV\x9a\xdf\xdc?\x8d\xdf\x98|\x816%\xb0i\xf0\xe8\x87\xb3\xdd\xf1 K\xc0m\xf1\x8b\xfd\xcd\x1f\xeb\xae\xb4\xab\xf8\x90\x9e\x03\x89g>\xe2)S\x07S\xae5G7\xdf\x1c\xafs\n\x96^\xb4\xb5L1\x9b\x91e!7\x1e\x8f\x0e\xbb\xbct\x8eI\x08\x14\x0b\xae\x9f2\xb5;\xb4$H/\x9f<\xbb\xe3\x80\x97m\xb8\xb3\x13\x1f\x07a\x88\x1b\x05E'X':\x82\x12a\xe0\xec\xd6\xcfx\xd1\x89\xa1O\xd7R\xb7Jws\xecD\xf1\x82uN\xd67G\x0e)\x9ft\xa3\x93D]S\xa3\xebavR\x05\\\xbc\x16{\x05\x03\xcbA\xa5\x03\xba+UY\xf4\x8d\x9f\xa6\xb5\x0f\xf8%\x9e\x07#\xc4\xb3\xa1\xderp\xb0>\x0c\xe8\xda}i\x8d\xad-\x84n\xf2\x18\x1cz\x16\"\x8e\x8f\xe3>\xd8\xab\x86\xef\xbb\x18\xbd\xf4\xd7\x06!\xfbD\x16\x06\xa0y\xd1\xc2\xea2\xd1\x85\x15\xce\xe0\x89\xb9\xcf*o\xda\xe5\xbak\x92\x00\x02X	q\xd2|&\x85\x8f}\x1c\xa6x)\xc3\xbe\xa0\xdcQ\x94Sh\xd2M\xfa$\x93\xce\xeb\xa3\x9bKG\xd0H\xe9\xcb\xd7\xea\x19\xa75\x0d\xda\x14t\xe6\xcf\x94\x1f\x06\xd4\xfbGMR\xab\xcd\xc8\xf8\xc8\xe0\x04\xa9Fq\x1c?\x9f\x00\xe2\x0f\x02\xf6{\xc9I\xda\"[\xcc\x1fFs\x8cN\x1a<.\xd1\xcc\x89I\x97\xe5\xbf\x9a%f\x8d\x84\x1e\xa4\xd1\x08)\x8b\xd4\xcee\xa3\xfc\xe8\x053\xee\xa9\x003\x00\x8dl\x04\x89\xdf\x97\x02Ggs\xcd\xfbF\xc4\xc8H3:~\x93t\x91=\x85eq\xe6g\xaa\xe8\xf7\x9d\x85\x90\x0c\x8f\x08\xfa\xfd\x81\x0fL\x07\x13iU\xcf\x81HV(\xb6\x11E\x15\xe8\xe4\xe6P	\xf0Q\xbb\x1d\xbc\xcb3\xcc9b\x9b\xf4\xca\xc0\x02`E \xed\xa3\xcd\x1e\x12x\xc4\no\xf4\xa3\xbc\xe9\xf3\xd3\x9b\xca\x97>\x97\xd7\xa9\xcf\x83\xf7r\xfcJ\xa2\xfeNT$X\x13V\x9e\xb3\x99\x93w\xb7\xf3\xa2]\xe9S\xc5\x12\xc0a\xe33\xafXyi\x19U\xf2H\x1b\x02]n*nm\xd7V\x0ce\xbf\xeej@	\xb9\xd7UEE\xd3^z\xbd\x0c\xd1\xe5\x0f\xdbS?\xfc2]\xe5\xf0\n\xa1e\xbd$\xea\x1f\x81\x85\x15\xcaH\xc8nn#\x93f\x1a\xf5$\x0cP\xcd\x1e9\x1a\xfc\xda\xa9\xd9jrS\xc1\xf9E\x1a,=\x134\x14]h\xec\xbc\x10j@\xf5s\xa6\x9b\xc5\x12\x0e\xc5\x12\xc7\xe4\xf7%\xee\xb3i.\x08\x93)Sy&\xbe\x82\xee\x06GC\xd8\xb3\xd9|\xc0\x11\xa8\xed\xc1\x01n\x9b\xd9\x089X\xf4\xc4\x88\xac\x9b\xda\xffTY\x9eb\xae\xf4\xb7\xde6W\x9e\xc1\xcd\xe1\xe3\xa0\xed\xd6;\xe3\xcc3\xc9\xdadJV\x1bg\xfe\xe6\xd3\xd5\x9ao\x01\xabA\xc3,\xad\xf9(e6SJ\xbd\xf5]\xcd\x93\x96\x96\xb8%4IyMy3\x1a\xf4k\xc9GV\x95\xb2\x8eE\xf9\x0dbB\x9aHG\xbf\x86\x18,\x02\x94\x16\xe1\xea\xd9\xf7y}[Me\xd1\xad\xde\x1b\x13\x07Z\x9fK\xad#\n\xb2\xe4\xf3\xb4\xba\xe0\x1a\xdbM\xe8\xebv\xd2+\xf7\xe5qC\xd6\x9cG\xdd\xcc\xcf`\xfe\x11\xaa'\xcf\xd77d\x89}<'\xb1\x01\x12\\\x80\x00\xbe5\xc8\xc4\xef\x1c-\xe1\xb4\xf9\x93\x1f\xd5\xc5U\x02\x06\xd8\x94Lq_\x8f'}\x16e\xf3\x95\xa141K$\xfb\xc2\xc6\xd0\x87\x11.b\xf6\x924k\x84\xf9{\xd8@\xcf\xea\xa6\xc7T\xe6\x10\xc9S'/P\x83q\xe0\xc9\xfd\x18\x8c\x84\xcb\x8f\xf6\xb9\x19\x1e\x9e\xf7\x88!Cc\xd2\x1b\xa4Id_&\x82|1\x91\xa1\xf0\xf7G\xcaMm\x93\xc2/\x8d\xb9\xdcY\x16|\xae\x0f\xe3\xf2\xb2.\x99\x12\x89\xdb\xbc!\x19\xf2\xd9\xf2@\x0e5l\xffU\x9f\xf7\x94KO\x15\x96R\xe2\x84a(\xf9\xc4G1n,\xe4\xcf\x96\xf1\xc6\xf1\x96\xad\x851v.5]	\xa9\xe5I\x81t\xca\xe2\xc7\x87\x07CB\xb2\xf9\xcc\xd2\x89\xd0\xd2\x14\xa5\xe3\x16>\x9f\xde\xcd\xbc\xb4j\xcb\xb4(\xf4\xc4\xbb\xc5\xfc\xbfn>\x15l\x91\x91\xe4$\xe3\xe7\xfb\xf4 \x15\x83\x8d&\xec\x99\x85\xec\xc8\xe3\x15\x14O\xd8\xae0r\xb1\xcc\x1d\xa8\x019\xd9\xea\x1ff\x0f\xd9\xd54\xd1\x81s\x16\xd1z\x96x\xa6\x15y\x8dMr\xbe\xed\xf8\xb3[G\xcd\xee\x07\x14\xc4\xd9b\xc8SB\xbe\xd6zT,\xe3\x9eX{][\xbd\x9dgi\xcc\xe3\xfa\xfem\x88\xc4}$\x1e\xf7#Kt\x94X\xc7\xeb\xae\xef\xe5-\x9fk&\x0f$\x97\xe8\x9c\xc7\xfe\xeaJ\x9c\x0d;Ba\xc4\x957\x0b\xeb\x8d\xf3\xc1\xeaA\xbc\xbf\xb03j\xe1]r\xdf\x1d\x8a9r\xb7\xf9\xa6\xee\x06h\xf3\x1d\x87\xd7\xf1\xa5\xfe\x0c}\xff\xb2/D\xd2\xf4|a\x97\xba\x17\xbd\xac}l/\xa5\xe4w\x7f\x97\x83\xce\xa0\xefK\xeeL^\xe4\xe62\xbd\x92\xa1CO\xb6\xd6\x1ae\xab\xd8\xb0\xfb\x00\xf3]bUhE\xc1\x00\x07\xc8?>\xfe\xf1\xa6\xf4\x90\x9e]p\xfa\x81\x04\x10\xbfj\x94Z=\xbc\xfd\xdaR\xeb\xd5\x1d\xa1\xe4\xdc_\xeb\xd4v\xaa\x11\xd5}[\x8a\xb1\x92O\xdf\xe2\x8d\"n*o\xfc\xf5g\xcb\xaa\x97\xbf\xc8Z`k\xdc(\x18\x1cI\x0dR@\xa2\xfd\x901\x0dF\xdd\xd2\x10,\xa5UD\x95\xad\x9ao\x15\xdew\x1dL\x1b\x9e5\xa3\xe5\x9dD\x03\xc0\x0e~\xe0\xfd,\xeb_\x16\n\xdd\xe6\x13\xf2\xb8\xc5\xa7-\x10@Q\xcb5\xc5\xbc\xe4a\xa5Io#\xdf\xd1u\x86\xf0\xe5\xcf\xd9_b\x97\x04N\x8e\x0c;\x1c?\x16{i\xce\xfd0\xbeh\xd8M\xfbM\xe1\x18\xeeG\x1e\xfd9W\xa6\xf6\x8b%\xe8\xbd'L\xa9A\xda\xab@\x81W[\xbf\xb6\x9e\xf5\xde\x0fl\xfe\xb6\xaa\xf0;\x0bO\xfc{\x13\xf4\xd1\xff\xfd@\xf7\x18h\xf1ac9>\xf6r\xf5\xf1\xef=\xa3\xb7(\x15\xdc~\xe6\x87\xcf\x9f\x1e\x01\xb0\x93\xa5\xbf\xcb\xa1\xce	\xc3\xbb|\x89\xc0\xdb\x88@\\\x14\xfc\xfe\xc7\xc7'\xf5\xbe\xfc\xdb\xbe\x8fYx\xf0\xe4}\xc3mc\xa1l\xf0;\xccHo\xf3-\xcd\x92\xf0\xb7\x04[p\xdcL{l2h\xb7\x9cXv\xabu\xac\x16\\\xad\xcc\xe1\x02\xfd\xdd\x0b\xcf\xe0]\xa2`~&\xcb\xbf\x96z+\x0c&\x9e\xa92o\xca\x98\xcfC\xdfc\xb6\x87\xe8\x00\xc1\x02^\xa1Z\xb2\xa1\xdd\x1e>\xce\xe5\xa1#\x17*\xd74\xef\xaeB\xa1\xef\xb4\xc24\xbd\xc2\x10\x96\x86\xb4N`\xd8Q\xe8\x88\xea;rqN\xaa\x1f\xea.\x00@\xe1\x04\xd6\xbf\x0f\xa8\x03\xc2\x8d\x11\xaa\xaf\xe4\xc3\xa1\x92 \n\xa7p}A_(8\xdc(\x93\x1e\x07\x12\xe1l\x14\xee*\x1fQ\xa1\x15\x8a\x01\x07y\xfc\x08\xf7\x1bq\x0cD\x80\xa2*\xfcK\xc3@\x18xz\x11\xda\xe1.\xf7\x11Dx\x910K[\x90\xaan8Y\xd4o~\x1fW\xed\xa8X/ \xcb\xea].S\x14\xd1Q\xd8epTiKT\x99\xa0^\x8a\xbc\x8fiE4\x062\xbaF\x9e.\n\x11\x89\x18\xc1\xba\xdd\x8bl\x93\x8d\x91i\x19\xa1o\xbb\x8f\xea\xf0\xc2\xe9br\xec\xb9\x8f\xc9\xe5\x8a\xea\x93w\xf2s\xf6(\x8d\x8c\xb5\x9e\xc1\x19C\x80\xd0\xb5\xbcS\x9dcg\xc88\x1d\x18)Y3\xe36\xee\xe3\xb6\xc8\xe2w\x04\xe3\xf7\xb4\xe3\x0f\xbc\xe2\x8f2\xe3\x15\xff\xffw\xd6\xf6@J\xba\x17\x90\x96\x91c\x90\x95_\x90\xfd\x16\x80d\xe5\x96k\xe4C\xa0h\xd55h\xd0|\xf9\x12hiQcCEn\xd6'hwOUms\xbb4\xd0\xd3\xd2*\xd9\xd5\xdd\xd2\x912\xd6;\xd3S[7,\xd5\xd4;\x9c\x8f\xac\xe9\x1f\x99\x8c^\x9a\x9d\xaf\xeb\xde]O\xdb\x9e]=(\xdd\x8c]\x82\\^\xed\x1f\x9c\xf6-\x7f\x9e\xd9\xdd=\xfb\x1e\xd9t\x05\xc6nns\xa4\xa8\x81\x9f<\x1d\xd2c\x9c\xd8,2\xa9DWP\xd7\xa8\xa7\xa9\nE\x91\xb8\x9c\x1e&\xaa\"\x0c\x081tq\xc3\xd5\xd6E4/?\xbb\xdf\x08:\x93\xf7'\x91*v\xa6\"T\xf7\x15$Jt)\xe6\x05],F\x1e\x0b\x98\xa3\xd2:\xb1\x98,Gb!F\x86\xe8,x\xea\x95J\xb8d(:\x9f\xe1\xa3wV\x97\xa9\x1b)\xf7Q}\xa1u\x1f\xcbd\xe3\x84\xd3X\xa8\xaa\xc4K\xe8\x17N\xb0S\xb5\xea\x9d\xfa\xe2\xe9m5\x9f\xe9\x96?\xa4\xe3?\xc7\xda\xb2_\xeb5>L\xaf9=\xe0\xd8\x08\xfb\x89\xba\xcb\x1b'\xabU\xaf(\xc0\x9c\xe6\xf6#k0\xac\xa9'\xe3\xfd\xfb\no\x95k\xd4\x96I+\xff\xd0\xe5\xbc\xfbKvZC	Y\n\xb9\x15_\xb1\\\x8a\xa6\xbc\xea\xcb\xca\xc9/Fs\xb6\xa27-Yj\x85f8\x87\xc2\xfc)\xc7\xc1\xb8\x9cO\xef&!:\x83\xdb\xfd-\x0d5\xed5\x02\xae\xa8\xe2\x97!\xcf\xf3|\xb3#\x8eG2>>k\xfaC\x18\n9\x9a\xe7\x84_<\xb7\xf2\xd4\xda\xec\x8b\xa0~\xc7<\xef\x0d\xba\xbf>\x1d\xee\xed\x11]r~\x81~D\xa5 r\xcc\xf0\xc2\x7f\x12\xe4\xb4\x19\x80\xcb\x83*0.\xf1\"\xdd\n\xad\x1e\x94\xc3\xe1\xab\x8e\x1b\xc4\x0f61k|\x14\x0f\x81\x85\xb7#=\x98\x97	?\x86\x11=M\xdc\x96\x80\xba\x08B\x01j\xec\xfa>\xe8\xcb\xc5\xf6\xcdH!r\x8cM1\x92Z\xfa\xcdp\x0e\xfe\x88\xcd\xd8W+Vf\x01\x82\x86\xbc\xef\xfd\xc8\x831\x14\x13\x0d\x01\x18m\x92\x9cf\xd6&G\x1d\x93\xfb6\x00\xae]T\xc8x\"Q\x19\x96*3e\xb5\x9f\xb5N\xb5\xa4\x10\xce\xacB\xf8\xf2\xed\xbb\xe8\xa8\xb0\xd4\x9fY\xde\xe5i\x80S\x89\xb1\x84\xb7\xcbC^\xfc\xda\x8d\x0b\xccjQB\xe20\xc0c\xc3z/2\x88\xe6\xd0T\x0fj\xdcq\xc0\x13\xb2\x08\x11\x07\xd2t]M\x03dXl\xe8|\x7f\xa0\xe6\xe9X\xfd]\xaeG\xf9\x1f\xcc\xb2\xe5\xcd\x10\xe8pk\xa2\x174\xe4\xb4\x15\xf9\xc1\xed/\xd0\xc1\\6\x89\xf6	\xc9\x9d\x93BC\xa0\x0f\xb8e\xe5@!\xb2\x81\xb8\xfe\xe7`Y-\x81S\xa1\xffI\xcd\x92Q\xb1\xe7\x8bl\xeaZ0\xfc\xe4\xa8\xa4\xef=6\x1cBR\xfa^\xa5\xed\x13\x85	[\xe30K\xe2\xe37\xcb\xc6\xf6\x93\xefM\x87\x95\x11\xec\x91__D\xed\x96w\xa4\xdbL\xf9\xd6\xc1S\xa3 \xf8\xd2x\xb5\xfc\xc1\xb5\x80jG\x98\xd7{\x89N\x1c\xda*6n\xf4\xf6\xd3\x93\xc2\x99x/\xba\xb4\xfbI\xdf\xb3\xb4;\xfb\x95\x8f^N`\xa7>\x08\x80\xc1\xc6e	\xa1\xc4\xd5\xe7\xc5\x16\xc8\xad\x1d\x18\xe0bmh\n\xb7\xd8\xa9l\xf6P\xc8qD\xa7\xfa\x98\x93\xdfS\x9bH\xc0\xc4\xc6\x90`w\x85\xdcYy\x7f\xc4\\\xd0\xfe\xbb\xb13_\x9a\xedr\xb0~\xb1\xb30\x0b{\xe7\x07\xfbt\xc3\xd5\x8fkC\xfc\xbf`\xf8\xa8\x0c\x06nt\x9f8\xc1\xc2\xcd\xfc\xf0\x98\xc8m\x952\xca\xafiH7P\xd0\x04\x89f\xfb\x9c\xd3w\x8e	c\x12e\x96Q\xf3m.\x9de\xad\xf5\xab\xe3\x87I\xf4X\xcd\xe9\xb1\x82\x11R\xbb8`)\xd6\xf2\xb7\xaba\xfd\x1e\xc4\x0bI\xfbQ\x9c_X\x81\xf6\xfe\xed\xce\x07B\x15%\x9f\x9fB/h\xd6\x97\xfb1\x83fS|\x83\x0d\xd7\x8f.\xed\x1f'\xea\xfba\x85\xbf\xf6\xf2|_t\xf5\xb90\x84\x08\x1eO\x8a\xf9\xf94\xdf5\xd6\xc2|s\x87\x0d\x1b\x80\x17\xc5\x11\xbe\x07\x87\xdf\xe2\x91# \xb7\xda\xd7\xfd\xe9\x04\x05\xedMC\x94\xd5\x86ii\xb4\x95\xb0\xadN\xdf\xbf\xa3\xd3\xd0\x02K\xdfx=9\xa3?\x10|\xdb\x04\xe0\x87E\xc7\xd9\x9f\x89D\x9d\x12\x9f8\x92\xd5\x04F\x1f\xc67c\xcc\xf2>\xa3\x82\xd4lb/\xb9p\xb4\xcf}\xd0\x99\x90\x100\xac\xf7\x17d\x9a\xe2\x17\xed\x0b\x10]!\xd7\xf2\xf2'\x89o\x1d\xb3(\x1f\x01\x1et\xd8\x13]j\xacEn\x8a{$&\xba\x11@\xb8F\xf5\x17Q\xdc\x8b;\x15\x0bP\xde\xc00)_\xd1,\x80\xe2\x03\x96s\xd6\xfe\xd0\xb8\x8a\x15\xed\x85\x10]\xb9n\xa1}\xf4\x02\x9dN\xaa\xda\xf3\xe7.?q\x1e\xe9\x83d=\x93~\xa9\xb4\x8e\xaa=\xfc\xc4\xe0\xc0i\xb1\x01\x9b%\xa1F\xbc&\x16\xba\x0c\x1e\x8d\xdcy\xb2\x1e6\xc0\xafY\xfdF;u5\x8dE\xb0\x8f\xc0\xe1\xf1\x9d\xf9`\xbbL\xfc\x08\xa2\xcb+\x17?\x0e 3$ZEU\xf3\xef\xe0\x11i\x9b%\x0c\xc96w\xfd\xf4\x9e\x0d\x1e\x9f}\n\xdc\xcb\x0f1J\xaa\xc5\xd9\xbd*eX\xcf\x94\xbf\x9b8q\x88KE\xd2\xb2\xb7\x0b\xe2\x1a\x19\xc5\x00\x89\xe2`\x02\xae\xb7\ncxq\xfd\x03f\x0c\x02\xa3o1$\xb6\xd2\xf3\xf7\x9e\xf9V\x93\x02j\xba	\x96\n#\xe8P}w\x05B(\x0f\xe8yu\xe1w\xed\xfb\xd7\xda\x14J\x89!\xa5eM\xeaZ\xaf\xf5_pi\x15\xae\x19\x00\n\xaa\xc1\x18*\xe6n &\x8bv0\\I\xd3\xe1\x89>@\x99\xc9[\x03R\xa6\x9c#\x90\x9d\xfd\xb4~%\x92\x95o 6\x13_'\x02\x06\xf6\xc0\x00\xae\x99\x91\xdd.\x9d8\xd8j\xb1\xd7]R)\xbd\xc9\xcd\xf4\x0b\x8aw\x1aml>m\xe3+\xad\xce_\xacZ\xc5\x9ad\x0b\xa8\xc1\xf3\xa7\xf8\xd9\xaaa+\x18\x197\xdf\x12x^\n\x0ff\x8e^p\xe7\xc3\x0e\xc5\xd9\xc9K\xc5[?\xc4=\x9e\xe7V\xd0\xec\x0b\xc9\x9e\x06U\x85\x1d\x06g32\x08pa\xdd\x13ET\xed\x19\xc9f\xc8\xc8\xc7\xab\xcc\x96PO\x0e\xbe\xb4\xf5i^\xbf\x9c\xb0\xc6\xfd5\xc8R!\xac\x91>4\xe9\xb6\xe9t,\x1b\xe7\xa3^\xea\xc0C\x08\xa1[EN`\x01\x08\xd9\x05B\x0d\x99\x812\xa4'a\x0dIZ\x15U\xda\xc1{\x01\x01\xcdO\xc9-\xd5v!\xe4\x9a%\xa1\x82\xad?\x07j\xb2COW\xf7|F\x9e:m\x11R\xc9\xdb\x99hy\xc3\x87j]\xec\xbd\x0d\x96\x0c\x9b\x008\x06\xeet]\x87\xd3\xf1W0+j\xb2'\x05\xadP\xa3\xdaX\x82r\xe5)]aC\xbc\x7f\xf56\x0de\x8f\x90\xe8VK\xfa~\x81\xc6\x9fB\x9d9\xf2\xc6&\x83<V3iX\xf0`\xf8]3\xa2\x85\xd5l\x99A']\x93\xa4\xb5\xae\xfb\x9b\xd9\xe6\xe5\xfbi~\xd2\xa2\x8f\x93\xe8\x8e\x15\xe3z\xefb\xef\xda\xd4\xf7\xedMd\xb6\x88\x9aN\xd2|\xb3\xc49\\\xd2l9\xde\xe6\xb3'f\xfa=\xb2j\xf5f\x8b\x9f\xb8d\xa0\xd3%\xd6\x9a\xbc\xadB\x9d\x80a=\xc42\xcc\x86:]\xfa\xb4~\x92\x9f\xeb\xd9\xd7\xed\xe6\x91\xd5xd\xf7H\xcc\xa7A\xef\x9dq\x0c\xa4\x841\x80\x05HP\xe8\xf6i\xd4\xc6\xe2\x12!\xd1\x14\xa7\x03\xcf\xf6\xfa\x8e\xd8i/\xa0\xf9\x1d>\x0d\xc0\xf1\x129\xfa\xf8\xcc.\xfe>\xf7\xc3\xc1\n\x81\xa3s\x94s1m\xbd\xdev\x0cS\xe4\xfdB\xfe}^D_5\x0dd\xf4	\x88g\xa7\xfce\x1b\xa1D\xe6\x15\xc8\xacy\xf9\x03\xa1\x9d\xae\xd4\xae\xddO\x1dy\x15j\x8d\x0bf^\xf52\x12L\x8fV\x15|\xbe\x8c\xed\xf50\xfe1\x00\xdb\xe9\x03\xa5\xd9\xd5\xd3\xfe\xcf\x85x\xdf\x18\xecWe\x9fFc\x00\x1a\xf1\x8d\xa9\xbbl\xe6B\xc3K\x84\xe4\xf7\x8c\xf1\xf6\xc5\xbb\xa6B\xf0OO\x9d\xe0\x19\xbc\xdc\x07[\x0c\x85\x13\x04\xb4WYK\x8a(\xe5\xceeU\xe7\xf0\xc6\xdc7\xe2`\xa1\x10qB`\xc45_M*\xe3\x8b\xb3\x90A\xe0S\x87\xc2GV8\x18(\xbc\xfb\x8a\xa1m\x9eh\x8e\x13EgY?\x1b\xee\xd7L\x94+r\xcc4\x80\x1a[\x1cib\xbe];67\x07\x7f5UA\"R\xc2N>T\xe9~\xa6\xf1@\xf71\xc7\xb2eh\x1a\xc7\xda\xbc\x92.\xdd\xf6=\xd4?\xf5Y\xf2\x079\x0e\x80\x9fXI\x10v\xa1\xc2\x9fr?\xa6\xae\x1b\xf5wv\x98\xedGob-\xa7\x1d\xe3f^\xad?~\x06g\xb0\xe2\x1d\xf9`P\xd8l\xf1\xdf\x15%\xe5\x1d\xe1\x93\x1et\x84\xf0	h'\x94[\xd9\x0e>sdyp\x06\xaf\xfdq\x08\xd4\xbdZ\xf2v\xd7.\xea\xf3\xffv\xf9ty\xd3\xce\xc9\x1dYvl\xd1`!\x89\x08\xa7\x19_\xfe\xfdz\xc16\xc7\xa5\xd5\n*\xde\xcd\x18\x7f\x12=\xd0ZG\x16\xa1\xfc\xca\x9a\xd9\x962p-.\xfcgL\xfa\xdb\x9e\x0b\x0d\x1b\xe1\x03\x96\xb6\xb6\x99\xb3I\x17\x9e\x98\xb6\xcdG'FK\xeeSS\xac\xe6e\xf4\xb4\x15K}\x0f\x15\xdc\x02\x1c\xd2#\xa9\xe9\xce\x19\x9d\xef\x8f./1\xe0\xc7\x82:\x97s\x19\xe9c\x159\xc0\x89P\x82\"Y\x8d\xcaB\xa6\x9e\xd4\xa9\x13cg\xcf\x83\x81H[ (W\xb1\xe7l)/Y\xfc]\xee\x9fs\xb12\xd4\xc7\xdc\x01\x8f\x85d\x0c\"\xe7w\xbf\xc7\xe2\xfe^\xbd<\x07\x11\x89\xddZo\xb4uz\x02\xa9\x00@T\x88\xc4\x14\xc1\x7f@6	8\x04`\xc5\xccaO\x8f\x91\xf2m\xdd \xdeu\x9fh\xd5y\xc1\xf4\xfcL<\xda$Y^?\xcekU|\xd5\xc9Z\xfa\xfd\xcd\xfa\xf1\x03	'\x88\xa5\xa8\x00>G\x11\x13\xd4\x9a\x02\x02\xaa\xde\xf5\\e\xf4\xfa\xa1\xf8\xb7v4\xb6\xbb\xd1?c\x88\xb5\x19\x8b\xfd\xc8\xc9\x088w\xe6\xc0V\xf9\x15v\xf2F\x0c\x80\xbe\xf7_AQ\xc6>\x1c\xbd\x0b\xbc\xa6\x04\\\xaf\x07\xa2\xeffrOWk\x13\xfe\xac+n\xfe\xf8\xb9\xe7nS\xdd\x07G\x8f\xa5n\xf9\x1e\xe1w\"\x00G\x8f\xf4\xe1\xd22\xeb\xcex\xeb5\xfc\xa6C+pE\xcew\xd3\xcd\xc5W\x16\xf7\x117\x114D\x02\x9fL\x0f\xa2\x90W<\xdf	\xe8Y|3\xce`\xf4$\xd2?'\xc3\xa5\x18p\xdc8|\xfe\xc7\xd3\xe6\x05\xa66\xe5}\xf0\xa4\xa6\xa7\x7f\xa2\xc9\x8d\x1c(?\xd1\x9b\xb6\xde[=\xd1\xfaI\x04E\xe8<i\xdfB09\xca\x9bY+T\xf6\xdek^K\xb8c\x89\x92V\xc0\x16WNi\x99\x01\x1b\x17\x80\xdb\x98\x87\xd6\x94\xe1\x14\xc0L\xb2\x04\x8c\xb0\x05\x94\xbe\xbb(l6\x07cl\x05q\x80\x01\xfa#7\xb6;;\xd7\x1d\xd7\x0f\xae\n}_\x03\xcb\x04\xd4S\xf9\xc0\x8c\xaa\x1ek\x18P\xec\x0c\x10\xcea\x98\xd3\xbc?\x841\xa2\xaf\x14\xc4\xa8\x1a\x91t\xe8\xb1]\x11\xf1\xc1\xdd\x05\x8e\xd0$*\x04\x05kG\xd8\x082T\xf0\x05h\xdcF\xc409\xc6\x1fE\x86d\x86\x88\xcb\xf9\xed\xc9\x85gd\xe2f\x1dEvF8\x97{\x00\xa0-\xedB\xb2h(o4E\xa1\xaf\x17\xa6~\xa5`x\xb5\xbcQ\x87\\t\x12\x93c,_\x8c\xb3v\x8cI\xefe\xb4\"\xeae\x17J\xbbj$\x1aH\x8c\xd2c\x8a\xfd+\x17\xd6#oRk\x15RNF\xfc\x98a\xf3\xc5+j\xf8(\xba\xce**\x9f\xc1\xddz!\xd6\x1c\xee\x8d:\x8a\x14\x1f\x8e\xeb>\x8a\x8b\xf0\x0c)\xb1\xf2Dj\xc5\xb5\xa0B9\x8b\xe3\x0e\xc8\x12\xae\x04\x13n\xb4\x13\xee\xbc\x12\xee3\x13~\xb6'\xfc&\x83\xfe\x87\xafG\xab\x03\xc5%\xa5\x85\xe5j\xa5\x10hUu\x15\xa4\xa4\xa2A\xae\xe4\x13Zk[;Zu]qcCqKGG\xcd\xe0\xe7\x9e|$\xb4\xbf\x1d\n\x99\x9a\x9e\xae\xa9\x1b\xce\x01\xfa\xc6'gVfJ\x01\xe9\xfa\xaf\x89\xc0\xd8x\xe7\xea\xe1g\\\xc2S\xdcR\xdc\xb3\xed\xf8\xe2\xfd\xce\xe5\xb9\xd5\xe2\x93\xd3_\x84\xe7\x7f/.	\xa2h\x86\x95\xd2\xaaLV\xae.\xf5\xee\xcf\xf3\xda\xe0\x11C\xf8\xae\xe6N\xda\xad\x96\xd8\xa4\xdfm\x97j(\xa5\x85\x9e\x15\xc9\x7f>\x8a5\x92\x88\x05n\xf8\xcc0pu\xb9\x9d\xa8\x97o$\xcb&\xf7F\x93\x99\x7f\xf1\xb9	:h\x8f>\xea\xd5Z\x0c)\xf1r\xec\xa5\x98\xdd\xc8\xd7*t\xa4\x94(\xc7LdcQ\xe9\xca\x16\xcf2\x88\xa6\xc86\xd1\xbb[j\xfa\x88g9w+\xf0\xc5\xb6\x94\x99Y\x82\x00\xdfY3\xad\xc0k\xe4\x18\xbd`\xcb\xb7\x8c&\x89Sl\xf2\xdbT\xdb*s\xb2\xbc\x9d+X[}P\xb3G\x8b6\xaf|C	\xdf\xb0\xf3\xa1\xf0nN\x8c\x93\xf1@$\xe1G\x9d\x89\xfbc&L\x1c\xc9\x18C\xc0\x98H;\xf6\x9d(\xa2V\xc4\xe8t\xdf:\xbb\xcb\xa9\xb6\xcd\x06y\x08\x01\xbb\x9cn\xb0\xc3\x9b\x8f\xf5\xc9p0?(\xaf\xf1l[\x1c\xd4\xd2O>\x7fM\xd4\xdc\x00\x88_\xe7\x8b\xad\xff\xd0\xd5[{\xff\x8ca#\xdb\xa8\xb5\xbf>\xc7r[\x84\x1cp89\xaa\xe4\xb4\xd2\xd9(7_\xac\xe1\xc4y\xcd\x16\x80cy}|]\xf2\xb1\x97\xccq{\xb7\x7f\x14\xe3\xb9\xeb-\x15_\x96\x03\xa5]\xe1fpg\xcd\x8fh'\x7fP\xf9\x84\xcd\xb5\x90+N\xd8\x0dqd\x18S\x07=\xe2\x11\x16\x02\xc3\xe6\xc2\xd3\xaa3\x90\x7f\xc9\xbb8!W\x18\xae\x18;g\x1c\xfcr\xe8^#T\x1c\"8\x01TQy\x18H\xb06F\xa2G\x80\xbd\xee\x8dcqkI\x89E\xc7\x8cI\x17\"\xc5\xd9\xc3p\x95\xa4\xdd\xefi\x92^\xa6\x1f\xcc\x7f\xe0_D\xd1q:\x08\x8db\x01\xd8\xa1:\x12\xcc\x16\x00\x17U\xac:I\x87\xa9\xfa*&!.k\xf3W\xb2\xaf\x19\xc6,\xaa\x12y\xa6\x92\xc7aY_\x8b\xb2\xca}\xf0\xc4a\xf04;\x1dm<n@\x1b\xfe\xf5\xbdk\x00\x968\xc43:\x8a1\xeb\xee\xd2c#50Z0\xafP\x96\xc4f^\xfe\xbdN\xe1\x91\xb8D%A~	P\x08X\xe7U\x1fTf\x10\xdd\xef\xcb\xea\x16H\x11`\x99\x85\xb4\xe3\xeb\xaa\xf02\xe8\xb8\xf6AJ\xb4\xf1\xfa\x10\xb4\xde\xf3\x9b\xcc\xa5\xd2c'\xa5>\xc2\xc6\x1bV~\xc5\x87\x15\x0e\xc1W\x9a\xf6\x8e\xc5\xfa9\x94\x15\xb6\x9f\x8e\xa8\x98{\xb1_\xb3\xb1\xbf?sgi,a\xeaz\xac\xceKm\x15\\\xf1\xc1\xf5\x91?s\x9bM.fok1NY0\xde\x91\xdd:\x87\xfc\xf5H\xd96\x14\xf5H\xfe\xd69\xd1\xf3\xf3	sF\xb8 k\xf6\xc5A:\"\xa6\xfd\xe7\x1d)G\x1d\x13mZ\xef\xf3GJ	\x81C\x12\xdc\xb1>\xcc\xa6U;\xfc\xd9!&\x83DlYFV`wh\x85\xd2\xea\xa9\x07\x94\xd14\xea\xef/\xdb\xe1\xa1;}#\x81\x93\xd6\x95\xef\x86\x816\xac\x97u\xa7Q\xacce\xa5aP\x96\xe2.d\xac8z\xefw\x91[)\xed_\xf2\xf5L\x12\x8cv\xdf\n\x9a\xbe\xb7\xbe\xb0z\x8d\xf1\xad\xb1\xfaU\x06?~\x89\xa2\x0c\x1c\x00\xf7BUoWi\x1es\x1dFM\xf9\xd9XCx\x14\x9f\x1bX\xab\x7f\xa4\xa2rP\x89(\xbf\x93&W\xbc\xe5_C\x1f\xf9\xc3\xba\xaeK\xed\xee\x997\xcd_\xfd#\xc0?\x86\x8cj\xc2\x01M$\xda\xaa,\xc8C\xb0\xb8\x1c\\/\xb8\x0b\xd1\xc7\x83\xafb~\xa5zXL`Q\xed\xb0\x82\xa5[<V2i\xa0\xb1y\xed\x0fA\xa3\xb5X\xff\xe4\x89\xb9\x03h\x88\x9e\xf6\xd3:\xce\xdf\xdeV-\xab=\x94\x13\x1doT\xaa\x8f\xb43\xd2'&\xcbq\x12\x80K\xd2\xdcx\xbf+Y\x11\xbd<\x9f\xe5+\xa8\xff\xeez\xd7\xfa\xa4\x0f\x0ea,\xc1\xe9J\xdb\x81\xbf?\xb6^\x92\xb7\xe3Q\xdf\xda\x0f\x1f'\x0c\xd8\x8f\xcb\xbb\xceh5\xabK\xec\xb3\xeeI\xf2k\xdb\xa7\xc0\xef\x1f\xddt\xb95\x12\xac]\xeb\xc3\x13KB\xc3T\x8e\x90nU2\xb3FK$\xba\x0d\x14\x8835BS\xe3\x17\x1cHK\x10^T$\x7f\xc4\x83\xf7|\x97x\x1a\xe2\x8bE\x0dp\xe1\x01\xb8JJ`,y\x00\xe35J\x18\xf2\xf3<\x02]P'F\xf6eP<:\xbeh\x8foa\xa4\xb6\x1c\x80\xd5~\x11\xf6\xf49\xe4\x89\xfd=7\x98\xae0\xf2\"'\x1b\xb1q\xd7\x80\x82_D\x18\xe1\x8a\xe3\x85EJ\xbc\x97\x17\x07\xa3\xcb\x97P,x\xbd\x99\xa3K\xb2~\x8e\x0b'|\xf7\x83{\x10x\x84\xd3\xe3\x94\xee\x80\xeb\x10\x03\xb9Q\n\xda%W\xc6d\x18YCO\xf2\x9d\xe3\"\xa5\xcd\xc6|v\x87\x04zp\x03@L\x12X\xa1?\x10\x1e\x1dh\x00\xf9\x08\xfc\xef`&\x1c\x96>K\xbb\xa3\xb3\x05\x93{;\xc6\xc5\xf0>I\x89W\xbf\xf0\xf91\xe5\xb6\xe8\x94\xaf$-\x04\x90)B\x00{3)\xa3\x84\xe6\xcc\x9e\x1d\xe3\xd2SO\xdd\xaan\xa8&\x17S\xbe<\x0d\xc626\x9d3\x11\xa8F\x8d\xe2\x87\xf7g\xc1g\xe89+t\xc6$\xd3g|\xfan\xa8\x8e<F\xa4\x92\xd5\xa6\xb8\x05?\xd3\xbc\xaa\x00\xfa\x7f\xb2Kf\x7fU\xaax\xf4\x13\x8a\x86Y4\xbdb`; 9\xc6\x84?\xbfK\xb2\xf5\xa2\xd4\xd5\x80[\xa9H\xfb\xa9\xe5\x19)\xed2\xbd\xf3\x1e\xeb\xa5O\xf2)\x86S\x0f\xb3\xd5\xb7'Pm\x15\xe1M\x16	d\xec\x7fr\x16\x1eO%\x99D\x13\x06)\xcc\xc4\xb3$\x13}g{BY\xb6\xd8\x90)qS\xfa\xac\xe5P\xd44B\xc9\x7f\xe5\x10\xf4\xf8\x0cs\xfb\xd9/\x9d\xc8@\xf0\xab\xc2\x17\x93\xc7\x14\x02?\xd4\xa6\xb2\x88\xca\xf05*U\x19)\x14\xb3\x8b\xc1\xe5\\i\x95dq,\x91q\x1bD\x15\xc2\xecL\x98\xc4\xcc\xb3j\x00\x1f\xe2N\xa4R\xa1\x81\xbf\xc3]\xc3MJ\xfeI\xd5\xfba\x14f\x8e\xd8\xff\xc5\xbb}xS\x1d8\xfe\x1f\x7f\xdf\xcbu]\xd9\xf3\x1aE\xa8.\x92\xbd\xa3K\x19\x99\x11\xd7\xde\x9b\x84\xec}\x87q	![\xd9{\x8f\xb2B\xd7\x9e\x15\xe1\xba\x11\xd9.2\x12%\x8a\xdf\xf9\x9e\xef9\x9fs>\x7f\xc0\xef\xfb_<\xcf\xe3\xbc^'\xe5\x86\x8bBP\xe2<\x86\xc7\xe6\xa4NH\x0fM\xad8	b\xa2n\xaca\x0d\x10B\x01\x8c\xc4F\x877\xb5\xda\xca5vs\x0f\x1f\x01\x8d\x01u<n\"V\x86\xc2s\x9d\xc9}\xf5\xf7\xfa<\x0d\xd5\x89I=7e\x12uOp\xe6P%\xd2\x03\xf6\xd4z\xbc\xfa\x13k\x96C\"O \xba\x0e\x000\x1e\xee\xda\xc6\x0dt\xa9\xac\xb0\xc6'\xba\x14\xb9\xe6*\x00\x90\xf4\xea\x83\x97\x01\xaf\xd2\xdct\xb3	\xa5\xda\xc9\x9e\xbbR\xca<\x03\xfc7\x05_a\xadS\xf7&\x1c\x9b\xfa\x0bPH\xdf3\x93\xe2\x82\x1aR\xca\xb6\xf1\x9882\xa9\x13\xac\xf7\x93\xfb\xda\xe2\xd8\xea\xdc\xf6\xaa\xe7\xd7\xb8\xe4:\x1a-.\xeb_\xf3\x0d.\xd1\x14\x02\x90\xe2\xe0z\x94\xa3\xa7\xf9\x84\x11\x07\xfd\xe3\xc1\xfd\xc7Ag\xa3\xfb\xa1\xa6\xac\xddtu>\xea \xf0:;\xbc\xc3\xe9\xab\x88\x8a\xf9\x06\x00\x1d\x91\x03\xd1\xa3\xc5\x80z\xae\xc5\x1e\xfd\xdf=O\xb3w\x02N,?H\xb3\xfb\xda/B\x00\x98\xd6\xa1u\xab\"j`\xd3Z4\xf6\xdd\xfc2T\x0e\xdeSV\xd6`\xe6\xfe\xdc>\xa76\xb5\xbfk\xbd\x81\xb6[\x07<\x9b\xd6\xce\x0d\xb9\xd9\xeb\xfb2\x0f\xa1\xbe\x18S\xc6\xe6\xd6\xa4\xcc}\xf7f;*x\xe8\xef\xf1-.\xef\xc45L\xa8\x19\x0eh\xac\xc1\x9b\x8c;_/\x98k\x7f#\x88J\x82\xb1W	\xca\x9dN\xd0\xbb5\xbftN4>\xdf\xb7\xc7\xf1\xe99e\x14q=`	\x97o.!KPw\x85\xdb0?\x17\xe6\xa5g\xea\xc7Z\n\xdb\xd2\xb4\x84\xb4\xfe\xe5i\xd1\x92\x85c5\x94\xce\xa6\x92zDU\xb7\xba\x1e\xb3d\x17\xa6h#\x9a\x11\xc7\xdf\xd7\xc4\xa56x\x13\xae\x81_]\xaa\xfbn\xaaI\xfd\x9a\x9c\x8e\xe8\x07_\xff\xb0c\xf44\xb3\x91[\xc3\xb3L\xec\xc3\xd0H\x82\xce\x04\xa7\xb1\xf1c\xb5\xab*\xd2\xe7\xf3s\xe5\xc951\xbdf\x0b\xcaUR\x9f\xf7^I1\xb2\xb9\xbb\xdb~\xc4\n(p\xcf\xc9\xe52\x93%\xff\x9d\xcd\xbb\xc9\x9e\xbc\xb4\xd7^\x93M\xec\x14(\xceD\xbaN+j())\x85sOmUp\xf7y\xa6\xdb\x9f/\x12\xd8e\x8d\x1e_\xd5\xebM\xe3\xceJC\xad\xdf\x96\x063\xa6\x1b\xbe[\\D\xb7\x11Z\xe8\xec\x11\xcf\x0f\x19\x1a\xf2ov\x1c$\xf6bc\x0dx\xff\x92\xdb\xdewKR\x01\xd2\xcb\xab\xf0O\xedCR*\x87R\x02oA\x927j\xbc\x06_[\xbff\x97\x10\xe4Rqb\x19}\xa6\x99$\xee\xba8+;>\xe4\xaa\xff3\xd7\x9b\xa7\x9c\x83!\xa4iny\x86~\xebx\xfd\xdb\xe1!g\\\xf2\x91!\xf2\xeeb\xce\xd2\xdbB\xaf\x8f\xc7\xe5s\xafZ\xbd\x93e\xaeG\xd9p<dxM.\x98)\xbdg\xf5\xf1\x922['\xdc@\xc6\xf4O\xf7\x81\xf2t\xe0\xc2\x8bu\x85}\xf2\xe8\xf4\xb3\x85\x9a\x02_\xd6\x9c\x99\xb3{\xfc=\xfc\x0d?-\xfa\x1d?\x85i\xceu\xfc\xac^n\xcd2\xb6\xf1\xf87\xbf\x1fh\xe8\x04P\xe0\xb3\xce\xbf\x9e\x1b\x8ag\x7f\xb3>\x08\x89:o\xfb@_#uf\xb5\xa5&\xed\xb8B\xf7\"x Ly\xd3:\x8e\x16~\x18\xa9=j\x109\x13\xedv>\x03\x19\x0b\xda]}\xc6.\xc08\xb8\xe9\x17|\xca+\x9c\x7f+m\xdciK\xf9\xde\xd9(\xf7\x13r\xf7\x93\xad\x0c)e,\xc9V\xb9\xe7ME\xd1\x9ejHz\xfb\xc1R\xec\xb2'\xd3!L\xe8\xaf\xc8\xfbW`VD\xe4\xc2\xae\xfb\xa7v\x1c\x13E.m\xcc\xcf \x83\x10[\xc6k{\"\x1c\x10\x8d\xdd\xe4\x7f\xdf\x87Uz|\x0f\"W\xcf\xefx,\xdeR\xf9;u95;]\xf0\xedA\\\xcf?\x92ix\x93\xab\x8eZ\x06\x90+\xad\x9f\xcd\x1a\xc5\x9c\x11\xd5\xd2\x185\xbb\x15e\x0dE\x03\xa7\x91\x97\x93\xce\x06\xa1\x10\x11Nd\x18\xfb\x08\x1a\xe1\x17\x0e\xa4D\xdcB\x07\xe9\x9e`\x98\xe1\xe8&S\x0c\x0c\x05\xf9\x9a\x85\xb92\x82\x19\x13\xa0a-\x04q\xf9\x80\xaf\x9f\x06\xf2\x1bbY\xedL\"\x04\xdd\xb8H\xd8;p\x15	\x04N:\x1b\x8bx\x8ce\xa12Lo\xc3\x96\x9aD\x0e#\xb1\x92\x08\x1c\x98\xac(H\x8a\xd4\x0c\x066*\xa2'\x07\xa3\xc9\xfc8\xd5\x91\x98\x03\xa8\x08\x10\x1cmaf\xc0y\xfa\xc8B^\xd2\x1a\x1fa#\xaf\xf68\x1b\xe7:\x12\x01GG\xbb\xba\x89\x1bT\x82\x98\xc9\xba^\xf2q\xf0t\xc0\x17n\xc8Qy\x1dx\x14\x17\xa8\x15\xcd\x84\x8f\x08\xa5\x8ec-\x8f\xcbb\xc4\x1b\x04\xdbF\x8d\xe0\"\xf0F\xb6\x1c\n\xbc$|b#\xf6\xd6[\xfa\x04<,\x11\x81\xcbk\xbf\xa5\\\x19_\xd0\x8e\xb7\x96O\xb0\"D\xc0\xd0a\xf7\xf0\xaa\x99\xc1\xfa>M	\xc5\xbf\xf0z\xeaQ\x8d\xff\xbd\xf5\xa0\xfd\xcf\x89\xd0\xe4\xff\xbfK\xffW \xa3\x80\xe2\x92\x92\xc2r\xe3\xe2\xff	d*HYE\x93vU\xdd\xdb\xda\x06\xa0\xb9\xf3\xfe\x1b\x8a\x9e\xf7=\xf5\xd5m\x1d\xef\x9a\x80j\xc2\xd8\xfb\xbe\xfe\x86\xa1r\x80j|\xa6\x172W\xfdi\xf250;\xf6\x81\xd4\xde\xb8\x90\xb3\xb8D\xb1\xbc\xd2@\xcb\xb2G[\xba\xb6\xfebsv{\x07\xf8\xbewF\xfb\xf7\xdf\xe0QR\xa7^Iy=\x9fJ\x04\xf5\xb9\xd0_\xa8x*\xc1\x03r\xd0\xb4\xd6\xc6\x90?\x18\x8b\xefdC\xc3\x99\x16Tc\x0eRbjn\xd0\xaf\xf1\xb9s\xc3l\xde\xb1\xed\xaf\xf5a&P\x92~7\xc6\xd6y\xd3\xc51uN\xdb\xbe\xab:\x97\xde5\xe8\xba>\xe7\x9f\xc9\x83\x86\xe3i\xe6\x9e\xdb\xd3\xb6\x1fC\xd5p\x12\xb7w\\X\xa3!f-w\xd0h\x7f\xe4X\x99\xb2\xeaQI\xd0S\xce\x89*\xe3i|\xa7pa\xddB\x1b\xb85P\x9d\x83\x89w\xe4\xe9\xb5\xb1\x1d\xf5+\xc2\xd7x\x0c\xaf,\xd3\xb0\xdf\xe6(\xeb\x0d\xb8\xa0Z\x9f}g\xca\x0e@R</\xe8\xee\xb6K\xae\x18\xffF}\x19\xdc\xb5\xff\xd1\xd0E\xca'\xae\x8doK\xac\xd3\xb0\xfd-\x06\xd9u\x9c,C\xf8\x1b\xae;\xde\xd0\xf6\xddZ\xe8a\xf7f\xbd\xa9(\xb4\xadW\xfc\xe8>*\xc5\xe2\x88\x93\xc8-\xdbn\xb3/\xdb\xfa\xc69\xdf+\xa8\x9c\xfb\xe7\xae\x9bu\xafA\x01\xea\x1f\xa4\xe3\xd9\xe6\xb2\xba\n\x94\xfd_\x8bXYb\x94\xbb\xdf\xab\x08y\xdf}\x8b.\\\x81\x18\x1a\xe5\xed\xdc9\x1du\xbe\xd8\xf6\xefp\xf5\xaf\xde\xcab\xd0\xb1D\x1c\xad\xe2\x99\x97\xf4k\xf3\xb9\xf5\xd6l\x10Hc\xed[\xe0P\xccg\xd43Jv2\xd7\x93^\xf1\xcd\xcb\x04\x1c\x83\xe4\xfdL\x19\xa6|Im\xc8~\xb7\xa9=k-\xac\xd0\xea\x19\x17v\xd8$\xce\xb9\xa8\"\x1f\xc7\xa8Rfn\xc8bd\xc6\xcf$\xda\x03qLlP\x05T\xad\x8e)7\x92\x02\x88\xd4\x01\xcb\xba\x8c\xe5\x01\x0e	\xc2\x99p'\xab\xa7t\x0f\xd73\xc0<_n\x98\xd7\x1f\x0f\x95\x9a\x8aO9]\xf5r\xff\xf1\xd91\xe3!e|\x86\x9d\x05;b\xcc2\xdd\xf74IY7\x12N\x1b\xfdv\xaf\xc31\x17Y\x0f\xb3c\x02\xdc66\xec|-\x02\xdd\"\x00\x9e\xb3\xf2\xd7[\xc8\x11'\xc97\xe6|c\xd2\xf6\x170D\x116\xd3\xc8\x0d\xf5\x1c\x15[dYD\xdcb\x7f\xe2\x97\xc65\x97\x98\xaaRuM\x82\xddL8\xc9\xe5\xfe\xbf\x11\xc7\x8a\xc2gB%\xcf)\xa9F\xd9\xec\xaa\x1dd\x8a\xcd\xd7 ~\xbd\x90v3FL\xed[\x8f\xce\xed\xdb\x172\x1e\x82\x8d\x90\xa7\x01E\xcf\x1ae8<KJ]d\xbeG*<\xa4 4\x8d\xdd	\x13*\xa8&\x9d]~O/A\x14\x8a\xf8=\x99\n\xb9\x9d\xc6\xf4\x80\xe0ei\n\xab\x11\x94QH\xa0Fvp\xcc(\xd1\xe8\x89\xf0\x95\xec\xb7\xa8\xe9n?M,\xe3\x12\x02:\xc0\xe7;{\xe2\xe7\xd5r\xd7\n6\x88\xdc\x87\x84\xc1Z\xbc8k\xa9\x9b\x9b\xa8U\xd2\x18[cwL\xfd\x8e\xa8w\xeba\xbcP4;OX3\x01\xd2\x10t8\x1c\xad(\xecX\xd9\xa8W\xf3}\xa8\xa0/\x96\xcdTtZ\xd6\xb2Nb\x9c\x07\x04\xfe\xc5\x1e.\xf5Q\xab4\xf1F\x97\xd1K\xc5\xfa\xcf\xba\x147\xb3z\xd4\xef\xbby\x8b\\^\xe3FOP\xf3MJ\xcc\x98\x97\xfe\xdd|6mD|5\xe14.\xfcdt\xab\x95\x16\xfde\xe0\x81\x0f\x08\xe4\xc8k\xf9k\xda\x8d\xd7\xf0h\xde\xdfF\xc3\xcb\xb2qOYv\xda\x85b\xc8\xa4\xf7\xc5'\x8f\xf1\xc54\x8a\xc6\xe3%\x88\x15#\xe1\xb3\x0b4?\x10\x15M\xe7\xf2g\xe5d\xfb*a5\x8a\x82\xf8\xe3\x9e&.IN\xcfY\x93\x91\x00\xe4f-^vl\x19\x11^6\xd8\xfd\xe8\x194Y{\xda\x1f\x8b\xde\x8c\xbc\xa4S\xd8\xc5\x18}\x97\xd1\x0eZP9\x87\xb4~KVzS\xaa\x85$\x9c\x94\xb4/\x12\xbb\xfd/l\x9ag\xa9\x0f\xad\xc3\xb7\xae\xf9\xcd\xa7\xf5Ne\xed\x99\x1c\xa3u\x14\x12N*\x86y	'$\xa8\x81b\x8f\xd5\x17\x9d\xf6\x8d\x15\x1d\xa0{\xcf\xd5\xb1\x0f\xe1\xd4\xff\xfe\xd8\x1a\x171}\x85\x158\xbd\xf9\x9ej\xec\x05\xa0\xca\x02\xa1\x84\xad\xb5\x18\\\xaf\x0f\x01\x1d\xda\x1e?\xd2\xe8.a\x15\x8f\xd8\xf6\xe4Ej\xf4\"\xa1\xb9\xe2\x91\xa5\x95\xbcV\xad\x0e\xa5\xb6u{A\xd4\xd4H\x986c\xdf\xaa\x8f6+\x9c\x1a\xa0\xba\xed*\x06\x13\xe4\x03}\xa0\xb8\x0eV\xbe\xd5\xcb\xc2\x95\x1a\xa9U\xca\x9b\x15KT\xab\xaf\xdd\xc3\xad\x9d\xe7\xbdxE\x85\x0e\x04\x96\xfd\x95&\xb8@\xcc|\x18\x06IQT\x13K\x91D\x82\xdb\x03\xe8\xcd\x1f\x89$%6\x96<7\xd5\x15\x18\x13ZH\x9b\x0e\x18.\xee\x7f\xf7\xe5R\x04\xb0\xa2\\}\x9f\xc9&\xe2\x17\xef\xcbq\xe5v	\xe7T\xf0P/\xa6:\xde\xeb\n-\xed7\x92<P\x88\x90lzN0\x93L\x01\x8d\x9b{D\xc2\xd0|l\x0fU\x1f\xa8\xca\x8a\xc7kF\x17\x16\x11\xfb\x86\xc2{c\xfd\xa8\x815*\xd3-\xad\xa0\x10\x1fK\xcb\x89u^\x89g\xea\x06C\x07\xa1\xadw_\xab\xf3\x8d\x0c\xf5n\x88\xa5\x8d7[O\xcf\x946^v\x1d\xc4Y\xeb\xf2\x15=\x0b\xe9d\x96\xfc\x99I\xca#\x82u\x86\xd8\xa8\xef\xd4\xd9\x90\xd2\xd9\xeeF\x8e|p8s\xcb\xa4\xab\xda\x90\x1avWc\xd6h\xc9edU\x97U\xff\xd1O\xd1\xfe\xf35t\xd9E.L\xbaR2\xfd\x05\xf3\xaa`\x85\ntt\x1c\xe2\xf0\x89\xff\x90\xb6\x12\xb9Q5\x8c\xea\xac\xc8\xb7kwP\x94H\xfd\x14\xa1x\x8ca6v\x00SjO\xa1\x99\xd3\x0b\x97\x9c^r\x0cT\x8djK\x1f\x17\xf1\xbd\xb6e\x06=\x9aJ\xb3\xf1/\xde\x9fq\xec#\xc3xA\xca\x84\x9c\xcb\xdf\xb4x\xa9\xfb;\x93j\x99\xfe\x85_K\xc9\x0cS\x9f\xab\xb2U@\x00\x03\xb5\xf0\x03e.\xad\\\"k\xbcuW\x95\xd1\xb7\x1c\x80\xc9\x1fIY:Z\xa9\xe1\xc71S\xdd\xc7\xfa*\x8cO@a;\xc8\xf8\xecF@\x95\x9c\x97\x07T\x91\x8f\xa6\xd2\xe8 \x15z\xe2>S=F,b>\xc9\xb1\x8a@\x18\x8d\xcf\xeb7ZH\x83\x1co\x88P#\x0b\x89\x19\\3\xd9\x11'\"\x96u\xc2\xef	\x8a\x03/\xb5(\xb7\xad\xc6sM\x99fL\xaa\xee\xf9\x0bC\xe5\x0b\xe7\x18\xcc2\x1b\xee9WY\xacH\x98\x15-1\xd7\x98+_Gl\xec\x98\xac\xe1\x8b)^\xeayA\xf5\xc7\xf4f\x8c\x02\x9bC!\x18g\xb1\xb0\xc6D0\x19\x91\xc7\xa3\xee\xda\xb0c\xe8\x9a\x1cX\xf0\xf0{\xf1\xb0\xfe\x95\x85\xa6\xf2\xcc\xe6\xfa\x08Q\xe7\"\x1a\xe0\xcb~@+/\xe1\xc9>9>w\x07\x05@\xd2\xeco\xc9)\xed\x12\x1f\x9e\xfelk\x15tr\x07\xd9a\x8f\xe8\xb3\xda\x87\xf5.\xeb\x9f\x8d\xce[1O\xa0Z\xa7\xbc\xdcY\xba\xb6\xd1g\xf3\x1c\x10\x12\x04\xd0\xce\xe9\\\xe8\xc3\x12\x11D\x9d\xdbR\x83:\xc0J\xae],\xb5\xac\xaa\xb1\xe6u\xf3Y\xc9\xb6\x8e\xcc\x001/\xba\xdd\xe8@\xd5!\xd0\xb9\x1e\x9a\xe2\xe8\xf9\xe3\xfdH\xe2;\xf0\xc7\xb2`\xba\xc4e\xe6\xf4\xc6\x12/E\x1a\xd8r\xd8\x97\xd3\x8bq\xa3EN\xfa\xd8\x9cN\xfb\xef;\xd2 \xdcF\x9d\xe1\xdd\x11G\x86\xa1\x00\x82\xab\xb4O\xc8\xf8x\x9b\x7f\x02\x7f\xce$?&\xad\xe0\x8a5\x87\xf7\x15\xdeK=\x92\xc0\xae\x88\x01\xb40\xb6Tq\xcf[\xaa\x84\xa7\x801\xf8\xcf\xb8\x1d}Kv\xf7\xf7O\xbeT\xe6\xac\xf7o\x1e\xfdM\xbe\xfd\x95\x90\xa06\xaa\xb3$\x02{`/\xa4#Z\xaaT^\x85\x8e\xd8\x0b\xdbH\xb8\x98\xec\xef\\\x97d\x07\x18\xb1\x11` \xd2E\x83?\xef\x06\xc1\xed\xc6\xb5\xb3\xd0\xcd\xb2C\x91\x7f\x08v\xd5\xbcX\xed\x85/\x07\xd6~\x7f\xed\x95\xf0}\xe3\xce\x82Rw/}`\xa6\xea\xef\xbd\x9b\x8eE\xc4J\nZ\x87CGw\xae:\x84h\xaa\x85\xd1\x02\xc2\xe7\x9c\x93 \xc6\xe3Bw\xeb\"Mo\x93\xf8\xa6P\xc9\xbb`]\xa7\x1b4x\xd6?\x8d\xe3\xd6#\x8fv\x95\x95\x16\x88y.\x9e0\xa1WP\xdb\xac\\o\xb1\x8f\xd9\x1d(a\x8cC\x96l\xd0\x1f-|\xf3\xc1\xedX\x97{\xe7_r\xf5\x85\x92\x17:7\xee\x9bq\x8ftDJ\xc8Z\x7fH\xc1'\xbf\xe9;D\xe2U\x86\xf6\x18\\\n\xce\xbfI\xc5nu\xc2\xb8\xdd\xee\xb3\x9a\x0c\x90b5\x1b\xed\x9fD\x1fDr[p\x12\xd0\x03\"U\xdeA\x87\x96Dky\x1a\x82\\\xfe\n&,\xf0C\x8c\xbc\xb4\x95\x9f\xb5\xf8\xe3\xfe\xe1\x15\xd2\xf3\\\x04\xff\x1d_Z\xe34\xfe\xb3\xd8\x93f\xa9%t\xee\xc2\xdfM\xeeK9\xedK\xdd\xc3`\x96\xd5\x12M\xc1\x90^\x17\xf2B%\xfbg\xdcW\xa7\x8e\x81\x0ck\xdf\x9c\x97yW\x80\xf6\x8e\x99\x8ad\xbf\xe4\xe1i\x10n\xef\x0d\x1f:\xf2R\x8d\x98\x91\x8dy\xaf\x1a\xc2a\x9ew\xdc'\xfc\xe0k\xc6>\xfd\xec\xdc\xc7\xc0\xbc\x12\xcb\xfe\xcf\x1aE\xf5\xe3I\xa4P\xdb\x86\x81\x1bx\x0f4\xe7\xe2 \xec\xf4O\x1c\xa7xX\xadz\xea\xaf$*s\xb0\x97\xc1\xdd\x85\x9ay\xa0\x8e\x02\xbb\x8b8\xde\xedU\xbd\xf6v\xf7G\xf7\x01\xd8K\xe1\x06\xc5\x8b>m\x8a\xc2\xb4\xb5Vi\x0cl\xc2m\xd0@\xb92)\xeb\x9c\xe8zf^\x17it\x00\xd9|\x87\xf8\xbb\xff\xf1\x94)\xfdg\x05\x85\xf2]Y\x86\xb3&A\xffc\x02o\xc4v\xd5\xd2b\x9e\xae\xcad\xad\xd8\x93\xbc\xdbb\xe7\xb0\xf5C\x7f\xa1\xc5\x9b7n-\xed~x\\0\xcf\xb7\xe6[\x82\xfb\x14!}\x16\xb4\xeb\x14]\xf8\xfb\xaaA%\xa9\xa13\x01l;\xa5\x8e\x1e8\xcf|\x17\xbe/\xbcu\xd2H\xf15\xc2\xa8\x84t*$\xe8\xf4\xae\xc4\xa5{\xfc\xd3\xc5k\x92\xa98q+2c\x06z$\x17%\x00\x892\x05\xae\x00H\x10\xab8\x18\x11\xa7\xa1\x12\xe0vya*\xfe\x06*\x7f\x96\x01h\xb7\xf9\xa7L:\x06O\xa2\xe9\x11\xff\xd3\xc2\x0cpL2\xfbuVD\x18\xa4\x1f@\x1aC8\xb3A\\Z\x11c\x94\x92\x02\x95 A7\x1c\xab\x0f\xd8\xe1\x96\xed\x801\xc6\x98\x95\x06-g@\xa3\xa5#\x13\x8c\x13\x0c\x063\x9a\xd9\xdd\xd5B0fcAp\x19\xd5\xf6\xc7RZ8u3\x1c}%\xce\x80\x1a\x10h\x8c)\x050\xee%\x98E\xe9\x18i7\x9c\xa0[\x8c%9\x06\xbc\x8af\x08\x8eukG\xdb!b\x1c\xe4m\xd5\xcdb\xe9I\xd1\x06f\xe2\x1e\xd9q^d\xac\xa1|\xf4M\x10\xe0\xa4\x85=\x14w\x0b\xa9\x8c\x0d\xc3\xd3D\xc0\xe3\xd8bq:#\xfc1\xa4h\\&\x00\x07\xe2\x12\x10\xc8\xa4\xec\xd8\xd0SMN<\xe8\xc7z\\F;%\x1f\x1c\x1bD-xo \x1e\xba\x1c_@\xba\x19#\xaf\x93G\x8d\xae2c\x8b\xd1\x02\x95d\x8b\x97Q\x1bT\x9e& \x99\x9f\x17\x8f\xc4\xa5U\xbaK\xb6\xe0\x9fgW>\xdf\x93z\xca\x19\xf8\xbc\x9d\x9a\xa1\x86\x8c\x1dA1\x11HT88\xef'R\xe2g\xf9\xa4\x19\xb3$bp\x12);i\xbe=\xe9+\\\xfe\x7f\x9d\x19\xf2\x7f\x95\xd1\x8f\xff\xd7\x99\x8dJ\xfe\xe3\xcc\x0f\x8b\xab\xebZ\xeb\x1a\x80\xe6wj@5\x05\xa1\xb7\xb7\xfem}qW\x05@\xd571A\x05\x99\xacm\x18)\x18\xfb0\xd7?9\xf5\xa9s&\x8fH\xea\x1b\x98_\x9f\\\\\xca\xeaY\xf9\xb2\xb1Q\xbaEN'\x8e\xcf}\xda[/\xa6e9\xa7\x1d\x16i\xfc\xc1\xf5\xc7\"f/_)\xe2\x9cY\x9f\x0cEc\xeeG\x81X\x84\x04H\xbay\xdd\x81tl\xc2\xfe#\xa9\x02]\xf8h\xaa\x0b\x87\xd6\x0fv\x1a\xc90\xce\x1e\x11H\xf3\xe4\x00\x98\xadlQ\xe9K\xaa\xbd\x82e\x87J\xeb\xf7\x12Z\xae\x87\x97v\x1c\x12wja\xc9W\xbbAC\xfe\xfb\x03\x02G\x08\xa3@\xf8	S\xc0\xce\x84\xfb\xe7\xb2@Tr1\xd7\xc4rM\xd1\xb5\x0e\xd9\x16\x1ac!\x94L	\xdfm\xb3\xf1@P\xb4\xe1G]m\xbaww\xbe\x1a\xb6\xe4\x9b}f*C\xa1\xbf\xbdGC\xdac\x94\x08\x9e\xf7\x8b)\xee\xceX'\xfe\xce\x03C\xd8\x84\x81p\x01\x0f\xd5\xe5\xf1,\xe8y\xae\x82p\xed\xea`\x00\x9d\xf4G\xcae\xdf\x98\xe1\x94[%o\x13;\x02\\,\xf6=R\x8f\x90y9\x0e\xcf\x0b5_\xb2\x07p5\xbb\xcf:\x03\xed\xd7\x92Cz]\xfc\xc4\xbd\xa6m\xe8u\x7fH\x95N\xed\xe8/\xc8T\xcby\x8c2\x0eR\x7fk\xc4\xb4\xff~?\xfc\x9c\xea\xfc\xeb\xab?Z\xe4\xd1\xa6\xf8_\xa8\xab'\x07\xdf\xad[\x83.\xf5\xa3ZN\x07\xe6T*\xa0tO\x14\xc3\x96\xf6\xcb\xa8x\xd9\x8f\xa6\\\x0b\x817\x8f\xde$\xfb\x9d\xffh\xb3y\xb0\xd7\x11\xf7\xfa\xa9\x1e`\xc7\x19\x04\xfd\xec\xca\xa3\xeb`\xfd\xf3R\xc7\xcb\xf1Oz\xb6\x1f\xeb\x18\xf3J\x00A\xfd\xfa\xb7p\xceP\xa8~\xdc\xbd\x0c\xcd|q`\xc0\x84?\x00\xd0\xce\xe6g\xe9\x83\x11\xae>\x17u\xa1\x02Q\x82\xbbdt\xdd\xe8\xae\x99[&\xad\xde\x0d&\xdeS(\x96\xe7cf?#s\xc4\xaa\xb6j\x94\xfa\xea\xc5\xfa\xe5\x8b\x9f\xc7\x07\xb2\x8a\xc8\x15\xfb\xad\x86\x95\x9cP\xc33\xcd\xab\x85\xb2m5\x12~\xde\xf0\xb7\xeaV+QD\xd6\x19\xb9 ]i*\x8d3\xa2\x9a6\x05R\xa0	\xe0lg\xabd6c\x7f\xbb\x04IZ\xfb\xba\xef0\xa3\x02\x16zP\xa5-\xf6bp\xd3\xe7\x01\x16\x8a\xac\xf0\xbe\x99Pj\x92\x02\xb6\xc3\x94\xb4ku\xb2\x94\xb9\xc3\xe69\\\xee]@\xaak1\xc9j\xbbv\xcf\x95\xd7\xd2\xab*\xd4\\\xab\x11\xf5\xe4?\x82\xc2\x86*\x81U\x96\\\xcc\xf17\x9a#\xffUof\x16\xc7JM\x95pX\x8935\x10\x12\xb6\xbf5\n\x83\xdd\xae\x17?u\x1e\x13\xf2\x84\xf7xXQV4\x97_\x12-~+H\x89\x1a\x95k0\xaa\xbem(\x14I\x13\xab~\xa6\xf3\xc0\xd9K\xe2|\xb1\xf6\x03\xca\xf3\x15\xe4(\x92\xe6\x96%E\x00\xe2\xa6r\xf1(7F\x8c\x83\xf5+K\xcf\x99\xcd\x0f/\xcb\xa7\xcc[b\xe5\xdfP\xbb\x8dE\xea\xfc]IXg\xa6\x81\x0f\xc3\xc0\xc4\xb9\x8f\xfe\x1f\xd4\x93\xed\n\xcf\xee\x8c\x1f>T\x14\xbf2-\xca5\xfe6\xfa\xac\xd0kL\x88\x86\xe6\xf2x\xbfU\xd4\xf9\xda<\xaeQ\xeb\xb4\\\xf6\xe1(\xa3y\xeco}\x9e\xf8d\xa8~iE\xd7\x03\xcdDB\xbf\x16N\xa2\xc5\xd3;^D\x9b\xc8\xf4\xe4CC\x8c\xe4\xe3\x88\xb7\xb3t\xa6\xdc\xc0\x145\x9f\x8f\xff\\\xcb\xa4\x9c\xe0\xf4\x90\xb3\xb5(\xb8\xa2:\xc0O\xee\xc9\x02\x18\xfd\xf53m$\x08\xe4} *\xb7h\x13\x1e\x913\xd1 \xab$\xb6\xca\xf1DTn\xbe\xa0\x8fE>\xe7\xd98\xea\xd7\n\xc4\x8a\x11\xf9\xe5\xb9\xd4E4J;*\xeb\xa8\x0d]v\xfbl\xc1w\\\xf4'\xfa\xb1_\xe4\xe5\xce\xac%T\x1cMn\xfa\xd4\xbd\xa3\xc4\xcc\xc8\xf7\xf7p\x80e9\xd8\xce{\xb4\x1bUG\x8d\xaf?\xdd`\xe5M\xe5\xcbqf\x90\x0e\xfe\xdai\xba\xf4n[=\x859\x1cX\x8ec\x88\xfc\xd1\xcf\xeb\xfds\xb9\xb6_A~c\xde\xb6\xa7\xeexx\xf6\xdd\xd1\x89/\x07{\xd8v\xf9\x8b\xa3\xee\xd3\xbf\xbfm{\x16k,\xf3\xd7\x19\x91\x00\xec\x8d\xc1\xd3\x8cPN\x1d#C1\xc3\x94;O'\xdb\x0b\x0e|(\x81\x81\x93\x03,\\z\xe9\xfdR\xda\x1d\"\x1c\x1aF\xd5\xf5\xad\x7fR:!\x02\xe0\xa3\xa8\xb4\\\xfc\x87\x82\xb7\xc1n\x96`\xe1\x89E\xa7	\xae\"\xfa\x8b\xa2\x11\xa3\xf7\x91\x80\xf6#,[\xe6\x90\xf2\xb1\x12- \x8eV\xc3\x82\x90`\x98\x16r\xcc\x8c|?+\xbe\x0f+9\xc1\xf7\xe7\x02zm\x9dn\xfb)\xd8\xca\xe1k\xfe\\\x1f\x8fu\x83\xdd\xbf\x11\x80	\x1a$\x0c\x16D\x82-\x12\xb9;o\x17jplB\x12\x0c\x8f\xbf\xb1\xba\x93\xa4El.S\x03x\x9dV\x7f\x15\xa6\xab\x19\x16\xa6x\x03\xd4\x04\xce\x0c\xb8\xd8\x15\xf8g\x9b\xa7\xdf\x1f\xf0\xad!\xd4W\xac\x0f\xa3\xd8\xfc\xa7\xd9\x0e\xda\xbbxv\x98\xa2\xba\x11\x99\x05cn\x1ak?\x0c\xc5\xbeZ\x08\nl\xc05WL\x1e\xe1|r\x05\xd9E_j\xdc\xafO\xf1<\xa4@\x07L\xa6\x03\x06\xc9\xf6\xdaG\xf0\x0d\x81\x00\x05\x15\xe3#\xe7\xb4p{\x8em\x9e\xa1!\xd9>\xb1\xf8\xe8tg4s\xe5\xd5\xb5\x1bi\x0c\xe2\xebe\xd2b\xf24\x8f\x97&\xd3\xbf[\x14\xf7\xaf\x07\x08\xbf\xc6\xf3\x83\xa0\xf2\x80\xe4\xcb\x11a+fDxk-\xa5\xd0\xb2\xbc\x9e;sC\xee\xc6t\"E\x07\xac\xc0\x10-\xf5\xea\x94uK~\xfc\x8f\xae!\xf6\xd3\xab\x14Fu%\xe8\xd8=\xae\x9e\xc1\xcc\x08J$\xf3\xcf\x9cO\xbe\xfb/\x0b\xcc\x01\x02\xc55`\xd8\xbf\x1cW\x08\xdf+\x13\x12p\xff\x14\xb6\xca\x92C\xeb\x85\xd0:\xa9R\xd0\x93\x94bey\x8d\xb8\x04\xce\xa8\xa3\\\xfcs/7\xa46\xd4\xee+N\xa7\xc4\xfa\x95\xca\xc9\\y`\x92\xfe9\x1du\x9c3\x19\xbe\x8d\x1c\x85\x02y\\\x19E\xf2\x1f\xb6\x99\x03-\xae\xc7\xff2.[u\x14\x12(\x1e\xd5\xd2\xa4y\x1b\xecvw\xc7\xf0e\xe8c\xa6t|U\x0b|\xf0\xb1\x90\xe2\xfd\xc2ML\xf5\x0b\x9e\xfcGS\n]\xb5\x1e\x19\xe5\xd7\xd4\xab\xa9\xbb\xe6E\x9dw\x1019\xac\xbbf\xa6\x1e\xc4Z\xb3\x8c\xbaA\xcb\x1a\x8d\xa9\xca\xc96.\xf6\x02~2!9\xd7cn\xf7\xc1f\x13\x1b\x12sOQ\x91XP\xed\x97\xeca-\xf2\x83z\x01C?s\xdc\xa8\xb1w\x1b@\xf2\xcd\x96i\x8c\x82*\xf5\xea(\x1e\xf1})N\xf0o0\xdc\xdb\xe5\xd1W\x18L\xd4Om\xec\x04\x90\xaeJ|\xd8v\xec\xaf\x92bH/T\xab&\x86G\x81P\x02Czy\xae\x9c\x19\x8fk\xac\xe2A\xd4\xb7u%,\x91\xb3\x00\x9a\x82\xb4\x81v\x8d\xf0$\xd1\xdb\x05\xbd\xe9\xfb\x87\xe4W\xff\x91\xbd\x16i\xdc\x91\xf6\xf6\xd2\x9d\xb9`ln\x8b\x7f\xbfa\x7f\xe0\xb6\xfd\x0d\xf4\xd0\xfe\x8d\xc0\xb6\xae\x7f\xb5\x86,\x93\xe6\xf4\x89\xbf(\x04O	>\xe7\xe6Kk\xfc,oS}E\xf5\xf2,\x89hm\xbe\xce\xfb\xaf\xd7\xc2}\xcf\x99\x0c\x83\xe5\xde\x91\x16\xebC\xc5jI\x87i@\xfa\xa0\xb2\x1e\xc48o\x9e\xf2@\x0eDKo\xeb\x0e\xc1\xa08\x9bYZD\x0cA\x80\xee\xe1\xadE\x97\xb6\xf7\xf3\x01\x95Ab\xb4K\x0c\x8e\xe3\xb5\xb93LA\xde	\xab\xf9\xb2$&\xd1%g\xd5\n-\x1a\xb6\xd2\xe5Q\xa1\xec&}\x90\x8f-\xad'G\xff\xdd\x9b\x98\x17\xdbB\x0b\x06\xc5\xf4o\xef\xb3\xbb&\\~1\xca\xfa\x98\xf2z\xca\xb9\x19F\xd4]\x0b\xaa\xe5\x14c\x1f\x92\xcc\x0c\xd1\xa1\xc1\xee}\xbf\xee\x04\x00\xf1\xbfi]6\xb9\xcc\x07\xad\x9f\xe2r\xa6_\xa1\xb8\x9as\xe2\xf0\xfao`;\xdc(a[\xf9~\x9bA\xf6^\xfd\x98\x14\x18e'G\xf8\xc77\xdes\xbb\x8f\xfdmB\xe9\xee\xc4\x88\xd9]~{\xf8\xcd(\x8b\xabgv#5F=oA\x91\x02)\xf0\x8fZ\xbf\xdc\xf5\xea\x1c\x7f\x85t+N\xab\x12\x12oJ>\n\x11\x0b^/9pp\x1c\x1d\xa6\x85[\xb2rI\xacp\xbc\x91\xdcu\x11\x98#:]\xfdV\xc1\x99\xf7\xc80zy\x9e\xf41\x90\xdaM\xc4#\xe9l>e\xf1\xbb#\xa2'\x07\x90pX\xfe\x8a\xd0\x95M\xe1&\xec\x07\xbe\xcb[\xfc\xb0\xf4SY\x81n6J?\xacj\xfec[\xab\xe3\xc8\x9e\x06\xff\x9d\xb6\xe5\xe49\xd9=g\x8b\x19\x85\x99\xaf\xe4\x8f#\x1ah\x1at\x90\xd2\xdf\x95\xc3\xc0V\xf9\xab\x93\xee\xee\xc3 \xe0\xfa\x08\x99IJd8J\xc4'\xdf\x8a\xca\xed\xfe\xf1\xf9\x94\xa0\x0b2\x1c.?\xd1\xc7\x0d\xfd\x91\xf9\xae\x0b\x94s\xd1\xd2\xbdb\xb7\x97\xf8\xfb`~V\xc5Y\x80\xa2\xb0\xc3\xe8R>7\xc9\x1do\xf8I\xef\x81c\x89\xd5\x86\x9d\xfd\xe9x\xd6G\xff@\xd3\xbb\x9d\xbfQjj!3{6G]aO\xea7\xb5\xcbT.1\xe1	/F6TB\xf5\x1c\x16\xe5\xffh\x17n\xbb\x15\xb8Q\xf8\xec1K[\xfe3\xf0\xbeiS\x8c[:9\x8cg\xcd\xb9\xc5\xc0K\x13\xbf*\xb3s\xf6\xba\xf38\xe2O\xaa\xdf^ \xf5\xee\xccV\xfc\x1d\xd5?\x95\xd2\x18\xbf(\xd6\x80!\xfdS\xed>\xc2\xde{\xcb\x8b#\x9e\xad\x90\x996\xc0\xfa\xd2\xfa\xb56^\xb3E\xff]\xf2'\xa1\xbaR\x01\xcc\xc7\x0e\x04\xf9\xf9\x1f\xa2\xe5lq$9\xfcR\x98\xf6y\x06>\xffGY\xc5\xe7\xa6\xe1\x9e\x90\xaa\x1a\x95\xad\xda[\xbc3a\xde\x12\x1e\x81\xde\x94\x02f\x15\x15\xae/\xce)\xc83\xa4w\x9a\x1195txv\xb1\x14\xb0\x80<\xe6\xe3Z\xe5c*\x84\xe1\xefI\xde\x12\xcd\xf6\xb6\xefP\x8a\\0\x99\x03r&T\x18\xb1\xad\xf2l\xcc\x87>\xaaoT\xff\xa2Y\x87\x1b`\x9dD_\xfcl\x00\xfd2\xedYh\x0cR\xbc\xa9\xe9\x0f3\x8d\xb2q\x8c\xec\x18\x8c\xfa\xd7\x16u+%|F\xe6\xc9]\x87g\xd4AO?\x0fT\x88qWD\xd2\x8cD\x91\xe6\"\xb9O\xe8\xd9\x1d\x9fQha\xa8*\xaf\xc2*\xa3\xfeU`.\xa0\x98\"\x00\x1b\x9c\xf5L X\xe7\xd4\x15{\x0b\x1f1\x12\x17N;\x8c]/\xc5\x8ai\xf1Ij\x81\x82\x07!4&O\xcf\xdf\xe0\\\x00\xe0\xfc\x16\xcd\xadIt3[4\\<\xfa\x01\x9c\xa7\xa41J\x96,\xf30\x1a\xf4\xe2wtoS\xa4Ev\xb8\xb1Y\x8cvLLHy\x0cy8\xc6\xda-f\xc5\x18s\x12\x17\xe5@\xc6\xa5\xc7\xe9\xba\x90\xc3\xed\xe0\xb1\x9e\xed\xd1&dC7\xadH\x0f\xf98\x9b5F\xbdl\x83\xa0\xec{pD,2\x93a)(\xc6\x97\x1a\x87\xad\xbcJ\x9f\xca\x1c\x95\x1d\xcb\xb7)s-\x18\x9f&\x1f\x9fn\x16\x9f\x19\x1c\x9f\x9d\x1d\x9f\xdb\x1e\xff\x8a\x14o\xc1H	(\xfd\xbf\x00\x00\x00\xff\xffPK\x07\x08]12\x95\xaeZ\x00\x00\x8f^\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xef\x9d\x8cN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/en_GB/common.jsonUT\x05\x00\x01R\xeb\xb0\\\x8cVMo\xdb8\x10=\xdb\xbfb  h\x8b\x1a\xe9\xbd7;v\x1aw\x9d\xd8\xb0\xdd\x06\xc5v\x0f\xb48\x91\xd8H\x1c\xadHE5\x8a\xfe\xf7\x05g\xa8\x0f\x07(\xb0'\xbdy|\x14G\xc3y\xa4~M'\xc9\x13\xd5\xa5\xf2\xc9G\xf85\x9dL\x92\x93\xb2\x16u\xf2\x11\x92\xc5\xfc\xe1a\xb5\x84\xc57xs\xe5\xde\xc0\xedv\x0fW\x8e\x1f\xdf\x93+\xf7=IfA\xaf\xb1@/\x13\x96\xab\xcd\xea8\xcc\x90qS\xaa\x0c\x97\x83h}?\xff\xb4\x82?K\x0f\x15\x99\x02\xeb\xb1\xf8\xb0\xdb\xae7\xab\xfdk\xb9\xc5vG\xce\xbb\xb5=\xe65*\x9ep\xa5\xc1b\x0bU\xe0\xc1X\xf0<r-\x13\x98\x9d[\xbd\x0e\xeb\xb8mi|\xcc\xe9J\xcb\x8c\xb7\xee\x1d(\xab\xe1J\x03\xe7\x12b\x8a\xb2\xe1\x0d\x7f\x98x)l\xea\x0cuH/\xc8v\xdb\xc3\x11v_\xf6\x9f^\x95s\\G\x97+M\xed\xc2t\xd5?\xdc\xcd\x97\xdbGX\xac\xff\xcf&\xc8gn(}>R\x96\x15\xf2\x86\xe3\xdd~5_\x06\xf9E\xd9\x1a;l\xf1\x97\x87\xcbM\x16\xc5\x8b\xc1\x16\xf5A\x95\xb8\xdeu\xe9\x1f`{\x0b\xc7\xbb\x15\x1c\xe6\xf7+X\xef\xe0q\xb5_\xc1\xd7\xf5\xeaq4{:\xf9=\x8b\x0d\xe5B?qT\x15M\xad\n\xd7\xf5\x97V\xe7\xe4#\xfc=\x9dD<\xeb\x90K\xa6\x93\xc9?\x9c@NM\xdd\x8b8\x98\xf5p$+\x8dm<\xf6\xc2\x18\xceF\xc1XL\xd6\xe7\x83\x96\xa3\xd9\x80GJ\x87)Y\xddKc8\x1b\x05#\xf1\x19\xd5\x90*\x07\xb3\x1e\x8a,\x16\x85\xfb\xa4+\x82\xd2\xa5\xb1\xa1\xb4s\x06\xfc&\x95Q`\xc2Cb\xce\x81\x1f1&\x99b\xc9\x9eKj\x9c\xd0)Y_\xab\xd4KS\x07\xc1Md R#\x9b.\xce\xc3\xae\x8a'A\x15\x05\x9c\xce\xb0\xde\x89\x0e\x7fVq\xe1\x95\xa0\x11;,!c\x17\x0b\xe4F\x87\xadH\xee\xc2S\xec\xcc\xe9v\x9f\xf7CY\xe3\xa9\xe6\xe9\xf7ht\xfc\xca\x1f\x8d\xf3\x0f\xd4\x066@\xb0\xd4\xca@A\xe9\xb34iDq\x175\xd6\xea\xe2=\xef\xdf\xcb\x10\x0d\xb6\xbc\xb0\"\xb5\x16E~\x87J\xc3hm\x878/\n6\x1br!:'J>\x87\xbc\xcb\xc5\xc9\x99\xc4d\x84b;6\xdb\xc1\x9b\xf4\xf9\xcc\x8e\xe3\x18\x9c\x10\xd1m\xc3\x87\xf4\xb8w\xd9x?\x02\xea\xb7\xe1LM\xe0\xde~\xa3\xe6]g+w\xb6il\xb5D\x1b\x97\x92\xb5\x98\xf6\x9f\x19Cc\xb3\x98\xf3\xd9\xa6\x17A'\xd4\x18\xa3)\xb7p\xe2M\x89]_\xa6\xaa@\xabG\x1d\xfdY\xd9\xd8\xd0\xb7x\x8a\xe8\xbeo\xf2yU\xf7\\\xe7\xe4\xcf\x8d\xedQ\xd1\xe9\x9a,\xa2\x03V\x11mS\x1f\xd1\x03\xbdD\xb4\xc4tpV\x8b\xf8\xdc\xe7q\xe8\xdfzO\x1d:6\x9d\xd9\x1f\xb1\xf3\xe71o\xba|k\xd3\xad\xa9\xfc\xd8\x88\x8d\xe9\xaf:\xf2\x9e\xcaP\xe7\x85 )\xa4\xb2)rW\xdc\x08\x8a\xacW\x05eB\x0b\x14\xbe0\xe1\xd0\xbd\x19&\x05\x02<A:\x9a\x1d\xddG\xaa\xd6#\xeb\x9d8\x16\x01Yv\xcf2<\x99y2\x05\x1e\x896\xaa\xcex$\xc4\xe0\x89\xa0`&j\xacq\xb94\xd7m\x87y$#\n\x9dI6\xbb\xc8Ihp\xd45\x86)+\xaa\xfd\x0d\xd5uS\xf1U\xb5f\x02\x9e\x94)P_\xc3mX5\x8d\xc3\xa3\x19\xcb\x98o\x94\xbb&M\xd1\xb9\xa7\xa6\xb8\x86c\x8eP\xa9\x0c\xa15E\x11\xfc\x0c5\x16\xd4_\xc2\xc6\xbe\xa8\xc2\xe8\x1bU\xf94W\xfc\x0ea \x8d\x14\xcb\x9e\x11+.\x93\xb1\xd9-\xb1\xf5\xfeB\xac@G\x0e\x9e(\x9a\xb0Pr\xc9n\xc2s\xd6\x1d\x1d\xc3/A4\xa5\\\x91\x10\xc6\x06\x15\xea#-\xfa.\xd80\x13\xeat\x1a\xf5C\x19\x0e\x8c\xaf\xc6\x19\xb2\xdd}\xceg\x08\xbc0\x17E\x8d\xf3\xf7\xca\xa7\xe1\x86Iv\xca\xb9\x96j\xed \xd0P2\xcf2\x8b\xed\x90\xd8\x03\xb61+\x19\xac\xc8X\x1fZ\xa9\xef\xb4]`B>\x17-\xc77\xc9p\x16\xf3?\xd0\x07\x89?\x1c\x8f\x1b\x11\xfd\xdbP<\x0c\xbfQ\x03\xb9zA8!Z\x88<kjTN\xee\x94\xbd\xa0\xc8jSc\xea\x17g9\x97\xf61~}S\x0c\xba\xe1\x93^k\xc7\x9fW\xe3S\x8d.\x17\x99\xc0\xc8W\xc5Y\xd8\x00:\x8ej\x1f\xc9\x80\"\xeb\x9b:\xe6\xcbH\xd8\xa6\x90B\x84\xf3\x1a\xf6\x1c\xf1\x80CU\xcb\x86\x1c\x04E\xd6\x85m[\xfd\xacL\xfc\xc9\xdcPf,D\x1e0\x0e\x888\xa7\xf6\x81\xbcI\xb9\xd9#\x92\x91\xe6T\x1a\xce\xf0 \x88Y\x9f7\xe5\xc9*S\x181\xdeq\x14__G\x07x\xaa\xa4/\xe5(L\x1a\xdb\xf9\xb8\xeb\xaf~\xcb\x94\x85a\x94\x7f5\xbb)mh\xaa\xa1\xf0_\x84\xb8(xS\x05\xdb\x05\x07\xb3\x82#\x08\xc7\xc8xxWSV\xa3\xe3\x02\n\x83\xba\xcf\xf4\xd5\"\x8f\xe3%\xa6\x93\xdf\xd3\xdf\xff\x05\x00\x00\xff\xffPK\x07\x087d	\x91\xc7\x04\x00\x00?\x0c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00v}\x96N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/en_GB/server.jsonUT\x05\x00\x010\xe1\xbd\\\xbc:]o#7\x92\xcf\x9e_Q\xd0!\xe7\x17Ev6\xfb\xb0\x98\x87\x00\xfe\x18O\xbck{\x1c\xcb\x93\xc1\"\xc8\x02TwI\xcd5\x9b\xec!\xd9\x96\x95E\xfe\xfb\xa1\xaa\xc8n\xb6\xe4I\x82\xdb\xbd{\x92XEV\xf1\xa3\xbe\xab\xff\xf5\xe6h\xb6v\xbe\x0d\xb3\xb7\xf0\xaf7GG\xb3\xab\xb3\x1ffo\xe1\xa77GGG\xb3kK(\x15\xb5\xb3\xd0)\x8b\x06\"\xbe\xc4\xd9\x9c\x91\xefl\xf4\x1a\x03\xac\x9d\x87\xd8 \xac\x94\xb5\xe8\x8f\x03\\y\xfc\xdc\xa3\x8df\x07g\xe1	k\xf8\xa1\xc7@4\x02\x18\x1d\"([\x83.(\xb7\xaeVf\xf6\xe6\xe8\xe8g\"<\xbb[^}\x1avp\xe7\",\xd5\x1a\xe1\xcay\xf8\xe4\xfcSb~\xee\x94\xafA\x19\xe3\xb6\x01Z\x15\xd1ke\xe6\x10\x1b\x15Ay\x04\xeb\"\x04Z\x17\x1d\xac\x10\x9e5n\x91\xb8\x82\x82\xad\xf3O\x80\xf6Y{g[\xb4q\xe4\xac\xccV\xed\xc2\x8d\xab\x9e\x06\xfeg\x0c\x02\x82\x11\xa9s\x17\xa3k\xd3\x1e\x18\x18*\xef\x8c\xd1vC\xe8Nm\x10V<\x07\xf0\x19-l\x1b\xb4\x10\xd5\nt\x80F\xd75\xda\x82\x9buv\xd7\xea\x80#\xb3\x01\"\x0c.u\xe8\x8c\xda\xd11\xa1s!\xa2\x0f\xa0\x02\xc8B\xd7\x87\x82V_k\xf7\xa33}[P#\x18<\x0bP\x08\xca\x0cpk\xe0\x05t\x1fm\x1ft\xc5O\xf2\xackt@\x0c\xd1\x87EI;\xba\x8d^\x17\xbb\xd4t\xe15\xbc\xbf\xbe\x82\xc7\xa6oWVi\x13\x12\x8b\x84ed\x1c\x91\x03\xb5\xd5\xe6\xb6\x8f\xe3&i\x00\xe7\xaaz\xdax\xd7\xdb\x1a~\xa4M$R\x8c\x13\xc9\x1a\xf0\xbc\xc9\x92\x9a,\xc8\xe4\xbe@\xe9\xacn\xb5\xfd:tX\xe9\xb5\xc6\x1aZl1@\xa7;\xac!D\xaf\xf4\xa6\x89\xa0mt\xb0s\xfd\xb1Gh\x9d\xd5\xd1\xf9\x82\x11\xc9\xdb\xbb\x97N\xfb\xdd\xc8\x8ce\x10\x19\x08Q\x0f\xb7|\xd7\xb7+\xf4t\xcb5\xc9\xceV\xc7\xc6\xf5\x11,n\xf9\x15\x03\xacp\xed<\x82\x02\xa6J\xb2Q\xa3\xc1\x88\xf5\x1e\xbf;U<\xa7p\xb3jd\xa3\xe4)\xe9\x86\x886/Y\xc0\x85\xb2P9\x1b\x95\xb6\xd0w$\x95\xdf\x9c\x82q[\xf4\x95\n\x087*j\x0b\x06#K\x93\xf3`y\xb7\xe5\x833\xa1G\x1d\xcd>\xf3\xc80\xe1\xce\xf8\x03\xf6s\x08}\xd5\x90\x90\x1e\x93 \xf4\xf0\xdfp\xab\xec\xc6\x1d/\xe0c\xda\xcb)T\x8d\xf2\xaab\xfe\xc6\xd9\xcd\x84s]^o\xbd\xcb\xcc\xf0%\x02!3C\xba\xc7qY\xa5\xbaX5jXy\x91\xc6\xe9\xf1\xc3\x13\xf4\x81\xb8E\x07\x95k;\xbajP\x90V\xb1\xfd\xaa\xd0\xf3\x85E\x15\x9e\xc8J=!x\xdch\x92\x0d6Q\xa4\x1f\xb1\xf1\xa8j\xa8<2\xec\x80\xfd\xa3\xda\x84\xfd-@$`:\x84\xda\xf0\x16\x82\xeb}\x85\xc26\xcd\n\xce\xf4l \x17\xf0\xc1\"-\"\xa1\xf0\xca\xd6\xae5;\xa8\x1a\x17\xd0\xf2\nTU\x03\x1b\xb4\xe8Y\x05\x13\x81\x05<\xe0\xe7^{\xac\xe5\x8c\xf2\xfa*\x82A\x15\"|\x0b\xb5^\xaf\xd1\xa3\x8d\xbc\xa1\xe2\xc6\xe9-\x96\x95\xf3\xe3S_\xe4\xd7\x81\xd0\xa9\x16\x02#\xb3nG\xcd\xc0-\xb2\xc6\xb85\xd9n\xbd\xde\x91\xf9S\xe3\xbb\x8a\x9d\xa57Z\xc0\xd9\x9a\x00\xf8R!\xd6l%\x1b\x04\xa3[\x1d\xf9\x1f\xbd\x0bl\xb51`Q6\x1f\x9cy.^\xa7\xdcj\x1f\xa2k/\x96\xcba\xabi[\x1f\x03\xfa\xafk\\k\x8b5\\,\x97\xe0{\x83$[\xaa\xc6\x1a\x9c\x85\xe8\xba,8\x01\x0dVts\xb1\xc1\x16_!\xfe\xe86\x9bB\xf0/\x18NT\xf3+2\x9e6N$\xab\x11=\x90\xaaq\xadz\x13\xcb\x8d^\n(1M\x84\xe8?\x9d\x98\xf6	\xab\x1d\xa4usq\x1a\xd6Y$!\x08\x18	I{\xaf\x8c\x9e\xb8\xab\xb4\xe0F\xd9\xcd\x01'\xa3\xec\xa6W\x9b\xcc\xec&\x0d\xa1S\xe2\xc8\xf6\x98\x96D\x03\x9d\xc9\xd3\xda\x91h\xe8\x97	&\xf4\x8aY\x0b\xe77\xa0[\"\x1eP\xf9\xaa)h\xe9\xa0V\x06\x1f\xdc\xca\xc5Q5\xee=\xb9\xc6\x08\x95W[\x83>\xabG\x06\x93\xbb\x11\xff\xb2\xc5U\xd0\x11\x87\x89\xa3q\x11F\x80v\xa3\xe9\x96l\x8d/\x8c_{\xd7\x82\xaa*\x0cAdM\x87d\x16\x0f6EB\xc3Vm\xdc\xd8\xa5`\xe8\xea\xbfV\xe41\x92}\x1e4~\xb2Q\xf2\xc3;b\xe6z\xdau\xa7|\x14\xfe\xf4T\xc7\xbc\xfex@3B\xe8\xd8\xcdh.\x0b\xbfX;;^\xf8\x95\xb6:4pOVN\x98^\x18G\x12\xd7\xa1\xdd\xb3}\xac\x89+e\xcc\xb0\xf8\xbf\xfeBCP6l\xc7\xdb\xbd\xa1\xc0\x8b\xfc\xbe@\x87\xa0-MnTh\xc86\xb6\xca~\xc1\x85\x9c\x0eK\xc9\x16\xfe\xe9tj\xc7\xa3\x8b\xca\x14\x97\x8c\xad\xd2\xe6\x9d\xf7\xc3\xa6\xde\x11\x00\xd0{7l)i\x12O\xa5[\x11\xe4+Dn\x956SB9\x02\xa5\x15B\xe0\x95e\xf7*\x14B\xa7B\xd8:_\x1f\xae\x84.\xa1^#\xe1|\xdc;B@\xff\x8c\x1e:\xc2\x1c\xd2*\xb0\xaf\x90[\xf6\xabW\xa9}\x99P\xe8W\xb5k\x95\xb6%\xb5\x97\x06\xe9mFR\x19 d2\xfe7\x14\x13_:e\xeb\xb3Bh\xde1\x04\xce\x8c\x81kZ\x92\x1f)\xc1IF\x98TX\xc0'\\\xb5s\xb8\xbf\xbcbI\xb8\xbd\xff\x96\x7fw\xae\xf7\xe0\xb6\"\x9e\x14\x82\xdb\xe3\x08j\xbdfk\xbb\x80\xbb\x14\xfc$*\x1c\xa3+\x13\x1c\xc8Vpr\xfb/\xdd\xe4\xde_\xca\xcb\xe6\x01\xb8N\xf2\x89\xe8`\xadMa\xc7\xd7\x885\x85\x8b\"&\x83>%\xa8\\n\xe16`=\xc1\x10\xbd:\x05\xdd\x14\x0d4\xc8\xbeC2\x9b\x82\x89\x99\xb8z\xd2p\xbf\x03\x81\xce\xb3)a\"\x12\xb5CUN!\x8f\xc4q`A\xd0\xf9\nk\n\xff\xc7-3\x08\xce\x86X\x7f>\xb1Q\xec8)\x18\x0cs\x88^w\x95\xabQT\x93\x0f\xf2\x1a\x93\x8d2\x06\xfd\xee\xd6\xd5\xa3\xa1y/0`\xa0p\xf8`\xcd\x0eB\xe3r\xb0\x9a\x0c\x01i\xa8\xbc]A\xd1\xb9\xd2W\xbe\x97\xe1\xbc\x18|A\xfeR*\x94\x17~\xcf\xc3\xb7p:\x18;T>\xe5K\xfb\xa7ht\x8d\xe7\xdaZ\xac\xcb\xe5\x08\x97\x12A\xb3\xcd\xcc\x97\xc5\x88\x9c<\x05I\x0e\x1b\xf5\x8c\xb0B\xb4\x8717\x91\x9e$\x9f\xbc\x9e t\x9b'\xca\x98\x93\x92\xb0Xp\n\xa86X\x93W\x1arN2\xae\x9ce\x0e\xfe\x80\x17C\x8bQ\xf1\xaa)\xcf\x07\xacz\x1f\xf43\x9a1\xf8\x1d`\xa25\x8d\xa6\xe0\xe9\xd5c\xa5\xa4\xd7cg\xb4\x84P\xaa\xbc:\xf0\x05\xf5	[N\xdd\xc2\xf4\xb0q?\x9d\x1bRP\xf8i\xd9\xb8\xed\xcf\xb0\xeact\x96\xb4\xa33\xaaJ\x19\xc0a\x9a\xd78\xaf\x7f!\xc91\xf4 \xba\x88P\xbe\x1f0\x90Q\xc2\xea\xdb\xbfT\x8d\xa2\xc0'De6Z\x8d\xc4\xf4\xf8\xd67n\xa3\xb3#.&\x90\x94}\xef\xc8\x94\xe6\x89l\xc5\x80a\xc0F,\x8c\x1e<\x1fJ\x84\xb3\xf3\xf8\xacq\xcb*\xd30\x8d)\xdd\x07\xe7\xe2\x87g\xf4^\x17\x8a#\xe4\xbds\x11\\\xc6	\xf1\xeb5\xd9B\xd8\x92\x03\x8f\x0e\x9a\xc2\xe8Ix\x02\x01;E\xe1;\x18WIz\xc1	\x87\x82\x8b\xcb\xbb9\xa0%\x8bAB\xb3\xee\x8d\x11\x16\xaa\xae=\x86\x00\x0dz\\\xc0\x0d\x92\x0cc\xdb\xc5\x1dq\xa0P\x94\xa6\xa7P.O^\xc0\xbb\x17E\xc9\xce[8nb\xec\xc2\xdb\x93\x93d\xc2[\xdc\xf4\x95\"\xffp\xbcw\xd6i* \x87\xfc#i@'/	\xca\xcaY\xff\x93q\xbfn'\xee\xe0\xba-\xdc\x81\x0c\x06w\xc0\x17<u\x08\xda\x1am\xf1J\xc7\xa9\xab+\xa4!\xb9\xb8\xf4F\xda\x06Q\x04\x8a\x959c\x12\x87fk\xf0\x18\xf4/\xc81\x9d\xafS\xc5'`d\x11\x1e\xf9}\xaeG'\x7f\xfd\xc3\xe5y\xe2B\xf0\xdf\xf0\xc8\xffT\\p\x18\xd5\xf1\xaf\x190/\x87C@y}\x19\x16\x90\xe7@\xa5,8\xb2\xdbb\xd3\xc40\x14Wh\xca\x14\xe1f\x9a\x1a\\4\xcan(\x88\x8e\xe8\xd7\xa4\xd1C\xea0.\xd7\xf6)\x14\xf7\x17\xd1[e@\xc0I&\xeaz\x0e\x1e[\xf7\x8c@AL\xad#`\x9e\xf8\xddw\xdf\x9d\xfc\x99\x94\xfb\xe4\xeb\xb8\xeb(\xb5\xe6d\xb4*}I\xabB\xe5\xc6G\xba\x95\xa1\x10O\x91\xa2L\xc9\x0ez\x7f)\x0b\xeb\xb0~\x90\x0f\xba\xec\xa4$*\xf0\xb3&N\xc5\xf2\xd8\x17B\x7f\xcbCv{\x94m%:\\\xae\x1cE\x97\xfe\x0c\xe9\x898H.C\x16+\xc9\x9c\xac\xb5\x0f\x11\x9eu\xd0\x13~/\xdf\xb3\xe2\xec\xe9Y#\xda\xc4J\x92\xd8\xde\xaa\x17\xdd\xf6mF\xb95\xf4]Jk\xf7}q\xab^\x96\xfa\x97\x03\xdd%\x81}\x8d\"#\x0e\xe9\x91]\xbf=\x9fP\xfd\xa4\xeb\xd8\xec\x91\xdd\x12\xecU\xba\x82\xf9\xcd\x8d\xe2\xa6\x7f\xfcq\xbcn\x19\n\x95{\xb2\xcaR\xf6\x10\xf7\x9akv\x95\x14\xfex\x7fdo9\xb6\xe2J\xe5>\xe1e\xe3|\xac\xfa\xf8\x7f\xc1\xc0\xd5\xe8\xd5DKoG\xd0|\n\x98j\xea8\x8fu\xb5T\xd39\x85\x97cy\xd7\xd6$\xe0dSz\xf2 \x19.%\x00\xed\xa1\xb5\xd8:\xab+\xa6;\xee\xcd\x96\xa5\xc2\xbb\xbd\"!\xc5\x041\xaa\x8a\x1d\xd2P\x86,\x16\xe3v\xc8\x8f\x06\x1a4m\x9a4M\x17P\x16ZN.\x92\xd5\x0f\x94\xa6f>\xc5*\x17uU\xecR\x86\xb2\x84\x9f\xad\xec\n(\x03-\x86@\xd2\x96\x14\x9e\x82\xac8\xc4\xe4\xb9\x1081T\xc4a\xad\xc5\xa9\x0e|.1<\xd1\x8a\xbb\x02\x99\x9f\xeb=F\xa8\x13\xbe\\\x1c\xa4\x08\xf3\xb9w\x14S\xd2kB\xd8\xd9j\xabb\xd5\x80\x0e\xa0V\xae\x8fl\xff\xa3\xf2\x93#nI\xc4\xcax\xe7\xcem!\xc1\xe0\\\x12\x89dx{\xef\xa5K\xd2%tpvCf\xc3\x9f\xa8\x93Z;1\xa4(\xd5G\x17\x1b\xca\x07\xa3G\xd5N\xba'\x07yJ!\x15\xce\xd4\x07\x0f\xfb\xc1\xd4_~\xd8\x1c\xcb\\\xbcR\xd2\xcc1\x10\xe4\xda\xe6\xe3\x98\xef\xdc\xaa\xae\xa3\x13\xb8\xf5\x10\x16;.5\xee\xd9\xde\\\xe8\xe4\xaa\x1c\x8d\x87\x92\xfc~\x99\xc6m-\x16\x8a\xf6A\x86\xf3q0U0)P\xcb\"\x89\xf19z\x92Z\x10+\x801\xb9\x9c\xe3l\xf4\xce\x90V\xf6!\x1eVK\x9d\xc5b\x1f\xdd\xfe\xfd\xdd\x7f\xe9\xeeH\xd8/R\xa9h\x1aG\x91j\x0cU\xa4?\x14O\x0d\xa5\"5\xe8\xd1\x7f2\xa4\"z\xd7\x1c\x18I\xf03\x9aw\x06\xb2.\xc3\x8d\xb6O\x07\xa1s\x9a@\xae\x1fk	\x8dz['\x8f\xc8CB\xd1\xf3VFWO\x0b\xf8Dz\x94jn\xf5\x1c\xacz\xd6\x1b\x15Q\x04\xa4\x99R\xd26DT\xa5\x14t\xbe\xb7\xb8W\xa6\xbb'X\xae\x9f\xcd\x93\x8a\xb3=\xcd\xa27\xe4y\x94\x915\xaa\xe6J\xdd\xd8~\xa1\xf4\xec\x8e{3{\x8c\x1e\xb9\xc2\xbf\xcf)&\xe8\x84U\x02~\x81\x97\xf0\xd1\xf6\x90\xcd\xae\x1f\xa8/M\x1f\x01-]L6	\x97\x8e\xab&\xe1i\\\xe1W\x8f\xf82Z\xda\x07\xacO\xceM\x8f\xf086bs:\xe3\xc9B\xda\x1aV\x84\x8f\xf8\x12A\xafA\x0c\x05\x97Sul\xe0\xf8\x1f\xfe\x98\xec\xca\xf1?VE\xecOg\xa1\xbc\xbf`\xa3j\x8e(G\x16\\v\xe8JI.\xd7o4\xf9\xa9b}\x02\xec+\x89G\xa3\xa2~\xc6G]\xf8\xab\x87\x04\x04\x82\x86\xa8\xda.\xdf\xf6\x80a\xc6q@Sr\xb3x\x0b\xc7\xdf@\xe3z\x0fj\xe3&\xa7\xe9P\x95W\xc6\xc3\xc3\x9dtf\xf70\x89\xc3~z \xd8\xcfd\n\x04\x91\x8c\x1b\x05\xb5\x8c\xca\xd9o\x92]\xcf\xea\xca\xf9\xc2\xab\xee\x88r\xb7\x8f\x0f7\xe3N(\x97#\xc0|2\xcc\x8b9RJ]\xbc\xa1\x9fC\xc2\x1a\\;\xad\xa4@\xe7\x1d\xc5+\xd2\xd3\xda:\xffT\xe8\x0cw?F\x9e<\x12\x86b$\xa5;rX\xe9\"\xb8\xf4\xe2a\xabm\xed\xb6#\xc9P\x86\xd6\xcb1w\xb8t,\xf6\xab\xbe\xed\xc4	I\x8b,]O\xe1\xa6\xc5\xf2\xa6\x9a\xd4 @\x8b\x92\x83\x19\xdfa\x89\x15\x05\xd2\x0c\x9b'\x9e&\xcaU\x08*\x97\xbcX\xde\x87\x90(\xf5\xc5\x882<`\xe5\xda\x16m-\xb6p\x85\xa3\x81\xff\xf3o\xb5 \x83\xea+\xb4\xca\x15\xc7\xed+\xbcS\xb9\x95\x9c\xf1\x8b\xca\xb5_\xc8\xe5\x02\x06\xb2\x98{\xdd\xe2\xb3\xe4\xae\x126\xf5\x8d\x13U\x12|z\x07n\x18\xf76j#\xf6<\xf9\xb8T/\x95\xce\x88\xae\x941;0\x8e\xebN\xae/\xc2\x8f\xd09mJ\xb7)\xe1\xfa2\x83K+\x93\xe6\x1e\xc4\xe7!\xaa\xf5z\xda\xfd]\x12\x08\x1e\x8b\xeeo69\\\xf5\xe5\x15\xd2\x1c\xce\xb2$E+Tu\x19?\x93I\x9aX\x19n\xeb\xbebe(\x83O	D\xb11i\x9e\x0dk\x8bV\xda\x92\x9bz\xdc\xfa\xdbk\xeb\x89@\xcaK\xdc\xaa\x971n\xa7tE\x99,\xb0\xffN\x0f?\x91x\xad\x89?\xe1\xae\xc7\x88\xf4V\xdb\xff\x0f\xee\xbf\xd3\xc1\x97\x90\xbb\xc6Py\xdd\xb1\x91\x8deO\x7f\xafP\x199\x07O\x92\xf4\xba|eY\x96l}\x90/\x91\x88WZW$\xe1\xe7\xef\x07Z\xa9\xd5:~\xc21\xa5\x97Z\xad\xf2\x89\xcd8g\x8f\xda\x1f\xae\x02\xbcF\x82\xc3\xfb\x14\x04|\xb0l\xf1\x07R\x9f8\xf4\xcfn\x9f\x82tF\x0b\x83\xb3\x89f\xaa\xba.,!\x13\xc5\xfap\xe9\xc8\x16W\xed\xb4x\xf9	W\xb7\xbfS\xbb\xe4)\x07\xa5\xcb\xc1q\x04x\xb5\x00\x9a\xe2\x8d2\xc4\xda6*\x9e\xd9\xd2\x1d\x7f\x1a \xc2\x92f(\x1a/6\n\xf8\x0f\x84\xca#\xda\xd0\xb8x`\xfdv\xca\xd68j\xda\xdfe8/\x06_0\x9b\xe4\xc6&\xcd\x89O\xce?\x91C\xca\xfb\xf8^\xd7\x18\x86\x06\x92$\xcat\x9a \xb6\xf2\xd5\x17M4\xf7\xba\xfa\xff\x1e\xe57G\xbf\xce\xdf\x1c\xcdR\xd11\x7f7g\xa5\x81+\xbfbI\xf9\x92\x08\xb6\xd6\x92,\n@v\x96\xca*\x19'\xe3D:8\x1fi\xdb\xd9\x90\xcf\xce\xd9\xc3\xe6'\x99\xdd\xa8 \x15\xff\xc2j\xccr\xeeQ\x80$ha\x17\"\x80+\xb2\xac2~\xc3W4\x8bj\xe8\x01\xcc\xde\xb3\xff\x94\xfe\xd8l\x19w\xc9Td-\x97\xf7\x12:}:F.\xb7\x84\x81\x1e\xb6\x9d\xa1\x00?\xdf\xcbJ\xd9\xfbR'\xff\xee\xfa\xa2	\xc3)k-\x85\xdb\x93\xaf\xc2	\xacv\xf0\xd5\xd8\x9e^;c\xdc\x96\x92\x1d\x8f*8\xfb6\x0b\x12\xf9\x9d\x95\xb2\x92\xf0\xb0\x01ER\x82\xaf\xf8\x93&M\x7f\x16Y7\xcbr-\xbb+n\xad\xce\xc5w]\xdf\x93\xed\xfc*\x95R\xd2\xf5\xf7z\xfa1d\xf9\x1d\xa4\x1c;\xf9e\xc2e\xbf\xce\x917\x1b\xd9VY\xb5\xc16W\x0fg\xaa\xaeyb]\xa7q'\x86ev\xd6e\x132S!\xe8\x8deG\xcb\x18\x1e\x8ag\x95	+\xc5\x92t\xae\xec0\xb6\xe8\x97\x1dV!\xed\x02\xbb\x18R\xbb\xfeO\xa7\xf0\xd7\xfbw\xef\xe7p\x7f\xf7~\xce\x9f\xe29\x0f\x9f\xde\x9d\xdf\xb2k\x15\x87\x02m*\xd9\xd5\xbaE6\x0e\x81L\xff\xb7\xa7\xa7/\xdf\x9c\x9e\xce\x074;\xe3\\.\xfc\xe6\xf4\x14\xfev\xceg\xb5\xfc%\x93\xad\x17i?|\xa2\xf3t\x9c\xf1\x8b\xac\xc9\xc7X\xb3\x8a\x8b\xcdEM\"\x97\x9f'\x15\x89Ye\xe8>*\xc2\xe7\xbf	\x8e\xca\xf3*\xfe#0g\xd7z\xd3{I\x13\x19\x99!\xc9\x87M\xa7-\xa5\xe5>\x99W\xb4\xe1g\x1c\x9c\x16\xc4xXR\xaaU\xe4\x83]\xd2\xaf@\xd8\xf5\x0eK\xcal\xb4\x9c\x90}S\x9e\xc0\xf6\xa6\x9c\x90jj\x19\xdf\xe5\x8a\xda\xac\xee}\xaei\xcd.\xf3\x7f\xc6\x88\xe8\xb3\x08\xbcK\x7f\x19\x9e\xfb\xda\x84\xc8\xdd\xef\x84\xe9\xab\xa7\x0f\"eW\x1f/\xfe\x06\x1f\xae\xae\x04\xb11n\xa5\x0c\xc1\xdf\xcb?\x86r\xefmv}\x99Gh\xa3\x8e\xfc\xd6\xd7\xf9\xbf`\x8c\xc1\x8d,\xbf\x96\xbf\x93\x12\xfa\x8c\x82\xb9\x8bB*\x8c.>D\xaaJ\x19!\x88\xb6\x9b\x03\xd1V\x07\x82\xfc{r\xfc\xed\xffB\x8e\x8d\xdb8\xae\x1e\xcfn\xe4_\x01\x95\x0f&\x08Aa7\x97\x94j|\xd6U\xbe\xf4\xbd\xf2\xe3\xac\xac8\xca\x8c\xd1g\xcc>tc!r\xe6\xb6\xf6\xce\x0du\x0e\xb6\x915G\xe9nk\xb9\xa2P\x94;\xb8zC\xb3\x86\x8a\xeb\xac\xeb\xfdf\x90\x9e{\x1a\xb0\xf0\x9c\x14\x12&\xde\xf6\xd19\x13u\xc7\x8f\xaf\x8d4\x1a%,Y\xed \xf4\xab\x7fb\x15\xe7\xe9KK\x9f\xcc\x99U-\x02\xdaJ\x05\xf9\x82\x9aD)\x18\x15\x1a\x0c\x0b\xc8\x8f\xf3\x80\x9b\xde(O\xa6\xd8K\x82\x93\x0c\xf0,`\x94\xb2'\x9fl\x891\x95)\xc3\x80\xbe\x91\x07\xcf\xe8\xf4\xfe\x1ci\x14W\x17\x1aE9\xe9[\xc8\xff\n\xe89\x07\xd7\xb3%\x0f`\xa5\xf3\x92\xe4F\x19G\xc1\xeex\xd84Ab\xd4A1\x97eN\x94\xa6\xc8\xa506\xfd\x15\xf8\xceV\xc9\x88X\xac\xa4\xb0\x17U\xec\xc3\x88\xbe\xc8.\xe2\xa3\xd5\x9f{n\x0cY\xf9\xf8PU\x14o\x9f\xf0WS\xe4\x82\n\x0f\x1d\xa5\xda3\x1b\xea;\xb3(\xb1\x19\xe7\x88	\xb2\xeb\x04B\xbf\x0c\xe9m\xf2\x0f\x1f\xf9\xcf\x9b\xa3_\xdf\xfc\xfa\xe6\x7f\x02\x00\x00\xff\xffPK\x07\x08\xd8\x10\xf2\x02\x9e\x10\x00\x00\xdb/\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xef\x9d\x8cN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/es_ES/common.jsonUT\x05\x00\x01R\xeb\xb0\\\x8cV\xddn\xdb\xb8\x12\xbe\xb6\x9fb  h\x0b\x18\xe9}\xef\xecXi|\x8ec\x1b\xb6\xdb\xa08=\x17\xb48\x96\xd8P\x1c-IE5\x8a\xbe\xc8\xbe\xc6>B_l\xc1?I\x0eP\xec^i\xe6\x9b\x8f\xe4p8\x1f\xa9\x1f\xd3Iv&]3\x9b}\x80\x1f\xd3\xc9$;1\xa5\x90g\x1f [\xcc7\x9b|	\x8b/\xf0\xe6\xc6\xbc\x81\xfb\xed\x1en\x8c\xff|\xcdn\xcc\xd7,\x9b9>G\x896\x0cX\xe6\xeb\xfc8\x8c\x08qQ\xb3\x12\x97\x03i\xf58\xff\x98\xc3\xef\xa9\x87\x86\x84D=&\x1fv\xdb\xd5:\xdf\xbf\xa6+\xecvd\xacY\xa9c\xa5\x91\xf9\x017\x1c\x14v\xd08\x1c\x84\x02\xeb#\xb7a\x80G\xe7\x8a\xaf\xdc:f[\x0b\x1bs\xba\xe1a\xc4[\xf3\x0e\x98\xe2p\xc3\xc1\xe7\xe2|\x8a\xb4a\x86\xdf\x0c\xbc&\xb6\xbaD\xee\xd2s\xb4\xdd\xf6p\x84\xdd\xa7\xfd\xc7W\xe5\x1c\xd7\xd1T\x8cS\xb7\x10\xa9\xfa\x87\x87\xf9r\xfb\x04\x8b\xd5\xbf9\x84\xb0\xcd5\x15\xcfG*K\x19f8>\xec\xf3\xf9\xd2\xd1\xaf\xca\xd6\xaa\xe1\x88?m\xae\x0f90^\x04v\xc8\x0f\xac\xc6\xd5.\xa5\x7f\x80\xed=\x1c\x1fr8\xcc\x1fsX\xed\xe0)\xdf\xe7\xf0y\x95?\x8dFO'?g\xb1\xa1\x8c\xeb'\xef5\xb2\xd5L\x9a\xd4_\x9c]\xb2\x0f\xf0\xbf\xe9$\xda\xb3d\x99l:\x99\xfc\xdf'PQ\xab{\x92wf\xbd9\xa2\xd5B\xb5\x16{btg#gL&e\xab\x81\xeb\xbd\xd9`\x8f\x98\x06\x0bR\xbc\xa7Fw6rF\xe4\x0b\xb2!U\xef\xccz3\xd0bQ|\x9f\xa4\"0^\x0b\xe5J;\xf7\x86\x9f\x89\x95\xe4\x10\xf7	\xbe\xcf\xc1\x7f\xa2Oa\x88\xfa\xf5\x97\x12ud\x15\xa4\xacf\x85\x0d=\xed\xe2w\x11\x81\x08\x8dT\xba\xb8\x0c\x87\x1a$	LJ8]`\xb5\x0b<\xfc\xde\xc4u\xe7u#E\xdcN\x84\x875r\xef_\xadP	\xee\x8e\"{p\xdf g\x9fn\xda\xde7\xa6\x84%\xed\x87?\xa2\xe01\xffo\xad\xb1\x1b\xea\xfcN+\xd2\x0cja\xd2\xde$\x15\xcf\xa1O\xa3\x15\x0f\x92\xa3f\xfdT\xc9\x0bA\x1a\xb4y\xa5G\xea\x14\x86\x01\x0bb\x9a\xc3\xd6\xf9Qx\x88s)\xc3\\\xc6j\xa6\xc1\x12'\x93T\x19r\x8b\xa1\x08\x86+\xca\xab4\x9aA\x85^{\x07+\x8a\xe7\x8b\x17\xa0\xf7\xc1\x04 \x8ao\xd8To\xf7\xa2\x1b\x9f\x8f\xb3\xfac\xb9P\xeb\xb0\xb7\xc7\xf6]\x12\x99\xb9\xa8\"6^\xc6\x85)H),\xfa\xfdFW\xa82\xa6|Q\xc5\x95\x93\x88\x1c\xa37\xf5\x0d\x9dYQc\xea\xd2\x82IT|\xd4\xdf\xb9J\xf2\xba\xc7S\xb4\x1e\xfb\x96\x9f\x9ft\x8f%]\xff\xa7U\xbd%\x13/v\xf8$;`\x13\xadma\xa3\xb5\xa1\x97h-E1\xe8\xacC|\xee\xf3XR\x1d9\xeb~\xfe!\x8fG\x81\xfd\x9a\xc9\xfa\xdcc\x07v\x1a\xcb\xb2\x15\xfd\xc3G\xd6R\xed[\xff\xc4\xbe%y1U\xa0o\x8e;o\xa5\x16(\x98e\x92\xca\x10\x08f\xc0\xa5pw\xf0\xdd0\xcc\x01`	\xe2L\xb3\x91\x1a]'\x8e\xa4x\xf2~ \x90\xf2bZ\xd5\x0di\x0b\xa6-\n4\xe6\xdcJy\x0b\xc7\n\xa1a%B'\xa4\x04E\x1dh\x94\xd4?rg!\xf1H\xb4f\xba\xf4S8\x1f,\x11H\x8fD\x8e\x12\xa6\x8a\xaf\x04\xeaZ(\x96\xf4X\x12\xb9\x1e&\x15\xf6\xe6:\x14\x1a\xa6\x19\x84\x00\xd3 \x99\xdf\x8b\xa0(l\xe1s\xbc#\xad\xdb\xc6\x8e\x92>3!\x91\xdf\xc2\xbdK\xa0\x88\xe1\xd1\x88\xe5\xef\xf6\xf8\x8f[\x14\xea\x85I\xc1\xefXc\x8b\x8a\xf99\x02\x02E\x84<\xed\x19\xb1\xf1\xa5\x15\xaa\xbc'/\xd7\xff\"6\xc0#\x06\xe7tkH\x16\xde\xe9_\x7fJ+\xea\xa4}\xb7\xf7\xe1\xcf\"\x8a9\xbc\xb4\xe0b\x03\x0b\xf9\x91\x16}\xfb\xec\xb0d\x9c\x80I8\x93Ju\xad\xdd\x95\xf7Y\x18A*\xfd\x15\xf8[\x10^<\x16I\xad\xb1\x8f\xcc\x16\x95\x9f\x86\x19\xd3\x91\xe6\x06\x1c\x0c\xb5\xc7=Ma7\xe4\xb5i\xf1\x85\xe0A\xc8\xb8PCBY\xd7\x81}\x83\xee\x1c\xe2Z\xf0\xaaS\xfd{4\xdc\xe8\xfeO\xea}\xf0\xdf\x1f\x8f\xeb@\xfa\xa3\xa5x\x9b>0\x03\xc6\xe5[\x08\xdb7\x8bFf\xc2\xa3\xb4\x0fVD\xb9\xd0X\xd8\xc5%\xdcd\xfb\xe8\xbf~k\x06\xde\xb0\x9b\xd7\xdcP\xee\xc4?k4U\xa0\x053\xe2\x8d\xbc\x04\xd44-\x1a\xcbz\x9c\xb4\x0d\x01g%\xedj\xb4\xad\x8eY\x97\x1aM\x8f\xb72\xd4b\xef\x0d\x8f\x19d:\x1c\xc7\xa25E\xff\x00\xa0q\x87\x96\x7foD\xfcQ]S)\x14D\x1c0\x06\x02\xb9\xa2nCV\x14\xbe\xdb\xa3\x15\"\xed\xa9\x16>\xc1C\xb0<j\xab\xb6>)&\xa4\x08\x1a<\x8e\xfc\xdb\xdb(\x01K\x8d\x8b\xcd\xb5\x16\xa7\xb8\xddV%Y\xa7\x06\xfbB-T\xec\x05\x81)\x18\xa2\xfe\x8f5\x0d\xe9\\W\x0d\xe5\xff\x14\x80\xab\xb2\xb7\x8d\x93\x9eS\xb1gx\x0f\xdc\xad2\x0e\xef4\xb9R\xfa\xf2\x05\x04y\x9f\xec\xabE\x9e\xc6KL'?\xa7?\xff\x0e\x00\x00\xff\xffPK\x07\x08\x9eLe*\xf6\x04\x00\x00\x86\x0c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00v}\x96N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/es_ES/server.jsonUT\x05\x00\x010\xe1\xbd\\\xbc:\xcbn$\xb9\x91\xe7\xee\xaf\x08\xd4bV\x80Q#i<>\x18}0\xa0g[\xb6\xa4\x96%\xb5\x1b\x86a\x03Q\xc9\xa8*\xb6\x98d6\xc9T\xa9\xda\x98\x8f\xf1q\x8f\x8b\xb9\xed\xb5\x7fl\x11A2\x93YR{\x06^{OU\x8c\x08F0\xc9x\x93\x7f{\xfdj\xb6t\xbe\x0d\xb37\xf0\xb7\xd7\xaf^\xcd\xce\x8f\xfe0{\x03\x7f~\xfd\xea\xd5\xab\xd9\x85e\x14F\xed,th\xc9@\xa4\xa78\x9b\x0b\xf2\xccF\xaf)\xc0\xd2y\x88k\x82\x05ZK~/\xc0\xb9\xa7O=\xd9h\xb6p\x14\x1eH\xc1\x1fz\n\xcc#\x80\xd1!\x02Z\x05\xba\xe2\xdc:\x85f\xf6\xfa\xd5\xab\xbf0\xe3\xd9\xf5\xdd\xf9\x87a\x05\xd7.\xc2\x1d.	\xce\x9d\x87\x0f\xce?d\xe1\xc7\x0e\xbd\x024\xc6m\x02\xb4\x18\xc9k4s\x88k\x8c\x80\x9e\xc0\xba\x08\x81\xe7E\x07\x0b\x82GM\x1bb\xa9\x80\xb0q\xfe\x01\xc8>j\xeflK6\x8e\x92\xd1lp\x1b.]\xf30\xc8\xbf\xd3\xd4v\x9e`a\xdc\xa7\x9e\xd0\x03\x82A\xe8\xd0G\xe2o \xaf\x9d/KJ$\x10\x1a\xef\x8c\xd1v\xf5\x12-(J\xc0\x95\xb6\x08\xda6\xa6\x0f\x0e\x9a\x1e\xadr\x82\xa0\x10\xf1\xcb\x7f#\xf0/Ph\x9cUZa\xb5F\xeb\xec\xb6\xd5\x81\x86%\x1eYgu\xab?cY\xc8\x15o\xb7G\x88N\xb9\x00\xc6\x05\xe8\\\x88\xe4\x034\xaeu\x80\xf6\xcb\x8fV\xb7\xae\xe2\xd9+\xed\xfe\xe8L\xdfV\\\x19\x06\x8f	\x98\xf8&\npK\x90	\xbc\x9bm\x1ft#\x07\xfa\xa8\x159\xe8\x0cn\xc9\x87\x9aut+\xbd\x1c\xd8\xde\xaf\xfbvaQ\x1b\xde\x88\xb7\x17\xe7\x80V\xb7\xa8\\\x16q\xc4#\x88\x85(d\xaa\x91\xdfbu\xd5\xc7q\x95<\x80cl\x1eV\xde\xf5V\xc1\x1fy\x15\xc36D\xca\x8a9\xe0e\x955\xb74\xa1\xb0\xfb\n\xa7#\xd5j\xfbm\xe8\xa8\xd1KM\nZj)@\xa7;R\xc0;\xadW\xeb\x08\xdaF\x07[\xd7\xefy\x82\xd6Y\x1d\x9d\xaf\x04\xb1\xba\x9e=u\xdaoGa\xa2\xc2$@\x88z\xd8\xe6\xeb\xbe]\x90\xe7mV\xb8\x0d\xb0\xd1q\xed\xfa\x08\x966r\x8e\x01\x16\xb4t\x9e\x00A\xb8\x82\xe6]2\x14I\xed\xc8\xbb\xc6\xea<\x934\x8b\xa3\x18Lg\xc9;\xc4\xbce\xca>\x9c\xa0\x85\xc6\xd9\x88\xdaB\xdf\xb1\xf9|w\x08\xc6m\xc87\x18\x08.1j\x0b\x86\xa2\xe8\x93\xf3`e\xb5a\x7fG\xf6\xbd\x8efWx\x14X\x92.\xf8g\xe2\xe7\x10\xfaf\x0d\x18`\x8f5\xa1\x87\xff\x84+\xb4+\xb7\xb7\x0f\xef\xf3Z\x0e\xa1Y\xa3\xc7F\xe4\x1bgW\x13\xc9\xaa\xde^\xb5-\xc2\xe8)\x02#\x8b@\xde\xc7qZ\x83]l\xd68\xcc<\xc9\xe3|\xf8\xe1\x01\xfa\xc0\xd2\xa2c\x03\xeax\xab\x01!\xcf\x12\xf7\xd7\x90\x97\x0d\x8b\x18\x1e\xd8\xc9=\x10xZi\xd6\x0d\xf1pl q\xed	\x154\x9e\x04\xf6L\xfc=\xae\xc2\xee\x12 20\x7f\x04\xaed	\xc1\xf5\xbe\xa1$6S\x05gz\xf1\xaf\xfb\xf0\xce\x12Ob\xa5\xf0\xecSZ\xb3\x85f\xed\x02Y\x99A\xd8\xacaE\x96<FR\x85\xc1>\xdc\xd2\xa7^{R\xe9\x1b\xd3\xe9c\x04C\x18\"|\x0fJ/\x97\xe4\xc9FYP\xb5\xe3|\x16w\x8d\xf3\xe3Q\x9f\x94\xd3\x81\xd0a\x0bA\x90\xc5\xb8\xa3\x16\xe0\x86\xc4b\xdc\x92]\xbf^n\x93\xaf\x1c\xce5\xb9i>\xa3}8Z2\x80\x9e\x1a\"\xc5d|zF\xb7:\xca?>\x17\xd8hc\xc0RZ|p\xe6\xb1:\x9dz\xa9}\x88\xae=\xb9\xbb\x1b\x96\x9a\x97\xf5>\x90\xffV\xd1R[Rprw\x07\xbe7\xc4\xba\x85\x8a\x148\x0b\xd1uEq\x02\x19jx\xe7\xe2\x9aZz\x81\xf9\xbd[\xad*\xc5?\x118s-\xa7(x^8\xb3lF\xf4\xc0J\xd1\x12{\x13\xeb\x85\x9e&P\x16\x9a\x19\xf1\x7f\xfeb^',\xb6\x90\xe7\xcda\xb3&\x0b\xd6Yb%\x08\x14\x19\xc9ko\x8c\x9eD\xbb<\xe1\x12\xed\xea\x99$\x83v\xd5\xe3\xaa\x08\xbb\xccC\xe8\xb0yxAh\xcd4\xf07y\x9e;2\x0d\xfd]\x86%~\x15\xd5\xbe\xf3+X|\xf9\x9f\xf0\xa9'\x85\xec\xefu\xfb\xe5\xef\xac\xa2U\x14Q:\xe0\xc2\xd0\xad[\xb88\x1a\xc9\x8d\xa7G\xd6\xc9\xc6\xe3\xc6p\xd4\x99O\xc0\x1czZQ\xf3\x0d-\x82\x8e4\x10\x8en&\x10\xfaf\x0ddW\x9a\xf7\xcb*z\x12\xfc\xd2\xbb\x16\xb0i(\x84\xa4u:d\x07\xf9lQ\xac>\xe2\xdf\xc6\x85\x9d&\x0c\x1f\xc2\xb7\xc8\xb1#{\xea\xc1\xf6'\x0b\x0d\x80v\xcb\xc2\\\xcf\xab\xe6|!\xc9\xe7C\xdb\x93\xf9{\x03Z\x10\x89\x8f]\x8d\x8e\xb3\xde+g+\xfd\xd3\xe4}\xb2\xa5,4C\xc8\x08\x10p\xa1\xc9\xc7*$\x8ai.\xd0\x98\x81\xc7\x7f\xfc\x9a\x87\x806l\xc6M\xbe\xe4D\x8e3\x81\x04\x1d\x92\xc0L\xbc\xc6\xb0fg\xd9\xa2\xfdJL9\x1c\xa6\xb2s\xfc\xe5\xe1\xd4\xb1G\x17\xd1T{M-js\xe6\xfd\xb0\xa83\x06\xc0\x99\xf7nXR6-!\xe5\xcd!A\xbe\xc0\xe4\n\xb5\x992*\x19-\xcfH\x0c^\x98v\x83\xa1\xd2=\x0ca\xe3\xbcz>\x13\xba\x8cz\x89\x85\xf3q\xe7\x13\x02\xf9G\xf2\xd01\xe69\xaf\n\xfb\x02\xbb\xbb~\xf1\"\xb7\xaf3\n\xfdB\xb9\x16\xb5\xad\xb9=\xad\x89\xcffdU\x00\x89M\xc1\xff,K\xa5\xa7\x0e\xad:\xaa\xd4\xe7L \xdas:\x8a\x01\x0c\x86j\xde\xbc\"\xa1	\x05\n\xc1>|\xa0E;\x87\x9b\xd3s\xd8\xc2\xd5\xcd\xf7\xb0\x85\xd8C\xe7]\xa7]\xd2`\xcbN\xdf\x02.\xa9\x89\xa8\\\xd8\x87\xeb\x9e\x1es\xd2\x1b b\xbb\xd0_\xfe\xcb\nQZ\x9df\xaa\xc9\x92'\xc7\"\xc3!\x99.Cp]\xa3\x9d\xa5\x00\x08\xec3\xf4ce4K\"\xc5YfR\xa6\xc2\xe9<C\xd3\x11T\xd1\x06\x96\x13\x0c\x1b\x84\xd2\x81sg\x0e}lF\x1crR=U	1\x93\x0c\x81\xdd\x81\xdfB\x82\xce\x8b\xdf\x11&)\xdd\x87\xa6&\xe1@&;R1t\xbe!\xc5\xe5\xc3\xb8d\x01\xc1\x91\x14\x19\xae\xaf\x18\x8bC\x93x\xcb9d\x98C\xf4\xbak\x9c\xa2d\xc0\xf2!/	Y\xa11\xe4\xb7WN\x8d^\xe9m\x82\x81\x00\x93\x84w\xd6l!\xac]\xc9q\xb3\xbb`;\x16]\xa89:W\x87\xd8\xb7i8\xaf\x06?\xa9\xa5k\xad\x14\x8d_}\x96\xcb,\xf7\x06\x0e\x07\xff\xd6v\x1a}^M\xa9\xc3\xdc\x94\x07\x1dkkI\x0d|~\xab\x15\xc1i\xca\xc5\xe1F\xf6a>\"\xd8/f\x9d\xe4*u\x8d\x8f\x04\x0b\"\xfb<{g\xd6\x93*X\xe63\x847\xf8\x00\x8d9\xa8\x19\xa7\x08\xc0\xa9\xd9\x8a\x14G\xb5\xa1\xf8e\xaf,\xe5\xee\x10Od2\xb4\x14QfMe\xdeR\xd3\xfb\xa0\x1f\xc9\x8ci\xf4\x00K\xd6\xb6\xd6\x9c\x86\xbd\xf8Y\xb9\xfa\xf6\xd4\x19\x9d\x921\x84\xb4\xd1i\xaa\xaf\xb8O\xc4JM\x18\xa6\x1f;\x96\x80;\xba\x8d\xf0\xe7\xbb\xb5\xdb\xfc\x05\x16}\x8c\xce\xb2\xc1t\x06\x9b\\K\x0c\x93F\x01\xce\xeb\xcf\xacL\x86\x0fDW\xb9N\x1e\xc3H\x91E]\xbb\x10\xd1\xac\xb4h\xcf\xf7\xbfn\xd6Xe\xebz<\xeeK\xb7\xd2%\x96W\x04\xac\xae\xbfu\xec\x86'.0p! \xea\xc8\xae\x0dP\x02\x05z\x8e\xc4\x1e\xe3\x97\x1f\x0b\xa7R\xbd\xf7\x16\xa1\xf3\xf4\xa8C\x8fF\x7f\xc6F\x7f\xf9\xd1\xe6\xf6\xc1\x0e\x8f\x1d\xe1\xb7\xce\xc5w\x8f\xe4\xbd\xael\xee\x821\xe0\x9d\x8b\xe0\n.	\xbcXr\xd9\n\x1b\x1d\xd6|hk>\xabds9\x0d\x82@\x1dr\xc1\x00\xc65\xa9\xa0\x91\x12\x07\xe1\xe4\xf4z\x0ed\xd9\xd9\xb0r-{c\x92\x08T\xcaS\x08\xb0&O\xfbpI\xac\xeb\xd4vq\xcb\x128\xf9e\xf2\x9c<\x16\xe2}8{B.\xaf\xde\xc0\xde:\xc6.\xbc98H\xeb\xd8oi\xd57\xc8\x01ho\xe7[\xa7\xc5G\xfa\xc8\x9fSxt\xf9\xf0\xd1\xa6o\xfdWV\x1a\xba\x9d\x04\x94\x8bv\x12P\xcap\x0c(\x8a\x02\xdb\xd1nP\xd1\xd6hK\xe7:>\xd7\xa3I\xdc,\x87\xa5mH\x96\xc3i\xba\x14k)\xc3\xb3\n<\x05\xfd\x99$\x89\xf4\xe9\xe3\x1cW\x06b\x0e\xa3\xbcOjL'.\xfepz\x9c\xa50\xfcg\xc5\xfe\x8f(]\x8f\xd1\x92\x7fW\x00\xf3z8\xe4\xb2\x17\xa7a\x1f\n\x0d4h\xc1q\x14H\xee0\xf9\x94jWM]\xa7\\(\xed\xdaR\xa1\x9fp\x88G@.S\xa5J\xe5\xcd\x10\x82jqF\xdb\x87P\xedd$o\xd1@\x02g5Qj\x0e\x9eZ\xf7H\xc0\x89\x93\xd2\x11\xa8\x10\xfe\xe67\xbf9\xf8\x15\xbb\x82\x83o\xe3\xb6\xe3\xfa^d5\xf5\x06\xb4\x18\x1a7\x1e\xd7U\x1a&\xe69;M$%\xdc\xefN\x15\xfd\x1d\xe6\x0f\n\xc3J\x9d\xed\x06\x83\x1cp\x96TM\x8f}e\x07W2\x94 \xca%_\xe6#-\xd7Q\x9b\xf9\xcfP\x19\xa5p+\xad\xd4j&\x87\x9b\xa5\xf6!\xc2\xa3\x0ez\"\xef\xe9\xb7bK;\xa6\xb7N\x06&vS\xfc\x19>\xe9\xb6o\x0b\xca-\xa1\xefrm\xbd\x1b\xd9[|\xba\xd3\x9f\x9f\x993\xab\xeeK\x1c\x05\xf1\x9c\x1f\x87\x84\xab\xe3	\xd7\x0fZ\xc5\xf5\x0e\xdb\x0d\xc3^\xe4\x9b0\xffp\xa1\xb4\xea\xef\xff8nw\x1a&.7\x1c\xa5R\xef%E\xe6\xd28lR\xf7Q\xd6\xc7.X25\xe9\x97\xee2\xbe[;\x1f\x9b>\xfe;\x048E\x1e'Vz5\x82\xe6S\xc0\xd4RG:\xb1\xd5\xdaL\xe7\x9c\xac\x0e]f\xf68J\x8b\x7f\xed9\xa8\x14x\xeaCh\x0f\xad\xa5\xd6Y\xdd\x08\xdfqm\xb6\xeeW^\xeft*9\x9d\x88\x11\x1b\x89QC/\xb4\x9aL\x9b\xa1&\x1bx0\xd9\xb4P\x9bN\xe0\xb2x \xe6\xaa\xa1\xae\x94\x8f\x16\x9e\xc0\x8e\xd0q\xa6\x8b\xba\xa9V\x9a\x86i\x92\x1c]}\xbb\x81\x06Z\n\x815.\x1b=\xe7hq\xc8\xf2KG\x92\x0d\x7f\"a\xa9S\xac\x9d\xc8a\xe0\x1058-\xf5\xec<uiT\xdfR\xa3\x17r\xf9\xf1U\xcar\xd7@\x9e\x024\x9aK&p\xd0[\x08[\xdbl06\xebt\xf5\x80\xd0\xf5\x96\x0b\x13v\x06-\xd9\xcfu\x9aa\xdd\x86\xb5\xb0\xce\xa6n\xa9\xf3N\xf5MJS\xb0\x89=\x1a8N%LZ\xdc%\xc7J\x9b\xf0>Sse\xa6\x1c\xfb\xb5\xb5\xf3\x08d\xc1\x1f\xe0\x81\xd2\xbc&\xe2\xccq\x0b\x8e\x93\xa1\xbc\xa1irp|2d DO\xd8\xf2,2\xb9\\\x92\x8f\xb5\x8dn\xeb\x80\xec\x8cz\xa6\x1b\xef\x8c\xfa\xban\x94\x0c\xe9\xe4\x85\xd6l\xc9\xac\xa0\xf4h\xef\xc7\x02\xec\n\xbb\x8e\xc3\xab[\x0eI\xb9\x93\x96\xe9\x8e\xfb.\x0d[\xe9.\xf2x\xb8Z\xd8m2\xb9\x8d\xa5\xcaV\xdf\xa5\xe1|\x1cLm45\xda\xd3\xa4TaHN\x96:YbC\xc6\x94f\x94\xb3\xd1;\xc3\x86\xdd\x87\xf8\xbc\xeb\xeb,U\xeb\xe8v\xf7\xef\xe6k{\xc7\xb6r\x92\x1b]\xd3\xec\x8c\xcdm\xe8\x81\xfd\xac,mht\xe1`\xf2\xff\xcaD\x8d\xf9]H\x96\x952\xa91B\x08PJ8\xb8\xd4\xf6\x01v3\xafL\xc0\xd9\x03\xa9\x94g\xf5V\xe5\xa0*CF\xf1\xf16F7\x0f\xfb\xf0a\xcd\x85^*\xa3\xd5\x1c,>\xea\x15r\xa2\xc2\n\xb2\x9er\xd26D\xc2Z\x0b:\xdf[\xdai2\xde0\xact\xff\xd2\xaaR\xe99\xa8\xdePer=\xb8F%}\xc6\xf1\x1a\x89\x8b\xc3k\xb9c\xda\x11t/7\x15\xbb\x92b\x86NDe\xe0Wd%9\xda>\x17\xb3\xed\x07\xeew\xa6\x8f@\x967\xa6x\x8aSg\xf7\"`x\x18g\xf8\xc5==\xc5\xca\xdb\xa8\x83c\xd3\x13\xdc\x8f\xf7\xd1\xa5B\xf4\xec`\xad\x82\x05\xe3#=E\xd0KH\xfeX\x9a\xc1:\xaea\xef\xaf~\x8fs\xbc\xbd\xbf.\xaa\x8a\x82\xbf\xe5\x9d\x9d\x14\xbf\xa8$)\x1dEH\x1f\xa4\xab5\xb9\x9e\xbf\xd2\x1c\xea\xaa\xf9\x19\xb0k$\x9e\x0cF\xfdH\xf7\xba\nyg!b\xdb\xa18\xec\xa8\xa9\xed\x1cd:\x1c:e5\xc9\x94\x82\xcb\xa7\xfd7\xb0\xf7\x1d\x88+\xc5\xe8\xbf\xfc=L>\xae#\xacwP\x86\xcf\x17\xd6\x99\xed\xed$\xb3\xfb\xf3-\x85\xce\xb1v\xff%\xddh+\xf24\xde\x8f\xc1UO\x8f\xe2\x8e\x17\x8e\xcbX\x18\xc8'\xd4\x93\x8b\xefJ\x9es\xf1\xfd\xed\xe5\xb8,\xae\x1e\x190\x9f\x0cK\x98\x94D,\xdfT\x0ewV\xac\xc8\xc1\xb5\x19[z\xfa\x9dw\x9c\x0e\xa5{\xbb\x8d\xf3\x0f\x95=\xc9\x0d\xcf(SFI`r\xa0\xe9\x06\xe8y[\x8e\xe1\xe9\xb9\x02l\xb4Un3\xb2\x0cu\xe6~7^u\x9c:1\x89E\xdfv)\xe6\xa7k\xc0l\xf6U\x06\x90\xbcrn\xa0\x0d\xca\xb5_K0\xe3\xa1\xdcQ\xc3y\xba\xc0\xe6Y\xa6\x89i+\x12\xaa\xf4\xe7\xc4\x16\x86\x8c+\xdf\xfd1g\xb8\xa5\xc6\xb5-Y\x95\xfc\xe4\x82F\xe7\xff\xab\x7ft\xcd\x1a\xb0o\xc8\xa2\xab>\xb7o\xe8\x1aK\x16R\xf0\xfb\x0dg\xa8?Q4\x06\n\xecWw\xee\xc6\x8frP\xcb\xd8|K\x9e\xf9\xb3\xd1\xf0\x89\xc8\xf5xo\xa36\xc9\xeb\xe7H\x18\xe4\x01H\xbe\xfd\xd1\x0d\x1a\xb3\x05\xe3\xa47\xe6\xfa*\x8d\x0b\x9d\xd3\xa6\x0e\xaerM<@\xb3\xc1\xd9\xd2\xf8\xe4s\xfa\xc5/$\xa0$\x12X\x18\xd7<\x049?\xf6B#\xe3\x88\xcb\xe5\xf4\x02\xfc\x8eAp_]\x80\x17o\xb5u\xbd\x07\x99\x91\xee\xc7\x8b\xaa\xa5n\x1b\xa1\xaa\xb3w\x963qP\xb2\xe4\x17\x1c\xd4R\xf3\xa2\xa5|\xa9\xf6:\xdd\x1f\x8es\x87\n\xfa\x8e\x0c5\x9c-\"{\x159\xaa\xc9\x9ddR\xdatFW\xf84\x96\x0e\\1\xa1)J\xfd\x7fy\xcb\x90Y\xbc\xf4\x98a\"]\x8f	\xf1\x95\xb6\xff\x1f\xd2\x7f\xe2%C\xca\xf8\x95d\xd8\x1d\xfb\xf4|\x8e\xb5YW\xdc\xa4\x0dp\x974hT\x8f\xacQC{\xb0\xa4D\x0d\xbb\xf6A\xe1R\xa2\xdb\xbdxo\xc7\x16p\xfcv\xe0x\xee8\xc9\xef\xc8\x07.A\xf4\xe7\xea\x89M\xe2\xb9\x14\x02E\xe5)\xd2\x94t\x87\xed\xd7\xfb\x12\x16:\xf4\x08}@\x9f^\x17-_\x10<p\x93\xfa\"\xa7\x17\xef\xec-\xc7\x9a\x81\xeb\x07\xa9=JB\xe1\xa4F\x18\xf4\xfahb\xcd\xa8T\xe5G\x85)\xa9\xe7SG\xb1\xb4h_\xec\xc8\xe6\x8e\xea\x07Z\\\xfd\xf3\x0dY3\x9d\x9f\x03\x13\xb1\x0bfg\x0b?\xaf\xfb\xcb%\x93~D\xe5*G\xbbYc<\xb2u\x8a\xf0a\x80\xa4\xb51\x05\xf2x\x7f\x85\xf2\x94\x8a 4\x9e\xc8\x86\xb5\x8b9\x12\x8e\xfc\xb6h\x15\x8d\xd6\xfb\xa74\x9cW\x83I\x08\xad\x16\xe2\xfc\xc3\xe4\x06\xe7\xca%\xe5\x89\x1e\x17\xf8\xd1\x0d\xd9I\xc3\x81\x7fz\xc7\x07[6\x8e5.\xb4\xd1Q.\x9e_P\x91\xfd\xe7\xb2v^R\xfc{$\xbe~\xf5\xc3\xfc\xf5\xab\x99\xeb\xe4\xf9Ly\x02i\xd3\x15\xfa\xccj\xbb\xeam\x925K\xfb\xca\xe0%\x97\x1a.otBnr\x83i\x86\x1f\xfb\x10\xe5\x99 \xdaf\xdd{NuDDp>\xf2g\x95p3;\x96\x8c\xa0\x1c\xe5\xec\x12C\xba;\xa9<\xd8\xac\xd4Q\x15H\x8c&]\xed%\xc09\xbb\xfa4~-[8\x8b8\xdc\xa6\xcc\xdeJ\xbcO7\x1b\x9c=j\x93?\xe7\xb8\xc4\xe5\x8c\xb2\xd1S$\xab[M6f\x9a\xa3\x88\x1f\xe5\xca+\xb1\xa5\xb63\\\xb3\x94mZ\xa0\xbd\xa9\xfd\xc2\x9f\\_\xddjI=\xae\xd2\x15\xc2\xc17\xe1\x00\x16[\xf8f|(\xb0t\xc6\xb8\x0d\xd7o\x9e08\xfb\xa6\xe8!\xc7\xc3\x05\xdaT\xc3\x89O'6\xe9o\xe4\xb5\x99\xe6?\xfb\x83'\xab\xda\xd9\x12F\xe5\x92{\x9eb\xea\xc5\x0d\xbb\xf3o\xc2\xe4\xa0{=}\xe6Z\xbfpM\xdf\x9c\x93\x08\xc6\x95$D\x8a	\xf1\xfb-Z\\Q[z\xaa3TJ\x08\x95\xca\xe3.y\xb4\xd9QW|\xd7\x0cC\xd0++	\x80`d\x98\"~\"X\xa0h\xd51\xdaal\xc9\xdfu\xd4\x84\xbc\n\xeab\xc8\x0f'~y\x08\xbf\xbb9{;\x87\x9b\xeb\xb7sy@\xe9<|8;\xbe\x92\x90\x9fb\x1c\xb4\xb9\x91\xa9tK\xe2y$I\xf9\xfe\xf0\xf0\xe9\xbb\xc3\xc3\xf9\x80\x96$\xa14Q\xbf;<\x84\xdf\x1f\xcb\xb7\xca\x8dzo\xd5~^\x8f|\xd1q\xfe\x9c\xf1\xb1\xdc\xe4\x9d\xdc\xacY\xa3]Q\xd5f\x99\x9d\x08d\xdad\x995\x86\xf7\xa3a|\xf9\x9b\xe1\x84^f\xc9\x9f\x04sv\xa9W\xbdO\x95\xaf \x0b$\x87\xd5)\xd9]z\xfc0\xa1\xab\x1eD\xcc$\xa7\xae\x98\xc9\xb0\xe6\xa40\xca\x87\x9d\xf2o\x82H60L\xa9\x0b\xec\x9a\xa0\xc4\xc7B ^\xb4&\xc8\x9d\xc6\x82\x1f\xba\x8c3\xd5\xfb\xd2\xe5\x9b\x9d\x96\xff\x82I\xaa/*p\x96\xff\n\xbc\xbc\x1d`Dya\x901}\xf3\xf0.i\xd9\xf9\xfb\x93\xdf\xc3\xbb\xf3\xf3\x84X\x19\xb7@\xc3\xf0\xb7\xe9\x9f@\xe5&svqZFd\xa3\x8er\xd6\x17\xe5\x7f\xc2\x18C\xab4\xfd\"\xfd\x9d\\,\xcc8\xc9<\xa9\xb4\xc2\xe8\xea\x8dXS\xeb\x08C\xb4]=Sm|\xa6\xc8?\xa5\xc7\xdf\xff\x13zl\xdc\xcaIO}v\x99\xfeU\xd0\xf4`\x85\x11\\#H\x97L\xd1\xa3n\xca\xa6\xef4d\x87^\xecx\\c\x08\x99\xbd\xcb\x7f\x13|c\xaf\xdd\xd0\xba\x11\x1f\xa9\xa4\xbd\xe16V\x9a$U\x07G\x1aRLu3\xe8G\xd7\xfb\xd5\xa0=7<\x10\xe59\xa84,\x05\xeb{\xe7L\xd4\x9d\x1c\xbe6\xe9F6\xe5C\x8b-\x84~\xf1\x91\x9a8\xcf\x8f`}vg\x16[\xe9\x96bHo\xe3Y\x95\x82\xc1\xb0\xa6\xb0\x0f\xe5pni\xd5\x1b\xceT\x9e:\x9f\xaa\xb1\xec\x80g\x81bj\xf0\xca\x97\xddQ\xcc=\xd80\xa0/\xd3\x81\x17t>\xff\xf4\xe6{\xdc\xba\xb0F.\xa5\xdf@\xf9WA\x8f%\xdf\x9f\xdd\xc9\x00\x16\xbaL\xc9\xd1Tp\x9c\x7f\x8f\x1f\x9b	R\xca<\x18f\xc9\xb0\xeb}K\x9b\"\xd8\xfe#\x95\xa0\x17\xb6\xb6\xc9>\xc4R\x93Z\x95\x11c\x1fF\xf4I\x89\x10\xef\xad\xfe\xd4\xcbm\x99M\xcfB%\x89\xa3\x03y\xbe\xc6\x11\xa8\x8a\xd31\xf5\xaffC\xc7j\x16Sf'\xf5l\x86l\xbb\x04\xe1_\x81\xf46\x87\x87\xf7\xf2\xe7\xf5\xab\x1f^\xff\xf0\xfa\x7f\x03\x00\x00\xff\xffPK\x07\x08\xcd\xa8b\x9e\xb2\x11\x00\x00\xb41\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xef\x9d\x8cN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/fr_FR/common.jsonUT\x05\x00\x01R\xeb\xb0\\\x8cV\xcdn\xe3\xc8\x11>\xcbOQ `\xcc.`x\xef\xbe\xe9\xcf;\x9aH\xb6 i<\x08\xb29\xb4\xc9\x12\xd9v\xb3\x8b\xe9\x1f\xc9\xcab\x1e o\x91c\x98W\xc8\x91/\x16\xf4\x1fI{\xb0\xc0\x9eX]\xfduWuU}U\xfc\xfdj\x92\x1dI\xd5\xccdw\xf0\xfb\xd5d\x92=3)\xb1\xc8\xee \x9bM\x1f\x1e\x96\x0b\x98\xfd\x15>]\xebOp\xff\xb8\x83k\xed?\xbfe\xd7\xfa\xb7,\xbbq\xf8\x02\x05\x9ap`\xb1\\/\x0f\xc3\x89\xb0\xcfkV\xe2b\x00\xad6\xd3_\x97\xf0\xc7\xd0}C\\\xa0\x1a\x83\xf7\xdb\xc7\xd5z\xb9\xfb\x08\x97x\xde\x926z%\x0f\x95B\xe6\x0f\\\x17 \xf1\x0c\x8d\xd3\x03\x97`\xfc\xcem8\xe0\xb5SY\xac\x9c\x1d\xfdXs\x13}\xba.\xc2\x89\x9f\xf4\xcf\xc0d\x01\xd7\x05x_\xdc\x9a\"l\xb8\xe1\x0f\x0e\xbe\x07ZUb\xe1\xdcs\xb0\xed\xe3\xfe\x00\xdb\xaf\xbb_?\x84s\x1cG]\xb1\x82\xce3\x9e\xa2\xbf\xff<]<~\x83\xd9\xea\xcf$!<sM\xf9\xeb\x81\xcaR\x84\x1b\x0e\x9fw\xcb\xe9\xc2\xc1\xdf\x85\xcd\xca!\xc5_\x1f\xde'9 N\x1c\xcfX\xecY\x8d\xabmr\x7f\x0f\x8f\xf7p\xf8\xbc\x84\xfdt\xb3\x84\xd5\x16\xbe-wKxZ-\xbf\x8dN_M\xbe\xdf\xc4\x82\xd2\xae\x9e\xfc\xaa\x11V1\xa1S}\x15\xec\x92\xdd\xc1\xdf\xae&\x93I\xf6BVy\x93A\xd4\xd9\xd5d\xf2w\xefB\xe5v\x12\xacB\xab0\xe2\xbc<\x02\xd6\\Z\x83=4.oF\x8b1\x98\xa4\xa9\x06,q\x9d\x90N\xeca\x1as\x92E\x8f\x8b\xcb\x9b\xd1b\x04\xbe \x1b<eRvm2\x1f\x16\x01\x1a#\xe3\x8b%E\x82\x155\x97.\xbeS'pm\x143\x18\x03\x92\xb1\x92<\x01\x04\\\x80E\x95\xf7)C\x93\x96\x14NK\x92\x97:\x18\xcdr\x92F\xb1\xdc\x84\x12w\xdb\xbb\xae-,W\x08\x02u\xa8j=&\xee\xec2\xe4y\xb5\x85;0d\x0dh\xdb4\x8a\xd7\x18\x9d\xc1\xb7&\x1a\xdf\xe1\x0bqY\xc4t\xc4\x8d\xc1X\xf7/\x83n\xf7\x07c\x15/\\\x92\xb29\xcb\xabtkx|\xc1d\xc4\xbc0\xc9\x0d)\x7f\xd1\x9cd\xceQ\x95\xd1\xce\x8b\xd5\xe6\x81\xce\xde\xc4\xbfA|\xe2R\x1b&c \x04\xe5\xaf\xa1\x9c\xa3\x14s]\xa0b\xe9\xbe\x0d\x15];\x8e/\x0d$\xe6\xa5$\xd5\xb5\xd1\x0b:K\x0cg\xb6\x8a\x1a\xc5\xbb\xd60\x9e\xde\xab\x11\xa7Bxr\xb90\x9d\x88\xab\xc4\xde\xe0\xdd\xf4x\xe4\xc3\x0bu\xe8e\x9e\xceQ\x0ct\xf5$\xdd\x1b\x9e\xbf^B\xd8\x1a.\xcb~\xdb\xca\xe1I\xbd\xdc3\xf3\x87\x8c\x0d^\\\xc8:\xedOOd\xf5\xcf\x89\x8d\xfa\"\xf3X\xa0\xd9\xa2ks\x92\x12s\xd3\xb5\xe1\xc8\xdc-\xdf8\xc9\xb0\xdc_d^)\xba\xbd\x8d-s\xc1\x15\xe61\xce\xef\x0e_\xf9\xf2\xcf\x0c\xaf1\xd5s\xce\x04\xcab\xc4\x86/LF*\xdcw\xed)\x8a\x1b\x96(?=\xa9^\xc7\xa3\xf4\xc5r9\x88\"!\xa9\xfb_\x14\xf7\xd8D\xe91\xfa5\xc9\x1e(]\xee\\\x1c\xa8yF|\xed\x9dY\xf0:\x82\xd6V\xfe\xe0\xcb\x06\x93\xf4\x05m\x94\x9e0\xe1\xf6\xac\x1e\xb3\xd8\xf2~X\x921T\xbb\x98\xcfX\xac\x9f\x9c\xc9\x1cE \xa6\xb4}Nsf\x98\xa02p\xc0\x8b6QVp\xd7\xb6\xe7\xfd\xb9\xb9S\x80!\x88W\xdd\x8c\xd8JL\x85\xe9\x90\x08\nV\"4\x82\xc9\xbc\x8a\xf7\x15$=\xd5\x0e\xa8j.\x93\xfd#\x17x Z3\xc7\xa9;\xf0k0D X\xcf\xb2\xa3\xebBU\x1c\x1e\xfet\xaa\x92\x92\xc8\x15,\xc9\xf2\x9d\x83A\x0d\xda\xe9=\x8e\xd7\x0d)3'\xa5l\xe3'\xdf\xfaSP1\xc3I\x02\x83\xae\xcd+\xb2]\x0b\x0dY\x059\xb3\x1a\xa1@p\xa4\xe1\xa8 '\xa5\xa8n\xec\xed\xf8\xbaE|\xd0\xc7\xbbT\xd7Z\xad\xf9-\xac\x194\xacD81\xa8\x19\x97\x06%\x93\x06\xba\xff\x1a\x85\xa00\xaf\x98*\xbb\x16\xd3\x9d\xf2\xc4\x04/\xe6\xac1y\xc5B>\xbc\x08\\:\xf3}\xb9\xbf\"6\xfe\xbf\x85\xcb\xf2\x9e<\x89\xff\x82\xd8@\x11up\xa4\x18[\xc1\xc2\x98/PI\xee\x9a\xc7MjK\xc3\x8f\xc9\x13*E\x96\x8b\xbe \x02\xad\x0f4\xebK\xe8\x9e\xbfu-0\x0b\xcf\xa9\x96j\xe4\x05=q\xcdI\xa6_\x89\x8dS\xc1\xc9\xeb\"\xc8j\xb3a&\xaf|\x8cPCMF\xbb\xa06L\xbb\xe8\x12?\xa14>\xb4\xa8\x1b\x1a\x9a\xb7\xc4\xf3\xe0\xdf\x03\xd9\x132\x0b\xda\xbe\xa4\x01\xd3\x10\x97\xc6\x15f_\xb9O\xa84\x08\x84\xfc}\x05\xfb\xa96\x0c\x81\x0dj\xedd\xf8\x05\x82\x12~\x81\xc3a\x1d\xb0\xff\xb0\x14\xdb\xaekR\xc0N\xf8O\xe8Z\xd3\xb5\x90\xf3\xbe))d:\x0c\xb7\x1d\xe3:=Ta\xe1\xbb\xd1\xec\x12\x9a\xdf.\xae\x81	\x01\xcf\x17Xm?\xe2\x86\xe7}\xc4\x86\x1f\xa6\x84?*\xd4>|\xd3\xdcX&\xb8NIR\xd8\x08\xdf\xa1w];\x0e\x9dBW\x88\x9e\x88\xbc\x94L\x0cxcUp\x1cM\xfa\xb9\xc9\x94\x15i\x16\xff\xa7\x14i$jd*$m^\xa1\x1a\x0d\x0d\xd4.\xb9\xcb\xb7\x86\xc7\xbf\xe05\x83\xa8\x04\x06\xe8\xf4)Pn\xec<\x90\xe1\xb9'\xc8J\x1e)]n\x9fk\xee\x1d\\\xca\x13]\xfa\xc1S\xd9\xfaY2.x`\xf2\x86K\xce\x8cU\\{R\xf5m\xdfP\xe3\xb6?3\x1bk\xc1\xca\xd4\x1cR!\x0e\xe9\xb3\x12\xea\x90r\xe0\xd2\xcd\xf7Sr\xcf\xca\xb3+\xcc!\x0d_\x83\xe2]\xf8m#\x88\x15\xf7\\\xf87|\xf5+p\xedi\xbc\xbdUT*\xd4>\x8a\xa6k\x85\xeb#\x9e\xd6\xbd\xcb\x1f,}\x1b\xdb\xb9\x9a|\xbf\xfa\xfe\xff\x00\x00\x00\xff\xffPK\x07\x08\xf6\xb8\x13[\x96\x05\x00\x00\xeb\x0c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00v}\x96N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/fr_FR/server.jsonUT\x05\x00\x011\xe1\xbd\\\xc4Z\xddn\x1c\xb7\x92\xbe\x96\x9f\xa20\x0bcl@\x91\x9c\x93s\xb10\xb0\x01\xac\x1f;:k\xd9\x8aF\xb1\xb1\x08r\x00Nw\xcd\x0cm6\xd9\xe6\xcfh\xe4\x83\xf3\x00y\x8b\x83\xbd\xf2\xe45\xfa\xc5\x16U$\xbb\xd93\xb2\x8f\xb3\xbb\xd9\x05t\xa1\xe1O\xb1\xbaX?_U\xf1o\x0f\x0e&\x0bc\x1b7y\n\x7f{pp0y\xfe\xec\xc7\xc9S\xf8\xf9\xc1\xc1\xc1\xc1\xe4Jh\x8d\"@=\x95\x9a\x16	/\x8d\x9e\x1c\xf2\xdc%:'\x96\x08\xdd?@,\x16\xb2Z\xa1\x85Zh\x07J\xc0s#-\xc2\xb3\xb0\x81\x1f\x03:\xda\xe4&\x0f\x0e\x0e~\xa1\x9d\x93W\xb3\xe7o\xfb\x13\xf8G$x\x8a\xde#\xb4J\xe8j\x85 \x827V:\x84:@e\xb4G\x1d\xe0C\x90\xa0\xa7\xe8<\xb4\xc2\x81\xc5\xca4\x8d\xd0u\xb7\x8d\x07\x07\x0d\xa8\xd7\xd2\x1a\xad\xb1A\xed\xa1F\xf0V\xac\x85T\xc3\xe9B\xdd\x8a;\xf7\xd2T\xef{\x1enLxg\x82u\xe0\x10\x16r\x83\x16D\x80\xb9p\x89\xaf7hM\x90J!(\x84\xba\xdb.\xa4\x8a\xe4\xe3*:E	hI\x16M\xf7[\x83\xe0$\xa8\xa9\xd1K\x85\x1e\x88\xd9JT\xabn[\xb0\xa0\x8d\xbek\xa4\xc3\x9e\x83gy\xc4fY\x08\x92\x81B\xd0\xa6\xe1\xcf0\xc1\x81B\x07\xc1K%\x9d\xf0\x18l!R\x11ji\xde\x18\x15\x9a\x82&\x8d\xc1:\x0e\xa6/\xe1\x1f$Qg4\xb4&Xh\x82\x93\x1f\x02\x02zPX\x11YX\xcb\xba\xdb\x9a\x92\xb87K\xb9\xe8	\xbf\x91KMW\xe5\xe0\xc5\xc5s\x10Z6\xdd\x16\xb3\xb0\x9ei\xd9 sJ\x93\x8d\xd4R\xf8`\xa5\xeb\xb6\x05\xbb\xf3\xe5e\xf0\x03\xa7\xf4\x03ND\xf5~iM\xd05\xbc\x915\x9a^\xcb<\xa0\x06g\x82\xad\xa5fIG\xfe\xa0\x9e\nke\xf7\xc9\xe27\xa43%\xf5H \x93\xff\x1c\xe5\xeeS\x83\x0e\x1a\x13T\xb7u\xa4\xc6J\x802\x81\x04\xdf\n\x0bj*\xeaF\xeaCPrmiE--I(j\x16\xe9\xdb\xdax\x8b\xd0\x18-In\x05\x03F\xd8\xfa|\xd3J{\xd73\xc1?\xd9|\xa0\x9e\x06\xdd\xebyb\xe6\x95i\xe6\x16\xe9\xa6\x93&\x12}m\xc2\x1aE\xd8@\x13M\xcd\x81X\x0b\xed\x89O\x17\xda\xd6\xa2s\xfb\xf4\xc6\\\xbc\x12\x85F\xbc\x8a\xbaD\xca::\xfc\n\x83O6&-\xbc\x0b\xeeC\x98v\xff\x80o\x9f@\xb5\x92\x8b\x85E\x07&\x80B\xef\xe9\xdfF\xea\xe0\xaa@W\xfc\x087\xd8\xb4\n\xe1)\xb4F=\xde9\xfbFz5\x1c~#}\xfc\xc0\xaf?\xfe	T\xc2\x8a\xca\xd3\x1d\x8f\x0e\xbb2JzR\xdb\xd1\x91\xf5 \xed\xe4\x9bz\xb5\xe7{Jc\xfd\x8eJ\xb4\xbeZ\x89~\xd3i\xfa\x1d7\x9d!\xb9\x16\xf2C\x9b\x91\xd1\xd1'T\xa6iU\xb7\xf5h\xc9\xe7$:\xd1\x9e*\xb4^H\x8d\x0e|\xf7\x9f\xd5\n\x1d-&\x8b\x98\xa2\xb6\xb8\x94\xce\xdb\xa8A$Q\x01\x95\xed\xb6\x83V\x80\x0b\xef\xd0\xefqx#\x96n\x97K\xf04\x18Y\xa5y\xd2\xdf\xc8&\xda\xc8	]\x903*\xb0\xf7e\xae\xe3\xce#\xf8I\xd3\xee\xe8\x9aVF:	B\x11\x1b\xe4\xb4\x99\xb7\xf8%+A\x8e!\x7f\xdd\xb2\xdb\xeank\xbb\xed\x11\x9c\x1a\xed%\xad\xd3\xdd\xb6\"\xa9\xe6}\"@c\xa4v\xf0\x1d\xb3wT|\xc9J\xd8Ye\xec\xa0\x0f\xfc\x8b\xd8r\xadh\xd8\xe0\x86\xcb\xce\x8aad\xed@h/y\x8d26;\xdb\xc6\xd4r!\xabBr\xc3\xe6>\x16\xa4\x0b?\x82g\xad\xed>\x91\xf1\x18i\x017U\xb7\xa5\x90A\xd6.\x1b\xe9\xf1\x10\xd4\xb4\xb8a\xa8qm\x05XR6Y^p\xf99\xc1y\xd3\x9c\xcef\xfd\xe7$\x96\x9fc\x8c\x14\xf4]\xfeN!\xb4h\x9d\xd1Z(r\x81\x1c,I\x14K\xba$AG9\xc7\x8e\x1d\xfc\x8a\xdc\x11\xb8nKnX\x1a\xad\xcb\x88\xd1\x9fwc\x96\xcb\xc2\xa8Ng\xb3\xf1	\xd9\x0bW^\xaeYR\x8b/34\x1cQ\xe3B\x04\xe5\xcbo\xbaa\x9e\xb2\x92E\x06w?\xa0\xdb.D\xf01\xe4\x95B\xd4S\xd4 \xc6\xdf\x03\"TA\xef\x1d\xf9R\xe8e\x7f&\xfd\x08X\xd2N\xe7\xa7\x89\xfb\xcf/i:\x92\x95%K\xcf4\xcf\xd0\x85Y\x1a\x8b\xc4\xae\xb1Z\xd1\x1f\x01\x9b\x86\x02w\xb1\xed\xc8\xd8eAO:1Wxm\xe6\xc6\x0fFx\xa2\xcc\x87\x80\xd1\xc6l\x9c\x8a\x84\xcf\x9b\xb6\xfb-\x86\xee<\x03\xf5\x147\xad2\xbd\xf7\x17\x15i \xda\x14r\xf6\x1cw:\xf2'\x87\xf6\x84\xfc\xe8p\xecU\\\xea\xca\xf8\x94\x0e\x9eaPy\x88\x9cLR\xe5\x00N\x12\xa8b\x17k\xbb-a4\x8c\x81E\x11\x8b\xe9\xf0\"6\xd7F\x17N\x1bm#5\x7fh\xef?\x0f\xcb\x99b\x02\x88\xa8-\xae\x02\xe5r\xe5\xe7B\xa9\x9e\\\x0f\x06\xe1_\xfe\x95'\xee\x0b\x00\xe4\xf7?\xf4\x0b\xd1\x93\x05z\xe3\x85\"\xce\xff\xf4d\x1c\x15\x8a\xd3\x1a!\xd5\xb9\xb5\x83m\x98`\xadDE\xf2\xb7\x96\x02ti\x1b\xf1\xee\xaa\xb4\xc6\x11\xc6\xa8\"\x96\x9b\x9be\xc0\xa3}\xc2\x97\x04#{pUS\xf4\xcd\xc2H\xbfzr\xfd\x91\xf7\x90\xb9\x12n\xb8\xd0K\xc3 \xb5\x15\x03\xadr\x88\xbd\xddT\xecP\xbf\x8f\xa8\xb1~\xd0\x12c=8\xb4k\xd2\x80\xbc\xa9w\xa9\xd6\xb3V\xa4\xe9\xfa\xeb\x98\x9e\x85\xf9\xe0\xb8\xef\xa7<3\xc1}S\x9b\x86\x82\xdf\xef9a\xb3B\xed\x85,\x80R\x1a(,\xd5\x96\xa6\x9aw\x8c\xed\x147\xad\xd0\xf5\xb3B\xd9\xba_=\xea\xdaFS\xe4\xad\xd9H\xe3\x0caj\x02\xb1\xc5tI\xad\x94\xe89\xff\xec\xc1\xf9M\xb7U\xdd6\xba!2\x00J~$[\x16[\xa6h\xbaO~\xa4\x9b\x0b\xc4z.\xaa\xf7\xe7M\xa9C\xa7c\x01f%b\x81i/\xaa\xc2\x94\x16\xaa\x84\x00gV\xb4(B\xde\x17\x93/NN\xe2\x04\x89\xbf\x15w1X\x96^\xb9 hl\x855\xa5\x1d;9\x88\xf0@s}$9\xeb\xb6N\xa4hB\x82\x1a\xd2@\x97CI\xf4$\xfc\xf1a\xaeRT.\xbe~)\x94B{wi\xea\x02\xf2\x9b\x1aa)\x14Z\x89;\xdf\x114!;\xc6\x13j\x87&\x885V|\xd2\xee\x85-\x8d)\xe3\xe2\x8b\xf8\xf3s*\x94\xa6\xfb\xdd+Y\xd7\xa8\x0b\x88E\xf9\x1a<\x85'=\x85\x06=\xb9\xeb\x8f\xdd\xd6\x9a\x88\x1c\x9c\xe7\xa81`\xf3\x98\xe5\xb9\x11U<\x91Zc\xddS\xfeA\xd6\x08g\xa8\xd0c\x0dW\xc6\xf5q\x83'\x84R\xd0\xd2 \xf8\x95\xf0\xb0\x12k\x849\xa2\x86:\xee\x18\x93\x1e\xa5\xd1\x9c/\xb2\x9b\xa6ap\xc1\xc2\xb1P\xea8\x91\xcf\xe9\xe4\xe0\xf0\x93(\x87\xdc\xba\xc50d\xd4\x14\x7f\n\"\xa3s\xaf\xb1\n\xd6\xc95\xaa\xbb\xdd\xe3m\xb7Mst}Y\x83\xa4s\xb2	\nK\x83\xb3\xdd\xb65\xda\x91\xdeL\x07\xa8\xb6\x97)\xd3y7\xab\xd0\xcc\xdd\xfe\x97\xee\x18\xf55\xe15Q\x95\xe6\xcea2h\x98\x9b\xe0\x8d\x86\x9f\x93\x86\xd9_\x8a\x03\x8c\x95\x1f\xc9\xda\x14\xdd\x86,\x80\xc8\x8c\xa0\xb8\x83~A\xd8\xec\xd9\x9b\x8bx\x9d\x83\x07\xa1\x9c`qX_\xea\x97\x1c4\xe0\xa2F\xed\xe5B\x8a^>\xc52\xe2\xfa\x07\xb3\xc6!\x88]\xb0\xbe\x8a\xc0\xf1 \x02\x15N\xff\x0c\xe5\xd4{\x86\x83\xd0\xdan\xbb\x96.\x086y\xc6\x1b\xe4\x03\xe4\x17\xc9\x8c\x19\xb86\xc6\xbf^\xa3\xb5\xb2\xb0\xd7\xf3(\xdcTU\xd9\x11\xfd\x15\xa5\x0b\xabn;G\xc6e\xc5\x05\xd4\xd8\x06\x19\x0b3%\x01\xe9<\xe5\xb1\x8f\xd6\xa4\xf5\xff\x06k\xa1(R\x14x\xee\xf1\x0eO\xe3\xd4!\n\x85s\x02\xc7\x13\x99\x8d{\xd2\x85\x00\x9e\xbd\xf5\x1a\xadK\xa7s\xba\xccd\xff\x88\xd4@6\xa3\xd0q\xd1\x8cB\xc7i\x0c\x1aA\x17A\xe3\xfe\x98!\xb5\x92\x1a\x9f\xcb2\ny\xd4\xae(\xc0	-c\xc23\xa5\\t'\xd61\xba\x9cJ\xed\xbb\xad\x95	\x0bf3k\x85%\xfb\x1c\xce\xfaP\x0f\xf1\xfd\xe2\xc7\xb3\x93\xcfzNZ9\x0e\xbc\xef\x84\x96\xde\xd8\xc2@\x8d\xae$i\x82+\xe1\x9d\xc3\x90\xaaf\\\xba\x90MR\x94\xec>\x07\x82j?\x1d\x18\x84\xa7\x97\xac\xb8*&\x03Q\xb7\xb5G\xbb\x10UaoJ\xea\xf7\x03C/%\x12\x90\xdcx\xb4z\xa8S\xbd#gd\x0f\x0bvL\x80n[K\x9f8S\xbc\xadF\xf0w-\xc2\xf7\xdf\x7f\x7f\xfc\xe7j%t\xe1\x0d\x1b\xe1*3\xdc\xcf%\xff\xf4{)V:\xb0O\xc9\x9a\xcf\xac\xdb\xa1\xcbZ\xde\x13OT\xa2\xea\x97\xb9>jX\x06\xe9\x900sO\xba\xa4En\xa9\xbc\x9c\xe8\xed\xc9dE\x1d\x94\xcf\xfc]pd\xc7\xbd\"#\xd3\x8d\x95.N%\xaa\x9c\xfc\x97\xb1c\xa06d\xe9l\xd2\x16\x9b\xa8\xa3kI\xbbK\xbe6?p\x820\xc4F\x11\xd8\xc6\xa2\xe5\xe5\xb8\x98\x06\x1b\xb1\x91\x8dPX8\x9fd\xd8\x0c\xc3\xb8\x00Y\xd2\x9e\xc9\x8fE\x16#b\xf5\xb6$\x9c\xc6P\xc3\xe5\xc9\xef\xa6\xfeV\xd6~U\xe8\xa8]\xee1\x9e\x07\x7f\x1fi\\\x86\x9b7\x83B\xc5\x9f\x91\xe0_L\x88Db\xf9\xd3\x155\x1b\xce\x92\\\xdbm+\xb9 \xec\xe4\xc6\x99e_\x10\xc9\x05^\x17Z\xb4\xadqe\xa4\x8dG\xcfV\xc6\xfa*\xf8\xff\x1f\x16L\x8dV\x8c|\xc9\xa5\xa9\xbb\xadM\xd5\xee\xd2\x9b\xdc\xebC\x0ea.4\xa5\x90\x18c\x8c$'a\xbfP6\xd7;\xd5\xd1t\xc4+\xd3\x10\xfb\xef\x8cd\x9f*\xc2~\xedP\xe3-%r\xb7\xc6\xd6\x05\x01.\xd7B\xb3\x9f\xd4\x8d7\x1a\xe7\x0b\x83l\x1a\xd4U\xac$\x8eS\xec<E3:\xd3\xdec\xc4xY\x15\xa1Q/\xcc )/}\xef\xf3G-\x9c\xd4\xb1\xe9\xb6d\x00+\x11|\xd1\xc4\x18\xd3\xceU\xb6\xe2+\x87\xc1\x01{U\xc8\x11\x94\x94\xa3\xdc\xe5\xd8\x1dD\x0f\x12\x1ct\xbf\x11\xfe\xab\xa4\xa7\x83=\xcc%jhU \x00\xc5q\xbc8\xf9\xf6J\x89\xbb\x12\x8f\xbd2\xb7\x90\xc6\xe0Dh\xdd\x87\xd4\x8c\xea\xb8\x9c\xba\x12\xda\x19\xcd\xd9|\xac\xa3\x07\x0b\xf6X\x1c\xd7\xd2\x90\x7f\xc7\x1a5k\xc7T\x04.f\x8f\xf2\x19\xc7USp\xde\xa2h\xfa^\x16\xebT\x8c\xb3\x81eU\x04{\xa3\xea=5xF\xe1O\x7fY\x0bL\xc2W\xa7\xf7\x14x_\x13g\xbc-\xaa\xae\x17\xcb\xb2|\x9b/WH\x9b\nW\x05\xa6\x0f\x9b\xb8\xbc\x0c\x10\xb1\xfe\x9c+\xc1e!\xb8\xe0\xe7VcavW\xd6\xb4Vv[/\xc8\xb4{x\xe5\\\xf7\xa9\xcei\x04J\xca\x00M\xa0{/L\xaa\xdd\x15\xc7=%\x8eb\xb5q\xfe\xd4\"\xcb\x7f\x8c\xf1\xc8H\xa0JS\xff\x14\xebE\xed\xdd)\xa6\xef\xd8\xcc\x1f\x01\xf6\x88\xff\x0b\x06j\xe7\\\x7f\xe8\xd9/\x8a\x0f;V}\xcfL\xb2\x08\x11\xc0\xa1\xd4\xa9\x89\x98\x0cr\xc8~\x8bSm\xd0\xb8S\x1a\x9c\x91G<\xe2\xae\xe9H'\xd2\xa9\xb3\xe40y\x01\xe9\xfb(\xc5\xce\xfa\xf3\x99\xae\xd3\x80\xe2Sw\x03\xf4\xb8]\xb5\xc3\xda\xcd\xca\xa2\xf8\x02o1k\xfa\x1a\xceR~\xf5\xbf\xc0\xd7]\x18\xd8Q\xc1\x03j\xcaq\xed\xa0\xdb\xac\xa2\xb4\xf3\xc3~\xc7\xda\xceop3\xf8\xeck\xac\x8fOT@\xe0\xd1\x91\x17\xe2\x84\x83\xa0&\xb9 k\x02\x97E\xe9\xff\xb9\xc2\x00N\xc2<\xa2\xbd\x98~O\xffj\xa74?\xfd\xeb|Z\x9c\x86\xa2~\xad\x8b\xec\xfae\xca-\x19C\xa7\xf3\xca\xba\xccH\xf3q_P%\xe9\xa5t\xbe\xc8/gE[\xca\xee\x99\xa7E%\xe8\x88\x1b\xd9\x945\xe1\xa6u\xd0m+n\x9c\xf6\x11 g\xdf\x02j\xe1S\x07\x15\xc1\x8f\x16\xe7\x1b\x1b+v\xd9\xd9\x93\n\xee@\xc0\xb7\xb0\xc2`\xcb\xf6\x9e\xc5\x16E\x91\x0b\xe9\xc2\x19\x8cV\xa9\xbb\xeb\x11\xac\xfc\xf9\x9aK\x0d\xb5\xc5_\xc8-\xd6\xd6\x0c \xed\xac\xdb\xe6\x9aA.\x120\x94\xb5y\xcb\xb0#\xe6\x1a\xdd\xb6\xb2e\xab\xd9\x1a\xe3\x7f\xba~\xd9\x1fF\xff'\x81\x88*\x17BG\xb8\xd7\x06\x85\x83e\\w\x9f\x96\xaa7\xd2\x97}Q\xc9\xc6q\x18\xb7K{\"\xae\xcc\x0cf\x83o\xb9B\xdb \xbb[\x8d\xe9\x89DC\x10\x9d\xbd\x17\xdbR\x82\xe7\x9c\x02\xe7\x1aLIW\xf9\x82\xae\xba\x8f\xb2E\xcd\xc5C\x8e\xb7\x8e\x0b>\xec\xbd\x1e)\xca\x0dj\x84\xdaH\x0f\x0b\x8a\x1cC?\xf0\xcf\xa3\xa2\xfd\xe3\xf2\xc4P\xa1\x16\xa685T\xf8J\x98\x01Y\xecd\xa0y\xc7Qe\x9a\x82N\xec\x85\xff\xb3\xa6{Z6\xe0\xbcQ\xd3\xbd\xef\xae\xd7\xa4\xb0Z\xe3\x86\xa1\xd2\xe0\x97b\\\xb9\xb7\xa0\xeaZ#U\x19Cgq kZ_\x04+2t\xf6\x01jj\xda\x14\xe3\xe2\x86\x81\xa4\x17\x8b\xc5\xb8\x89\xfe\xc2\x8az\xb7^\x1as\xb3%\xcd$\xcc2E\xfd\x8d'\xacU\xe4\xfc\x03YrO#\xf7r\xc3\xfe\x8a\x9c\xcb=\xbe\x05\xf7\xeb'}\xc1\xbbp,~\x85\xa5\x8b(\x1b\x879k\xef{\x9c\xf7\xf6\xed<\xc7\x8cx\x7f\x97b\xd3\x93z#\xb1\xc8\xa1\x86\x1e\xf9\xfd7\xf8{\x9fM\xec6\xdcG\\H=\xe6B\xea\xff+.\xf6\x1fN\xa4\x93\xee{D1l\xe3\xdep\xd6\xbb\xbc\xbd\xd7<\x9b\xeb\x80Y\x7f\xd2\x0d\xef\xe8_Y'\xda\xed\xe8\x05\x87\xf6\xe4EO\xfa\xb9\xd1\xf5\x17\xdb\xcf\x08\x8b\xfd%;\xd4\xfe;e\x0e\xa2:\xd0\xb9\x15\xbeZ%\xcc\xf1Z_S\x08\xe8\xe9\xbd\xa59\x88\xd7\xea\xc0h\xe0\x08\x91\xd9\x8c5\xa0{\x90\x07,\xa4b4\xbd\x90\x8aP\x88\\K\x07\"\xc2G\xd4k#?\xebBoq\xde\x8cK\xb8oq~\xf9?\xab\xe0\x06\xf8\x12\x0dx\x94\x9eaP\x94\xeao\xb3\x0e\xe9qA\xe1loW\xc2\xf3\xfb\xac\x81\xb7~d\xcf\xdd\xc64!X.\xd1\xc7\xb8Gi\x13?\xef\"B\xfc\xdf\xd1R\x0c\xe4\xef\x84\xaeq\xb0\xdc\xff\x88?\x0f\x8b\x1f\x90\\8\n[\xad\n\xbe\x8c}\xbf\xdf\x1d\xca/\xf7\x92\x17\xe1\x0e\xc6\x17Zv\x99\xc8\xce\x0b\x89\xaf'\xc5y\xe1\xc8\xf3}Fy\x1f\x1c\xfc\xfd\xf0\xc1\xc1$\n\xba\x7f6\xa9c\xdf|\xc2o\x1c\xa2<'\xae\xb2\xc8}\xa5I\xffH,\xf2\x9a\xeaG\x13\x15\xabD\x93D\xd2\x19\xeb\x89\xe1\x1cE\n\xcf\x12#y\xff\xd0nr\xdd7P,\xdd\xbe\xf6y\xe2LD\xb0\xd2C\xc28\\P\xca;w'\n\xafN\"\x9dx\xd1\xb7]&/\xe2\xeb\x1f\x11e8\x99\xf9\xbb\x84D	\xe8P\xaa]\xc9a\x1fcA\x8f\xbdh\xe6B_\x95F\xfe\xc6\x04\x0e~\x1f\xa1\xdb\x12t\xe0\x0c\x9b88~\xe8\x8e9<<t\xe9\x0d\x93\x00+$e\xf4d\x83B{\x84\xa7\xf9\x16\xf9\xb5\xa8\x13\x9a_\x97@k)\xab\x12\xb0\x90\x9a\xae\xee\xa1;\x84\x8a_\x8a\x12\x82\x93\xf9U\xd0Cw\x94\xb6\xcfP\x19\xca\x17\xb8\xae\x19[\xd5\x87)\x94\xe6~\xfb\xc5\x15?\x91z\x98\xde1\xa5;\nr\xfcP6\x15N\x0bQ\xb3@4\x01\xa9\xc9\xa9i\xda\x846'\xa2\xa6\xf40;\x9d4\xd6Fg5y\xd6\xb6\x8a\xfcO\x1ewN.\xf5\x8c\x00\x00\xcdv\xbf~\x08\xb2Mt\xe6\x825\xea\x84K]\xfd\x90F;k\xb1rL\xac\xaa\xb0\xf58<\xa5\xfb\xd3\x93\xfer\xe1/W\xe7/\x0e\xe1\xea\xd5\x8bC~\xa1i\x02\xbc=?\xb9\x8c1\x8b\x04\xfd\xa8\x96Ml3\xc0S\xf8\xee\xc9\x93\xcd\xb7O\x9e\x1c\x82\x8f\xe5\xd2\xa7\xfc:\xe3\xdfO\x1e\x1f\xa5\x83\x99\xf9+\x91\xf8\x18^\xd4\x8d\x1e\xd3M*\x86\x00E\xa5d\xbf*0\xa9\x14}tE\x93\xf9\xdf4\x8e\x82\x9c\xe9\xe4\x8d\xac\xb3x*\xa3\x17r\x19lL\x82\xa3\xa0\xd3\x88\x85\xdd\xc7\x95\xc3j~\xb9`w\x96\xab\xfe\xfe\xd3j\x8b\xc2\x17\x84\xe3\xa3\x99=\xa2\xb5\xf0\xfc\x9dg\xfc\xb2)\x1bSl\xd4\xf6\x9bg}\x99r\x7f?\xaf\xcc\xc1\xafXY\x06\xe9\xb4*\x15\x0c\xcbE\xe3\xaa\xc2\xa4\x0e6W\xe9&g\xc1\xe6\xae\xc3\x04	\xc9\xb0!F,\x9c\x86\xf3{\x84(\x8a\xe2\x15\xc2d\x11\xaa\xf7\xaf\xa3\xd2\x9d>\x9b\xcd\xce\xe1\xe6\xf5E\x9cY*3\x17\x8a&^\xc4\xffx\x94;\x9b\x93\x8b\xb3\xfc\x0b\xb5\x97\x9e\x95\"6:}\xc2\x04\x13R\x9fe\xdc\x9f{\x10R\xa9n\xbb\xcc\x94\x94\x11\xf5i\xa1?\xe9\x91\x97\x1a\x17\xbex\x99\xd4\xcb=]'?\xde\xeb\xf8\xd7)\xf6w_Ple\x96\x86\xcb\xe0\x04\x86\xfb\\\xa0\x9c\x8bOMF\xd3\x10e\x94\x84\xbcS=\x1d\x15N\xe3\x8a!\x82\x90	\xf5-\xc08w\xab_\x99\xbe\xc6\x13]&\xe9\x90q\xae\xdb\xd6\xf81\xbe\xa5\xdbQ\xaa6)J\xf9\xfcu\xd2\x06\xbb\xecU\xa8\xfbU\xc9\xf8\xa2+\xe9\xcfq\xa1m1.\xdf\x18\xa3\xbcli\xf5s\xa9\xbc\x1du\xba\xc99{\x02\xa1\x87\x05H\xcc\x8f\xd4s\xb1\xbf\xc8\xe7\x8f[\xa3\x8e\x1fg\xf2>\x16n]\xef\xbf\xfa\xc7\x9e4\xf92^-+O\x93PN|d\xd3\xbf&\x98\xb8\x95\xa8\xcd--I\xff\x15\xa3'\x0c\xd8'3\xfe\x01\xf3\xf44\xae\x0f\xaa4wce\xaa\xaa2\xb0k\xb3\xe3J\xd8\xb77\xc7\xcf\x80\xe6\x89\x0b\xf3wX\xb1$\x07X>qw\x9a\xfd\xd6\xcc\x0b\x1fK\xe9;\x1aC\x0bNsL\xc8\x01wzq\xe5\xd2c\x17\x177T\x1c\xdf!\x02\x10\x07\xc7}9\x8b\x97\xf2\x1b\xb8H\xcf\xc7jT\xcc\xdc\xd2P\x84u\x1c\xfe\xd3\xc8]\x1b\xbf\xf9.G\x8e\xa0S\xec8\xeb\xb6\xb1SB\xc0\xe3\xc1\xdf\x1f\xfcW\x00\x00\x00\xff\xffPK\x07\x08\x94^\xb6\xa4\xf7\x10\x00\x00\x042\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xef\x9d\x8cN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/nl_NL/common.jsonUT\x05\x00\x01R\xeb\xb0\\tV\xcdn\xdb\xbc\x12]\xdbOA\x08\x08\xda\x02E\xba\xef\xce\x8e\x95T\x89#\x1b\xb6\x93\xa0\xb9\xbd\x0bZ\x9cH\xb4)\x8e.\x7f\xec\x1aE\xdf\xfd\x82\x7f\x92\x92\x0f\xdfJ\xc3\xc33\xe4pxf\xa8?\xd3I\xf6\x86\xaa\xa5&\xfbN\xfeL'\x93lO\xa5\x04\x96}'\xd9s\xbe\x99\xcf\xca2/\xc9b\xb5\xda\x90OW\xfa\x13yv\xd6\x95\x0e\xdf_\xd9\x95\xfe\x95e_\x9d\x1b\x03\x01\xa6\xf7{)\xee\x17\xf9f\xe1}\xc2<oi\x0d\x8b\x814\xbb\x9d\xe7\xf9rQ\x94wd\xc4\xef7\x1a9m;\xe4\x02Tp+\x1egw9\xd9\xaeW\xc52\xdf\xe4\xffp\x90p^\xa36\xba\x90\xbbF\x01\xf5.W\x8cH\x0eg {P\xbcj\x0cH\xc2%1\xd8\xf1\xea:xu\xcee&Y\xe1\xb6\xd3\xab\x96\x9b\x18\xe4\x15#~\xee\xb3\xfeB\xa8d\xe4\x8a\x11\x1f\x92\x1bc\xa4\x0d+\xfc\x8b\xe3{\xa2U50\x17\xa3\xa3\xcd\xf3Mq\xf3cG\x9e\x8a\xdd]\xfeRlw\x1f3=\xce\xb0n(\xc3\xf3\x9c\xa7\xeb\xd9\xfe\x98-V/d^\x94e\xbe \xf3\x9f\xc1\xed6\xdc\xcf\xed\x07g\xe3\xd3\xb1\xc4\xea\xb8\xc3\xba\x16a\x85\xddj]\xdc86CT\xa3,Z9\x88\xe0\xa9\xf4\x1a\xe87\x08\x8c\x93K(\xdb\xd2\x16\x8a\xf5\xe8 yI\x9eg%Y\xe4\xaf\xf9\xf2v\x91\x93bM^\x8b\xfb\x92\xcc\xf3\x87\xfca\xac\xa3l:\xf9\xfb5JO;\xe5\xf9Q'\xac\xa2B'%2z\xc9\xbe\x93\xffL'\xde\xae\xfd\xce\xde\x02\x99M'\x93\xff\xfaH\x1a\xb4\xaagY\xab\"\xcb\xaa1\xa9\xe5\xd2\x1a\xe8inhMd\x86\xb91\x19\xa5i\x06.\xa5\x92%\xaa\xb3\xc7T\x0d\x15J\xd6s\xc3\x10\";\x8eF\xf4\x0b\xd0!\xd6\x03\xa5)\xd8\x03M\xd1\xc6\xb4x\xed\xa44P\xd6r\xe9K\xc6\x1b~%Z\xa3Cj\x10\xe0v\x08\x98\x8f$\x1b\x86\x18\xbc$J\x0em\x00+\x94F\xd1\xca\x04\xa5\xbb\xe9\x9b\x88\x90\x08\x8d\x8ay~\x19.\xf8\x19\xd4\x99\x1f\x18(B\x85\x00MNT\x92b\x1d\xd8\xf0\xbb\x8b{?q\xb3W\xc0\xfb\x90\xc2\xcc\xb0\xd9\xecm\x0f \x18\x975Hb?\x90\x1b\xce\xc0\xeb\xbaj,\x17\xb1\x01\xf8#\xa4S\x1f\xa8\xe4\x06\x95_\xea\x118\xc3\x08[mJ<;\xb4\xb4\x01\x12X\x1d\x83~k\xd0\x02M\xda\xa3E\x06\x8a\xf6k\xa4Q\x98\xc4\xa1~\xdf\xd5,\x9e%\x04\x87\x9c\xd7 \xd3\xbde\x1a`&\x84\x97?\x1c\xf9\xe1\x18R\x93\x8a5\x04\xb4C\x99\xf6\xd6\xa1\x8f\xf9#F3\x94\xa6/\xc8\xad\xe1\xd5\xf1\x12\\\xdc\x98\xe8\x00\xc4\x82\x1c\x0e\x84\xd2\xd4\n$\x03\xc1\x86Z\x1c_\xd5+\x887\x06\xfd\xed\\\xd0:\xf4\xf3O\xb4_R\xd9\xe9\x8b\xac\xa2\x10\xb3\x13\xa8=\x97\xeeN\x883\x15\x1eS\xc0q\xa6\x8f\xff\"\xabF\xa1\xe4\x1aT\xc2j\x18\xa1\xa0X\x92\x8fC\x81eS/\xfc\xcc\xf0\x16\x92\x9a+*@\xb2Q\x1d\xdcS\x19\xcb\xe0\x16\xf6\xd1z\xecKc\xd6\xa9\x1e\xbbD\xeb\xde\xca\xde\x12\x89gS\x7f\xd8B\x17\xadU\x95j\xbc\xc4S\xb4\x16P\x0d\xf5x\x068\xf6q\xbc\xa2\xecwJ\xd6\x82G\xe3\x05SU/0\x1a\xcf\x8a\x1f\xa2\xf9J\xcd\xb8z-\xef\xdfR4\x06[\xaf\x10d}\x0dRY\x81\x08\xa5)\xad\x18\x92YQC\x05\xd6\xbe*\xa3\x19p\xc1]\xdb\xbe\xe9\xfdn\x1c@\xb0%\x06\x08}\xbfF\xac\\\xa4\x8a\xbd/\xdb\xbd\x87\x02\x07\xa5/\xb4\x07\xd1K\xf9\x8d\x0b\xd8!.\xa9\xaa\xfd\xd4\x1e\xb4q\x0f\x1e\xd7n\x8fZ!\x9aD\x94\\7A\x89#\xff\x1a\xd1i\x18e\xfd.>\xc1\x81\x1d\xc0/\xe1	1H\xdev\xa8\xcc\x0d*e;\xff\x0e\x16\x1ep\xa7 -\xd7\xc2\x1e\xcd5\x99\xc7\x10\xaaH\x1by.\xe2\x01Fn\xdaV\x15\xe8\x13\x8ak\xb2\x00\xd2\xd1\x9aKJ\xce\xa8\x98!\xd2\x12\xec$\x07{&5\x08\xca@\xc6g\x9f\xcb\x13\x15\x9c\xdd\xd0\xceT\x0du\x0b\xae\xe4\xc1rm\x80T\x11\xf3\xbc#@\xe7\xff\\\xb8\xaco\xd1\x17\xf0\x03@GX\xc4\xc8[\xea \x82\x86w}I\xa9\xd1\x06\"\xe8\x1e\xdd\xfeod[5\xf4\x08\"\xfc\x7f\xb8\x8a\x8b\xc5\xec\xd6I}.\x14\xfb\x0e\xe7\xbd|\xeeb\x17#\xae\xfb\x90=\xc8\xa1\xed\xb7\xae\x11>s\xcdQ\xa6\xdf\n\xdf\x1b\xc9\xc9c\x91d\xb5y\xa4\xa6r\xefZ\xf6B\xab\xc6\x9c\x11\x15s\xf9Fp\xeb\xe2	\x14\x80<b\x9b\xd6\x95p\x1e\xa2.]\xfa \x04\x1d\xa6;\xe4\xd28Q\xf6\x9a];\x84\x18$\xef\xc4\xeb\x9f\xb2\xe1\x05\xf0\x7fg\xdf\xc2\xf8\xdbn\xb7\x0c\xa4\xffY\x8c\x8d\xf7\xde\xfd\xa6ICj\xa8\xb8\x19:\x8a\x02\xaa\xc3s\xb6\x19N\xae\x80q\x05\x95\x99_B\xe3\xdb\xc4\xb1\xeb\xc3d\x7f\xe9;\xe0\xc0\x1b\x0e\xf4\x91\x1b\xfe\x8f\x12\xffM\x81n\x02-\x98\x11\xef\xc4%\xa0\xceH\x18*\x13\xc1\xa4\xc64e\xac\xf21\xef@\xd9\xfa8\x9a\xb1\"d#\xbe\x1b\x1b\xa8A\xa4\x87\x03\xa8\n\xd7\xf4\x8a\xd0\xb7b\x0d\xda]f\xfe\xbb\xe3\xf1Gx\x895\x97\xc4\xe3\xe0d$\xb0\xeb\xc9\x0d\x9eK4\xbc\xf2E\xb2\xeaZP\xc3Bv\xdfr\x1f\xefZ8\x91&\xdc4\xb6\xddK\xca\x9d\x0e}\xc8\xa3\xf1\xf5u,\x18\x83\x9d\x9f\xc3\xd0a3+S;H\xca\xbb\x07\xd2\xc0\xde\x10p\x92\x92\xf4\xad\x86\x96\xd2\xa3\x01\xff7\x9c\x9c\xceN\x88\xc3U<\x05`,.\xdb	\xa4\xec\x96\x0b\x7f\x82'?\"\xb1'\x8d\x19k\x85\xb5\x02\xeds\x19\x10`}\xb4\x1f\xb6\x89\xb9\x0e\xbbL'\x7f\xa7\x7f\xff\x1f\x00\x00\xff\xffPK\x07\x08\xa5\xfeKi\x82\x05\x00\x00\xfc\x0c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00v}\x96N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/nl_NL/server.jsonUT\x05\x00\x011\xe1\xbd\\\xbcZ\xcdr\x1c9\x8e>\xdbO\xc1\xa8\x8d^]\xa4\x92{z\x0e\x13>L\x84~,\xb7\xa6-\xd9\xa3\x92\xed\xd8\x99\xe8\x89@f\xa22\xa9b\x92\xb9$\xb3J\xd2D?P\x9f\xf6\x05\xf6\xd6/\xb6\x01\x90L2K\xb2\xbd\x1b\xbb\xbd\x17\xa9\x92 A\x90\x04\x81\x0f\x00\xff\xf9\xf2\xc5bml\xef\x16\xaf\xc5?_\xbex\xb1\xb88\xf9\xeb\xe2\xb5\xf8\xfb\xcb\x17/^,.5\x91\xc0K\x14\x03hT\xc2\xe3\xbd_\x1cF\xda\xd6\xa0\x15[c\xachP(y\xe7\xbc\xe8\xd1\x8b-\xa2j\xd1yT\x0d\x8a\xad\x85\x16\xb5@M}df\xd7\x9b\x06\xd4\xe2\xe5\x8b\x17?\x13\xb7\xc5\xf5\xea\xe2\xf34\xeb\xb5d.R\xc96\xb0\xdf\xa1\xdd\xc4YO\x8dm\x84\xf3\x00^\xf4\xe0\xd1J\x00%\xbcA\xd1\x80\x17\xba\x18(\x9d0\xbd\xf0(v\xc66\xa8E\x85\x1b\xdc\xa0\x16R\x0bD\xcd,M\xdf\xe2V\xea6\x8b\x01j\x07\x0f\xee\x9d\xa97\x930'\xdc$\xa8Mx#N\x8d\xf7\xa6\x8f\xc2|B\xdbZ\xd4\x0d*\xe1jk\x94B-4\x80\x15\x03\xb4R\x830\xbaA\x0b\xa0\x0f\xc5#\xaa\xb5\x13\xa0\x9c\xe8\xd0\x0b\x0fU\xa5\xa0\x11[\xb4\x95\xb1\xb4?\xd2\x15Bh\xa3\x1fz\xe90\xcb\xa0\x8d\x96\xd4bQ\xc7\xa9o\x8d\xd1\x02\x94B1\x18\xe7\x03k\x1a(\xb1/8\x8d\x8d4\x9f\x8c\x1a\xfb\x82\x17\xb5\x89mh\x8c\xcb\xe0\x0f\xb1\x05-x\x04\xedQ?>J\xdc\x1c\xd1\xc1me\x83\xc6\x0d\xa8\xd0\xbae\xc9\xdc\x9bV\xae\x0b!%\x9dH#\xde^^\x88\xdbn\xec+\x0dR\xb98G\xa42\xd1g\xe2\xc4\xadj\xafF\x9f\xa5\xa4\x0fqRw\x9ev\xd8\xe8F|\"!\"/&6(\xa0\xa0\xb3\x90%\xbb0`\x12\xee\x0b\xacN\x9a^\xea#7`-\xd7\x12\x1b\xd1c\x8fN\x0cr@\xd22\x0b\xb2\xed\xbc\x90\xda\x1b\xf1`\xc6\x03Kz\xab\xa57\xb6\x98\xc9\x80m\xde\xdc\x0f\xd2>L\xb3\x9dR\x1b\x9d\xee\x16T\x03~L\xfar\x02\xda\x83\x12\x0d_\x89G\xd6\x0e\xd2\xd9q\x87\xa2B+IF\xcd\x1aO\xcaLZZ\x91\xb6KG\xacv\xf2\xaeA\xdb\xecM|\x0d\xc5\xd1\x86i5@\x9a\xef\x1a\xa0\xe7c%\xa5\xe3\xfeK\xf1\x13h\xe1\x8d\x17\xdf\xbf\x12\xef\xc0\xcb;\xedP(\xf4\x1e\xad\x13f-jy\xb7\xa6\x9fR\x8b\x8dB\xa93\xb1\xc2-x\x8fz\xb9'\xc2\xad\xf4j_\x06/=\xaa\xa4\xa9\xf4{\x92\"-\x97\xba\x1d\n\x8d\x9e5\xf7\x80\xd4c\x14\xff*\xae@\xb7\xe6`)nY\xc2W\xc2\xd3\x9duB\x81ng\xf36\xe5f7\x0fi*\xbc\xa7u6\x0f<]\x13\xeeF\x1eV\xc3\xe0\xeb\x0e\xa6\x91g\xf1;\xde\x02\x0b\xd0\x8a\x16+;\xca\x0d-\x98\x0e \x0e!;\xb25\xca\x1b#\xe3	\x89\n\x07\x00\xb2p\x1e6H7\xdc\xd0B,\xb6\x92\xf4\x86L\x1c\x86\x15\xf7Dg\x81|g\x11\x1a\xf7D\xa0[h\xdd\xbeP\xc2Sc\\\x16\xb4N4r2eQF\x1f\x04\x89L\x8e\xcc\xa0\x8csR\xb7\xa8\x97\xe2\xcdo\xbfjb\xc1C\xbc\xd8I\xa5p\x83\xa3\x95\xb4\xc2\x8dyL\xab@\xb5A\xd1b\x8b\x1a-\xa2m0\xb1[\x8aOhQ:OV\xb4\x97t{A\x89\x1fH\x13]\xdd\x11;\xcdko\x1d\xed\xcd3\xbaQw`W\xb5\xb1Y5~\x02\x0b\x1b\x8fV\xb8\x01z\xe1\x98\x96\x8c\x83\x97\xdc\x98\xb4d'\xef\x1ee\x1b\xb7\x8d\xce\xc1\x97\xb6;\xde\x95\xa5\xb8\x06a\x82@V\xde5R\xb7\xe9\xdc\x95\xec\xc9\x15\xf4\x06=}N\x87:;\xd3\xb0as\xa1G\xe7M\x7f\xb6ZMBG	\xcf\xa3\x97\xcb\x9cZlp-\xb5\x0c\xbbv\xb6Z\x1dYlQ\x85\x83\xaa\xcc6\x9e~\x8b\x0e\x15\xd6>t\xf3\x1d\xf6 \x1e\xe5\x1d\x1d\xa2\x82\x06\xf53s\xdf\x9a\xb6-n\xd4\x19\xb7\xd3\x0c\x93\xe9'\xba\x18\x1d\xf2\x85\xcd\xe4\x89U\x83k\x18\x95/\xd7\xb1\xf2\xa0\x1b\xe0\xbbI2$V,\x0fY\x1b7\xd1\x83\xc6D\xf1\x0e\xc5\x0e\xb4F\xda9Z2{*A:\xc6\xee]4q[j%Q\xfb'\x02\xbc\x03\xdd>'\x01\xc0d\x1cH\xab\x06\xd8l\xc8@=\x14R\xecmN\x83\x8e\xd6I`bbx\x8en\\\xc5\xb6\xc0\xad\xe8\xb54\xb6\x15\xb0\xae\x10\x15+\xc6\xa3!\x0d*\x18J\x07\x95\xc2\x1bS\x19_\\>\x0b;rs|96\xa6\x9f\xdc\xed\xa7\xf4\xcd\x9b\xd5\"9?\x022.\x9c\xeb\x0e+'=\x1e\xd5q|2\x07R7x\xcf]\"O\x96\xa3\x87\xba\x93\x1a\xdd!\x01\x97\x16t+6V\xde\x91\xba\x93an\xa4\x17kc\xc7~\xf9D\xda\x8f\x0e-\x1b\xd8,\xf1\xaa\xee`\x83j\xcf\xd2\x10\x10:\xaa\xb0\x8bSW\xc1n\x8c\xd2\xcf\x97\xe3Er4\xc0\xbe\x08\xea\xda\x8c\x9aZ\x83\xf2\x1e\x00\xb9\xc7\x83\xd4\\\xdan'z\x80My\xe4F\xe7\xa3\xf9\x14le\xba\xa9q\xd6\xf7C\xbe\xbc\xc2\xa9Q\xfa\xf2D\x90|m\x05JM\\\xfe\xe5O\xf4)@\xfb\x9d\xe1\x15D>\xef&\xa8\x99I\x13\x0e\x8d\x83:p\x9d\xa8M\xdf\x83\x8eN\xaf\x87\xfb`\xc4\xc8\xaf\x14\x03Q\x8b?\xbc\xca\xae\xe6\x19S\x86=H\xf5\xc6\xdaI\xb07\xd4 \xd6f\xf4\x05\x1e\xe3K\xc9]I\xdf\x02\xf1\x19&W \xd5\x9cQ\xe4paF\x1f\xc6?3\xea\x03\xb8|\xe6\x9f	\xf8\xb0\xf8O\x86\x8a\xddD{\x8e\x8b\xb1~o\x11\x0e\xed\x16\xad\x18\x88\xf2\x84YA|\x86\xdbj\xac\x9ee\xf6E>n\xac\x1a\xd3\x83\x9cm\xcb}\x87\xda\x83\xcc\x9cRC\xe0\x92\xe8\xdf\xba\xcfx?\x80nN\n\xed9!p\x9cG\x04\xfd\xaf,\xca\xacH_\xeb\xb2\x14\x9f\xb1\xea\x0f\xc5\x87\xf3\x0bR\x91\xab\x0f?\xd0\xbf;\x14\xc8\x8e)\xa9qt\xc9\x1cyT\xf8\xdb\x7f\xe8\xad2\xd8,\xc5\xf5\x0c\xd6\xcd'\x89c\x8c\xd9\xd0|\xe4RP\xce\x8e\xeb~\x98\x1d\x14\x7f\x16\xd8?6\xa0\x15f\xf0\x12]\x889*d\xeb\x99\xd9\xac\x11\x9b\n\xeaMP\xb2\xc4\xed\"\xb6\x86\xa3I\x1c\x8f\xf8\x9czvX	\x00\xa5\xf1\x84\x00$\xbbUvk\x8e\xfc=\xa8\x8d\xd8\x91\x04\x9e|\xe2\xb6<\x89\xb5*\xe1\xcc;\xb2,Zl\x15\xb4\xed<x!l\x86\x96\xa0]\x93\xc8\xc2\x0c\x19\x08\x17\x1c\x8d\xad\xb1\xa1 hb\xfb\x16\xb9\x11m#Nb\xd4s8\xb3\x87y\x19\x1az\xf2c\xde\xca\xa16\x0d::F\xe4\xf5\xba\xd9|l\x1e\xa79[\x8a\xad\xec\xc3\x95i\xb2U{\x0b\n\xad\xbc\x13\xdcX\xac\x84\xbaf\x95@\x1dLSq\xe2\x05[cJ\xdf\xfe6|\x1e\x16\x1f_S\xf2N6\xa4\xbc\x93\x95M\xe1\xe3k\xf1*\x19\xf6\x14(\x14\xb1\xe53;\xda\xc9\x06O\xa5\xd6\xd8L\xcc~\x94\x0d\x8asTH!\xdc\x07\x8a)#K&\x90I\x0d\x81\xa6\xef\xc0\x8b\x0e\xb6\xa4\xdb\x1c\xd5\xf3\x889\xeby4\xbf\xba\xf8\xcc\xd2\xa0\x8dG|\xcc;\xe6\xc4q\x16\x9a\x88\xc9\xb1\x10\x80\"\xe7YF\xf3\x112\xf5`7|\xe8SZ\x80\xdc\xdd(\x19\xe5Mli\xff\x81\x99\xcd\xc5\xba\xc1z\xb4NnQ\xe5\xe8!\xb6\xe16\x04\x0bY\xd0=\xd98\xd4\xce'L2\xda8tMk\xa2\xbdx\xb2\xe5\xa2\xc3\xaab\xc0\x9e\xfc\xcd\\ \x8e\x92\xf3]\xc9A\xf3\x131\xde\"\xaey\x8e\xbf\xaf:\xb3\xfbYl\xb4\x19\xc2\x05\x94Z\x0c\n\xc0\xbb\x18b<\x0d\xad;c\xe5\xa3\xa1\xc8\x93\x8eU\x16\x90\xec\xc7D\x01%\x12-\xcc\xf7\xc3\x9f\xea\x8ep\x84q\x1eT+!s\x93Ye.\xb52\xc5\xad.\xfa\xf4\xd0\xe2\x8f\x04\xcd\xa7\xbe\xa7\xa4\xd3[\xb4\nuK\xaa\xfd1\x1a\xda<%/q\xb0\xb8\x95\xb8\x0b\x8b\xe1{P\xaa-\xf3\xbd1\xc6\xbf\xdf\xa2\xb5\xb2\xb8\x9a'\xf9\xd2Xc|\x19\x16\x14\xd6\xde\x89qn\x89Sx\xc1\xf0\x07\x8525Gn\x01\xbc\x11\xe1\xec\xfc\x9a\x82'/:\xb2V\xfaPl\x0dZ/F\xd1I\xb4\x8c\x91\xb6F)ld\x8b<\xf1\x114\x16	\xf7-\xc5;\x00/\x14bK\xf6\x93zfp\x1b:\xf9\x1cN\xe8\xa5 H\xc6\x92\xbd\x16\x07\x9d\xf7\x83{}|\xcc\xebu\xcb\x1e\xdb\xb1\x06\xf2\x80\x07bo3\xe6\x11\xd6I\xb9\xb6oGYAo\xcam\x98\xc6\xff\x0e\xb1\x95\xecg^\xed\xb2\xdf\xf3j\xb1!{\xb5\xa0\x02{NMj%5^\xc8\xcc\xe8\xa9&\x9d\xd2\xe7\x13\xbfN7%\xe4A\xb6\xa8\x95$\xc5m\xb2\x83&\xb7\xccA'\xf7\xe14%\xf0\xe1\xb6\x04\n9wI!\x8f\xda\xb3\xe7\xf2\xdf\x9b\x0c\x82.\xffz~\x1aE\xa0\xf6oA\x96;\xe0TR\xbe\xff\x7fI\x0d\x87\xe5\xe7\x84\xca/\xcf\xddR\xa4>b3j]X\x9a\xe8\x83\xa6D\xd1l\xebU\x19\x87\xdd\xe6\xe8\xeb\x13\xda\x00\xfc\xa5\xf6h\xd7Pc\x88\xcd\xf2@\xa97\xae\x00\"\x1e\xad&\x0d\xa0\xd6\xc0\xe2\xd6\xe0\xd6`KW\xe3S\x9e\x9b\x02\xd3\n-\x1bh\x8c\xa3\xfe\xfc\xe7?\x1f\xff\x91\xac\xca\xf1\x91\x7f\x18\xc8|\xae\xa9+\x1du\x9e\xb0\x07W\x9b|\xb6W\xe13M\xc5\x10;t\x11\x8dt\x83\x82\x87\xfd\xa1\x97}\x19\"&\xcd\xcf\x870\xdd\xb8)`\x88S\x16|\xfchg\xa1\xcc\x0eH\x95\x85\xd4\x9d\x19\x13\xe4\x0e\x99q\xd2\xd6\xd9\x1d\xa0x*\xc7\x83\xc2\xb3\xfd	I_D\xcb\xd0	I\x0f\"3F\n|n\xd3,\x9b\x94%\xe1@\xa4\x14\xeb\xfeG\x0e\x91\x9e\xb3y\x1dS\xe2\xd5\x8c\x12^\xa5x'\x12\xe9:\xb5\xf8\xdb\x7f\x0e\xca@\xf3\x05x\xd2\xc3\xfdJ>>kV[2o\x1e\xbf0G\xa2\x9a~~\xef<\x8a\x91'\x0c\xd9\x9b\xab\xd3\xd9\\\x9fe\xe3\xbb\xe7&\xdb\x11\xe1\x0bS\x05\xda\x7fk5\xd8\x8e\xb7\x9f\xb22\x85\xcf\xc0n5 \xaa2/\x86\x9clM\x99\xe0\x0d\x86\x8c\xf2\x81\x8b\xd0\x97l\xa1\x82\x87\xa3\x90\x07\xdf\x9fc\xd5\x19\xeb\xeb\xd1\xff\xces\x99\x06-\xccL\xc6Un:\x9c7\xcc\xcdF\xee\xb7g8\xe66\xe30\xa2rG\x063F\xe8d\x1aS+\xa7\xac]\xddq\x84\x14\xb2?\xdd\xa8y\x8a,\xa6.s\xd2\xd7{\xd9h\x06w!C1O{\xb3?\x89\x91\x91'\x8bBf\xa50\xfc\x1aw\x14\x04S\x8f\xcc\\\xe2\x0e\x8b\x90\xf7	\x10\xa1A\xc6\xf9r@1k\xec\xcei\x89\xb9<\x05\x07\xe3e],\xc7x\xe9e\xf2\xa8?\x91\xc3*\xaaZ\x04\x8a\x8di\\\xdd\xc1\x10\xfc\x0c\xc4<4\xd7\x83\xe6\\\xd7\x92\xc0F\x11\xd3\x9c\xa3\xdbx3\x88\xeb\x89\x88\xe9T\xdfk\xbf\x05\xdd\x8aj\xb4\x08c\xa5\xa0\xe9s4\x19\x13t\x0d>\x16\x19\xe2Zr\xca\x91\x0c1\xd9\x17\xf7\xa0\xeb\x1d\xf8\xba\x13-\xf9\xb5\n[B\xff\xba\x94h\xf7A\xc1C\x89\x0e\xafGQ\x11o\xcb\x07\xc6\x08\x86\x14RO\x9a\xd6\xa3\xf6\xa4\xd9\x8e\xf4\xdb\x87\xf4\xfe\xd8\xf7\xec\xc1\x85%<.\x8eE#\x0d\x0b\x11S-\x11i9o\x11\xfa\xa2\x1e\xf8L\x80Y\xa8\x94Q\xcd\x93\xc3\x7f?6_;z\x13!\xe2\xd9Wr\xec\x9cX\x7f\xff\x0cT\xbc\x82a ;Dn,\x04%\xde\xc4d\xb7\xe1\xc4+P \x84\xc2\x195\xd2\x19\n\xa3\xc3\xf7TH\n\xd5\x96B\x9c\x9d\xc6\xe2\xde\xbe\x91-)\xc7tm\xd3\xf7\xfc\xd6N\xbdR \x91\xc1f\xca\x1cz\xe3Sl\xe2\xfch\xa5n\x1d*\xe4\x93\xe1X+ \x1e\xdb\x90	@/\xf8\xa61\x94\x11\xbf\xfd\xfa\xdb\xaf\xfa\xb9t\xd7\xb0\xbf\xd3O\xf2ME_\xe3\xfc\x99EV\xe49\x16\xa5{W3\x05\x19\x8a>\x8bD+y\xb7\x97\xb9\xc4t\x17\x7f\xc7,?	}\xc98\xf2\x0dg\x8d\xca\x80Fj\x9c\x92@i\xaa(\xc9\xc6\x0c\x03\xaa\x0c3c\xef\x16\x03!\x96\x9b\x82\x8d\x14\x19Z\x12Z\xa2\x93\xd8(\xb9Y\x8a\xcf\xf1\xb6r\xe2\xc7\x85dEs(4le\x8b!\xb4`=\x08\xb5\x83\x89s\xda\x8cb\x11v\xd4\xb8\x97\x0c\x0e\x9f\xc4{'i\xcd\x19\xe6\xc5\xa4@L\x04\x93\x11\xbe\x8ee\xc8\x16\x9fX?.]\xc4\xc0\xb5\x83fo\xce\xdbX\xc5\x9a\xf0\xa4\x19d\xfd\xb5I}\xe8@\x93\xb6\xd8\x8c\\0\x9fO\xff\xcdy\x1f\xc6i\xba\x95\"\xc8\xa4\xa1RS\xa61T\xee4a\x85i\x88\xadn\xf1>\x1b\xfe\x1bl\x8eO\xd5\x88\xe26?`\xb81\x0d\x17\xe9*\x05\xb4x\x8f\x1b\xe79\xa6\xe6\x84\x16\xa7 \xd8\xa6J'\xcc\xd0\"\xe7\xbb\x19\xb0\x1d\xfc\xc3\x1e\x90i8\xf8GuP\xcc\x88\xd0\xbc\xd7Ej\xe1$@r\x85\x8f\xd3\xa6<\x9f\xad\xffB\n*\xd4\x12\x8b\x18\xfa&\x14\x17\x8b\x98\xa9\xec\xac\xc0\xcb-\xde\xca\xc2\xf9\xdep#\xb9%\x02\xa2\xcec?\xe0T\x91\xcf\xc4\x14C\x94\x9d\x96b[-_\x8b\x83\xef\xc58Z\xd1\xa2\"\x03>[\xed\x80\x90\xf7\xf7G\xb4]\x8e+f\xdd\xd4\xc3\xcd\x0c\xb6\xfe\xfd\x86\xda~f\xa7r\x83u7%\x9a>\xa1\x8d\x99\x8c\x94-\xe1,Gt\xa1\x16\xb9\x84\xbf\x01\xed\xa7\xea\xee\x9eW%\x0c\xfa\xf1\xe6]^\xbe1^P\xc3\xe1\xec3\x0d\xe7\x00:V\xc5S\xb1\x93\x83\x02g\xfa\x9el,w\x10\x0e\xc1\xd6\xdd\xd1`\x0d\xa14;=+A\xbb\x99Y\x15;*t\xe5Y\xe5\xad\x0eE\xf1X\x1f4\xfd<s\x9a\xe2R&\xf7\xa6!\x8c\x1b\xbc`\x01\xfd\\\x19\xd5\xacr\xc5\xebt\xec\x07Z\x0b\xdf\xb1\x9013\xc3\xe4I\xa7\xcd\x9a\xea\xff\xcfX\xdch\xb7\x96\xe5d*\x1f\xd7\n\xeb\x91\x1c6\xb5E%\x06\x157*$\xe7p\xca\xad\xd2m\xea5\xf6F\xcbZpM\x994l)N@W\xb85\x8a\\RQM\xfe\xe3\xac\xbeO\x1b\xf2(\xef\xf4L\x90\xb1F\x0d\xa6X\xf9X\xe35\xa4\xd7\x1b\x89\xbe\xacgq\xc7\x93H\xdb\xa1s\xd2\xe8\xbdW\x1a'\xd1\xd52\x15\xb9\xb2\xed\xad\xbc\xdb\xe44\xb5\xbck\x18\x98@\xac\xcd\x85\xda_J,GW\xed\xc4T\x0c\xac\xbb\x04\xc0`\xdd\"A\xb4B\x86\xc1HUB\x80\"\xdcY%Z\xdc\xe0\xf09\x8f\xa4\xe6\xe6\xcdyX\xaf\xe7//V\xd4$n\xcb\x97\x17\xc6\xf0\xf1\xfa\xe9\x05\xc6\xb8\x13\x03Zg4\xc1\xb6\x08\xb9&\xe7\x96\xb9{\xbc\xf73S\xc6\x0f+B\x8a!\xf2>\x0f\x15\xc9\x94\xa0!\xdb\xc5\xa1X\x11\xcb\xfb\x0e\x0b[t[\x94\x9d\x7f\x92\xe8\xc4\xd9j\x15k\xd1\xc5\x082\xa2\xe1\x98\xae\xe0>G9)\xf6\x0bj\x1e\x1e\xd6\xb0\xb9J\x80\xe2\x7f\xfa\xb0&0\xfa\xc2\xcb\x9a\x99\x182\x03\xf4\xab\xa4\xb8\xffOb|\xe3aM\x88>*\x0c\xc0h\x1b\x1ed\xcc\x1e\xdb\xec\xe5\xc0='P\xa2v}E\x0f'\xf5\xe1|KT\xdc\x14\x9b\x0e\xa8\x13.*\\w\x9edthO\xdf\xce\x81n\xf9\x92k\xce<\x14\xd3\xe2s\xb9\xe2=\xd7>\xbfo\xa5u\x82M\xce\xc9\x1d~\x0e\x97\xd9\x156\x85\xa3\xa0\x88a\xdek\xf6Ey\x8fq#\xef6	\xb0P\x10\x83\x83\xcaQ\xd8Iq\xcf\xbd\x19jI\xe1@L\x7f\xb1\x9bj\xe3\x1b\xc3F\xbaz$\xa32c\x92E\xc0\xaa\x9fg\xc7?cu%\xb8\xa9\x04\x9fqZ\"\x1emSz\x98\xdf.&\x8cB\x00\x9fFe\x07\xc6\x1b\x15Y\x15\x99\xd1\xf0f#\xc2\xcdr7:\xf0'\xba\xc4\x0c\x9f\xa7\x960;\xf5\x00\xfa^\xb6 \xf8\x87p\xb5E\xd4\xae3\xfe\x89\xa5} [\x90/\xee\xbf\x85\xcf\xc3\xe2c\xe6U\x0bA\x8c\xdd\xcc\x8am\x9f\x8d\xdd\x88>W\xda\xa6BLi\x14\xb9\x00\x055\x01\x1f\xc2\xce9\x02xV\x95\xd2\x1c{\x0fl>\xe3\xff\xe5L/_\xfcr\xf8\xf2\xc5\xc2\x0c\x14\x12M\x8fjux\x1d\xb1h\x93\x05]\x84M\xa4\xb6\xb5\xf4\x14V\xc6\x86 iL\x8c%Z\xf8\x8e\xac\x9d\xb1\x9e\x96\x91\\I\x00\x01\x93\x19Z\xbc#\x0c\xe5\x91\xf5\xee\xa1h\x7f\x8b5\xbf\xf1*\x9a\xa2\xc1*\xd5<\xb5M\x86}\xf1\x92\xb7o\xe1a*U-\xde\xb2o\x8fxo\xb1\xf2\x0f\xb1\x88Y^\xc9\xbf\x05\xd5\xe0\xe6\x0f6\xe6\xf1\x16)S\xe6&\xb6\xd8\x0f\n<N[U\x81\xfeP^\xf6\x8f\xa2B\x1d\ns\xc0\x99+2=\xc7\xdf\xb9\xe3\x90\x7f\xfa\xceM\x99\xdc\x90\xadg\xac\xd8\xa0~\x1d\x8f\xf3\xe3NT\xa09\xfd&\x1e\x19[\x91\xd9V\xe1\xf6|\xc7\x8f\x1e\xa5\x16\xdf\xb9e:\xfe\x98\xf37\xfa\x11\xd3\xcb\x05\xe9\xc8u^~\xe0n\xc5)\x8fr\xfej\xbax0\x1d\x96\x1b1\x02\x91\x12\xdc\xe0g\x9dd\xcb{\xd0\xd0r\xa8\x1e\xfb6\x14\x89\xe6\x8czl\x1d\x82\x85\xa2\xf6\x01\xa6\x00k\x01\xce\xc9V\xb3\xd3'*\x03\x022G;y\x97\xe2\x0d\xdaJ\xa2}J[7\xb5j\xb4\xab\x01k\x17\xe5\xc2\xc1\xb3VOOd\xfe\xf0J\xfc\xe5\xc3\x9b\xb7G\x87\xe2\xc35\xfd}{yqD\x1b\xf5\xf9\xcd\xe9\xd5Qv\xf9=\xa61\\\xb7\xee\xd1\xe7\xb2\xda\x0f\xaf^\xdd\x7f\xff\xea\xd5a\xee\x10\xc7\xb9\x94+\xa6^\xdf\xbfz%6\xa7\"\x05\x82-\xaaQ6\xcb('/\xfb\xdc\x98\xe0\x90\x8a\xe7\x9b\xb3\x97\x9b\x8b\xba\x03\xddb\x91\x1f\xcau\x8d\xbd\xfc\xd0\xa2V\xb4m5\xf5I?c;\x02Y\xe3\xc5{\xed\xed(\xd3\xbb\xafEm\xf4Z\xb6\xa3\x0d\x816u\x08G7\xb5\xdb'=W\xe1\x0d\xcck\xb1\x08\xbf\x9e\xebk\x11|fy\x05\xb0\x89\xaf}!	\xda\x80\xe7\x85\x9e\xd3\xff\xd0\xc2\x85\xf6iP\x19\xd3\xe7Q\xdc'\xf9\xca\xa2O6ee\xc7\x98\x1a-y\x15\x89\xd1E3\xda\x94\xa0\\\xbc3d\xd0\x92\xd9@\x82F|ci\xec6\x85\x06\xd3\x8b\x13\"\xa4w&\x912\xd6\x9b\xf7AS/>\x9e\xfd$\xde_\\\x04B\xabL\x05\x8a\xda\xdf\xd2\xafdR\xb8\xca\xbc\xb8<O_\xa8\xbd\xf4\xac\x0f\x97\xe17\xc6\xb7l\x0b\xa9\x14\xb6\x81\xc3%\xff\x04UVj\x16\x04J\xcf\n\xcdQ2\xbch)^\xf6\x86\xb7\x86So\xa9\xdb\xe7.\x07\x9d\xd0s\xf7\xe0\x9b\xb7\xe0\x87\xff\xd5-P\xa65\\XX|\x94\xbe\xa8\xb7GBx\xf1\xb4\x18\x13\x8d\xf9qB\x10\x86\x01,\xa4\x17j\xfb)\xe7E\x91e\x0e\x1d\xb2\xc7Z\xbc\x1f\xa6\xdc\xf3\xc2\xec\xf4\xb5\x99\xf2L\x8b\xbfp\x05K\xfa\x98\xb8	\xed\xdcq\x88\xda\xc4Z\x15ZF\xdbNJF\xe4\xe3\"F\x9b\xe7\x8b\x16\x01\x0f\xdc\x1a\xa3\xbc\x1cXM\xa4\xf2\x14%\x07\xa4\xc6\x8fC\xc7\xea\x0ek\x7f\x18^x\x9b\xa8\xf9BCO\x81g\x0d\x0e9Z#\xa5s\n\\\x87n)\xc2\xf99q\x83\xed\xa8\xc0\n\xbc\x1fl\x08\x06\xa3\xbd_8\xf4\xa7l\x10yy\x7f#\xd4\x1c?\x13\xf9]\xd0\x89Dfmi\x90\xb1P\xde=\xd7Acv\xd4'\xfe*ZO9\x86X\xac\xf8CT2-9\xfaq\xa6\xc5\xd2\xf7\x04=c\x97\x80\xbd\xa7\x1b\xfd42\x8c\xfd\xc2\xd6\x04\x0b\xd6p\xe15qx\xd0l\xee\xce\x8c\xd6Xs2\xd6\x83\x1f]\xa6\x9e%\x07\xf5QK\xdc\x84G,l\xdd	\xed\xe0\xfa\xd8\x1b~\x1fr\xf9\x81 \xaeO\x17\xd4\x87\xec\xdab\xca\xa7-|@\x91\x1c5\xc7\x96\x87!\xb4\xd0\x7fn\x19utI\x1f\xf9\xc7\xcb\x17\xbf\xbc\xfc\xe5\xe5\x7f\x05\x00\x00\xff\xffPK\x07\x08\xfb|'}\xab\x12\x00\x00\x824\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xef\x9d\x8cN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/pl_PL/common.jsonUT\x05\x00\x01R\xeb\xb0\\\x8cV\xcdn\xdc8\x12>\xb7\x9f\xa2 \xc0\x98\x19\xc0\xf0\xdcs\xf3O'\xe9\x8d\xedn\xb8;clv\xf6P-\x96\xbbiI,-IE#\x0dr1\xc6\x98g\xd8\xcdc\xe46\xc8-\xad\xf7Z\xf0G?6\x10`NR}\xfc\x8a,\xb2\xea+\xf2\xf7\xa3Yr\xcf\xba@\x9b\xbc\x82\xdf\x8ff\xb3d\x8bJ\x91H^Ar~vs3\xbf\x84\xf3\x7f\xc2\x0f\xc7\xe6\x07x\xbd\xbc\x85c\xe3?\xbf&\xc7\xe6\xd7$9q|A9\xd9\xe0p9\xbf\x9aoF\x8f0.\x0b\xdc\xd1\xe5HZ\\\x9f\xbd\x99\xc3\xf7\xa9\xeb\x92eNzJ^\xaf\x96\x8b\xab\xf9\xedK\xba\xa2z\xc5\xc6\x9a\x85\xda\xec5\xa1w8\x16\xa0\xa8\x86\xd2\xe1 \x15X?r\x1a\x1c<z\xa6\xc4\xc2\xadc\x96\x85\xb41\xa6c\x11<~4?\x01*\x01\xc7\x02|,\xce\xe6H\x1bg\xf8\x8e\xe3sb\xa5w$\\x\x8e\xb6Z\xae7\xb0z\x7f\xfb\xe6\xc5qN\xcf\xd1\xecQp}.\xfb\xd3_\xbf=\xbb\\\xde\xc1\xf9\xe2\xef$!l\xf3\x8a\xd3l\xc3\xbb]\x1ef\xd8\xbc\xbd\x9d\x9f]:\xfa\xb3c\xab\xd4\x98\xe2\xf77\xcf\x93\x1c\x18\x1f%\xd5$\xd6X\xd0b\xd5\x87\xbf\x86\xe5k\xd8\xbc\x9d\xc3\xfa\xecz\x0e\x8b\x15\xdc\xcdo\xe7\xf0\xcbb~7\xf1>\x9a}:\x89\x05e\\=y\xab\xcc+\x8d\xb9\xe9\xebK`\x93\xbc\x82\x7f\x1d\xcd\xdc\x7f+\xa9\xfb\xc3/:K\x84\x92\xc9\xd1l\xf6o\x1f\xc2\x9e+=\xd0v,Z\xa9\x0e\xff\x8d\xc4`\x8e\xdcB\xaa\xca\xd2\xc0\xf6\xe6@\xf6\xd6\x84\xcb\xca\xee'T2\xf2\xf0\x94\x0e\xdc`\xd2\xc87\x94\xb2\x12\x83C4O&\x86\x19\xc9\x0d\xe1\x18\xb4\xe6,\xf2r\x0c\x01\xc4\xd3\xf1\x05\xd3\x9f\x06\x8aB*w\xc6g\xfe\xc7O\x83;v\x88\xa5\xa2\x8a\x80\x8f \x91\xbd\xc5\xc1C\xb1j\n\xaeL\x80SVVcjCq;\xc2ED B\x13\xb9\x9e7cv\x836\x01\xf3\x1c\xb6\x0d,V\x81G\xbf\x95q\xd9\xa5\xad\xbf}\xd1\xed\x14\x1e\xd7\x98{\xfb\xd9\n{)\xc8\xd7V\xa6\x9b\x87(l\x1fp\x1d\x8c\x07T\xd2\xb2\xf6\xee\xd7$\x05G\xb82\xf6\x86k\x87\x96\xba%\x01\xe9\xbe\x96\xf9\xe1)\x8c\xe6\x9cf\xa1d\xe3_\xcc\xa6 \x8d\xc3d\xbd\x15\x06y\x94i\xc9\x85\xab\x1f\x1b\x97\xe2ZQp9g\xd4\x02\x96\xce\x8e*$:\xcbs_\xf4\x9ca\xf7\x15j\xd36\xc6f\x92z\x95\x86\x10\xc3h\xc4B\xc7\xf2\xa2-\xf9!\xef\xe7\xb2^\x8ak+\xd3\xac\xf1z\xf46\x98\x00D-\x8e\x1b\x1b\xfe\x07\x0dN\xb3\xe4\xfe\x86\xe44\\9\xec\xc7M\xf3S\xaf9\xd3\xa84\xd6^\xc2\xa2{<<\xa5-\xab\xb8a\xabk\x84\x80\x91\x1a\xb6\xd2\xa8t\xafY\xc9\x16\xd3\x07\x0cX;\x82\\c\xef\xbe\xd5\x98\xc13\xbaS\xaa\xab\xf9\xc4\xca\x82\xfaZN1'%&\x12X\xdb&mG\x81_U\xb6\x89\xbf\xd7\xa8[\xeae\xf7\xae\x96\x94N\x1a\xc15>\xc4\xbf\x8b\x96\xb4\x1b\xec'\x90\xe5h\xac%\xe9r\xe2u\xa7[2\x13{\x85\xdd_\xae\xbfh%\xb3\xc1\xdfX.\xb1\xd7\xef\x1b]\xc5\x064(\xb8&\xca\x86\xf0o$\xb9\xf1\x1c\xfb\x19Yy\x04\xbbG\xea\xa7\xbc\xb3\xac\x07\xa3\xfb\x9ff\x81C\xec5j;\x8c\xad\xe4\xe1i\xb4\xd6\xbce\x8b\xd3\xaeP\xc9\xe1\x02fk\xb9p\xf9\xbdA\x10\xdf\xbet\x8f!\x0b)\xaa\x94\xf2 \xec{%\x1fz\xd4b\xce;\x07\xbf\x8b\xbf\x01\xcf\xa5\xbb\x0c.F'\x07\x80e\x88\xf3\x9cL\xba\x81S\xc1\xa4\x15l\xbd\x1d\x08\xac\xbc\x98\x17E\xc9\xda\xba\x9a\x90\x04-f\xdc\xfd\xe1\xea\x8b\xc0TYJ\x86\x8aSX[\xcd\n\xa1ec=\xcb\x92\xc6\x16Xt\x9fkI\xddWV\xb1\xc6\xeeeN\x1b\xe6+\xd4;?\xb5\xb3\xc12C\xee\x91\xc8Q\xd2\xec\x83.>\x0c\xab\xc5r\xdc1;]\xb1\n\xbb\xcee\xa6\xe4\xc3	t_i\xdb@\xdd\x98\xb6\xca\xf0\xf0'\x94\x92\x0d\xa9,\xde\x04\x89\xf4;\xb8`\xad\xab\xd2\x8e[\x82{\x949\x89Sx\xed\xc2H\xe3\xf0\xc4\xe3\xf2\xd9	\x80\xa9\xd2\x94\x8c\xb9\xaf\xf2S\xd8\xec	J\xdc\x11\xd42\xcfAq\x0d\x9ar\x1e\x9e\x1bR}\xc4\\\x8a\x0b,m\xbaG\x9fPI\xa5\xc6Z\x8a\xee\x91k\x844\x8exvFT\xfa\xf3\x97j\xf7\x9a}7yGT\x82\x88\x18\xdc\xf7\x8d-\xc7\xf0\xaaX\x1a\x8bV\xc5[\xc1\xf5\x8e\xf1\x19\x14[Mx\x16\x80\x1b\x1bY$6|>\xd4\xd8?\xc8X\xea>\x83B0X4\x05\x08\xcec\x12\n\xd7\x9c\x7f\x91F\xb2\xea\x1f2\xbe_\xc3G\x8fERe\xec5\xdat\x1f\xba\xa2@E\xb0G\xd3=\"\x14\x95i\x0fO\xb0m\x0e\x7f\x82\xc5L\x92[!\xce\xad\xa8\x1e\xa3\xbd\xe1\xba\x01K\xee\x11\xea\x07K\x96\xca\xba\xda\x1dJ{\xe5\x10W\xbc\x17\xd3\x1a\xf77\xe9x\x17\xf9\xc7\xe0\xcf\xc1\xfey\xb3\xb9\n\xa4\xffT\x1co\x81\x0f\xae2\xbbG\xb7\xdb\x16\xd3\xc6Ws\xec\xc3\x9a\xd0\x84[\xf56\xfcETHM\xa9=oB\x0f\xbe\x8d\xf6\xcb\xbbr\xe4\x8d{z\xc9\x0d\xa9\xe8\xf9\xf7\x9a\x8c?\xb1e/\x8f~\xa4\xcc\x9b\x80\x97\\K\x12\xdd_\xc3\x00k\x9f\x82\x0f\xbb\xee\x91\xbb\xcf=l+\xad\xc2\xe6k\xfd\xedK<@]\xe5\xe1H>\xa0A\x11\xf7h\x08uH\xd3]\xd0\xc8C\x8f\x1b\x97\xce\xf9o\xa5\x8c\xaf\xee+\xdeI\x05\x11\x07\x8a\x03\x81\xbc\xe7\xfa\x86\xadL).*Qp!'\xb7J\xb5-d\x08\x14m-I\x0f[\xb0\xfb\xaa\xd8*\x94\xb9\x0c\xc2\xbd\x96J\xa2\xadt\xd3\x86\xb6rz\x1auc\xb9LB\xf7\xdb}\xfb\xa2{\xfdV\xaao\nC1\xa2iAI\x1a\xdbQ\xe3_\xe3=\xbfve9f\xe4}\x00\x9ee\xa2*\x9d^\x9d\xf4=\xc3[\xe0\x1a\xd2tx\xa5y\xa7\xc9\xf8\x03uO\x13W\xdc\x8a\x87p_,s7]\xe4h\xf6\xe9\xe8\xd3\xff\x03\x00\x00\xff\xffPK\x07\x08\xb2\xb7\x93{\xdb\x05\x00\x00d\x0d\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00v}\x96N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/pl_PL/server.jsonUT\x05\x00\x011\xe1\xbd\\\xbc:Mo\x1c7\xb2g\xf9W\x14\xe6!O\x97\x89\xe4l\xf6\xb0\xf0!\x80%\xd9\x8e6\xd6\xc7z\xe4\x08\x9b \x0b\xd4t\xd7\xccp\x86MvH\xb6Z\xdd\x8b\\\x8c\x18\xf9\x0d\x86\x7f\x86o\x0f\xbeY\xf3\xbf\x1e\x8adw\xb3G\xf2&xo\xdf;\xcd\xb0\x8aU\xc5\x8f\xfaf\xff\xf3\xd1\xded\xa1Ma'O\xe0\x9f\x8f\xf6\xf6&\xcf\x9f\xfem\xf2\x04~|\xb4\xb7\xb77\xb9DE\x12\x84\xe2	\x985k\xd5L\xa6\x1eq]\n\xdb@[\x8b\xbb\xb7-*\x82\x16\x14\xae\xb3\xf6\xee\xdd\xf6}&h\x0d-\xe6X\xa3j\n\x01e\xe3P	,\x04\x08\x10\xca\x83:\x86k,\xc4\xe4\xd1\xde\xdeO\xcctr>{~\xddK>\xd7\x0ef\xb8 x\xae\x0d\\k\xb3\x89\x82\x8f4\x9a\x1cPJ][(\xd0\x91\x11(\xa7\xe0V\xe8\x00\x0d\x81\xd2\x0e,\xd39\x0ds\x82\x1bA5\xe5 \x14 \xd4\xdal\x80\xd4\x8d0Z\x15\xa4\xdc \x19e\x8d\x8d}\xa9\xb3M/\xff\xa9\x07\x01\xc3\x98\xd5\x91vN\x17q\x0d\x1eh3\xa3\xa5\x14j\xc9\xe8\x12\x97\x04s?\x07\xe8\x86\x14\xd4+R\xe0p\x0e\xc2\xc2J\xe49\xa9D\x9a\xd2|\n\x96\x06a=$\x088\x11\xb6\x94\xd8\xf06\xa1\xd4\xd6\x91\xb1\x80\x16\x02\xa1\xael\xc2\xab\xca\x85\xfe^\xcb\xaaH\xb81\x0cn\x0200\x0c3@/\xc0\x13\xf0y\x14\x95\x15\x19\xa0\xca\xe1F\xe4\xa4\x81\x05\x92\x19\xb1vz)\x16\xc9\"\x05\x9fw\x0e/N\x9f\xc3\xd5\xaa*\xe6\n\x85\xb4QB\xc4z\xa4\x1b\x90=\xb7\xf9\xf2\xacr\xc3\x1ay\x00G\x98m\x96FW*\x87\xefy\x0d\x91\x95\xc7\xb9\x15\xc1|\xc0\xfb5\xa6\xdc\x02A\xc7\xee3\x9c\x9e\xe6\x85P_\xda\x922\xb1\x10\x94CA\x05Y(EI9XgP,W\x0e\x84r\x1a\x1a]\xed\x1b\x82B+\xe1\xb4I\x04\xb1\xba=\xbb-\x85iza\xc7-Z\xa8\x9b%n\xdf+q\xf7.\x13\x08y+p\xfb\xa6\xea\xf4Cd\xed\x1c!Wb\n\xa5\x86\x8d\xfb\xf4\xc14\xd9*\xce\x829\xb5\xa0\xf3R\xd7\x82\xf2V@\xab-\x1b\x08Ae+\xe6\xe7\x9a\x1d\xf1\xe7\x98\\\xee9\xb6\xf5\xae\xbc\x00S\xba\xa6\xa5\xeeP\x07p\xa6\xb7\x1f	Z\xac\x05\x19\xbc\xfb\x0dr\x0d_=\x86\x02\xb7ox-R82\xb0}\x83\x99\xd8\xfej7\"[\x81\x00\xc9\x0b?\xd8\x11\x7f%\x9c\x1c\xe4_5\xae\xda\xbe\xd9Y@\x04\x8eW0\x85Lo\xdf\xc3\x1a7\xb0\xcf\xdaQ\xc1\x7f\xc2\x19\xaa\xa5\xde?\x80\x13^\xcbch\x15n>}\xa8G\x12\xf3\xe1\xa0\x8fx\x10%\xd0\xad\x03F\xb2\x0e\xb3n\xb0Y\x0cd\x19\x96.[\xe1pEq\x1c\x88/uix)\xd5\xf6c\xe36\xbaVb\x83\xa0\xa1nJ\xda\xbeQ\x82\xf8\xf0\x03\x87\x06J\xd36\xe0\xd0\x1f\x88i)k1[\xf9=\x18Z\x13\xebL\xb6F\x10\xe0jmZO\xe8\xa8@W\xdd[\xca\x15.\xed\xeer\xc010n\x08\x97\x96=\x87\xd5\x95\xc9\x08\x16\xda\xc4% X-+'\xb4\xb2\x07p\xa1\x88\x89\xd8\x8b\x18T\xb9.d\x03\xd9J[R\x9e\x82xqKRd\xbcaF\x06\x07\xf0\x8a~\xae\x84\xa1\x9c\x05dZ9d\xff\xe7@\x12Z\x07_C.\x16\x0b2\xa4\x9c_Pr\xfa\xd9\n\xcd,\xd3f\xb8\xee\xe3\x15\x1a\xccXUl\x89\x05X\x8f\xec,\xde	\x0f\xac\xc9\xdb\x91^@\xa1s\xb1h\xd8'\"d=\xa5w\xbe|_\x07\xf0t\xc1\x00\xba\xcd\x88r\xef:W\x04R\x14\xc2\xf9\x7f\x95%\x03\xb5\x90\x12\x14\x85\xc5[-o\x88\x99\xc5\x9d%K\xad\xac\xd3\xc5\xf1l\xd6/5.\xeb\xb5%\xf3eN\x0b\xa1(\x87\xe3\xd9\x0cL%\xc9\x82\xd4\x98S\x0eZ\x81\xd3e\xa7D\x96$e|rnE\x05=\xc0\xfcJ/\x97\x89\xf2\x1f{8s\xedn\xd1\xe3y\xe1\xcc2\x1b\xd0=\xab\x9c\x16XI\x97.\xf4D\x17\xcd\xf6\xbdT\x0dX\xd7\xc8\xce\xe5G\xa0 6\xca\\s\xf0\xf4\xf8)\xaci\xfb^\x8aT{\x81\xa7\xd5\xcd\\\x90i\x89\x83*-\xf5=\x91/Q-\x1f\x90\xb9\xbe{\xd76\x9b\xfbR\x99\xbd\x17\x1a'$\xec,\xef\xcb\xe0r8\x88\x13\xb2\xd5,\xc2\x02\xa7d\xd6\x816K\x10\x05\x87DKh\xb2U\xc2KX\x9cKz\xa5\xe7\xda\x0d\xe6qi8f:\xc8\x0c\xd6\x92c\xd0t\x04\xe6@\x14\"OMs+\x1c\xf5\x13\xa7`\xabl\xc5\xa11\x08\x02RK\xa1\xf8Dr\xba\xf5\xf8\x85\xd1\x05`\x96\x91\xb5A\xdf\x84\x05\xef\xd5\x0e\xee-\x8a\x15\xc7g\x17\xc3\xc2N\x02\x06\x94V_\"\xc7\x92@\x0b\x99!d\x0b\x1d/\x94\x03t\xc3\xc2t\xc5\xab.\xd1\xb8 \x9fUm\xdf\xd3\xef\xf7h\x8f\x08|\xd4\x12\x14\xd5\x81u\x121s\xad\x86\x03\x7f.\x94\xb0+\xb8d\xaf\x17\x84\x1eK\xcdZW\x92\xda\xf1\x85\xde\x1a\xe7(eO|1\x04\x9b\xff\xf8\x8b\xc7tq\xca:LC\xd1F\x17\xa4\xf2&\xce\xba\x1fB\n\xdc\xd8\xa6@\xaf/\xec\xbf\x13R\x01\x7fz\xfc\xa0G\xa7\x02\x85|fL\xbf\x9ag\x0c\x80g\xc6\xe8\xfe\xa6\xa3\x19\xf9\xa9|\x1c\xe4\x91\x0f09C!\xc7\x8c\"\x07\xcf.0x\x80\xec\x12m\xa2mhm\xadM~\x9f\x12\xca\x88z\x88\x856ng\x0b\x96\xcc\x0d\x19(\x19s\x9fW\x82}\x80\xdd\xac\x9a?\xc8\xed\xf3\x8cl5\xcfu\x81B\xa5\xdcnW\xc4\xde}`\xd5\x01\x02\x9b\x0e\xff/,\x92nKT\xf9\xd3D[\x9ey\x08<\x95\x12N\x99\xa4\xbb\xa4\x08\xe7\x9c\xd4\xb3\xb2\x07pM\xf3b\n\x97'\xcf}\x1eyv\xf9\xb5\xffmte@\xd7A/9)W\xfb\x0ep\xb1\xf0\xae\xf6\x00\xce\xa9\x0e\x98\xc0\xc5g\xed(\xad\x86\xb0\x14\x1a\x9d\xfem9:\xf7\xdb\xf4\xb0\xfd\x00t\xe9c%G\x8b\x85\x90\x89\x13_\x10\xe5\x9cA\x065\xe9\x13O>\xd2\x0d\xc7\xc4\x8d\xd3u\x97^\xec@Y\x8d\xd7y\xb5\xbe{\x9b5`\xc5\xdd;P\x08\xcbO\x1f\xd8\xdbZg\xb4j\x925.\xe4(\xd4\xb3u\x9b\x06\x02t\xda\xb9\x11\x9f\xce\x87T\x1e\xb2t\nG$\x86'\x86\xbf\xd0&\xa3\x9ck\x82\x9e\xebuST\xb6\xd5\n}\x0d \n]\xeb\xed\xfb\xbb\xdf\"\xff\xebf\xfb\xe6\xeem\xc65X[7i\xa0\xf8\xf4\xa1\x9e\x823\xa2\xdcp\xea$\x80\x8f\x82 \x9c?\xa6j\xb0D)\xc94g:\x1f|\xce\x8b\x00\x03\x0f\x0c\x92.\x94l\xc0\xaet\xe0`\xbb\xe4\x82m6\xdcf\xc2Q\xeb4t\xbe\x08\xc3i2\xf8\x8cF\xc6r\xa9#\xfc\xd6\x0f\x9f\xc0\xe3\xde\xef\x11\x9aXS\xed\x9e\xdcJ\xe4t\xc4\xe10O\xc9	NH\x12\xc7\x8fK?}: \xba\x02\xcb\x86\x02r\x857\x04s\"\x05y\xa0\x18\xb3\x1e\x15\xa8\x9e\x9e!|\x83\x87(\xe5a\xca88s\xce\xaf\x96\x94s\x80\xea\xebR\xce\xdb|%\xda\x87\x06O\x0c\x059\xf4Tc\x99\xaf(\xab\x8c\x157$\x87\xbc\xb8\x87\x05;Z	\xce\xa5\x1e\xdcV,\x8c\x0d\x95R\x84\x8c\n\xd3\xa3\x03\x93p\x1f\x89\xf5\xf5\x9d\x1do\xd6\xed\xd6|}\x99\n?\xceV\xba\xfe	\xe6\x95sZq\xc2WJ\xcc(\xa4Y\xf7k\xc1\x956\xa2e\xcd\x91|!\"IS\xbe\xed1\xd0\xa1\x82\xa8\xaf\xff\x92\xadP\x81b\xbd\x95K\x81\x0331\xdc\xf5K\xbd\x14]LN&\xb0\x96}\xab\xd9\xb9v\x13\xbd_\x03\x0f\x03\xef\xd6\xec\x10\xcc\xbbM\x05\xe5,\x0d\xdd\x08\xaa\xbd\x99\xae<\x8f1\xdfWZ\xbb\x8b\x1b2F$\x86\x13\xd8\x1b\xad\x1d\xe8\x0e\x17\x98\x9f.\xd8;B\xcd\xb1\xdciX%n0d*`\xa9D\xce\xe6A\xea\xcc\xe7\x18 \xc5\x86S\xe0\xe3\x93\xf3)\x90b\x07\xc2J\xb3\xa8\xa4\x0c\"0\xcf\x0dY\x0b+2t\x00/\x89u\x98\x8a\xd25,\x813S\x9e\x1es\xc2n\xf2\x01<\xbb\xc5\xa2\x94\xf4\x04\xf6W\xce\x95\xf6\xc9\xe1at\xea\x05-\xab\x0c9b\xec\xef\xecu\\\x19\x84M\xfe\x91\xaa\xa0\x0c7	\xa8\xc2^\xff\x9de\x80(F\x01\xe2\xb4H\x02D\x18\xf4\x01\xc2\x1f\xf08D\x08%\x85\xa2\xe7\xc2\x8d\x83_\xa2\x0d1\xe8\xc5;\x12\xca\x06C (\xd1\x17P!\xc4\xa9\x1c\x0cY\xd1\x92O\xefL\x1e\xbbB\x96\x9cW\xe1A\xde\xcf\xf9\x10\xf6O\xffvr\x14\xa50\xfc_\xc4\xe85\xfa\xae\xc4`\x8e\x7f\xed\x00\xd3t\xd8\xe7\x96\xa7'\xf6\x00\xba9\x90\xa1\x02\xcd~;\xf8\xb4\xe0\x18\x92#\x94i\x9d\xc0EC5d\xf5\xc7+TK\xce\xa7\x1d\x99\x05[\xb4\xec\xf0\x03\xb9P\x9bae/\x85\xda\x08h\xa9Vw\xef\x9ciU\xdf\xd1\xd29\xae\xa7P\xd9j\xfb+\xc8j\x0e\x947\xaeZ\x83\xdc\x9d\x0f\xb5\xafz*\xf8\xe6\x9bo\x0e\xff\xccF\x8f\x87\x83\xb0\x02m\xa6]\x12\xcc\xfd0\x88\x88Id\x98\x02y0\xe3]R\xaf\xb5=}\xaf(|\xea\xd1Z\xd0\xfa\xfb\x8d\x92\x12rW%\xda\x7feh\xfb>\x13\x90K\x84\\\x1bm}W%\xf2\xbb4m\x03\xa5 S\xdb\x96\xd6P\x8b\xb6\xc9\x04\x1f|\xe8{V\xeb\xddN\x84\x0b\xdc\xb8\x9a\x1f3\xe4\xbc\xc3\xd1:$\x1d\x82\xd2\xe5\xdc~\xeb\x0d,9y\xb6\x9f\xba\xb1z\xa3\xfd\xd2\xf4\xdc`\xbb\xc1>\xc3\x89\xa9;\xf6s\xee~\x83\xd2\xb4d\x9b\xed\x1bT,,P|\xfaP\x8f\xe4\xccDK;R\x8cn\x0b\x81\xa6\xfa\x9c\x8c\xa6\x9b\x01\xb5i\xab\x0c\xb9>\xed\xe6B\xdd\x18\xdc~\xd4\xaa\x81\x1a\nZ\xe2\x1c\xd7\xe8Ru/\xf0\xf6Z\xe4n\xb5#\xd4\xb6d~wo\xfd\xa4?\xb49ZVW\xdf\x0f\xda\x14\x86\xf1\x0e9\x06\x84\x9eK\x08\xe6]\x1b1\x0b\xbdHv\x06\xde\xbb\xfb\xc4\xce\xf7Nw\x19\xcfV\xda\xb8\xacr\xff\x17\x02tN\x06G>\xe1l\x00M\xc7\x80\xb1_\x18\xe6y\xcf\x90:\x85)\xccQ\x0d\x0dg\x95\xb3\x15\xb1\x07\xab8^u\xf0y\xc3\x06\"\x0c\x14\x8a\n\xadD\xe6\xf9\x0ekSi\xbb2\x0d\xc9\xa7\x85\xb8{7\x0d\xedP\x82\xf9\xdd\xbb\xbc\x1d\xfa\x0e\x14:oJ\xd7|Y\xf7\xf2SEu_\xb7u\xbc}!1.\xe6\xc6\x04\\\x16\xa7\x93\x93\xea\xf9\x82\xebf\x15+\x91\x84J;\x91\x0d\xab\xbfXn\xdfh\xeb\xfb|\x91\xee;\xf3\xe9\x83\xdb`\xf2\x82\x01\xa5\xde`\xcb[\xc0{U\xc2\x98\xf1B\x84\x88\xde\xb3?!\xbbq\xba\x84\xf3\x04\xd9\xdd\xde\x0br\x90G|Jl\xc3\xd3\xc2\xcf\x95\xe6\x84\x96/\x17l\xa3\xb2\x9aM\x08\x84\x05\x9c\xeb\xca\xf9\xe0\xe3\xd0\x8cvV\xb3\xc6\xa5\xc9\xd6\xb9\xae!\xc2\xe0\x08\x95\xea\xab\xcf\xe3\xcapp\x93A\xef\x18m\xb5Zr\nd\x0e\xf10\x17\x9a\xe5\x12'\x91\xac#\xda\xad\xb8<u\x86\xb0\xe8\xce\xc5'.ByO\xca;\x98{\xf6\x89\x92h\x99\xdf\xbb\xcf\x0b\x99\x7f\xfe>\xbbD\xea\xf8\x81\xf6j\x97\x80A\xd7g\xbd\x1aj\xaf3,K\xde\x81^\xf49\xb9\xf6m\xcf\x1d\x7f\xdf5]}\x87\x90\xc7\xfd\xa3\xc1n\xbbH\xd7\x8a\x12\xbb\xbb\x08\xc3\xe90\x18\xdb[x\xb0\nD\xa1\xc0\xf0\xa9[\xe8I\xf9\x8c\\\xca\xae\xad\xa4\x953Z\xb2\x91V\xd6\xdd\xef\xdcjE\xc9:\xca\xdd\xf3\xbb\xfc\xdc\xd9\xb1\x8e\x1f\xc7\x96\xd58\x89c\x8b\xe8\xbbY\x7f(\x99\xeb[V\xd8\x9b\xcf\xbf3\x9fc~\xa7>+\x0b\x99\xd7\x90-y\xa07a\xe0$\xe3^\xde\x1e'pBAy\xc8\xcb*\x95\xc7l\xd9\x0f\x19\xc5\xd7\x9bI\x91m\x0e\xe0\x9a\xed(\xf6\xfe\xf2)(\xbc\x11Kt\x14\x14d5\xe6$\x94u\x84\xa9\x16\x94\xa6R\xb4\xd3.|\xed\xb3\x9b\xf0\xfc\xd1u\x16F0\xff\xf4\xb3\xfd\x88\xb9\xea\x9c\xdc\xa7\x0f5\xe8\x1c\xce!Wb\x87\xf9\xd5\xca\x10\xde\xe3\xee\x9f\x1b\xc6\xcc\x03\xe8\x0f\xf3n\xaa\x9e\xe5LV\x0eH\xf1	\x98>Q\xf3\xdd\x1a\x9b4\x80\xcd\xfc\x8an\x07O\xfa\x8a\xf2\xc3#Y\x11xh\xa4\n\xd9\x16\x18.vU\x0es\xc6;\xbau \x16\x10<\x82\xef\xdf\n\xb7\x82\xfd\x7f\x98}v \xfb\xff\x98'\x15\x06\xef\xf5B%E\xeeU#7\x1ar\x0d:\xcf\xda\xc6uOM}\xaf\xa3\xd0\xdb\x8fR\xd41\xd4k\xebt\x8d*-\x0c\x0d-\x05G\xacd\xe1\x11\xb0k\x1f\x86$:qCW\"\x89\\\xaf\"\x10\x18j\x1d\x16eg\xe4=\xc6\xab\x86\xeb\xd1\\T\x1d<\x81\xfd\xaf`\xa5+\x03\xb8\xd4\xa3\xfd\x95\x84\xe9!\xfa\xe1\xfd\x95\x94\xb2y5\xca\xeb~|\xc5\xb0\x9f\xd8\x0b\x04D\xf4k\xfa\x86\xc0\xa3\xba\xaa;\xaa\xad\xf1\x96\xea\xeb\x94\xee\xa9l\x94\xafs\xcd\xf8\xfa\xd5\xcba%\\C2`:\x1av\xc4\xbe\"	.pxVZh\x03V\x17\xe3\x0e\x0e\x94Fs\xe6b\xbc\x05\xd5\xdal\x12s\xf1\x8f0\xbd\xcc\x1f\xd0b\xff\xb4\x17\x06\xdd\xab\xe1\x10N\xb9\x14\xd0\x1b%\x08Z\x9e1\xf0\xb2i\n?\x1b\x8a\x95\x13\xed\xbb,\xf3\xaa(C\xe0\xf1\x06\xd4\x9dK\xf2\xec\x13\xbcml\xbc\xf5\xee\xef \x95 \x87\x0b8\xa2\xb6\x14\x94\xb5\x9c]~\xfa\xd05\x9cg\x9f>Hhq\x1e\x91\xb8\xbe{\x9ba\xdfj;\x80s\\K*\x05\xad\xa7\xb0\xfdH\xf3\x06\n\xde\x1eB\xa6A\xe1\xbaP\x82\xd6\xf0\xe7\xd0/\x17#\xc9UF\nu\xb2\xbf*\xa3s\xec^\xb4;\xfcA\xa6\x8b\xcfT\x8b\x96,\xbb\xc5\x9dG\xeb\xeb\xe4\xbd\x9ai\xecZ\x84\x86g\x17\xf3\xfd\xa36\xbb\n\xccV\xfe\xd9z\x8d\x1bQ\x849`\xef\xdev\xcf0M\xd6\x86\xd7'\xa9\x97\xba\xf6\x17\x95\xc8.\xb5\x90it\x0c\xa5\xd5\xac\x03\xa7>&\xce\xbd\xd78\xb4\x0e\x17\x8b\xf1\xa3\xf3\x8cA\x10`c\x87\xe3{\xcd\x9e\x02\x1c\xa3\xbb\xa4#4\xc6\x08\xf34kf\x87\xf4\x80\x8fq\xb4\xe9\xf3\xc2\xde\xbd\x0c\xc5\x03WnR\x8c+\x87\xf0^\xd8\xb3\xf1\xa3\xa8\x17\xfeI\xd1?<\xee<*\x06u\x0c\xd7r\x86\xb7C\xe2\x8e\xb7\xa2@\xd9\xa9+\xf9	\xde\xadD\x96\xe7U1'\xc3\xca\x9bcc\xbd\x13\xe5\x1c\xaf\x0b\xbf\x16\xe6\xb4\xd0\x86\xe3id!\xec\xfd\xfe\xe5H\xba\x18r\xd03\xa1\xfe?\xa4\x8f\xae\xf3\xa1o\x18bb=\x05]\n\xabk\xf4\xbd\xeca\xd2\xc0\xc9W\xf9Q\xa1\x1eV\xb3\xc81\xf6\x03z5\x0b\x8a\xf1\xc0\x83\x19\xe7'G/z^\xf1\x91w\xf8\xa4d\xcc/>\xf2\x86/~\x869;\xdc\xfep\x9f\xe1!\x16>\x99\x8f\xe1\xffBy'?\xd8\xb1O\xf4\xc3Q\xfb\xae\xa4\x0f\x19]\xbe\x16LTd(e\x03\x98\xe7\x89\x0f\xf4L)\xbfO:\x88\xa5y1\xee\x93^\xd3\xfc\xecw\xda\xa4~\xca\xbd.i\x1f+,<\xd8k\x8dIG\x9aP\xd5+tOU\x1a\x81\xaf{H\x10\xc93\x90\xc7\x07K\x04\xff\x07lf\x88\x94]iw\xcf\x0d6\xa8r\x1a\xac\xec\xefa8M\x06\x9f\xf1\x9f\x1c\xb9F\xef \xd7\xdal\xa0\x18\x1eA\xbe\x159\xd9\xfe\xf5*T\xc9\xbc\x1b\x1b2\xdd\x07o4\xf2\xdc\xf9\x9e\xe0\x7f\xc7\xf9\xd1\xde/\xd3G{\x93\xd8\xdf\xec>\xdfS\xe1\xd9x27\x18^\xf9'\xe1\x90\x18\x96\xeb\x12m\xb5\xe6\xac\x8a6\x06U0\xbeI\x1d\xbb-)>\xe9\xb6L\xa2 \xab\x8d\xe3Mt\xde}r\xe4#mwA!\x84p\xe1\xee|t\x1b\x1e\x87\x13t\xd5}8\x13\xe2N\xf7\xa9\xd4\xee\xdc\x0e\x9c\xf4l\xf8 '\x0e\xfbG\x89\xc9\xc5\xf2\xd3\x07\x19\x1b\x8c\x93Y\xf7%\xc5\xe4\xba\xb1m\xb5\x11\xb5w\xdc\x9eA\xdc\xe5\x0f8\xc7:\x8a\x9dm\xd8\xdd4=[*J\xc9\xb9\x7fw\x88sT\x97\xa9\x01\xff]W\xc9\xe3\x90\xaff\xf3\xd0P>\xfc\xc2\x1e\xc2\xbc\x81/\xac\xcf\x8a|k^K\xa9k\xae\x83\x0c\xa1\xd5\xeaI\xa7u\x1c\xab\xe6\xa8B-\xe4=-\xb1\xc5|a9\x1d\x16\xfc\xe7\xa03\xe4\xb4\x8d\xecC\x9c\x7f\x04\x9e\x86xwz\xc9N\xf6\x8b\xd8t\x89\xb7S\x89\xf1\x07\x9c\x93\xd3\xaeE\x11\x8f(V\xa5\x8c\xfaN+\xa7A@\x8b\xa6\xbd{\x9b\xb7\xfe\xac\x82\xab\xc5\"^\x01\xe6\\|\x85\xc6m\x84\x94\xc1\x11M~@W\x0b2\xf9\xf6\xbf\"\xc2Z\xb1T>H3\xfa\xa9\x1f\x86\xa8\x1c&\xcc\xd1\xab\xdf\x11\xaa~\xac\xc8\xccJ\xca\xac'\xc82*\x9d\x85\xaa\xe4\xed\xfe\xe91\xfc\xf5\xf2\xd9\x8b)\\\x9e\xbf\x98\xfa\xef	\xb5\x81\xebgGg\xbey\x1f\"\x10\x14\x1c4\xab\x02rQ\x90\xf7(\x96#\xd4\xd7\x8f\x1f\xdf~\xf5\xf8\xf1\xb4G3\x05\xf8\xc6\xbc^\xf8/\x16\xbe;\xf2v\xa5\xfc\x87W*?\x88\xeb\xf1\xdb:\nn4\xf9\x98l\xf4\x1d\xd9$\xf3\xcd\xf0\xa4m1\xf9\xa1\x10\xb4\xfd\x15Vh\xb7ot\x9c$\xf942\xc6v\x7f#\x9c\xd0x\x96\xfeO\x80i\xb5\x10\xcb\xca\x84:2^\x8c\x87\xf8\xc6R\x1a \x87\xb9\xb3\xf0\x9d\xc0\xeedK\xa6&\xd3\xad\x94S\xda\x81\xebU\xff\xb1\xda\x88e\x8e\xceo\xf2\x04c\x168	q\xbb\xa7\x0b\xcf\xa6!]N't\xc1\xad\x9b\xe0\x1dV:!v\xde:|\xd9\xf5\xdd&ye\xba\x16\xd8\xe4\xa4\xfb\xef1\xc1\x1c\xbc:<\x8b\x7f=\xbc{\xc5\xef\xd4\x167\x91\xd5\xa2\xca6\x17A\xe1\x9e\xbf>\xfe\x0e.\x9e?\x0f\x88\xa5\xd4s\x94\x0c\x7f\x11\xfey\xa8\x7f&\x9c\x9c\x9et#RN\xb8\xa67\x86\x08\x96\x92\x96\x81\xf64\xfc\xf5M\x18RQ\xa6\xd4\x98\x1f'\xda!E\xf8:\x98\xe1]3c\x98)\xd4\xf2\x9e\x8a\xe3=\x85\xfe=}\xfe\xfa\x7f\xa0\xcf\x9c\x9a\xfb\xbe\xf3\xe4\x9a\xd3\xf4j\x9d\x82\xc3\xb7\x1e\x1d\x06Z\x82\xda\xb6\x8du\xfe\x93\xc7*8\x04\xda\xfe\x1aHv\xfa\x96\x93\xb4U\x19f\x0c\xe1g\xf2\xda:\xac\xc5\xe0\xdcu\xad\xceu\xdf#\x99\x9c\xfb7\x10+0G\x1b[\x15\xc9\xe7\xa2\x81\xa4\x8c\x9a\xd3\xb7j'ee\x96\xbdB]\xf2\xc0\xeb\xd3a\xa2t!\x82_i-\x9d(\xbdB\x08\x19\xdeIC\xaa3o\xc0V\xf35en\x1a\xbf!5\xb1\x06TX\x10\x90\xca\xd0\x86\x8f\xc4Y\xd3\xacD\xbb\"{\x00\xdd\x9d\xbd\xa2e%\xd1\xb0\xc76\xa1\xb6\x8a~zb\xc9\x85\xc6\xa9\xdf\xdf\x8c\\lt\xda\x1e\xfd2\xe8A\x87\x8ej\xe1\xb3\x97\xe4\x0c\xed\ns]\xf3\xa4\xf8/\x81\x1e\xf9d}2\xf3\x03\x98\x8b\x8e$\x06c\x8f\xd3\xc6U\xeb\xae\x0fTFe\x8e\x89oo\xac\xb3\xb4\xde\x8aS\xc2\xa9x\x1f\xc1\xc4\x11\xda(\xef\xbff\x0e]e\xa1\xd4\xa1$\x1a\xee\x95'\x1cw\xb1\xe4\xb5\x12?W\xc4v\xa2\xc2\xb7\x94\x989qC\x87N;\x94\x1c\xaaB\xd4\xf1\x84.\xf4\x91&}\xe7h\xe2B\xc27\xb9\xea\xb3\x08\xd7\x94\x01\xc2\xbf\x1eR\xa9\x18?^\xfb?\x8f\xf6~y\xf4\xcb\xa3\xff\x0e\x00\x00\xff\xffPK\x07\x08\x81\xff\x1b\x8b@\x12\x00\x00\xb70\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xef\x9d\x8cN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/pt_BR/common.jsonUT\x05\x00\x01R\xeb\xb0\\\x8cV\xcfn\xdb8\x13?\xdbO1\x10\x10\xb4\x05\x8c\xf4\xde\x9b\x1d+\xad\xfb%\xb1?[MP|\xfd\x0e\xb48\x96\xd9P\x1c\x95\xa4\xecx\x8b\xbe\xc8\xbe\xc1\xeeu\xf7\xb4\xd7\xbc\xd8\x82\xff$9@\xb1{\xd2\xcco~$\x87\xc3\xf9\x91\xfa>\x1ee;\xd25\xb3\xd9;\xf8>\x1e\x8d\xb2-S\ny\xf6\x0e\xb2\xd9\xf4\xee.\x9f\xc3\xec3\xbc\xba0\xaf\xe0z\xb9\x86\x0b\xe3?_\xb2\x0b\xf3%\xcb&\x8e\xcfQ\xa2\x0d\x03\xe6\xf9M^\xf4#B\\\xd4\xac\xc2yOZ\xdcN\xdf\xe7\xf0s\xea\xa6!!Q\x0f\xc9\x9b\xd5rq\x93\xaf_\xd2\x15\x1eWd\xacY\xa8b\xaf\x91\xf9\x01\x17\x1c\x14\x1e\xa1q8\x08\x05\xd6G.\xc3\x00\x8fN\x15_\xb8u\xcc\xb2\x166\xe6t\xc1\xc3\x88\xd7\xe6\x0d0\xc5\xe1\x82\x83\xcf\xc5\xf9\x14i\xfd\x0c?\x19xNlu\x85\xdc\xa5\xe7h\xab\xe5\xa6\x80\xd5\xa7\xf5\xfb\x17\xe5\x1c\xd6\xd1\xec\x19\xa7\xe3L\xa4\xeao>L\xe7\xcb\x07\x98-\xfe\xcd!\x84m\xdeP\xf9XPU\xc90C\xf1a\x9dO\xe7\x8e~V\xb6V\xf5G\xfc\xe9\xee\xfc\x90\x03\xe3 \xf0\x88|\xc3j\\\xacR\xfa\x1bX^C\xf1!\x87\xcd\xf46\x87\xc5\n\x1e\xf2u\x0e\xf7\x8b\xfca0z<\xfa1\x89\x0de\\?y\xaf\x91\xadf\xd2\xa4\xfe\xe2\xec\x94\xbd\x83\xff\x8dG\xd1\x9e$\xcbd\xe3\xd1\xe8\xff>\x81=\xb5\xba#yg\xd2\x99\x03Z-Tk\xb1#Fw2p\x86dRv\xdfs\xbd7\xe9\xed\x01\xd3`I\x8aw\xd4\xe8N\x06\xce\x80|B\xd6\xa7\xea\x9dIg\x06Z,\x8a\xef\x93T\x04\xc6k\xa1\\i\xa7\xde\xf03\xb1\x8a\x1c\xe2>\xc1\xf79\xf8O\xf4)\x0cQ\xcf\x7f*QGVI\xcajV\xda\xd0\xd3.~\x15\x11\x88\xd0@\xa5\xb3S\x7f\xa8A\x92\xc0\xa4\x84\xed	\x16\xab\xc0\xc3\xa7&\xae\x9b{K\xe8!\xde/\x12\xa2gK\xec\x05G\x1f2\xaeD\x18\x07\x86m\xa6=~eJX\xd2~\x8a[\x14<n\xe2kk\xec\x1d\x1d\xe3\xf65\x83\x1aM\xda\xa0\xa4\xf214k\xb4\xe2ir\xd4\xac\x9b*y!H\xbd@\xcfDIG\x85a\xc0\x8c\x98\xe6\xb0t~T\x1f\xe2TJ\x17\xbaG\x0d\x968\x99$\xcb\x90W\xfe$\xb6\xa9\x18&\\Q^\xa5\xd1\x0c*\xf4\xda\xdbXQ>\x9e\xbc\x00\xbd\x0f&\x00Q|\xfd~:\xbb\x13\xdd\xf0|\x9c\xd5\x1d\xcb\x89Z\x87\xbd.\xda7Id\xe6\xa4\xca\xd8x\x19\x17\xae\xe6\n\xcbn\xab\xd1\x15\xaa\x8a)\x9fTy\xe6$\"\xc7\xe8\x8d}CgV\xd4\x98\xba\xb4d\x12\x15\x1f\xf4\xf7G\xa6b{_\xe3!Z\xb7]\xcbO\xb7\xba\xc3D\xb4>\xb6\xaa\xb3d\xe2\xc5\x0e\x1fe\x1b\xb4\xd1Z\xb6\xc9\xba\xa34\xf3\x1c\x7f\xe9uvD|\xec\xf2\x98S\xdd\xcdPE\xab\xc0\xb4\xfa\x7f[\xd6Y\xa2\xe3=%\x8bm\x87\xb2lE\xf7\xf0\x91\xb5T\xbb2\xaf\x89\xb3\xe6\xf9\xb7XI\xa6J\xf4\x9dq\xe5\xad\xb8\xdd\xacd\x96I\xaaB \x98\x01\x97\xc2\xdd\xc2W\xfd0\x07\x80%\x883M\x06ztm8\x10\xe3\xd6\xfb\x81@\xca\xabiQ7\xa4-\x98\xb6,\xd1\x98]+\xe5%\x14{\x84\x86U\x08G!%(:\x82FI\xdd3\xb7\x13\x12\x0b\xa2\x1b\xa6+?\x85\xf3\xc1\x12\x81\xf4H\xe4(a\xf6\xf1\x9d@]\x0b\xc5\x92\x18+\"\xd7\xc5\xa4\xaa\x98\xfd\xb7\xd6-\xa7\x194h\xbe\xb5\xc20\x0d\xaf\x03\xe9\x0d0\xa8\x9f\xff2\xa2\x0c\x15\xcf\x84\xcf\xf6\x8a\xb4n\x1b;H\x7f\xc7\x84D~	\xd7.\x952\x86\x07#\xe6?\xdb\xed?nV\xa8\x03\x93\x82_\xb1\xc6\x96{\xe6\xe7\x08\x08\x94\x11\xf2\xb4G\xc4\xc6\x17Y\xa8\xea\x9a\xbct\xff\x83\xd8\x00\x8f\x18\xec\xd2\xe5!Yx\xb3\x9f\x7f\x95V\xd4\xe9\x1apJ\xed\xff2\xa2\xb0\xc3\xab\x0b.\xd6\xb3\x90\x174\xebZ\xa9\xd0\xec\xc08\x01#\xd0\xc3\xae\xaa\xdd\xe5w/\x8c \x95~\x12\xfc}\x08\x07\x8fERk\xec-\xb3\xa5{\xb6\xb2\x153\xe6H\x9a\x1bp0\xd4\x1e\xf74\x85\xc7>\xb5;:\x10\xd8\xe7?\x1aQ\xc6\xf3lH(\xeb\x1a\xb2\xeb\xd7\x95C\\G\x9e5\xae\x7f\xa0\xfa\x1b\xde\xffZ\xbd\x0d\xfe\xdb\xa2\xb8	\xa4o-\xc5\x9b\xf5\x9e\xca\xe7\xdfaG\x02\x1c\xd6u\x8fFf\xc2;\xb5\x0eVD\xb9\xd0X\xda\xd9)\\n\xeb\xe8\xbf|~z^\xbf\xa3\x97\xdcP\xf5\xc4\xdfi4\xfb@\x0bf\xc4\x1byJ\xbbH\x8a\xd5\xe8\x9a+p\x9d\xd5\xe3\xb6\xd51eKZux+C)\xd6\xde\xf0\x98A\xa6\xe3yD1$\xdc\xb8s\xcb\x9f\x1a\x11\x7f]o\xa8\x12\n\"\x0e\x18\x03\x81\xbc\xa7\xe3\x1dYQb81o\x85H\xbb\xad\x85Oq\x13,\x8f\xda}[o\x15\x13R\x04M\x16\x03\xff\xf22\n\xc1R\x13Z\xb3\x89'\xd1\xaa$\xf2\xd4a\x9f\xa9\x85=; 0\x05}\xd4\xff\xc1\xa6!G\xd7V}\xed?\x05\xe0\xac\xe6m\xe3\xe4\xe7\x94\xec\x19\xde\x03w\xc7\x0c\xc3+M\x95F\xe3\xcb\x17\x10\xe4]\xaa/\x16y\x18.1\x1e\xfd\x18\xff\xf8;\x00\x00\xff\xffPK\x07\x08\x9a	\xb1m\x05\x05\x00\x00\x96\x0c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00v}\x96N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/pt_BR/server.jsonUT\x05\x00\x011\xe1\xbd\\\xbcz\xcdn$\xb9\x91\xf0\xb9\xfb)\x02\xf5a>\x01F\x8d\xa4\x9eY\x03F\x1b0\xa0\xdf\x1e\xd9\x92Z\xeeR\xbba\xd8c *\x19U\xc9\x11\x93\xcc&\x99*\xd5\x18\xf3.6\xf6`\xcc^w\xf7\xb2W\xbd\xd8\"\x82\xccLfI\xddc\xac\xbd{\x91\x8a\x11\xc1\x08&\x19\xff\xe4\x9f_\xbe\x98\xad\x9co\xc2\xec5\xfc\xf9\xe5\x8b\x17\xb3\xf3\xa3\xdf\xce^\xc3\x1f^\xbex\xf1bva\x19\x85Q;\x0b-Z2\x10\xe9!\xce\xe6\x82<\xb3\xd1k\n\xb0r\x1ebM\xb0Dk\xc9\xef\x058\xf7\xf4\xb1#\x1b\xcd\x16\x8e\xc2\x1d)\xf8mG\x81y\x040:D@\xab@\x17\x9c\x1b\xa7\xd0\xcc^\xbex\xf1-3\x9e]/\xce?\x0c+\xb8v\x11\x16\xb8\"8w\x1e>8\x7f\x97\x85\x1f;\xf4\n\xd0\x18\xb7	\xd0`$\xaf\xd1\xcc!\xd6\x18\x01=\x81u\x11\x02\xcf\x8b\x0e\x96\x04\xf7\x9a6\xc4R\x01a\xe3\xfc\x1d\x90\xbd\xd7\xde\xd9\x86l\x1c%\xa3\xd9\xe06\\\xba\xean\x90\xbf\xa0\xa6\xf5\x04\xd1\xe3=z\xb0\x0e\xbcS\xd8>\xfe\x98\x97q\xcbpp\x10*\xef\x8c\x01\x85\xd0>\xfeu\xad-\x16\xa4\xd0Ph\x1cT\xae\x01\x04\\\nj\xd5Y\xe5\xf6\x0b\xc9\xd6\xd9m\xa3\x03\x0d\x82\x8f\xac\xb3\xba\xd1\xdf\xa3\xcf\xa2\xae\\\x88\x1e!:\xe5\x02\xb8\x00\xad\x0b\x11\x95\xf3\x14\x98\xb5\x03\xb4\x8f\xffau\xe3B\xc1\xb5S\xda\xfd\xce\x99\xae)\xf82\x0c\xee\x130qN\x14\xe0V \x13x\x97\x9a.\xe8J\x0e\xea^+r\xd0\x1a\xdc\x92\x9f\xb0\x8en\xadW\x03\xdb+m5\xc6\xcec\x00E\xf0\xe6\xe2\x1c\xd0\xea\x06\x15\x86~\xf9\x9f&\x18\x98.\xd7W]\x1c\x97\xca\x038\xc6\xean\xed]g\x15\xfc\x8e\x97\xd2\xb3c\\\xd2\xba\x01/K-\xb9\xa5	=\xbbOp:R\x8d\xb6_\x86\x96*\xbd\xd2\xa4\xa0\xa1\x86\x02\xb4\xba%\x05\xbc\xe3z]G\xd06:\xd8\xban\xcf\x134\xce\xea\xe8|!\x88u\xf1\xec\xa1\xd5~;\n\x13\xfd$\x01B\xd4\xc3^_w\xcd\x92<\xef\xb5\xc2m\x80\x8d\x8e\xb5\xeb\"X\xda\xc8\x81\x06X\xd2\xcay\x02\x04\xe1\n\x9a\xb7\xcbP$\xb5#\xef\x1a\x8bCM\xd2,\x8eb0\x1d(\xef\x10\xf3\x96)\xfbp\x82\x16*g#j\x0b]\xcb\xb6\xf1\xea\x10\x8c\xdb\x90\xaf0\x10\\b\xd4\x16\x0c\xc5H>\x80\xf3`e\xb5a\x7fG\xf6\xad\x8efWx\x14X\xb6\n\xfe\xfdD\xfc\x1cBW\xd5\x80\x01\xf6\x8e\xacn:\xf8\xffp\x85v\xed\xf6\xf6\xe1}^\xcb!T5z\xacD\xbeqv=\x91\xac\xca\xedU\xdb^\x18=D`d/\x90\xf7q\x9cVa\x1b\xab\x1a\x87\x99'y\x9c\x0f?\xdcA\x17XZ\x14\x0bmy\xab\x01!\xcf\x12\xdfV\x91\x97\x0d\x8b\x18\xee\xd8\x83\xdd\x11xZk\xd6\x0dq_l%\xb1\xf6\x84\n*O\x02{\"\xfe\x16\xd7aw	\x10\x19\x98?\x02\xd7\xb2\x84\xe0:_Q\x12\x9b\xa9\x823\x9d8\xcf}xk\x89'\xb1Rx\xb4\xca5f\x0bU\xed\x02Y\x99AX\xd5\xb0&K\x1e#\xa9\x9e\xc1>\xbc\xa3\x8f\x9d\xf6\xa4\xd27\xa6\xd3\xc7\x08\x860D\xf8\x1a\x94^\xad\xc8\x93\x8d\xb2\xa0b\xc7\xf9,\x16\x95\xf3\xe3Q\x9f\xf4\xa7\x03\xa1\xc5\x06\x82 \xf3N\xda\xa8\x05\xb8!\xb1\x18\xb7b\xbf\xaeW[m\xd7\xbc\xa1\xc3L\xf1\xc1|F\xfbp\xb4b\x00=TD\x8a\xc9\xf8\xf4\x8cnt\x94_|.\xb0\xd1\xc6\x80\xa5\xb4\xf8\xe0\xcc}q:\xe5R\xbb\x10]s\xb2X\x0cK\xcd\xcbz\x1f\xc8\x7f\xa9h\xa5-)8Y,\xc0w\x86X\xb7P\x91\x02g!\xba\xb6W\x9c@\x86*\xde\xb9XSC\xcf0\xbfu\xebu\xa1\xf8'\x02g\xae\xfd)\n\x9e\x17\xce,\xab\x11=\xb0R\xb4\xc2\xce\xc4r\xa1\xa7	\x94\x85fF\xfc\x9b\xbf\x98\xd7	\xcb-\xe4ys\xd8\xd4d\xc1:K\xac\x04\x81\"#y\xed\x95\xd1\x93P\x96'\\\xa2]?\x91d\xd0\xae;\\\xf7\xc2.\xf3\x10Z\xac\xee\x9e\x11Z2\x0d\xfcM\x9e\xe7\x8eLC\xb7\xc8\xb0\xc4\xaf\xa0\xdaw~\x0d-\x85\x8f\x9d\x0e\xc8~\xff\xa2\xc15\xd9\xc2\xe5+\x1dpi\xe8\x9d[\xba8Z\xc8\x8d\xa7{V\xc8\xca\xe3\xc6p\xdc\x99O\xc0\x1c|\x1a\xd1\xf1\x0d-\x83\x8e4\x10\x8e>&\x10\xfa\xaa\x06\xb2k\xcd\x9be\x15=\x08~\xe59\x0eW\x15\x85\x90TN\x87\xec\x1d\x9f,\x8auG\x9c\xdb\xb8\xb0\xd3\x84\xe1\x13\xf8\x129pd7=\x18\xfed\xa1\x01\xd0nY\x98\xebx\xd5-\xfa\x98\xe4\xf3\x89\xed\xc9\xfc\xbd\x01-\x88\xc4\xc7\xaeG\xafY\xee\x95\xb3\xe3\xbe\xdf\x92o\xb4E\x9f\xdc]\x12{N\xec0\x9c\x80\x00\x97\xe4c\x11\x0e\xc5,\x97h\xcc\xc0\xe2\xff\xfd\x82\x87\x806l\xc6=\xbe\xe4\x0c\x8dS\x81\x04\x1d\xb2\xbbL\\c\xa8\xd9Q6h?\x11O\x0e\x87\xa9\xec\x18\xbf:\x9c:\xf5\xe8\"\x9ab\xab\xa9Am\xce\xbc\x1f\x16u\xc6\x008\xf3\xde\x0dK\xcaf%\xa4\xbc7$\xc8g\x98\\\xa16SF}\xaa\xca3\x12\x83g\xa6\xdd`(T\x0fC\xd88\xaf\x9e\xce\x846\xa3\x9ec\xe1|\xdc\xf9\x84@\xfe\x9e\xf8x||\x86W\x81}\x86\xdd\xa2[>\xcb\xed\xd3\x8cB\xb7T\xaeAmKn\x0f5\xf1\xd9\x8c\xacz@b\xd3\xe3\x7f\xdaJ\xe9\xa1E\xab\x8e\n\xdd9\x13\x88\xf6p\xeb\x14\x86a\xc6\xbc@\xb2\x03c\x1c\x06\xd0	\xbd\x0f\x1fh\xd9\xcc\xe1\xe6\xf4|\x0eW7_\x03\xfb\xdc\x0eZ\xff\xf8\xef\xad\xd7Yo\xed\xe3\xbf:\x08\xfc\x07W\xc4\x19n\xd8\x87kw\x9f3^\xc9\x1e\x85]\x03\x11\x9b\xe5\xe3\x8fM\"NK\xd4\xcaM\x97=9\x17\x19\x0e	u?\x04\xd7>\xfe\xed\xf1?9\xe9C\x8f\x80\xfec\xa7\xef\x0b\xc3Y\x11)\xce2\x93B\xf5\xcc\xce34\x1dC\x11m`5\xc1\xb0Q(\x1d8\x81\xe6\xd0\xc7\xa6\xc4!'\x15K\x85\x103\xc9\x10\xd8#\xf8-$\xe8\xbcw=\xc2\x84w\x81<T%	\x072\xd9\x9d\x82\xa1\xf3\x15)\xae\"\xc6%\x0b\x08\x8e\xa4\xd6p]\xc1X|\x9a\xc4[\xce!\xc3\x1c\xa2\xd7m\xe5\x14%#\x96\x0fyN\xc8\x1a\x8d!\xbf\xbdrjtLo\x12\x0c\x04\x98$\xbc\xb5f\x0b\xa1v}\x8e\x9b]\x06\xdb\xb2\x1ce\xc9\xd1\xb92\xc4\xbeI\xc3y1\xf8\xbc\xa6\xd6Z)\x1a?\xf9,T.i\xc5k8\x1c<\\\xd3\"\x0c\xfaD\x03\xc9\x84\x0b\x1dkkI\x0d\x9c\xbe\xd1\x8a\xe04\xa5\xe2p#\xdb0\x1f\x11\xec\x1a\x13;\xa9@k\xbc'X\x12\xd9\xa7\xc9;\xb3\x9eT\xb82\x9f!\xbc\xbf\x07h\xccA\xc98\xc5\x00\xce\xcc\xd6\xa4\xd8\x94\x86\xc2\x96\x1d\xb3\x94\xb2CD\x91\xc9\xd0PD\x995\x95\xf9\x8e\xaa\xce\x07}Of\xcc\xa2\x07X\xb2\xbcZs\x16\xf6\xecg\xe5\xca\xdaSkt\xca\xc5\x10\xd2V\xa7\xa9\xbe\xe0>\x11{[w\xcd2L?62\xcc\xb2J\xed\xa86\xc2\x1f\x16\xb5\xdb|\x0b\xcb.Fg\xd9^Z\x83U.%\x86I\xa3\x00\xe7\xf5\xf7\xacK\x86\x0fD\x17\xa9\x0e\x8f\xc5G|3\x90dY\xd7\x8c1k\x8d\xa0\x1c|\xfd\x8b\xaa\xc6\"[\xd7\xe3y_\xba\xb5\xee\xc3yA\xc0\xfa\xf6\x8dcW<\xf1\x84\xe2\x82z}l\x00\x1d\xdc\xa0\xf6\xbb\xb5{\xeb\x1f\x7f\xbc\xd78\xfa0\x1b\x98\xb4M\xa4S!\xef\x9c\x8bo\xef\xc9{]\xd8\x96\xb0\x07\xef\\\x04\xd7\xe3\x92\x84\x8b\x15\x97\xa7\xb0\xd1\xa1\xe6\xd3\xa9\xf9P\x92m\xe5\x8c\x07\x02\xb1{\x8b\x04\xc6U\xa9p\x91R\x06\xe1\xe4\xf4z\x0ed\xd9\xa9\xb0\x16\xad:c\x92\x08T\xcaS\x08P\x93\xa7}\xb8$Vjj\xda\xb8e	\x9c\xe42yN\x12{\xe2}8{@.\xa3^\xc3^\x1dc\x1b^\x1f\x1c\xa4u\xec7\xb4\xee*\xe4`\xb3\xb7\xf3\xad\xd3\"#}\xe4\xdfS`\xb4\xe9\xd4\x01m\xfa\xd6\x7ffE\xa1\x9bI\xec\xb8h&\xb1\xa3\x1f\x8e\xb1\x83\xade7rhk\xb4\xa5s=\xb2\x19\xbb }\x10\n\x953\x92\xb4E\xdd\x8a\n5=\xc9\xeb?\xda\x1bi\xa4\xe1kx\xf5\xd5\xcf\x1f^}\xf5\xf39\xc7<\xb4\xb5+\xc4A+D\xee\x97\x7f\xb4\x8b\x1a}[\x107(\xed\x83\x88\xa6F\x85\xbf\xfc\xa3M\xee\x90\xfc\xeb\xec\xf5\x9e\x86\xe8_\x16\xab\xff\xa8\xc6,\xe4\xe2\xb7\xa7\xc7y\xc9\x0c\xff\xe9\x94\xe1;\x94.\xc9h\xfa\xbf\xee\x01\xf3r8\xa4\xbf\x17\xa7a\x1fz\x1a\xa8\xd0\x82\xe3\xa8\x91\xfcgrB\xc5\xe9\x98\xb2\xae\xb9\xd4\xa9\x80izk\xeb\x14z@0=\x1c\x14\x82f\x05_aU\xd4uF\xdb\xbbP\xd8q\xf4d\xd1@\x02g\xa5Sj\x0e\x9e\x1awO\xc0)\x97\xd2\x11\xe8!\x92g\xc2_\xfd\xeaW\x07\xff\xc2\x0e\xe4\xe0\xcb\xb8m	<I\x1d]\x95\xf1\xac\xc1P\xb9\xe2\xfc\xd301\xcfym\"\xe9\x93\x84\xdd\xa9\xb2\xb1\xc3\xfcA\xfd\xd8D\xb2\x15b\x10\xe5\xce\x92\x8a\xe9\xb1+\xac\xeaJ\x86\x12z\xb9P\xcc|\xa4\x0b;\xda\x06\xff\x18J\xaa\x14\xa4\xa5\xbbZ\xcc\xe4(\xb5\xd2>D\xb8\xd7AO\xe4=|#\x96\xb9c\xc8u2W\xb1\xc2\xfe\x80\xf0A7]\xd3\xa3\xdc\n\xba6W\xe4\xbb\xf9@\x83\x0f\x0b\xfd\xfd\x13\xe7\xa0\xbf\xa7g9\n\xe2)?\x8e$W\xc7\x13\xae\x1f\xb4\x8a\xf5\x0e\xdb\x0d\xc3\x9e\xe5\x9b0\x9f](\xad\xbb\xdb\xdf\x8d\xdb\x9d\x86\x89\xcb\x0d\x07\xb7\xd4\xb1I\x01\xbdo7V\xa9g)\xebc\x87.\xf9\x9d\xb4Zw\x19/j\xe7c\xd5\xc5\xff\x0d\x01N\x91\xc7\x89\xad^\x8d\xa0\xf9\x140\xb5\xd7\x91N,\xb64\xd69\xa7\xb89YMY\xa4\xd2\xe2\xad;\x0eQ=<u/\xb4\x87\xc6R\xe3\xac\xae\x84\xef\xb86[v9\xafw\xfa\x9b\x9c\x85\xc4\x88\x95D\xbc\xa1\x83ZL\xa6\xcdP\xcd\x0d<\x98lZ\xe2M'p5=\x10\xbb{W\xd6\xd7GKO\xd05`\x07\xf88\xd5E]\x15KM\xc34M\xce\xae\xbc\xf1@\x03\x0d\x85\xc0*\x97\xad\x9es\xbb8\x14\x07}#\x93-\x7f\"a\xa5S\xe8\x9e\xc8a\xe0\x18\x84N)\xdcE\xd7\xf6.\x86l\x8d`\xa7T\x96\xc3\x87Pq\xf6\xc1v\xff\xb1s\\i\x81\xeb\xe0c\xc7j\x04\x0e\xc2\xd6V\x1b\x8c\x95\x94\xfb\xf4\xf8\xb72C\xb1n\xc3*Wf\\\xb7\xae\x92\x89\xb8v\xbe\xef\xae^=\xfeW\xd0\x15\x02\xc6\x0e\xe5r\xc4\x1f\xe0\x81\xd2\"\x878w$p]\xf4\x1c}\xd2\xe6\x8c\x9f\x11\xa2'\xe4\x9d\xce\x95\x12\x84\xae%\xaf\xcb\xb6\xbb3\xea\xc9\xf1\xbe5\xea\xd3\xc7\xdb\xa7L'\xcf\xf4d\xfbT\x0b\xfa\xe6\xec\xedXy]a\xdbr2\xe1VC:\xee\xa4W\xba\xe3\x81\xfbN\xad\xb4\x15y<\xdc)\xec6\x98\xdc\xc6Rano\xd3p>\x0e\xa6f\x96:\xeciR\xaa-$IK],1\x03c\xfaF\x94\xb3\xd1;\xc3\xb6\xd9\x85\xf8\xb4\xdd\xeb,\x15\xebhw\xf7\xef\xe6S{\xc7\xda~\x92\x9b\\\xd3t\x8d-f\xe8\x7f\xfd]i\xdb\xd0\xe4\xc2\xc1j\xff\x99\x99\x1b\xf3\xbb\x90\xcc+\xf5!F'/@)\xde\xe0R\xdb;H\xa9\xfb\xd8\xb6\xcb\x04\x9c\x00\x90J\x85M\xc7\xe9\xd2p\xad (>\xde\xca\xe8\xean\x1f>\xd4\\\xe2\xa5\xfaY\xcd\xc1\xe2\xbd^c\xa4\xa4 \xf5\x94\x93\xb6!\x12\x96Z\xd0\xfa\xce\xd2N\x83\xf1\x86a}\xe7/\xad*\x15\x9d\x83\xea\x0d\xf5%W\x825*\xe91\x8e\xf7G\\\x16^\xcb\xe5\xd2\x8e\xa0[\xb9\xa2\xd8\x95\x143t\"*\x03?!+\xc9\xd1\xf6\xa9\x98m7p_\x98.\x02Y\xde\x98>g>uv/\x02\x86\xbbq\x86_\xde\xd2\xc3\xe8o\xdf\x91:86\x1d\xc1\xedx\xcb\xdc\xd7\x86\x9e]\xa4U\xb0d|\xa4\x87\x08z\x05\xc9\xa3J#X\xc7\x1a\xf6\xfe\xe4\xf78M\xdb\xfb\xd3\xb2(1\xf8[\xde\xdaI\xd9\x8bJ\xb2\xcbQ\x844@\xdaR\x93\xcb\xf9k\xcd\xd1\xaa\x98\x9f\x01\xbbF\xe2\xc9`\xd4\xf7t\xab\x8b\xa8u\x16\"6m*\xfa\"5\xad\x83LV\xd4\x00\x9f\xa4\xe0rj\xff5\xec\xbd\x82\xdayv\xa4\xfe\xf1\xafa\xf2m-a\xb9\x812|\xba\xae\xd6l\xdfMr\xb3?H\x85\xec\xbf\x85\xc7\xbf\x80\xd2\x9et\x1c\x9c\xb6\xe4\xba\xb0t1\xd7\xb4\x8927\xc7z\xe2\xe2\xaa\xbb\x90\xe3\\|\xff\xeer\\\x0eW\x91\x0c\x98O\x86}|\x93\x14*\xdfL\x0ewT\xac\xbf\xc15\x19\xdb\xb7\xf1[\xef8\x91I\xf7t\x1b\xe7\xef\n3\x92\x1b\x9dQ\xa6\x8c\x92\xc0\xe47\xd3\x8d\xcf\xd36\x1c\xc3\xd3\xdb\x03\xd8h\xab\xdcfd\x19\xca\x9c{1^m\x9c:\xb1\x84e\xd7\xb4)X\xa7k\xbfl\xedE\xe8N\xce87\xcc\x06\x9d\xda/%\x98\xf10\x16Tq\x86-\xb0y\x96ib\xda\x8a\x84\xea\xfbqb\x02C\xae\x94\xef\xfa\x983\xbc\xa3\xca5\x0dY\x95\xdc\xe3\x92F\x9f\xff\xb9[\xd5\x80]E\x16]\xf1\xb5]E\xd7\xd8\xdf\x8e\xf7\xf8\xfd\xca5\x9f/\xf8\x02\x05v\xa5;\xf7\xe0G9\x8eel\xbe\x11\xef\xb3\x13\xcd\xe7l\xd3Uxg\xa36\xc9\xd1K\xf0[\xc6 /9\xf2e\x8f\xae\xd0\x98-\x18'\x8d0\xd7\x15\xb9Wh\x9d6e<\x95+\xe1\x01\x9a\x8d\xcc\xf6MN>\xa3\x9f\xfdLbH\"\x81\xa5q\xd5]\x90\xb3c\xc732\x8e\xb8ZM/\xbb\x17\x0c\x82\xdb\xe2\xb2\xbbwP[\xd7y\x90\x19\xe9.\xbcW\xb3\xd4Z#Te\xce\xcdr&>I\x96\xfc\x8cOZi^\xb4\x14\x1d\xc5^\xa7\xbb\xc2qn\xd3\x1b\xef\x82\x0cU\xdaY\xb6\xe0H\x0dNo\x1f\x93\xba\xa6\x13\xba\xc2\x871\xdd\xe7*\x07M\xaf\xce\xff\xc8\xab\x85\xcc\xe2\xb9g\x0b\x13\xe9z\xcca\xaf\xb4\xfd\xbf\x90\xfe\x13o\x16R\x92\xae(T^\xb7\xec\xc4\xf3)\x96\x06]p\x93\xd2}\x91\xf4gT\x8e\xacO\x16\xf3\x15E\x9f\x03\xb1/\x1f\xb4\xcd~\xe2\x82\x8eU\xff\xf8\xcd\xc0\xec\xbc\xe3t\xba%\x1f\xb8`\xd0\xdf\xa3r\x13v\xf9\x11\xd1\x94\xe2Y\xb7\x9c\x18\x7f\xba\x91\xd0$\xdf\xde\x05\xf4\xe9)\x910\x1e\xe7K\x15\x90\xd3\x87\xb7\xf6\x1d\x07\x93\x81\xcf\x07\xa9\x10\xfa\x84\xc1Yi\x11\xf7J|41]T\xaap\x98\xc2\x94\xd4\xd3\xa9\xa3XZ6\x9fi\xb5~\xa0\xe5U\xd1=M\x12\x9fi\xb4N\xe9R\xa4!\x0f\x9fm\xdb\x82D\xdf\xc9\x0b\xadM\x8d\xf1\xc8\x96\x81\xfd\xc3\x00I\xb2\x99\x02y\xbc\xbfFy\xdcD\x10*OdC\xedb\x0ed#\xbf-ZE\xa3	\xfe>\x0d\xe7\xc5`\x12\x01\x8b\x858\x7f7\xb9p\xf9\xe0\xfc\x1d\xc7\xb1~\x1d\xdfhE\xa1o|\xe4\xc2\x9b}IH\xceu|0\xf5\x94\xe7\xce\x03\x87\x7f\x8c\xf3\xcb\x17?\xcc_\xbe\x98\xb9V^\xaf\xf4\xcf\x0bm\xba\xc4\x9eY\xb2u\x97\x0cd\x96\xb6\x89\xa1+\xce\xf7]\xde\xb7\x84\xdc\xe4F\xcd\x0c\xbf\xeb$\x11y\xfc\x0b\x18\xf4\xeb\xce\xb3\x8a\x8b\x88\xe0|\xe4\xe5\xf7\x11`v,\x01\xba?\x9a\xd9%\x86twQ\xb8\x95Y_\xcd\x14 Q\xedt\xb3\x96\x00\xe7\xec}\xd3\xf8\xa5l\xd5,\xe2p\x9b1{C>_+\xcc\x8eZ\xf4\x8f\xfff+\x9d\x1c\xf1\xec&G\xca4:\xd5\xf7\xe4Y\xd72qDS\xcbuSbIMk\xb8j\xe8\xb7h\x89\xf6\xa6\xb4\xd5\xdf\xbb\xae\xb8Q\x92zX\xa5\xae\xfe\xc1\x17\xe1\x00\x96[\xf8b\xbc\xa7_9c\xdc\x86+(O\x18\x9c}\xdd\xeb\x14\x87\xa7%\xdaTE\x89\x93%6\xba/\xe4\xa1\x97\xe6\x1f\xfb\xbd\xd9V\x95\xf3\xa9\x08\xe3R\xdd\xe7;\xe6y\nq\x177\xec_\xbf\xc8]\x9a|\x02\x9d\x9e>\x1f-_\x8e\xa6\x8f\xce\x05-\xe3\xfa\x9c@\xd2yq\xc4\x0dZ6\xc0\xbe19C\xa5\x84P\xa9<n\x93\xcf\x99\x1d\xb5\xbdw\x99a\x08zm%\x1e\x0bF\x86)\x00'\x82%\x8aJ\x1d\xa3\x1d\xc6\x96\xfc\xa2\xa5*\xe4UP\x1bC~\xb7\xf0\xd5!\xfc\xfa\xe6\xec\xcd\x1cn\xae\xdf\xcc\xe5y\xa2\xf3\xf0\xe1\xec\xf8J\"p\n:\xd0\xe4n\xa0\xd2\x0dI\xdd(9\xc3\xd7\x87\x87\x0f\xaf\x0e\x0f\xe7\x03Zbv\xdf\x89|ux\x08\xbf9\x96o\xb5\xf2\xbe\xcb\xaa\xfd\xbc\x1e\xf9\xa2\xe3\xfc9\xe3;\xb5\xc9\x13\xb5YU\xa3]S\xd1\xe8\x98\x9d\x08d\xda\xe6\x98U\x86\xf7\xa3b|\xff3\xc3	\xbd\xcc\x92\x1f	\xe6\xecJ\xaf;\x9fjOA\xf6\x90\x1c\xe7\xa6d\x8b\xf4\xf6`BW\xbcG\x98Iz[0\x93a\xc9Ia\x94\x0f;\xc5\\e\xccRx\x1e\xa6\x94%nI\xd0\xc7\xac\x9e@\\OI\x90\xdbu=~\xe8\xd5\xcdT\xe7\xfbN\xd9\xec\xb4\xff-\x98\xa4\xfa\xa2\x02g\xf9\xa7\xc0\xfbk{F\xf4\x97\xfb\x19\xd3Uwo\x93\x96\x9d\xbf?\xf9\x0d\xbc=?O\x88\xb5qK4\x0c\x7f\x93~	T.\x11g\x17\xa7\xfd\x88l\xd4Q\xce\xfa\xa2\xff\x9d0\xc6\xd0:M\xbfH?'\xdd\xf9\x19\xe7|'\x85V\x18]<\xcf\xaaJ\x1da\x88\xb6\xeb'\xaa\x8dO\x14\xf9\xa7\xf4\xf8\xeb\xff\x81\x1e\x1b\xb7v\xd2\x98\x9e]\xa6_\x054=\x19a\x04\xa7\xec\xd2\xa7Rt\xaf\xab~\xd3w\x9a\x9aC?s<\xae1|\xcc\xde\xe6\x9f	\xbe\xb1\xd7nh\x9e\x88\x8fT\xd2`p\x1b+m\x8a\xa2\x87\"-!\xa6\xba\x19\xf4\xa3\xed\xfcz\xd0\x9e\x1b\x1e\x88\xf2\x1c\x14\x1a\x96\x02\xef\xads&\xeaV\x0e_\x9btI\x9a2\x96\xe5\x16B\xb7\xfc\x8e\xaa8\xcf\xefO}vg\x16\x1b\x02\xb2\x15\x86\xf4\xe6\x9cU)\x18\x0c5\x85}\xe8\x0f\xe7\x1d\xad;\x83\x9e]\xb1O\xc5Qv\xc0\xb3@\xf1X|\x95|\xd9\x82b\xee\x81\x86\x01}\x99\x0e\xbcG\xe7\xf3O/\xaa\xc7\xad\x0b5rU\xfb\x1a\xfa_\x05\xf4X\x12\xf0\xd9B\x06\xb0\xd4\xfd\x94\x1cI\x05\xc7	\xf1\xf8\xb1\x99 %\xb1\x83a\xf6)o\xb9oiSf\xc9)w6\xe6\xb0\x17\xb6\xb6\xcaN\xc4R\x95\xba\x85\x11c\x17F\xf4I\x1f\"\xde[\xfd\xb1\x93;'\x9b\x9edb\xc59\xf9\x81<\x1f\xe3\x10T\x04\xe9\x98ZH\xb3\xa1i4\x8b)M\x93\xfa2C\xb6m\x82\xf0\x7f\x81t6\xc7\x87\xf7\xf2\xe3\xe5\x8b\x1f^\xfe\xf0\xf2\xbf\x03\x00\x00\xff\xffPK\x07\x08\x89\xfc\x07O\xbc\x11\x00\x00\x0d1\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xef\x9d\x8cN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/ru_RU/common.jsonUT\x05\x00\x01R\xeb\xb0\\\x8cW_o\x1b\xc7\x11\x7f&?\xc5\xe2\x00!	`8\xef~\x93,:&*K\x84H\xc7(\x9a>\x9c\xef\xd6\xe4U\xc7[\xf6\xfe\x98!\x02\x03$\x85\xc8M\xe5\xda\xadR\xa0A\xe1\xd8u\x13\xb4\x8f=\xd1\xa2M\x91\"\xf9\x15f\xbfB?I1\xb3\xbb\xb7G\xc1n\xfb$\xce\xde\xcc\xec\xfc\xfb\xfdf\xf5M\xb5\xe2<\x12q\xd7M\x9d[\xec\x9bj\xa5\xe2<t\xa3\x88\xfb\xce-\xe6\xecl\xef\xef\xd7v\xd9\xce/\xd9'[\xc9'\xec\xce\xc1!\xdbJ\xe8\xcfW\xceV\xf2\x95\xe3\xdc@}\x9f\x87<U\x06\xbb\xb5\xbdZ\xcbZ\xa8\xefA\xd7m\xf3]\xabT\xbf\xb7\xfdE\x8d}\\\xb5\xd9\x13A\xc8\xe3\xb2r\xb3qP\xdf\xab\x1d^W\x8fx\xbf!\x924\xa9G\xadN\xcc]2\xd8\xf2Y\xc4\xfb\xac\x87\xe7,\x88XJ_n*\x03:\xdd\x8e\xfc:\xde\x93\x1ct\x83T\xc7\xb4\xe5+\x8bO\x93\xcf\x98\x1b\xf9l\xcbg\x14\x0b\xcaB\xabY\x0f\x1f1\xdcT\xcc\xe26\xf71<Tk\x1c4[\xacq\xff\xf0\x8bk\xe5,\xd71\xe9\xb8\xbe\xe8\xef\x04\xa6\xfa\xcd\xbb\xdb\xbb\x07\x0f\xd8N\xfd\xffi\x82JsOxG-\xd1n\x87\xcaC\xeb\xeeam{\x17\xd57\xca\x96E\xb6\xc5\xf7\xf77\x9b\xac4\x1e\x07\xbc\xcf\xfd\xa6\xdb\xe5\xf5\x86	\xbf\xc9\x0e\xee\xb0\xd6\xdd\x1akn\xdf\xab\xb1z\x83=\xa8\x1d\xd6\xd8\x97\xf5\xda\x83\x92u\xb5\xf2\xe4\x86\x1e\xa8\x04\xe7\x89\xa4^\x98\xc5n\x98\x98\xf9\xf2\xdd\x81s\x8b\xfd\xaaZ\xa9T\x1c\xb8\x80),\xe53\xba\x96\xc4%L\xe1\xd2\xa9V*\xbf\xa6H:\"\x8b\x0bm\xf9\x14r9\xd2\xbaJ\x80\x15L\xacv7\x88\xb2\x94[\xefW0\x83\xa5<\x96c\xc8\xcd\x0d\xc5Q\xc9JDi\xa7l4\x95#\xf9B\x9eX\x13}\x00\xd3\xf2e	\xf7D\xe4\x17vZ\xbcQ\x12\x12\xab<\xe0\xae\xcd\x03\xde\xc2\n.\x8c\xfb\x05Lu4\xbaz4P\xa6Z\xae\xdf\x0d\"\xec\x01\xfc\x11.T\xf4\xaaGn[\xe0\xb1\x1c\xc3\n\xae\xe4\xb1>\xa4\x80\x1c\x98\x19Q\x18\xdb%\xac`	3\xb8R_<\x11\xa5\xb1\xeb\xa5\n	\xa4\xf3\x06&0\x95CU\x1d\xf9\x8c\xc1\x0c\xde\xc3\n\xce\xe5\x10rx\x87}\x82\x99|Q\xc6\xfc\xce\xc0\x8e\x08\xfc\x04\x17\x90\xc3\x02f\xcax\"G0er\xc4\xe4\x1f(\xc4\xb7\xb0b\xf5\x86\xb2\xe6_\xf7L\xa0\x7f\x83\x1c\xdeo^\\\xd6)\x85\xf7\x01\xcd\xff\x1ab'\xf0\xb9\xcek.\x87\xf2\xd4\xba\xd6\xf5\x9c(\xe97n\x14\xa4\"V\x97\xbc\xc6b\xc2J~G\xb5\x9ak\x8d,I\xf7E\xbf\xa8\xf6B>\x839\xac\x98|\x8a\xa2\xd2	\x85w\xa4\x10\xa5\x7f\xe9\xc9\xf2y\xec\x16\xde_b\xdb)\x81\x1c-\xe5Pi	K'\xb0\x96CX\xc1Z\x1e\xcb\xef(\x1d\xed^\xf4#\xae\x9d\x9c\xc1\x02r\xc2\x0d\x0e\xce	\x83\x0bX\xc9\x11\xccM\xcf\x13\xce\xb7\xc3Pg\x8e\xb9\x8c\xe5\x10'\xach\x8aa\x1b\x95\x11\xa5<\xa7\xda\xe6\xb6D\x89\xe2`\xede\x0d+\xb8\xa4\xebt\xc4)qL3\x0d\xbc\xa3\x81r\"\x87X/\xbc	\xd6j\x04\x0c\xd7\xd8\xca\x14\xbf\x0b\x8e\xd9\x18\xa0\xbfc\xac\x0c\xdbX\x0c\xca@d\xf8\xe9S8\x93\xa7\x9f\x19rI\x06\x91\xa7\xa1\xe4\xa0	\xcca!\x9f\xcb\xa7\xf2O\x06\x1aD\x0bS\xb8@\xb0\xa8\xa10Y\xcb\x11\xc1\xff[\xaa\xf2\x92\xc6'\x97'vh>\xf0}F\xbf'\x90\x17\xde\xb1}\xa3\xff\xa1Y%\xdc;i\xd0\xe5\x06\xc9\x9e\x1b\xf2\xc8/\xd3\xc0\xbf`\xa9\xa7\xb0\xe2\xc0\xcf\xc4.Zx	\xb9\xaeu\x05\xc1\xbb\xb6\xc2K\xc8\xe1\xd2\x08?\xc8\xe7:*-,\xac\xcd\x04\xde\x1a\xe1\x0dv\xc5\x08\xaf`.\xc7F\xf8\x11V:\xf5\x8a\x03\x7f\x86)\xcc-e\xf59?\xb2\xb1\x9e\xe1\x8c\x19\xcd\xd7\xb0\xb4\xfcu\x86\x13V\\%\x87\xf6\xcb?\xe5\xd8\xa6\xf4Z\x8em\xaco\xe0|\x93\xf3\xb2\xa0x~\x884\x15]\x9a\x88\x1f\xb1\xac\xaa\xe8\x9e\x1by\\M\xf5+9FJ&\xac\x17s\xe6\xb9\xa9\x1b\x8a6)\xfc\x95\xe0\x85\\\xb4\xd25p\xbc0\xc0\xcdx\xbb\xf0r\x1b\x0fX*\x98v|\xa3\xc4j\xc2\x8d\xfd\x0fQ\x9a\x06\x9a\xe1Z_D\x8a`\xbe\xa7)\xc4\xcek\xb4>\nB\xde\x12b\xcf\x8d\xdb\xa4\x812K\x85`!\x9dh\x9d(H:\x1a\xf5\x7f\x81\\1\x9b\xfc]\x19\xf5m!\x10f\"j\xebr \xc9]a8H\xad\xc4>p.O\x19\xcc\x88\x00r\x15\xe6\x1a\xd7\x15,\xe5s\xe5$\xe8\xf6D\x9c\xde\x16q\x9c\xf5\xe8-\x02?\xc0\x15BZ\x0e\x11nKtu\xac\x12\xc5-w\x93\xc1\xcf8b\xb0`\x84\xfb	a\xfa\x1d\\ \xbcn\x96]\xee\x9a\xfc7\xfc\xbd\xb7\xa9\x90\x05\x837\xc4@9\xf5\xeb\x04r\x06\xe7t\xdf\x14\xb5\xd7\xc4\x86S\xb2z+\x87\xf2X\xb3xnn\x8a\x1e\xbba\xe0\xdfv{\xa9\xd7qu\x15\xa6f\x0b@._0\"\xaf5\xbe\x06\x94\xc9\x11\xe7=zm\x06Q\xfb\x8e \n\xfb\x05\xe7=\xe6\xeb3\xf6H\xc4J3t\xd5\xe3\x0c)P\x8e\x90\xdf\xe8\xfd1\xd3\xf8\"&\xb7\x0fK\xf5\xae\xd2\xefI\x86\xdf\xac\x16\xf7[b\xc7\xce-\xb6s\x83\x0b\x19L\x14G\x98\xd9\xe9\xf2\xc0\x17_\x06I \"\xf3D\xbc\x87G\xec1\x9di\xa5,I\xef\xb9\xa9\xd7\xd1A\xe6\xc4/\x0b\x98\xd1(\xc2\x02\xde\xc1R\x9e2\xf5\x08\x825m\x85\x12\x87G\xbco\xa3G\xa4\xc3D\x9e\xc2%S\x0bA\xa3\xd4\xe9\x89 J\x11\x19\x16?\xafuS.\xe5\x18\xef\x9a35[\x06Q&\x07z\xa6\x94\x164UQ\x8e\xe5\xe9\xe7\x84\xc0!Z\xc3\x12\x17\xd3\xe7\xad\xd6\x9e\xb2\xf9m&\xcc\xa6;C\x16ej\xe1\x11\x05\x8fK\x04\xba0\xdb,\xe6n\xa2\xde0\x87\xea\x97>\xf5\x83\x98{\xe9\xce@\xed\x8eC-37\x0c\xd9\xc3A\xb1?\xac\x9e\xad\xc4u]\xd5P\xa3\xff(\xe6\x89\xaa\xf7+8\xa7G\xd3\xa4L31\xef\x85\x83\x82\x85p\x0c\xc7\xd7>\x8b\xb8\x98)x\xa7K6QmAx\x19\xbd4\x8b\xa3\x02\xd48\xfd\xba\x1dq\x16\xdar\x96\xf7\xb2\xaa\x14bk\x86\x0f\x00\xa5\x9dp7.\xc6c\xa5h\xc0|Ip\x90j_\xf7\x02\xfd\x9f\x14n\x01\xb5\xb6\x1043l\x15\xf2}\xc9YG\xf4\xf7E\x1ax\xdc\xe4/\x7f/_\xc0\x84\x80Q\xda\xa1I\xf6\xb0\x1b\xa4E\x11\x8a\xb0l\x19\xd2N\xd6}\x18\xb9A\x18h\xe6\xfa\x9e<\xa8\x87\xcf\x89\n`M#6\x91\xcf\xe4\xf3\x7f\x0f\xff\xa1\xedDO\x8f\x06\xf2\xc7\xb7\xea0\x8b\x0cU\x1a\xa4\xc0O\x8cJ:b\x94\x11\x15g\xa9I\xa4D=\x08\x0e\xb8$\x16C-\xe3\xad\x8f\x88\xb2\xe3p_\x1dl\x8cA\xd6\x0b\x85\xeb\xdf	B\xaa\xc4}\x92\x18\x92x\xf9s#\x16\xed\x98'\xaaY\x96\xbf\xdec\xd3\x8a\x8c\xae]\xf6\xa0|U\xb5\xf2\xa4\xfa\xe4?\x01\x00\x00\xff\xffPK\x07\x08'[\x9e\x89\xcd\x06\x00\x00\x7f\x0f\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00v}\x96N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/ru_RU/server.jsonUT\x05\x00\x011\xe1\xbd\\\xcc\\\xddn\x1bG\x96\xbe\xb6\x9f\xa2\xc0E\xe0\x04`,g2\x17\x83\\\x04\xf0\x7f\xbc\x13\xff\x8c\xe5\xc4X\x04\x19\xa0\xd8]${\xdc\xecb\xba\x9b\x96\x95\xc1\x00\x92\xe8\xd8\xce\xca\x13%\x9e\x00	2\x89\xbd\xde\x04\xd8\x8b\xc1b)J\x8ch\x8a\xa4\x80\xec\x0bT\xbd\xc2>\xc9\xe2\x9cS\xd5]M6i\x0b;\xbb\xd8+\xab\xbb\xabN\x9d\xaa:?\xdf\xf9\xa1\xffx\xf2D\xa5.\xe3VRy\x87\xfd\xf1\xe4\x89\x13\x95Kg\x7fWy\x87}t\xf2\x84\xf9\xbb\x8a\x7f\xa9\x7fU\x035\xd2\x9bz\x8b\xc1\xcb\x93'N|\x0c\x1f*\xd7V/\xdd\xce\x86_\x93)[\xe5u\xc1.\xc9\x98\xdd\x96\xf1\x1d3\xf9\x9c\xe4\xb1\xcfx\x18\xca\xb5\x84\xb5x*\xe2\x80\x87U\x966y\xcax,X$S\x96\xc0\xbcT\xb2\x9a`w\x03\xb1&|\x16D\x8c\xb35\x19\xdfa\"\xba\x1b\xc42j\x89(\xcdW\xe6\xe1\x1a_O\xde\x97\xde\x9dl}\xf5\x8d\xea\xa9\x91\xdeP\x03u\xa4\x86zK?f\xaa\xaf&j\xa8\x0et\xd7n\xe4\x89\xdeT\x03\xb5\xa7\xf6U\x8f\xa9#\xbd\xa1\xa6j\xaczzKo\xab>\xfe\xfb\x98\xa9\x11\xb3\xb3\x18lYo\xa8\x1e\xbc\xd0\x0ft\x97\xc1D\xf5\xb3\x1a05\xd0\x9b\xeaP\x0da\x8d\x91:T=\xb5\xafF@t\xa2\x06\xc8\xc7\x96\x1a\xe2\xf2=\x87\xe9HF\xeb\xad \x119\xcf_\xaa\x89\x9a\xe2rcXR\xf5\xf4\x035\xd4;\x96\xdd\xa7zKM\xd5.\xb2\xf0\xb3\xe5\xaf\x0f{\xd0\x9f1u\xa4\xa6\xc0\xa0\x1a\xe0>\xfa\x0c\xf9\xb4\xd4z@\xd1Y\xbb\xe3\x07\xf2C\x19vZ\xf9\xeag\xe1\x1d\xbbK/iE\x1a\xc1d\x9d\xe1\x04\xb8\x86V'	<\xc6#\x9f\xdd\x0d|!Y;\xe4\xeb\"N\\\xd2\xa9l\x04\xf5\xe2\xa6pC\xc4\x17\xb25\xd1\xdbj\xc0._\xb9\xf4&\x1e\xfb@\xf5\xf5c\xfd\x85\xdd\xe71fd\xcb\xd6\x1aW;i\xbe\x19x`\xe7\xb8w\xa7\x11\xcbN\xe4\xb3\x0f\x81YC\x1f\xbf\xa5M\xc1j\xf9w\xdc\x8cK\x8d&Xr\x0b(\x9d\xf5[A\xf4f\xd2\x16^P\x0f\x84\xcfZ\xa2%\x12\xd6\x0e\xda\xc2gI\x1a\xf3\xa0\xd1LY\x10\xa5\x92\xad\xcb\xce\xa9X\xb0\x96\x8c\x82T\xc6\xceB\xa0\x0e\x17\xef\xb5\x83x=?\xb1'\xb8\xc1\xb1\xdea\xeag\x94\x83	H\xd6>\xdc\xaf\x1a\xa9\xa1=\xa6\x7fSC\x94\xba)|\x03A{\xc1\xd4\xae\x1a\xa8\x83\\\x16P\x0e\xf6aDO\xf5\xe1YwQf\x0f\xd5\x00\x85x\xc7%[d\xea\x1awdC}K\xec\xcc\xf1`\xdeO\xf0\x9e\xa6\xc0A6\xa4\xca\xd4XMA=\xf4\x16\xd3\x9bj\xaa\xf6Q63\xc9\x05\xbe\xde:\xc3@]P?&4\x0fdyWw\xd5\x08x\x1f2T\x81\xfbz\x83\xc1#\xc8\xc5\xcf\xb8\xd71\xc3C\xda\x833@\xad\x1c\xcc\xf0\x7f+HCQ4\x07{j\n\xc7\x85\x9c\x8eJ6S2\x067\xa6\xb7g76Q=\x14C\x90\xd2\x81\xde`\xbf\xfc-\x13\xda\x010\x8d\x1a7Q{\xaa\xf7\xcba\x95\x9eFz\x13\xbe\xeb\xae\x1a\xb3\xb7\xce\x9ca\xf4\x88\xeb\xe0z.\xfb~.\x0c\xe7\xe0\x81\xf8\xbb%\xee\xa5\x0c>\x82B\x82\xfc\xb6e\xe2\x18A\x8f\xb7S\xaf\xc9\xf3-\x7f\x87L>T=g\x7f(\x15=\xd5W\x87z\x87n\xe1\x08\x18\xd0\x8f\xd5\x01\xa9\x1a\x18\x10\x14\x8f\x17`\xcb\xfaxk\xc6|\x8e\x88\x1e\xd9\xbdC\xba\xf6\x81\x1a\xa9)\n\xd6\x86\xde\x86\x9b\xdb\x87\xa9\xb8J_\x0d\xd5\x8b\x92\xb3\xa2\x87\xd9\xebCS\x07'7$Y9@9\x9d\xe0;\xba_x1\xb7\xdb[\xbc\x91d;>O\xefX\n/\xcd\x99\xf1F\x02\xae$\x91\x9d\xd8\x13\xac.cff\xb2D\x86\x9d4\x90Qr\x9a]\x8f\x04LbA\xc2b\x1e\xf9\xb2\x15\xae3\xaf)\x13\x11\xe1\x0c\xc1\xbd&k\x88H\xc4<\x15\xbe%p\x9a\xdd\x14\x9ft\x82X\xf8\xb0\x80'\xa3\x94\x83\x9bJY(x\x92\xb2\xb7\x99\x1f\xd4\xeb\"\x16Q\x8a\x0c\x9dvxo\xf2x\xd5\x93q.\x9e\xe7\x9b<\xe6^*b\x96\xb4y\x8b%\xf8\xd1\xd8\x98(\x0d\xf0\xe5\x9a@s\"\xeb\xac%\xfd\xa0\xbe\x1eD\x0d\xc6\x99\x97\xcdD\x1f	\"q\x9a\x9d\xad\xc3\x0bq\xcf\x13\xc2\x87a ,a\xd0\nR\xfc\xab\x93\x88\x98\xad\x05a\xc8\"A\xcc'2\xbc+\x80\x98\xd9\x99\xc3j'Ie\xeb\xfc\xeaj\xc6j\xe6\x8c\x8c\x1d\x86\x0b?\xd4;h\xb0\xc0D/\x14\xa81;\xbf\xbaJ\xd6\xbb\x87\xc2q\xa8zU\x96\x8b\x86\x9a\xccR\xe9\x83\xbc\x80P\x81\x06\xee\x1a\xcf;A3\x83T\xc7z\xbb\x84\xd3[\xb2\xd1p5\xffY\x19?\xfa1\xe9\xb2z\x01\\\xe5\x8e\xc7z\xea\xcc\xf5,Q\x92\x19\x1a\x19'\xbe\xa8\xf3N\x98\xba\x87\x86\x90i\x8c(CM\x19\xd8\x00$\xf8\xd0`\x89\xcc\xf3}\xab7\xf3\xb5t\x97&\x81\xa6\x95O3\xc7\xe0\xea\x85Y\xfc}\x1e5\xf2\xd5\xffC\x1d\xe8m0g\xc7^\x9dL\xdf\xa2\xd5w\x88\xae\xbbz\x02\xd7\x10\xf3F~\x01\x17D\xd2Y5\xefh!g\xd4i\x197\xe8x\xc1\x97\x19\x0e\xc1\xd2\xe8\x0d\xf2	hu\xc6\xce\nA\xc2k\xa1\xb8)k2\xcd5_}\x85Ft4so\xf8\xb0K\x06J\xf5]3\x8f\xb2\x0b+\xd0\xfd\xd2\x98\x9e\x1a\xa3\xf1\xce\xd81\xc6\x7f\xec\x12\xc2A\xfb\xe4_uW\x1d1\xd7\x95\x0c\xe6\x18\xfd \x111b\xde\xc4\x15\x06:\xe3\x119\xe6}D{\x939wI&\xb0\xef\xb8\xcbyw5\xb3\x0f\x04\x83j\\e\x08}\xa7\xe4\x8e\xb2\x15@\xdf\xca(['7(E\x03\xbe\x8cf\xfc(\xe9\xe5#\xbb\xa8\x01\x1b.[#t\x07\xf8\x15\xce\xcc>Za\xc2\xe1\xd9\x02h\xd7j<\x0c\xb3U\xfe\xe17\xf0Hsa\xb1-\xbdm\xa9?G8\xbfI\xde9\xfbn\x81\x0ez\xa5\x11\xc1x5Q\xfbz\x9b\x11\xadW\x04#g\xe6h\x0e\xd9\xaf\xce\x94xk{\xd4{j\xeal\xa4\xc5\x83\xf0b\x1cg\xfb\xb8\x08/\xd8\xc58\x96q\xe6\x90\xd0:1\x1c\n\xa6Y\xe0\xc7\xd3\xf3D\xae\xf2 ,\x122\x14\x90\x1c\x11(\x99v\x83'\xb9\xa4\xc1\xc3\x9a\x8c\xfd\xf9\x99\xacm>\x95\x91\x90q:\xb3\x85D\xc4wE\xcc\xda\xf0e\x9e\x96\xf3\xb5\x84\xdcj\xa7VJm1\xa1\xa4S\xf3e\x8b\x07\x91K\xed^S\x80\x87\xcdI\xd9\x17D\xc6~?\xb6A\x11\xf7\xda<\xf2\xcf:\xd2\xa7\xfeE\xf5\xd4\x01I\xb9\x9a\xe8\xae\xa3Z\x0c\xe1x\x1e\x83\x19\x14m\x85\xf3\xd8\x13\xd9\xeb\x80\xa1\x00i\x1d\xc2#J'\xe8)\xbb-jW\xab\xec\xc6\x85KUv\xf5\xc6\xdb\x19*\xda5\xc8j\x90EDV\x9d\xf4\xf6\x1b\x85=\xb9w\xa8\xfe\x1d\xd1\xe7\x11\xa2\xb4\xad\xa2\x8d\xb4\xac/\x19\x82\x1c\x19\x986U/\xc0:\x00\x18\xd7\xf7UO\xbdP\x87\xf9\xb2u!|\x88\xa9HZ\xb3\xd5\x9f\xd2\xae\xf5\x16\xd2\xd9\x81\x9d\xf4\xd1ud\x8b?SS\xfd\x10lk\x95\x19c\x9d\x05\xb9\xb9\x0b\x04\x9d#@`\x02\x00\xb5k\x00\xc1\xa0\x08\xff\xeba\x01\x14\xcaN\x94\xc6\xeb\x8c\xde\xd2\x82\x17\x82\x04\xe2VDK\"f\x9e;\x84\xc9\x08\xdf;!m]\xc6\x9e\xf0\xcfF2\xca7\xf5\x13\x9e\xd4f1F%F\xdd\xb8{bn\xe7\xb1\x1b\xc3\x8f\xd4\xa1\xfeB?\xb4\xf6\xd3\xa0\x1f\xb4\xce[\x88\x86\x8e\xd0\x86\x81\xf52\xee\x08NGw\x19@o'\xb4\xcb9l\xf00\x14\xf1\xfaU\xe9\x0bW\x8c\x07\x18?\x8e\x19\x04\"\x80\xc1\x10\xb0\x0d\x9dSGe8p\xd2\x1dH8\xf3M\x99l1\xbd\xb9@\x82\x8by\x85\x86\x94.\xf6\xbaL\x8fU\xe7\xe1X\xba\xd9\x0c|_8\x87\xfe\xdcz\x125}\x87\x9d\xc9\x8f\xf4!\x85\x11\xe6@Q\x8e\xad\x8f\x80\x85\xec\x9c\xa2\xb5n\x06\xbe8\x17D\x91\xf0\xb3\x05\xde\x0b|\xc1.\x88P\x00\xcc\xbf\x81BP\xcd?\x80KB\xc9\xa0lU\x93\xdf\x15\xac&D\xc4|\x9aQ$]\xc8\x86\xe1|x\x03\xd2\xb5\xc2\xc3p\xc5%\x8c\xf1j\x02QBC\xf8\x8c'y\x12\x0c\xa2\x0fL{\xd5c\xd9B\xf0\x8e\x93YK\xa4\x1cg\x15\xd7\xbc)\xbcN\x9c\x04wE\x98\x07\x90\xd9;\xe4\x9d5\x03\x88\x08J\xb7e\xb2p\xb1h\x87\x01\xc5\x05\x9c\xd1\x0d\xd0\xd4\xd8\xa1^X\xf6V\xb3\xd3\xaa%%\xd7\x84\xe8`>\xbfS\x9a\xc7\x1a\xa1\xd6\x1c\xa9\x91\xee\xb2\x8fr\xd9\xa4\xcf\x1f\x93U\xc4\x08\x12s\\\xac<\x0b\xd4\x94q\xf0)Db!\xdc^\xe0b\xe0\xbf\x80\xc2\x1a\x19\x9e \x8e\x031\x9f\x140	\xca\xe0\x9ee\xf3\x07\xf3\x92F\xeeQ\xba\x04D\xf6\xed\xdfxM\x1e\xe5\xab\x06\xb9\x04\xa9\xbf\xa2\x98\x0d\xd5\xc4PqF\xb5xC\xbc'\xc1\xf3\xb9\x9e\xc6\x11\xd0\xe1\x02?a!8\x86\xd2}\x13s\x15\x90\xbbC'\xb7\xda\xe5\x1e\xc7\x84\xdf\xb3\xb4\\-FNoJ\x99^\xbf+\xe28pm\xca\x0f\xe6\x02\x10]\x19\xc5\xa53\xd4\x9f\xd1q-\xd8\x81\xe5\xf4k\xc4h\xf8\xed\x00\xaf\xf3\xf3\x9c\xb3\x05[\xc7\x9b\x07\xb7@\xa1\xe6c\x14\x94q\xb6\xa0\x1a\xa0\x17\x9dI2\x10\x16<\x7f\xe1\xda\x1b\x94\xc7\x18P\x1e\x03s\xb5`\x07LLg\x05\xa0\xa7\xf6\x11Io\x96&w\x9ai\xdaN\xdeYY\xc1\x83IN\xb7D\xa3\xe3q@\x1a\xbf\x1c\xce\x9cZ1\xac\xbf\x02\xaf^)\xa4o\x93\xb82\x1e1\xa4\xf3\xf7\x8c\xe1\x83V\x11\x0d|\xab\xc6\xd6\xd1g\x91_\xfe\xaa\xe8\xed\x07`\xa6\x87\xea \xf3\xf6n\xac\x19Da\x10\x89KA\xbaD\xa0g\xa1\xd1\x14\xa1\xc0C\x0c\xb2\x97\xcbi\xdf@(\x93,\x9a\xda\x0b\xcc\x02\xefiv\x8f$\x92\x0ec\x9f\xf89\xe6\xbc\xf2\xbb\x0b\xe7\x0c\x17\xf0\xfe\xd8\x00\xf1\x0f\x1cs\xb6\x8e}{\x86\x01\xc6T\x7f\x8e\x12\xeaDd_\xaa\x11R\xea\x82\x85\x01\xef}43\x12\"\x87\xd71\x12\xd9\x83\xbd\x15\xdd\xad\xcd\xcd:\x99\xb99d\x17\x96\xc5\xf5\xf9-\x1e\x18(a\x1c\xe1\x8e\x8d\xfb\x87h\xf2\x00<\xdd\xa7\xfc\x9c{Za\x10\xddqv\xf7\x04\x11\xd5#\xe4x\xc0\xf4\xa6\xde\xd4\xdb\xea\xd0\xdd\xe6\xd7\x18\x0cc\x12\x07\xd6\xa9f\x9c\xe7\x88\xe6\xd0f\xf8\xf6m\xf9\xa3\x88(\xfb\x0b\x17ax\x0bG\xaa\xc7\xde}\xf7\xdd\x95_\x83\xa5]\xc9ym\xf1\xc4\x93\x8e\xbc}O\xf2\x06\xe6a\xa9w\x19\xe7\xe3\xdc\xad\x139T\xd3\x8c\xa6{\x9a%b\xb9\x90R\xdaq4_=Q\x07\xb0cJ\xd0\xe3\x16\x9d\x98s\x88\"0\xc8W\x9a\xe8\xfb\xa8\x19\xe3WK<\xe5V\xfc\x08)\xf6\xd1 bR\xed\xc0Z\xb9)<\xbb<\x8c\xcbx\xc0\x97=\xf5bv3\xf7\xdeC\xd3T8h\x9b\xc2\xb6\xae\xd3\xc0r\xbd\x0dt\x81\xc0K\xa2\xa3W#q\xa0zjOo\xe8n\x86\xfe\x8d\x9e\x97\xd3vy^\x0d>\x15K9&G\x95\xfb\x9e\x8d\xe3s\\B\xe2x\x1cc 3F'\xd4C-\xc2\xb3\xd7\x9f\x15vr;\xf0\xd3\xe6\xcb\x0f\x1fs0\x1b\x94\xda9\xfeVJH\x1co+\x0e\xcb\xa2\xd1\xb9\xf5a\xc6\xf0Uz\xa4\xa5o@\xa4EiuB\xba\xb6`\xe6Q\xd5-aA\xc4\x00\x16aD\x86\xe5\xc4Y\xc2\xabM\x19\xa7^'\xfd\xdfX@\xfa\"\xe6E\xfb\xfe}\xa6&=[\xdaXh\xe1\xc7\xb3c\xe7m\xfc\"\xb3^\xb5\x11\xac\xb5\x9a\xa4\xc1\xfb\x98\xf0z\xcc\xae\\\xc8}\x9b\xa9\xe9:\x8e \x9a/\xcf-5\x80C,\xd4A\x00i\xb2}n\xc9\xb8\x10KFb-\xcb\x1b9\xb8/\xab\x83\x1d\x81\xab$\xb34\x87qa\xaeL\xd2\x05\xf3\ni\xc2\xa7N^\xf0q\xa1\xd06\x97 \x8cd\x1ax\xcen\x9f\xaa]\xfd\xcfz\x07\xb3&\x84\x123S\xfa\x9d\xb9\x88\x11\x1a]\xf4{\xd6\xb2R\x85ijJ\x08\xc6\"\xc39}n\x89,\xc8>\x98\xc1&\xf90\xdf\x07\xb0]\xe4\xb4\x1ex<\x0d\xdc\\\xc1\x8f\x06\x7f\x02\x168\x9cUL*Ot\xf5C\x1b\x81w\xcbFgU\xb3\x07h\xdf\xdd\xac\xc3P\xef\xc0]\x92\xcf5\x0ea\xa0\xf6)e9\xd1\x9f\xe1\xd0I\xdeO\x00\x00\xc0\xe5x\x0d\xf4\xa7\x10*}\x95\xe7U\xd8/\x7f\xc3<\xe7\x0b\xe4\x0f\x93\x00{\xb8\xf5\x81\xde\xfa\xe5\xd0\xee\x81\x1aB\xba\xfasS\xac\xc0%q$\xc5#n\x1dc\xa07\xd5\x84\x8a\x87=\xa67VTo\x05\x90\x1d\x19\x19\xd8\x82\x80\x80\x13\xb7\x82\xce\xeb!M\x9c\xbb\xc9c\xa5\x84d\xe8\xcf\xcb\xf4s4\xbc\x1b/\x97ji\xc2\xa0\xf3%5G\x1b\"1[|\xbc\x95\xa7\x99\xae\xf2v\x1b\xa0\xbb\xacg!\xbe\xc4Z \xfc\xdbI\x04\x84\xfa\x00\xe6m%\x92\xc9\x88\x9e\xb3\x86\x02\x9c\xe6@y\xb9\x16\x89\xb8\x80\xd5\xa8\xd7\x04+l\xfa\xc1B+\xd5\xcf\xc7\xe9\xc7\xfa\x01\x04\xceN\xae\x9f\xbdnrP\x98~,\xd66\x10GPU\x81\xe9\xfb@Q\x8d\xe8\xf8\xb3:\x88\xa9\x89\xefc<\x85\xce\x81j\xc5#\n\xd1\xb6\xe0\xa6v\x81\x0b\x84\xf0j\xe2X\xb1\xf6\xdc\xa5<[r\x0f\x10$\x9d\x8f\x05jW1\xd0\x02\xa3\xc3<\xf3\xe9\x95\x02.\x1a\x8c%\xd4H\xac\xfd\xdd\xeb\xa6@\xef\n\x86G\x171\xbf\xbc,\xe4\xcf\xd0\xaf\xe98\xe89>bY\x80_@\xcd3\xf3\xb2\xb0\xe6\x10\xe2\x0e\xdd\xad2r\xf1\xfa!\xe5\x8c\xc9H\xd8t\n: \xbd\x89\xc5\x13\x86\xad\x17\x98y1\xa6r\xec\xa4\x1du\xd7\xd9c\xdc\x89\xc4lm\xebK\xea3A\xc5\xa5\xa4\x1c\xf6A\x91\xa0L\xf3X\xe5\xc7\xa2S\xcce\x9162\xd7U\xd0\xc3\x90\x01s \x03\x14'j|)\xf5`\xc8\xd8\xadf,\xf8\xcb9\xcbz\x0b\x9c\x02a\x917;\x02\xf5\xe8\x7f\xca\xd9z'ch5\xec\xa4LD\xbc\x16f\xb5\x90\x0b2:\x952\x9e\xdc\xc9g\xc4\xb5[\xe2^\xeePo\n\x7f\xe5\\\xd8\x11\x0c\xdf\x9aY\x01e\xb3c\xe1ccV\x0d\xbe\xa7\xe2^\xca\x82:\xab\xcb\xb8\xc5\xd3T\xf8l-H\x9b\xec\xd4\xef\xe3SL\xc6\xec\xd4\xefk\xa7\x9cE\x04\xf7\xafGN\xd2\xb0P\xa2\xc4\xd4\xfc\x8c\x9f-\xa9=\xc2\xb1\xd8\xe2\xfeha\xa6:\x16\x8d I\xdd\x1c\xd87\xa8\xf1n/HY\x81\xc2\xa5\x10\xf24\xb8+n\x05.\x02z\x8a\xe5\x85)f\xe4\xb7\x9c<\xd1\x00\xcc\x97i\xa7r\xa1\xc7\xcb\xc6\x16\xd8/M\n\xbd\xc5\xacO\x9c\x18u\xd9w\xd3A\xb1h\x0b\x9e\x16\x93\x06}\x03\x11\x87\x0b6\xd6\x0e\xd7o\x16\xc2\xae\x8f\x90W,G~\x8cyms\xbe\xbd\x1c=\x94hr1\x99\xeaT4{(\xc1\x86\x88\xee\xea/\xcc\x16\xac9Y\x80jb)\xd3\x0fn\xbe\x9f\xef\xe5;\xdc\x05\xb8\\j\xfa\x82\x8f\xd5\x85\x9f\xa8\xbc\xbe\x01\x1aT\xa5\x9e!@X\x9f\x19w0^\xd2M\x94\xc5\xa6Xq\xcd\xcb\xf2\xbd\x97gm\xe2N(\xdc\x94\x8dv\xbaN\xb2\xc3s_\x16\x9dY	\x0e\xa4r[\xdfB\xfe\x9e\x93\x8a\xc4d\xf5\xc4\x05\x1c\x89\x9bQP\xcf\x89\x8a]\xf7\x074\x14\xd8\x93y\x94\xd5]\xc8\xc8\xb8\x14BGz\xbe\xa3\xba\x10U1\x10\xe3\xdeWC0\xe6t\x1eTUs\xbd\xa6zN\x8f\xd9\xe9\xee\xa1\nS\xa0\xf2 o\xab\xca\x8aM\xb6\xdc\x8dE+\x82\xbd\x98\xb3\xaaR\x1e\x87\x8a\xea\x03,\xaaS_\x8a\xb5x\xf4v\xa0\x06\xec\xd7\xa5ur\xe4\x00\xf1[\xd7\xdd]\xc7\x13\x11\xcf;,W\xe1\xc55n;+\xed\xf7\xd3\x9el\x1d+[\x97\x88$	d\xb4\xb8\xa5Rw)[H\xe5\x03\x8b\xa0\x19v\xd1n\"\xfbK\x1b,\xddfJj\x16v\xee\x81\x82e\x93\x1d8\xb4\x95\x00\xcau:In \xe0\x0047\xf3\x92\xb4e\x10\x16 \xdes\xdc\xfd\x0b*\xdfaEpYz\xfd\x07\xc2\x13[yv\x8e\x99\xa6\x1e\x87\xc2\x92lA\xceH\xca\xeb\xf5\x99\x1e\xca\xef\xd1\x88\x8c\xa8\x103\x1b\xf6\xf6\x96\x86\x9fs\xe3\xb3\xd6)s\x1b[p/\xcc\xa89\xc5\xbc93\xe0\xcc\x96\xf8\xa7-\xdb\x8f\xbe\xc4;\xe5\x99\x8d\x03\xb4\x8aY>\xbb\xe0\x9d\xd2\xa6p\x9d\x8a\xe9\xda\xb2d\x9f\xd864\xa3\xb2(Q\xdd\xd2\x0cZ\x8a\xe8\x83\xa4\xf0*\xbf\xe7\x9e\xe1|\x1ef\xd6\xf3\xe4M\xbfN\xe7\xe3\x12\x994M\xbf\xe5\x81}!$,t\xff\x12\xa6/\xe9\xad,0\x1fD.\xf3$\xce\xff\x8fY?~\xd7/y,lS\xa2z\xd3\xc1\xb2I\xf9J\xd8\xc8\xb3J\xfa\xba@]_\x92\x93{2\xd3	P\xd4\xd4\xccr;\x1dTKj\x1e\x10\x9d\x9c\xbb\\\xc4\x1b\xcbZ\x16!\x9a\xce\xaa\x94\xc5\xd6\xbf\x97'\xa0\xe7\xc8\xcc\xb0Q\x9eQ\xff	}\n\x1d\xea\x82J\xa7\xa3\xf4k<\xf5\x9a\x06\xc5_\x8fn\x028\xca(\xde\x86o\x8c\xe4\x14;6\x10;\xd9h\xaf\x93\xca\x16O\x03\x8f\x87\xe1:\xe3\xbeo\x06B\xc4\x86D\x85??5_V\xd4Z/\xad\xd2\xde\x16\xb5\xab\xc6\x17\x1d\xaf&\x0b#w\xd5\x98\"\x9d\xf2\xf9\xe8p_\xb1,\x8c\x86~D?A\xb1\xf17\xc6\"\xbb6\xaf\xd6\xcfd\x0c\xe68\xed\x0fkM\x9e\x9e\x8d\\\x0c};{C;\x80\x11\x1c\x9eO7\xf8\xbc\x0f\xee\x19K0x\x93XE\x0f\xff\x08\xe3}\xc7\xcf\xaf\xf3\xc8\x17\xb9\xf9\xfb'z\xac:\x0fT\xefd\x89\xe0\xb1\xd7t\xd8\x93\xf1\x9d\x85\xed,\xbb(\xfe\x9b\xb9i)t\x1c,H\xbb\x0f\x8f/\xcc\x96\x89\xd9\x96\xe2\xff[VN\x9e\xf8S\xf5\xe4\x89\x8alc\xb7\xba\xfdmVDm\x99\x15\x04u\xe4\xf9*\x89\x17\x0b\xec\x97\xa9 \xfd}4\x17\x13G\x95\x99\xfe3\xdeU\x0f\xd1\x18\xed\xd1\x94\x1a\x16O\xc9+\x04\xa0\x9d\xc8K\"\xe3\x14\x0e\xc6\xe2\x94\xc2oV2dK\x0b\xb8\x9f\x8eL5j`<\x00\x1a3'>\x99\x9bQ\xfc-\x801\x9d\x05WR\xe8\xd7\x9c\xfb<\xe3\xe6\xe1Z+)\xaf\xe5|?\xd5\x9b\xc6&M\xb2\x92\x1c\xa5\x061\x1bi\x9e\x9f\xe5\xc2\xbf\x18~\xe5En\xb0\xdf\x0f\x0b\xd6\xde\xf4\x9f\xe8\x1d,}\x0f\x98\xf9\x95\x18`\xb4G\xe8U\x883\xd1j\x87<\x15\xd9%\xd7xt\xa3\x10I<\x01\xfcF9\x86!y*\xcax\x0e\xd4\xc4\"\xbb\x95\xd7\x92\x95\xf2\xba\xc4\x98\xbd\x96\x18\xed\xc5P\x16y\x99\xa8\xc1;\xb9C\xea\xe9G&\x89\xcaL\x92d\xa0\x1f\xea\xaf\xf4\x16N59\xe6>{-9]\x9cs\xe5\x06\xfb\xaf\x8d\xaf\xf1\xbd#\xb2\x9d\xa0\xf8K\xc2\xecG\x84\x15\xeea\xd3\x1d\xca\xdd\x8fYX\x9by\xf7Y\x84<5\x9d\xd9\xd6\x0fO\xed\x91s\xdfG\x1a3%i\xf3\xb1M>\x83\"\xbd\xa1[\x1b7\x03\x92$hD\xab\x00uq\xd8\x0fx\x7f\x94&\x1b.\x00\xaeVHk\x9c\x14\x0d\x93\xe5\xd9\xabH\xc4\xabm\xe1%\xf8\xe9	\xca.\xf5\x1dO\xa8\xc0\xef\xf44Q,\xf1\xab3\xec\x1fo\\\xbc\\e7\xae]\xae\xb2\xcbW.\xd9S\xbe}\xf1\xdc\xd5\x82\xf8b\x0f\xde\xb8\xb4\"961\x07B\xdeG\x0eDz\xfb\xcc\x99\xff\xfc\xe6\xad3g\xaa\x85\x92\xa5\xa9\x16c\xbf\xf3o\xcf\xe1\xc1\x1a\x1cv\xa0\xfa\xc4\xa4\xd9\x10\x9d\xdeSk_\xf2\x9f\x18\xcd\xfe\xba\xa8\xe25y\xd4\x10N\x92\x1d,\xa1\xdb\x8c0\x97a\xafx!\x9c\xbf\x07c\xed\x9f\xe6\xbd\xe0\xb1Y\xb8\xd0\xd9g>\xcb\xa8\x1e4:1\xa5\x1e\xed\xbdi\xdb\xb12,$\x14\xad\x95\xcb&\xadR\xef\xf1\x82Y6Qag\xc5\x82\xa7\xce:\xcf\xad1*]\xc3\xe7)\x1e\xcd\x05\xf8\x97\xde`c`>\xff\xc7b\x9b\xc4\xec|\x1cm\xa1\xd3\xdc\xe8r\xb8\xe4\xcc4\x95\xb7\xb9\x89n\xe1\xad\xe2wb[\x9d\xaa\\\xb0\x7f\xe3\x17\x01x_\x90\xe4~K\xaa\x8f\xe2\x9ay\x17\xdb\xdeKW\xb3\xbc\xa9\xb7R\xefxw\xae\x93Z]\xfa\xe0\xfco\xd9\xf5K\x97\xe8C#\x945\x1e\"\x8d\xbf\xa0`\xef\xba\xa5u\x1a\x83\x0du\x95+\x17\xec\x93\x88\xd2 %Y\xfc+\x9a\xadb[m%\x08C\xd1 \xa2W\xe8O\xfc=\x96\x88(EZ	%\xf7\xcf\x17d\x17\xd3\xe2$\x9b\x84\x9d\x10i\x17\x82DP\x0b\xf3\x937\xeb\x07\x80N\x1052\x0d?\xeby\xa2\x9d&\x8c\xa3\xe2\xca\x98\x94\xb6\x1e\x84\x82\xb2\xad-~/huZ\xcc\x0fZ\"J\xc0{3Y\x07\xa5\xbc\xf76\xe8\xa4\xfd\x8c\x13\x92\xe0S\xfc\xa5\xadQJ\x1e\xf9,\xc2\xdf\xadE\xfei\xbbzCv\xe8\x86\x9f@\xac\x04\x96\xc9\xfdB=\xec\xd6\x0b9\xe9\x07\x83\x04\xb1\x0f}.\xcf1[\xb3,-WZA\xcb1\x08\xfd\x00\xecANF\xaeE\xd7dV\x0d0\x9e*kn\xa7\xe2\xd3\x80N\x1b\x7f\xce:E\x08P\xf8\xc9hf\xd5\xdbV\x92\x9f\xb9\xa2\xdb\xee\xc4\x8dL\xc8o\xc0\x03\x96m\xa8+\x8f\x86\x10\x8a\xbc%e\x98\x06m\xa4\xf0\x13\x98S\xfd\xd8\xa8y\x7f&\xddf\x0b%\xe6wT\x83\xea\xccoC\x08\x91\xa3\x85\xcc\xdcG\xb1pf5r\xac\x0e\xf5W\xd8\x95\xdf\x85	\xbb\x08\xb46\xd5\xa1\xfe\xb3~D\xbf\xbe~\xc3\xd4\xc8\x8eL\x06j\xa8\xc6z\xdb\xfc\x0cRw\xb1\xdc\xb0a\x9b\xfa!\x16\x9e\x0f\x19+\x89H\xcf\xa1\x8f\xa1#\x9eqz\x85\xea\xa7)\x1d\xc1\x94\xf7Ija\xca\xaaH\x99\x11b\x06\xd0\xdeQ\xff\xa4\xc9}\xb9\x06\x83\xcc_\xce\xdbs\x98\x04\xa8\xac\xe2\x03\xab\x05v\x8aA\x81\xd66\x96\xfct`\xe6\xa0\xcd<\n\x9asK\xf7\xbc4\xee]\x1c;W\x92N\xed\x0f\xc2#\x19q\x934\x95d=\xf2\x88\xa4\x93U\xc2;\x1dP\xf1q\x86\xcez\xe4\x9d\xb7@\xe4\x83(\xf8\xa4#\xc0rD\xc2K\x85\xcf\xb8\x97\x06w\xc5J*S\x1e\x02\xc2!\xc8\x82\x13S*\xca8\xff\x17\x82yO\x01\x95\x03a\xcd\xfb\xf5\xb60\xe3\x87\xea\x88\xdeu\"\x8b!Hc\x9df\x10\xc0\xd9'\xfft\xf2\xbf\x03\x00\x00\xff\xffPK\x07\x08\x86\xe3\xc6\xe7;\x15\x00\x00\x91A\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xef\x9d\x8cN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/sk_SK/common.jsonUT\x05\x00\x01R\xeb\xb0\\\x8cV\xbdn\x1bI\x12\x8e\xa5\xa7h\x10\x10v\x170\xb4\xb93\xca\xa2\xd6\xf4J$!R6\xce\xb7\x17\x14\xa7\xcb\x9c\xe6\xf4t\xcdu\xf7\x0c\x97\\8\xbfD/p\xd1M\xe0\xc0\x81\xa3\xcb\x0c\xac\x92!\xdf\xeb\xd0?\xf3c\x1d|\xb8h\xaa\xab\xbe\xea\xae\xae\xaa\xafz\xfe8?\x1b} \x9d\x83\x1d\xbdd\x7f\x9c\x9f\x9d\x8d\xd6\xa0\x14\xf2\xd1K6\xba\x1a\xcff\x93kv\xf5\x17\xf6\xc3\x85\xf9\x81\xdd\xcc\xef\xd9\x85\xf1\x9f\xdfF\x17\xe6\xb7\xd1\xe8\x85\xc3s\x94h\x83\xc3\xf5\xe4v\xb2\xea=\x82]\xe4\xb0\xc1\xeb\x1e4\xbd\x1b\xff2a\xdf\x87.\x0b\x12\x12\xf5\x10\xbc\\\xcc\xa7\xb7\x93\xfb\xe7p\x85\xbb\x05\x19k\xa6j\x95j\x04\xefp\xc1\x99\xc2\x1d+\x9c\x9e	\xc5\xac\xb7\\\x06\x07\xaf\x1d+>u\xe7\x98y.l\x8c\xe9\x82\x07\x8f\x1f\xcdO\x0c\x14g\x17\x9c\xf9X\xdc\x9a\"\xac\xdf\xe1;\x8e\xdf\x02K\xbdA\xee\xc2s\xb0\xc5|\xb9b\x8b\x87\xfb_\x9e\xa5s\x98G\x93\x02\xa7\xdd\x95h\xb3\xbf|=\xbe\x9e\xbfcW\xd3\xff\xa7\x08\xe1\x9a\xb7\x94d+\xdald\xd8a\xf5\xfa~2\xbev\xf0o\xd2V\xaa\xbe\xc4\x0f\xb3o\x8b\x1c\x10\x95\xc0\x1d\xf2%\xe48]\xb4\xe1/\xd9\xfc\x86\xad^O\xd8r|7a\xd3\x05{7\xb9\x9f\xb0\xb7\xd3\xc9\xbb\x81\xf7\xf9\xd9\xc7\x17\xb1\xa1\x8c\xeb'\xbf*d\xa9A\x9a\xb6\xbf8\xecG/\xd9_\xcf\xcf|\xef\x9c\xfe\xe1\x8f<\x1bq\xd5|\x19\x9d\x9f\x9d\xfd\xcdG\x90R\xa9;TJ\\(\x88\xb8\x94x\xf3E\xf5\xc8\\\xa8\xd2b\x87\xcd\x85j\xbe\xda\x16\x1cV\xfb\x01\x9a\x94M{0\x1a\x01I\x8b\xf5\x0b\xaaz\xb0\xc1\x84\x14\xef\xd0q\xf9b\xb00=x\x8f\xd0G\xac)\x8b8MY\xdc2\xe6\xc6\xb7K\x9b\x0b\xe0\xb9P.\xc3c/\xf8\x8d`CNS\xe8\xb6\x95\xc0\xc70\x82vE\xde\xe3\x8d\x13\xf69\x95&\xe8\x13RVCbCo;\xc4\xfb\x1c\xd5\xa9\x16\x8c\xd6\xba\xa9\x0f\xd9~H\xd7\xab}_\xdd\xf79\x9c\x9eXu\xaa\xd1f{v`\x9a*\x05\x19n]\x91\x81k4\xd1\x13\x7f/b(\x13/Q\x05\xa7OCS\x7ft\x00\x94\xdbgg\xa7\x82\xa3o\xed$\x1d8\x87\x0cTa\xb1\x05%,i\xbfK\xf3O\xaeOO\xeb\xa6\xd6\"\x1aKcg\xb4\xf3-\xa9\x9b\xbaBfQ\xc3!\x18%%Yh\xea(\xc5\x82s\xd4\xd0\xeex\xe7VMm\xa9\xdd\x91z*W{\x85I\n\xaa\xf9\x1cM;\x85\xd1\x0b\xb6\xc2\xe2\xf1\x89q2\xedU\x0c\xe2XJ\x9f>Zk8\x88\xd3\xa76\x85-\x9dC\xa0\x9d9\xaa\xc3t\xf3Y\x88b \xb0\xa7\xed\xd2\x8a$\xdb\x87\xfba\xa1\x043A\x11y\xdb_\xb1\x93;\xbe\x0e+\xba ~|\x82X\xc8\xe6\xcf$\x8d\xa5\x14\x18\x9beO\xa5\xc3\xfd\xb8\xda\xff\xd4r\xd6\xecU\x12\xfbwD\xbc\xa0-\xaa\xe6\xcf\x80.\xb4(\x9az\x0b\xcc\xc4\x1et\xd8T\x93\x12\x87r\x8b\x9d\xf6@\xbd\x9e*\xe8\xdc9\xfe\x97\xfe\xdc\x93fdE\x8e-\x15\x12\x90\xa8\xf8\x80Co@E\x0e\xdd\xe0:Jw\xa0\xa34.Z\xe9\xae\xa9\xb7Q|\xd3|U\xbd([h\xb9\x89\xd2\x12\x8b(\xcd3\x1b\xa5\x19UQ\xba\xc6\xa4\xa7\xf3\x0e1\xebB\x99!\xc7\xe3S;T\x16\xa48\xca\x8e\xe1\x0f\x96z\xba/\xadF\xde\x02O\xff\xb2\x95\xb6\x9dm!\xa0_,iM\x16\x86\x83\xa1\x14\xdd\x0bL\xd6R\xee\ntM\xb2\x0cIL@%\x18\xfaM\x97\xa7\xba\xeb\xa7\x04,H\xda8\xc3\xafNl\xfe\xbd\x89\x06)\xdcc\xf0\xaa\xf3{\xe5\x14\xcc\x12\x8b[\xbd\x18L\x03\x02\xcd\xe3(8\xc0\xe9\x93o\xf4x0'\xe59;\xcd\x0b\xd2\xd6\xd7\xef\xf3%[Z\xdd\xd4*s-\xc1\x14\x1c\x1f\x9b/\x16\xd8AQU\xc6\xa7\xf6\x83\x90\xb8\"\xba\x05\xbd\xf1\xeen\xcd,\x11\x93^\x131J\x9844\xf4k\xb2TQ\xd0o\x88\x1c\x17H\x85kI\x91)\xc1\n\x8d\xac\xdao\x9a\xaf\x1b\x89J +\xd0(q|l\xc9!|x\xafH\xeb\xb2\xb0}\xbc\xec \xf7)\xc8K\xb6\xa0S\x9d\x11wM\xcdL\xf3uM\xfar\xe8x\x1do9\x03\xf1\xec\x9eM\xad\xff\xd7=\x85\xaa@\n\xfe\n\n\x9b\xa4\xe0\xb7\xc0B\x82UM\xcd2(\x8e\x8f\x91\x1c\x19b\xe1\x7f\x82\x84\xda\xdc\x90\xe7\xff\xaf\x88\x05\xe3Q\xc7>P\x9c\x04\x12\xe2?\x03\x19\x89\xbc\x1bG\x8e\xef\xfdoN\x1c\x0f\xe5\x01\xf2L\x95V \xabdSg\xf1\x95\x8c\x13pEW]'\xbd\x0f\xc0\xe63S\xc0LA<v\xe2(G\xc1\xe9\xad0\x82T\xfb\xb7r\xe7T\xac\xf2\xba\x08*\x8d\xbd\x03\x9b\xa4\xbeThdS\xbb\x8c\xe4\xa5\x11\xc0\x0e)\x0d\xdf\x02\x85\xbb>\xce\x19U\xcd\xe7\x18[\xacnABY\xd7\x98]\xe3.\x9c\xc6uf\xab\x8b8\xf7k\xd7=)\x0b	\x1b7\xb9\xab\x9f\xe7\xe1M\xa1\xea\xe7\xd5\xea6`\xff^R\x9c\xe23\x81\x99%v\xfa\x04,\x11\xae\x8c2\x16J#\x98\xf0r\xde\x07)j\xb9\xd0\x98\xd8\xab}\x98\x9c\xf7q\xcd@J\xb6\xde\xb3\xe9\xe29\xae\xbf\xdbsl\xf8\x07k\xf1\x1f4\x1a\x9f\xae\xf9ZQ\xd5\xf1Uc!\xfd\x80\x9f\xf3\x82*\xe400\x90\xb6\xa1\x07S\xd9\xd4f\xe0bK\xed#\x9f5u\xa5\xc1Fu)cf4T\x82\xcb\xa6\x0ez\x83\xa0c\xa1\x8eO\xd0\x1f`\xd0\xb8\x82N~/D\xfc\xb9^\"\xf7T\xaa\xf6\x85>\xd5 c\x81\xdc\xcb5#+\x12\xcf\x88\x87\x82\x0e\xa4\x95\x03Fs\xb9\xce\x85\x0dW #\xbb\xfdmZ\xe6k\x05B\x8a\xc0\xdb9\xb7\x12\x8e\x8fY\xb9\xc5\xfc\xf22V\xc1R\xe1lo]\x84^Q\xaav\nt\xdd\xd7\xd4\x86)\xe4\x94\x91:>z\xc2\x16\xa1\xf6\xad\xc7\xceub_\x87\x87\xa0\xf8&\xffe!	\xf8\x8d\x90\xf1\x0en\xc5\xdc\x0c\x1a\x9a\x17\x9a6\x1a\x8dO\"\xf9\xbb8\xce\xb7\xa1>;\xe5\xdd\xf0\x8c\xf3\xb3\x8f\xe7\x1f\xff\x13\x00\x00\xff\xffPK\x07\x08\xa2E\xfc\xcc\xe8\x05\x00\x00;\x0d\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00v}\x96N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/sk_SK/server.jsonUT\x05\x00\x011\xe1\xbd\\\xbc:Mo\x1c\xb9\x95g\xfbW<\xf4b\xd6@\xa0\xc8N&\x87\xc0\x87\x00\x96d\xcfx\xc6\x92\x15Kc#\x13$\x00\xbb\xeau\x17U,\xbe\x1a\x92U\xad\xea \xf7=\xac\x91\xbf\xb0\x0d\xc4\x879\xf8\xb4{\x1a\xc0\xba\x94\xfa\x7f-\x1e\xc9\xaab\xb7\xe4$\x08\xb2{\xea\xaeG\xf2\xbdG\xf2}?\xfe\xe9\xe1\x83\xd9\x82LegO\xe1O\x0f\x1f<\x98\xbdx\xf6\xdb\xd9S\xf8\xfd\xc3\x07\x0f\x1e\xcc^j\x1e\x12N\x92\x86ZhT\xe0\xf0\xda\xcd\x0e\xfc\xe0s\xed\x8cD\x0b\x0b2\xe0\n\x84\xb9\xd0\x1a\xcd#\x0b/\x0c\xfe\xd0\xa0v\xaa\x83g\xb6\xc4\x1c~\xdb\xa0e\x1c\x16\x94\xb4\x0e\x84\xceA&\x98+\xca\x85\x9a=|\xf0\xe0\x0f\x8cxvv\xf1\xe2\xdd\xc8\xc1\x19\xb6\x05\xe5\xba\xff\x11r\x82\xda\xf4\x9b\x0c#\xf9\x13\xb2\xa5\x80\x9aZ\xba\xbdi\xae\x10*\xe1\xd0\xc8~\xa3\x0e\xa0td\xfaO\xa0%\xc2\x15\xc2\x1c\xd75\xde\xbeg\x1c\xb5\xc15\x1a\xb1\xfd\x00-\xd4Fd\xd4j\xaa\xa06d\x9d\xc1\xbc\xff81!\xd4Jt\xf6\x15e\xe5\xc8\xca\xdb\xedM\xde\xc1ZT\xfd\xa7R\\A	\xb6\xa6\xbcl\";<\x15lfH)\xa9\x97\xe0\x08j\xb1D\x98\x93sT\x01\xb6\xa8aU\xa0\x06'\xe6 -\x142\xcfQ'\xf44\xe9\xae\x92\x16Gr\xcf\x02d\xdd\\E\n\xdf\xd3\xdc\x885\xb4\xdb\x0d\xba\xb2\xff\x94\x15P\x1bik\x89\xadpx{C-\x88\x92\x80\x11\xf5\x9f*\xddeE\x82\xbd\xc9%\xbd%\xd5T	~\x86A\x1b\x80\x81B\x98\x01\xb4\x00\xbf\x00\xa4\x86\xaa\xb12\xf37\xd6\xca\x1c	j%:46E\xedh)\x17	\xdb\xb2\xa2V\xf0i\x7f\xf5\xf2\x05\xd4Be\xa41+\\\x17\x89\xf0\x84\xe6\xea\xce\xe0\x88p\xbe<m\xdc\xc4&\x7f\xc0\x91\xc8\xca\xa5\xa1F\xe7\xf0\x96\xd9\x88\xa8\xfcX\x10\xbdq\xdc\xb3\x99b\x0b\x0b\x06t\x9f\xc1\xf4,\xaf\xa4\xfe\xb9\xad1\x93\x0b\x899TX\xa1\x85Z\xd6\x98\x83uF\xc8e\xe1@jG\xd0Q\xf3\xc8 T\xa4\xa5#\x93\x10\"a\xf2\xe7\xd7\xb54\xddH\xec\xf6?\x85\x05dX\xbf\xc9$BN\xb6\x1c\x8e\xe1\x9cn\xdf\xa3\x83\\\xf7\x1fYBAS\x1b\xef\xb4\xffhklKb\x11\xc5\xdc\xf5\x9f\xaa\x03\xd0\xb8\xbd\x01+<\x06\x01\xebJlop\x8f\xf6\x99H.\xf7\xac\xdf\xac\xa9\xdd!\x18A\x9aZ\xbc\n\x03\x87p\xda\xff\xcf\xf6\x06\x81\xe6V\x14\xd4\xb2^T\xe2ZV\xfdFi\x84_<\x81J\xa8\xfeGP\xc2ImK\xd6\x9f\xfe\xa3\xadP\x0b\x10\n\xe7\x04\xb7\xef\xfb\x8fV\x89\xc3=N.\xa5S\x13+\x97\xd2\xf5?)*w\x98\x19\x81	;^|\xb5\xe0\x03(\x95\xc8\xe1\xd19)\xe9d)\xe0\xdf\xe1\xc2\x08-\xf1\xd1!\x9c\xa6\xfc=\x81\xb5\x16%\xb5;\x0c\xe4\xd3\xf9_\xa2\x1a.\x98\xffz\xe3\xd5\xf8\x93\xfe\xb1\xf0\xa2\xbc\xec7\xae\x99\x16g\xa2vY!\xc6\xf5\xdf\x8a\xfa\xf6\xbd\x18/l{#E.\xae\xa0\xd9\xde\xf4\x1f'\xa5\x9bw\xd0v\xb5\xd2RI(yE\xc3\x17\x07\x8d\xb9}/]\xff#\xf0F\x8b\xb0\xbb+\x04\x83Ki\x9d\x17\x08\x01\x02\xda\xce\xb5\xfd\xc6\xef\x0eZ\xd5o\xca\xd4(D~.\xc5\xd2\x8e<\x1d\x07\x188\x06\xc6\xbd\x89\xa5e\x83c\xa91\x19z{\x1cW\x82%\xd5x\xcb{\x08\xaf5\xf2\"6>F\xe8\x9c*\xd5AV\x90E\xedW\xa0\xc8\nX\xa2F#\x1c\xe6\x03\x82Cx\x83?4\xd2`\xce\x042\xd2NH\x0d\xc2\x81Ba\x1d|	\xb9\\,\xd0\xa0v\x9e\xa1\xe4\"\xb2B\x98\x8b\x8c\xcc$\x08\xc7\x850\"sh\xc0\xd6\xa2\x02\xeb\x07\x07\x9b\xe0\xa4\x07\xae\xd0k\x1a-\xd8)\xc8E\xc7\xa6T@6\xaed\xe2P\x93u\x87\xf0l\xc1\x00\xbc\xce\x10soq\x0b\x04%+\xe9\xfc\xbf\xc6\xa2\x81\x95T\n4\x06\xe6-\xa9\x16\x19Y\xdcY\xc2jc\x1dU\xc7\x17\x17#\xab\x91\xad\xef,\x9a\x9f\xe7\xb8\x90\x1as8\xbe\xb8\x00\xd3(\xb4\xa0H\xe4\x98\x03ipT3\xafL\xd0\xa2\xc2\x8cO\xce\x15X\xe1=\xc8/i\xb9L\xd4\xe2\xad\x12\xd6\xb1\x95d\xbaQ\xc2\x0c\xd6ZBMQ\xc0\x82L\x84i\x05\xf9\x89#\xda\x1c\x17\xa2Q.e\xfa-\xdb\x0f\xca\xd7R\x80\xeb\x7f\xac\x06\xb9\xbd\xe4\xff\xc1%\xfe\x04Z\xb0\xce:\xb1\xfdp\x00%\xde\xfe\x05J%\xf9\xf2\xbchk\x9e\x80\xeb\x96\x94Tw(\xbd\x12zy\x87T\xff\x11\xae\xc4\xba+#\xa9o\xf8\xff\xe0}\xe36\xb6\x1f\xbc\xe4\xb7\xe3\x8a\x14\xb3\xe5\xc31b9\x1d\xcb	\xda\xe6\"\xc2\x02\xd2d\xd6!\x99%\xc8\x8a\xfd\xaaEaR\x0f\x97K+\xe6\n\xdf\xd0\x9c\xdc\xa4,\xdf\x8b\xb2\xdflo`\x85\xf3\xcc\x88\x95BC\xedt\xd8-\xef\x9c\xfdW\xe5E~\x85s+\x1dB\x9ci\x0f\xc06Y\x01\xc2Fb\x80z)5\x82\xd49^\xfb\xf1\x85\xa1\nD\x96\xa1\xb5A\x02\xa5\x05o\x01\x0f\xef0\xc6\xa2t\xc4C	s\xa6\xd9n\x12\x03\xe0\xad\xbb\xc4\x124\xfe\\\xb0?\xaa\xe4\xe0\xf9\xc5\xdc\xf4\x1b-\xc1Q\xd5x\x83#\xca\xfeSI\xb77-\x96 u\xff	\xfa\x9f\xbc;\xf1~\xc2/\x86\x8a\nR#\xfa\xed\x87`\xf7\xa2\x15\x9e\xd8#\x9d\x1c>\x95\xa4o\xdf\xcb\xed\x87\xc18\x8e\x0c\xb8\x96\x0c\xc3\x89\xff S\x1cf\x8c\xa8\xbc\xea\xce\x85R#\xbe\x7f\xfb5\x7f\x02\xe55\xb5x\xfb\x97\xe1F_q\x10\xc8A\x86\xb6+4S\x00\x19\xa7\x17\xc2\x16\x90QU	\x9d\x1f\xc2\xb1\xd0\xa3\xddijVd\xb6\xfb\xc3R\x0eL~\xf9\xe4\xc9\x93\xc9D\xb0%tB%7\x80\x95\x90\xea\xb91#[\xcf\x19\x00\xcf\x8d!3\x9aP\xaf\x9c\xe0\xa7\xf2U\xa2\x1f\xbc\x07\xc9\xa9\x90j\x17\xd1\x10\x0d\xf3\x8a\x80\xe0\x9ee\xe7\xc2N\x17\xcf\x1f+2\xf9\xdd\x95P\xc7\xa1\xfbP\x90q{[\xb0hZ4P\xf3\xc8]\\\xc9\xe8=\xe8.\x9a\xf9\xbd\xd8>\x8f\xc86\xf3\x9c*!u\x8a\xed\xba@\xbe\x9b	\xd5\x00\x08h\x86\xf1\xbf\xa1\xb9x]\x0b\x9d?K\xc4\xe6\xb9\x87\xc03\xa5\xe0%/\x19.)\xc2YF<*{\x08\xefp^\x1d\xc0\xf9\xc9\x0b/	\xa7\xe7_\xfa\xdf\x8e\x1a\x03\xb4\xd2\xdeS\x800\xa8\x1f9\x10\x8b\x857\xd0\x87p\x86\xab0\x12\xb0\xf0\x04\x10\xca\x12\x04Vp\xe7\xf4\xaf\xeb\x9ds\xf7\x9f>T\x9a\x98\x1a \xa0\x85u\xa2\xc5\xa0\xca`\xfb\x9f\xe6d\x92\xf0b\x81\x98s\xa4\x1a\xc4f\xf7\xf0\xb5\x00[\xf7\x7ful\x83\xdb\xfe\xaf\xeby\x93\xf8 \x18V\xc6\x0bq\x04\xb9\xb4\x1c\x8a\xb3Cd\xd5aG\x14\xf2\xaf\x84\x9cJ\xe3\x86o\x8d\xbc\xd2\xd4*qE\xb7\xef\xc78l\xc8)\"\xb8\x81\xd2\x88+\xa9\xbbA\xbbE\x82\x8eL\x869g%\x93\x1b\xe8t\xff\x93C\xddo \xe6/n\xf0:\xc1\xb85\xdb\x1b\x19\x02%\x1f>V<\xf5\x80}\x81BGe\xff\xdfy\x07\"\xec\xa9\xe3\x8c,\xd0\xa4T:\x96B)4\xdd)\xe5\x93\x99z\x83\xdb\x1bY\xc1\x92\x83S#qg+\xfdGP\xa8\xa7(\xba\x03\x0b\xc4\xd6s]\x8aJ&x\x89Ro\xfcU\xf8<H>>#\xae1q\x1b\x16^d\x85\xcfv>e\xc5Sx\x12\x11\xbc\xedn\xdfK\xeb\xd8^\xaei\xad}\xb03N\x1b\xb7\xd9\xee \xc5#\xa95\xe6#\xe2\xafe\x8ep\x82\n\xd95\x9d\x93u\x83\x16\xf8\x01\xd6\x01\x16a\x0b\xae\x10\x0e\n\xd1r\x9e\x8b\x1a\xf2\xb0b\x17\xf5NF\xed\xd73\x84\x03\x98\xc7B\xa9\xc7)b\xef\xbe,\x07sK\xcc\xd9\xf7ir`\xc5\"\x84\x95+2e\xf0z,t~1T\xe8\x84_\xb5K\xf3\x0df\x8d\xb1\xb2E\xd5%\x17\x17aA\xfd\n\xc9\x81\xdb\xbd\xdb:\x08\xfb2X+\x19\xc27\x11S\xe6\xb0\xd4$\xd8w\xc8^\x16M5\xb7{\xd7\xc3\xaa\xd9o\x8a\xdb\x1b\x91\x0f\x82\x7f\x12\xf5G\xc0\xef/\nZ\xfd\x01\xe6\x8ds\xa4Y\x9fj%2\x0ca]S\xcd\xb5\x90*Iw\x0b2r\xcd\xeeH\xf1\x9d\xc8$&\xfaz\x1c\x81a(\x90\xfa\xf2\xd7Y!4h\xb2N\xa8\xa5LTJN\xd7\xfd\x8a\x96R\xc7\x05\xc9\x04\x16\xc1\xaf\x89\xcd\xf2\xaeq\x0c\x9b\x8a\x82\xddAM9\x94\x8dY\x93\xa1j_\x1d\x86\x9d\x0f\xb3\x9b\xdd\xd9\xbb\xb4\xde\x10\xb9\xd7-\x1a#\x13}\xf3F\x18\x0c\x91\x03\x1a\xc6\x02\x91\x97\x0b\xb6\xb5\xb0\x92\xb6\xe0;*\x12\xa3\x1ab#\xb0X\x0b\xce(@Q\x16\xaa=J\x96\x1c\x86\x1f\x9f\x9c\x1d\x00j\x8e\xe1Y\x96\x16\x8dR\x81\x84\xc8s\x83\xd6B\x81\x06\x0f\xe1\x15\xb2hcU\xbb\x8e)46$\xfc1(\x1d&\x1f\xc2\xf3kQ\xd5\n\x9f\xc2\xa3\xc2\xb9\xda>}\xfc8\xba\x88\n\x97M&\xd8\xff<\xda\xdb\xebnv\x126\xf9\x8fd&u\xb8]\x10:\xec\xf5_\x99\x8a\xc8j\xc7\xdd\xbc\xac\xf6\xddM\x02I\xdd\xcd\xfa\x1ew#\xb5\x92\x1a_Hw\x9f\xec\xec\xba\xd4xgR[\xd6Bf\xba\x16>\xa9\x0b\x0eT\xe7`\xd0\xca5r\xb0K&\x8f\x05.\x8b\xce\x8b\xf9D\xf1\x87|\n*^\xfe\xf6\xe4(Ra\xf8\xdf\x88\x00\xae\x84\xaf\xa5LZ\xfb\xcd\x008H?=\xf1F;xyb\x0fa\x98\x03\x99\xd0@Zu\xd1\xf4\x05\xfb\x91\x1c\xa9J\x13\x97o\x92l\xe5\xb8\x10z\xc9\xe1\xbcC\xb3`\x95\xe7\x99\x0d'\x1e\xd3Z\xa9K\x9b\x1c\x9fC\xc3)\x1b\xe5\xa5X\x8f\x85\x1c#s\x9fO\xad+\xb1\xe6k	\xb5\x11\xcce\xbc&\x1c\x96\xfd\xe67\xbfy\xfc+\xb6\x05\x8f\x07\x0c#\xa1J\xd8\x8c\xa6\x8b:\x0d\x9f\x81@\x8cM\xc3\x94\xc1\xf1\xef/\xf5\xe2;\xae\x1f\x85\x85\x8f;\xaa\x8d\xb0\xfeb#\xa5d\xb9k\x1258\xf5\x9f>\xe2F=p\x10\xca\xc0\x930\xf3\x9f1[\n\xb1\xb9\x85jg%;\x97\x854\xd6A+\xad\xdc\xa1w\xfd\xb5W\xa5\xc9\xf4yM\xd1\x02\xb6\x9b\xfe\xa3)\x9b\xd1_G\xea\xbe\xe4\xd3TP\x8c\x1a\xd8\xd41\x05\x0fr\xbb\x83\xfcB\xae\xf1.\xea\x16ooJ\xb2\x93\xcd\x1c\xf3\xc0\xb1\xa0t\xcf\xa4\x06Z8=\xdaA\xffN\xe6\xae\xb8\x07\x7f\xffi\xbb)\xbb\xcf\xb1\xbe\xe2U\x7f\x9bs\\6\x97o\xa7k\x08\x9fQ\xc2\xd8Q\x85\x9aM\xf0\xcfC\xa12\x0b\xd5NV\\o\x99y^(\xd0\xee#\xbe(\xc8\xb8\xacq\xff\x17\x04(G#v\xf4\xf7t\x02\x1d\xec\x02vux\x9a\xe7\xb58U\xe0\x03\x0eg\xfd\xdf!\xcd\xcb\xa57\xbb\x0d\xfb\x9a\x01>\xefX\x16\xa5\x81JcEZf\x1e\xef\xc4\x9bNk\xa2\xa7\xa8\x87j\xe0\x99\xa8\xbc^\x08\xe7D\xe6]\x97\x8e9Ar%\x1aWc\xa66\xe0\xf0\xa9\xc3n\xfa\xb6\xbb\x80\xect\xc6g\xd4&yr\x98\xfdzH\xa4\xf5\xce\xe0\x84\x83\x9c\xcc&\x9e_\xafu\xbf\xa9P\x8fQ\xee\xb7\xa6\xdf\xb8R\x0c\xfd\x93\xdb\xf7\x1c|\xdb\xda\xf4\x9b\x96\xbd\x00\xfb|\x1f\x8f\xb3L\x9a\xach\xf6\xf3}\xc6\xbf\x90\xc1\x15\x8fTN\xd0\x96\x9cA\x9c%\x83\xc3\xd5}\x85\x0e\xf28\x9e.\xb6\xa1\x9d\xf1CC\x1c\xa0\xf2\xcd\x82\xedt\xb6\x12.+@Z\x10sj\x9c\xf7\x12N\x98\x9d\x0d\xaeX\xdc\xd2\xc8\xe9\x8cV\x10ap\x14\x92\x98h\xa1\x1bcB\x1f\xa9\x8e\xc3\x96\xf4\x92M\x8by,\x1e\xe7\x92\x98.rP\xc8\x02B\xae\xe0,\xd5\x19\x14\xd5N\x7f\xe9N\x8e\x94H\x08\xa9\xfc\xce%\xbfV\xf9\xe7/y\x88\x80\x8e\xef\xa9\xcd\x0e\x91\x13\x0cE\xda\xcb\xa9H{*\xea\x9aw@\x8b1\xc6&_3\xdd\xb3\xcfC\xc5\xd6\x97\x17\xf9{\xecI\xecW\x96h\xa51Q\xba\xd7\xe1\xf3`\xfa\xd8U6_}\x82\xb0($\x0c>\xe6\n\xe5+\xaf\x0cJ\x05\x1a\xde\x86\x1bR\xac\xa1\x8duw\xcb\xbe\xa41\xe1\xa3\xde?\xbf\xf3\xcf\x9d\x1d+\xd8\xb1A\x7f+\xbb\xd1\x17\xeb\x0ddq\xe8\x1f\x8a\xc2\xc2d_\x1e\x1et\xf7_\x19\x881\xbe\x97>\x80\n!\xd2\x14\xd6x\xa0\x0f\xf1\xe1\x95\xd4%\xf8q\xcb\xeat\x90N\xe0\xd0\x01\xf3\x10@5:\x8f^\xd3\x7f\xf2\x10_o\xa6dV\x1e\xc2;\xd6\xa3X&\xcc\x0f@\x8bV.\x85\xc3  \xc5.&\xa9\xadC\x91JAm\x1a\x8d{\x95\xc5s\x86E1\x8b\\\x85Lr\x14\xbd1i\xe4\xf4\xae\x109\x08\xddM6\xd0\xe7zg\x90\x8b\xce\xee\x11\xba,\x0c\x8a;\x94\\\x84\xee\x90\x8a\xc0\xcf\xd0\nt\xa4\xbeK\xa6kF\xec\x17\xaaq\x80\x9a\x0ff0	'\xe4k9\xb6\x9cV\x98\xf9%^OV\xf7\x0d\xe6\x8f\x8fT\x83p9\xb5\xaa\x87l\xcfpN\xabs\x98\xf3\xb8\xc3k\x07r\x01\xc1P\xf8\n\xb0t\x05<\xfa\xa3y\xc4v\xe5\xd1\x1f\xe7I\xc6\xc0{y\xad\x93\\\xf6\x15j6\xb2\xa1\x9c>\xd9g_q\xf6a\xe0\xd8\x9c\xe5\xc1\x04\xcfR\xb2\xefJ\xd8\x8d\x80}e1\xa8\x84\x93-^\xca*-}\x04 0\xd4:Q\xd5\xc3\xa9\x8f#^N\xdc8\xcc\xa9\xd1\xe1Sx\xf4\x0b(\xa81 \x96\xb4\xb3\xab\x1aEzt\xfe\xf3.'\xb5\xea\xde\xec\xc4l\xbf\x7f\xc3\xb0?\xb0I\x08\x03\xd1\xc8Q\x8b\xe0\x87\x86|:\xca\xb0\xf1j\xeb\xb3\x8b\xd8/\xa9w\x02m\xce\xfc\xbe{\xf3j\xe2\x843A\x06\x1c\xec|\x0e\x8b}\xf4\x14\xec\xe1\xd4\xa0b\xa1\xb5T\xed\x16n\xa06\xc41\x8c\xaf\x0b\xfb\xfaE\xa2;\xbe\x9d\x93\xc8\xb2h\xfb\x8fn\x0c\xde\x82\xc1\x0c-\x9f\xbb\x157\x86\x87\x97\x0b\xb0\x92:\xa7\xd5\x84\xd6\xa6\xa1\xf8\xc5\xd4\xc98!\xaf\x02\xf3\xa6\xaa\x83C\xf2\x1a2\x1cQ\xd2K\nV\x98b\xadc0\x8b\x87)\x055\xdd\xc5\xd1\xf0\xbea\x03\x96no\"\xb1\x0b\xa1\\8\x12\xcc8.wF\xd6\x19\xe5\xe8\xe5\x7f\x0c\x97b\xc3\x8f\xb1\xc3\x1b\xcc\xa8\xaaP\xe7\xc16\xceq2\xf8\xbf\n\x05v\x0c\x05vEz\xb9\xc3L\x93\xa1\x16\x94l\xb9\xc9\xf0L\x0c\xc1\xd60~\x98Q\xf5\x99\x0c\xd0\xa2e\x0b\xba\xd7>\x7f\xdb\xd5f\xbb\xf1m0\xcb^^\n\xdfX\x0d\xad\x8e\x88\xdc\xb7\xd8[\xa8\xe9\xf6\xbdC\xc8\xb7\xffAYq\x00%\xe6\x1dX\xb1_\x7f\xe4\x95\xae\x1bZ>2\xe3\x80=/T\xbf\xb1iU\xc6\xd6$U\xeaY\xd9\x90\xf8\xde\x89\xad\xe9J\xe1\xe8\x95\xce\xfd\xdb\x93\xdd~\xdd\xcf~\x16J\xb9~\"\x05\xd8\\QIm\xe8>'d\x9cX,v[\xe5\xb1EoH\xdd)j\xba\x96\xae\x1a\x1e\xe1\x9c\xa4P\xa2\x95\xb7\xefK\xbc\xa7\x87\xcdT\xee\x18\xaa\xe4\xd5\xced\xa0\xc8\xb7\x9bT8__<HK\x92\xa1\x8d9\x1e\x81\xff\x8a\x92\xe5;\x9d\xbe\x1f\xba\xd7\xeb\x0c\x02\x1dn\xf1T\\O\x917\xa7AB\x0d\x02\xef_Dt\xdeFE\x94gM5G\xc3\xe2\xcf\xce\xc0\xdba\x8e\x1e'\x874\xc7\x05\x19\xf6\xd4\x11\x85\xb4w+\x9d;\xd4\xe5\x14\xdd\x9eJ\xfd\xffA\xfd\xef?z\x08\xb1;\xfb\xbe\x9a1\xd0~l\xee|\xa2\x7f\x11\xc4o\xd2\xa6 K\xfb\xa9\xa5o\x16\xf7?m?\x0c\xc26N\x00\xff\xc8\x83\xaaA8\x92\x0b\xe2\x08\xe8\xe8\xab\x11\xf5\xb1\xefM'\xafk\"\xeeXq\x08\xad\xeb\xf8\x8ei\x9a\xb3\x87\xed\x1f\xae<\xdc\x87\xc2\xa7\x0b1\xa8x\xad\xbd\xe7\x18Q\xbd\xf3\xa9\xc4\x10Fp\xd0\xef\x87\x03\x81g\x83\x0e\x0b\xa5:\x10y\x9eXS\x8f\x14\xf3\xbbK'\xb28\xaf>_V}\x87\xf3\xd3\xfbJ\xaaC\x14\x11\xc6\x0d\xb6\x12W\x1e{\xc1\xa8FOdC\xd7\n<\x81)<\x8c\x81L\x1a\xbb\xad\n\xe1\x9e\xe9\xd4\xbf\xbf\x1b!\x81$\xcf\x10\xfc}\xb8\x14\xe0\xff\x80\xcd\x0c\xa2\xb6\x05\xb9;f\xb4\x13:\xc7I\xed~\x17>\x0f\x92\x8f\xcf\xd8_\xf6\x8b;-\x96wdJ\xf6n\x03\x1f_\xcb\x1c\xed\xd8/\x0b\xd98\xef\xc6\x86\xa0\xfa\xde\xab\x8d8\xf7\xde=\x9c\x87gw\xfd'0\xbe\x8d\xf3O\x11x\xf8\xe0\xcf\x07\x0f\x1f\xcc\xa8\x0e\x99j|\xb9\xa8C/;\xfc\x06\x1b\xeb\xcf\x8aa\x0b\x19\x92\xd1\x00\x08\x0c\xc6R\xce0\x16\xbe#jK\xc61\xf7\x83\x17\x98\x9d\x98\xa5n\x9c\x0c\xea\xc7\xf9\x8a\xc2<\xbc\xcb\x18{M\xa1e\x17]R\x17\x1a\xe5\xd3\x82\xdb\xf7\xae\x89\xad\xf0\xbc\xff\xb8\x03M\xac/\x9f\xdd\xcc\x89\xb1\x851{\xbb\xdd \xcd1\xd3\xfd\x8fa\xcd\xf6\xbf\\\xff)t\x9dgo;_\xda\xe7\xe4\x9f\xad\xf8nmk\xf6}\xbf\x99\x8b62pQ\x1a\xe1\xbc\xb5	$\xb0\xaa\x15g\x19\xc3\xe1\xcd\x85>O\x15\xf9w\xd4$m%\x9f7\xe7\xa1\xa8\xff\xf8\x0b\xfb\x18\xe6\x1d|1u\xee\x17\xa4\x14\xad8\xe32(,\xe9\xa7\x83\xd0q\xc89\x17:d]\xde\xf2\"+\xcc\x17\x96#l\xc9\x7f\x0e\x07\x85N+\xcb\xbc,t\x9d\x0fB3\xf7\xe59\x1b\xdd/bm'\xdeQ#w\xdf\xac\xc6:\xa5\x7fk\x176\x1d\x13`\x1e\xbb\x885\x12\x1f\x034 \x12\xcb<\x139\xe7wC%7\xc2\xea`\x8bf\xe7\xec\xde\xe5\x08\xb6V.\xf5\x05\xfb\xee\xb8\xc4\x88\xdb\xbf\x00Y\x91\xa3\xb6\xae\x0dA\x0f\x9f&\x0f\x1f	=~k4\x175f|\xe0\xbcY\xac\x9d\x8d\x0f\x1a~\xf9\x04\xbe9\x7f\xfe\xd5\x01\x9c\x9f}u\xe0\x1fF\x92\x81w\xcf\x8fNa!Y\x03|ZR\xc5Rb.+\xf4\xf6\xc4\xb2\xc3\xfa\xf2\xc9\x93\xeb_<yr0\x0e\xf3\n\xf0\xa5zZ\xf8\x97\x12\xdf\x1eyu\xd2\xfey\x98\xce\x0f#?~kGq\xff\xd3\xbb\xb7\xf4\xc9\xdb,\xf3\x05\xf2\xa4<2\xfb\xbeB-\xb7\x1f\xa0@\x1b_\xd6\xcd2\xc5G\x92\xf1\xe8\xf07\xc2Q\xb0y\x1d\x9b\xa1\x11Lz!\x97\x8d	)+\x8f\x9f\xf9.\x06c\xe5\x1bi\xf6\xa6]\x84G	;\xf3\x92\x87\n3\x1f\x1cO\xc8\xdevC}-A\x96\x0b\xe7\xf7v\"b{z\x16\xbc\xf7\xb8\xea\xfbX\xb9O\xd7\xf8\x19\x83o\x1bg\x04\x0d\xa32\x9d\x14k~\xe3\x9c\xb4\xe07\xcb\x1b3\xd4\xdaf'\xc3\x7f?\x12\xb4\xc1\x8b\x83\x8fY\x9a\xab(\xb4C\xb3?H\xad\x7f\x13\xb0	o\x02\"\xf7\x8b&+_\x07\xf9{\xf1\xdd\xf1\xb7\xf0\xfa\xc5\x8b0\xb0T4\x17\x8a\xe1_)\x9a\xfbW\x92a\xc07\x1ag/O\x86/\xd4N:/\x02/\xc3\xff\x88Y*\x85\xcb\x80\xe1\x0c\x15.\x19E\x17\x9e\x87\x86	\x8aD~\x9cH\x8b\x92\xa5\x96>*\x1f^\x96\xb1\x1d\xf2\xaf \xe5\xb4B\xea\xe5\x1d\xd1\x17w\x04\xfd\xef\xc9\xf9\x97\xff\x84\x9c+Z\x92\xafz\xcf^\xc7@\x7fP\xe40\x12\x9e\x9d$\x83\xb0\xa6\xf4\x85\xf5Z\x18\xc9\x9a=\x18\xec\xbd\xf2\xe9\xec\xbb\x9a\xd6d\xf4d\xe7'\x974{K\xb77\xf3\xa8a\xb4\xd2g4Vifg\x18\xdf\xf5}\xdcn\xe2\xdb\xbb\xf4u\xb0\xaf>y\xf3\x92\x8aR\xdd\x98\xe5(l\xe7\xfc\xe1\xc3\xd3\xd0\xe8\x0cS\x82_\xbf$RN\xd6^>\xa4\n\x1d\xd6\x10	\xcd;\xb0\xcd\xfc\n3w\x00s\xca;>\xff\x90ljQ!\xa0\xce\x84\xc5\x9c\x0d3\x0b\xa0U\xc2\x16h\x0fa\xb8\xb37\xb8l\x940l\xc8M\xc8\xd8\xa2\xf9\x9eYt\xa1rk'U\x8d\xc5\xd6n\x9c\xf1*\x88B2\x83#\x1a6\xd7M\">\xf1 m!8\xa9~\n\xc3\xbf\x04z\xe4\xa3\xfb\xd9\x85\xff\x80yl\x9c\x8f>\xdb+#\x19\x91\xf3}\xfaG\xb5Zp<w{3\xe0\x0e!\xf6\xa8\xdd1\xe4\x86\xf4$\xc31\xf9\xa36\x98W1\xe1\x9c\xd9N{[w\xc1\xec\xd7F\xd6t5]>\x0f\x1e\x0f\x0e\xe7;-\x7fh|?L\x87\x87\xa1\"s\xb2\xc5\xc7\xfey\x1a;\xb4\xe0\x9a\xfcB\x17\nX\xb3\xb1d5s!*\xf4\xc1D\x84t\xb5\x87\\vu\x004:z\x99\xd7\xf9\\\xe8\xe6\x8aC\x97\x87\x7f~\xf8\xbf\x01\x00\x00\xff\xffPK\x07\x08\x0c\xd1\xc0\xa5P\x13\x00\x00\xd11\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xef\x9d\x8cN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/tr_TR/common.jsonUT\x05\x00\x01R\xeb\xb0\\\x8cV=o\x1b9\x13\xae\xa5_1X\xc0H\x02\x18N\x9fN\x92\xd7\xb6\x10\xdb\x12$%F\xf0\xe6-\xc6\xcb\xf1\x8a\x11\x97\xdc#\xb9V\xd6A\xea\xfb\x13\x87\xb8\xbc\xf6\xd4\xa4\xba\xce\xf2\xff:\xf0c?d \xb8\xabv\xe6\x99g\xc8!9\x0f\xb9\xdf\x86\x83\xe4N\xe9\x02m\xf2\x0e\xbe\x0d\x07\x83\xe4\x16\xa5$\x96\xbc\x83d<\xba\xbeNOa\xfc	^\x1d\x99Wp6[\xc0\x91\xf1\x9f\xcf\xc9\x91\xf9\x9c$\xc7\x8e\xcfH\x90\x0d	\xa7\xe9e\xba\xea2B\x9c\x17\x98\xd3iG\x9a^\x8d\xceS\xf85uY*.H\xf7\xc9\xcb\xf9lz\x99.^\xd2%m\xe7\xcaX3\x95\xab\xb5&\xf4	G\x0c$m\xa1t8p	\xd6GNB\x82GG\x92M\xdd<fVp\x1bk:b!\xe3\xb5y\x03(\x19\x1c1\xf0\xb58_EZ7\xc2/\x12\x0f\x89\x95\xce\x89\xb9\xf2\x1cm>[\xae`\xfeaq\xfeb;\xfb\xfbh\xd6\xc8\xd4v\xcc\x9b\xdd_^\x8cNg70\x9e\xfe\x97C\x08\xcb\xbcT\xd9f\xa5\xf2\\\x84\x11V\x17\x8btt\xea\xe8\x07\xdbV\xc9\xee\x88?\\\x1f\x1er`\xdcs\xda\x12[bA\xd3yS\xfe\x12fg\xb0\xbaHa9\xbaJa:\x87\x9bt\x91\xc2\xc7iz\xd3\xcb\x1e\x0e\xbe\x1f\xc7\x862\xae\x9f\xbcW\x8aJ\xa30M\x7f1\xac\x93w\xf0\xbf\xe1`0H\xf2\xa7\xbf\xa5\x9f2\x98\x82t2\x1c\x0c\xfe\xef\x8bX\xabJ\xb7D\x83h#\xd1\x99\x07\xc4\x82\xcb\xcaRKe\xb8\xe1\x1b\x8c\xe4\xe8t\\%\xed\xba\xa5b\x1diXw\x14C\x99\x92\xac\x9b9\xb8\xc7=\xc7t\xe4\x9a\xb0+\xb2\xde\xefD$z\xd3\xb1\xe2\x8e\xf8&iv\x00Y\xc1\xa5\xdb\xd7\x917\xfc@\x98+\x87\xb8O\xf0}	\xfe\x13}\x15R\xdc\xd7#\x99\x92VcfC3\xbb\xd8$\"\x10\xa1\x9e<\xc7uw\x9aA\x8b\x80B\xc0m\x0d\xd3y\xe0\xd1\xd72\xceyN\x92??\n\xb2\xfd@7K\xea\xfd\x839\xd6\x9c\x91\xcf\xe4\x0f\x82\xa2\x94}\xb5\xcd\xea\xbe\xa0\xe4Vi\x9f\x7fE\x9c\xc5E~\xa9\x8c\xbdV[\x87>?\xf2\x82\xf1\x00\x0b\x95mB{F+\x1e\x1d#\x8d\xed(\x8d\x17\x82\xaa\x93\xe4\x81\x0c\xd5VRH\x18+\xd4\x0cf\xce\x8fz#\x1a	\xe1B\x17T\x1a.9\xe4O?\x8dm\xc3\xebP\xd8\xf9\x01\x18\xee&/\xcfh\x06\xf9y\xd1--\xcf6\xb5W\x9e\xf7\xc1\x04 \xaa\xae[Vk\xb7j\xeb\x9f\x8f\xb3\xdac\xa9U\xe5\xb0\xd7K\x92o\x1ay\x99Zf\xb1\xe9\x12\xc6M\xa6\xa4\xa4\xac]rt\xb9\xccc\xcd\xb5\xcc\x0e\x9c\x86\xc8(zC\xdf\xcc\x89\xe5\x055-\x9a\xa1 \xc9z\xbd=\xcb\x1aI=\xff\xa8n\xa3y\x85:Z\xd7\xdc\xb4X#\xaa\x0b|\x88\xd6\x8a\x8ah\x8d\xf6\x8fU4\xd3\xba\x11K\xba\xe1\xd1z\x8f\xcd0#\xddS\xed\x96h\xd3V2o\x87\x9d?4w\xc2\x12\x9b\xb1\x9e~\xd7\xcf\x8fM\xbc3'\xcd\xac\x13k\xfa\xba\xacx\xfb\xec)kU\xe1%&b\xe7g(3\xf2\x1d\xb2\xff\xab\xb4q\x8a$C\x8bB\xe5^p\xd1\x0c\xb8\xe0\xee\xf6\x9d\xb4I\x13\x07\x80U\x10\xc79\xee\xc9\xd15cO\x8b\xb7\xde\x0f\x04%\xbd\x96\xa6E\xa9\xb4\x05Se\x19\x19sW	q\x02\xab5A\x899\xc1\x96\x0b\x01RmA\x93P\xed\xf3v\xc7\x05\xad\x94\xbaD\x9d\xfb!\x9c\x0fV)\x10\x1e\x89\x1c\xc9\xcd:\xbe\xef\xdc\xda(\xb9\\)\xd7\xc0J\xfau=\xff@\xbd\xd9\xef\xea\xfd\x0eB\x80!\xa0F[\xe0\x06\xf8\xd3\x9f\xeeY\xdd\xef6\x02\xeb\xfd.\n\x9c\xfbj'J\xeb\xaa\xb4\xbd\xf2\xef\x90\x0bb'p\xe6J\xc9b\xb8\x97q\xfa\xab\xd5\xfe\xebb\xb9\xbcG\xc1\xd9\x04K\x9b\xad\xd1\x8f\x11\x10\xc8\"\xe4i\x1b\xa2\xd2o2\x97\xf9\x99\xf2\xca}OT\x02\x8b\x18\xdc5W\x88\xc0\xf0V/\x9b\x0b\xd6i\xb4\xfb\xb1\x88\x92\x0e\x0f-\xb8X\xc7\"\xb6R\xe3\xae\x7f\x9e\x1fq\xff\xe8\xf6\xee\xe9\xa7d\xa4\xb9h.\xb6\xc2]}\x1f\xb9\xe1J6\x7f\x06\xfe6\x84{\x8fERe\xec\x15\xda\xcc=S\xc9\x1c\x8d\xd9*\xcd\x0c8\x18\n\x8f{\x9a\xa4mW\xdc'\x92\x1c6J\x86.OJ\xc5\xa5u\xad\xd8v\xea\xdc!\xae\x17\x0fZ\xd6\xbfJ\xdd\xcd\xee\x7f\xa6\xde\x06\xff\xedju\x19H\xbfU\xca6\xfd\xa29\x18\xfe\xc0H\x02\x8a\xfdN\xda\xfd\x0ej,\xed~\x17\xa8\x9a\xd0\x84gj\x11\xac\x882\xae)\xb3\xe3:\xdco\x8b\xe8\xbf|\x81:^\xb7\xb0\x97\xdc\xb0\xfd\x0d\xffN\x93Y\x07Z0#^\n\x7f\x0bO\xe8\x1eK\x81-\xaa\xb4\x0db6%\xaf\x95\xec\"\xb6\xd2\xbe\xeas\xd2\x1cN\x9f~6\x85W\"l\xcc\xc2\x1b\x1e3\x84:\x1c\x8d\xbb\xa1\"d\xdc\xe9\xa5_K\x1e\xffZ/U\xce%D\x1c(\x06\x02y\xad\xb6\xd7\xca\xf2\xcc\xb7}\xb4B\xa4\xba-x\xe8\xc0`y\xd4\xae\xab\xe2V\"\x17<Hs\xd5\xf3ON\xa2\x16\xac*]\xec\xe9\x0f\x13\xb3*\xd9\xe8\xbc\xe9\xb3O\xaa\x825\xde\x13\xa0\x84.\xea\x7f^\x9b\x94\xadk\xaen\xeb?\x04\xe0`\xcb\xab\xd2)\xd0\x89\xd93\xbc\x07\xee\x9a\xe9\x87\xe7Z\xe5\x9a\x8c\xdf\xb9\x80\x10kK}1\xc9M\x7f\x8a\xe1\xe0\xfb\xf0\xfb?\x01\x00\x00\xff\xffPK\x07\x08E\xd7Z^8\x05\x00\x00\x91\x0c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00v}\x96N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/tr_TR/server.jsonUT\x05\x00\x011\xe1\xbd\\\xbc:\xcbr#Gr\xe7\x99\xaf\xc8\x80C\xa6\xbd\x01\x91\xd4\xee\x1e\x1cs\xd8\x08>G\\\x913\\\x82\xa3	Y\xa1\x8dHt'\x80ZTW\xb5\xaa\xaa	b6t\xf6O8\xcc\xe3\\\xc5\x8bN\xba\x11\xf3_\x8e\xcc\xaa\xee\xae\x06\xc9\x95\xc2\x96}\x02*\xb32\xb3\x1e\xf9\xae\xfe\xfb\xcb\x17\xa3\x99u\x95\x1f\xbd\x82\xbf\xbf|\xf1btz\xf0\x97\xd1+\xf8\xf6\xe5\x8b\x17/Fg\x86Q\x18\x945P\xa3!\x0d\x81n\xc3h,\xc8\x13\x13\x9c\"\x0f3\xeb ,\x08\xa6h\x0c\xb9\x1d\x0f\xa7\x8e\xbeo\xc8\x04\xbd\x86\x03\xbf\xa4\x12\xfe\xd2\x90g\x1e\x1e\xb4\xf2\x01\xd0\x94\xa02\xce\x95-Q\x8f^\xbex\xf1\x1d3\x1e\xbd\x99\x9c\xbe\xefV\xf0\xc6\x06\x98\xe0\x8c\xe0\xd4:xo\xdd2	?\xb4\xe8J@\xad\xed\xcaC\x85\x81\x9cB=\x86\xb0\xc0\x00\xe8\x08\x8c\x0d\xe0\x99.X\x98\x12\xdc(Z\x11K\x05\x84\x95uK s\xa3\x9c5\x15\x99\xd0KF\xbd\xc2\xb5?\xb7\xc5\xb2\x93\xff%9\xf8\x80\x15\x1a\xc0Ow\xb8\xb9\xdb\xdc\x97\x08K\xd4i\x19\xcf\xa2{\x9e\xc6\x9au\xa5<u,\x0f\x8c5\xaa\x825\xd6=\x93%y\x05\x18\x11\xf3\x87\x9f| \x97\xb1hJe\xbf\xb6\xba\xa92&\x0c\x83\x9b\x08\x8cl\xe2\x0c\xb03\x10\x02\xdel\xd5xU\xc8y\xdf\xa8\x92,\xd4\x1a\xd7\xe4|\xce:\xd8\xb9\x9a\xf5\xdbEGK\nZ\xc1\xeb\xb3SX>\xfc\xfc\xf0\xf1\xe1\xe7\xa5#\xaf*MN%IO\xe2`\x11i\x81\x82W\xa6\x171\x9d_4\xa1_8\x0f\xe0\x10\x8b\xe5\xdc\xd9\xc6\x94\xf05/,\xf1\x15\\T\xa5\x0e/\x0b\xcf\xb9E\x82\x96\xdd3\x9c\x0e\xcaJ\x99\xcf}M\x85\x9a)*\xa1\xa2\x8a<\xd4\xaa\xa6\x12|p\xa8\xe6\x8b\x00\xca\x04\x0bk\xdb\xec8\x82\xca\x1a\x15lv\xe8SV\xb0\x93\xdbZ\xb9u/L\x94\x8e\x04\x08Au'\xff\xa6\xa9\xa6\xe4\xf8\xe4K\\{X\xa9\xb0\xb0M\x00C+\xa8\xad\x0f\x1e\xa64\xb3\x8e\x00A\xb8\x82\xf2P\x92\xa6@\xe5\x96\xbc7\x98]q\x94f\xb0\x17\x83\xf1z\xf9\x84\x98\xb7\x90\xec\xc2\x11\x1a(\xac	\xa8\x0c45+\xfc\x17\xfb\xa0\xed\x8a\\\x81\x9e\xe0\x1c\x832\xa0)\x04r\x1e\xac\x03#\xab\xf5\xbb[\xb2\xafU\xd0\xdb\xc2\x83\xc0\xa2t\xc1?\x12?\x06\xdf\x14\x0b@\x0f;\x07FU\x0d\xfc3\\\xa0\x99\xdb\x9d]x\x97\xd6\xb2\x0f\xc5\x02\x1d\x16\"_[3\x1fH.\xf3\xe3-\xd7\xad0\xba\x0d\xc0\xc8V \x9fcOV`\x1d\x8a\x05v\x94Gi\x9c.\xdf/\xa1\xf1,-X(lU\xf3Q\x03B\xa2\x12\x87U\x90\x93\x03\x0b\xe8\x97\xec\x96\x96\x04\x8e\xe6\x8auC|\x12\xdbLX8\xc2\x12\nG\x02{$\xfe\x1a\xe7~{	\x10\x18\x986\x81sY\x82\xb7\x8d+(\x8aM\xb3\xbc\xd5\x8dx\xc4]xk\x88\x89X)\x1c\x9a\xd2Vz\x0d\xc5\xc2z2BAX,`N\x86\x1c\x06*[\x06\xbbpE\xdf7\xcaQ\x19\xf7\x18o\x1f\x03hB\x1f\xe0\x0fP\xaa\xd9\x8c\x1c\x99 \x0b\xcaN\x9c\xefbRX\xd7_\xf5Q{;\xe0k\xac\xc0\x0b2\x9d\xa4	J\x80+\x12\x8b\xb13v\xd6j\xb6Vf\xce\x07\xdaQ\x8ac\xe5;\xda\x85\x83\x19\x03\xe8\xb6 *y\x1a\xdf\x9eV\x95\n\xf2\x8f\xef\x05VJk0\x14\x17\xef\xad\xbe\xc9n'_j\xe3\x83\xad\x8e&\x93n\xa9iY\xef<\xb9\xcfK\x9a)C%\x1cM&\xe0\x1aM\xac[XR	\xd6@\xb0u\xab8\x9e4\x15|raA\x15=\xc1\xfc\xda\xce\xe7\x99\xe2\x1f	\x9c\xb9\xb6\xb7(x^8\xb3,zt\xc7\xaa\xa4\x196:\xe4\x0b=\x8e\xa0$41\xe2\xff\xbcc^'L\xd7\x90\xe8\xc6\xb0Z\x90\x01c\x0d\xb1\x12x\n\x8c\xe4\xb5\x17Z\x0d\xe2S\"8G3\x7f$I\xa3\x9978o\x85\x9d\xa7!\xd4X,\x9f\x10\x9a3\xf5\xbc'\xc7\xb4=S\xdfL\x12,\xf2\xcbf\xedZ7\x07q\xfb\x80\x0e+\xccX)\x8fSMWvjCo\x19\x97\x8enX\x11\x0b\x87+\xcd\xd1g<\x00s\x08\xaaD\xb7W4\xf5*P7\xb1\xf7-\x9e\xd0\x15\x0b 3W|H\xa6\xa4[\xc1\xcf\x9c\xad\x00\x8b\x82\xbc\x8f\xaa\xa6|\xf2\x8a\x8f\x16\xc5:#N\xad_\xd8q\xc4\xf0\xc9\x7f\x8e\x1c0\x92{\xee\x0c~\xb0P\x0fh\xd6,\xcc6\xbc\xea\x1a]\x88\xf2\xf9\xa6v\x84~\xa7C\x0b\"\xf21\xf3\xde[f\x91\xb7\xb4&\xf3\xb6*(\x97\xc4-\xb1\xc6\xecz\xc4\xf0\xa6\xa8u7\xf9\x9f\xfe\x8d\x87\x80\xc6\xaf\xfa\xd3<\xe7\xc4\x8aC\x7f\x84vIY\x9a\xbc@\xbf`WX\xa1y&b\xecw\xa4\xec\xfa~\xbf?t\xdb\xc1\x06\xd4\xd9\xa1R\x85J\x9f8\xd7-\xea\x84\x01p\xe2\x9c\xed\x96\x94\x0cG\xa6\xf2)\x90 \x9f`r\x81J\x0f\x19\xb5\x19&SD\x06O\x90]\xa2\xcf\x94\x0c\xbd_YW>\xa6\x84:\xa1\x9eba]\xd8\xda\x82'wC\x0ej\xc6<\xe6\x95a\x9f`7i\xa6Or{\x9e\x91o\xa6\xa5\xadP\x99\x9c\xdb\xed\x82\xf8nzV- \xb2i\xf1\xcf\xdb!\xdd\xd6h\xca\x83Lg\x0e\x1f~\x0e\x0f?\x1b\xe8\xb3\xb59\x19\xf5\xe9NS\xbb\xcbo\xc8(\x98+W*M\x0eJ\\(\x0d\xd3\xe7\xa9\x94\x1b\xac\xb8\xce\x0f\xf2xs\xff\xe9\x0e\xdd\xe6\x1e\xba<\xf9`\x8dN\x0b\xa8\xb4~\x8d\xeb\x88\xeb\x18\xcc\x88J\xce\xf9\xe2\xe5\xb7|N\x134\x1eY\xe6\xfba6\xc0\xb0\x02\x97\xcasr\xcb\x81\x88\xd5\x9e\x03@\xacG2!z\x10\xaf\xd9N\xdd\x1a\"t\xdc:\x04a\xc2\x81\x8c\x1c\x14\xf9\x14\x0e+\x92\xcce\x0c\xad+\xa8\xe4t\xbe_\xb2\x80\xe0@\x92~\xdbd\x8c\xc5\xd3H\xf4\xe3\x8c\xce\x8f!8U\x17\xb6\xa4hp\xb2\x91\xa7\x84\xccQkr\xeb\x0b[\xf6\xee\xe2u\x84\x81\x00\xa3\x84\xb7F\xaf\xc1/l\x9bq&\xf3f\xbbS\x15\xce)\xe7hm\x1e\xf0^\xc7\xe18\x1b<\xadU\x0bU\x96\xd4o\xf5\xb5\xfa\xa0\xd5+\xd8o)y\xd8)\x90\x82@\x95\xfa\xa0i@N\x87\xca\x18*;\x16_\xaa\x92\xe08f\xc2p)\xfb\x1e\xf7\x08\xf6[q3R\xd5-\xf0\x86`Jd\x1e\xe7\xce\xcczP5\n=C\xf8@\xf7P\xeb\xbd\x9cqt\xc5\x9c\x18\xcd\xa9\xe4\xf0\xd2\x15\x8b\xec5\xa5<\xec\x1c\xbb\x10CE\x01\x85j(\xf3\x8a\x8a\xc6yuC\xbaOb;\x98\xac\x1d\x16\x8a\x93\xa0'\xb7\x95\xaaUG\xb5V1\x15B\x88g\x1cI]\xc6} \xf6z\xd1TS?\xdcl`\x98a\x1d\xda\xd2e\x84o'\x0b\xbb\xfa\x0e\xa6M\x08\xd6\xb0\x81\xd4\x1a\x8b\x94\xc9wD\xbd\x00\xeb\xd4\x07V\x1e\xcd\x17\xa2\xb2L\xe3\xc4p\xf0mo\xb8\xf5iC`\xc7F\xf5\xb7|n\xe7\xaa\x8d\xa5\xd9\x04\xd6\xca/-{\xc7v\xe2\xc3\x7fzv7%\xad\x97dzW\xf3/W\xac\x8d\xff\x9a8\x9cru\xff\xf0\xb3\xcc$\x98\x93.\xd5\xe6\x8e3\x82'}\xdbP\xdc\x95\xb5\xe1\xed\x0d9\xa72c:c\x0c8k\x03\xd8\x16\x17E\x9d\xcd\xb8:\x84\x95\xf2\x0b\xbe\x9d\x05_J4\xa6\x94x\x80\xa7\x1a9/\x07m\x8bX7H%\x81pt\xfcf\x0cd\xd8\x8b\xb0\x16\xcd\x1a\xad\xa3\x08,KG\xde\xc3\x82\x1c\xed\xc29\xb1RSU\x875K\xe0\x1c\x93\xa7\xa7\x1c\xad\x9d\xbc\x0b'\xb7\xc8U\xcc+\xd8Y\x84P\xfbW{{q\x1d\xbb\x15\xcd\x9b\x029\x12\xecl\xedu\x98\xe3\xc7M\xfe\x9a\xfc\xbe\x8e\xb7\x0eh\xe2^\x7f\xcb\x84^U\x838\xb1\xf9\xf1\xe1#_\xd7sQ\xa2D3\x88\x12\xcahe\xe8T\xf5\x1c^\xa7\x8bn\xb5q\xf3\xa3^\xc2\xfc\xe1'S\x12\xdf\xe3R=\xa5\x14\xc0\xab\x8b\x92\x90';\xf6\x08\xeb&h4\xe5\xe6>\xd7\xe1\xef\xcb>\x9e\x9f\xfd\xe5\xf80Ia\xf8\xf3\xc1\xf7o(\x9d\x84\xde>\xff\xdc\x02\xc6\xf9\xb0K\x15\xcf\x8e\xfd.\xb4s\xa0@\x03\x96}ytr\xd1SdG\xa8\x07\xb9\x7f\x17\x15\x8f\x95VP\xd2\xe6N}\xba\xe3T\xb2\x9f\xaf\xcc\xd2g\x99DpdPC\x04\xa7S/\xcb18\xaa\xec\x0d\x01\xe7'\xa5\n@\xb7\x81\x1cO\xfc\xd3\x9f\xfe\xb4\xf7\xc7b\x81f\xef\xf3\xb0\xae\xd9\xc8\xa4\xac,\xf2\x80R\xa1/l\x7f)\x17q\x18\x99\xa7$0Ni\xa3\xf46\xa9hgG\xdfZ\x9f\xa8l\xb2\n\xf4\xa2lIRF\x1e\x9aL\xcb/d(\xb1\x8f\xeb\xa6\xc4G:\x8d\xbd\xae\xf2\x9f\xae\xd2\x88QR:\x88\x19%G\x8d\x99r>\xc0\x8d\xf2j \xef\xf6K\xb1\x94-\xc3ZD\xf3\x11\xabHb/\xf0VUM\xd5\xa2\xec\x0c\x9a:\x15\xa8\xdb\x01\xb9\xc2\xdb\x89\xfa\xf0\xc8X\xd5\x07z\x92\xa3 \x1e\xf3c\xcf~q8\xe0\xfa^\x95a\xb1\xc5v\xc5\xb0'\xf9F\xcc?\\(\xcd\x9b\xeb\xaf\xfb\xe3\x8e\xc3\xc8\xe5\x92\x83Ml`\xc4\x00\xdbv\xdf\x8a\xd8\xc2\x93\xf5\xb1\x83\x95\x04K\xfa\x90\xdb\x8c'\x0b\xebB\xd1\x84\xff\x0b\x01\xb6$\x87\x03\xb3\xbc\xe8A\xe3!`h\x9a\xfd<1\xce\xdc.\xc7\x9cc\xa6l1\xa6q\xa5\x12\xef\xd9p\xc8h\xe1\xb1\x98W\x0e*C\x955\xaa\x10\xbe\xfd\xdaL\xde\xf4{\xb3\xd5\xee\xe3\xac \x04,$\x02u\x0d\xc5\x8c\x98V]\xe9\xd3\xf1\xe0i\xc3zhH`}\x7f\xc61\xefO.3\xcd\x1e\xc0\xd6\n\xf0\xe1c\xc6\xc0\x06Ud\x0b\x8e\xc3H(7\x98\xf7\xf6QCE\xde\xb3\xe2%\xdb\xe7\x8c+t9z\xdb\xddc\xfb\x1fH\x98\xa9\x18Ps\xaf\x821\xecC\xb3\xe6\x18!\x81\xa2uazso\xc2\xe6\x1e\xd6Xo\xeeu\xb9\xb9\xdf\xdcm\xeeM\x89pCk\x04\xbf6\xc5\nC\xb1\x80)~\xba\xd3\x98\xe13^\x83Xc\xec\x8a\x15.\xcf\x7f>\xfd\x97\xaaJ\x05\x0f\xff\x81,\x0d\xdb\x94\xa6\x05\x7fL`\xe0\xdag\xb9\xb9\xe7\x1a\xe7Q+\xdf\xea\xf2\xd1u\xbd\xd5\xe5\xf3\xd7\xd5\xa6$GO\xb4\x1c\xdbT\x06\xda\xde\xe3u_\xca\\`]s\xb0\xb6\xb3.\xdd\xb5\xd2\n\xdc\xf2\xa8m#R\xbaf<\xeeZ\xe6\xdb}\x14\xbb2\x94\x99\xcf\xdb8\x1c\xf7\x83\xa1\xd9\xc4\x06r$\x8a\xb9\xbb$A\xb1Y#j\xadu\xdbo\xb1&8\xab\xd9\xd6\x1a\x1f\x1ew3\xad\xa1l\x1d\xf5\xf6\xf9]>wvl*G\xa9\x973L\x87\xd8\x02\xba6\xcf\xafJ\x8b\xba^\x0evV\xf8[fF\xcc\xefL\xd2\x9b\x13\xa9\xdd{\xa7-@)\x8e\xe0\\\x99%\x08\xde\xf7\xdd\xa94\x81\x03:\x95\xb1ph\xd8n\xbb\xae\xb9\xa0\xf8z\x0b\xad\x8a\xe5.\xbc_p	\x15\x0b\xd2r\x0c\x06o\xd4\x1c\x03E\x05Y\x0c9)\xe3\x03a\xae\x05\xb5k\x0cm\xf5\xd1.\x19\xd66\xb8\xe2\xaabQ\xd7\xa9^W\xbfq\xa5\xb5\xc0RZi\xfd\xf3\x08\x97]o\xe4\xeddK\xd0\xb5t\xe0\xb7%\x85\x04\x1d\x88J\xc0gdE9\xca<\x16\xb3n:\xee\x13\xdd\x04 \xc3\x07\xd3&\x94\xc7\xd6\xec\x04@\xbf\xec)\xdc\xf4\x9an{\xffyE\xe5\xde\xa1n\x08\xae\xfb\x97\xd1\xb6\xf6r\xec\xecL	S\xc6\x07\xba\x0d\xa0f\x10}\xa3\xf4;UX\xc0\xce_\xdd\x0e\xa7];\x7f\x9df)<\xef\x85K\xfaL\x0c\x96\x92\x18\xf6\"\xa4\xa3P\xe7\x9a\x9c\xd3\xcf\x95x\x9e\x9e>\x01\xb6\x8d\xc4\x91\xc6\xa0n\xe8ZeQh\x82\x18\xf6\xfe\x1d\xab\xce\xc9\xc9\xff\xcd=|\x01\x1e1\xc0\xc3O\xa6\xa0u\xc9\xf5\xfeT=\xf6r\x8ej\xc2\xfc\x88d\xf8Xr\xad\xd7W\x83l\xea\xdb#\xba\xc1Z\xe3w\xe0qs\x07\x01\x1d\xceB\xfb\xac\x93p\x10\x9aOw\x8d\xc18\x05u\xc06Te\xac\xad\x0d\xef\xae\xce\xfb\x15p\xe9\xc5\x80\xf1`\xd8\x86\x1f\xc9s\xd2kZ\xf7\xae\xc2J\xe9m\x95\xb0m\x0b\xbav\x96\xb3\x8d\xf8\xb6\xb4\xb2n\x99\xd9\x86\xbcB\xf42e\x14\x05Fg\x18_)\x1e7\xab\x18\x1e\x1f\xc1a\xa5LiW=K\x9f'\xc6\x93\xbe\x1d\x7flE\xbd\xa7MU\xc7X\x1a\x9f\xaa\x92	g\x915z\xd8\xd4V\xea\x14e7\x97\xa0\xfb\xf3\x9fP\xc1i\xb0\xc0\xc6I\xa6\x0e\xf1(\"\xaa\xedZ\x89^w	Mz\x9fb\xcepE\x85\xad*2e\xf4yS\xea\x1d\xf9\x1f\xff\xd1S\xa0\xc7\xa6 \x836\xdbnS\xd0\x1bl\x9ft[\xfcna\xab\xa7+/O\x9e\x1d\xe3\xd6\xa3\xedA\x8aJ	\x9b\x9eo\x13S\xd6z\xbe\x06y\xb7mLP:\xbam	e\xd3\xe0\xe5[\x82\xf4B\xa1\n\xd4z\x0d\xdaJ\xdb\xc86Y]\xe0k\xabt\x1e\x1d\xe5\xfd\xb2\x83FY'\xa6\xed\x01\xf2\xe5\xfc\xeew\x12\x11\xe2\x14\x98j[,\xbd\\\x1a\xbb\x91\x9eq\xc0\xd9l\xf82;a\x10\\g/\xb3\xad\xbbY\xdb\xc6\x81P\xc4\x87\xdbV\xbfb#\x8ap`\",g\xe0ad\xc9Ox\x98\x99\xe2EKI\x90\xf9\xcd\xf8\xb0\xd5\xd3V\xad\x95\xf2_N}<\xe5Ic\xd4\xcfx3\x17x\x9beu\xb7\xaaB\xdd\xea\xef\xff\xe6i=\xb1x\xeam} ]e9\xa52\xff\x1f\xd2\x7f\xe1a=&\xcd%\xf9\xc2\xa9\x9a]q\xba\xbd\xdc\x823nRPO\xa2\xded.\x96\xed!iS\xcb7\xe9\x16-\xf36,\xab\xf7\xe1\xeb\x8e\xf0+\xf5\xe9Ny\xd2\x80n\xc9\xde\xb5u\xf8\x8f\xe0\x9c\x1fK\xd3d\x9b\xd5\x93\xc5\xfbA\xa2\x02\xf5\xf0Q\xa5\x07\x84-\x95\x90\x84<\x05\xf8\xb7\xe6\x8a\x83A\xc7\xe5\xbd$\xebmH\xb7F\x9a\xa4\xadb\x1e\x0c\xcc\x11\xcb2\xf3~\xc2\x94\xca\xc7\xa4\xbdX\x9aV\xbf\xae\xed\xf8\x9e\xa6\x17\xbf\xdcu\xe4Y\xc3\xfe\x12\xbb@\xf1N\xebXU<|\xdc\xdc/\xc1\xea\x8a\x0b\x84a\x87i\xb5\xc0p`\xf2\xc8\xfb\xbe\x83D\xc1<\x03y\xbc;G\x90?\xe0\x0bGd\xfc\xc2\x86\x14\x94z~k4%\xf5\xd6\xf5M\x1c\x8e\xb3\xc1 \x9ae\x0b\xb1n9xb\xd8\xfc\xf8\xe9\x8ecR\xd3\x86\xcc\xbe\x8f\xa6>hrc\x08\xc9\xd09\xa3\xedu\xc4o\xeeg\x9b{\xa7\xd1=f\xbe\xf5\xd2\xfe\x1b\x89x\xf9\xe2\x87\xf1\xcb\x17#[\xcb\xf7\x14\xedWl&>\xaf\x8e\xd66~16\x8a\xa7\xc6\xa0\x19\xe7\xe76\x1dcD\xaeR\xa3d\xd4^bEkbA\x9b\xbbta\"\xc3[\x17x#\xad\x9f\x1f\x1dJ\xfcmokt\x8e>\xf6\xf33'2j+\x90\x0c$\xca\x1e\x9f\x97\"\xe0\x94}l\x1c\xbf\x94C\x1b\x05\xec:\xfc\xa3\xd7d(6\xfaF\x93\x90Z~\xf1\xb4\x0e\\t\xbb\xa3\x93\xcd\x9d&\x93\xea\xf0\xd1W\x9b{\x8fk\xab\xe3\x11E~T\xd5\x9a\xd3\xfc\xf6\x80\xa6h.s\xc3\xfd\xc66\xd9\x13\x8b\xbc\x9d\x95\xb1\xcd\xbd\xf7\x99\xdf\x83\xe9\x1a>\xeb_\x95gVk\xbb\xe2\x92\xc7\x11zk^\xb5:\xc6\x11h\x8a&\x96=\xe2O\x89m\xf03\xf9\xf0H\xf1\x9f\xdd\xd6\x8a\x8b\xc2\xbaX5Y\x90\xc0%/\xa2\xe3\x18\xc5\xce.\xd9\x95~\x96\xda$\xe9\xf8\x1b5\xfcF1\xff<1n<U\xa0\x8ck\xc3\xbe\xe4\xdf\xe2s+48\xa7\xaa\xed\x0c\x8e\xb0,ebY\xa6q\x1d]\xd0\xe8\xa0n\x9d\xcd\x08\xbdWs#!W02\x8c16N\x98\xa2\xe8\xd4ar\x9c\xa3\xf8\xe88\xa9\xa9\xf0i\x15T\x07\x9f^\xd9\x7f\xbf\x0f\x7f\xbe<y=\x86\xcb7\xaf\xc7\xf2\x85\x9du\xf0\xfe\xe4\xf0B\x82l\x8c/P\xa5v\\\xa9*\x92BO\xd2\x82?\xec\xef\xdf~\xb1\xbf?\xee\xd0\x12\x96\xdbV\xe0\x17\xfb\xfb\xf0\xd5\xa1\xec\xd5\xc8\xf7F\xa6\xdcM\xeb\x91\x1d\x1d\xa6\xed\xf4\xdfM\x0d>\x99\x1a\x15\x0b4s\xca:\x13\xa3#\x81\x0c\xfb\x12\xa3B\xf3y\x14\x8co\xff&8\xa1\x13*\xf9\x13a\xd6\xcc\xd4\xbcq\xb1X\x14d\x0bI!m8m\x12_\xca\x07\xf3\xb2\xd7\xf3\x91\xa4\xae\x193\x19\xe6\x9cJ\x0c\xb2\xb1cL\x15\xc3(F\xe2\x8e$\xafI\xf3	m\x00k'\x88\x87\xcc'\xa4~Y\x8b\x97O\xd1\"\xbaqm\x93jt\xdc\xfe\x17LT}Q\x81\x93\xf4W\xe0\xed\xc35#\xda\xe7\xed\x84i\x8a\xe5\xdb\xa8e\xa7\xef\x8e\xbe\x82\xb7\xa7\xa7\x111\xd7v\x8a\x9a\xe1\xaf\xe3?\x81\xca\xfb\xda\xe8\xec\xb8\x1d\x91	*\xc8]\x9f\xb5\xff#Fk\x9aG\xf2\xb3\xf8w\xd0\x1e\x1fqZw\x94i\x85V\xd9\xe7BE\xae#\x0cQf\xfeH\xb5\xf1\x91\"\xff\x92\x1e\xff\xe1\x7f\xa0\xc7\xda\xce\xadt\x86G\xe7\xf1_\x06\x8d\x1f:0\x82\xb3ri,\x95t\xa3\x8a\xf6\xd0\xb7\xfa\x89\xd2\xb2\xec\xc62\xa3\x0f\x1e\xa3\xb7\xe9o\x84\xaf\xcc\x1b\xdbu;\xc4G\x96\xd2\x11\xb0+#}\x85\xac\xe9!=\x1c\x9eu\xd9\xe9G\xdd\xb8y\xa7=\x97<\x10\xe5\xd9\xcb4,\x06\xe2kkuP\xb5\\\xbe\xd2\xf1\xd50&0\xd35\xf8f\xfa7*\xc28}\x0f\xe9\x92;3X\x11\x90)\xd0\xc7\x0f\x9bY\x95\xbcF\xbf \xbf\x0b\xed\xe5\\\xd1\xbc\xd1\xe8\xd8\x15\xbbX\xff$\x07<\xf2\x14\x0e\xc5W\xc9\xce&\x14\xd2\xf7\x12\xbeC\x9f\xc7\x0bo\xd1\xe9\xfe%	\xc9\x8e\xce/\x90+\xd6W\xd0\xfe\xcb\xa0\x87\x92k\x8f&2\x80\xa9jIR\x18\x15\x1c\xe7\xbe\xfdf\xd3\x84\x98\xb9v\x86\xd9f\xb2\xf9\xb9\xc5Ca\xecW\xd64	\xb86E\xf2 \x86\x8a\xd8\xdb\x0b\x18\x1a\xdf\xa3\x8f\xda\xf8\xf0\xce\xa8\xef\x1by\xf11\xf1\xfb@,8\xf7\xde\x93/\x9d8\xfed\xe19\xc4\x86\xcf\xa8k\xf1\x8cB\xcc\xd9\xa4~L\x90u\x1d!\xfc+\x90\xc6\xa4\xe0\xf0N\xfe\xbc|\xf1\xc3\xcb\x1f^\xfew\x00\x00\x00\xff\xffPK\x07\x08\xaf?\xbe\xf3#\x11\x00\x00o/\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xef\x9d\x8cN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/uk_UA/common.jsonUT\x05\x00\x01R\xeb\xb0\\\x8cW\xddn\x1b\xc7\x15\xbe\xa6\x9eb\xb0\x80\xa0\x040\x94{\xdf\xe9\x87\x8e\x89\xca\x12!\xd11\x8a\xa6\x17\xa3\xdd\x119\xd5r\x86\xdd\x1f3D`@\x14a7\x01\x8c\xa8u\x0c\x16H\x90\xa8\xeeM{\xc9\xd0RE[\"\xf5\ng\xde\xa88gfw\x96\x04\\\xf4F\xda\xf9\xe6\x9b\x99\xf3\x7f\x0e\xbf]\xab\x05':\xe9\xf2,x\xc8\xbe]\xab\xd5\x82c\xae\x94\x88\x82\x87,\xd8\xde\xda\xdf\xaf\xef\xb2\xed\xdf\xb3\x8d\xf5t\x83=:8d\xeb)\xfd\xfb:XO\xbf\x0e\x82\x07\xc8\x8fD,2{`\xb7\xbeWo\xf9\x13v_vy[\xeczR\xe3\xc9\xd6\x97u\xf6i\xeaQO\xcbX$U\xf2Q\xf3\xa0\xb1W?\\\xa5+\xd1o\xea4K\x1b\xaa\xd5I\x04\xa7\x03\xeb\x11S\xa2\xcfz\x883\xa9XF;\x9b\xf6\x00\xa1[*j\xe0;\xe9AWfN\xa6\xf5\xc8\x9e\xf8,\xfd\x9cq\x15\xb1\xf5\x88\x91,\xb8\xd6\x8e\xe6o\xf8\xc4\xc1eb\x9e\xb4E\x84\xe2!\xadyp\xd4b\xcd\xa7\x87_\xae\x98\xb3j\xc7\xb4\xc3#\xdd\xdf\x96\x85\xf5\x8f\x1eo\xed\x1e<c\xdb\x8d\xff\xc7	V\xcd=\x1d\x9e\xb6t\xbb\x1d\xdb\x1bZ\x8f\x0f\xeb[\xbbH_2[\xae\xbc\x8b\x9f\xee/;\xd92\x9eK\xd1\x17\xd1\x11\xef\x8aF\xb3\x10\xff\x88\x1d<b\xad\xc7uv\xb4\xf5\xa4\xce\x1aM\xf6\xac~Xg_5\xea\xcf*\xa7\xd7j/\x1e\xb8\x80J1\x9eh\xd5\x8b\xf3\x84\xc7i\x11_\x11\x1f\x04\x0f\xd9\x1f\xd6j\xb5Z\x00Wp\x0ds\xf3\x9a\x9e\xa5\xe5\xdc\x8c\x83\xb5Z\xed\x8f$GG\xe7\x89\xe7\xbe\x87\x05\\\xc1\x0c\xe60)\xf8\x1e\x9a\xf9S]\xa9\xf2L\x94\xe7\xccK\x98\xc2\x0cn\x97NV\xc1\xcaI\xad\xb2\x8e\x7f\xf0\xce\x8c\xcd\xd0\\\x98W^@\x0fU\xc4LE\xa8UT\x9es\xcb\x07\x95E\xea\xc9\x03\xc1\xbdN\xe6\xcc\x8c\xe1c!\xd3\x19,\xe0\xa3\xd3\xc4Y\x92\x82\xab\xb0\x1c\x8f\xbaR\xa1?\xb6\xe8\x83\xae\xe3m\x8d\x889\x87\x05\xdc\x99\x91\x03I\x1a\x04'\x05\xa0\xe9 \xfc\x15\xe6\xb0@#\xc3\x9d\xdd	\xb5\xca\x12\x1ef6%\x90\xb3\xe3\x10\xe6\xa0J\x92o\x0f|L\xd8\x8cf<\x8e\xd9\xf1\x805\x9a\x96'\xbe\xe9\xb9\xc7\xe1\x1f\xb0\x80\x1b\xf4\x909\x83\xb9\x19\x99s\x98U9\xfe\xc1:\xad\x97\x9e\xeb\xc8H\xd0%\xef\xccKX\xc0\x14&\xfe\xb85A\xa1\xea\x9f\xb8\x92\x99N\xe8\xa2'BF\xda\xc1y\x9a\xed\xeb>1\xbf\x87\x05|@\xbd\xedV\xac\xc3S\x1b\xfc\xee\xcb\xb9>\x12	/o*VvS\xfb\x84\x87{r\xd3\xbd\x19\x99\xef1v\xfd\xbd\xba\xaf\x84=\xbd\xady\x12\xb1\x03\\\xbb\xd4\x16b+\x8e\xe9\xf8%\xf9x\x027V%\x06S3\x84\xeb\xa2\x02X\x89WIn\xd7\xd6Ek\x16\xb8'\xa5n\xe1\xda\x9c\xb9\n@y\x7f\x94\xc9\xf0t@\xc9Ok\x96Z\xc0%\xbe\xd7\xbd\xfc.\x13\xbe\xea\\\xfc*}:\xd09b\x9f\xc1\x1b\x98}^\xa4x:P\xa1\x0b\xe3\x00\xde\x981\\m\x98\xb7\x98\xbf0\xf1&\x81Ks\x063\x8b\x9b\x91y\x0bw\xb00C\x97M\xa8\xc5\x0c\xe6\xe6%\x19\x14C\xf2\xa6\xe0|j\xdf\x86B\xe5~\x8a13\xfc\x1f\xbc5J\xbb \x93]Q\xa4Q\xc8c\xa1\xa2J\x16\xc2;36\x7f\xa1JtQ$\xfa\xcf\xe6\x07\xca\xa9\xf7\xee\xadZ\x00\x7fCc\xc35\xdcTy?\xc1\xd4\x8c\xcdy\x15\xfa\xa79\x83	L\xab\xd0\xbf\xe8\xe8\x12\xf43\xcc\xe0\xbe\n\xbc#\xce\x12\xf4\xc6\xbeh\x86Up\x8c\xda\x99\xf3\x15qaf\x86$\xf0=L\xe0\xcaW\xc8\x1f\xcd\x99\x19\xb92{\xe1\xabP_\x88S\xaf\xff/pU\xf0/a^\xben\xceK\xd9\\\x94\xa1*\x1e\xbd\xac\x10\xe0\xb7j\xd9\xcae9M\xe8,\xd3]\n\xda\xb7\xdeq!W\xa1\x88](\x7f\x84\x89\x19\x9a\xd1r\x96\x87<\xe3\xb1n\x13\xe5'\xda\x98\xc0-\xfa\xc3m\xc7\x12[\xddNy\xcf\x0e\x02,\xd3\xcc]\xfd\xa0R\xb50\x1d+%\xeb\x98\xd6\x96\xa0\x95\xad3\xaf\xe0\x0eS\xca\x9c\x99sfFf\x08\xf7fl\xbe\xc3\xbe\x02\x1f6\x19\xfc\x1d&\xe4\xd4\x1bf\xadLu{\x8e\x923\xb8/\xc3bb\xa3\x8ed\xfd\x0f\xcc\xcc\xb9y\x8d\xcd\xc2\x0d\x1f'2\x16-\xad\xf7x\xd2\xa67q\xcd2\xadYL\x88\xe3(\x99v\\\xa5\xf9\x11\x16\xe4\xd1),\xdc\x15m\xad1\xc7\xb5*\xecr\x8b\xed\x03c\x1e\xdfbp\x05\xb7\xe6\x82\xc1{3\x82\xf7p\x8b\x08\xaa1\xa4\xa6J\x17\xc8nO'\xd9\x8eN\x92\xbcG\x83I\x83\x00v\xc2e,\xa2M\xf6\x08e\n\xddv\xe5\xc4\xae\xb3\x93\xa3\xa7y\x18\x8a4=\xc9\xe3M\xd6\xea\x08\xd6\xe3m\xc1\xfa2\x8e\x99\xd2}\x96\x88X\x97#\x97T\xcfy,\xa3\x1d\xde\xcb\xc2\x0e\xa7;,\xc2B\x07\x11\xedT\x88\x1e\xb9G\xaa\xf6#ME\xeewB\xf4X\xe40vR\xd4\xe2\x98\xdb\x91\n~%W`\x96\x97\xeaaI\xf3\xa3\xa0\xab\x80v4b\xb8\xe7Y\"j\xe9m\x1f\x9aT\xa7`\xbaa.\xc8\x8dsX\xa01\xe9O13\x04]\xec._\xc9TjU\x8cu\xd4p\xd8s\xc2\x1c)O\xb3'<\x0b;\xae\x8eO\xa8(\xdd\x9a1\x83;\x98`M1\xaf\x99\x0d/\x98\x16\xb9\xea\xa3^\x89\xbe\x97\x1f~!\xe7\xcf\xe0\x033\xe7\x14b6K\x83\x9e\x96*\xc3\xe0/S\xa4\x89\x08F\x7f\x819\x1e\x0e\xc7e\x9f\xa5Y\xf9\x0b\xbb\xfe\xa2\xd5\xda\xb3\xa4?\xe7\xbahmo0\x11\x19\xfcfF\x98j\xcc\xb6:\xf3\n#y\xb5\xf2&\x82\xa7v\xa48\xb4_\x0e\x8dd\"\xc2l{`\xdb\xc8\xa1[\xafN	\x9e\xe7\xb5]\xe5Z\xb7\x15\xfc\x93D\xa4\xd6\xa6\xbf\xd2\x043%\xa1f\xc5v/\x1eX\x0d\xb0\x11Qo\x9c\xd2\xa0V\xa5\xe8\xc4\xc6\x8d\xcd\xe5\xeb\"\xdd\x97/\xca\xf2D\x05E\x0b\x9eRf\xbb\xd9\x053\xd9\xb1\xf2\xd8\x1a\x94\xc2f\xe2\x06I\x17%\xa9\xe0I\xe9\xfd\x85\xf9\xce\x8c\xdc|\x17\xa4\"\xc58\xa9\x7f\xd3\x93\xee\xc7\xcd\x9enK\xc5\x1c\xce\x84\xdb\xb0\xe4\x8e\xee\xef\xebL\x86\xc2KC\xba-\xe0\x0e\xbb>E\xbd\x93'\xcd\x8f\xbb2s\x113\x81+J\xf9\xdbjXe\x9d\xbc{\xac\xb8\x8c\xa5\xab\x1c\x97d\x81\xe9\x86\xf9\xc1\xb9un.6]\xc6f\xbaG\x9c\x7f\xc3\x04\xee\xb1\xc4Y8WEq*\xa2\x1f\xe7\x01\x1b\xd6o\xb1\x1d1\x98\xbb*\xf8\x11\x8b\xa3m\xa8\x14\xbdd\xea\xa1k\x16A\xae\xfa\x98 \xde\xf3O-\xb0\xe4\xf1\xbc\x875\x04\xcb\x111h\xc5\xb0bV\xb7\x9b\x89n'\"\xb5\xbeX-\xbf\xd7\x85VN\xad\x95G\x9fU\x9f\\\xab\xbdX{\xf1\xdf\x00\x00\x00\xff\xffPK\x07\x08\xb3\xff\x16F\x96\x06\x00\x00\n\x0f\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00v}\x96N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/uk_UA/server.jsonUT\x05\x00\x011\xe1\xbd\\\xbc[\xddo\x1c\xc7\x91\x7f\x96\xfe\x8a\xc2\x1e\x0c\xde\x05\x9b%\x1d\xe7!\xd0C\x00\x91\x94\x14%\xa2\xc4\x88t\x84 H\x80\xde\x99\xde\xdd\x0eg\xbb7\xf3Ar\x13\x18\x90H\xcbR\x00A2l\x1f\x058\xb6\x15\x1f\x0e\xb8{\\Q\xa2H\xf1c\xf9/\xf4\xfcG\x87\xaa\xea\x99\xe9\xd9]*\xba\xbb$/\xd2N\x7fTWw\xd7\xc7\xaf\xaa\x9a\x7f\xba|\xa9\xd11q?i\\\x81?]\xbet\xa9q\xfd\xea/\x1bW\xe07\x97/]\xba\xd4\xb0\x9f\xd9\xb3\xfcS;\xce\xef\xdbS;\xca?\xcb\xf7\xec[{f\x8f\xc0\xbe\xb4'vl\x8f!\xdf\xb1\x07\xf68\x7f\x90\xef\xe4\xbb\x8d&OznG\xf6\xdc\x1e\xe5\x0fp\xe0k{\x92?\xc3\xf1#{f\xcf\xecA~?\xdf\x85\xfc\x81\x1bp\x9c\xef\x82\xfdO\xfb\xb9\xfd\x1a)\x8d \xdf\xbbh\xc1S;\xb6\xaf\xed\xc8\x9e\xe4O\xb0!\x7f\x08v?\xdf\xb3\xc7vl\xcf\x1a\x97/]\xfa-.\xde\xb8\xbdv\xfd^\xc9\xfdm\x93\xc2\x9a\xe8H\xb8nb\xb8g\xe2\x0d\xc7\xe0\xa2\x11q\x08\"\x8a\xccV\x02}\x91\xcaX\x89\xa8	iO\xa4 b	\xda\xa4\x90\xe0\xbc\xd4@[\xc2\xa6\x92[2\x04\xa5A\xc0\x96\x897@\xeaM\x15\x1b\xdd\x97:\xadV\x16\xd1\x96\x18&\xb7L\xb0Q\x9d\x1e\x1e\xc4\xbe}c_\xe3\x06\xce\xf3\xfb\xf6\xc8\xee\xcf\xe5\xcf\xeca\xbek\xf7\xed(\xdf\xe1\x03\x1a\xe3?gvT\x9c\xdf\xd7vlO\xb0k\xdf\x1e\xdb\x13;\xb2\xaf\xed\xb1\x1d\x01\x9e\x9e\x1d\xd9c\x9cf\xf7qB\xf3\xbd\xa8V,j\xa3\x87}\x95\xc8\x8a\xc3\xcf\xed\x19\x1e`\xbegO\xf3=\x9fB\xc1\xcb\x0b\xbce;\xaa\x13\xdf\xcf\x1f\xe4{x\x03\xe7v\x8cWO\xb7\xbag\xf7!\x7ff\x8f\x81n\xba\"\xba\xef1\x90\x85\xca\xfc\xcaDY\xbfb\xe1*\xb6\xc1&7\xf2\xa2<\x02L\x07h\x02\x9e|?KT\x00B\x87\xb0\xa9Bi`\x10\x89\xa1\x8c\x13\x9ftj\xba\xaaS\xdb\x192`\xc7\xc86\xfe\xe6\xb3:\xc0\xb3z\n7n^\xc7\x8d\x15\xbbt\x83\xfd=\xce\x18\\.\xd6\xee\xaedi\xb5\x05\xfc\x80E\x11ltc\x93\xe9\x10~\x85,:\xd2\xd4\x97\xf6$\xb4\xab~\xda\x82O\x8d'\x14\xe4.\xa0t5\xec+\xfd\xc3d \x03\xd5Q2\x84\xbe\xec\xcb\x04\x06j CH\xd2X\xa8n/\x05\xa5S\x03C\x93\xcd\xc5\x12\xfaF\xab\xd4\xc4\xdeB(\xf7\xd7\xb6\x07*\x1eV\x8b\x91.Hj\x84T\x95wp;\xeb\xb7e\x8cw\x10\x8aa\x02[*\xed\x99,\x05-\xb7``\x924\x81\xb6\xec\x98X\x82\x00\xa2\n*\x81PF2\x95\xe1\xc4z\xb7\x85w\xd9\xf6[\x14%<d\xb4\x08\xa8\xe5\xaf+Q\xf3\xfa\xce\xe8\xda\xc6\xf9s\x12\xe4\xfc\xb1=\xb6G-\xb0\xdf\xd8\xb1}c\x0fP1\xf6\xe9rG\xf9\x9f}\x81\xffp\x01\xed\xc4\xc8\x9e\xd8\x03{\x96?\xc1Y(\xa5'<\xc6\x9e\xe5\x0f\xbc\xc6|\x8f\x05w\x82\xdfu\x95F\xb2\xae\xc2\xafH\x1f\x89#\x14\xef\x92#\xdf\xdeM\x8c\x99\xb5\x01l\x1d9\x85uz\x0ds\xa5\x9c\x1e\xb0\x01\xb4\xdf\x90\xfa\xbc\xb2\xa3\xb9\x16\xd8oq\xb3/\xf3=\xb4z\xf9c{\x00\x1f.,\xa0\xf1<\xb2\xa7\xb4\xd2I]\xbf\xda&\xf4o6\x1c:\x0e\xd7\xe5v\n\xd8\x89\xf7\x89\xc2\x88WXM\x0b\xc4 \x0dz\xa2\xda\xf4\xd7\xc4\xe7\xa3\xca\"\xbd\xb0G\xc8\x1d\x9f\xf41]\xdc\x11\xdb|R\x98G\xa4\xfc\xbc5 \xbdy\x95\xefQ\xff}6\xe2\xf9sg\xde\xd1X\xec\xd3\xfc\x03t\x07\xf9\x1e\xe4;\xf9C\xfa|\x9d\xefN\xb1\xb4.\xbaI\xc9\xd6\x12\xb7A\x8a\x8dnc\xa2\x9b\xa0\x95NL\x16\x07\x12:&\x067\x13\x12\x13e\xa92:i\xc1\x1d-q\x12\xcah,th\xfa\xd1\x10\x82\x9eI\xa4\xa6\x19R\x04=\xe8J-c\x91\xca\xb0 \xd0\x82\xbb\xf2\x0f\x99\x8ae\x88\x0b\x04F\xa7\x02=@\n\x91\x14I\n\x1fA\xa8:\x1d\x19K\x9d\x12C-\x8f\xf7\x9e\x88\xd7\x02\x13WR\xb4\xd4\x13\xb1\x08R\x19C2\x10}H\xa8\xd3i\xb5N\x155nIR`\xd3\x81\xbe	Ug\xa8t\x17\x04\x04\xe5Lr?xo-\xb8\xda\xc1\x06\xb9\x1dH\x19\xe20\xbc\xd1H\xf5UJ\xbf\xb2D\xc6\xb0\xa5\xa2\x08\xb4d\xe6\x13\x13m\xa2\xa6\x16;\xf3X\xcd\x92\xd4\xf4\x97\xd6\xd6JV\x1d[\x1f'2\xfea(;J\xcb\x10\x96\xd6\xd6 \xce\"\x99@dD(C0\x1aR3(\x84)\x91\x91\x0c\xf0\xe4\xd2\x9e\xec\xcb\x19\xc4\xd7M\xb7\xeb\xe9\xd4\x12\xb5#\xd5\xe2\x16\xa9\x1f\x19G\x92A\xd5]\x92\neGdQ\xea3j\xbf\xb2\x07\x88\x14\xecI\xbe\x83\x8a\xc5H\xe4\xb4\x92\xd9\xff\xe0O\xc8\xff\x8c\xae\xf0\x90\\1:\x82\x1d;\xb2o\xf2\xdd\xfc\xab|'\x7f\x92?@pr\xce\xce\xb2\xa4\x97\xef6I\xcc\x9d\x17\x9e)\xf2\xce!\xb3\x93\x1e\x11\xfd3;F\xe5D\xf9~\xda\x9ab\xfe\x96\xd0\xdd\x8b\xb9/<U\xc1\xfd7\xfc\xe9\xb8Gk\xf7\xda\x8e\xedKT(\xfb\xc6\x8e\xde\xcd\xbd\xbfv\x82\xc7\x19\x8bnu\xfe\xcb2\xc9\xd6\\[\xe5\xe5\xf3\xc7\xf9.Z\xaeCo\x19\xb2\xa2\xbc\x80G\xa8e\xe2\xae\xb7\x82JD;\x92wM\xdb\xa4\x95\xae\xae\xc6r\x13U#\x88\xc5V\x84\x8e\xbaYkFo\xdd'm\xdb\x92\xedD\xa5\xb2\x1c\xd8\x84$\x0bz \x12H\xa4\x88\x83\x1eH\xddUZ\x82\xd2\xa1\xdc\xa6\xfeNl\xfa \x82@&	\x0b\xbfJ\xd8\x07\xb5\xa6\x98B)&\x07W1\xb6\xcc=\xa0\x8d\xfe\xa1@\x8f\xea\xfcW\x10K\x81\xe6\xa2\xceh\x02B\x0fq1\x93!\xd7\x03\x11\xa7\xbc>\xca\xfd\x1c\xcd\x9f+\xbb\xa9\x83\xe9\xe8.y\xca6\xaf]\xb1e\xf4\x84k9&\xc0\xfb\x88\xcc\xebQ\x89\xa7|\xc7rL\x82\xe7`\x17JA\xd9`\xdfV\x13\xca%\xc8\x8a\xb4E\x14\x95\xeb\xfc\xcbO\xf0\xb3\x98}\x8er\x85\xbf\xf2\xbdb\x95\xef\x1d\x16'\xc751\xca\x1e\xe0*\x8c\xe1\x1d\xa1\xfc\xa1=\xc8\x1f\xb3~\x9c\x92\xd4\xbf\xf6]\xf3)[\xfe\x82gv\xcb\x0b\xb3	\x93O\xf8\xd1\x02v\x1f\x92s<fGrH\x0e\x15\xfd\xf8\xd8\xbbT\xd9\x17*\xba\x16\xc7\xe5\xce\xaea\x03\\\x8bcS\n\x983%4\x14oAR\xe7\x0c\"+BEuB\x8e\x02\x91c\x023\xa6\xad\x8a\xc4\x13r\x91$[&\x0e\xa7g\xc2\xc0u\xcd\"a\xe2tb\x0b\x89\x8c7e\x0c\x03\xec\x99\xa6\xe5\xf5\xce \xb7\x96\xb5gR\xbb\x98P\x92\xb5C\xd3\x17J\xfb\xd4\xb6{\x12=\\E\xaahxo\x0bQ\x90\xa8\x9b\x07\xb9=\x10:\xbc\xea\xc9\xa3\xfd\xab\x1d\xdbC\x04L\xf9}{\x96\xef\xb2\x9c|\x81!\x05\xd8\xe7u\xda\xf6,\x7fVp0s\xda>O;\x9c\x9e\xd6\xc2\xb8rd\xdf\x92\x0d\xf7B\xca\x9d|\x17\xee\xc9v\xbf	\xab\xcb\xd7!\xdf\x83\x95\xd5\x8f\xd8\x92\xe7\xf7\xab\x15\x08\xed<-llk&c\x80\xb1+\x03\xbd#?\x18\x1a\xe5\x0fI\xb2IC\xde ~\xdbEp\x83\xc4\xa6\xd7\xd8!\xaf\xf2\xacv\x15\x03_B\xec\xbfcxM\xc4\xefW\x96\xa1\xd6\xe8\x87-gv\xe4\x80\xd7\xd8\xbe%\xd4I1Y\xfe)\x9fE\xb5LG\xca\x10c\x12\x96}\xcf(1<\x1b\xa3_r\xde%\x7f\x8c\xac\xd6%\x81\xb0\xea\x05n\xd1\x85\xfc\x875RO\xec\x18\xb7M\xcd\x18\xacb\xe8\xefq\x13\xd5\x90\x1e\xda\xd3x\x08\xdc\xda,\x0c7\x06}\x04\x81d\x0c\x81?\x04\x01	E%\x1eA\x13\x072\xbc\xaa\x8d\xae\x05\x1f\x84\x9d9\x850\x06?\xfa\xc5_\xc5\x0e\xbf |\x8d }\x94\x7f\x05\x14\x18>\xbb\x10\xf66\x11\xc1b\xf4{N\xf7\x8d\xa1>\x1f\x0e\xa2\x8f\x03'\x7f\xc5\x91x\"R\xb1\xda\x15Q$\xe3\xe1\x8a	+\xe7p\x83\xdb\x80\x1a\x99\xad;:\x1aB\xd23E\x00\xe6 )Z9\xd5\x17]\xe9m\xbek\x8c\x0f\xb8\xec\x97\xf9\xae}e\x0fp\xe3\xef\xa5\xcf(\xd8\xaf\xca9{\x15\xe1\x9e\nC\xe9\x9d\xe8\xf7\xf9\xc3\"\xc0\xb6\xe3+\xb0PP\xff\x8e\\Z\xe5\x06\xf2\x07\xd5@\x8a\xc4\xdd9\x1c\xd5H\xcbE\xa5\xb5\x0cK\xf2?S\xa1\x84e\x8e*a\x95\xee\xb7Yu\xa0/\xe2\x93\xa0\xc4MOlJhK\xa9\xa7\xe3P$]K\x0c\xd1|lA\xc1\x99\x17Q4\xef\x13f\xaf\x8d\xa8\xbe+CD\"e>\x08\xa3\x05\xca\x00\x95\x18\x80&C_\xa6\x82f\xd5\xd7\xbc+\x83,N\xd4\xa6\x8c\xaa\xa8\xacl#\xde\xa1\xa7\x10\xc1\xcf\xdc\x96KH\xc5r\x10)\xc6\xf1\x02\xf8\xfcyj\xecQ\xaf-\xbb\xde\xcb\xfa\xedJ\x99\xec\x0b\x12[w\xfe\x93\xc9\x0dO\x1e\xa6\xf3<\xc7\xa4\x1f\xe7\x94\xa5\xfb\xcdZ\xcfl\xfd\x96}\xb3\xf3\xf1d\xf9+J\x15\x13&V\x7fD\xe9\x8c\xf0\xd2\x94\x0f{\xbfd%\"\x99c(\xee\xf2y\x1e\x98\xc1H\xdd\xbe\xf2\x05\xd55\xb9\xf4\x12\xc2\xe5\x8f~\x12\xf4\x84\xae\x96T\x95\xd4\xdc2]U\xc08o\x00\xaa\xc8\xcf\x0c:\xc6Y\x8e\x88Xa\xab>\xad\x0f\x15\x1c\x9b\xe1\x03\x9cAwv\xae\x0e\xcc)\x1e&`\xb3\x8f\x01n\x19\xf3\xa2u\xf1r\x02P\x99\xc7\xd6\x04\xc7w\x8dI\xefl\xca8V\x9eq\xb8\x89=\x10\x1b\x93\x82)\xfa\x98\xc5\x9b\x1d\x18\x9a\x0c\xb6T\xd2C\x81\xe9\xa1\x9c\xb0qp\xb0\x19\x129\x10\x18\xe7Bd\x02\x02\xbc\x10\xa9\x0d\x0c\x0e\x97\x96o7Aj\xb4\xad(\xd8\x9d,\x8ax	\x11\x86\xb1L\x12\xe8\xc9X\xb6\xe0\x96D=\x93\xfdA:\xc4\x150f\xc3\xe1.\xc6)\x06\xb7\xe0\xda\xb6\xe8\x0f\"y\x05\xe6zi:H\xae\xcc\xcf3\x1f\xad\xbe\xecf\x81@\x9007\xb1\xd7z\xcc\xcc\x9b|\x9fxy\xc0B\x06B\xf3^\xff\x9e\x01\xb2\xea\xd7\x9d\xf1g\xf6t\xd2\x15{M\xefv\xc4\xf6\xb0t\xc3\xbe\xebS:RZ^W\xe9\x84`\xe6\x8f\xedQ\x99'y7\n\x9a\x01\x7f\x80a\xd1)\xc6\x17\x85\x11fQE\xe5\xbd\xcfz|b\x0f\xec\x1bv\x85\x94/\xae\xf3<\xb6\xc7\xfeI\xfc!\xac\x80\xe6\xcd_./\xbe\x97'!d\x88S\xeb\xa8\xf0\xf7\x82R\x93\x95\x91\xfay\xd1\xd0\xf4?\xcb\xd0\xea\xe6r\xd2\x82b\x0c\x04B\x83Ao\xc8\x96\x9e\xcd\xa5\xc7jT\x8b\xb4\xbf\xa9\x07\xd6\xcfkg\xc2aw\xbe\xcb\x85\x07Nd\x7f\x8an;\x7f\xe0\xdfQ\xa4\xf4F\xe2\x83\xa41%\xe0\xcf\xf2\xbd\xfcq\xe9\xcf\x10Y\x14V\xa4X\xed+W\xb5\xa8R\xe7\xf6\xc8\x951\x9e\xf1\xa5PZ\x8c\xf2_\x18\xf4\xf8\x12t\xc8\xab\xe0\x1a\x9e\xd7\xf4W\x81\x9f\xfe\xf4\xa7\xf3?FK8_\xf1\xda\x17I`*aZ\xe1O\xe6\xc7\x85G<\x04B\x86T\x93SI\xf3\xca\xf9\x85e!ut\x1a/\x12R$\xb7\x927=\xcd<\x0d^\xa1O\xc2)R\x17\x1c\xdc\xd4\x1d\x13\xf7+=\xc4\x1fe\x0e\x80\x11\x0d\xd5d\xbc\x99\xe8\xa4;*NR\xd8T\x89\xaa\xad\xb7\xfd3\xb2\x02\xd5\xcd\xfc\x85\x8b\x0e\xf9\x0e\x9d4\x06\xb5\xe5Y\xe6Og\xc7\x15\xdfP\xdc\xc99\xd5\xc2\x1d\x8d\xfc\xe9\xa3\xd9\nV\xc1\xdcZf\x89\xfa)\x1e\x98\xf6#>\xe7k\xea\x8fr\x16\xdf\xbe\x96\"\x0e\xbb\xd0\x1b\xcd\xe2\x1b\xdd\xe8\xa4\x9aO\xa9\xe4>\x102\xc5\x00\xfb%\x9a#\x17\xb2\xfc\xeb\xca\xe2\xbf\xd58\xbc\xa7\xc2\xb47\x93E\xb6LG\\\x98\x9bq6\xef>Zo\xfa?\xe2he7[\xffU%\x84\xfc\xc9\x0c\xadb\x00\xc1)`FyE9%\xe0\x9aL\x02J\x93K\xa5@\x83JL\x93\x84\xd7z&N\x83,\xfdG,`B\x19\x8b\x9aY\\\xa9\x9a\x9a\xf5\x86\xbai\xac\xc6\x91q\xf4\xedb\x13\xdaB\xbb\xa8)\xa1\nZ\xa8\xc8_f\x08\x12\x8a\xf6\xf6\x105Q\xc5\xd0\xd7\xb2o\xb4\n\x88n\xc5\x9b\xaeUq>\xb3\xa7s\xd5-\xf3\x97C23#\x1c-\xb7\xca\\IA\xe4\xb6\xdc*\xb3$Sh\x0d'\x98$\xf5C7\n\xb3\x11%\xbe\xa8\xe7\xc8\xbe\xa8'\xc5\xca\x88|fvL\x9bT\x05\xdeF^p:\x8a8>\xae\x15`\x1d\x9e;\xb6\x07\x17\xd5\xa4\x0f\xc0\xcbf\x8d\xed)W\x9d\xd8\xf7r\xca9\x7ff\xf7\xc9\xd0{\x98\xd0\xd9\x16\xf43\x0f\xa9\x10\x9e\xef\x10v@\x97t\xec\xc7C\xc8jG1F\x9bbxjEV,\xd4\xfc\x97v\x8c\xc1\x17\x1a\x02t\xe5{\xf6\xa4\n\xcavH\xed\xeae\xce\x8b\x08\x16\xe9\xef\xfc3:\xd82\xca\x03\x9a\xfb\xb8<\\ 32\xe6\xaa\xd4\x19\xd5r8\xa4>t\xa5\x1f\x17\xf6>\"\xbc\x81\xf8\xe2\x89/Wf\x0bu\xa6\x16#<\xb7#R\xedC\xe6\xceE%\xde!.\n\xad\xcb\x04Wm8-\xfe\x8a\xaaN5\x1c\xbe\x97?\xf0\x8fi^\xcc\xdb\xd7\xf9\x1en\x86\x99\x97\xa1\xd4M\xbe\xe8\xc73_!\xb8l\x0f\xbfb\xa0j7\xe77\xdc=V\x1b2Q8%\xeaw\xa2\xf0bQ/\x10\xfc\xd2\x8c\x8aW\x81\xfc\xa1(}\xadW\xf9\x90\x151\x18 \xb65\x9d2`5T\x89\x9ap\xd2E\x1d\x8c\x8a6\xf8]\x16\x90'\x93\xe6fKK\xcf\xf6\xdc\xe1\xcff\xf5Q\xb79\\9\xe6I\x1c}S\xcc\xc0\x99y\nW\xa3\xa8H\xae\x1b\x9d\xc6&BC\x95%\xe9t1\xcdh\xe9\xf11\x98<\xbf\xd5\x8b\xce\x0e\xad\xd7\x92K\xdc\xd7\xa3\x07\xb4\x1eeN\xff\xbd\xa2\x882q/\xca\"\xf7\xdf3\x90@z7	\xe9_\xa3\x8ch\x05\xa5\xa9\x91\xd2\x1bpK\xe9\x0d\xa0\xfe\xa4*E\xb8\x01\x08Ae\xc8\xa1\x7f\xa6C\x07\x9d\xe8\x13\xbb\xf0z\x83H\x05\x1b-\xb8\xd7\x93\x1a\\\x05$l\x82\x16\x9b\xaa+R\xc9\x02\xd2\xabSR:I\xa5\xf0\xa5`\x10gZN\x14MV\xb1\xad\xa8f0W\x9c\x96)E\xaf\xcc\xc0h\x83?B\xaa\x9bT\x8f\x05:&\x86\xdb\xf4\x92`b\xa1\xf5^,\xc5\xd4J\xa9k\xad-\xe5\x1a/X\x8b\xd7Qzz\x99aVR_\x8b\xb2\x14\xa4\xc6\x83)\xcc\xc7\xb2\xd1s)\x88d\xa3\x9a\x11\xb7\xd7\xe5v\xe5{\xee\xcap~1\xca$P\xab\x9b\xc5\xc8\x19b\x19\x92Kmc\x7f*\xb7SP\x1d\xdcl_\xa4T\xdcRi\x0f\xe6~\x17\xcf\x81\x89a\xeewm/\xe2\xc5\xbd\xdc\xd1^b\xc8\xfe\x85r\x02\x07\xe0\xaaA\xb5W8\x13\x19\xc9\xc9*z\x95Y\xac\xbf\x07\x88eW\xa1\x7f\xf7\xf6\xe2\x1a&5)\x96\x91H\xd5\xa6\\W\xbe\x9fg\xdfzFa\x07\xd5\xea\x1fQ\x06\x95<\x06\x83\xc87U\x19\xe9\xbd\x06\xd7\x18m\xc1\xb5\xed\xd6\x15\x98\xb3_r\xe2\x14\x03Z _s\x9a\xef\xd6\xcej \x85\x7f!\xf49\xbd\x85A4\xbc[\x0b\x07~\xe3\x98:\xf7\xdc\xfc\xd1o\xe9\xf5\x19\xb9\x8c\xfdzV\xfbA\xbe[\xc5\xd4\xb5\xdc\xd7l:\xe5{\x0e\xa44\xf681&\xfd\xf8\xee\xad\x8aacR\xc0\x86f\xed\xb3\xa8\x9fS\xd2\x82\x8dq\xf5\xe6\x005&1}\xd7[\x14C\x07\xb1A\x1c\x19\x93.o\x99x\xc3S\\\xaa\xd0{H\xc1\xf1E\xe1b\xb9K\xbf\xd1{\x823\xab\xc2\xfc\xb4r\xa0\xfb\x93\xcf\xef\xe8\x86\x8a\x07xx\xd9\x15\x1b\x89\x1f<\xaeU5\xe6eC\xfa\xda\xce\xfa\x03\xda5\xabsa\x93\xbc\xe7\x04\xec2\\F\xbf\xb4\xe1-\x7f\x85\xc8C\x86\xdf\xf3\xeb\x98b\x87\xee\xd3{wx`\x0f\xed\xb9=\xc8\x1f\xb9\xe0\xa1\x9e\xae\xe7gk;\\\x81?\xa3\xa4=\xc1\x17\x1aN\xefw^\x91\x92\x1dTOYZ`\xffj\x0f\\\xfd\x13\xfb^{\xe8(\x7f\xc8X\x07\xecK\xf8q\xfd\xad\x8e\x8f\xee\x12\x91\x05R\x0b\xe3\x9dT\x16\xc8\xdb\xc2\xf8\xf2\xf8\xee\xac\n\x144Z\x81\xe9{\x94e\x82\xcec\xe2\x99\x97\xfd/TF\xc0\xfd\xe6\xcf\x91\xab\x03z\xc2\xf7\xbcX\xceu\xef\xd3\x9b\xc1\xfc\x99+gq\xdajd\x8f\xc9\xf0\xa0r\xec\xb8r\x16\xa2\xdf\x91\xddgeue\xacG|\x00\x1c~\x9e\x10\x10\xdb\xa3#=\xf3\x95#\x19\x18\x15\xf98\x83\x1ej\x1c3\x10&[\xc1\x85-\xaa\x9c\xe0\xa9{F\xb02\x81$\xc3^E\xa6\xcc\x88\xfc\xe0\x07\xde\xd5\xd3\x13U\xbe\xe1\x1a\xcd\xba\x95LR\xd1\xe9\xd4\x9f}\xada\x13p[\xdd\xe2\x0fM\x16\x03\xcd\x80\x14\xbb\xd1\xe5\x94\x8e\xb8'E\xe8Gt\xe8\x11.0\xf2\xe5\xb3\xd9\xd9&~f\xe8K\xa1E\x91A\xdc\xbb\xf0]\n\x92\xf1x\xa0G9\xb5\xc3\xf6\x1e\xc9\xe0\xaa$[d\xf4\x96\xd6\xd6\xdc3\x1a\x1f\xd2\xb2\xa6\xb2@\xad\x88\xed*8\x15\xdb\xaa/\xa2B\x93\xff?o\x08\x1d\x89Y\x8f\x08k\xab\xab*\x14ZQ\xfa\x9f\xb1\xfa\xec\xd7\x80\x17\xbd\x04\xf4\x03G\x8a@O\xcb\xe0\x92>\x0fk/\x05k\x14\xaa%)G\xb7\xc6jR\xb7\xe7\xae\x8a\xc3W}A\xb9\xc2\xcf\xbf\xbc \x05\xc2\x0b?\xae\x1c\xdb\xf9{\x12\xf2\x93*\x88t\x17ox\xc0\x80C\xb9\"\xe3\xf4)\xda\xcc\xe9X\xf6]\\\xec\xbf\x17\x85	\x06ff&=f\xa8$=\xa2j\xce\x11Q\x18\xb9\x88\xefo\x91\xdf\x12i\xd0sX\xf4\x8e\xbe\x8bH\xa2\\\xe6\x1e\xf6\x95\xe8\xd3h\xaa\xc8\x15/+\xaf\xf2C&\x15\x88(\x1a\x82\x08C\xcf\xaf\x11Q\x19NO\xad\x96\x95\xed\xfe{\xd5\xa4p\xa0[\xf1\x9el\xafp]\x7f\xb2\xf2\xf4\xf4\x7fUy\xf2\xb8\xe8\x89\xf4\xaa\xf6\x81\xdf\xbd\xb2\x85\xd7\xc4\x11\x02\xbf[]\x01\xf4\x03\x92 \x96R'=\x93:lR\xd1\x1b\n\x1d\xca\xcaL\xfc\x9a?\x9b\xdeG\x0d\xd4x\x8c\x98x\xa3V\x05\xa7\xd3\xf0\x12\x1a\xf7\xa9^qdO}<S\xca2\x9b\xcd\xd9\x95\x90Y\xe26\xbd\xf0\xc4C\xc5\x7f\xc2\xf2\x97/}\xd2\xbc|\xa9a\x06\xf4T\xb5\xf8\x13\x0c\xcd\xef\xc4\x1a\xf6\x0d\xa1\xae3\xae\x0d6\xd8[\xd1\xc1S\xef9\xbf\xe3\xb2\xaf\xa8pS\xa4s\x80<\xcb}\xf2\x1d\xfc'\x19\x8d-\x97\xc9\xbdpJ\x95\x92\xa5j?\xf1\x94\x988\xc5C)<u	\x12^\x92\x8b9/\x88\x17\xcd\x87P\xa4\xfeh\xe3O\x18=\xcd~uVL\x9a\x8ed\\\xf7\xd7\x0c\xe3\xd0{\x17\x95\xe6\x19\x0f\xc8.\x1c\xec{\xc9\xc6e\xba\xe5F*\xda\xd5V\xbe,\x8dp\xf1w\x17\x08\x1dw\xf8q\x88\xfb~\x17\x04\xe3!\xd73\xed\xc6\xfe\xb7S\xd8c\x17\xb7\x15k\xca\xfe \xc2\xd8\xbb\xb8\xd9\xb6\xd0\xab\xbe\x0d\xfb\xb5\xc9\xbc\x97\x0bm\xa15b\xff\xd8\xf4a\xfe\x83d\x1e\xdaC\xf8\x80\xa3g\xaa\xc9\x9a(2[Jw!\x96\"1\xfaJ\xa1T\x88I\xdaBs.\x82<\xa1Dk\xf3A\x82q\xa7\xc2\x1f\xad\xc2^\x05\x81\x899\x95a\x80\xa0\x0c\xbd	k2\xae\xb9\xb9\x8aN\xf0\x03\x97\xf8ur\x90\xa9\xfa_\x065\xf8\xefu\xdc\xd3\x81\x86\xcb	Q\xc7\xe75\xa4X\x84}gd\x81\xde\xf0oB\x90\xd6\x7f\xf9\xdf\x10aH\xb3]\xad\xac\x8c{\x1bb\xc0v\xd8)\x9a}K\xd0\xb4\xeaN\x12\xd5\xd5\x04\xd5p\xd0U\xfadl\xc6\x03\xda\x82\xf4dQ\xe8\xf2[\xcbxm \x83\x84&\x04\x81\x1c\xa4	d\x03<\x8c\x1f-\xc0\xcfW\xaf\xddh\xc2\xea\xed\x1bM\xb8q\xf3:\x9e\xdd\xbdk\x8b+\xd0Q\x91d\xfc\x00}\x84<Y\x1fB\xd5\x97\x94\xa3I\x10_|\xb4\xb0\xb0\xfd\xe1\xc2B\xb3\xec\xc6\x19\x90\xa8?\xd2{\xe6\x0f\x17\x16\xe0\x17\x8b\x94&\xd0\xf4R]\x87-\xc7\x0fmn\x91}\x89\xf7\n\xbfx\xe9\xee\xda{Bw\xa5\x97T\x9c\xaaa\x9eS\x06t\\\x89n\x10\xe1\xd9\x048\xb6\xf8\xe9\xda\xa5\x88i\x01\xfa\xc1mFwT7\x8b9\xe7C\xe4\xbfu\xa9\xda\xc7\xf5\x8a\xb6\xbb\xb6B\xef\xcb\x89k\xfc\xde\xf0\xe2\x99\x14n\xdc\xe7l\xa9\x9b\x8bQ\x9d\xb7\xe2\xf7\xa5\x198\x9a\xb9V(R:\x98e\xfc\x9f[\x08\xa9\x95\x14\xfct\x94?\xa0@\x0c\xc5\x00r<\xfe\x00Wf(\xfa\xe9\x0f#\xb8;\x8b\x8b\xdc{c\xb9\xf8M=\xac`$B\xd7\xdcOj/^\xd4\xd1\x8e8a\xf0\n\xcdG!\xaf\x9d,\xd8\xb8\xc3\xb2z\xfd\xe3\xa5_\xc0\x9d\xeb\xd7\xb9\xa3\x1b\x99\xb6\x88\xb0\xfd\x06\xff\xa2Vz\xd2\xd2\xb8\xb9\\|I\x9d\xaa\x94\xd5\xe1;\xca\xab\xbct\xc0\xdf\x19=7.\x8ad\x97\x89\xdd\xe4\x9f\xb5\x02l#2\"\\\xf2$-R\xc1\x06\x8a?\xb6\x17i\xcbj\xa4\xd2\xdd)u\x11S\xca\xf1\xb7t\xe3\xa3\xff\x83nD\xa6k2\xb6*\x18\xa3\xbc\xad\xd4\x9e{\xf8E\xa9\xd7Y\x94V\xca?M+\x03#\xc4G\xcf\xf2\x87<{\xa2\xac\xd2\xb8\xed\x7f\xd3\x88\xca\x177\xecw\xf6\x9cc~\xd7\xb5\xa5o\x9b27J\x8b\xbb?\x028\xe5\x8a\x03\xfe,|6\x15*\x9d\x1c\xbbM\x0d\x9c\xb0\xad\x96R6\xc8\xe2n)\x83\xab\xf8A\"8\xef\xc9)\xa3\xa4uc\xa2T\x0dHxT\xc4\xaft\x18V\xb6\x87\x90d\xed\xdf\xcb m\xba\xbf\xf1\x89]\x0eE\x8b\xbe\x04\xa9\x03\x91\xf0\x9f/\xa2p&\x91Hz2iAq\x9dwe7\x8bD\x8cn#\xe6\xd4\x81s\x16\x8dD\xa6\\i\xa1\xcd\xae\xc9\x94\xfd\x93\xab	`\xf7-\x16\x91\xa2\xdbI\x0c!D\xef@\x93\x9e\x08\xcd\x16\x0er\xbf\xbc\xd6E\x8a\xe8\x1ak\xf4\x01mULq\xd8\xa3R%\xaa\xc5\xd7\x9f\xd8\xd0\xd5\x1ep\xfa\xea\xb0\xf0\xe2.\xbdP\xea\xbd\x8b\xa3|\xc5w\xa7E\xb4\xfdp\xb8\x91\x0cuP\x9a#z)L\xb8\xc6\xfdu%=X-\xc7-\x15N\xefc\xad\xfe\x90\xd1[\x05\xcd\x7f\x05#\x82Tm\xca\xf9\xd4\xa4\"B\x8f\xca\xee\x91&\xa6\x9cWn\x94\x99\xe4F\xca\xb0\xbb\xb1^\x80\xedF:\x1cp\x0b\xfeO-\x99v\x8e\xecc\xfaq\xf9\xd2'\x97?\xb9\xfc?\x01\x00\x00\xff\xffPK\x07\x08q\xc5=\xc9$\x15\x00\x00\xb7;\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xb8\x8cwN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00$\x00	\x00sql/functions/assert_can_perform.sqlUT\x05\x00\x01\xedn\x96\\l\x90\xcdj\xc30\x10\x84\xcf\xd2S\xec\xc1\xe0\x04\x92@\xcf\xa6\x85>I\xd8HcG \xaf\\\xed:\xcd\xe3\x17\xff\xe4P\x88.\x92V\xfbiv\xe6|\xa6oUT#\x0e\xa1\xccb\x14XhB\xedK\x1di,\x11\x95-\x15!\x0e\xcb\xe6C\x05\x1b\xa8\x9f%l\xe5\x15\xbe\x06\x96\xeb\x0e\x1d^\x1f\x19\x9ev\xa2[\xe1\x1a\xf7s\xc6\x03\x99t\xe4\x9c\x93\xd8\xd1W\xd8\\E\xe9QR$Vj\x1a\x1f\x112Wx\xb7\x8cq+%w\xfe\x86!\x89w\x8a\x8c`\x84gRS:\xec\xd7\x0f\xef\x96\xd5\xd72\x92\x1a\xf7=\xe9V\xf9\xbd\xa3\x82\xf4\xb2\xe9'\xa1C\xcb9\xb7\xa77\x13o=\xc7\x8ds,\x91\xf42\x15M\xab\xc1\xaf\xcfw\xc4\xea\xe4\x1f\xf0r\xfd\xb6}\x7f\\$\x92XY2\xee\xbcK=I\xd9\x02\xb7;\xc4;W9)\x08\xcf\x80i\x15o9\x04\xa8R\x84$\xc4\xb6\xf3\x0e\x12)\xf5\x9d\x87\xc4\xce7\x0de\x96a\xe6\x014\xe5i\xd0\x9f\xdc\xf9\xbf\x00\x00\x00\xff\xffPK\x07\x08A\xbd\xd5h\x05\x01\x00\x00\xd2\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x89\x93\x96N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00sql/functions/bump_thread.sqlUT\x05\x00\x01\xc3\x07\xbe\\d\xcfMN\xc30\x10\xc5\xf1\xb5\xe7\x14o\x91E\"!T\xb6\x98\x9c\xa5r\xecIb\xc9\x9d1\xfe\xa0=>\x82\x16\xb5\x15\xdb'\xeb\xef\xdf\xf8\xc2\xae1\xb4\xa0pN\xce3\xd6.\xbeE\x15,\xfd\x94\x8fm/\xec\xc2\xa8\x19K\xdc\xa2\xb4\x97\xdb\x1cO\x8cE5a\xc6\xeaR\xe5\x89\n\xb7^\xa4\xe2Kc W1\x0c\x14\xd8'W\x98\x8c\xe8\xf9\xd8%^n\x11\xbc\xcf\xe0K+\xce\xb7\x91\xb3\xfa\x1dk\xd1\x13D\xcf\xe34YZx\x8bB\xa6\xe7\xf0C\xbb\n*\x19S\xb9\xe1:^\xff\x9f\xf1\xd7%c\xce;\x17F\x0c\x98\xa1\xd9\x92\x89\xeb\xe3\x01\xafw\xb5\x93\x80\xac\xb5\x1d\xbdvi\xe3\xe3#\xcd\x13>\xf0v8\x1c\xd0v\x162\xff\x0d\xbf\x88{\xec\x89\xf0hx\xceZ2,\x01q\xb5\xc4\x12,\x0d\x03\x92\x93\xad\xbb\x8d\x91S\xde\xeag\xb2\xf4\x1d\x00\x00\xff\xffPK\x07\x08s\xdcw}\xe3\x00\x00\x00\x8b\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xdd\x9c\x8fN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1f\x00	\x00sql/functions/delete_images.sqlUT\x05\x00\x01\xd2\xdd\xb4\\t\x92\xe1j\xdc0\x10\x84\x7fKO\xb1\x84\x83\xf8\xe0\x08\x84\xfe\x8b9\xfa \xa5\x98\xb5\xb4\xb6\x95\xca+UZ\xa5=\x9a\xbe{\x91\xac\x14\x92\xd2\xbf\xe3\xd9\xd9\x9d\xcf2\x89P\x08B\x82D\xd1\xa3!X\n\x1bq\x81\xc1\x92'\xa1\xc9\xed\xb8R\x1e\x9c\xcd0\xbb\xd5\xb1|\xf9z\x014&\x14\x16\x10\xfa)g\x9dHJ\xe2\x0c/\xc1Y\xc0\x0c\xa7\x93\xb6d<&\xd2j\x0e\x98l\xf3\x8dZ\x99\x8d\xcc7\xb2S\x133<\xe7\xc03\\\xe1\xfe\xd7\xef\xfbQ\xab\x18\xb2L\xce\xf65\xa3\x9eiu\xac\xd5\x12\x12\xa1\xd9\xe0\xed\xb3c\xc0\x94\xf0\x06\xf5$\x1fB\xd4Je\xf2d\xe4\xb0\xb4\xf0!>8{\x06\xc7\x12\xa0	Z)\xb5\xa4\xb07O\x86:\xa4~l\x94\x08\xaa\x13\xaeo\xf1UW\xc8\x16\xe2C\xde\xf0\x11\\\x06\x0e\x02\\\xbc\x1f\xb5Rn9\xe2\x9a^\xbc\x07\xd9\x88\xeb\x8c	,\x8e\x0bU\x13\xb1\x05\xb7\x8c\xfa\xf0\xd7\xf1\xf7\xc5?w(}4RZB\xda\x01s\xa6$\x93A\x9e\xba4t\xce\x97c\xe9\x05\x1e\x9f\x9e\xf2\x8e\xde;\x96\xf3\xd8\xd6\xbe'z\xfd\xb0	^_\x0f\xca\xd3\\\x9c\xb7S\x98\x9f\xc9\xc8\xd0\xd3$\x15:\x7f8\xb8D[\x1f\xc4A	;\xa8L\x02\x8d\xc6\xb5\x95\xfe\x1f\xbb\x06\x88k\x89\x03\xfc\x1e\xec\xe4\xc3\n\x83\xdc\"\xfd\xed\xd0\xcd\x17\xb8\x9bow\xe7\x9a\xf5\x82\xbeP\x86\xe1\xd3\xbf\x9e\x0e\xa0^Y\x8f\xac\xbf{\xd4\xc4v\xd4\xa7\x13x\xe4\xb5\xe0J\x10}\\\xf3w?\xea?\x01\x00\x00\xff\xffPK\x07\x08\xee%\xd00\x86\x01\x00\x00\xcf\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x99S\x8dN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00sql/functions/delete_posts.sqlUT\x05\x00\x01\xe3\xb9\xb1\\d\x92Qk\xdc0\x10\x84\x9f\xa5_1\x84\x83\xf8\xe0Rh\x1fc\x8eR(\xf4\xad\x7f\xa0\x14#Kk\x9fR\xdd\xca\x95\xd6i\x8f\xa6\xff\xbdH\xd6\xa5$y]\x8d\xe7\x9b\x9d\xb5Md\x84\x10\x13\x12-\xc1X\xc2\xb4\xb2\x15\x1f\x19\x8e\x02	\x0dK\xcc\x92;\xef2F?{\x96o\xdf\x0f0\xd6\xc6\x95\x05B\xbfe\xaf\x13\xc9\x9a8\xe31z\x07\x93\xb1\xdbiG6\x98DZ\x8d\xd1$Wu\xbdV\xf6D\xf6\x07\xb9\xa1\x0e3\x1er\xe4\x11G\xdc\xfe\xf9{\xdbkU@\x83w\x0d\xd3\xeb\x91f\xcfZM1\x91\xb1'\\\x9f=\xc3\xa4d.(\x91B\x8c\x8bV\xea\xee\x0e_H\xaa\x04\xd5\\+\x95)\x90\xddf\x1b\xb0[\xdey\xb7\x87g\x89\xcf\"5\xa5x\xae\x9a\x8cb\xa4~\x9d(\x11\x8a\x12\xc7+\xb2\xcc\x95a\x07\x8e\x02\x9f\x87\xad\x98\xe6\xd7\xeb\x8d\xff5\xe2l\xc4\x9e\xb4R~\xda\xfc\xe13x\x0d\x01r\".&6\xb2x^\xa9\xd7J\x11;\xf8\xe9\xfa\xf5\xa7\x9c)	\xd6L	\xd6\\\xbbo\xc1\"o~\x07\xf8\xa9f0!\x91q\x17\xb4>7dyxY\xf0\xc7V~\xa3/\x94\xa6\x98\xce0\x955X\xc3C\x1bu\xed\x9e\x87+\xe7\xfd\xfd}>\x9b\x10<\xcb\xbe\xaf\xc9_^\xee\xf8\x8a\x84\xa7\xa7\xed\x9a\xc3\xb8\xfa\xe0\x868>\x90\x95\xae\xb9IZi\xffv\xe7\xcf\xffw,\x1bpm\xa0^\xe7\x1c\xdd\x10\xe2\x8cN.\x0b=\x87j\xd78\xe0f\xbc\xdc\xecK\xaaG\x13V\xca\xe8>\xbc\xd5\xb4\x8d\n\xb6P\xcb\x7f\xd2kb\xd7\xeb\xdd\x0e\xc1\xf0\xbc\x9a\x99\xb0\x84e\xce?C\xaf\xff\x05\x00\x00\xff\xffPK\x07\x08\x89\xdd\xb4\"\xa2\x01\x00\x00\x07\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00me\x8dN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00$\x00	\x00sql/functions/delete_posts_by_ip.sqlUT\x05\x00\x01o\xd9\xb1\\\x94S\xcdn\xdb8\x10>\x93O\xf1!0`	+\x1b\xbb{)j!\x05\n\xb4(\x82^\xf2\x06\x02-\x8ed\"4\xc9\x92T\x12\xf7\xe9\x0bR\xb4b\xbb9\xb4\xbey8\xfa\xe6\xfb\x99\xd9l\xa0\xc9\x8c\xf1\xb0\xc3\x13\x91\x83$MQ\x99\x11\xce\x86\x18`\x07\xc4\x83\nxx\xc4`=\xe4\xe4ET\xd6@\x19\x04\xea\xad\x91\x81\xf7\x9eD$X\x0fON\x8b\x9e0L\xa6\xcf]\x19\x8c\xba\x0c\xd5\xedO\x9dr\x95\x92\xd8\xabQ\x99\xd8@\xf4\xbd\x9dLD\xa4\xd7\xd8p6\xd3X^=\x89`M~\xac\xb9\xa78y\x13\xf0l\x95\x84\x08X\xad\xb8\xa4^\x0bO\x9cE\xe1G\x8a\xdd\xde\n/s{\xbb\xd4\x94\x832\x94\n\xcb\xd8\x96\xefiT\x86\xb3\xcd\x06\xdf(f\x99\x98\xbf\x15F\xe2\xe1\x91\xb3@\x9a\xfa\xf9eF\xad\xdcV\xc9\xba\x81\xdbf\xc0hq9\xb3\xc12\x8d36x{,\xde\xa5\xbf/\x07\xf2\x94>\x94\xb8\x7f\xc7\x8e\xad\x92-\xcf\\\x1e\x13\x8f\xd1\x9al\xa4\xd0\x9e\x84<\xc1\x89\x10\xf1\x01R\x9c\x02\xac\x96\x9c\xa9\x01\x17\xd2\x02\xcc\xa45\xe2\x81\x0cgl\xf6\xa8\xe5\x8c\x8c\x84\x1a\n\xee\xe7\x10\xc8GL\x81<zq\x8e\xe4\x9c\xae\x99\xa5s\xe6\xc8\x0f\xd6\x1f!r{\xd7\x0b\xd3\x95RUbjnD\xff\xb7\xdb\x85\xa3\xd0Z\x99X\x97Y_f\xecx(\xf8\x9c\xa5\x9dQ2mKuvu\xab$g\xec7\xa3.\xacr\xb8\xbf\xb2\x941\x96\xa2\xb9\xcd\xe3\xad\xabhH\xbf\xcd\x06_M\x98<\xc1\xd8\xb8\xf88\x8b\x96oX\xe9Q\x85\xae\xd4g\xbc\x9a3mm\x1a\xa8L\xf6,'}\xb4\xb2\xd3vD\x15O\x8e\x1a\x14\xf1\x99\x8b\x92\x0d\xee\xf6\xa7\xbb:\xe1>\x0b=Q@\xf5\xff\xadO\xa9\xabXX\x97p\xd2\x98b\xd9\xf7wn.\xaa\x1c\xaa\n\xa0W\xa7<\x85\x9c{9\x8fO\xf8\xf7\x1c\xf8_\xd2l E\x14M\x01\xba\"\xfd\xf1\x96\xf4\xbb\x9b\xba\xe88\x1fg3\x1bZ\x00\xdb\x1bJ{a\x02*\xe5\x166\x83\xf5i\xcb\x97\xaf\x0b\xab\x99q\x91zEkY\x82?\xa4w\xc5j\xe1\xba\x0e\x07!\xed\xcb\xba\xd4+c_\xaa\x1a\xff\xe0(\x9e\xa8S&\x92\x7f\x16\xba\n\xd4\x07\xec\xee\xcf\xee\xd4\x10\x11Q\x1d	?\xd3M\xae\xa7\xd8\xaf\xeb\x8b\xdb\"#[\xbeZA\x0b3Nb$8\xed\xc6\xf0C\xb7\xfcW\x00\x00\x00\xff\xffPK\x07\x08\xcc\xaa}OZ\x02\x00\x00Q\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xb8\x8cwN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00sql/functions/insert_image.sqlUT\x05\x00\x01\xedn\x96\\\\\x92A\xae\x9c0\x0c\x86\xd7\xf1)\xbc\x18	\x90x\xa3iUU\x95\xd0\x1c\xa0\x9b^\x01\x05b\x18\xbff\x1c\x1a\x87\xf6-\xde\xe1\xab$L;\xed\x0e\x8c\x93\xff\xf3\x87_^\xf0\xab(\xc5\xa4\xc8w\xbb\x12\xb2\xa4\x80\xf4\xc6\x9aXV\xdc\x82&\xb4\xe20R\xda\xa3\x1c=\xaf\x1a\x04\xe6H6\x11.\xbb\xcc\x89\x83 \x97k\xc6\xd2\xd1\xe6s#;\x9cxeI=\xa6\xf0\x9d\x04\xe7\x9b\x8d\xed\x97\xcf]\x8fb\xef\x84?m,\x95\x8f\x97K\xd7\x83\xd1-\xb0\xa7\x88S\x08\xbe\x83\x1a\xa8%kB\xabx:\x81\xa3\xd9\xdbH`JH\xbe\xbf\x9c\xfft\xe9\x060\xce&[\xbb\x07\x98he\x01\xb3o.#f\x18\x05c\x94\x12\xea\xcd~\xc0+\xeeJ\x95t,d\xed3\xfc\xb9\x942\x91\xa9A\xdf2\xec\xf5\x9f\x01\xcfy\x80\xd2\xf1\xa0\xfe\xef\xfbQ\x06c~\xdd(\x12\xb2\xc3+\x1eV\xc0\x98:\xdd\xc3\xb0\x9e\x0bVQ\xff\x18m\x00\xc3\xcb\x9f7dE\xd9\xbd\xc7t#\xc9\xe7-+!\xbd\xcd\xb4\x15\xf9M\xf9Q\x12\x12.a\x17\xd7\x0c`H\x1c\xf22\x00\x18%Os\xc2\x14\xc6\xa2\xa7\xe5\xaeFec`\xcc\x12\xc3\xbd\x06)\xf2_\xe0\xf3\xa1\xea	\xe8X\x82b\xfa\xfd\xbd\xca\x1e\xa7\x9d\xbd\x1b\xc3\xf4Jsj\xc1\x98\x86]\xd3?F\xcd\x8a\x9a\xc3E\xd3\xe3\xf1T\xaa\xd9`S7\xa1\x1b\x80\xc4\x0dp:\xa1\xb7\xb2\xeey\xc76\xbf\xad\xfa\xc3\x0f\xf0;\x00\x00\xff\xffPK\x07\x08\x0b\xce\xa2\xd0u\x01\x00\x00\xa2\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xb8\x8cwN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00sql/functions/is_deleted.sqlUT\x05\x00\x01\xedn\x96\\L\xce\xb1n\xc30\x0c\x04\xd0Y\xfc\x8a\x1b<$K\x80v5\xf2-\x81,]T\x02\xb2\xa4J4\xda\xfe}\x11\xdb@\xcb\x8d\xbc\x07\xf0B\xa77\xa2vt\xb6\xec\x03\xf1\xdcJ0\xad\x05:\x1e\x91\x99\xc6x\xd1\x88E\x93\x16\xbbJ\xa7m\xbd\x0c,\xb5f\xf8\x81i\x92\xc8\x90}\xa7\xb8\x93\xef\xd9,\x0b\x93\x16q\x83\x99\xc1\xc0o\x1d6p9\xd77q\xafy\xf6\xba\xa2\xd5a\x8f\xb5Fv\xbf?n\xeb\x11~}\xb0\x13m\xbd\xed@#\xee\xff:\xdd4\x1e\xca\xf9\x12_\xc8~\x1aq\xc7\xfbU\x9c\xd3b\x15'\x9c\xc5\x1d\x9d\xff\x0e,q\x96iB\xf6%m>\x11-\xb74>\xf3,\xbf\x01\x00\x00\xff\xffPK\x07\x08)\x9e\xae\xd8\xb4\x00\x00\x00\x0f\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xcd\xadwN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00sql/functions/post_board.sqlUT\x05\x00\x013\xa9\x96\\<\x8eAN\xc50\x0cD\xd7\xf6)f\xd1\xc5\xff\x9b^ \xe2,(m\xfcS\xa3\xe0\x04\xc7\x15\x1c\x1f\xa9E\xec,\xbf\xd1\xcc\xdb]r\x08^\xa7\xed\xa1\xdd0\xfa\x8c\xf7\xadg/\x0f-\xd8\xb4\xaa\xc5\x93]\xe2t\x9b\x08\xf9	\xe4\x89e\xe1\"{\xcb.LW\xf8\"\x897\xa9jLS\x9a\xec\x81Xo\xa6\x16\x1d\xd7\xc9D/\xef\x9f\xd7\xca\xc4`\xa2\x8f\xae\x868\\r\x99\x08t\xc3#V-x\xc3X\xfbx2\xd1\xf7!.\x18\x7f\xcf\x7f\xbdUKb\xba\xc5\xee\xf2\xc4b%\xf1\xb2\xa0e\xabg\xae\x82\xd1F\x9d_-\xf1o\x00\x00\x00\xff\xffPK\x07\x08\xad\x9f\xd7\x04\xa3\x00\x00\x00\xe7\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xb8\x8cwN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00sql/functions/post_count.sqlUT\x05\x00\x01\xedn\x96\\L\xcd1\xae\xc3 \x0c\xc6\xf1\xd9>\x85\x07\x86\x977\xe4\x02\xa8g\xa9\x88\xe3P$jS0\xea\xf5+5\x19:\xfe\xbf\xe1\xfbq\x97\xe4B\xc7T\xf6bJ\xcd\x86\xdf\xd9\xa6\xfa\x9f5\xdaJ.\xea\x0bv\xf1\xd9u\\MiP\x08\xb8\x0b\xd7\xd4\x05\x81\xaf=\xe2&\xb9(\xc2\x90*\xect\xde\xfc/T\xd4\x8d\x18\x01\x8en\xcf/1\x10\xe0\xfd\x90.g\xad\xd6\xe8\xf6c\xaf\xd6\"\xc2\xa9\x12G\x14\xdd#\x86@5i\x9e)\x0b\xb5\xda\xf2x\xd5\x88\x9f\x00\x00\x00\xff\xffPK\x07\x08\xbaki\xf9\x8b\x00\x00\x00\xc0\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xb8\x8cwN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x19\x00	\x00sql/functions/post_op.sqlUT\x05\x00\x01\xedn\x96\\4\xcd1\x8e\x021\x0c\x85\xe1\xda>\x85\x8b\x14\xbb\x0d\x17\x888\x0b\xca$\x9e`)\xd8&\xf1\x88\xeb#\xc1P>=\xe9\xff\xea\xe4\x12L\xfb\xa15\xc4\x94\xdcV\xdc\xcc\xff\xa4\xd1&]4\xfeqr\x1cS\xd7\xb9\xa9,J	\x1b\xd7Q&#\x98\x9fG\xc6\x8d\xbb(\xc2\xe2\xc15\xc8\x9cD\xc3\xc8\x1c\x01\xf6i\x8fO{!\xc0\xeb\xce\x93I\x1a]\x7f\xdcEZF\xf8Bd\x9e\x91\xb5eL\x89F\xd1~\x94\xce\xe4\xc3\xfbz\x8e\x8c\xef\x00\x00\x00\xff\xffPK\x07\x08Q\xa7\x96\xff\x86\x00\x00\x00\xb1\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xdd\x9c\x8fN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x00	\x00sql/functions/spoiler_images.sqlUT\x05\x00\x01\xd2\xdd\xb4\\t\x92\xe1j\xdd0\x0c\x85\x7f\xdbO!\xca\x85\xe6\xc2\xa5P\xd8\xaf\x86\xcb\x1ed\x8c\xa0\xd8J\xe2\xce\x91<[\xeeV\xd6\xbd\xfbp\x92;h\xc7\xfe\x9e\x9cs$}\xb1\xcb\x84J \x192\xa5\x88\x8e`\xaa\xec4\x08CI\x12\"\xe5!\xac8S\xe9\x82/0\x869\xb0~\xf9z\x01tN*+(\xfd\xd4\xb3\xcd\xa45s\x81\x17	\x1e\xb0\xc0\xe9d=\xb9\x88\x99\xac\x19\x05\xb3\xdf|\xbd5n!\xf7\x8d\xfc\xb0\x89\x05\x9e\x8b\xf0\x08W\xb8\xff\xf5\xfb\xbe\xb7&I\xd1!\xf8cLoG\x9a\x03[3I&t\x0b\xdc>\x07\x06\xcc\x19_\xa1\xad\x14E\x925\xa6P$\xa7\xbbe+\xef\xd2C\xf0g\x08\xac\x02\x9b`\x8d1S\x96u\xf3\x14h!\xf3c\xa1L\xd0\x9cp\xbd\xd57\xdd {H\x0fe\xc1G\x08\x05X\x14\xb8\xc6\xd8[c\xc2\xb4\xd7mz\x8d\x11t!n\x19'\xac\x81+5\x13\xb1\x870\xf5v\xf7\xb7\xf8\xfb\xc3?\x1fP\x8eh\xa2<I^\x01K\xa1\xac\x83C\x1e\x0e\xa9;8_\xf6\xa1\x17x|z*+\xc6\x18X\xcf\xfd6\xf6=\xd1\xeb\x87I\xf0\xf6\xb6S\x1e\xc6\x1a\xa2\x1fd|&\xa7\xdd\xd1\xa6\xb9\xd2\xf9\xc3\xc25\xf9\xf6\"vJx\x80*\xa4\xb7\xf7\x00\xd7-\xf6?|\x1b#nw\xec\xecW\xf1C\x94\x19:}M\xf4\xf7\x8c\xc3|\x81\xbb\xf1\xf5\xee\xdc\xba^0V*\xd0}\xfa\xd7s0h\x8b\xb6=\xdb\x1f\xef-\xb1\xef\xed\xe9\x04\x11y\xae8\x13\xa4\x98\xe6\xf2=\xf6\xf6O\x00\x00\x00\xff\xffPK\x07\x08\xfd\x08\xb4\x18\x88\x01\x00\x00\xd3\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xb8\x8cwN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00!\x00	\x00sql/functions/use_image_token.sqlUT\x05\x00\x01\xedn\x96\\\\\x90\xc1N\xc40\x0cD\xcf\xf1W\xf8P\xa9\xdb\xc3\"\x90\x10B\x8a\xb8q\xe1\x038\xafL\xe2\xb6\x11Y\xb7\xc4	\xf0\xf9\xa8IA\xec\xde\xa2\xb1\xe7\x8d'\xc7#\xbe*+\x96\x15I0\x9cib\xa4\x18\x17G9,\x82yygA\x12\x8f\x89sI\xa2\x98gnk\xbd\xe2\xcb3\xb8\xc4\x94\x19\xc7\"\xae\x1a\x8a\xf2\xa9\x8eO\xd5zh\x007S:<>\x0c\x03\xfcb\xaar\x7f; )v\x1dxv\x91\x12\x83\xd1\x99\xee\xfe\x86\x16\xdex\n\x02\xc6s\xe4-%-g\xfcGW0\xe6k\xe6\xc4\x17\xe2M\xcb|\xba\xbe\xa5\xe9`L\xbb!\xc8ti\xab\xd1A\xf2\x82\xdb\xcb\x82	#6MQJ\x8c[\xf5j\xa7\xa0\x8c\xfc\xedx\xad\x95\xfb \x9f\x14\x83\xdf\x7f\xaf\xd2z\x0b\x86\xc5c\x18-\xecy;\x95\xc5[\xe8:\x8c$S\xd9\xf6\xd7\xb8N\xfa\x11-\xfc\x04\x00\x00\xff\xffPK\x07\x08w\x97\xe0l\xe7\x00\x00\x00\x8b\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00nR\x95N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00sql/triggers/boards.sqlUT\x05\x00\x01\xb0C\xbc\\\xac\xcfAj\xc40\x0c\x05\xd0ut\x8a\xbf\x08L\x02\x99\xd2}N\xd0S\x04O\xac\x18\x83+\xbb\xb2\xdc\x92\xdb\x17\xe2\x0b\xb4\x90\xad\x90\xbe\xde\xdf\x95\x9d1\xb2B\xb9$\xb73\x8e&\xbb\xc5,p\x87\xb1n\xaf\xec\xd4\xd7-Je\xb5i&ek*\x15\xa61\x04V\xb8\x8aq\xa4\x17\x87(4\x14\xd6#\xeb'J\xd8$[<\xce\xe9q\xddo\xadxg\xec\x1f\x0b\x84\x7f\xde\xa2\x9fW\xa2\xe1\xf9\xc4\x87DC9\x1b\xbe]jLC\x7f\x83(\x96\xaf\xf1\x14\xfd\x82\xb2\xe7&6\xf7\x9d\x8a\xa9G,x\xbfR:\x08\xd2RZ\x89\xc5\xaf4\x8eHNBs\x81QR	\xf5+\xadD\x7fm\xda\xa9w6\xbd\x9d\xe89\xf1-\xc4\x9c\xfc\xff\x88\xbf\x01\x00\x00\xff\xffPK\x07\x08\xae\x07\x84\xd3\xc3\x00\x00\x00/\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00eR\x95N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00sql/triggers/mod_log.sqlUT\x05\x00\x01\x9eC\xbc\\L\x90Q\xca\xe30\x0c\x84\x9f\xedS\xcc\xfe\x04\x92\x82)\xfb\x1ez\x96\xe0\xc6\x8akpe\xaf\xacl\xe9\xed\x17']\xe8\xdb 1\xdf\x8c\xb4\ny%\x14\x81P\xcd~%l;\xaf\x9a\n\xc3oJ\xb2<KXr\x89K\xe2F\xa2\xd3\xc5\n\xe9.\xdc\xa0\x92b$\x81o\x18\x06\x1bh\xcd^\xc8\x9aRqO1\xb1\xce\xf6N1\xb15i\x03\xd3\xebZK\xd3%\x05\xfc\xba\xe17\xf4Al\x8d9\xa1H\xac\x05\xc7\xfeY\x02\x89?\xe2\xa7\x8f\xc1A\xdf\x95\x1c~\xee\xef\x1f\x87L\x1c\xf5\xe1\x10\xbc\xfa\x8b5\xc6\xfc\xf5y\xa7\x86\xe9+\xc2\x1dy\xa7\xab\xab\xd3\xd9\xd5\x7fw\xd7\x07a\xb6\xc6\xec5\xf4\x17ts\xeb\xc4F\x8aO\x0f\n\xb8Ae\xa7>\x7f=H\x08\xa9\x8f\xbe\xc2\xfa\xe6|I\xe2xB\xae\xa5\x9e'\x95\xda\xf9\x95d+\xf2D\x8d\x0b\x17M\xdb{\x1a\xbfo\xa50\xba\x0eY\x0b\xaf^\x97W\x9bF7:\x94z\xd6L\xe1\xd2[\x12\x07\xa4m\xb6\x9f,\xf0\x9e\xf3l\x89\xc3l\x87\x01\xd9s\xdc}$\xd4\\c\xfb\x93g\xfb/\x00\x00\xff\xffPK\x07\x08\x99\xbd\x0b\x86\x1a\x01\x00\x00\xd8\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00[R\x95N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00sql/triggers/posts.sqlUT\x05\x00\x01\x8eC\xbc\\\x84SMo\xd40\x10=\xdb\xbfbT\xad\x94\xac\x9a\xaeT\xaeQ\xb9\x94\x0b7\x0e \x8e\x91\x1d\xbfd\xadz\xed`OH\x17\xf5\xc7#;)\xdb\x82hs\x895\x9f\xef\xbd\x99\xe9#\x14\x83B\xa4\x88\xc9\xa9\x1e4\xcc\xbeg\x1b<i\x0c!\xa2\x9bB\xe2\xd4Y\x9f\x10\xb9\xde\xcb\x08\x9e\xa3O\xc4\xd1\x8e#\"\xa9D\xbb\x9d4\xe8\x9d\x8a\x90\x82Cg\xe0\xc0\xe8\xf4\x99\x18\x8f\xdcJ\x8d\xd1z)&\xc4!\xc4\x13\xe9\xf94u|\x8cP\xa6\xf6X\x0eaj\xc8\x07\xa6\xfcNj\xc4\xbe\x95\xe2\xe6\x86\xaeo\x1b\xd2\xe8\xd5\x9c\x90]\x94a\x90M%t\x05\x03Cg\xf0\xa5\xf04v>\xb0\x1d\xceu\xe5\xb1\x14\xdc\x9d\xf5[\xab\xaa\x91B\xac\xed\xe8\xe9\x89\xaa\xa6\xca\xbf\x12\xd3\x87\xd9\xf3\x06eO\xd7t\xbboe\x81\xf0\xa9\x10)A\x0d\xd9\x81>\x7f!\xedT\xff\xe0lb\x18)\x12\x1cz&}\xd0g\xb2\x9e\x03\xbd$/\x85\x18b8\x91V>\x91\x96\"\x17\xbcW\xbeb\xca\x8cJc\x1dT4\xf5\xfe\x05\xcf\x7f\xb8\x89\xe5\x88\x08*\x91tG\xf5\xd6\x92\x0f\xc5\"E\xf9J\x9f\x95f\"\xde\x8ck\"\x1fl\xce\xdb\xc8e\x97\xf2\x86\xf4\xc1N\x9b\xd5N\x17#\x9f'\xd0\x1dU\xe9\xa8LX\xaa\x8b\x03\x8f\x93\x8dH\xf4\x91|X\xea=)&\xb6'\xd0\xaf\xe0A\xd5\xb7\xaf\xf7U+\x85\x1d^\xf1\x7f\x9e\x95\x9f\x9d#>\xc2\xaf\x12|\xb7\xce\xd1\xa0\xac\xa3\xc0G\xc4\xc5&\\\xf8?\xe0O\xda\x12-3<eU\x95v\xd8\xe4H`\xea\x83O\x1c\x95\xf5\x9cV\x1dO\xc1 \xaa\xbc\xb3\xdb\xd0M7\xe4R\x06\x03b\x84i\xa5\x10\xab\xac\xeb\x98\xfeJ\xa2z\xcbj(+\xd0\xd0\x95>_\x15\xad~*7#Q\xd9\x8d\xec\xfe\xd0\xbc\xa2\x987\xb5\xec\xd4V\x0bYj\x8e3Z)\xe0\x0d\xd9!/\xd2z0Y\xecV\xc2\x9bV\xeev\xe4\x94\x1fg5\x82&7\x8d\xe9\x87k\xa5|\xe3\x10\xd5\xc0\x88\xdb\x1d\xce\x93Q\x8c\xff\xde\xe1\xbb\xb7\x961?#\x9a\x9d{\x0b\xd2\xef\x00\x00\x00\xff\xffPK\x07\x08\xbaz\x81\xd3\x0d\x02\x00\x00\x1b\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x8b\x91\x96N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00sql/triggers/threads.sqlUT\x05\x00\x01\x07\x04\xbe\\\xb4\x90\xc1j\xe30\x10\x86\xcf\xd6S\xfc\x0b\x06;`/{\xda\x8b\xd9\x07\xd8[\xe9\x0b\x18\xc5\x1a;\x02y\xa4\x8eF)\x85<|q\x9c\x16zir\xe9i\xd0\x8f\xe6\xe3\xfff\x12\xb2J\x88\x02\xa1\x14\xecD\x98\x0bO\xea#\xc3\xceJ2\xeaI\xc8\xba<z\xce$\xda\x1e\x8c\x90\x16\xe1\x0c\x15\xbf,$\xb0\x19um\x8e\xb4x6U\"\x99\xa3\xac8\x965\xddV[\xa6\xd7\xdf\xde\x1d\x06c\xaa\xbe\xc7\x7f\xf6\x8a\xe7\x92\xb3\xb7\x0c\x89%\x90*\x99j\xc7\xc3\xb3\xc6\xcf\x14\xadw\x1d\xf2\x14\x0bk\x07\xb9\xce\x03\xce6\x14\xca\xb8a;\xfc\xed\xf0\xe7\n\xdf\x8b\x81K\x08\x83!v\x83\xa9k\x04\xcbK\xb1\x0b!\x85\xb4\xe4\x970\x18\xf3\xb0rI\xce*\xddS\xee{<	\x9d\x89\xb7\xf6\xb3g\xaf\x04\xa1\xa9H\xde\x88\x91\xa1~\xa5\xacvM\xd8\x81\xd9T~\xc6V\x7f\x7f\x8f\xdb\x07\xfc\xfa\x87\x18\xdc\x97HO\xc4\xa6\xfa\xfe\xa6\x15\xb1\x83\x9f\x87\x1f\xd0w\x14\xe8\xbe\xfeG\xbb\xb4\x8c\x1c\xd5\xcfom\xb3\x03n\xfb\xae\xe9\xaeb\xc7h\xc5\xe1rA\xd35\xdb\xd8\xb2\xdd\xe0\xc1\xe2\xef\x01\x00\x00\xff\xffPK\x07\x08\x04AO\xf0$\x01\x00\x00\xaa\x02\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xb8\x8cwN]12\x95\xaeZ\x00\x00\x8f^\x00\x00\x12\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x00\x00\x00assets/loading.gifUT\x05\x00\x01\xedn\x96\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xef\x9d\x8cN7d	\x91\xc7\x04\x00\x00?\x0c\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xf7Z\x00\x00lang/en_GB/common.jsonUT\x05\x00\x01R\xeb\xb0\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00v}\x96N\xd8\x10\xf2\x02\x9e\x10\x00\x00\xdb/\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x0b`\x00\x00lang/en_GB/server.jsonUT\x05\x00\x010\xe1\xbd\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xef\x9d\x8cN\x9eLe*\xf6\x04\x00\x00\x86\x0c\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xf6p\x00\x00lang/es_ES/common.jsonUT\x05\x00\x01R\xeb\xb0\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00v}\x96N\xcd\xa8b\x9e\xb2\x11\x00\x00\xb41\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x819v\x00\x00lang/es_ES/server.jsonUT\x05\x00\x010\xe1\xbd\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xef\x9d\x8cN\xf6\xb8\x13[\x96\x05\x00\x00\xeb\x0c\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x818\x88\x00\x00lang/fr_FR/common.jsonUT\x05\x00\x01R\xeb\xb0\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00v}\x96N\x94^\xb6\xa4\xf7\x10\x00\x00\x042\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x1b\x8e\x00\x00lang/fr_FR/server.jsonUT\x05\x00\x011\xe1\xbd\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xef\x9d\x8cN\xa5\xfeKi\x82\x05\x00\x00\xfc\x0c\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81_\x9f\x00\x00lang/nl_NL/common.jsonUT\x05\x00\x01R\xeb\xb0\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00v}\x96N\xfb|'}\xab\x12\x00\x00\x824\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81.\xa5\x00\x00lang/nl_NL/server.jsonUT\x05\x00\x011\xe1\xbd\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xef\x9d\x8cN\xb2\xb7\x93{\xdb\x05\x00\x00d\x0d\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81&\xb8\x00\x00lang/pl_PL/common.jsonUT\x05\x00\x01R\xeb\xb0\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00v}\x96N\x81\xff\x1b\x8b@\x12\x00\x00\xb70\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81N\xbe\x00\x00lang/pl_PL/server.jsonUT\x05\x00\x011\xe1\xbd\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xef\x9d\x8cN\x9a	\xb1m\x05\x05\x00\x00\x96\x0c\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xdb\xd0\x00\x00lang/pt_BR/common.jsonUT\x05\x00\x01R\xeb\xb0\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00v}\x96N\x89\xfc\x07O\xbc\x11\x00\x00\x0d1\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81-\xd6\x00\x00lang/pt_BR/server.jsonUT\x05\x00\x011\xe1\xbd\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xef\x9d\x8cN'[\x9e\x89\xcd\x06\x00\x00\x7f\x0f\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x816\xe8\x00\x00lang/ru_RU/common.jsonUT\x05\x00\x01R\xeb\xb0\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00v}\x96N\x86\xe3\xc6\xe7;\x15\x00\x00\x91A\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81P\xef\x00\x00lang/ru_RU/server.jsonUT\x05\x00\x011\xe1\xbd\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xef\x9d\x8cN\xa2E\xfc\xcc\xe8\x05\x00\x00;\x0d\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xd8\x04\x01\x00lang/sk_SK/common.jsonUT\x05\x00\x01R\xeb\xb0\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00v}\x96N\x0c\xd1\xc0\xa5P\x13\x00\x00\xd11\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x0d\x0b\x01\x00lang/sk_SK/server.jsonUT\x05\x00\x011\xe1\xbd\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xef\x9d\x8cNE\xd7Z^8\x05\x00\x00\x91\x0c\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xaa\x1e\x01\x00lang/tr_TR/common.jsonUT\x05\x00\x01R\xeb\xb0\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00v}\x96N\xaf?\xbe\xf3#\x11\x00\x00o/\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81/$\x01\x00lang/tr_TR/server.jsonUT\x05\x00\x011\xe1\xbd\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xef\x9d\x8cN\xb3\xff\x16F\x96\x06\x00\x00\n\x0f\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x9f5\x01\x00lang/uk_UA/common.jsonUT\x05\x00\x01R\xeb\xb0\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00v}\x96Nq\xc5=\xc9$\x15\x00\x00\xb7;\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x82<\x01\x00lang/uk_UA/server.jsonUT\x05\x00\x011\xe1\xbd\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xb8\x8cwNA\xbd\xd5h\x05\x01\x00\x00\xd2\x01\x00\x00$\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xf3Q\x01\x00sql/functions/assert_can_perform.sqlUT\x05\x00\x01\xedn\x96\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x89\x93\x96Ns\xdcw}\xe3\x00\x00\x00\x8b\x01\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81SS\x01\x00sql/functions/bump_thread.sqlUT\x05\x00\x01\xc3\x07\xbe\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xdd\x9c\x8fN\xee%\xd00\x86\x01\x00\x00\xcf\x02\x00\x00\x1f\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x8aT\x01\x00sql/functions/delete_images.sqlUT\x05\x00\x01\xd2\xdd\xb4\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x99S\x8dN\x89\xdd\xb4\"\xa2\x01\x00\x00\x07\x03\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81fV\x01\x00sql/functions/delete_posts.sqlUT\x05\x00\x01\xe3\xb9\xb1\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00me\x8dN\xcc\xaa}OZ\x02\x00\x00Q\x05\x00\x00$\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81]X\x01\x00sql/functions/delete_posts_by_ip.sqlUT\x05\x00\x01o\xd9\xb1\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xb8\x8cwN\x0b\xce\xa2\xd0u\x01\x00\x00\xa2\x02\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x12[\x01\x00sql/functions/insert_image.sqlUT\x05\x00\x01\xedn\x96\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xb8\x8cwN)\x9e\xae\xd8\xb4\x00\x00\x00\x0f\x01\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xdc\\\x01\x00sql/functions/is_deleted.sqlUT\x05\x00\x01\xedn\x96\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xcd\xadwN\xad\x9f\xd7\x04\xa3\x00\x00\x00\xe7\x00\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xe3]\x01\x00sql/functions/post_board.sqlUT\x05\x00\x013\xa9\x96\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xb8\x8cwN\xbaki\xf9\x8b\x00\x00\x00\xc0\x00\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xd9^\x01\x00sql/functions/post_count.sqlUT\x05\x00\x01\xedn\x96\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xb8\x8cwNQ\xa7\x96\xff\x86\x00\x00\x00\xb1\x00\x00\x00\x19\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xb7_\x01\x00sql/functions/post_op.sqlUT\x05\x00\x01\xedn\x96\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xdd\x9c\x8fN\xfd\x08\xb4\x18\x88\x01\x00\x00\xd3\x02\x00\x00 \x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x8d`\x01\x00sql/functions/spoiler_images.sqlUT\x05\x00\x01\xd2\xdd\xb4\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xb8\x8cwNw\x97\xe0l\xe7\x00\x00\x00\x8b\x01\x00\x00!\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81lb\x01\x00sql/functions/use_image_token.sqlUT\x05\x00\x01\xedn\x96\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00nR\x95N\xae\x07\x84\xd3\xc3\x00\x00\x00/\x02\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xabc\x01\x00sql/triggers/boards.sqlUT\x05\x00\x01\xb0C\xbc\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00eR\x95N\x99\xbd\x0b\x86\x1a\x01\x00\x00\xd8\x01\x00\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xbcd\x01\x00sql/triggers/mod_log.sqlUT\x05\x00\x01\x9eC\xbc\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00[R\x95N\xbaz\x81\xd3\x0d\x02\x00\x00\x1b\x04\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81%f\x01\x00sql/triggers/posts.sqlUT\x05\x00\x01\x8eC\xbc\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x8b\x91\x96N\x04AO\xf0$\x01\x00\x00\xaa\x02\x00\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x7fh\x01\x00sql/triggers/threads.sqlUT\x05\x00\x01\x07\x04\xbe\\PK\x05\x06\x00\x00\x00\x00%\x00%\x00\x88\x0b\x00\x00\xf2i\x01\x00\x00\x00"
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x9c\xa5\x97N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x12\x00	\x00assets/loading.gifUT\x05\x00\x01Hy\xbf\\\x00\x8f^p\xa1GIF89a\xe0\x00\x80\x00\xd5+\x000\x00 \xf8\xf8\xf8\x80\xa0\xa8\xe0Px\xa8\xa8\xd0` @\xa88`\x88 0\xf0\xe0\xd8`\x10(\xd8\xb8P`p\x80\x10\x100 (8@\x00(XXhHP`\xd8\xa0\x98`h\xa8 (H\x10HX\x90p(\xa8`h\x00\x00\x00\xd8\xb0\xc8\xa8x\x98\x18h\x80\xe0\xe8\xe8\xf8\xc8\xf0\xe0x\xa8\xf8\xa8\xb0\xf8`\xa8\xd88p\xa8\xa8\xf8\xb0Hp\xc8XP\xa8\x1880Hp((P\xf0\xb0PX8\x18@P\xb0 0\x80\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00!\xff\x0bNETSCAPE2.0\x03\x01\x00\x00\x00!\xff\x0bXMP DataXMP<?xpacket begin=\"\xef\xbb\xbf\" id=\"W5M0MpCehiHzreSzNTczkc9d\"?> <x:xmpmeta xmlns:x=\"adobe:ns:meta/\" x:xmptk=\"Adobe XMP Core 5.0-c060 61.134777, 2010/02/12-17:32:00        \"> <rdf:RDF xmlns:rdf=\"http://www.w3.org/1999/02/22-rdf-syntax-ns#\"> <rdf:Description rdf:about=\"\" xmlns:xmpMM=\"http://ns.adobe.com/xap/1.0/mm/\" xmlns:stRef=\"http://ns.adobe.com/xap/1.0/sType/ResourceRef#\" xmlns:xmp=\"http://ns.adobe.com/xap/1.0/\" xmpMM:DocumentID=\"xmp.did:CB3790B2E86211E4969693F512777AEC\" xmpMM:InstanceID=\"xmp.iid:CB3790B1E86211E4969693F512777AEC\" xmp:CreatorTool=\"Adobe Photoshop CS5 Windows\"> <xmpMM:DerivedFrom stRef:instanceID=\"xmp.did:7871C5BD62E8E411BABFC0027CE0A7AC\" stRef:documentID=\"xmp.did:7871C5BD62E8E411BABFC0027CE0A7AC\"/> </rdf:Description> </rdf:RDF> </x:xmpmeta> <?xpacket end=\"r\"?>\x01\xff\xfe\xfd\xfc\xfb\xfa\xf9\xf8\xf7\xf6\xf5\xf4\xf3\xf2\xf1\xf0\xef\xee\xed\xec\xeb\xea\xe9\xe8\xe7\xe6\xe5\xe4\xe3\xe2\xe1\xe0\xdf\xde\xdd\xdc\xdb\xda\xd9\xd8\xd7\xd6\xd5\xd4\xd3\xd2\xd1\xd0\xcf\xce\xcd\xcc\xcb\xca\xc9\xc8\xc7\xc6\xc5\xc4\xc3\xc2\xc1\xc0\xbf\xbe\xbd\xbc\xbb\xba\xb9\xb8\xb7\xb6\xb5\xb4\xb3\xb2\xb1\xb0\xaf\xae\xad\xac\xab\xaa\xa9\xa8\xa7\xa6\xa5\xa4\xa3\xa2\xa1\xa0\x9f\x9e\x9d\x9c\x9b\x9a\x99\x98\x97\x96\x95\x94\x93\x92\x91\x90\x8f\x8e\x8d\x8c\x8b\x8a\x89\x88\x87\x86\x85\x84\x83\x82\x81\x80\x7f~}|{zyxwvutsrqponmlkjihgfedcba`_^]\\[ZYXWVUTSRQPONMLKJIHGFEDCBA@?>=<;:9876543210/.-,+*)('&%$#\"! \x1f\x1e\x1d\x1c\x1b\x1a\x19\x18\x17\x16\x15\x14\x13\x12\x11\x10\x0f\x0e\x0d\x0c\x0b\n	\x08\x07\x06\x05\x04\x03\x02\x01\x00\x00!\xf9\x04		\x00+\x00,\x00\x00\x00\x00\xe0\x00\x80\x00\x00\x06\xff\xc0\x95pH,\x1a\x8f\xc8\xa4r\xc9l:\x9f\xd0\xa8tJ\xadZ\xaf\xd8\xacv\xcb\xedz\xbf\xe0\xb0xL.\x9b\xcf\xe8\xb4z\xcdn\xbb\xdf\xf0\xb8|N\xaf\xdb\xef\xf8\xbc~\xcf\xef\xfb\xff\x80\x81\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x8b\x8c\x8d\x8e\x8f\x90\x91\x92\x93\x94\x95\x96\x97\x98\x99\x9af\x00\x9d\x9e\x9b\xa0`\x9e\x05\xa4\xa5\x9f\xa1\xa8V\x9d\x05\x06\x03\xae\xae\x06\xa5\x05\x9d\xa9\xb5O\xab\xad\xaf\xba\xb0\xa6\x00\xb6\xbfH\x00\xac\xbb\xc4\xaf\xb1\xa4\xbe\xc0\xca+\x00\xb9\xc5\xcf\xc7\xb3\xcb\xbf\xcd\xcf\xd6\xbc\xb3\x9e\xc9\xd3\x9a\xd5\xd7\xb0\xd1\xb2\x00\x0c\x13\xe5\x0c\xb4\xdc\x98\x00\xdf\x03\xe1\xb2\xb3\xe4\xe5\xf2\xe7\xda\xe9\x92\xde\xd6\xef\xef\xe3\xf2\xfd\x0c\xff\xff\xb6\xd9s\xb4\xee\x9a\xbe^\xe4\xfe\xcd\xd3\xc6\x10\xdd\xc0D\xc2\x0c\x962@\xd1@\xa7x\x00\x03\n\x93U\x91b6\x81\x0f\x07\xe1#6\xb1\xe2Es\x19;9\xc3\xe6\xcc\xa3\xc3\x90\x81\n\x16#\xd5\xd1\"\xc6\x8c\xe7V\x12\xa3\xa8\xcb%H\xff\x98{\"\x16\x8bU3\xd68\x9c\xe7\xd8\xc1\xea	\xeb'P<#u\xb1\xaai\n\xa7L\xa5\xbbrY|\xca\xe7j\xcf\xa9\x15\xc5\x1d\xed\xe4\xca\x03Vk[\xb9\xe6\xf1j\x8c\xaa)V*\xcb\x9e\xbd\x96\x16X\xbd\xb5\x0d\xc9\xf6tkJ\xa7\x87\xbf\xbb\xcc\x0e\x98\xa8T\x1a5\x05\n*T8%\xc7\x13\x84\x05\x90#/\x80\xe0	\\Xq\x05\x02\xff\xdd,\xb7]\xe6c:w:\x05\x05\x00q\x05\xc4\x89\x19\xb3\xe9\xf4X\x80\xeb\xd7\xb0\x05L\xf6\xd4q\x9f_]\x9b\x05\x0f\xc3\xf6\xcd\xb0-O\xa7\x13\x9bV\xcd	\xc0\x82\xd8\xc8\x91\xcfn\xa6o\xa4\xe0\xc0\xbc\xe66\x9d\x06\x1c\xf5\xf0\x97b\x8c'\xdf\x9e|\xc1\xaa\xb7<\xaf\xe9\x0e\xcd\xae.\xf5N\xc1Q/\xc6\xde\x05\x00\xf7\xf7\xdd7\xce\xfa\x8c5\xf3\x00\xc0;Ie\xf5\x9d\xae\xba\xf5\xf5\xa3\xa9\"\xc0\x06\x1b\xc0g\xe0k\x1byC\xde~\xafp\xb6\x12Q\xf7\x99EQ\x80\xcb\xf8\xa7\x1eqU\xb8G\xe0\x81\x1c\n \xff\x0c>\x0b\xbe\xb2\xdbW\x11\xb6#\x148\x14rc\xe1u).a\xdc\x86\x1dr\x18W[\xf9\x84v\xe2`z\xb53\xa1ZC\xac\x98\x1a{MhX`\x8c2\x86\x06\xe1W:Y\xd4\x12m\x96\xb5\xf8\x90\x8f\x00\xde\xb2\x00\x81C\x12i\xa0w\xd0\xb83\xa2+3\x1a\xb5\x8a\x8e;\xf2x\x04\x94\x18\x1a!d\x95V*\x07\x01\x04\xaeE5\xd4T=\xc55\xa1\x05\xb4\x11e\x9e\x98f\xa2g\xdd\x8f\xa3iW \x8ci&\xc7\xa6\x00\x94\x95\x07\xe7+\x00\xd0i\x92\x05t\xaa\xa4$\x7fx\x06\xa3\xe7\x7f\xc4\xb9\x07\x1b\xa0iZ\xea\x1ad\xb2\xb9\xb9_x&^p\xc1\x84\x11D\xd0(s\xcd8\x19\xa9\x10d\xd2\x02\xc0\xa0\xc7\xbd\x86i\x8c\x9d\xc0\xc6\xe6l!\x9a\x08\xea,t\x8aZ\xaa\xa9\xdf\xad\xa2\xea\xaa=Nz\xa1q\x9c\"7\xebv\x83\xb6\xa9\xdd\xa6\x91	\x03jV\xcd\xf0d\x11\xa3\x9dDpA\xa9\xa3\xf8\x02$\xb1A\x1a\xfbjd\xdcQ\xf9Z\xb3\x9b6\xeb\xff]\xad\xe7B\xb6QG\xd4Vd\"\xb6\xbfn+,\xab\xe0^\x01\x1c\xb2\xb1\x1e8Yl\xaf\"\xd8h\xbf\x8fy\xe7\x11X5I\xbb\xa8\xa8\xdb\xfez\xca\xb0\xf9\xbaXp\xbf\x06\xb6\x06\xe3\xb3\xde\x01\xdbl\xc1\xd5\x16e\x92\xa3FY\xc0\xf0\xb6\x08D\x00q\xc4N\xf0\x1bc\xb3\x04z'\xaaw\x16pk\xe9d\xeezlR\xc9\n\x03\xa0\xed\x05\x010\x8c\x00\x02'\xa3,\xb1\xbb\x1d\xfek+\xb2\xbe\xca,\x1b\xcd\xeb\xdal\x11\x02\xd8\x92z\x01\x01=\x8b\xfas\xd0B'\x81\xac\xa6\x9b\xbe\xd7\x1al\xaf\xae\xa9\xf3\x058\xf3\xfb\x18e\xcc\xd8\x0c\x00\xd4t\xc2\xa5-\x01\x05\xf0\x1c\xc1\xd5\xe0\xe6\x85u\x8fbk\x9a\xecv4\xc3\xe6\x1de:\xcfm/\xbf\xc6m\xa3pGk3j\xf2,\xa5\x060\x00\xcf?\x03\xbdj'\x12P\x1d\xc0\xe5\x04H\xd0\x10\x14\xda\xc4J.wkR\x0c\xf8\xc8s\x9b\x8c,eh\x17\x8b\xb0\xceP\xcb<\xb7\xe3dG~\xf7o\x96_n\xff\xfb\xed\x98k~\x17\x13\xda}\xcel\xe8`\xef<2\xd4\x9d,W\xa9|\x8c\xff\xcc\xed\xdc\x08\x0c\x16\xb9\xe4b\x02\x80\xfb\xf4\xd4\xe7\xbe\xbb\x99\x13\xef\x8d\x1c\xe0\xda\x8fM\xb5\xdc@\xb3\xe6\x899\x0c\xb1\x1al\xe4\xdc\xfeL\xca\xf3\xb3\xd7\"}\xf5\xf0\xe3\x9e9\x86\xfcj\x1f\x1be&\xef\xed\x1d\x02<\xf7\x1c\xc0\xd5\xaf\xd2\x06\xf90\x80\x81\x0cd\xc0nk{\x9e\x02e7\xb9\xf89\xd0v\x04\x98\x1f:\xc6\xe5\x1d\xfb\xbdf6@\xdb\x1be\xf8W5\xd9yBv\x01\xc1\xc0\xed0\x90@\x00H \x01	H\xe0\x02\xd97\xb9\xda=0~\x11\x94`\xd8\x8aG\xb1\x0b\x8a-\x83\xfdb\xdd\xf7b\x074\xc4x\x82[\xe7\x10\xa1\xedH\xf83:\x11 \x01\x9ac\x9e\xf2\x18\xa5\xbc\xf6\xfd\xe6\x85\xd3\x8b`\x14#\xa8;O\xf4\xeb\x8a\x93\xc1\x1f\xb26\x95\xc0\xcc\xf10|\xe9iH\x06\x08H\xc0\x04\x1a\x11\x89)L\x94\xa9\x14\xd7\xa8\xbaA\x91z1ta\x1c#\xd8;\x8ce\xff\x912tJ\xd6\x06\x11\x10\x00	|\xd1t\xc6b\xd1\x01\x7fe\xc4\x13\x12\xc0[\x9b\xa3\x82\x13\x1b\x01\x00\x17\xbe\x11\x82s\x8c\xe4\xd6z\x07\x995\xe5-Y\xac\xe3c	J\xa0@\xf9\xb4*QgL\xc0!\xdb\x13\x80om\xe2}\x8f\x9cb$\xe9\xe8\x9dCJ\xc6\x92\x96\xdc\xe2\xfe\"g5\xf6!0=,B\xe1(\xb9\x80\xca]\xba/\x95\xf0[%\x1dY\x19\x19X\x8a\xad\x98*\\!\xd0P\xc8\xccf~\xb2=\x0fp\xa1)/\x81J`VO\x98\x87,\x981\x8f\xe9.%*\x13\x00\x07\x08\xa78\x9b\x89\xc2gb\x01\x00\x0fH\xe7\x03n\xe7K\xd28\xd2\x9a\xaa\x9c\xe3\xd6\xb6y\xbad2\xf1y&\x13\xa7>\x0f@\xcer\x06\x92O\x19R\xa7:\xa5\xb9\xc8B4\x12\x9e\x0f\x94$e\xb2	\xcb\xad)\x91\x90\xe8\x03\xe7>\xc3\xd9\xcff\x8a\xc0\x9c\x9c\x13\xa8@\xd9YPBT\x13\xa1\xc1\x8cc'\xe8\x18K\xe3\x00\x8eu\xbf*U\xa3&J\xd1\x8a&@\x04\xff\x1d\xe8\xc0E\x99\xf1\xcf(\xa5\x0c\x00\x0d\xd0h:	z\xcaw\x824\x9e\x87\x8ca\x00\xb3\xd9\xc8CfK\xa5\x9d`iKQ\xc8O\x14\xc2\xf4\xa2\x9d\x98\xa9\xf9\x00\x80K\x80\xba\xc8\x139\xd5)G\xbb\x11\xc7\x9f\x86t\x95E5*\x1d\xc3\xa9\x0d\xa56U\x9f\xcc\x84i'@\xc0V\xf6|2\x914\xd5FV\x05\xcaSu\x08\xd3\xab\xf2\xc3fP\xc7j\xd6\xbe\xa6\xb5\x03\x00\x00\xc1\x07\x06\xfb\x01\x10\x04hE\x8aIl\x05P\x80\x82\x87y\x02\x85sU\xe7V\xa9\xa9\xd7\xbbz\x15\x9b\x12\xed+K\x9d*\xd3\xc0\x82\x80\x04p\x95\x14U\xad\xe3\x00f:\xc0\x01\x8c},Z\x13\xa0\xd3\xbaVBz\x95\xf5\xe9e%\xd9\x81\x04hv\x9f	\xe8\x00\x078\x00X\x12\x80\x16\x94cq\x92'P\xe3\x00}\x9ev\xb1\x00\xb0-n#\x9b\xce\xc9R\xe2\xa0\xab\xc4\xab\x03\xe7\xa8\xb9\xdd\xd6V\xb9f\xcd-ow\x0b\x80\xdf\xc6\xccd\xfe\x98&\x11J\x83\x98\xd2R\xf4\xb8\x8b\xd9\xffl\x02\x98\xeb\xda{XN\x8a\xd2M\xa8H\x01\xa0\xdb\xdd\xf2\xd6\xa5/\xad\xafn=\xf1]`\xf5\x83|)S\xcfi\x13pZ\x07(&\xb3\xb8e\xadF\x9d\xeb\xde\xf8B\x91\xba\x88\x84iLc*\x82\n\xc3\xd4\xba\xf6\x05l\x7f\x815\xbe\xff\x0ek\xb4\xa6)\xf0iS\x93]\xe6\xe2N\xbc\x109\xa8\x83\xaf)\xc9\xf1\xd2\xd7\xbe\x18\xce\xb0u\xedf\xc0\x03\xc6\xa3\x1f\x1f\x06\xf1\x9e\x86\x93\xdd\xf5\xd2\x95\xc1\x91\xe8\xc4\x8a\xd9)L\xa7tB\xbf0\xa6\xb0j\x000F2\x1e\xc5\xc3Q@\x0f\x80\x18b^\xa5\xa2p\xc1\xb7\xebhWV\\Y\nyB\xc2J\x06\xae\xf9\x12\xd0\xe42*d!S\xf0\x84\x88\xd7<`+G\xb6\xbdA\xc6kl\xdb\xa9\x84\x860\x8aN4m\xa6\x10\x03@B\xab\xa8\x82\xcd\x80\xae\xf2j#\x0bdH|\x14\x98\xb1\xad\"\xef\x00\xd2\x89;7\x8a\x9c\x06\xccrJ\xa2\\>\x00\x04:\xd0\xd8m\xe9\x8fm\x87\xe2C\xa8\xd8\x9a\x95\xd5\x9d	\xffF\x9d\xa2\xa30Z\xb5\xe2$ \x99ow\xc0q\x04\x0d\xa7\x0d\x88u\x03:q\xe9Z\x0b\xda\xc7\x92\x85\xa0\x96\xa1\"\xdb\xe9bS\xd4\xa3\x0e\xb6\x97S\xc2\x00f\x8a\xf3rMM\xc0\x08g\x07kY;;\xd6\xb6\xc6tS\xe7\ngF\x14u\xbay]%\xb0\xe5h\x82>Y\xa5\xd8\xcaE\xf6R	\xd8jU<\xfb\xdc\xe8\x86v\xb4K\xab\xe0\xe6\xea:\xc8\xf0\x9db\xb6!\x0c\x80Q\xbfW\x1bt\xee\x91U&\x80Bq\xfb\xb3|\xfaJ\xb7\xc0\x07\xde\x80KS;\xcb\xbb\x9e\xc3\xb5\xb3=\xef\x18\xea\xaer\xbaN\x14\xc0\x8b`jFO\x80\x80\xe2,\xd39\x1d.\x01\x82{<\xdd\x05\xcej\x03\n-\x88\xbc\xc4\x10s]%2|\x8dZ9*\xf2\x97N\x9a\xd3\x1aR\x00\xc0o}&\x9c\xe2s\xe6\xf8\xc7?^m\xa8\xe4%\x04@\x0fz\x08\xc2:G\x86\x07\x80\xe5\x12H\xfa\xcb\x1b\x95\xf4\x12\x18\x19)\xe7\xa89Y\xc7@\xf4\x9c\x87\xba\xe3;'\xf9\x1b~.\xfft\xa1O\xaf\xea\xf1F9\xe6\xec\x16W\xcaEp\x93 \xb9\x08\xd4i\x9eq2\x80\xdd\xeap\xaf,\xc2\xb7\xae\x8d\xae{\xbdzC\xf7D$\xe57\xf6z'=\xe6\xe3m9\x016Y\x02:\xf6\xa8\x80\x15\xd7\xc8\x04(z\xf3<!\xd0\x13I\x8f\xbb\xe4\xef\xbd\xf5\x10<0\xefp\xad:\x1c)'\x81`w\xdbL\x84/<\xa7YEF\x1b3\x9a\x19\xe0n\xbc\"\x1f\xcfz\xbbE\x1e\xb3\xaa\xbf\xa9\xd0\xc9^\xe7\x94C\x92\xf3\x7fWz0\xde\xe9\xad2#\x9e\x1e\xc5\xea\xb4G[/\xfc0\\\xef\x16'\xe7(\xees\x0fx\x17\x8fT~\x9d\xf0=\xe2c\x9f5\xe4;\xd2\xa8&d\xfe\xdf\x11i\xbe^\x97\x12\x85M\xae\xf1\x01\xab\xbfu9B^\xfb\xdbg\xc6\x08\xf4\xee\xfd\xef\x83\xbf\x80\x06\xa4>\xf9y\xa7\xfc\xecS7\xfd\x00\xa0\xc0\xfa\xc3\n\xc3\xe4\x92\x99\xcc\xf17\x7fm\xd0K\xae\"x\x91\x04x\x00\xa0\x01#p\x02`\x07}\xfe\x97\x01\xcc4~\x02\xb8\x06\xff\xef\x83}f\xa7m\xae\x92\x80\x1a@\x01\x0c\x08{Iu\x00\x10\xe8O\x13H\x81\x0c\xb1\x7f\xaf7_\xda@\x01\x1a\xb0\x82\n\xd8\x81(\xc8*\xe1\x84\x01\xcc$\x7f#\x982#\xb0~'(A\x14\xa0\x82+\xc8\x83=\xe8\x82K\x16\x83ME\x835\x18$\xfa\x87\x83\xdb\xe7-;\xc8\x82>\xb8\x817\xe8VB\xc8ODX\x84\xbc\xb3\x83O\xc8\x18\xf9\xc7\x83K\xc8\x84\xfa\xf7\x13\xc9\x15\x85SH\x85u\xb6\x83Vx\x83\xeb\xd7#>H\x86dh\x86^\x18\x85)$\x86[\xa7\x86k\x98\x0c\xf9\xc7\x82\x1b\x88@\x14\xa7\\\x180up\xe8\x06Y\xa8\x86g\xc8*L(|\xe3\x00\x82m\xd7\x87\xab!\x87\x14\xf0\x12\x1aX|\xa8\xc7\x00\x19p\x88\x88\xa8\x06\x7f\xb8\x83^\xe8\x88S\xc5\x00\xe3\x14\x86\x93\xc8*\x8axs\x85(\x85\x9d\x88\x06\x95H\x86\xa0xz\xa3\xc8	\x8ah\x89^p|\xa9h|\x9d\xf0\x89\xaf\xa8\x08\xb1h\x8a\xb3\xb8\x08\x13w\x8b\xba\xb8\x8b\xbc\xd8\x8b\x11\xbe\xf8\x8b\xc0\x18\x8c\xc28\x8c\xc4X\x8c\xc6\xe8\x07A\x00\x00!\xf9\x04		\x00+\x00,\x00\x00\x00\x00\xe0\x00\x80\x00\x00\x06\xff\xc0\x95pH,\x1a\x8f\xc8\xa4r\xc9l:\x9f\xd0\xa8tJ\xadZ\xaf\xd8\xacv\xcb\xedz\xbf\xe0\xb0xL.\x9b\xcf\xe8\xb4z\xcdn\xbb\xdf\xf0\xb8|N\xaf\xdb\xef\xf8\xbc~\xcf\xef\xfb\xff\x80\x81\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x8b\x8c\x8d\x8e\x8f\x90\x91\x92\x93\x94\x95\x96\x97\x98\x99\x9a\x9b\x9c\x9d\x9e\x9f]\x00\xa2\xa2\xa0\xa5O\xa3\xa8\xa4B\x00\x06\x06\x05\xaa\xa6\xb1D\xa3\x05\xad\xb6\xad\xaf\xa4\xac\xb8\x00\xb2\xb2\xa2\xb5\x03\xc2\xc3\xc4\x03\xae\xa3\xb6\xaf\xbe\xa0\xc0\x06\xc5\xcf\xc4\xc7\xb5\xbc\xcb\x9d\x00\xc1\xd0\xcf\xb7\xae\x05\xd3\xca\xd5\x9a\xac\xd9\xc5\xdc\xdd\xdd\xb8\xe6\xdd\xbd\xe0\x97\xe2\xe3\xc6\xe9\xf1\xe7\xb9\xa3\xec\x96\xee\xd9\xe5\xf2\xe9\xa2\x0c\x13\xff\x0cR\xd5\xb3\xb7\xe8\xda\xbb}\xf2\xfa\xfd[\x08\x90\x81C\x87\xeb\x08&\xc2\xf7,\xde\xb6V\n\x192|\xf80\xa2DC\x06\xb3\x9d\xbb\x88\x11\x80\xbf\x86''\x04\x14\x88\xeac!\x8a\xc4\xa6\x914`\x12%\xc7\x95\x16o\xd1s\x19\x08\x80\xc8\xff\x99\x18\xfd\xdd\x84(\xcaY\xb4s\xc3xy\xe4\x99'$9[\xc6t\xbe\x1a\xba\xd2\xe8\xb8V\xd1\x8e-e*\x87\xa5(aP\xa3\xa23g\xb2#\xccwV\x8d\x81\xdd\xca\x95\xcd(\x08\x0b\xe2\xca]\x00aTV\x84e\xcf\xbe\x83f\x95m\xdb3\xa2\xe0\n\x18L\xb8\xb0\x00\xba\xc8\x10\xaa\xfbJ\xcc\xc3\xde\xc7\x03\xfc\xfe\x15#j\x81\xe1\xcb\x97\x11_\xdbw-\xed\x00\xc7\x90\x1fK\xce\xd4rNe\xcc\xa81/\x00\x16\x0f\xa6\x87\xd7\xc5\x1c\x97\xf3<n\xf4=\x05\xb8+\x0ct\x03\xc0r\xea\xdf\x997\xab+\x10\xfb\xb5qa\xa0\x83q\xa3\xad\xcd6%\x00\xb8\x15T\xc8\xbd;\x0d\x80\xc1\x1b6\x00\xdfNX\xb8\xeba\xc6a\xbb:J|\xaf\xf3\xe7\xa2\xa6\xe7\x96^\x9d\xcc\xf5\xec\xda\xb9\xcb\xdfL\x11tla\xd8B\x0f\xfb\xc6,\xbdz\xea\xb0\x84\xf1^v\xf2\x15(@3\xcc5\x06\x8f~\xe4\x9c\x87\xc9(\xff\x01\x18\xe0\x16\xbd\xc1\x17\x9f\x81\xdc\x19\x94`1\xf9\xe5\xd3\xff\x8d6\xfc\xfd\x92^t\xd1\xe96\xe1\x15\xef	@ \x86\xf3\x81\xf5Xy\x9f\xd9\xf7LH\xe2\xd1d\x0f\x84$J\x88E\x85\xd8]\xc8\"p\xab\xb9x\x15\x8c1\xca\x16\xa30\x8c%e\xa3D8\xe6h\xa2\x83G\\W\xd8\x8a?f\x06\x01\x04\x83\xf9\x04V\x82\x1d\xee\xd7 >\xb6@i\x8d@\x11\xea\x08\x05\x00X\x1aF\xe5\x8fR\x12\x96f]G\xa5\xe5\nmD\x1aC\x8b\x92K\x82#\x8a\x04\x04\x10 \x01\x999\xb2w\"\x12\xbd\x0d\xe6\x1bak\x1a(Ja\xbe\x05\xf9\x94E\x15e\x85\xca\x96\x18\xb1\x03\x80\x04\x98J\x10\xc0\xa6\x01\xf8\x99J\x99\x82\x8e\x86\xa6ei^\x96\xe8a\x97\x95\xbaZ\xa1\x84Y\x86\xd8A]b\x13\xa6\x05vm#f8\x99\x12\xc0)\xa7\x9e\xa2\x02\xea\x93Q\xce\xf5\x1b|\x86\x96Zlw\xa3\x14\x86e\\Z\xfe$\xa7L\xc7X@kQ\xb8\x1cC\xd0\xa5\x98\xee\xaam\xa7\x7f\xfa\xea\xe4n\xa3\xd2e\xa0`+\nv\x18\x00\xd3\x1e:\xffW\xb3 b5\xcc5\x17\\\x90K\x04\x11L\xbb\x0bMy\xde\x88\xa9\xae\xdbj\xdbk\x93%\xa2\"\xac|\xe2\xf6(\x80`\xabYP/\x9a\xad\xc2\xe5(_\xbb$\x85J\xbc\xf4.L\xed+!\xea\xabi\xbf\xfd\xfe;\"\x89\xba\xc95\xee\xa1\xc5\xc6[W\xc5\xa7\x1d\xe6p\xc4\xcd\x85\xf5J\xc5\xf4^@/*Z\xdd\xfa\xc9\xa5\x1c\xe7\xdc\xa9.\x1f\x87\xdbf\\\xbf\x15\\\xd8\xa8\x10P\x8c@\x04\x81\x15\xcb,\xb4\xd1t\xe6\x0cM0W,3*\xca\xd8\xcc\x8c\xce:\x13\xa0\n*p\x15*r\xd0\xc6fy%\x001\x1f\x9dl\\+/w\xd1+\xb7\x90\x1dA\xbc2\xa3\xbc\xc25\xbdX\xcd\x0c\xbfXs\xacuDhV\xf6uju\x85\xbd\xda\xc9\x11 \x80\xc0\xd4~\xd3\x85\x18P4\xdd\xfb\xf2\xdbp\x17\x8e\xf4\xdcv\x9b\x02@\x9fyg\xbd\xce[\x7f\xa3\x86\x18\xc9\x07\x8b\x02\xb7\xccf'\xde\xdb\xbd$\x91\xed\xb4\xdb\xf1\x06\x00\xb7\xe1\x95\xff\xd2'\xde\x99w\x8a\xff\xb7\xd6s\xcf\xdd9jc\x83N\xf6\xe8\xf1\"`/\xda}\xa3n\xab\xf0\xbb\xb0\xae\xeb\xeb\x08\xc4\x1e\xcb\xe5\xb3\xd7\xce\xeb\xec}r\x0e\xf4oc\xb3j(\x00\x87/\x7ft\xf3\xa3h\xb6\xf9L\xdc\xd3Z\x0b\xd9\xdd\x17pA\x00\x857?Y\x11\xd0G/\xbd\xed\xd4k\xbd\xbb\x95\xaa\x93\x8c\xfe\xfa\xae\x1b\x0e~`u\x9b\x05\xb40\"<\xf3\xa1/\x02\x01\x18\xc0\xfa\xfc\xe7<\xcb\xdd\x8ev\x99\xab\x9f\xfd\xaeg\xa8T\xd5eT\xad\xe2\xde\xe1\\w\x01\x06\xce\xad.\xb9\x83\x9f\xd30R8\x0b\x80\xaf}	\xec\xa0\x07\xdf7\x04\x00l\x0b\x82y\xab_\xb2Z\x05:\xba\\\x90\x82u1\xdc\xf2T\xe8\xbe\xad\xb5\x875\xeah\x1f\xec\xfc7\x80\x02\xf8\xcf},\x9c\x1b\x0c;5?\xfa\xcdNJ\xab\"\x99\x0dox(\xf4!\xc0O<D\xe2*\x16\xb2\x92\x81\xd0\xe2\x15G<b7\x8e\xd8\xc0\xab51g2\\\x95\xfdhx%*n\xcf\x7f\x01\x90@\x16\x91V7Q\x00\xff\x04\x00\x18\xc0@\x062\xe0\x15\x0d\x86\xf1\x8fe\xbc\xd9\x19\xb3\xf6\xc4\xf8\xb1\nmm\xac\xcb\xf5\xac\x88\x80\x00\x94\xa0\x04a\x04\x80n\x18\x08\x11\x0c\xec\n\x03\x1a\xbcT\x02\x12\xe0\xc7?\xae\xf0}\x97\x1b$!\xb5F\xbdCN\xb1w\xae\xea\xe4\xe1\xb2\xd8<\x05\x8cbf\x01\xb1$\xa70i8Z\x11 \x01\x7f\x12\xe2\xd1\xa4e\xb8\xc9\xb1\xd0\x85\xa2\x1ce)\x97uJT\xaeF\x97\x9elet\x04\x92\x81<\xe6Q\x83\xb6\xc4%'\xd1U/iY,\x89s\x0b&\xd6$8\xc1b*\x92Y\xc8\xf4$\xc0\x02&\n>*la}J\x00\xeeX\x82\"\xcbis\x9b2\x84K\xe0\x12\xd9\x1b\xc4\xe8\x92\x97G\xa4\xa3\xb7\xbe%\ni]n\x93\xb8\xf3\x82\x0b\x07E\x9a%\xbesz\xc3\xbc\x1c\x9a\xdaXOQ\x08\xb1b&\xec%\x006I\xd1iJ2PO\x02h \xe1\xc7\xab\x8d\xbe\xe4\xa0\xc2\xdc[\xf5\x18\xda\x9b\xba\xecMr0\x9b\xd6\x01V\xca\xd2\x8a\xee\x93\x9c\xff\x1em\xe1\x03hG\xd0{\x80\x14\x9e\xd5{\xa2I\xfbVH\xb7Y\x8c\xa5@miE-Z&`i\x01\x00\x0fH\xea\x03v\x15\xd0M\x84\xf2\xa6!\xcdi\xf5\x0c\xb9RT\x045\xa8C\xcd\xea8C\x15;\xa4*5\xa94\x8d\xe9\x1f\x80	U\xe9\x95\xb2\x94WM\xeb\x01*\nT\x8a\x8a\xa0\x03\x1d\x10\xc1Ka:\x05\xaf~5\xa9L\x15k\x1f\x9eZV\xb3J\x10\x00j\x0d\xacP\xdf\x1a\xd7Q\xc8u\x15\x1f\x03Y{\x96 \x8a\x06\xdc\x15\xac\xbb\xaai$\xf8\xda\xd7\x08\xcaP\xb0\x81M\x80\x08\xde*W\x00\x80\xe0\xb3\x13\xdaj\xa8\x9a\x80\n\xc7>6\xaf\x055he_(\xc3\x0e$\x00\xb3m\x85ka=\xfb\x81\x0f\x80\xa0\xb6\x83\x12\xed\x93\xd8\x89\xd8\xd2>6\xac\x0f\xc2\xdcjq\xda\xa7?q\x80\x03\xae}mf;p\xdc\x0e\x88\x82\x04\xb7\xadmtm+\x19\xddV\xe0\xba\xd7E\x01\n\xbc8\x8aM\x9a\xf6\xab\xa8\xbd\x87\xfc\x86\xab\xb7R2\xf7\xb8\xc8M\xffnV	{\\\x00@\xf7\xb3\xb5\xfd\x00	\xe2\x0bZ\xc6&6:\x0e\xa0\xa8\x03\x1c\xa0\xdd\xee\xb65\x01\xbf\x8d\xac^\xed\x10?\xd5\xaeV\x86\x86\x85+z\xe3\xba\xd9\xcd\x9e\x97\xb9\x9e\xbd\xedt\xe5\x9b\n\x12\x9cg\x9c\x0e\x00\xea~+\xb0]\xe5\xb6\xf5\xbbJ\x0d\xaf$\n,\\\xf2r\x13\x00\x8f,\xc1(\xce\x9b^\xf4\"\xb7\xb9\x11\x96\xaet\xfb)\x903]\x147\xf9]i\x026\xac\x9b\xb4z\xf7\xae\xc0}I\x1fE\xc1\xcd\x12[\xb6\xc8H\x9e\xdd\x9f\x1e\x897T\xbc\xd5\xc5p\xed,|\xa5kajN\xcb\n\xa2(\xd1~w\xccc\xc0\xfa\x18\xc0w\x15\xf1 \x00\x10\x822\x9b\xb9\xcc$\xe6f\x0c\x93\xcc\xe6nI\xa0\x04\x10\xdc\x9c(d\xdb\xd9\xf7\xd2\xb7\x9f(\x83\x08\x8a\xfeS\x81\xfd\xfa\x99=\x99\x05\xf1R\x05\xfc\x92\x10h\xeb\xa9l6h\xa2\x93\xdc\xad>=r[K\x19\xf2(\xce93\x868\xef\xbe\xdf\xcal\x02@L\xbb\xa6\nB\x14\x86\xe6\x14\x91\xff7\x95h\xa6.\x9a\x9b\xdd\x1a\x85\x9f\xe0\x0c\xe9$\xa0KZ\xb0\xa6\x15\xa5\x01\xa0\x91	4\xd0?^\xc9\xb1Z7\x19\xe6\xc8\x82\xc4+\xfc*\xb2\xa9OM\xbdT\xcfb\x14\xfd\xaan\xaca=iZ\xd7: Y\x18\x85\x9f\xa7=m\x0fc\x95\xd3\x84f\xc4\xa8\xd1Hl%/v\x15K\xf4\xb4\x08\xfb\xc9\xecU\xa4D#\x1b\x05\x00\xb5\xd7\xedgk\xebx\xd3\xe0\xed\xa8#\xb6\x8dPb\xa7ZT\xe1\x16\x95Y\xc0\xe5l\x8d@\xbb\xae\xa9\x98\x1b\xbb\x07\xaek\xa1~5\xc8\xda\xa6\xb7\x13\x8b|o\xd2n\xec\xd0\xb6)\xcbM\"]k[S\x01\x00\x0d\xc8x\xc6EA\xf0\x8e\x17\x1c\xde!\x96\xf7#P\x01\xc3\xe2\xd6\xd8	\x94\xdd\x19i\xa9\xb2\x95~/\xc4n\x18\xd7\xb8\xcc5\xee\xf1\x81\xbf\xf6\xc7\x90\x15\xf5\x80\xbb\xd2\xc7L]x\x14|\n\xb6d[(q\x8e\xb0\xa5&w\xbc\xf8\xcc\x97\xce\xf4\x8c\xd7|\xcb`\xc6\xab\xc8G\x8e\xadLY\xfdO(\x1f\xc1\x9eJ\x19\xff%\x96P\xe5\xdfQ\x9ax]\x9bN\xf6\xb27\x80\xe0\xdfExA\x82^l\x9f\x9fb\x04'\xb8\xd4\x13	\xe5L>~]\xcf\x84\x12\xbb\x14\xe2\x87)\xb3\xfb\xbd\xe9~6m\x03\xc4\x8c\x08\xb9\xa3z\xe8\xb3\xa0\x80\x06N\x10wLEz\x14\xcd\xcc\xa3\xdd\xef^]\xbdG!\xcd\x8c\x96\xc0\xdf\xff\xae\xf6\x97\xb0\xbd\xd8\x88\x87\x9f\xe2\x17\x1fwX\x88\xa2\xa2\x91\x7f&\xe5\x19\xbb\xef\xbdw{\xd1}\xdf<\xe1\xc7\nt	v\xeb\xf2\x00\xa0\xc0\xe8I\xafu\xcae5\xf5z,:Q\xec\x0by>\xba\xfe\xf5\xc8/\xb5\xce?\xadu\xc3\x9b\xdcf\x00\xd0\x80\x06t/}\xe97\x7f\xa2-\xdd\xe3\x1e%\xbf\xc7\xa2\x87\x1e\x00\xdb\x9f\xe5\xad$\x0d\xf4\xcf'\x7f\xd1\xa4\xde9\x18\x000\x02\xad_\xddj\xd1\xaf>\xf55\xd0~/\xafT\xf2\xa8\xd7>\x1fKC\xda<^R\xaf\xe4\x17\x80\xa9\xb0/H\x16z]A\x01\xf5\xe7vuU}\xd2\xa7{\xa3\xc0Ry\xb4VC\xa5\x7f\xff\xc6\xb7w\xfe'~{%\x80{\xa5{\x08\xe8~\x95\x13\x7f\x0ch\x12\x9bt\x00\x11(\x81\x16\x05~\xddg\x80\xf0s\x81\x01\x10|\xd8ty\x1c\xd8\x81*\x18%\x0c\xa8\x01\xfdP\x82\x12hz\xdfv\n\x14\xb8I\xea\xb7\x0c\xb9\x17\x832\x88\"!(\x82-5\x83g\xb2I\x19@Q?\xf8\x0bB\xa8{\xbd\xb7#\xf2g\x84UE\x19J\xc8\x84/HZO\x88\x80\x81\x94{\xd5\xd7\x0fUh\x85\x9b\x84\x01k\xd5\x84\x96\xf3\x84H\xc8X_h\x12ah\x85$\xa8cf\xc8\x0cB\x08\x80\xa8\xc0\x00>\xe8\x1e\xcaE\x86\x07\x10\x87r\xc8\x81M\xb8\x83\x02\xf5Zz\xb8\x87Y\xc8\x04AH\x01|\x08\x18+\x15\x00,\x95\x86mq\x88\x88\xd8\x08\xd8\xc7\x88G\x08\x88I\x04\x89\x89h\x06\x80E\x89X\x95\x89\x0f\xe2\x87\xf36\x86W\x05v\x85xl\x8e\xc8\x07\x135\x88\x07\xf0\x0f\x9eX\x8ap0Q	\xb0\x84\xac\xd8\x8a\xae\xf8\x06\xb0\x98\x00+Q\x8b\x9c\x90\x17\xba\xe8	\x1e\xa7\xd8\x8b\xc0\x18\x8c\xc28\x8c\xc4X\x8c\xc6x\x8c\xc8\x98\x8c\xca\xb8\x8c\xcc\xd8\x8c\xce\xf8\x8cD\x10\x04\x00!\xf9\x04		\x00+\x00,\x00\x00\x00\x00\xe0\x00\x80\x00\x00\x06\xff\xc0\x95pH,\x1a\x8f\xc8\xa4r\xc9l:\x9f\xd0\xa8tJ\xadZ\xaf\xd8\xacv\xcb\xedz\xbf\xe0\xb0xL.\x9b\xcf\xe8\xb4z\xcdn\xbb\xdf\xf0\xb8|N\xaf\xdb\xef\xf8\xbc~\xcf\xef\xfb\xff\x80\x81\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x8b\x8c\x8d\x8e\x8f\x90\x91\x92\x93\x94\x95\x96\x97\x8e\x00\x9a\x9b\x00\x98\x9ef\x9a\x05\x06\xa3\xa3\x05\x9b\x9f\xa8^\x00\xa2\x03\xad\xae\xad\x06\xa6\x9d\xa9\xb4V\x00\x06\xaf\xb9\xae\xb1\x9aD\xb3\xb5\xc0I\x00\xba\xc4\xbb\xa6B\xb7\xbf\xc1\xcb+\x9a\xc5\xcf\x03\xbc\xb7\xbc\xcc\xc1\x9a\xb8\xb9\xa4\x05\xdb\xdb\xd8\xc6\xdd\x06\xca\xd5\x9e\xd7\xba\xa4\xe7\xdc\xdc\xde\xb1\xdd\xc7\xe3\xe4\xde\xbb\xe7\xf3\xec\xe9\xd8\xec\xa3\xe2\xef\x93\xc3\xd9\xf4\xff\xf5\xd4\x95\x12\xe5n\x1f?s\xa3\x10\xfeK\xd7\x8e\xa0>\x83\x8d\xfa\xbd\x8aG\x0c C\x82\xdb\x1eBT$\x11Z\xb1\x85\x173n|t\xcb\xe3G\x80\xf58\xf5j\xc6i\xe4\xa1\x8e&a\xa1\xcc\xa7\x89\xc1\x84\x9b\x0c61\xd8\x99\xd3e\xa1\xff\x921\xe5\x85\x94\x05\xc0\xe6\xcd\xa38y\xf2\xd4\xe8s\x0fL\x8f\x01\x19\xd6DJ5\xa9\xd2\x9eM\x01%S\xb9)\xdbPYF'\\=\x9a\x93k\xcb\xacN7AX\xc0\xb6\xed\x02\x08]\x05F-*\xf6\xea\xd2P\xf6\xd0\x9dBKG\xed\x02\x01\x80\x03\x0b\x06\xfcv\xd3@\xa9v\xef\xb2\x9a\xd8M\xe8J\xben4\xad\x1dL\xb9r\xe1U!\x8b^-\x07-\xa1c\xa6\x90\xc5h\xfa[\xb9t\xe9\x05x\xd3i\xde\xc99f\xbc{\xa0Q\x9d}\x03\x80\xf4\x86\x0d\xa6s\x0fF\x8d\x99[\xd1\xd6A\xcd\xb9z\x0c\x0c\x80\x82\xe3\x15\x88\xab\xa9-\xe06n\xdd\xd0\x03\xa3V\x0d\xd4\x95\x87\xe0\xcf\xc2-3\x8e\\A\xf2\xd8`\x98;\x8fN>0\xe6U\x14\xafc\x87\x06\xbe\x92\xa6\n\x15\x8e{\xdf;De\x17\xf1\xb7\xcb\xeb\x17\xb0\xaaz+\x0f\x00\xe6r]=\x14\xb1\xc7\xcc{\xdd\xcd\xc7	\x01\x014H\x00}\xb6@\x00\xd8x\xfb\x95\xb7\x8a\x80\x00f\xf8_+\x05\xc0\xd2\xff\x98I\xda\x1d\x88\xa0|\n\x00 \xc1\x89\x124\xa8\xe2\x83\xcaE!^s\xf9UH\x1eL\xea\x0d\x90a\x80\xb10\xd6\xa1I\xed\x91\x83\xe0&(\xa6\xa8\xe2\x8a\x10:\x01\x80\x84\x82\xc5(#t\xa8YG\x8cz\x8b\xad7@A\xefl\x12\x9f&'28\xe4\x90,B\xc1\xdc`J.)\xd8[\x93\xf1\xe7\xd1\x80;J\x19M\x8f\xb4\xfcx\xe2\x96pv\xc9\xc4\x91\xa4\x81\xf9\xdc\x92\x00\xec\x86$\\&-\x16\xa09\xdbTD%D*i	'\x97-\x16Q[\x9d\x94\x85\xc9\xa4yy\n6Y\x93\x9d\xa5i\xe3\x9f\xf1\\\xf8\xdf\x80!6\xb5\x89\xa1\x87\x12\xc9\xd4\xa2\xd2U\xe6\xa8\x00HJ*\xddhcJX\x184Q\xe6\xb2c\x80\xc0E\xd3iV\x00\x10\x00j\xa8\x0e\xb6H\xe7_o\xe5F\xe1d1\x06\x0b\x18'\x8c\xba\x8a\x9ag\xba\xc4\xea\x8a\xa5kj*\x135|\xe5\xca\xeb\xa1\x0f*\xeaV\xaaL\xa6\x9a\xdf\x97\xa8E\xb0\xc9\x98m\xf5\x13\xcbk\xd0r\xf8\xff\x1a'\xeb\x90\xc2\xe6\x81\xba^{\xe8c\x8b\xa2\xa6_\x99\xab\x02\x0b@\x04\x11X\xc0j`k\xbd*\x93=\xcd\xceCT(\x1e\xba\x0bY\xae\xf1\xca\xbbe\xb6\xcd\xb0\x15)y\xc6\nv\xe4\x05\x17\xec\x1b\x01\x02\xe2\x1e)i\xb9\x82Rtn)\x9aX\xe0oWk*\\\xad\xae\xbb:\x1c@\xb6\xb5yL\x18[\xb9\xe1K\xd8\x91pa\x8c\x00\xc7\x9b\x90\xf6\x16\xc8\x80\xaeK2\x00\x16\xf4{\xb23\xf98\xb4\xf0\xcb\x0d\xbb,\xeahnAg3\x7fpi\x8c\xc0\x05<Kv3\xd0^\xb5\xbb\n\xc6E\xf3\xdb/\xbb\xd2\xdc\x8a\xab\x83-\xbb\xcc\xa2[\x8c\x9e\xc6\xad\x00\xbc\x85\xbd\xf3\xd5\x1dk\x027jk\xf1i\x0ePC\x13\x8d\xb1\xd8GO\xd3I\x7f\xa1\x01\xb0b\xda\xd8\"\x1a5t_B\xca/\xc6\x7fg\x0du\xd5\xcbV4\x8d\xadD\x8b\xfd8\xbf\xbd\\sL\xa2>\x19\xbe\xa2\xd3L\xabX\xef\x8c\x8c\x86{5\xe4:\x07.\xb1)p\x9d3\xd14$k.\xff\xf6\x05\x1d#\xf3\xf9\xbb\xd5X;:\xe9,\x07@'yp%\x0b\xc0\xce\x170\x88\xf5\xc6\x9d\xd7V\xb5\xe7\xa2\x18\x8c^>aC\xae\xf9/\xa0\xa3%:\xe9\x0f\xb3\xfc\xaftm\xa3:\xbcy\x1b_\xdd \xd6;\xaft\xe4\xe0\xb4/tM>\xb7[\xcf32\xa1\x19\xe1;\xf7\xdd\xeb:\xf1\xdd\xe1CP|\x9d\xa8\x99\xdb\x05\x02\xa0\xb3\xf4\xd5\x07{3\xd9\x17zL\xf1\xb8\x01B\xce\x80\xf5;\xc2\xf6\xf0\x97\xbfH\xf1f\x7f\x80\xf1\xdf\xff\xcc37\x04(\x0f}\x08\x98\x85>\xda7\x8f\xe3\xf9+\x1cE\xbb\xda\x07\xd1\xc7\xbb\x91L\x90\x82\x87{\x10o\xf4\xa7'\xff\x91\nn\xc7\x9b\x1b\x01$\x00\xc2\x10*\x81\x84\xeeB\x80\xbf\x18\x88<\x02\x14`\x80\xe5k\xe1F^\x08C\xb4i\xc2{\xa9\xd3\xe0\x06\xc5\xd7\xc1\x00\xf0\xb0\x83\xe2\xfaa\xf4\xcea\xc2\x93\x95/\x02\x01\x18\xc0\x00\xe7\xa6DB51N,\x83\xa2t\xa4\xb8\xc1\x00V\xb1\x04%\xe8\xa0\x0f\x7f8=\xf8	\xff1\x84\xe5C@\x18{XF\x83\xdc\xef\x8chK#\xb8\xc8\xa4\xc1z\xc1E\x8e\xab\x93c{RC\xc4\xf4upJ\x1d\xec\xa3\x1f\x11\x87\xbf4\xea\x0fX\x84,d\xb9\x10\xc9\xc99\x1e\xb0H\x9b\xc8H'\xb7\x11\xc9\x08\"\x81a\x94\x04\x9e \x03\xc6F\x1bn\xb2\x93\x8a\xf4\x05Y\xcc\xc2\x15X\x96\xd2\x94\x12L# \x99\x06E\xb8\xb4rQ\x87\x94\xa3\xc9\xe4X7\x96\x00\x00'\x00\xc0\x8023\xb0\xaf\xf4I \x01	\xc8\xa1-%9IK\x02r\x95\xb9\xc2\x99\x0d\xe9\xb4	,\xf2\xcb\x02s\x13\x17r\xa4\xa9\x99d\xaa\x08\x039\xf4\x17\x01\x12 \x81f\x86s\x98\xf3\xc3\xa5\xfd,\xd94\x18\n\xd2{U\xd3\x1f\\\xf4\x97C\xdb\x9d,>\xc79\xde\xd8~\x83\x81s\xa63W\xec\x8cf\xe6LV\xb4\x93\xc9S\x82\\\xd2\xe5\x19\xe9\xf9 A\xa2\x92E\x1a\x1b\xa8J\xae\xc4\x95\x0cx\x94\x99aS\xe7:a\xc6\x95\x87\xe6\x12[\xc1\x9b(E\xbd'Hdl\x02\x9a\xff0\x85\xe6\x88\xe4\xf3\x9d\x921\x14\xa1	\x80\x98I\x9b\xc0\xc4\xfc\xed2\xa2\x14}i\x02\x0e@\xd4\xa2\x12\x15\xa6V\"\x91\x82\x80\x04M\x9d\xeetNN\xab\xe7.\x83:T\xa3Z\xb5\xa81M*\x89j\xcaNj\x16.\x95?\xbd\x16=\x01p\xd5\xb2\xc64\xab3\x1dg\xf6\xb4\xb0\xd6L\x98\xc5\x17am\xe2X\xcbJ\xd7\xa3\x9eU\xa1\x00\x00(M\xdbZ\x05\xd1\xf1u\x11\x00\x08\x81`\x07\xbb\xa0\xb8\xda\xd3\x92d\xad\xabQc*\x02\x11t\xe0\xb1\x8e\xed\x80\x08\xd2\xbaT.L\xd0\xa9\xfc\x08\x81\x8a\x06\x1b\x82\x9er\xcf\x9a\x9f\xb5d;\xa1IW\x986\xf6\xb1\x90\x9d\xacJ\x1a\xab\xd5\xbd\xfeu	\x00x\x00\x9c\xbc\xfa\x07Mh\xd6t`\xe5\xd5JS\xea0\xd1\x02 \xb5\x8d\x0dnd9@\xdc\x0e\x14w\x13$\x00\x81rI@\x82\xbd\xb4V\xade\x8c\xed\x03\x1e\xb0\xab\xd7&\x82\x96\x7f\x14\xebnW\xaa[\x96\xb5\x13\x00\xc4\x0d/\x07\x8c\x1b^\xf2\x8e\xb7\x03\xc8\x05\xff\xc1\x07H\xf0\x81\xf6\xb2\x17\x04\xcdU\xc6s\x15\xe4\xa2\xe9\xdaW\xb6\x88J\x05W\xd2\xb6\xdd\xfe\xf6\xf7\xbb\x00(\x81&\xccK`\xc8r\"\xb9\xeauo{\xd7\xdb\xde\xe5\x8e0\xafJ\xad\xa9\x914q_\xean\xc9\xba\x91\xf8\x14\x1a\xfd\xeb\xdf\x13-\x88\x00%\xe0'v\x01\x80`\xe5&\xb8\xc1\x0bN0r\x1f2_	\xd3\x91\xc2\x15~\x18m\xaf\xab\xe1\x18r8\x8d\x1e\xde\x04\xe7\x18\x04b\"\xd9\x8f\xc4&V\xee\x82?pb\"\xc7w6\x12\xa4lMKj\xccMT\xb8\xba3\xe6H\x8dmL\xd1\x1ck\"\x8f<k\x10\x1c\xf3\xfbc\x04\x0f\x99\xc8).Y\xdd\x16\x99V\xf8\x98\xb9\x02(@\x81\xfa4\x01\xd3\x18s\xb9J\x85=\x9c\x95\xe9\xa3cq\xf18\xc4\x17\x16\x06\x90\x83\xec\xe0\xccq\xae\x85\xadu\x00L\x1d\xe0\x804\xb3\xd9\xaa	xr\x9e]HK\x86\xfa+\xc9,\xdb\xf2\xa2\xf5l\x96\xb0q\xee&\xd1\xe5\x8e\x02\x1c`TB\xa39\xb1\x8bM\xf4}\xff/\x1c\xe5\x0c\xb7\xd3\xd1&\x03\xcd&\xf0<\xe9\x1f:\xda\xcf\x97>J\x1f5-\xe8\xa3z:9fU\xb4\x8f](\x81\x07\xa1\x9a\xcc\xb3\xe5\xe9\xaf\xc3vL\xaa\xccZ>\x84\x86&\xa1\x1d0\x1f\xb3\x8a\xda\xbe\xa4^\xa2	\xf8\x99\xea\x1e1\xd1\xda\xc3.vUf\x0d\xd0\n,{\xd9\xcdv\xf6}A\x85\xd9\xde\x99`\xda;D\xb20\xa0\xacnE\xa1\xfadU\xc1\xb4- \xac\xd4\xbd\xd6\xf5\xd9\xd3\x8d\xf68L$\x81s\xf7z\x87\xed\x9c\x93\x90\x88\x84U\xbc\x8ex\x13\xd5\x8e7V\xfaz\xf0\xaa\x8a\xdb\xbe\xe4.\xf5K\xd2xn\x13x\x98\xa7\xd5\xc5\x80\xb3\xa1\xa9L\x0c0s5e\x19\\\xbc\xa3\x0c\x80o\x9b\xdc\xe1W\xc57~MW\x9c'\x8a\xf6\xdc\xd6\x8e\xf8\x01:\x8e\x81\xbb&\x80\xe6\xcc\xb4\x8b\x08\xb7\xbd\x85\x92\x9b\xfc\xe7(/\xf8\xb8\x87\x84a)\x9bh\xb7\x01\xe7i\xaf	\xbe\xd8\x8f:\x1d\xe7 g\x0d2\xc2\"\x96R\xfb\xfc\xe7@O9\xbe\xdf\x0c\xff\x89S`\xc9\xbfl\xba\xe8\x83\nn\xf3\x04d\x80\xe6Q\x97z3t~\x05\xae`\xfd\xed\xc9F\xf4\xd0M'\xf19\x00\x80\x02#\xf8:\xd8\xbd\x84c\xb2\xd22\xa6\x1e]f\xda{\x92v\xaf\x02\xa0\x01\x88O<\xe2\xe1\x8eu\x94Cs\xd4\xbbf\xc4\xdd\xf1\xce\xef e\xa9\xefO\x00\x92\xf7\xa2yJ\x00\x98\xdd\xe9\x83g\x1f\xdb\xdb\xae\xf8\xd2\x9b\x9e\xf1q?\xea\xdc\x1bT\xf76h\x82\x02\xb0\xcf\xbb\xe5g_$\xd8\x8e \xef\xfcn+\x00\x9c\x9es\xb6\xef^'e\xc1\xc2\xe1MO\xfc\xe2\xbf}\xd0\x90g}\xeb\xcb\xa0\x12\xd8;_\xf6\x96\xb7\x8f\x14\xee>\x82\x13\x9c\xa0\xdd\x8a\xd2\xc4Gk\xa2\x94\xc1\x9d\xdd\xe3\x1f/\xba\x04\x8bO\xfe\xf2/~\xd9\xca\x86x\xe4\xb5\xe2\xfc\xf6\xc7\xbe\xf2\xb5\x9f>\x054\xa0\x81\xea\x9f \xef\x13\xa6%2\x94\xb9%t\n_\xb4\x12`~\x02xz\x0d\x00mt\x17\x08\x93\xe7~\xb1\x87{$7\x7f\xf4\xa7\x01\x94\xc7V\xfc7$\xff\xcc\xf4\x7f7\x86c\x018\x80\xa6ga\xbd\xc2~\n\xf8|\xf8gY\x0f\x08\x81!(|g\xd7j}u\x81*\xa8+'B~D\xb7|_\x90\x80\xce'}\x96\xe5\x80\xf5Gr\x9fWP\xac\x97\x05#vy+\xa8\x82\xeb\xa7\x072(~.\xd2~V\x07M\x19\xf0y\x15(\x1a\x07\xc7\x15>\x08\x84;\xc8\x07	\x08\x83\xf5\xe1\x80\xb0w\x84f\x87Tv\xd0\x84N\xf8oZB\x84h0yTX\x1f#\x08\x81`h?H(S4\xa6\x7fy\x10\x7f1X\x86\xf4G\x01gX\x1f7\xa7\x85O%\x846X\x86\x86ws\x07\xc0yw(\x84\x9c\x90\x87{\x18\x00}8\x86\x7fhY\xd87}	@\x88~x\x88\xe4\x00M\x8ch\x88\x8e\xb8\x85\x90HT\x928\x89}Q\x89\x96\x88\x89\x97\xe0y|XT\x97\xc8\x89\xb4\xc1qV5\x87\xa2(\x85J\xe1p\x8dx\x8an\xb5	H\xb1p\xac(	L\x16\x8b\xb4X\x8b\xb6x\x8b\xb8\x98\x8b\xba\xb8\x8b\xbc\xd8\x8b\x06\xbe\xf8\x8b\x87\x10\x04\x00!\xf9\x04		\x00+\x00,\x00\x00\x00\x00\xe0\x00\x80\x00\x00\x06\xff\xc0\x95pH,\x1a\x8f\xc8\xa4r\xc9l:\x9f\xd0\xa8tJ\xadZ\xaf\xd8\xacv\xcb\xedz\xbf\xe0\xb0xL.\x9b\xcf\xe8\xb4z\xcdn\xbb\xdf\xf0\xb8|N\xaf\xdb\xef\xf8\xbc~\xcf\xef\xfb\xff\x80\x81\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x8b\x8c\x8d\x8e\x8f\x90\x91\x92\x93\x94\x95V\x00\x98\x99\x99D\x9b\x96\x9e`\x98\x05\x06\x03\xa4\xa4\x06\x05\x9d\x00\xa8\x00\x9f\xadZ\x00\xa3\xa5\xb2\xa5\xa7\x98+\xaa\xa8\xae\xbaP\x9a\xb0\xb3\xbf\xb4\xab\xb0\x06\xac\xbb\xc6F\x9a\xa2\x06\xcb\xcb\xc0\xce\xb5\xcc\xc5\xc7\xbb\x99\xa2\xce\xb4\xcc\x05\xda\xda\xb1\xb4\xc3\xc4\xd3\xae\xa1\xdd\xd7\xb2\xcc\xd9\xdb\xdd\xa7\xcb\xb9\xe1\x95\x98\xe4\xe5\xc0\xe7\xec\xe9\xa6\xca\xd2\xee\x91\xbe\xf2\xfd\x03\xf4\xa7\xec\xadk\xa7\xef\x11?\x7f\xfd\x00\x06\xe4\xc6-_\xc1E\x07\x11\xd6K\xa7\x0e\xe06Q\xdb\x1c><t\xb0\xd9\xac\x85\xca\xe8]\x0c\xa9p\xa46\x8d\x1b	\xf1\xf3\x18\x8c\xa2Bt\x17_\x9aD\x992\x90\xaaQ,\xff\xc5|Y\xd2\xa4\xc5\xff\x914k\xfa\xf9\xa6n\xa7H\x93\xdc&\x8e<\x9aQ\xa8\xca\x90\xf7\\\x82DJ\xb5j/MCz9\xd5\xf3M\xa7\xd1\xaa`\xa9fb0\xa1\xac\xd9	\x0c\xc62X\x1bt\xeb\x9b\x9b0\x19\x86\x9d{\x11\x13\xd9\xb3x\xcb\xae\xdd\x9b\xd6\xed\x1d\xa2t\x03#\x05p\x97o^\xbd|\xfb\xfa\xa5\x03w\xaa`\xc1\x84\x13\xef5\xcb\xf6*\xd6\xc5p\xbe=\xde\\W\xf2\xdedF\xd9u\xc2\xac\x06.]\xcb\xa1 K\xae\x16\xcfk\xc5U\xa4\xb1\xb4E&\n\xd7LM\x10\x16\xe8\xde\xbd\x00B\xb5\xd3\x9fo&$'zv\xec%\xb6\x9a\xa8J\x9d\x11Sn\x01\xd0\xa3K\x8f\xde\xfb\xb7\xd5L\xad\xe5\x117e\xfc8\x12\x00\x12\xba[_\xf5|\xba\xf9\xf3\xd5m\x0f\xce\x8e\xd0\\\xa9\xe4\xdey\x110\xce\n\x17\xa6\x05\xe7\xf3\xeb\x17\xb0\x80y\xdd\xd6\x1e\xb4w\x0d8\xf1=\x01\xde|\xca\xa1\x82\x1ft\x1bl\xb0\xdf\x83\xd4\xf9\x17\x11)\x01\nXNw\x05\n\x01@\x00\xe2\x01\x10\xff]\x83\x0eB(\"\x7f\xb6\x01\xf0\x8b\x07(\xceR\xa1=\x08aX\xe0\x86\x08\"\xc7 \x88#\xd6(\xc0r\x05\xa8\x88\xe2\x8e\x14\x92\x92\xa3k\xfe\x10\x98\xa1\x12\x1br(\xa3\x00 \x86h\xe3\x88\xf0\xa8(\xcb\x8e\x01\xfe\xd8\x92?\x04\x0d\x89\x0c\x01\x01\x04\x10\xe3\x11\x00\xe0\x17b\x83K\xda\x18Q\x85NZc\xa17\xd4\x8c\xc6E\x91Y\xd2\x04\x00\x04\xd3\x81\x19f\x8d\x13\x9ex\n\x85d\xb6W\x8b8\n\xf4Y\x81\x9a\xb2e\xd9\xe6w\x0bJ'\xe7\x9c\x10\xf6\xd7\x8f\x99\x03\xf0\xf8\x916\xf3\x08\xe9	\x00}*P\x81\x9f\x97U\x01\x00\x96\x82n\x99\x15\x9c\xe7\x1d\x8a\xe8t\xbd\x81\xea[9w\x0e(\x8b*\xa5\xa4\xb8\x8c\x8b\x8c`R\xc1\xa5~b\n_\x14l\n\xaaQ\x97\xfa\x89\x1a\xa6\x87\xd3\x81\xda\x1f{\x030j\x0e\xa4\x8d\x06\xd8\xa49{\xea\xa2\xc9\xac\x95\xda\nk\xae\x9d:\xc4+t\x85\x1a\xaa\xa4\x8d\xfd\x01K\x1d~\xd5\xe5t\xcf/\x01\xc5C\x0c\xab\xccJ\x9a\xff&\x00\xb4F\xfb'\xa0E`\"\xe8\xbc\x83~\xba`\xb6\xe6\xf9\xca\x9fy\xa0\xde\xe8\\\xb0\xfc)\xaaS7\xc6\xfa(\xee\xb9\x98\xa4\xab\xee1\x99\xb4\xeb\xae\x9a\x99pJo\xb5Y\xed\xd6\xef~4\n\x00\xaa\x9c\xe5\xf5\x17\xc1\xbf\xd1\xe5\xa6\x9b\x89\xd8l\xf3\x8b2\xc1\\U\xd1\xab)=\x1b\xad\xb4\x9bN,\xb3\x91\x1a\xeav\xf1\x88\x177\xd8\x1b\xb8\x00D\xf0\xf1}\xdf\xdaL\xf2\xb1\xe6\x00\x96\x89\x05HWc\xca9\xb0\x8a#\xeb\xcb1\xcf<s1]\x96\x87m\xa27\xdf(r\xcf\x11 `\x01\xc8\xd0\x89,\xb0,fF\xd3\x0b\xd2>G\xf05v\x03\xb3\xecW\xc3\nD-\xf5\xd4\xb7\xf8\xf6\xad\x88Vc\xfb\xe6\x05\x17\xf8\x8c\x00\x02?{\xab\xf1nC\xdf\x83S6\x00 \xcd\xb7\x05i\xabm\xcb77U\xf9\xb6\xbcs\xcf\x9d	\xb8\xba\x89\xd8\x9byob\x12\x01\xdf\x7f\x07^\xa8\xd8\x85\xb7\xcdt\xe3\x9f\xf7\xed\xf3\xda\xb7\xe0\xb3\x9cwrW\xdei\xb5]\xee\x86\xff3\xbe\xdd2\xde5\x02\x17\x00\x9e\x89\xb0\xa4?J\x0f\xd7\xa8\x7f\xees*{\xde\x8aY\xec\xb2g\xc9)\x01\xf3\xd9Nj~Y{\xbe\xfb\xdf\xbc\x07\xce\xeb\xce#3\xc3\xdd\xe9\xc5\xa7\xdd7|\xaf\xdf\x12\x1f\xf3\xcdk\xa9e\xf4\xf8\x0e\x8e\xde\xcd\x1e\x03\xce7\xdf]\xff|\x0b\xe1\xb9\xf9F\x121f\xfb-?\xfdi\xbb\x95\xf2\x8e\x83\xbe\xe6A\x0fz@\x93\xce\xe6\xces\xad\xe8\xf4\xeco\xf3\x9b\x9f\xd7\x1e\xb7\xb5\xce)d\x18\x99\xc0\xde\xff\xe8\xe7\xbbxY\xe9\x16\x12K\x9f\xf3\x0e(\xb8n\xb5\x8fD\xd9\xea\x0f\xf6.\x80\xa5\xdeu-\x15\xe9\x81\x07@`\x81\x8a\x8fi\x90o\x01\x90 \x02\x9a\xb6\xbc\x10\x8a\x90\x84\x0e\xdc\x94\xe0\xa4\xe3\x9bl=\x10\x82Y\xea\xdd\xdf\xc8\xe7\x1b\x0d\xbd\x04\x16\x89\xf3\x1f\x04[\x08\xba\x1d~\xf0;>4 \x02G\x06D~\xbd\xa9P*\\a\x0e\x95\xb8\xc3|\x90\xef\x82\xa8@\x9a\x06yG\x80\x02\\ \x00\xbb\xe3!i\nX9\x04b\xff\x82\x84\xed\x83@\x11\x0bu\xc4\xbfQ\x11{\xd6\x8a\xd7\xfej\xa8F\x0dF \x00\x03x# \xaf\x98\x04:J\xed\x80\x90\x9c\x0f\xa9\xf4\xb8G\xbd\xad\x91\x00\x12 \xa3\x15\x91\xd3\x98\x1azm\x8d\x08@\xa4&\xe58G\xe8\x89p\x84\x91\xf4\x96\xcd(Y;\xe8\xf4\xf1o\x01\xc8\xa4!;T\x97\xeb\xad\xb1X\x1a$e\x0fM\xf9\xc3T\xc2\xc9b\x94\xacd\x185\x18\x80\x12\x94`\x8d.\xfa\x0d\x00@\xf97m\xe4\x92\x91X<\xe0)S\xc9\x9b`\xea\xb1v\xf1\x03e\x15\x17\xd9H\xd4d\x90\x99\xc8\x84\xe6\x95\"9M<\xae\xd2\x9a\xd8|%8\x97\xf8\x1d\x00d\x00\x03\x18\xc8\xc0\x11\xc1\x93\x80\x04,s\x9d\xec\x14''\"\xc9\xcb\xf4\x91\xf0\x9a\xce	f\xed|cK|\xee*\x13\x18\x10\x14\x06\xee\xf95\x02$ <\xb6T\x9b\x05B\xa7K\xb7\x14\x89\x9fY\x9c\x9b\x1d75\x1ft\xe6O\x9d^\x9b\xe8,\xcdx\x97	\x00 \xa1\x01X\xe8\xdf\xbe\x16\x80\x87\xda3\x8a\xffHc\x1c\xeb\xf4\xa9!z\xf1\xf3\x94\x1c\x82\xe4\x9b|\xa3\x89\xfe\xcc\xa7\x8fi\x13\xa9\xef,e\x19\xbd\xb83\x03\xf2\\\x1d\x8c\x12\x80 \xcb\xd04+3#'N\x9d\x17\xb1\x7fr\xf4\xa7\xf5k\xdc\xda^F\xd4\xe3U\x06\x131\xddP==\x95!x!\x83Z6\x85\xe4T\xe7\xc5\xcf\xabn4\xa8Wi\xd7=}\x87		\x84'\x13-ejE\x95c\x89\x0d\x85\x00P\x9a\xc8\xe8\xc4\xd4\xbaV\xb6b\xf4\xa7/\xc5D\x02D\xd0\x81\xc6\x8a\xa0aq\xd5\x84]\x1d\xfa\xd0\xbd*g\x80\x06	@\x086{\x15\xc1j\xb4\x9f\x85E\xa5N\x0f@\xda\x04t\x80\x03\xa8m,jS\x0b7\xae\xbe\x0b\x00\xf5\xb4,\x13.*[>\x14i\xb3\xb8\xfd\xabg\xeb\x08\xda\xc2\xb6\xf5\xb4\xa9\xed\x80e\x80\xcb\x01\xe1\xb6\xf6a\x98\xedB\xae\x92\x1b+jq6\xb4\xeb\xdb\xad\xec~\xdb\x81\xc7*\xb6\x9e\xd8M,c\x1b[\xdd\xe3V\xea\xb5\xb5\x8d\xd7\x03BHVHd\"\x04\xf3B\xff+N\xa5\xb9\xd6H\xde\x15\x13\"\x88o|\xb1\x8b	\x12\xd8W\x13\xdb\xed\xee\xd3\\kVM=\xe0\xbf\xf4bn#z\xf1W\xe8\x1a\x18\x92\xef\x95\xaf\x82\xadk\xdf\x06;\xb8\x17\xf2u\xd9\xcb^+\x9b\xffZ\x98\xbc\x026\xc8\x1d\x0d\x1c\xdd\xdeF\x15zw\xcd\n\x00\x16\x0c\x00\x12\x80\xe0\xc4(6q\x8aAp\xdf\xfa6\xd8\xbb0\xa3\x02\x00,Lc\x0c;\xcb\x91\xbc=,{E\x1bbd\x90\x18\xc5,&\xc1\x07\x86<d!\x17\x99\xc8)\xee\x85\xc3\xbe\xdb\xdfv\xce\x98\xc6\x16\x0epx\x01\xb1a\x7f\xea\xf8\xca \x160|#\x1cd\x10\x10y\xc8^&\xb2\x91?\xc0b\x88\xc1\x98\xa8\x19\xd6\x90&\xa0\xfc_\x1b\xdfXb\xa0\xc5\xb2\x9c1\x99f5s\xf9\xcbHFr\x98A\x90\xe13\x83\x97H\x9aH\x00\x9b\x1f \xe54\xc1y\xcer\xbe\xab	L\x90\xcc^\x84\x19\xcc_>1	\xc0\xfa\xb5\xcb\xb2\xcb\xb5\xe0\xd5\xca-0QZAC\xd9\xcd7\x96\x1b\xa2\xff\xf9\xf9^\x13\x18\xd3\x98\xa4\xcc\x84\xa4\x93L\xe9\xb55z\xbf\xdf\x9d\x95\xacg\x95\x89\x04\x90\xf6\xd6\x9e\xfetz\xa7,\x88\x88\x19\x16\xcb\xef\xe5%\x01N]\xde\xb3\n\xd0\xd5\x9a\x88\xe9\xda\xd82\x85\xe3:\xc0\xd6\xa5u\x00\xad\x01pk\\\xe7\x9a\xc6l\xad\xb3$\x02\x9b\xd6\x03\xbe\xd72\xcfK3ab\x9b\xec\xe2}-i\x89\xd1\xe5~\x9f\x8dk\x07H\x1b\x05\xd4\xaev\xa7\xd9\x0c\xeaix\xf3hI\xd3*\xb2\x9d0\xee\xec\xc6\x16u\xc8\x8e\x0c_*\x8a\x89>\xb9;\xbb\xee\xa6\xb5\xbc\xad\xcd\xe6B\xd7$q\xca\xfeZA\xed\xc7o\x06 \x15\xa9\xf4\xd5\xdd\xb2\xd3\x82T\x813\xfb\n\x97\xae\x80\xbbG\xeen\xa2.\x9c\xe15\xce\xf6\xc3#\x9eo\xc7\xdd\x11C\xfd\xc6\xee\xc5\xe59\x16\xb3\x1c\xd5\xe3\x1f\xbf\x04\xa5\xb8\xca\xe4\x93\xa3\xfc\xc2\xbbnY\xc4{!1\x983\xc0\xdf\xd9%L^n\xbe\x9aW\xdc\x1b\xb6>\xc7u\xc3)\xf6\x90\xab\x84\xa7\x9f\xc3.\x01}\x8e\x8e\xff\xf4\xa4\xe7%-8\xcf\xf9\x16\x00@\xf2\xb2C\xdb\xe7\xf5\xd4\xb5\xaex\xbd\x87Lq\xc2\xaep\xb7\xebU\x89\xbd\xf5\xa4\x07\xda\x9e%\xb5y\xd8\x15\xb3\xa6\xb2\xfb\xfd\xe0h\xbf6\xa1i7\xe0\xcb`\x82\x02\x88\x1f\x01J\xc0\x03\xf7Ec\x82\xd8\xf5\"\x12\xd7\x95\xa7\x89\xa5\xdbe\xe0\xa0\xf8\xbb\xe6\xcf^\xed\xb4\x03\x9d\xea\x8a\x00\x00\xe2\x11\x7f\xf8\xd1+\xfe*\x1aZ\xb4\xea\x19\x8d\xd6\x0e\xb5\x05\x00KWs\x93e\xa3\xf9\xda\xb3[\xde\x9eos\xd0\x13!\xfa\xd1\xfb>\xf1\x12po&\xe2.\xf7\xd6\xbf\"\xef|\x07\xc5Ul\xcf|\xce\x0b^\xe5\x88\xe8\xfd\xefM\x1f|\x8c\x12\xbf\xf8\x13\x93\xad\xc0mN\x06\x004\xe0\xfb\xe0\x0f\xbf\xf8\xc3\xdf\xfch\xa7|\xed\x86\x90\xfe\xf4\x81_}R\xc3\xbd\xca\x01\xd6\xf9\xde\xd9\x8e\x8c\xf1\xdb\xff\xfe\xf8'y=\x1d\x80m\xd0\xf7z\xfd\xbe7\x02pg}r\xe7Y\xea&O\x9eA\x7f\xf5\x87\x7f\x0c\xd8\x80\xf8\xf7_\x0d\xb0{6\xe1{\xff\x99\x10\x80\x92\xe5^\xe1a9\x9a\x02O\x08\x88ye\xe0V\xa3\x86I\x12\xe0\x80\x0c\x98m\n\x18\x06\xbdG>\x9a\xb6i\x8cW\x80Q\xa5n\xf0\x04O\x1ew\x82\xe3\x14\x826(g\xfeg[\xda&bw\x94E?\xa5)\xef\x84R\x08\xb8\x83Y\x00\x827x\x84WF\x84\xbdvzq\xa6\x84\xc8\xf0N\xf3\"O\xf2\x84\x06OW\x85Vx\x85\xa8g^#p\x02 \xe8\x84\xc8PO\x19@/\x1d\xf7Tr\x00\x00\x1ap\x02\\\xf8~\xaf\x00\x86a\xa8P4H\x86\x06B\x01\x1ap\x86\\8{\x06\x02\x86`\x98%\x0b\x05\x87q`\x86sH\x87\xa7W\x84\x07\x80\x01v\xf7\x86|\xc8o\x7f\xa8\x01\x89\xa7}\xa4\x85\x01\x07\x10[\x87X\x86r\xf8\x87\x14\x10\x88 gk\x01PZ\x86\x18\x89\xfc6zsX\x89\x8cx\x00\x99hO\x9c(\x89\x14\xe8\x85\xf1\x82\x89\xa4\xb5\x89\xa58[\x93Hzc\xa7\x8a\x07\xc0\x8a\xadH$\x89\xa8\x88\xa8\xc8	\xf5\xe4\x88\xb7F\x8bE\xb5\xc8%\xb7\xf8\x89\xb9x\x0b\xbb(o\xbe\xf8\x8b\x9c0\x89\xc1Hp|qv\xa4\x88\x8ci\xd0\x0b\xca\xa8\x01\x04Wy\x94q\x8c\xd0\x18/n'\x1b+\x98\x8d\xde\xf8\x8d\xe0\x18\x8e\xe28\x8e\xe4X\x8e\xe6x\x8e\xe8\x98\x8eK\x10\x04\x00!\xf9\x04		\x00+\x00,\x00\x00\x00\x00\xe0\x00\x80\x00\x00\x06\xff\xc0\x95pH,\x1a\x8f\xc8\xa4r\xc9l:\x9f\xd0\xa8tJ\xadZ\xaf\xd8\xacv\xcb\xedz\xbf\xe0\xb0xL.\x9b\xcf\xe8\xb4z\xcdn\xbb\xdf\xf0\xb8|N\xaf\xdb\xef\xf8\xbc~\xcf\xef\xfb\xff\x80\x81\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x8b\x8c\x8d\x8e\x8f\x90\x91\x92m\x00\x93\x96x\x00\x95\x97\x9b]\x99\x9e\x9f\x9aI\x00\x05\x99\x9c\xa6U\x9e\x05\x06\x03\xac\xad\x03\x06\x9eH\xa3\x06\xa4\xa1\xb2\xb1\xa7\x9c\x99\xaa\x06\xbd\xbe\xab\xae\xb4\xb8D\x00\xbe\xb5\xb6B\xbb\xbd\xb5\xb9\x93\xca\xbf\xd0\xbe\xae\xaf\xc7C\xb3\xbf\xc7\x9e\xc0\xad\xc2\xc8\xcd\x8a\xd7\xd1\xd3\xaf\xbd\xe3\xdd\x95\xe1\xd1\xdb\xe6\xa4\xdf\x8c\xd7\x05\xf1\xf2\xe5\xe6\xeb\xc1\xd9\xbc\xd0\xe3\xe3\xed\xee\x88\xb3\xf2\x02\n\xb4\xb7o\x1a\xad\x80\xea\x0e\xc6['\xcc\xdf\xa1b\xa9\x04JTU\xb0\"\xab\x84\xd8\x16r\xeb\xe7\x90P\xa8]\x13\x07Z\x0c\x86Q\xdd<V\xf1\xbcu\xf48q\x19\xc2\x91\x17K\xba\x94\xa8P\xe5JA\xa3Z\x1a\xd38rf\xc8\xff\x9f)\x89\x95\xba	(g\xc8\x8c\x05`^\x04*\x10T\xa9L\x0c\x18\xd8$z\xc7\xe8Oh\xf1\x94\xde\x93\xf8\x89\xc1\x84\xaf_\xa5F\x8d:\x95*\x1d\xab@\xa3%\xd5\xea\n\x94W\xb0p\xc1\x8e%kV\x0fZ\xa6\xf9\x96\x11\x1c	\xe0m\xdc\xbfa\xe7\x96\xad\xeb\xe6.\xde\x85\xfa\xd8\xf6\x9d\xcb\x18\xaeT\xa7\x9f\x08\xcf1|\x18\xb1^\xad\xb0\x183\xfe4\x10\xdb0\xc9k(W\x9e\xe7r\xef\xbd\xc5\x9a\x95\xb1\xa3\x18\x93\x19h5\xa2G\x93VHP!\xa9\xcd\xc5`\xd2k=\xf4\xb5\x99Q\xa0d\x0b\xe7:6\x93\xe9\x82\x0c/\x0e\xf6\xcd\x05Y\xc4\xe1\xc2\xb5\xb1\xad\xb8\xad7s\xd8\xa2!\x83\x1c\x9d{\x9a\x87\xe9\xc8\x97_\x0f\xb3\xfd\x13\x84\x05\xe8\xd3/\x80\xf0\x1chwW\xdf\xc1\x87\x1fO)\xd3y\x01\xf8\xf3\xeb\xc7\xbf\xbe=\xd7q\x1e\x04\xe8\x1dJ<\xe9&\x1e}\xcd\x01p\xdf~\x0c2\xd8\x9fa\xa3x\x17\xe0\x84\xac\xc4G\x0b5\xac\x8d\xc4\x11\x82bd\xb2\xff@\x83 \x86\xb8\xc0v)\xd9\x13_\x85\x14\x0e\x90\xe1A\x06r8\x06\x00\x1f\n\xb0\xc1\x06!\xd6\xb8\xdf\x889\x19\x07\xdf>\x16\xae%\xdf\x86.6\x87\xdf\x8c4\xdahd~8^3R|\x19\x82\x07K\x90^\x00 #\x91GV\x99\xdf.\xaf(u\xe1\x00\x02\xae\xd6J\x97\x0dA\xa9\x85\x94E\xceh\xe5\x99\xdd\x1d\xd7J\x86)N\x13!IO\x8a\x89\x85\x94\xfa\x99y\xa6\x95\x00p\xa3aAyr\xf9\x9d\x8e\xdc\xf4r h\xd6AA\xe7~v\xdei\xe4\x88\x81\"\xe7c[\x06a\xb9\xe62\x83\xd6\x05@\x00\x95Zsc~E*j${\xf7\xd8\xd3$+}r\xf3\xc96\xf9d\x9aHd\xa8\x04\x80i\x14\x1e\"\x19#\xa7\x9e\x82\xb8\x1e\x04\x020j\x90m\x8f\x92\xba\xcd9\xc6\x01S\x0c\xa5\xa6\x00\xa0\xc0\xb1\x15\xb0\xfa\xc4\xa5\xae\x12p\xa0}\xea\xad\xd7`\xa2w\x1e\x9a\x1f\xae\x02\x80\xea\xe8\xa3\xc1R\n\x80\x05\x16\xa4RN\xb7\xaa\x1ab,\xb2\xc7*\x90\xff\xecg\xb2\xb8\xea\xee\xb3\xe7\xa9\x87-\x88\xd4\x1e\x89\xe3\xa6\xb9\x96j\xce{\xaf\x80\x94\x89\x05\x11D\x10\xae6\x82\xae\x00P\xb9\x85xRA\xba\x0b#\xab,1\x04\xb8\xeb\xea\xa0\xd0\x8ex$\x95\xf8\xcd{\xed\x95\xf6m\xda_xp\x02p\xc1\x05\x00\x07,0g\x05\x1b\x0c\\3\n7\x9c\xae\xc3\xc30+\xb1\xb3\x86f\xe2\xa9\xb4vJ\x9bo\x04\x1d_\x9b\x9e\xbe\xae\x90\x12(,&\x8fl\xf2\xc0\xe2\xdaR\xe8)\x9f\xb8\xfc\xb2\xba\x9eD,\xf1\xc4\xb0\xd6\xba\xa0\x99\n\xe2\xf7-\xcf\xb1\xf2\xf7\xf3^\x11\xfe\x02\x80\xc9E\x07\x0c\x8a\xc1@\x12\xd5\xf4\xd3\xc7\xca<\xf5\xabM\xd8|\xb3\xc6Z\x7f8b\xc0\x03k\x1c/{\xd2(\x07\xcd\xd8d\x97\xad\xec\xd2\x96*\xdc\xb6\xd4oS\xcd\x04\x8cV\xcf\xca\x9f\xc8\x17l\x8d\x00\xd7\x8cg\xfcs^\xb0\xe4\x05x\xc0#_@6:J#\xf8I\xe2S\x13NLz\xfa9\x1e\"\xdd\xd9\xc2\x08\x81\xd1\x93{\x82^\xdd\xe8\xf1m\xff\xcc\xb0\xc2 \xa0\xfb\xe4\x9d{\x1e\xbb\xc1r&\x838\xe9\xcd>|z\xb4\xf6\xb2\xae {\x9c\xc7^\xb1\x00\x1e\xa2\x87{\xe6\xe7\xec\x8e\xc0\xc8\x01t\xae;\xc2\x96\x12\x9f\xb8\xb3\xc6\x1b\xac^\x95\x95#\xa9 \xe0\xba{\xfe\xc9\xcf\x06\x9fg{\x8e[G`\xfd\x05\x11k\x8f\x00\xf7f\xb9\xed\xfd\xcc\x90Y\xa3z\x8d\x16\xdb\x8f'z'?\xca)Hz\xc98\x1f,>!0\x0bX\xefz\x04(\xc0\x05\x02 \xbf\xfb\x05\xcf\x1a\xfb#\x1e\x01$\xa00\xd9\xd9\xaaA\xec\xb9\xd1\xd8z72\x04 \x0dZ\x1f	\xd61\x1a\xf8\xc0\x08\x04`\x00\x13\xdc\x1d\xfe\xa8\xa2\xbf\x0c\xce\x8c\x00\x1b\xf4 \x08\x1b4\"\xd5\x01\x8e~\xd9\x9b\xdc\xfd\"s>\xa1\xe4\x83\x14\xf2s\xe0\x03_x\x01\xeb\xcdPm6\x9c\xd9\x0dq\xc8\xc1\x05\x99\x0fD!L\xdd\xd8\xae\xe7\xaa&n/f\xde \xd1\x16\x1f\xa8;\x159\xf1\x82\xc9\x88\xe2\x0d\xdd\x85\xc36V\xac?X\x94\xdb\x95*8\xc1\x12n\xffOe\xc4\xb8E\x8e\xc8\xa8\xbbx\x9c\x11\x8d\x00\x18^\x14\xdb\xd8\xac6\xe2\x10\x14Xd\x8f\xc6F\xb4\xbb\xfay\xd1\x82\xcb\xd1\x0e\x00\xf8H\xc6'BQ\x8dl4\xa4&\xdd\x98H\xf6\xcc\xean\x8d\x94\xc0#-\xb88\x0c``\x8b\x00\x90@\x02\x120IJ\xca\x10\x8d\xc2\xc3d&7\xc9I\x07A\xe0\x96\x9e\xe4\x0f{\xac\x17\x00QZ\x8fgJ\xc8\x04\x06\\uJ\x13\x062\x01\x1c\xac\xa0\xeeX\xf8;X\x1aL\x90\x83\xa4\xa5\xb3TW;\\\x1e0W\xa0\xdc]\x00JP\x82\x07Net\x01(f\xb8\x02\x80LVn\x0d\\\x00\x1b\x983\xad\x01M\x1b\xd2\x12F\xd1\xaa\xdd\xf2<\x99\x9e]>\xd0\x8e\xaf<\x02\x00\xe4\x02\x00\x0cd`l\xe3$@\x02h\xd6\xbfuBL\x96\xb3tc\xc5\xd83\xcf\x03\xca\xd3\x95\xde\xd4#Y\xfe	\xaeK\xad\x92f\x06]\x1c\x0e\x11\x9aP\x85\xda\x07\x97\xf4\xec\x8f2!:\xc4\x98\xc9\xf01\x9e \xe7@-\x19\xa4@\xb6S\x8d\xff\xef\x9c\xa7\"\xe59F\xeb\x81\xab\x85\xe7\x82\x9a'&70	\xf8\x14\x87\xab\xe4`F\x97EH\x8eN\xd1\xa3\x1f\xcd\xc4!kz\xb4\xdd\xf1\xcci\x0c\xfb\xc4*\xa7:U\x96\xc6\xcdt\xdfp\xe9F\x8dzT\xa4n2\x13\x05$\xdb\xc0\xcc	\x00\xa8F\xd5\x13UE\xc3\xa5\xc0w\x13\xad\x16\x95\xab\x1d\x95\xe6R77V\xaa\x92\xb5\xacl\x83\x19V\xa3\x848\xb6\xfa\xc3\xad\x9a\x84\xeb\xdb\xe4z\xc8\x0ep\xe0\x13\x1dHlbWy\x80\xc6\xaermy]\xd7^\xb3\x00\x80\x07\x0c\xcf\xaf\xb9p\xe9\xd4\x02+\xd8\xcd\xbe\x93\x03\xa0U\xacaA\x1b\xda\x0e$\xa0\xb1\x8e}\xac\xe1\"\x1b\xbe9=\xe0\xb5\x96\xe5\x9fU\xfd\xa0Y\xd2\x19\xb2\xb3]\x9d+dD \x82\xc4\x86\xf6\xb4\xa8\xb5+d\xd9&Y\x96V\x16\xb6\xaf\xbd\xecd\xdf\xf1R\xcf6\x17\xa1_\xcd\x04o\xa7\xcb[O\x8c\xf6\xb7T\xe5mbE\xe0\x14\xb3\xea\x95{\x99@.r\x95k.I\x9e-\x90\x98|\xff+W\xbf\x9a@\xear\x97\x04$\x90\xaebI\xab\xd8\xea\x02 \xbe\x15E)^Y\xbb\\#|B\xbc\xb0-\xddl\xd3\xc0\xac\x10\x18\xf8\xc0\x08\x0e\x81Rq\x0b]C:\x07\x00\xd5\x05\x81\x84\xe1\xeb\x89\xde\xd6\xd7\x13\x14\xce/n2\xe1\xdd\xe2>\xeb\xbf\x00V\xee\x80\xcf\x00\x80\x10\x90.\xc15d\xf0\xfe\x1c|\x0b	\x83\x80\x04.\xa60(\xe0;\xe1o\x85K3\xc5\x19\xee\xd3<\\P\x95\x01`\xaa\x00\x8e-\x1b\xfbk\x87L\x18x\x7f)V\xf1\xf7\xa8X\x96L\xb8X\xc2\x1f\x88\xf2\x84i\x0c\x02)\xdbx`8\x96\x8aP8\x1c\xd9\nx\xf9\xcb_~\npS\x1bd\xf2\x0e\xc2\xbc\xa0\x10\xec&m\xdbF\x0e\x8a\x07\x00U\x8e\xb2\x94\xe3,e9\xc7\xd7\x13\x19\xf8'\x8em\xa2\xe3c9\x80\xaa\x0ep\x80\x9731\xe6\xe0&\xa0\xcc\x12#\xf2\x99\xd7K\xd8M\xba\xd9Pq\x86\xb2\x9c?@\x029\x83@\x13\x00\xc8s\x9eQC\x97\xc5q9]\x7f\x0en\xa0\xff+\x80\x02\x00\xa0\xf6\xd4\x07Xe\x90e\x0b\x89$g\xb0\xd1\x86|\xb4\x14\x9c<\xe9(\xc3\xd8\xca\xd6\xc8\xb3)\xf5\xdc\xe9ee\x02\xd4cN\xc0\xa8\x93\x85\xeaS\xabZ\xbc\"v\x04ze	k&\xb3\xcb	\xdf\xf2D\xa4\xe1{\xe7\\\xebz\xd7}\x01o\xc3\x02\x0dhA\x13\xbb\xd8\x86\x0e1\xff\x94\xdd`\xc2\xba\xb9d\xe5\x8a\xdf	\xd9\x95\xe9\x0c\x98\xf2\xdd\xff\xbc\x02\x97+\x10\xe8z\x07\x1aj\xe06\xf6\xa1\x91\x9d\xe8\x11\x17\xe6\xb9\x8357X\x8f\xa6hk\xc4\x0fo*\xf9q\x02\xdc\xfdn\x7fZ\xf2\xd7yuX\xa1\xc1}\xec\xf1\x0e\xd9\xdflpu\xc0\xa5\xe9f\xb1fB\xa8\x86\"\xcb\xd9\xfc;\xd5k\x9br\xb6h\x06@\xbd'\x8e\xea\x8a\xc3\xb6\xaf\x18\x87M4i)k\x1b\x7f\xdc\x8d\x86\xfa\x8b\x96I\xbe\xf0Uj:\xe6*\xb7\xb7\xd0\xed\xcdr2\x8b\xb7\xdf\xffp'g1\xebc	\xb4\xd9\x04\x95\xdag\\vN\x0c\x9f\x0b\x17\xe8C\xcf\xba\xd0Y\xee\xffr!\x07\x80\xe98Y\xf1\xd2\x97\x96\xca\xa7\x9b\x00\xea9\x8fK\x18\xadN\xd5\x98[C\xebp'\xba\xbe\xf9]<\xb7\x7f\x01\x9cK\xbe\xed\xd7\x9d\x92@O\x9c\xfd\xef>\xa5\xd8_\x9c\xc3v\xd5\x92\x07\x14qO\xfc\xca\x8d\xfe\xf2!\xef\x01\x14>\xf5i\xde\xb7\xbaw\x00\x94\x00\x00\x14\xa0\xc0\x08<\x11\xf9\xc0\xb37n~	\x8b\xd2\n\xcf\xca\x175\xe0\xf4\xa8O\xbd\xeaW\xafx [|bv\xb7B*;\xff\xd3W\xa7\xd4Y\x99\xd7\xfc\xeci\xffS\x16/\x01*\xa1\x9f\x80\xd2\x1a\x8b\x81\xb6\x93\x01\x00\xabO\xbe\xf2\x97\xbf|\xd86\xe0\xe2U\xe1\xbd\x04\xbc\x07>\xb7a>\xf7\x9b\x97~\xefq.\nN\xfb\x85\xea?>\x00\x06R}\xda\xd8\x1b\x8c\xf9\xe8O\x7f\xf3\x1b\x00s\xf3\xc3\x8a\xf6\xd0\x9c\xeb\xdb\xae\x8f\xfd\xddK\xdf\xf7\xfa\xe4uj\xac\xc1\x80\x04\x8c\x1f\xb5\xee\x87|\xea7\x80\x04\x88z0Wd\xbd\x97Ix\xc7?\xb9W\x7f\xdaG{\xdftr{F\x0c\xff\x0c\x90\x01\xc6\x16\x80\xcd\x96\x81\x1aHK\xef\xe2~\xbe\xd6?\xcb&`\x0d\x88}\x9b\x97r\xa2\x00o\xde\xe7\x1c\xfdwj\x1e\x08X\x1b\xf8\x82\xcdVw}\x90b\xf4G\x01\x9e\x90y#\xb0yT\x90i\x0d\x87\x1a\x84\xd3\x17\xc1\xd5\x82\x19\xd8y)W\x84F\xf8x\xd3W:\xb9\xd7\x1b#7k\x0cGL\xd9&\n\x0c\x00\x80je\x84\xad\xa5\x0b\x9f b\x997`\n7L\xeerJ\xdf\xb4\x82\x07\xe0\x81.\x02\x00%\xe8t,\xf6ls\xe2sSsJ\xc1\xd4\x7f\xa57T\x945\x02'Pv\xdc\x07\x06\n\x97\x01\x0b\xe7\x85p\xd3}\x05'\x87\xc1\xa4\x01'P\x87\x91\xe7v\xe1\xa7\x87Sur\x80\xa8V\x14\xa0\x01\x82X\x87j8&\xa75~U\xf5\x87\x8b(o\x8e\xe8\x88\x83\x98\x83@G|\xe4G\x86\x97\x98\x0c\x99\xe8\x88\xba\x87q\xa6v\x00\x01\xe0X\xa0\x18\x8a\x063\x8a\x1a\xa0{x\x08\\\xa98\x86\xacH`\xb9G\x8a\x91(o\x8c5\x8bqX\x8bb\xbf\xf1\x8aK\xd8!\xbb\xd8X\xab\xc8\x8a\x00\x90\x89[(\x8c\xfeG\x85\xbeHb\xaeh\x83\xa6\x18\x15\xff\x97j\xc5x\x89\xc7\xe8\x8a\xafh\x89\x9e&ui\xd5\x8ce\x80y\xd8\xe8\x88@\xc7\x8d\xbd\xe8\x8d\x87w\x83\xa3hw\xc0W\x8d\xb5\xd8\x84/\xa2\x8d\xe6\x18\x8f\xf28\x8f\xf4X\x8f\xf6x\x8f\xf8\x98\x8f\xfa\xb8\x8f\xfc\x18\x06A\x00\x00!\xf9\x04		\x00+\x00,\x00\x00\x00\x00\xe0\x00\x80\x00\x00\x06\xff\xc0\x95pH,\x1a\x8f\xc8\xa4r\xc9l:\x9f\xd0\xa8tJ\xadZ\xaf\xd8\xacv\xcb\xedz\xbf\xe0\xb0xL.\x9b\xcf\xe8\xb4z\xcdn\xbb\xdf\xf0\xb8|N\xaf\xdb\xef\xf8\xbc~\xcf\xef\xfb\xff\x80\x81\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x8b\x8c\x8d\x8e\x8f\x90\x91\x92\x93\x94\x95\x96\x97\x98\x99\x9a\x9b\x9c\x9d\x9e\x9fT\x00\xa2\xa3\xa4\xa0\xa6Q\xa4\x05\x06\xab\xac\xab\x05\xa3\xa7\xb1G\xa3\xaa\x03\xb6\xb7\xb8\x03\xac\xaf\xa2\xb2\xb1\xa2\xb5\xb9\xb9\xad\xc4\xbc\xbe\x9e\xc0\x06\xc2\xb8\x06\x05\xce\xcf\xcf\xad\xaf\xc7\x9b\x00\xc1\xcb\xcd\xd0\xda\xd0\xa4\x00D\xb0\xd4\x90\x00\xca\xcb\xba\xdb\xe7\xbc\x0c\x13\xeb\x0c\xa4\x0c\xed\xe1\x8e\xa2\xe5\xba\xd9\xd2\xdb\xa2\xea\xeb\xfb\x13\xef\xfe\xf0\xf1\x12\x89\"\xc7L\x95\xabb\xda\xf2\xf1[\xf8\xef\x9d\xb7\x80\x87\xc6\x11sepX\xb6g\n\x17\xeek\xd7\xad\x1bD9\x0f\xa1H\xbc\xe8\x8c`A\x8c\x00\xf45d\x07\x0cZ1p\x1f\xd9\xf4B\xa5\xcd\xe4\xc9\x92)\xfb5t8nX\xc9\xff[\xaef\xc6L3.\xe4\x93d\xf4H\xaeJ\xb9\x93\xa3M\x8b\xcc\x9a	\x1dZF\xa2\xd1&=\x93F\xb3%ugVz@\x99q\xbdJ5\x8c\xb5\xa5dg\x01\x00{\xd1dW\x7f\xf3\xc0\xca%8\xb5\xec\x97\xb3A\xeb\n\x19\xf5\x94k\xabe\x18\x9d\xe2\xf2 \xb7\xb0\x81\xb4v\xb5X3H\xb1\xa35\xc7q\xe7:\xe3\x9b\x8bpa\xc3\x88\x13_Y\xcc\xf8\xe9(\x08\x0bB\x8b^\x00a\x14X\x8cO=\xa8\xae<`\xeb\xe5i\x9a\xbbp\xae\xb7j\xc0\xe7\x05\x02r\xeb\xde-\x80\xb4\xe9\\\x18\x0b\x08SM\xdc2\xe1f\xe6\xae%\xcd\x1c;\x14\xb4\xb1\x00@\xf3\x9e>\xdd\xf7Z[\xdcR\x0f&\xde\x9a`\xb6\xc2\xb0\x9bg\xe1<\x19\x00n\xea\xe8\xa9/hY\xde\xa6e\xd6\xad/\x0b;,~\x0bg\xf3\xe9\xf3\xa3_\xbf\x18o\xe1\xe3\xc2\xc9\x07\x1cs\xf5\x1d5\x99n\x1bl\xa0\xdf\x82\xba\xad\xf7\x8ap}\x01\xa6\xccj\xf39s\x0b\x85\xf4\x15\x88\x85(\xb9%\xa8\xff \x83 \n0\x10W\xaf]H!3\xd6\xccG\xa0\x86K\x8c\xd2a\x82!\xc6\xf8U\x84@\x058\xcc\x00\xab\x1dv]A+\xb2\x88\x04\x87\x02x\xf8a\x8c\x0c\xae\x17\x15=\xcaa\x17\xd5\x88@-\x85\x0cLDu\xf8\"\x91!\x96\xe6\xd3S6\xd6\xd8$/n9\xc9	\x00\n\x84YA)f\x000\x1d\x8cT\x16\xb9cT\xdb\x08\x13\x0c+\xb4\xd4\x18\xd4\x97a*PA\x9dcB\xe9\x85\x99\xd4\xa1\x99\xa6z\x10@ \x80\x95lYX\x101\x00X\x80\xd4Rs~	@\x05w\x8a\x89'\x99\xf6\xf1v\xde\x94\x7f\x8a8\x9d\xa0F\x16j\xd3HRY\xa0\xe8@A\xa9\x12\x9e\xa3\x00\xa0\x00i\x9d\xac\xe6\xa9\x97\x14\xf8\xe5FZ\x9fC\x12	d\x83\xb8\x11ZN3&Ys\xc1\x05\xa3X\x10\xc1\xa8#y\xd3\xdf)\xa4D\xca\xea\xa4z:a\xdeh|\xa2\xe7\xa7n\x82\xf2Vmo\xa2\\\x9b\x1b\xa7k\xaa\xe8\x1d)\xbf\n;,-R\xedu*\xb2\xa3(\xbb\xff\xac\x9d\x94\xb68\xdaz\x0czH\xedt\xd1\x92\xa2-\xb7\xb5a\x93\xd5a\xe2F\xe0\xef\x05\xfev\x93\xe1^=v\x92\xec\xba\xcc\xbe\xfa\x0d)\x97\x868+\x9a\xf0\xe6\x96\xa8\x8b\xb8\xfa\xf6\x17.\xaf(#\x95\xbf\x1c\xff\x1bA7\xe7\x16\x0c\xca\xc1\x08\xb3\xdbl\x11\xd1\xc6(]\x90\nF'+\x00\xe3\xc6\xda\x1bh\x0ev\x06'c\x89\xfe\xfbk\xc7\xc6\xf6\\`7\xea\xb6\xda\xee^\x7fj+\xf1\xaf\xeb\x89+\xca\xa5\xa4\x85\x06*\xa2\xbb\xc0\x8c@\x04\xbf\xee<\xf5CX\xfbH\xb0(A'l\xec\x9f\xb3\xee\xb6\x1eh;\x07\xec\xb2\xac4\x976\x11\xa3KE\x80\x00\x02\xbf\x06P\xf5\xdb\"\xff\x9cn\xc9c6,+\x832K\x1c]iT_\x1d\x1dnN\x87\xa6\xf6D\x19K\xf56\xdc\x04\xc8\xfd+\xddZ\x1fuw\x9d\xcf6\xac\xf7~}\x8b\x18\x1d\xccn\x03<\x8ah\xa5\x81v8\xa2\xa6\x02\xb08\xe3\x05\\\x10\x80\xdb\x08\xd4\x1d\xf9\xd6\x8f*P\xb9h \x96f\xff\xa9(U\x03,x\xe5\xc6\x06\x8aW+\xfd\x9d>u\x00\x03\xa8\xbe\xb8\xeb\xaf/\xfcyh\xb5\xdb\xbe\x1b\xcc\xb9?N\xac\xe8X\x97\x96\xcc\xcd\xa6\x0b\x8f\x00\xf1\x17\x9c\x8e|\xf2F<[\x9dz\xd9\xf2\x06}\xe3\xdd\xbb\xdd\xcdz\x0b\x13\x8c\x12\xeb\xda\xb7\xe6}}\n[\x91yo\xe8\xc1\xdc\xf7zp\x07 \xf7\xf1\xf6\xba\x8aQh\xf1\n\xed\xbd\xcd\x19\xf3k\x0e\x00\xfc\xf7\xbd$\x9c\xadA+\xbb\xdd\xc7\xcc\xd79\xc7\x1do\x05\xbdP\x18d\xb2g\xc0\x04j\x06\x00\x8dc`\x03Q68\xc3\x95\x8f:\xd6K\x19\xff\x10\x80\xbe\xeeA\x0e\x83{	\x1f\x000\x10\x00\x0cLL\x02	H\x00\x07;\xd8:\x05\xfa\xef\x87\x048Y\x8bF!\x81\x8e\x04\x11=\xbe{\xa0\x88XG\x00	\xb8\xf0\x85\x0e\x1c\x05\x0dm\xf81\x02$\xa0\x88\xf0\x9b\x9a\xa8\xde\xf6\xb1\xd8\x80\xf0\x87`\x0c@\x10\x85\x88ARH@\x02\x04H\xa3\x1a\xd5x?\xd2$\x91i\x1c\x0c\x80\x13O\xf71\xc4\x00\xff\x80%\x0b\xa4\xe2\x02\xaf\xa8\xc3\x9c\x89JX\xa3\xf2a\x18\x07\x99\xc6\x0d\x9eq\x8d\x88Dd\xcaz\xe3\xc67\xbe\x0c~\x01(A	\x84\xf7\xa8v\xb9\x03\x00\x19\xc8\x80\xfe\xc4\x98\x80 \x96\xd1#\xe2Y\xe0 GI\xca0&R\x91L3\\\xa0\x1c\xe9\x1b\xe1=\xce{B#\x05\x00E\xa1\xa8\x00\xe4\xd0\x93\xc9\x13e)w\xb9\xcbD\xce\x0et\xab\xf4\x1d\xcd\xd6\x93\xc5\x0e\x82	ac\xd2b7\xac\xd8\xc9\x11\x86\xc2\x99\x80\xd0%/\xa7Y\xcaB\x96\xd0z\xc1\xf4\x1d\xe8v\xc8C\x92Q\xce18\xbc\"4\xa7\x00\xc2\xfa-B\x9a\xd4L\xa7\xff|9\x8a f\xb34&,\xe6\x16\xe9\x08\x80\x1c\xf6\x91kx\x1bZU\x80hND|Q\x9d`L\xe3(Oy\xca\xbf%\x91z\xa2\x80\x1f\xc7,\xb0\xb8\x8f\x1d\xe0\xa1\x10\xcd\xa17\x97\xe5\xaaq:\xcb\x94\x16\xcd\x03:\x01J\xd0\x8e*\x92\x14\xee,\x0d\x1b\xa5\xd6\xb1\x98A\xf4\xa4\x0f\xb5\xe7=c\x87L}\xda\xe7\x01\xff\x0f\x08\xe1\x0f\xfbY\x88\x7f\x024\xa0\x1e\xcd\xe9\x1a7\xf7Q\xce\x99\x14\xa5\x11U\xa9P'\x1aK\x9a\xa2\x02\xa60\x95\xa9\x18\x8d*\x88\x8d\xde\x14\xa7:-(\x08\x15)\xd1Q\x005\xa8\xf6<\xa9J\xb16\xb9uUtCH\x0d\xabR\xc7\xd8\x08\x9b>\xf5\xa6Q\xdd)\x07\xd6\xda\x81\x1c^\xf5\xad)M\x80\x08:\xd0\x01\x11\x08\xb0\xab\x14u)V\x00\x10\xd6\xb0b\xb4\xacJ=\xab`\xd7IP\x00\xb0u\xad\x1ch+\\Q*\xd7\xba\x8e\xc2\xae?\xc2kQE\xd6\x8d\xbe&5\x8cL\xf5\x83Y\x07;\xd8D\x16\x91\xae\x88El]\x13\xf0\xd6\x1cv\x80\xad\x00\x00\x81jiJT\xaf\x0d\x91\x14	\xb0lL\x03\x9aY>\x00\x00\x8d\x9c\xcdmO\x1f;\xd7\xd3\xb2U\x04B\xf5\xedi;\x90\xda\x0f\x18\xf7\x03 \xa0\xacd\xbd\xe6\x18\x82\x91\xf6\x009\x94\xedX3\x1a\x07Q\x046\xb7\x1cUc\x11E\xc0]\xee\x8e\xc2\xb7\x87\x15\xadh\xed\xaa\xda\xe3\x1a7\xb9\xb0Z\xff\xae\x9d \xc5\xde\n\xa0\x00\x05\xa2x\xaeVckY\xccR\x17\x0eS\xc5\xeeSw\xfa\xc9\xeezW\x14\xbd\x0d\xadpE\x01\x02\xe4\x16\x18\xb9d\xdc+>Y\xe5\x00\x07\xc87\x01\x0e\x80/\x00\xae\x1a\xdd\xbeN\xf7\xben\x98\xeau\xf5[\xcd4\x16qa\xdd\x05\x00	H\xf0]\xb6:V\xc4\xe6]-\x86\xbb\xda\xe0\x077\x18\xbe\xa5\xa5\xafXi\x8ba\x99\xac\x91\xc3\xbc\xe4\xaf\x0c\xbdK\x02\xd5\x92\xb8\x1b\x05&\xc1q\x93\x9b(E\xdd\x85\xa5\x15h\xb1=\x1b<&\xb8Vx\xc6\xfc\xf4'\x1ao\x8c\xe3\x81zX\x83\x00\xb0\xab\x88U\xcbe!\x9f\xd7\xb8\xb4$V\x8d	\x16\xa9$7\xf8\xcc\x0eh\xb2\x93e\x8cT\xfb\xeea\x83 Md\x95\xa1\x1aD\x13|8\xb2\xa3\xe81\x08\xbc|^\x12\x8b*\x1f\x0e\x11\xc3\x82[*_\n\xb3y\xb6\xfc\x1c\xb3H6\x18\x82F;\xfa\xd1\x8d\xbe-A\xe7\xbcS\x13\x98\xa0\x90J(\xb2(\xf4\xec\xe303\xe5\x1f\x8a\x1e\x02\x9c\xffEq\xe6B3\xf6\xd0\xd3}\xc3\x02!\xcd\xeaRn\x96\xb0\x1bFk\xa5/\xcd\xc6\xc8\x8aK\xcc\x19TTSB\x1d>4\xfb\xda\xd7\xa6\x8e\xab\x85\xc1X\xdb=\xa93\xd2\xed\x9c3\x10\x15ii_\xce\x82#9\x1b\xd77\x9a\x12\xe82\xfd\xfa\xdah6\xf5\x93aJ\xe36\x8c\xba#\xa2\x96@g\xd3:F;;;|\x1b\xd1\xe7\xa7\x1b\xc2k\x94a\xfb\xdd\xc0\x166RS\x1dM\xc1\x92\x1b\x97\x92V\xe4,4\x02\x10\x94Q\xbb\xdd\xdd\x80\xb7\xc0\xb3\x0da(\x8b\xf0\x0f\xaf\x9e&\xb9\xef\x8c\xc1\x82\x86O\x1f\xfc\xe8\xb7\xbf\xbd2\x06\x004\xe0\xe2\x18\xcf\xb8\xc63>\xf03#\xb5\x012%\xabm\xc5\x9d]\x9d\x161$\x1a>d\x13\xc9rG\x8d0g\xdd\xd56\xcb\xc6gN\xf3\x9ao\x1c\xa6\x17\x0fy\xb1\xd7\xe0\xd4\x0e\xe7\xf4\xe4\xdf\xf0\xf0\x19\x87\x8e\xf2Q@<\xdd\x99\xde\xb5\xa0m\xce\xf4\xa6\xd7\x9c\xb6o\x8e5!\x7f^\x17I\x0f\x9d\xe8\x9f\xd4\xa4?\\\xce\xff\x04\x98K|O5W\xf9\xbd\xef=\xd3v\xdb\x8f\xe49\xee\xe8\xc9\xd3r\xdb\xab\x9fq\x14\x99\xd4\xfa?\"\xbe\"\xaf\xf3Z\xc3c\xcf{G\xd7\xb9\xf3\xaa\xe0\xb6\x97T^j\xfd\xda\xeevQd\x00\x03\x88\x97\xfb\xdc\xfbQ0\x98\xdf]\xefy\x7f\xfb\xb7\xcd\x0e\xab3\xfa\\\xe7B4c\xe1\x0d\x8fx\xc4{=\xe6]\x87\x0c\x18\xf0~J\xc9O~\xd4\x84h\xfb\xd4\x05\xcaw\xeb\xe2r\x16#\x10\xc5\xe6sx\xf8\x1f*\x9e'\x92\xcbd\xe7=?\xfa\xc9\x1bl\xe8\xbdl\xe7\x953=\x82\xd8_=\xbe\xb4\xa7\xa1\xedAy\x94\xb8\xef\xde\x86\xe0k\x11\xf0	)\xfc+\xbf\x9c\x02\x14(~\xdb\xebi\xcf\xb8\x83\x11\x03\x9a\x0c\x85\xf3wOy_\x10\x1e\xed+\x17\x05F\xeb\x8e\xfd\xec\xc7>\xbeq\xef~\xe2\xfb.j\xdd'>\xfc\xd1\xff\xd1\xd5\xd3\x9f\xdfD\x87\xbe\xfd\xd8W|\xb1gKB\x95\x00\x9a4N\x98\x14\x7f\x12\x95\x7f\xb3\xb0\x7f\xd5\xe7\x7f]\x07\x80\x00\xf8~\xff\xd0eOz\x05+\x19@{\x16\xc8\x80(s|\x00\x90}'\x80wL\xf5\x81\x12\x18\x80\xb1W\x81	V\x05\xdc\x97\x81\x0b\xc8\x81\xca#\n\x14\xa0\x01\x1ap\x02!\xf8v\"Q\x82\x14`U}$h\x1a\xd8\x82.\x18>1(\x834\x98\x82(S\x82\x13\x06Q\x94\xb7\x82\xa4U~\xc7@\x822(\x83\x02\xe8:$\xd8~Gx\x00\xe5\xa7\x84\xd0\xc5\x84\xe8\"\x83A\xc8\x85\xef\x07+\x00x\x84ZXO\x19\xf8PZ82]\x88}^8BS\x98\x83g\xc8}\x18\x80\x84gh0O\xa8\x01\x12\xe8Lm8\x87\x18\x94\x00q\x18Q\x17\xa8!\x00P\x87vH\x84\x11\x88}z\x08\x87\x14\xa6\x87\x97\x10\x88Ox_\x7f\xc8\x83}\x88R_\x179\x1f\xd8\x88\x8c\xb0\x82'\xb5\x0e\x8a\x88	\x8c(\x83\x9b\xb8'=\xa8\x89\x9f\xb8\x88]8\x8a\\\xc0}	\x80{?\x88A\x96xNp\xb1\x8a\xa2\xf6\x88ME\x7f\xb0X\x8b\xb6x\x8b\xb8\x98\x8b\xba\xb8\x8b\xbc\xd8\x8b\x0c\xbe\xf8\x8b\xc0\x18\x8c\xc28\x8cU\x10\x04\x00!\xf9\x04		\x00+\x00,\x00\x00\x00\x00\xe0\x00\x80\x00\x00\x06\xff\xc0\x95pH,\x1a\x8f\xc8\xa4r\xc9l:\x9f\xd0\xa8tJ\xadZ\xaf\xd8\xacv\xcb\xedz\xbf\xe0\xb0xL.\x9b\xcf\xe8\xb4z\xcdn\xbb\xdf\xf0\xb8|N\xaf\xdb\xef\xf8\xbc~\xcf\xef\xfb\xff\x80\x81\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x8b\x8c\x8d\x8e\x8f\x90\x91\x92\x93\x94\x95\x96\x97\x98\x99\x9a\x9b\x9c\x9d\x9e\x9fV\x00\xa2\xa3\xa3\xa0\xa6T\xa2\x05\x06\xab\xac\x06\x05\xa5\xa7\xb1J\xa9\xad\xb5\xac\xaf\x00\xb2\xbaC\xb4\x03\xbe\xbf\xbe\xae\xaa\xb7\xb9\xbb\xb1\x00\xaa\xc0\xca\xbf\xc2\xc3\xaf\xc6\xa6\x00\x06\xcb\xd4\xc1\x05\xd7\xaa\xcf\xd0\x9d\x00\xd5\xca\xcd\xd8\xb8\xb0\xdb\x99\xdd\xde\x03\xe0\xe1\xaf\x0c\x13\xed\x0c\xa4\xe3\xe4\x8f\xe6\xd5\xe9\xea\x00\xec\xed\xfa\x0c\xfc\xfd\xc5\xf2\x8d\xbaM\xa3\xa6\xae >}\x08\xfb)\xfc\x070\x91\xc0j\x05\xef\xb1\xe3\xb7\x0f\x9e\xc5\x86\x88\xba\x15\x80\x18\x11\xdbA\x85\xef\x90\x85\xab%\x0e\xe3 i\x1b	^\xbb\x15\xee\xe3\xc2\x81\xccV2s%\xca\xe4\x1f\x940\x81\xadd\xe9q\xe2K\xffo\xab\x80\xad\xc2e\xf3\x8e\xc5Q\xcb\x92Yk\xe9\xd3_\xcezB\x831,\xeaf\x14\x84\x05X\xb3.\x80\x80t@\xca\xa5=A\xf2\xa3wn\x19\xcc\xa9T\xd3\x88\xba*\xa0\xad\xdb\xb7\x02\xb6vE\x17\x11\x1f\xc8n\x1e\xca\xeaE\x0b*\x1e\x1cQ\x0b\xe0\n\x16,\x17\xa5\xc1u\xfe\x06\xe4\xd5\xbbW\x16\x00\x05\x90k\xfe\x0d<\xb82\xe1T\xf7V\xd9\xc5\xeba\xf1\xaf\xbc\xd8\x9e\xd6\xe3\xcb\xe91\xe4\n\x15\xfc\xa2\x01`\xb9\xf5\xe0\x05\"\xaf!c%Jq\xe7\xdb\xbe\x16o\x14&:)\xe9M\xa2PCV\x90Z\xf2\x19\xd6\x1b6\xb8^\xee6\xb6\xb4e\x9emw\xa6\x1b\xf3\xebh]\xc1O\x9fV\xfd\x05\xb9r\xe6\xe0E>\x07\x16\xfd\xb3/\xa5\x8c\x7fis\x9c}8q\xeeZ\xbc\x83\x9f/\x80Voe\xba\xd3\x7f\xfb\xdd\xb7\xfd\xf0\xe2\xfcM\xe1\xddw\xf41\x87\x0c:\xfam4\x9dY\xd7\xe4\xb6\x98\x01\x01F\xe3\xdfv\xc6\x85\xd2Vr\x04\x16\xe8\x1al\xc10\xe6\xff\x8a\x83\x0f2C\x965\x11\x9e2J\x05\xee\xbdWa\x14\xac	\xa0\\r\x1a\x82\xc7U\x87e\xa1\xa7\x9e\x83\x10>\xa5YQ\x13RX\"\x11-\xbe\x05c\x8c\xafA\x00A\\\xf4\x04\xe5\x8du\xd6\x08u\xe0L\x10\xa6ub\x8a*\x96\x18d\\n\x0dI\xe4\x95\x02\x1c\xd9%Y\xae\xf4\xc6\xa4W0\xd1d\x1dmi\xf1\xd2c\x95I\x04\x89\x95`Z\x16(\xca[\x94q\xf8\x8d=L~\x18\x0c\x84\xb5\xed\xb9c\x9a@\xae	`\x11\x00h\xe5\xe5`qZvh}\x00\x1c\xfa\xa6\x9d\xe7@\xc8d2\xb4Y \xca4a\xfe	(\xa1\x82\x96RhV\x8bV\x86\xa1[\xa1\xd6\xe7\x16ls\x92\x1a\xd7\x8c\x91>\xa9\x1e1\x16X*\x8dfC\xfd\xc8c\xa7\xa0r)\xa3\x8b\x04\x06\xc9\x15`\xaab5\xa2Y\xe3\x05s\xc1\x05\xb8X\x10\x81\xac\xb5j\xba\xe9,\xfe5j$\xb0\x05nu\xa1r\xb05\x17k\xa3\xa4f5\xec~9I\x03\xc0\xb1\xca.{)\x9f\xaa\xd8\xfa\xff\xec\x94\xa3(\xa0+sl\xd1y,\x92\x11D\xc0m[W	\xab$59\x8a\x08@\xb9\x17\xd4;\nM+ \xa3\xee\xb3BL\xe9n\x8c\xa5\x1a)\x00\xb9\x02\xfb\xea\xed0\xfb\xaa7\x1e\x9f\xe5\xd6\x1b\xf0\xc0\xc5\xac\x880\x14\xa4hh-\\\x8d\xca\x15\xb0\xbd\x85\xae\xea-o\xb6\xf0\x89)\x84\x16\x1c{\xb2\xc0\x05\xafw\xf0\xc7\x84:*c\xa8\xb0M\xab\xec\xc6\x9f\xc2\x86\x15W-\xd36[\xad1\xcb\x1c\x01\x02\xf6\x16|3\xceH\xa4\x8c%\xbcR\x93:\xae\xd2L\x03&\x97\xbeECho+\xff\x1e\x1b\x80\xcc\x08 \xd01\xd4Y\xa4\xfc\xe6r\xd3R\xd6\x9c\xccd\xcb*l\xc9\xe7\xb6\x0c\x80\xd9\xc4Dp\x01\x01c\x1f[\xf6\xd3h\x1fQ\xf2\xdat^Fm[\xb0\xed=6\xd3f\x03\xc6U\xc1\x8f\xcfj\xcb\xdd\x92\x03\xb0\xf4\xde\x05\\\x10\xc0\xd2f\x07\x9evV\x84\x0df\xf9\xe1\xf5\xe9\x1d\xc0\xe2\x7f\x03\xd6\xf1?G\x83\x8d7MeG\x10\xc0\x00\x9a\x97\xdd\xff\xb9\xe7R\xc0\x93\xaf`\xf1\xbe\xc5U\xe3n\xc3\x86\x80\xe6~\xff\x0d9\xeb\x81R\xec5Jw\xdb>\xfb\x05\xb6\xdf\x8e;\xb4\xa4H@\xc0\xf5\xa3\xb8\xdd\xd6\xbdt\xfe^\xb2\xd5\x08\xf0]|\xe7\x1es\xda\x92\xc1\xd1\x97\xedU\xf4\x80K9\x8a\xf5\xa7\xc7\x1f\xc0\xf5\xd8\x07O:\xbe\\\xd9K\xb8\xe5\xe1K\x00\xbd\xedgc\xc2(d\xc3\xbf\xf4]\x83}\xd3\x0b\x14\x00\xf8&\xbf\x06\xca\x8f\x00A\x13\x05\x04	\xe3\xb3O!\xaey\x01\xf0_\xf4PF\xbd\xa3\x140}\xe9k\x1f@F\xc1@\x07\x9a0~\xa4\xa8\x1f\\\xb6b\xa4_\xad\xedw\x08\x08@	J\x10\xc2\xe2pJ\x14\x18\x08\x00\x06,g/	$ \x01\xcd\x03!\x02='\xc1\x13\x1a\x91~H\xa4\x1f\x97\x86\xd6B\xae\xac\xed\x83\xe3\xfb\x9b\x8f\x9c\x06\x80v\x00\xe0t;\xcc\x1a\x01\x12 \x81\x0f2-V\xb1\x13\xa11\x8ahD\x07&\xf1\x8c\xd8;\x15\x13\x9bhA\xe1	\x11\x80)J\x0d\x00\xc7\xa2\xc3\xbb\xd9\xff+\x00\\\x04\xe2\xbf\x96\x15+s\x89q\x17\x0b,\xa3\x19\xd1x\xc6\xc1\x81\xaa\x89m\x0b\x0c\x0c\xdf\xd88\x00\xa0(2\xb1\x8b\xc0X2\x90\x01\xcbYj~	\x80 \x15IADA60\x89G\x84\xa0\xa1\xd6\xc2\xc6|\xc1\x86s\x08\x00\xe3\x06W\xe7Hwyp[[\xcc\xe4\x1f1rEO\xc6\xefz\xb6<\x9dE \xc8\xc6\xc1\xe5/\x92\xcb\xb2]\xd3\xd4\xa4\xb0\xff\xbc\xef\x87]L \xa1ry\xba\x122\xf3\x96\xd8\x9b\xd6\x02K\xc6\x15\x08.\xad^\xd8\x94\x15<~\xc8\xcdb\xfah\x96\x0d\xa9\xe53\xc7iB\x12F\xb3\x9a\x10\x14E6E\xf1\xc3\x03\xb8\xf3\x9d\x07\xe0f7{4\xa82\x94\x8f\x12\xe2$\xa7>\x07\xc9\xcb\x05b\xcf\"\xf0\x0c\xa8@\xe5\xa9\xc7V\xba\xa7\x9e`\xb8b:1\x91\xcf}:\xf4\x81\x12Tb\x02\x04J\xd1\x80\x12\x94\xa0\xded\x93\x17\xc4\xa9\xc9J4\xf4\xa1 \x9d_\x12\x01P\xd1\x92^t\xa2\xee\xd4c\xc2\xe8	\x1f\x01=\xc0\x99\xff\xf7\x0c\x883C\xea\xd0B\x96t\xa0\xdc\xa4\xe8\x0f\xd1\xd2\xa9\x98>\x01\x00\x0f\x08*D=\x1aH\x9a\xd6\x14\x89]\xbc\xa9R\xdf\x99\x00\x11t@\x04\xa4\xc9\xa8Fs\x17\xd4\xaa\xc2\x14\x9c{ #9\xe9wT\xfa%u\xa97mj\x07: \n\xa8R\xef\x91\x07\xf5i\xd4\xaa\xca\xd6\xa1\x12\xf5\x99\\}(R\xcb\xdaN\xb0Z4\x01c%+\x08@\xa0\xd6\x95\x1a\xd4\x98}\xf5+[\xad*\xbf\xc0:\xc4\x93H\x04\xe9\\\xcb\x9aW\x11\xd4U\xa9?t*\x078\xa0\xd7\x0fX\x96\xaf?e\xa9Z\xe11X\xa1\xde\x12\xabY=!(\xe5\xeaU\xe3\x88\xa2\x03\x93Mm\x07N\xcaM\xd4Rv\xb2z\x05\x81e/{\xb0\x8c\x02\xe8(\x9b\x1cEg\xaf\xeaQg\x8ev\x9fI\xec\xa2\xc7N\x0b\xdb\xb1\xa6\x96\xb2\xae}-e\xa1\n\x00\xd9\xeeu\xb6\x1f\xc0l\xee\xe8\x89\x9a\xeaV\x00\x05\x92a'7;\xeb\xd6I\xf8\xf3\xb7\xb6$di\x01`\x82\xf2\x0e\x97\xb8\xc7M\xff\xaer\xc9\xda\\\x12\xccV\xb6\xd1mi\x13\xbc\xe9\x80\xfa\xda\x17\x05\xd8\x05\x00J\x99\xba\xdb\xc2\x82\xd6(\xd6K,3\xc5K\x00\xe1\x9a`\x86\x086ATG!Y\xd52\xb7\xb9\x96u/m\x0d\xeb\x84vA\xc6\x01\xfbM\x80\x03\xf2\x8bS\xee~\xd6\xbb\x02\xce\xa5x\x0d\x8c\xe0\x12\x94\xb0\xa3m:\n	\xe0{\xd9\xf8\xfe\xcbR\xdd\x19\x0e\x86\x99\xbaa\xec\xea4\x01\x83\xe5\xadQ<\xc8c\x0f\xc6u\xc0g4py\xcb\xcb\xb7t\x8a\"\x99\xd0\"\x81\x92\x9f\x0b]\x17o\x0b\x1f\xffM\xd8#\xeb+O\x07\xa0\x86\xa4:\xedo3\xa3\x1c\x9f\x10x\xf9\xcb`\x0e\xb3\x98\xbd\xec\xcfq\x06Y\x14C&\xf2\xfc\x8e,\xdc\xc0\x8aB\xc9K\xde+	Da)K-\xa4;\xa6\xa9\x80}\xed{\xe5\x8a\xfe\xd0\xc3\xba\xe42\x16\x00\x10\x82}\x02\x00~fF\"\x9b%\xc0h%\x1e\xfaz\xe6\xa5\xf0+\xfd(\x16A\x9b\x86J\xdb\xd9\xef]s\xfca;\x88\xc2\xcbe$3<\xffp	WE\xbfo\xb1\xf4+/\xa3\x9f\xb6\xc7z\xc9\xea\x1dbyGB{\x0c\x00*\xfb\x19\xd0\x01\x10\xf4\xa0i]\xa1GoU\x89\xb1z2<\x02Ld%\n\x88\x14\xf9\xb0\xcb]\xc8P\xeb=;\xdb\xd6\x9bfk	)\x9c\x07_\x97\x9ao/\xce\x98\xb9V*\x81b\x976w\xf9H\x88\xb2\xef,\x86f?\xfb\xd9\x9aN)\x8e\xdb\xba\xe5CX\x1b\xc8\xb9~16]\xcd\x90\x88\x8e\xb4\xc2\xf0@\x88>\xc6\xed\x0ff\x9f\xfb\xdf3\x86\xe7\xba	\x1bhC\x14U\xc4\xd8\x86G\xb0al\x84\xef~\x1bZ\x19\xc0\x80\xc4+\xa9ow\xf0[\xd6]8\n\xc07\x9e\xe1\x81{v\xcd\x8584\\s]0S\xc3\xc7\xe1\xd8\x8b\x9a(\".q\x0cT\x92\"\xfa\x86\xf5\xb23\xde\x80\x9a\xdb\xfc\xe6\x1b\xff7J\xffL\xf0x\x13\xe2\xa3G$y\xc1f\xe8\xdb\xa8\x16\xd2\x83\xdcd\xb9\xc4\x95]q\x99\x93\x9b\x0b\x00\xb8\xb9\xd4\xa7\x8es\x8e\xc7\xd3\x01\x83\xed\xb4 \x80~\xffB\xd6\x95\x98\x81\xbfAy\x17Y\x9b\xf4\xc2*D\xdc\x95\xee\x0e\xd5\xd7\xcev\x9b\xa3[\xc3\xd2F\xa1\xae\xcb\x8d\xd8z_\xaf\xc4%\x88j\x80\x19\xdd\xc5	\xf8\xf9\x87\x190{\xacE\x91\xf6\x8d\x06Y\x02mO<\xdb\xab\xda\x00\xad\x03\x82\xeb(\xf4\xeb\xc1A\x1e\xb5\xeb\xf1\xbd\xef\x02m9%\x03\x8f\xc2J\x03\xa0\x92\xe3\xb64\x81	\xcch\xc5O=\xa8\x8d\x97\xfb\xd6g\xfaY\xad~R\xef\x05\xe6\xbb(\xfc\xee\xce\xf8\x11\x94\xf3\xa7\x03=<6\x0f\xfa\xb1$t\xf4\xc0\x1f}\xe9\xa9\xde\xdd\x9bL>\xd0\xac/l\x9b,/\xfb	\xe4\xf0t)\xdd&%'\xde\xeb\xcdS\x9f\xda \xe3q\xa3\x83\xcf}4\xfaw\xebd\x84\xa0\xf7\x1dO\xa8\xd8\xaf\xba\x8a\x02_\xd1E\x80\xc4\xfb\x96\xbb|\xee*\xe7\xf5\xb0\x03\xdc}R\xa3\xd8\xf8)$d\xbb\xa3f\xbd\xcb\xcf\xfe\x9d\xd8W0\xd6\xe7~\x95\xd4\x07\xf27\x7f#\x05\x7fW\x00\x0b\x00@\x01\x140\x02(\x87F>uh\xff\xfeW0\xb4\x078\xfa\x95\x00J\xb7t\x90\xd0cx\xd0\x80\x0e(\n\x14\xa0\x01\x0f\x18\x81\x8e\x16!\x14({\x05\xc3\x00@T\x05\x19\x98\x01\x1aH}\xca\x042#\xa8\x01$h\x83\x14p\x02b'_\xc4T\x81T\x14\n\x807O\nHK5x\x83$x\x02\x10X`<H(\x10\x98\x82\x01\x185A\xb8S3H\x83EX\x83\x0e\xa8\x83O\x08\x00#\xb0\x85\xa4\x00\x81i\xa3\x81\xdd4\x854h\x83$h\x85[\xe8\x85\x02\xe2\x80\x0fx\x86h\xb8\x80`(\x85b8_EX\x86\x0e\xd8\x84OX0j\x98\x87m\xe8\x82Q8\x84&\x01\x00dH\x82\xf00hy\xa8\x86{\x88\n}\x18\x87\x15\x86\x83K\xc8\"\x85\xd8\x84\x83\x96\x00\x18\x00\x87\x8a(@\x8d\x98\x86z\xf8G\x19\x88\x01\xf1\xe4\x87\x95\xb8\x04 \x18\x82w\x08$?\xc4\x89\x07\xe0\x89\x9f\xd8&\x858K\x9b\x08\x80\xa9X\x15\x85H\x01\xa3\x980\xa5\x08O\xa8\xf8\x8a@\x12\x8b\xa2\xc8\x87\x92\xa8i2\xb3\x88\x8b\x98\xa8\x8b\xed3n\x19v\x8b\xb8\x08\x0f\xab\xe8\x82\xc8V\x11\xc0\xd8\x06\x83\x18\x1f\xcf\xd8\x8c\xd28\x8d\xd4X\x8d\xd6x\x8d\xd8\x98\x8d\xda\xb8\x8d\xdc\x188A\x00\x00!\xf9\x04\x05	\x00+\x00,\x00\x00\x00\x00\xe0\x00\x80\x00\x00\x06\xff\xc0\x95pH,\x1a\x8f\xc8\xa4r\xc9l:\x9f\xd0\xa8tJ\xadZ\xaf\xd8\xacv\xcb\xedz\xbf\xe0\xb0xL.\x9b\xcf\xe8\xb4z\xcdn\xbb\xdf\xf0\xb8|N\xaf\xdb\xef\xf8\xbc~\xcf\xef\xfb\xff\x80\x81\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x8b\x8c\x8d\x8e\x8f\x90\x91\x92\x93\x94\x95\x96\x97\x98\x99\x9a\x9b\x9c\x9d\x9e\x9fS\x00\xa2\xa3\xa4\xa0\xa6R\xa3\x05\x06\xab\xac\xab\x05\xa3\xa7\xb1I\xa2\xaa\xad\xb6\xad\xaf\x00\xb2\xbbB\x00\xaa\x03\xc0\xc1\xc1\xae\xb5\xae\xa2\xbc\xa7\x00\x06\xc2\xcc\xcc\x06\x05\xd0\xac\xaf\xc8\x9f\xca\xcd\xd7\xc3\xd0\xd1\xcf\xba\xd4\x9b\xd6\xd8\xc2\xc4\xda\xe4\xd0\xdd\xde\x97\xbe\xe1\xd9\xe5\xe5\xa4\xe7\xe8\x94\xd6\xcb\xd7\xcf\xed\xe4\xa2\x0c\x13\xfb\x0c\xc7+\xb0\xf1\x1a\xf9Z\x85\xed\x9e;}\xfb\x12N\xe8\x07\x80\x81C\x7f\x01\x13)#\xd8L\x9b\xbdv\x0d\x15*t\xc8\x91#\xbc\x88\x84\x06\xfeb\xb6\xcd`F\x8e	\x19\xbe+\x05\xb2\xd0\xc4\x02\x15\xa5\xdd;\xd9\x91a9[\xb9>\xb6\xec\xa3\x0c\x1aI\xff`\x171.\xac	\x8e\x1d\xbd\x01\xc6t\xee\xc4#\xf2(Rv\x18\xf5\x11u\xea\xcci\xd2\xa5vV\x8e\xa2\x1a\xcc\xe0\xab\xa1\x1e\xb96\xb3\nT)V5\xa3 ,X\xcbv\x01\x84Q\xceLJ\x0d\xbb.\xdcQ\xb3\x9eX\xd2\x11\xa5V\x80\xdf\xbf\x80\x05\xb8\x85;\xc0kC\xa2\x03<\xd4]\x8c\xf7\x9b\x02\x05\x15*\x00|#jA\xe0\xcb\x97\x07\xfb\x9a9*\xac\xe2\xc5u\x0d4\xce\x04\xe0q\x85\xc7\x90'\xa3\xb5\x8c\xb9ufZ\xeej\x91\xf2@\x9b\x99b\x8b\xa0G\x93\x16u\x1a\xb2i\xd5e\x00\xb8\x1e\x8ey\xc1fs0m\xd3^\x0eL\xf13\xa4\xd1\xeaN\xdb5\xaa7j\xc9\x10\xc5\x08\xdf\xb0\x81\xb8\xf7\xbf\xc7\x8b\x06\xfb\xdc\x9c\xf9H\xe8\xc9\xb1\x89\xf6V\x1d\xf5\xef\xec]\xb6w\xffN\x7f\xb3\xf8\xc4\xd7>\xa7\x07\x0dl:{\xde\xee\xa5\x06\x1f\x16\xf2\xd1g\xa0\x00\xb4P\xb4\x8es\xfb\xf1\xb7^D\xed\xb9\x87\x9dnM\x00`\x19w\x07\xd2g\x1cLb]\xf3\xffKmc\xf9\x94\x98s\x14\xca\x12\xe1u\xc0E!\xdf|\x19z\x07N\x87?\x95G\x9e0\x00\xc4U\"u\x00J\x98\"\x13\xc2	\xc0\x1d\x8b-f\x06\x01\x04\x02\xbc5Lh\x0d\x1eY[\x8d\xe2<x\xd6?9\xa28 \x12\x16\xfa\xe8\x17\x86A\xb6F\xa4qM\x86s\x9e0\xfb\x89v\x14+7\xa2s\xe2{\xa3\xf5\x08\x18\x96Y\xaa)\x98_F\x8a\xf3%t\\\xbdB\xa3:@\xadRf@g\n\xa8T\x95\x7f\xb1\xe6#\x90\x07\x8a\x02\x98e\\\x8e\x15Tt`\x8a2\x0ca\xad\xec	R\x9f\x13\x0e\x01\xa8_\x82\xfe\xc5\xe6pD\x82\x07@\xa7o&\x8a\xa4U\x90\x02`\x81\xa3\xb8H\xba\x13\xa5i	\xda\x17f?\xfe\x05\xaa\xac\x81\x8eB\xeb`\x8b=\xe3\x94\xa9\xa7Bg\xaa(\xae\xe8\xf9$*Q>v\xa9[\x1a\x12\x89%\xb2~\xfd\xaa\xe6\x96\xa2\x863\xd1\xa3\x17\\0\x11\xaf\xc0\x8a\xe9\xdf\xb0*\x02h!\xa2ne\xca)k\x18\xbej\\\x04\xa7\xb2\xa6\xff\xd6Z\xf793-R\xa6F\x10A\xb5\xaf\xa0+\x92/\xaarKDem1\xfb\xdd\xac\xcdVk\x9c\x05\xf2~\n'[\x03u(&=\xa2\xc9\x1b\x81/\x17\x14\xdc\x13\xbe\xfa^\xf1m[n\x12\xe7/\xa6\xdfV\xeb\xb0\xa1E\"\xbc\x8d\x82e\x11\xc4\xcd\xc7\x00\xcc\xfbpN\xf9Vl\xe9\xa7k\xbd\x95\xf1p\x06\x03\xf6\x96Z\xa6F\xfc\xb0p\xe1\x0eF\xcc-z\x9a\x1co\xb5:K\xdc\xb2\xcbF\xacd\xe0\xa5\xcd\xf2\x95\xb2\xca\xfc\xae\x9b0\xd0zN\xa4\xa7\xbcDG\x8c\xc0\xc3H\x831\xb3ko\x89+J\xd6\x11l\xcd\xaf\x852S}\xb5\xd5\x0dW\x1b\x00\xd1\x08 pt\xd7\xb3\xd07$\xd3\xc6e]-\x02\xa7\xaaus7l\xdb\x02\x80\xdc\xd2\x0c~\x01\x01o\xef-7\xdd^|}\x99\xcc\xaf6;/\xe2\x17l-7\xda\x9f\xc2\x138\x99r\xcbV\xf6\xe1\x05\\\x10@\xd9\x8b3\xfe\x84VJ\x7f\x97\xf2\xb7\x80\xa5,\xfa\xdbq\xcb-\xf3\x80Ss~\xff\xea\xd5[\x070\x80\xe8\xb1\xcf\xbd\xea;\x12\x10 \xfc\xf0\xc4\x13\xe0x`o\xc9\xbdV\xad\x08\x88\xae\xf8\xe5\x14\x1f\x91 \x99e\x0f4x\xdc\xbaW\xde\xbb\xe9E\x90\x12|\x00\xe0\x87\x0f~\xf1\xc3\x1fo\xf3\xe0\xac\xc398\xe5\xda_\xbe\xad\xf4\xc7\xd5\xbb\xf2\xf5q\x17\x16{\xe9\xa6\x8f\xf2\xbd\xf8\xfc\xf7_\xbe\xdd\xab[\x9e\xe4\x10@\x00	\xb4On\x95z\x19KRQ/Q\x90.n\xd0\xb8\x9f\xefp\x04\x00\xc4\xf5\xef\x82\x184\xde\xbf\xde\x92.\xd6\x18\x07{\x06\xbc\xdf\xc3\xd04\x8a}\x00 \x03\xa8[\xdd\xfdV\x18\xb7	&\xa3\x82\x18\x8c\xa1\xf8\x8ag+N\xdd-}\xc9C@\x00JP\x82\x15\x96\xc6=\xf4;\x0c\x000\x80B\x82\x01@\x02	H@\xcaX\xe8C\x97\x89\xc2\x822\xcc \xf9\xfe\x07\x1e\xe4\x0d\xe9n\x02\\b\xdc\x9e\xd7\xc2\x1c]\xaf`\xfd\xc8\x00\x11\x07w*\x02$@\x02Z\x8c\x1b\xba,\xa0F\x17\xe6\x05\x8aQ\xf4\xdf\x14i\xd84+^\xff\x91u\x1fd\xe2\xf6\xa0\x94BQ\xa0\x90o\x00\x08\xc0\x19\x95\x18/\x0b\x18\xd2^n\xecD \xe3(E\xe1]\xf0\x7f\x15t\xd3\x15\xef\xb8\xbc\x1c\xea\x11\x7f\xbd(\x96\x9f\xcahF\xe3\xf1Q/\xdcZ$#\xe5\x18G\xe1UP\x83p\x9a$\x16-\x93FC\xae\x90k\xf0\x03\x80u\xd0\x94\x00O\x8eaJ\xf2\x18\xe5#u9>T\xf2e\x92|a\x17\xe9\x1c\xc6\xc66\xc2/\x89\x84\x94e\x80\x12\xa8\x1d\xe3%\x92\x0f0\xe4\xa54/8\nS\xde\xd0\x94\xecZ\xa2\xc3\xe4u*\x9d\x88\"\x01\x078\x002\x93\xc8*\\\x12(|\xb6\xac\x04)\xe08Mi\x12\xaf\x9a\xe5\xd3\x8c\x03\xb9	\x9fQ\x803\x9c\xf8\x14\xe78\xcb\xf9LQ\x82\xcf\x9c\x90\x10E;\x07\xca?\x1a\xbe\xc5\x96}\x04@\x12\xf3\x99\xcfq\xee\x93\x9fY\x00\xc0\x03\x1e\x00\xc7t\xa6\x83\xa0\x18\xed\xa5)#i\xcf{\xe2\x13\x99\x0cm(H\xf5\xa99M23\x14\x13\x9d(\xff\x00\xfa\x08\x7fft\x9a\xff\xe53\xe5BC:\xd3\x90\x86s\xa475\x0bDU\x94\xd2\x94V\xf4\x99\xd0|\xe9@\xc9\x07\x00\x9b\x1a\x95\xa18\xcd\xe9\x12vZ!\x89\xf6T\xa5\xe2c)#\xa2\xc9HG\n\xb5xh<\xeaQ\x93zS\x11\x94\x88\xa9\xb3\x18\xc5S):C\xa0\xe6\x81\xaa\x8f\x1c\x9eP\xd19\xbc\xacj\x95\xa6\x1eE\xaa\x08\xbc\xda-e\xea(\x85\x99\x14\xebXWj\xd6\xacX\x95\xad\xc5[\xabF\xd1\xa8\xd0\xb7\x1a\x16\x9f\"\xe8\xc0\x8e\x9aj\xd7\xebD\xe6\xb1\x15@\x81?::\xd6\x9f\xaas\x8ej]+\xf1\x08\xdb\x81\x0e\xd4\xf4\xb0F\xed,\x00@ \xd5\xa5F\xc9\x01\xa8M\xad\x03P \xd9\xa2\x8at\xafQ\xed\xeb\x1cN9\xc5\x8cN\x91\xb0\xba\x00@g; \x82\xb8\x82\xf6\xa3\xa2\x05\xc1\x07H\xab\x85\x1c9\xc0\xa3	@mk\xe1\xfaT\xcbF\xe2\x88\x01\x08,L1[@\xd5\x00\x80\x03\xd8\xe5\x80g}k\xd8\x0e`w\xb4\xc2\xfd@\"E\xf1\x98\xe3~T\xb9\x92\xb5\xffi\x12\x9f\xca\xd7\xe7\"\xee\xaf\xee\xc4,a%\x80\xc6\xee%v\xb7\xbc\xfd\xacQ\x13\xd0Y\x0e\x8c\xf6\x03$\xf8\xc0p\xfbY\xde\xe3\"\x13\xb5\x91q-M\x9b[\xd6\x80J \xbaC\x9d\xe3|q\xfb\x11Qx7\xbb\xd8\xdd\xaez/\xac\xd8\xf0\x92\x00\x94\xc5=M\x05T\x9b\xda\x04\xef7\x01\x0c\x0e_i\x05\x81V\x19\xb2\x13\xb3+Q\x01\x00L\xf0'\xddf\xb7\xbf\xdau\xa8w/\x0c\xde\x0fc\xcb\xac\x9a\xd4\x11wo\x8a\xe2\x9e6\xd8\x11-\xdeeA\xa7H\n\x15\xa4\xe0\xc9)\x98\xb1N\x01p_\x0ck\x17\xc3<&\x81\x8f\xe9)\xdbO\xa2\x0e\xb5C\x16g\x8a\xff\xd9R\x97\xce\x90\x9d\xd1e\xb2	\xd6L\xe3w\xac\x19/\x16\xc62\x96yK\n\x82\xed\xec!d\x00\x00\x89\xf7\x0cf\xe6\x1aY\xc5]n\x83\x99\xd3\x9cV\x1a\xb2\x99\x87\x88N\xb4	\xea\x1bV*_8\xc3^\xc5\x16\xc1N\x85\x90	\x04\xba\x17|\xce\xb4o\xd7\xebSt^z\x0d.\x85/`\xff\xdfI\xdfR\x97\x1a\xbe\xd5\xe5\x91\x85E\x9b\xdb:\x03@#\xfd\x08N\xa6g\x1d\xd7\"\xa74\xb6\x83H\xa8\x05\x89W\xe8\xf2y/x\xe4\xa3\xefbc\xc9\xc7\x0c\x18[\x14\x1a1\xe1\x17\xb42\xebf#\xd7\xd6d\x1d\xdf\xa7\x19\x9bPQ\x84\xe0\xda\xd8\xcev\x08h+j\x8d\xfa\xda\x90\xdd\xecc(\xbe\x99\x001b\xe0\xdc(L6\x90\x1b\xc0\xeev\xbb\x9b\xdd\xcd\xde\xf3=9\x0d\xd5\x7fN{\xa9\xda\xce\xb7\xb6c\xc8\xedF\".\xb7\xe0\x8e\xd76\xedeZ\xd49\xd4\xdc\xe8\xce\xc8F\x80\n\x80w;\xfc\xe1\xf0v\xb68\x1d\xd0ii\xc7\x01\x00!\xc8\xe8\x113+\xc7\x7f+p\x9e\\\x06hC\xf4\xfb\xd1$f@|\x188\xcc\xc2\x1b\x07\xf1\x96\xbb\xbc\x01\x99\xfe\xb3\xbd\xe1`\xed\x8c\xf7\xef\xda\xd5>e#\x03\xa0\xb9R\xff8\x9a\x8di\x88Q\xcfMDc\xa3\\\xe5`a\xf8\xcb\x97\xcetvW<\xba\xf7VBBy\xd4\xed^\xf2\xbc{l\xae\xe6J\xa5>\xff\x81|\x8a\x8f\xc8\xe5>zMb\xdd8\xf9J\xa0\xe9h\x9fh\x03p\xed\x124[\xfdOo&^\xa2\x83\xde\xf5\xf0\x89\x14\x99'\x0f\x1f\n\x89\xe25\xea\xfa\x9d\xba\xf4}\xf9\x91s\xedv\x08\xeb\xd4\xe7\x89.A\xf9\xa84\x81s\x7f\x14u\xc6N\xf8J\xc2\xc0\xed\xbf[\xfe\xf2\x1c\xe7y\xd4\xb7\x00]\xff]\x9dJ\x88G\xb3N\xbb\x8e\xcf)\xad\xa4#\x81\xce\xb9(\xe8\x8b\xf9\xd6[\xd5\xa2~\xe8|\xff*\x0c\x8b\x95\x88\x9e\xf1\xa5\xa7\xd2\xb1\xc7\xbey\xa9\xab>\xe7\xac'\x1f\xd4{o1jvo\x04#X\xa7\xa7g\x8f\xfb\x03\xe8\xd4\xd8\xbbG}\x99\xab\x0d\x88\x16/\x90\x02#8\x81\xce\xe3\xa8S\x06(Q\xf7\xd0\x1f\xa3G\xb8\xb7\xec\x07\x03\x9a\x02\xe8\x17\x05\xfa)\xa0}\xe9>r\xcap\x0e\x7f\xc2\xc9N\xfe\xb2K\x1b\x00\x14\xd0\x80\xfe\xf3\xaf\x01\xf6W\xbe\xdb\xb0w:&Gt	W\x7f^c~\xb9\xc5\x7f\xfd\xb7\x7f'\x90|\xd45l<br\x08wn\xd2\xc4\xa7/\x1b\xa7~\n\xc8\x7f\xd8\xd7~\x1b\xf5\x0e\x16cr\x03H\x81\x06\xa8\x1d	\xa8\x7f\xfd\xa7\x81\xfe\x07\x81\xa1\x00\x82x\x97r#xK\xf8g\x82'\x88}\xc8\x87|\x0c\xc7\x82\xe4\xa4\x82/H 2H\x01x\xc5\x05\nUn\xfb\xb4\x83f\x00\x00\xfb'\n'\xd0\x80\xb2\x15\x84\x180\x84D\x18\x1c\xa3\xb0\x7f6\xe85I\x84\x01\x8f\xf7\x84\xc1\xb1~Z\x98|TX\x85J\x85\x85y\xa6\x85\xe8\xc7\x85]h\x85\xe1T\x81\xf5\x87\x7fb\xe8\x83\x94\x87Lf\xe8|`\x18\x86k\xb8\x84U\xe8QhH~j8\x87^\xe3\x10M\x98Ow\x98?\x18(\x86@\x96\x0f\xdew\x85q\x08\x83\x82\xa8\x1d\x0e\x91\x12\x7f\x98\x86 \xe65\x1ex\x88\x928\x89\x94X\x89\x96x\x89\x98\x98\x89\x9a\xb8\x89\x9c\xd8\x89Z\x10\x04\x00;\x03\x00PK\x07\x08]12\x95\x96^\x00\x00\x8f^\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x004<N]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/en_GB/common.jsonUT\x05\x00\x01T0\xcfj\x8cV]o\xdb:\x12}\xb6\x7f\xc5@@po\xd1 }\xcf\x9b\x13;\x8d\xbb\xf90,\xb5A\xb0\xdd\x07Z\x9c\xc8l$\x8eV\xa4\xe2\x1aE\xff\xfbb\xc8\xa1$gQ\xe0>\xe9\xcc\x99Cj\xc8\x99!\xf9k>\xcb^\xa8k\x94\xcf.\xe1\xd7|6\xcbv\xcaZ\xd4\xd9%dW\x8b\x87\x87\xd5\x12\xae\x9e\xe1\xaf3\xf7\x17\xdc<n\xe1\xcc\x85\xcf\xf7\xec\xcc}\xcf\xb2s\xd6k\xac\xd1\xc7\x01\xcb\xd5\xdd\xaa\x18GD\xbfiT\x85\xcbQ\xb4\xbe_|^\xc1\x9f\xa5yK\xa6\xc6n*\xce7\x8f\xeb\xbb\xd5\xf6\xbd\xdc\xe2aC\xce\xbb\xb5-\xf6\x1d\xaa0\xe0L\x83\xc5\x03\xb4\xcc\x83\xb1\xe0\x83\xe7\"\xce\x1f\xd8\x85\xd5k\x0e\xc9=6\xc6K\xe0g:\x8e\xf8\xdb}\x00e5\x9ci\x08\xb1\xb0M\"\x1bg\xf8\xc3\xc0Sa\xdfU\xa89<\x9e\x7f\xf3\x98\x17\xb0\xf9\xba\xfd<.\xe1\xff\xf6\xd1\xed\x95\xa6\xc3\x95I\xbb\x9f\xdf.\x96\x8fOp\xb5\xfe'I\x88\xcb\xbc\xa3\xf2\xb5\xa0\xaa\xaa\xe3\xaa\x8a\xdb\xedj\xb1\xe4\x9c\x9d\xecro\xc7\x14\x7f}8Mr\x9c\xed\xcd\xe0\x01u\xae\x1a\\oR\xf89<\xde@q\xbb\x82|q\xbf\x82\xf5\x06\x9eV\xdb\x15|[\xaf\x9e\xc6\xe8\xb2\xf9\xec\xf7\xb9\x14\x94\xe3z\nV[\xf7\x9d\xaa]\xaa/\xad\x8e\xd9%\xfc{>\x13|\x9e\x90\xcb\xe6\xb3\xd9\x7f\xd8\xcc\xf6\xd4w\x83(\x18LG~\"k\x8c\xed=\x0eB1\xd9\x95|S1Y\xbf\x1f\xb5\xc1\x12)\xe3\x89\xd2aIV\x0fR1\xd9\x95|\x13\xf1\x11\xd5\x18j0\xce\x07\x18e\xb2)\xa1\xc0\xd2&(\xdd\x18\xcb[\xbb\x08\x80\x87d\xaa\"f\xf8\x13\xed\x10C\xf8\x88Mq\x88%{l\xa8w\x91.\xc9\xfaN\x95>\x165\x0b\xae\x85\x01\xa1&mzu\x1c\xb3\x1a{\x12T]\xc3\xee\x08\xebM\xd4\xe1\xcfV~\xbc\x8ah\xc2\x8e\xbf\x88\xbe\x93\x1f\xec\x8d\xe6Td\xb7\xfc\x0d\x83\xe2\n\xd3\xf2~(k<u!\xc2{4ZV\xf9\xa3w\xfe\x81\x0e\xcc2\x04K\x87\xe8\xa8\xa9|\x8du,(\xb0\x0di\xec\xd4\xc9<\x1f?FW\xea\xbfK\x18`\xe4\x0f\x16\xe3ooQi\x98\xfc\xdb!.\xea\x9a\x07\xe4\x186\"ub\x8c'\xdf\xa7X\\<\x93\x82R`\xe0}h\xb6\xdc\x9b\xf2\x95\x8b:\x8b\xcd\x07.\x12\xd2m\xe3B\x06<t\xd94\x1f\x9c\x99!\x0dG\xeay\xc4\xdf\xcf\xd4\x7fHm\xe5\x8e\xb6\x94R\xcb\xb4q%Y\x8b\xe5\xb0L1\x8d\xad$\xe6\xa3-O\x8c$\xd4(\xd6<t[\xe6M\x83\xa9.KU\xa3\xd5\x93\x8a\xfe\xa2\xac\x14\xf4\x0d\xee\x04\xdd\x0fE\xbeh\x13\xba\x1f:\xf9K\x9fF|\xe9kA\x8b\xbe\x12\x94c+\xe8\xb1\xf4\x82\x1e\xe8M\xd0\x12\xcb\xb1\xb3\x0e\x88\xafC\x1c\xf90\xeb=%T\xf4\xa9\xd9\x9fdm\xb3\xac\xd8\xf7\x82n:#(W~\xda\x88\xbdI\xab\xdd\x91\xf7\xd4\xf0>_E\xc4\xfa\xacT\xb6\xc4P\x15\xd7\x11	\xebUMU\xa4#\x8c|m\xf8\xd0\x15){\x99\x00O \xf3L\xbb\x8fT\xa7'\xad\xb7\x0bv\x14\x90\xe5$dK\xfe\x06\xe6\xc5\xd4X\x10\xdd\xa9\xae\n\x1e\xb6\xc1\x13A\x1d\x18\xd1X\xe3\xf6\xb1Kn\x12\x0e\x9e\x8a\xa8\xaa1'[\x9d\xc4\x14ip\x94\n\xc34-u\xfe\x9a\xba\xaeo\xc3U\xb5\x0e\x04\xbc(S\xa3\xbe\x80\x1b\xfek)n\xb9\xcfY\xb0\x94xE\xee\xfa\xb2D\xe7^\xfa\xfa\x02\x8a=B\xab*\x84\x83\xa9k\xeeg\xe8\xb0\xa6\xe1\x126\xf6M\xd5F_\xab\xd6\x97{\x15\xe6\x88\x0c\x94B\x85\xdf\xbc\"\xb6\xe1\x842\xb6\xba!.\xc8\xec_\x88-h\xe1\xe0\x85\xa4	k\xe5B\xe4w\xfc\x1d\x8e\x8e\xf1I M\x19\xafH\xe0n\x1cU\xa8\x0b\x92\xdc_B\xc6\xf7'j\xde'\xa9\x8c\xa0k\xf8\xc0\xf8f\x9c!\x9b\xee\xf3p\x86\xc0[\xe0D\xd4;\x7f\xaf|\xc97L\xb6Q\xce\x1d\xa8\xd3\x0e\x98\x86&\xf0\xe9\xc92\x06\xf6\x80\x07\x89*=.\x8c\xf5\x05]\x8f\x95\xb6a\x86\xe3I\x9c\xe8\xf8\xd13\x9c\xc5\x1c\x94\xfb\x14\xedOEq\x17E\xff\xedI\x1e7\xcf\xd4\xc3^\xbd!\xec\x10-\x08\x1f4\x1d*\x17\xef\x94mD\xc2j\xd3a\xe9\xaf\x8e\xf1\\\xda\x8a\xfd\xfe\xa6\x18u\xe3\x92\xdek\xa7\xcb\xeb\xf0\xa5C\x176h+P\xe6i\xebpzn\x03H\x1cu^HF\xc2\xfa\xbe\x93x\x03\x8al_\xc7\x8d\xe0\xf3\x1a\xb6\xc1\n\x0e\x87\xaa\x8b	\xc9#\x12\xd6q\xdaV?[#\x8f\xcc;\xaa\x8c\x05\xe1\x01\xc51\\\x05\x0f\xe4M\x19ZPP\xf4\xf4\xbb\xc6\x84\x08\xf3\x88\xde\xbd\xc3P/;\xf5\x12\x04\xc5Xy\xdcR\xcf\xd4w\xa0\xd9	{\xe5bV^\xb1\xf5\xd2\x1f~\xdf7;\xabLmb\xef\x16\x13\xfb\xe2\"\x89\xa8\x0d3S+\xf6\xb1\x15\xb9 \xb9{\xd2\x01\x91\nw\xa8\x05ea\xf4\x86\xc7o\x1ar\xe0j\x1d3\xfa5\x12'\x99\xec[\xeeg>\x1a\x82\"X\xc0\xe7\x93\xcc\x11\x88MGU\x87.d&2\xa8\x87\xf8\xf9\x85)\x01'\x18\x86\xbe\xfb\xf9\xd3\xf4\xd7\xf3\xd9\xef\xf9\xef\xff\x0d\x00PK\x07\x08\x13D\xb5\x00\x03\x05\x00\x00\xb0\x0c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x0b>N]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/en_GB/server.jsonUT\x05\x00\x01\xc63\xcfj\xbc[mo#\xb9\x91\xfe<\xf3+\n:\xec\x0d\x10xd\xef\x0b\x82\xdc|\x08\xe0\x97\xf1\xac\x13\xdb\xe3X\xde\x0c\x82\xcd\x06\xa0\xbaK\x12\xe3n\xb2\x97d[V\x82\xfc\xf7\xc3S$\xbb\xd9\x92g\x92\\\x92\xfbb\x8bE\xb2\xf8V\xaczXU\xfd\xd7\xd7\xaff+\xebZ?{G\x7f}\xfd\xea\xd5\xec\xf2\xf4w\xb3w\xf4\xe3\xebW\xaf^\xcd\xae\x0c\xaaT\xd0\xd6P\xa7\x0c7\x14\xf89\xcc\x8e\xa4\xf2\xbd	N\xb3\xa7\x95u\x146LKe\x0c\xbb7\x9e.\x1d\xff\xdc\xb3	\xcd\x8eN\xfd#\xd7\xf4\xbb\x9e=xxj\xb4\x0f\xa4LM\xba\xe0\xdc\xdaZ5\xb3\xd7\xaf^\xfd\x04\xc6\xb3\xdb\xc5\xe5\xa7a\x06\xb76\xd0B\xad\x98.\xad\xa3O\xd6=\xa6\xc1\xcf\xacr5\xa9\xa6\xb1[O\xad\n\xec\xb4j\x8e(lT \xe5\x98\x8c\x0d\xe4\xd1/XZ2=i\xde2F%E[\xeb\x1e\x89\xcd\x93v\xd6\xb4l\xc28\xb2j\xb6j\xe7\xafm\xf58\x8c\x7f*$\x02\x0d\xac\xcel\x08\xb6Ms\x10\xa2\xaf\x9cm\x1am\xd6\xa8\xee\xd4\x9ai)m\x88\x9f\xd8\xd0v\xc3\x86\x82Z\x92\xf6\xb4\xd1u\xcd\xa6\x18\xcdX\xb3k\xb5\xe7q\xb0\x81\x12\x07\xb8\xd0\xbek\xd4\x0e\xcb\xa4\xce\xfa\xc0\xce\x93\xf2\x14;\xda\xde\x17\xbc\\\xb5\xd1O\xfc\xb0q\xacj?2\x8cd\n\x89~4\xa1v\xae7\\\xe7J\xd2\xc6\x07V5\xd9\x15\xd5\xdcp\x905m\xb8\x9dSb\x1b\xc9\\\xd3r\x873c\xa7\x82\xc5\x8c\x1c\x93\x0f\xbair\xfd\xbc\x98W_k\xfb{\xdb\xf4m\xb1J\xd0\xe8)\x12\xe3\x8cb\x0b\x8c,\x1dpNm\xefu%\xa2\xf2\xa4k\xb6\x84\x8d`\xe7'\xbc\x83]\xeb\xd5\xc8\xd7h\x08BM\x1f\xae.\xe9a\xd3\xb7K\xa3t3,:\xd6Je\x18+\x07n\xcb\xf5M\x1f\xc6I\xa2@g\xaaz\\;\xdb\x9b\x9a~\x8fI$VR\x17%~\xa8\x97I\x96\xdcb\x87\xcc\xee3\x9cN\xebV\x9b\xb7\xbe\xe3J\xaf4\xd7\xd4r\xcb\x9e:\xddqM>8\xa5\xd7\x9b@\xda\x04K;\xdb\xbfqL\xad5:XW\x0c\x84{\xf0\xfe\xb9\xd3n7\x0e\x06\x1a\xb1\x10)\xe8a\x97o\xfbv\xc9\x0e\xbb\\\xab\x9d\xa7\xad\x0e\x1b\xdb\x072\xbc\x15\xe9\xf2\xb4\xe4\x95uL\x8a\x84+d6\x9d\xe8\xdex\xb7\xaa8\xce8\x9aQ\xe30*\x1e%v\x08\xbc\x85\xd9\x9c\xce\x95\xa1\xca\x9a\xa0\xb4\xa1\xbe\xc3m\xf9\xfa\x84\x1a\xbbeW)\xcft\xad\x826\xd4p\x10)\xb7\x8e\x8c\xcc\xb6<pa\xf4\xa0C\xb3?x\x10Z\x1c]\xea\x0f\x86?\"\xdfW\x1b\\\x9e7\x10\x84\x9e\xfe\x9bn\x94Y\xdb7s\xfa!\xcd\xe5\x84\xaa\x8dr\xaa\x92\xf1\x1bk\xd6\x93\x91\xebr{\xeb]\x1e\x8c\x9f\x03-m\xbd\xcb\x03b\x1f\xc7n\x95\xeaB\xb5QC\xcf\xf3TN\x87\xef\x1f\xa9\xf7\x18-X\xaal\xdb\xe1\xf2\x90\xa2\xd4K\xf4j\xc5N6,(\xff\x08\xed\xf9\xc8\xe4x\xad!\x1b\xa2:q?\xe2\xfd\xa5\xca\xb1\xd0\x0e\x86\x7fPk\xbf?\x05\n \xa6E\xa8\xb5L\xc1\xdb\xdeU\x1c\x87MS\xf0\xb6\xe9\xc1\xd3\xcf\xe9\xa3at\x82P8ej\xdb6;\xaa6\xd6\xb3\x91\x1e\xac\xaa\x0d\xad\xd9@)p\x9d\xd70\xa7{\xfe\xb9\xd7\x0eZ\xc6\x0e\xa7\xaf\x025\xac|\xa0o\xa9\xd6\xab\x15;6\x01\xbc\xcb\xb3\xc6Y,*\xeb\xc6\xa3>\xcf\xa7C\xbeS-y\xa9L;i\x82\x16\xe2\x96\xe5\xc6\xd8\x15\xf4\x93^\xed\xa0\xc2\xd4x\xae\xd0+J\xcehN\xa7\xab\xc0\x8e\xf8\xb9b\xae\x93\xa6\xa3F\xb7:\xc8/\x9c\x0bm\xa1\xd3\x0c\xc7\xc9{\xdb<\x15\xa7SN\xd5\xb6\x9dc\xef\xb55\xd7\xfc\xc4\xcd8\xe3\xb1\x82\x1a\xa9Ij\x9dW\x0dTQ\xd11\xd6c\xe2[^z[=r\xa0\x96\xbdW\xebd`\xabF\xb3	\x9e|\xdfu\xd6\x89n\xee\xd8\xa5&o\xeb\xc8qNo\xbf\xc6\x01a\x055\xafT\xdf`\xa7\x9f\xb89\xa2\x13\xaa\xb5W\xcb\x86}9\xec\x11\xbd\xfd\x06k\xf5dM\xb3\xa3\xef\xfb\xd5\xaa\x95k*[\x02\xe1\xfa\x1a\x92\xf1?\x14\x9c\xaa\x99|\x87\xcd\xc0t\xbc\xfe\x0bO\xb6\xc0\xf9s\xc75\x9b\xa0USH\xdb\xc7\xfb\x05UEE:/\x98m\xaa\x9c\xf5\xfe\xaduz\xad\x0d\x9d\xde]\x91\xc0\x06\xe8!l7\x9b\x9a*k\x1f5\xfb9]\xad\x8du\\\x1f\x91^\xd1/\xb0Be`\x14-lzd\xb07\x99\x8fB\xdc\x9b\x88MD\xb4z5KM\x06>\xc1R\xab\x1ey:\xadrJ\xc7\xaa\xd3\xc7\xa3\"\xd9\x84\xd0\xf9w\xc7\xc7\xfc\xacpu\xe7\x95m\xe7\xf4\x8b\x0cI`\xb2\xd3x\xe5\xd4z\x1fl{\xbeX\x0c\x13K\x93\xf9\xc1\xb3\xc3)j\x18\xe4\xf3\xc5\x82\\\x8f\xa3j\xac\xaa\xb1DC\xc1vY\xc7xn\xb8\xc2%\x0b\x1bn\xf9\x05\xe6\x0fv\xbd.t\xe4\xb9\x0c\n\xaei\xb0X\x8fs\x07\xcbj\xac\x1eX%\xe1)'z\x91\xe4)\x0e\x9a\x18\xe176\x0e\xf3\x04*H\xfd\x8e\"\xee1\xd60\x0e\xcbs@%\xa42\x8a\xf1\xc1@\xd7\xca\xac\x0fFj\x94Y\xf7j\x9d\xcd\xcau*R\xa7\xaa\xc7\x17\x06}\x81\xa9\x0f\xb7\x07\\\x1dw\xd0\\\xb67\xe1\xc0,\xe2V\xfa\xd8\x04\xc0\xd6o\xec\xd6\xc8\xe6G\x0d\x0bt\xe7\x8b\x11\xe7t\x82y\xa0\x19\xce\xbb8\xe7\x9a=v\xd5a\xf6\xe3\x04|\xbfH44\x9a\xb4\x9a[\xb7&\xdd\x02>z\x06\x98+x\xc5{+\xa8id\x16\x89\x11.%v\xf7\xfcg\xaeBBP+\xdd\xf0h\xe2U\x02Q>8V\xed\x01\xef\x0fl\xaf\xee\x0exGjRYV\x10uc\xed\xa3\x18o\x1c%\xb6P\x1e\x00v\x95\xd1\xe9\x9c\x04\x0dDlA\xab\x06\x96\x85\x0d\xb8\xd5Q\x9b\xca^\x19\x1b\xab\xca\x0d\x8b\xeb\xb9\xbb\xb8<\x98\x06h\x93\x05\xde]\\\xc6\xe5\x1dt\xbf\xb7K\x1b\xc6K\x7f\xe7\x80\xc1\x03UNm\x1bv\xf9\xe2g\xb2\xea\x83\x8d\x80\x11:W\x07\x1e\x1a\x8e\x97<\x1e\x06\xb1Yk\xc8\xb2\xa9\xf9Y\xeaW\xce\xb6\xa4\xaa\njT\x8c\x87\xf6	\xe7\x1cL\nW;\xee\xca\xc1\xe9\x19k\xde*@\xc0\x04\xb8\x06\x13>\x99(\x00\xff\x0e\x83a\xc3Iu\xca\x858>N\xe1\x8d\xf4\x7f3TKE\xe4c\xd6#\xfc*\xf7\xca\x9aQ(/\xb5\xd1~Cw\x80-\xa8}5;o\xacg\xb2\x1d\x9b=0#\xa6u\xa9\x9a\xd1\xbe\xfd\xd7\xafP$e\xfcv\xdc\xddk\xbc\xf0\x00\xe4#ux\x1d\xa6\xc6\x1b\xe57\xb0@\xad2\x9f\xc1\x84'CW\xd8\x9foN\xa6\xc0,\xd8\xa0\xca\x9b\xc6\xad\xd2\xcd{\xe7\x86I\xbd\x07\x81\xd89\xeb\xfcT\xdfIS\xecJ\xac|\x81\xc9\x8d\xd2\xe3\xea\x84Q\xda\x94\xf7\xe8\x11\x19\xbc\xd0\xedN\xf9B\xe8\x94\xf7[\xeb\xea\xc3\x9e\xd4\xa5\xaa\x97XX\x17\x06\x1622yvO\xec\x08\xd6\xfe\x05^E\xed\x0b\xec\x16\xfd\xf2En\x9fg\xe4\xfbem[51\xa4\xfc\xbca\x9c\xcd\xc8*\x13\"\x9b\\\xff\x05\xe5\xc5\xcf\x9d2\xf5i!4\xef\x85B\xa7MCW\xe8\x92\x0f)\xd1!#\xa2\x07\xfd\x9c>\xf1\xb2=\x92\xeb\x8e\x9a\x9b\xbbo\xe5\xff\xce\xf6\x8e\xa0\x98!\x9ex\xeb\x9b7\x81\xd4j%6qN\xb7\xe95\x93\xb8\xa0\x01\xa9\xc6[\xbc\x87\x94\xa9'\xafS~\xee&\xfb\xfe\\n\xb6\x14\xc8v\x82\x7f\xa1\xe7\xa1s\xc6\x95\xad\x98\xeb\xa5\xaa\x1ee{\x87\xd5]&j\xdc\xdc\xb44h\x00\xca\xedc\x0d\xf8\xd5\xe9u\xafa``F\xbb\xe4B)\x06\xd1\x0d?\xec:\x1e\xc5\xebR7LAH\x05\x96\x02*C\x05?\x076\xc0\x9b\x85\n\xfbs\xb7\xc6sj\xcb\xcbvN\xd7\xac\x9ep\x13\xba\xb0\xc3\x14\x04\xac\xe0o\xc6\x95@\x15`\x9e\x14\xb9\xf8k<U\nfP\xcc&\x8e\xdf\xd0\xba\xb1\xb8\xfa\xbb\x11<I\xa7b\xe2\xcd\xe4\xd1\x01\xcd\xe5vQ\xeb\xef\xf96pV\xec\xa8*\x9b\xc0\xe6\x82^h\xadUcm}e\x02\xbb'Ul7\xa8x Gr\xba\xee\xbae\xdajS\xdb-\xf6\xd6seM=*\"\xe1$\xec#\xde\xdf\x1b\x03\xaa\xb0\xd8\xee\xfd\xc6\xc9\x03\xa0\x9eu\xdb\xb7\xe9\x8d\x9a\x0d \xe6\xcdtu'\xfb%X\x12O\xect\xbc\xab\xc9T\x13tHf\xaf\xdc8\xeb*\xae\xe1\x08\x1a\xe7 $:\x1d\xbc>\xc3\x06\xa2/`\x9c\x93\xe7\xb7?\xa2\xe0tW\xd9\x9aa2\xea(i/n\xa6u\xa17\xec\xefT\xd8\x94\xc3\x081\xcay\xdaJ\xbcoQ\x96\x95\xc8\xf2R_\xbc>\xa8\x81Q\xac\x9d\xda\xc67\xe0\x8f\xa9\xee'j\x95{\xec\xbb9\x9d\xf5\xba	o\xb5\xc9\xbd\xe2u\xec}\x82\xf2\x80\x14\x9eK\x05\xb6VM\xc3nwc\xeb\xd1F}\x884\x12b\xc2\xee\x10F\xc1\x128G\x9f\xe5\x12\xca=^\xfb\x82#P\xccd\xa1\x82k\xa8\xd6\x8e\xab`]~\xca_\xe4r\xc9\x0c\x07'\xcd\xe75\xfc\x8a\xa6\x8e\xa5\xa7_J\xb9VA-\x95\x87\xaaJ\xe8X\xf4\xc4q\xef\xdd\xb1\xdf(\xc7\xc7\xd2\xba\\\x9e\xb5%2\xff\x10\x8b\xa8\xca\x85\xcf\x80\xc0\xe4;\xccK\xf8^\x8a\xef\xe8$\xf5=oX\xb9\xe4`\xdc\xbf9\x1b]\xf3\x996\x86\xeb\xb2;\xd3Er\xe6E\x81\x8f|\xa4\"{\x1b}\xf4\xa6n\xa01\x96\xcc\xe6\xd0\x19\x04\xd6\x13o\xad\xf4\x07\x05Bw\xac\x9a\xe6\xb8d, \xc7\xe3\xa5\xbf\xe6\x1a\xe8jp\xd2\x02$\x88[v\xc05\xd2\x99Z\x0eJzM\xc7\xbc\xe7\xaaw^?q3ze\x06\x9a\xcc\x1d{\xa1\xcd\xfa\xc5e%/1\x1e\x02\xf0\xb9A\x0d\x96[G.sjv\xd3a\xc5\xa78\xea\x06Yl\xe1J\x1c\xae%\\\x95\xa4\xe8\xc7\xc5\xc6n\x7f\xa2e\x1f\x825\xd0D]\xa3\xaa\xe4\x9a:\xf4?n\xac\xd3\x7f\x81zmp \xbax\x0f}?\xd4P\xae\x8aC}\xfb\xabj\xa3\x0c\x19\xeb\x83j\xd6Z\x8d\xcc\xf4x\xd6\xd7v\xadM\xeaP4\xc0-\xf9\xde\x02\x12\xe4\x86b\x8dIh$\xc6\xd8\x8fH4;\xa2\xa5\x1bu\x8e\xe1O\x17\xcd\xb2A\xfb=\xbe\xd7p\xa3\xec\xf1\xfd\xb2\xf6L\xb6Z\xdc3\xf1\xb1\x05\x05	\xa906v-.\x914\xbe\xb76||b\xe7t\xa1)\xe2P\xce\xda@6\xd7\xa1\xcb\xab\xd9\xd5\n\xb8\x81\xb6\x00\xbb\xc1\xd2\xa6\x00\x08\"r\x8a<w\n\xbe+jl% <z\xdb\x14\x9d_\xdc\x1e\x11Co\x8b:X\xf5M\x13\x87Pu\xed\xd8{\xda\xb0\xe3\x03\xcb\x8a\xc7u\xe9\x86I\x8d\xe7\xf4>\xba\x0b\xde\xd1\x9b\xec@Hp\xa7\xe5u_)`\xa97{k\x9d\xfa\xc1\xe2\"\xff\x11\x1f\x18.\x02T\xa22q\xad\xffN\xa7\x97n'\xd0\xe9\xaa-\xa0\xd3U;\x81N\xb2\xc1S\xf0\xa4\x0dL\xc7e!%\xfb\x12\x97\xe0\xe0 \x18>^6\xbc\xfe\xc5]\x88\xb5\x89\x91s\x0c_\x94\xbc\x7f\\\x9d\xc20\x9e\x03\x16^l\xe3\xcf\xf5\x08\x88\xaf~wq\x96\xc4\x02\xf4/\xa0\xd7?+\xf1\xb6\x8fW\xfe7\x99pT\x16\x87\xc7\xd7\xd5\x85\x9fSn3\xa2\xa6\xa87e\xca\xe5\xab\xa3)\x9d\x1e\xd9\xbb\x918\x9fo\x94Y\xe3\xc1\x19\xd8\xad\xa05\x06g\xc8\xb0\xa6F\x9b\xc7qf\xef\x9f\x03;\xa3\x1a\xd8\xe4\xc7\x8c\xb1N\xeb\xfa\x88\x1c\xb7\xf6\x89\x01\x01\xb9\xd6A \xa24\xfc\xf5\xaf\x7f}\xfc\x1d\x14\xc8\xf1\xdb\xb0\xeb\xe0W\x16Ol\xc5\x85\xedh\x95\xaf\xecxH7\xb1\x98\xb0A\xf4\"\xc5&\x19\xcc\xeew\x15a\x1d\xfa\xa7\x9eB\xcc\x97D\xc1\xccd6e\xf7\xd0\x17\xce\xdf\x1b)\x8ai\xe6\xc1q\x13\xa3\x93\xa3\xbf\x16?\x86\xa7<T\xa96\x12\x1b,zBe\xad\xb4\xf3\x81\x9e\xb4\xd7\x93\xf1\x9e\xbf\x97\x8b3\x0c\x19g\xb9\x11\xe2\x8b\xea+U\xd9\x15\xf5]r\xd4\xed\x83\x8fV=\xdf]\\.\xf4_\xca\x95<\xcb\x93\x06R\xbb\x87&A\x02\xbb\xc1\xc1\x01\x85xs\x96\xb0\xa2xhU\x07\xef\x15\x16\x1a!\xb8\xb4\x8b\x1d\xf7\xd5d\xab\x9e\xef\xc5\xc4\x8dB\x82\xf2n\xb2\x96\xdb)\x80=\"%\x1ab\xbb\xd1\xd5&ibO\x1e\xce\xc7%\xe3r-\xfb\xb6\xe3A9\x97\n\xce\xae\xe8\xeb\x93\x93\x93\xe9\x04&+O\x8ak\x98\xebgV\xbf\xb7\x99q\x0f&\\%\xb4v\xcdf]\x80\xba\x1b\xf5\x9c|\\M\xac\x982\x8f\xc4\xc9YIk_\xbc\x10\xf2\xaaz#;4y)\xb6\xea\xf9\x93\xae\x8b\xf1\xe2b\xb6\xa0\xbd(\x1c\xb1\xe6\x8b\xb2\xc1\xeb\xfe\xe1\xf7\xe3\x02b1y~\x80 b\x98%\xa2\xa6\x1c#\xac\x8ai\xc3\xc4\xa1\x1d,si\x83#\xe3\xc5\xc6\xbaP\xf5\xe1?2@p\xba\xf2\x0f\xf6\xb1\x80\xa47\x91HA\xa8)H\xcf\xca\xb1\x8b$q\xa5\xe7HP\xf4\x9c\xd1\x9d\xb3-\x87\x0d\xf7\x9e\x12O\xc4\x86\x8e\xd3\xef9\xbd\xcf\xef\xd4\xf4N\x12\x81cSwV\x9b\x12\x11\x8c\xc1\xe8q:#\xe9hJ\x98j\xeb\xb1\x9d\xe8\xebRU\x1f\xe19\x9e=\x9bbkj\xedaWz\xa0\x88L\x8f\x8em\xed\xa85\x8c\xe8l%V`\x9c\x9b)c\xa5\xb7{QR`\xcf\x10\x10:\x0bv\x8c\xc3\x16\x9dy;\xf8\x93\xf2\xca\xc4\xc1\x91\x89\xfb\xb8\x0e\x1d\xe0\xcb+\x1b\x17\xce\xbd\x8fp\xeb\xe5q\x8aa\xecE\xdf5\xbaR\x81\x93Of\xe8o\xa9\xceUY\x82'\xce\xd8HK\x90Z\x9e\n\xaa\x81\xd6\xd8\xc5'\x83\xecR\x9d]\x1c\x1e\x91\xe1\xa8U\xca\xc1\x83\xae\x8a-\x8a\xc58\x88\x08q\x99+\xa2\x9a\x1c\x16\xcb\x16\x07/\x8908PR\x88\xa4\x9bXJc\x83^a	\xbaxU_\xb0\x7f\x84^\xbb-*\xb3\xac|\xe0@u\xaa/;\xfb\x18\xd7\xf8\xb9\xb7X\x14D\x89\xfc\xceT[\x15\xaa\x0d\x02\x1dj	g;B\x02A\xb9\xc9\xfenq\xa3KP\x7fk\xb7\x94ht&\x893i\xec\xf3\xde\x01\xe04\xf1\xdaA\xe7zk\xd6\xb0[\xeeX\x1d\xc3\xb5/\x96\x9cc\xec\xd7\x86\x0d\x02\xa2\xe2\xd6\x9f\xe4\xd4\x1c8\x95\n\x91\xb4M} U\x1f\x9bz\xf0O\xa6\x99\x14\x1d\x12\x98>\x7f!\xa0\x9cA8\xa5Jz\x18#\xcb7\xaa\xeb\xb0\x02\xbb\x1a\xde~\x16\xcf?\xbfg\xfcs\x98\x19\xab\xc4\xac\x93\xb2\xe3\xfa\xc0\xa7n\xb7\x86\x8b[\xfe1\x16\x93p\xa30\xbd\xdd\xe2w\x87\xb3\x10\x9eh\x91N\x81\xefI\xfd\xe0\xf65M\xf6\xbd[\x13\x9cm\xfc\x9cnz\x1f\xb2\x13`\x8cU[S\xbai\x86\xad\xca\xdbp\xf7\xb9\xbd\xc3\x0d8\xeb]q'q\x1fi)\xa4\xfd\x00\x14\x1a\x0fQ\x801\xf8\x98\xbdl\xda\xd0\xcf\xbd\xae\x1e\x11\x9e\xa8R\xe08\xe5l`\xdb\xe2\xf3\x05\xb6Kp\x82\xdewL|].\xc0\xfapnmS\xdb\xedx'dfU\xa6&\xbd\xa9\x91/\xd1J\x1eI\xe9N[r\xd8\xe6\x1b\x9e\xb5`\xbc\xe0Ww\xf1\x1c\x87\x90\xc8\xe7|]2\x87\xb4\xd8\xe9\x1b\xe7n\xb2\x0f\xff\xc8[g\x08y\xa8A\xbf\xfd;\x9f;\x98\xea\xfbZ\x87O\xe2V\x9c\xceS`u\xf47\x8e{\xd6\x078\xbbd\xfc|\x9eGT\xf7\x0e\xd7!\xa2+E\x15B-\xc9\x15	\xe3\xb3d\xe1\x85\x80\x14\xc2g:\xf81d\xf0\xa5=\xbc\x92\xd7T|1\x0dS\x8bDQ\xfet\xad\xcd\xe3\xc1\x9b>5\xc0{!O\xa27uz\xe5&\x8f\xa7y\xc4QV\x8d\xae\x1e\xe7\xf4	\xba/Y\xe2\xfa\x88\x8cz\xd2k\x04L!Za3\xe5\x94r\xc9\xf6v\xf0^\x85=\xe7\x80Lo\x94\xdb=\x10\xf5\x99\xeb\x00\xd7c+[\x1c\xdf\x96pK^\xdd}q\x8b\xd8]]\x8cj\x03\xa3\xb2\x83\xa1N\x03\xc2S\x83\xcc\x10voS\xacw\xc8\xb1#]\xb3\x81\x89`G\x06\xee\xd0`c\xb2\x0b&W\xa2\"\xc9\xa6\xdb\x0b\xf4\xdd\x81\x96t_\x1a*\xba\xdd\x06}8x\xd8\xe0\x0b\xdb\xa8Zb}Y\x82\xa3\xd3\xfaV\xd2\xb5\xf6\x06\xda\xcf\xf4\x8b#%\xc4>\x1d*\xc3\xf8\x97\xc7\xc22\x04\xed\x1d\x0c\xb3\xeb\x87\x0d[4}H\x81\xdcl\xa7.\xac\xc4]\xfc\xe381\xb7\x84\xbfx\xe8t\xcf\xf5\xf1Y\xd33=\x8c9\xa3\xd9\x91\x04\x1c\x08D\xb5D=rJ\xe1\x0e\x8e\xd6k\x90\xff7\x7fro`\xec\xde\xfciYxD\x00.\xa6\xfa\xf4\x1e\xe7\xf5\xb2>\xbd\x7f\xbfxH\xa9%\xaa\xfe\x8f\xeaSL\x0b^\xe9\xe9\xac\xf0f\xcb\xfb\x95 \xecD\xa6\xa7\xfd\x0f\xaf\x870\xf9\xec\xf5\xf8\xcc\xea\xfe\xc9\xeb\x11\xf3\xc9\nG\xe0}&\xec\x9b2\xc7\x8d\n\xc82\xd5\x05\xa4\xbdOD\x02\xd5\x07\xd5vY\xfc\x86\x1aYr\x18\xaa\xe1\x03\x9b\xbf\xa37_\xd3\x06\xa1<\xb5\xb6\x93\xe3\xedX\x95\x1b \xc5\xc3\x99@\x8a\xcez$\x8fM\xde\x98x\xe1\xaa\x1d-\xa5\xa6|c\x8f\x0f]\xc7Uv \xc5\xbd\x92\xab(\xee`A\x93HT\x93,\x15\x8f\xa6\xd6\x18\xaeB\xf2+\x8d\xce\xc9\xf3\xd4B\xeeT\xab\xbdG\xf2&\xecp\x0b\xf0\xe0\x18oLQ\x8a\xa9\xc3d\x81\xcd\xee~\xe2i\xf8\x11\xb3\xde\xfd\x04\xac\x11+\xd21\xc3m#U\xd9\x87\x9c\x14\xadC#\x12\x8f\xd8\x8bx\xd7Y\x1b~\xb8\xbf\x1e7\x11\x0eQ\x10\x8e&\xc5\xdcY |J\xd2\x1c\xd2\xf5\xe0{\xf5\xb6M\xa0?\xe7At\xce\xe2\xfd\x19S\x16\xb7\xd6=\x96\x0bC\xc6\xd28\xa6\x94\xca\\m\xa9OF\xac\x8c{\x82\x8et=\xd5d#:\xb0\xf4\xa5\xf3h1z\xc7R6\n\x9c\x11\xc5&\xe7\xed)\xde\x01\xb2\xaa\x1c\x00\x1b\x0cq1i\xaf\x9aQ\xd8\x16p\xfc\x03\xc44Y]-T\x13\xe2V\xc4\xaa\x1c_\x13\xdd5<\xf8R\xda#8\xd3=#\xb9\x81\x11h\xc6|\x96<\"\xc8\xef\xbe\x94a\xeaU_\xb1Qc\x86\xcf\x02\x84[\x95\x93{r=\xd2\xcc&gR\xac%\"\xc2\xbdd\xe0\xd3\x84\x87SmJ\x0bN\\\x1f\x12\xa8\x83\x81\xa1\xde\x04\xdd\x00\x96\x0f :E\xcfc\x9e\x8c\xae$\xe2\xdbX\x89\xde\xd8\xbex\xdf\xf8\xce\xea\xa6\xc4\xe5\xf2\x8a\xa4E&'\x0d(\x16#\xb5\xcd\x8f\xc9\x91GP\xab\xd54\xb9w\x01\x12=\x14\xc9\xbd\xd9|H\x0e\x80\xf4\x88\xb9\xbf\xf9\xb9#\x9af\xc3\xaa.\x0d\xb3\xef\x97\x86_\xc0\x1e\x0b\xa1\x17\xeau\x0c\x18\x9d\x0en\xb6\xb1Vdw\xbb\xb1\x0d\xd3\xf17\xdf\xd1\xd5\xdd\xd3w\"\x06\xc7\xbf\x94\xc2/\x91Ba8L\xf2\xe8\xb5\xa9\xf5\x93\xae{\xd5\xd0\xd5]a[C\x19\x1d/\x1eL\x0f\xb6\x13\x1f\xca \xba\x1aI\xbf\x1e&1b\xb3\xb4\xceX9\x840\xe7t=\xe4Q\xa7\x0c\xea\xa3\x14\x9bN\x8e\x0b\xe57\x88\x0f\xc7\x1c\x9bo&\x82\x0803\xa7\xd3@-6\xefW\x85L\xc2.Ol\x1a\x0c\xf9K6M\x9c\x90\xd1\xd1U.\x12\xd9\x81\xc3a>\x14y\x83\x0b\xc9`\x94<\xc7\xbd\x1c\xc6\xa8.\xa3\x08\xdf\xa8\xe7\xa1\xb7\xf8\xf1T\x93\xd4\xe9\xbf\x94\xdb\x9eX\xbc\x94\xdc>\x19]\x8f\xef\"y\x03\xfd?\x8d>\xc9\xd6\x8e(\xafL\xd6>\xc5S0M\xc4'\x0d\x93\x02\xaa\xd6\x0c\x1an\x7fC\xfd\x1d\xbb\xbbR\x99\xe6/:\x80\x15:\xb5>\xb4\x92y\x84\xd1\xcbb\x8d\x88J\x16L\xa4\xc2\xed\xf9Y\xc2\xe4\xf6\x1e\xa6\xe6GoN\xcd\xber\xba\x13\xcc\x10\xcad\xfd\xbd@o\x90\xf8B\xd2!/k\x96i$b\xd0,\xf1\x8e\xbc\x90\xc2\x16Et\x8a\x1e\x7f\x10\xdag\xf0c!\xd8\xffQ\xf4\x18'v\xa8\xa2\xd2\xe4F%\xb4\x87\x00_\x9c\xdf?\x89\xff\xa0\xf0\xcf>\xecg\x0b\x8f\x1f\xacL79e\x0b\xe3\xe0\x8b\xcf_\x8a\x95\x08\xb7\x7f8\xec\xf3\x12\x0bq\xa7%\x01\xfdh\x04\x00\x0d\xac>\xa1n\x10N8\xc5\xa4:\xb9\x05&\x86J\xd5\xc3\xa7\x11\x00+\xe8\xc8\xf5a\xd7qX^\xb6\xd3\x88\xf8'^\xde\xfc\x9d\x80\xb849\x88\x87\x0f8\xca\xd3\x8bQ\xf5\x9c\x13[\xe8\xda\xedF\x85SS\x02\xebO\x03\x05\x0db\x0b\x85\x16\xf3\xb5\"\xf9\x81o\xcf\x98\x8d\xdf\xd8p\x00\x06v\xc8u\x1b\xf5\xe7\x1fb\xf1\xa8(|\x06E\x00\xd5M\xd2o\xf0\xd1\x1d\xf0Y\xd6uH{\xf0\xc9|g\xaf8V\xe3#tx\xf1D\x13\xcf\xbd\xc4\xf4\x7f\x8d\xf3\xebW\x7f;z\xfdj\x96\xa2\xcc\xf9\xebE\xe4\x9c\xcf\xdeQ\xfc/\x83\xc7M\x02m\x85\x8f;l\xda\xb5X\xb9MA\x9d\\\x17\xcb\x89\xb5\xb7.`\xda\xd9T\xcf\xce\x04p\xe6#\x99]\xab\x94,^|e5\xcb\x0e\xad\x82t\xbf\x97r\x1e\x93\xf8\x04\x96\xcd^\xcb\xbd\x99\x055$\x96\xcc>\x08\x9c\x8c\xc9\x83\xb3E\xd8%\xf4\x93U_\x8cQ\x0b\xe5\xb2O\xcb\xc8\xc1\x1e?\xf0\xe3\xb6\x93l\xf6\xbc/Ke&\x06\xe0\x0f\xb6/2{$\xef\xb0\x8e\xde\x94\xe3\xaf\xfc1\xfcy_\x15)s\x16V\x07\x8f \xc7\xca[\xf3.\x89\xc2\x1f\x00\xc3\x10$\x91\xdcn1\xca\x8cK\xf9\x95\xc7\xbb]\x1b\xfa\xca\xcfS\xd3\xd32>\x8fn1!\xf5(B\xb9\xab;\xd8\xe3\xafRt<m\x7f\xaf\xf3\xe4\xe3'\xa9\xe5\xd7\xa8\xc2u\x96`*\xea2\xcc\x85@\x8a!\xa1V\x19\xb5f\xf9\xc036\xae\xe1\x1cCL<\x95\x81\xf1\x84\xd2e\x152S\xde\xeb\xb5\x11\xdc)5R\x8c@36X*\x91\xa43\x95f \x1b\xe7\x16\x1dW>\xcd\x82\xbb\xe0G\x9c\xf5\x9b\xbb\xf7\x1f\x8e\xe8\xee\xf6\xc3\x91|x\x88oX\xdf\x9f\xdd\x14\xd9\xf9\xd4&U^\xeb6\xa5n\x028~{r\xf2\xfc\xf5\xc9\xc9\xd1P=\xc6yc\xa0\x95~{&k5\xf0Z\xf6\xa6\x9e\xa7\xf9\xc8\x8a\xce\xd2r\xd2WX %\xef|\xa2KvA\x11\x03\xc8\xf9\x06\xd9\xdd\x98\x9a5\xd8\x8f\n\xdd\xf3\xcfDg\x05\x8b\x1c\xd3\xce\x12\xcd\x9a\x95^\xf7.z\xc0\xa42S\x92a\x9f6[\xc8\xf1O\xdbE\x91H\x0dq\x8d\nfR,9!\xf9\x0e5\x17\xf8\x9f>\xfbh\xb8\xe8R:\xda\xca\x06\xd96\xe5\x06\xa2\xc9\xca\x06)\x80\x96\xeb\xbb\x1c>\x9b\xd5\xbd\xcb1\xa4\xd9E\xfe\x9d\x13\x89\xa1\xed1\x1fA\x8f\xe9\xe9;$	\xa3\"\xa7\x06\xa7\x9a\xbez\xfc\x18\xa5\xec\xf2\x87\xf3\xdf\xd2\xc7\xcb\xcbX\x11\xe3\xfa\xe8\xf0!\xfe\x1a\x13\xbafW\x17\xb9\x04\x97d\x90\xb3\xbe\xca\xbfc\x8d|(\xb5\xd0\xadn\xe2\x11\xc5/\xa7\xc8GJ2\x88iv\xbaix-\xd9\xb4\xb3\xab\xf8s\x92`1\x030\xcar\x83\x83jt\xf1\xe1M\x96\xad\xa1\xa56\xeb\x83{\xa0\x0e\xa4\xfe\xef	\xfd\xb7\xff\x07\xa1o\xec\xdaJ\xa0\x1b\xc9n\xf8\x95\xe6\x84\xdf1\xf5\x1c\x15x\xb2\x02\xbbQ\xcdO\xba\xca'T\x86\xf7\xd0\xb0\x0c\x07F>\xa3\x81\x99}L?#}kn\xed\xe0\xef\x85.\xa4Z^\xb8\xc8M\x87g\xb5p\xfb\x8a\x0f\x1a\xad\x86X\xec\xac\xeb\xddz\x10\xb5;\x14D\xd2\x8e\x0bq\x8c6\xfd\xc1\xda&\xe8\x0e\xbd/u\x13x8D(i\xdf/\xf1\xd1\xcfQ\xfa\x08\xd5%\xdd\x87\xa03\xb1\xc1\x87\xb5\x125\x82Q\xf6\x8d<\x02\xe7\x94\x0f\xe7\x9e\xd7=d\x82\x9f\xf3W\x8aI[\xcf<\x87\x18\x93\x94U/8\xa4\xc4\xf4\xb4\x18\xcf\xe1:\x1ex\xaeN\xe7/\xb0\xa4\xb8\x16~\xa3\x10\x14yG\xf9WA=\x93\xf7\xd5l!\x05Z\xa6\x1c\xc5Y\x12\xd4\x04\xff\xd05\xfd\x1cEX>\x86v~\x084\xf3\xb3\xf6aN\x0f6:xX\xaa\xd3&\xe1 \xb6jwDk+\x9b\x90S\xc6\xfae\xfe\xd2\x13&%\n\xe5\x1f?sY\xfe8\xa3j\xc3\xd5#g%\x9bq\x01f\xb7@\xa6\xdbx \xa9At|\x0c\x9a&=bJU\x93\x0eNX\xa4\x9f\xb1\xeb\xceTI+\x8a\xe3\xd1\x8a\xf9	}\xde\xfa\x9d\xa9$\x9b\x1em~0\xfa\xe7^R\x9d\xd0\x14\x1e\xf5\n\xaf\xaac\xf9F\x066\xb5\x80\x1c\xc9\xe30:\x1b\xe4u\x0f.\x83w~&\x88\x05\x94\x01\xb9 1L(\xf8/\xbdz\x93L\xe0\x0ff\xa9\xcc\xec\xf5\xab\xbf\xbd\xfe\xdb\xeb\xff\x1d\x00PK\x07\x08:/k\xd3+\x17\x00\x00DB\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x004<N]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/es_ES/common.jsonUT\x05\x00\x01T0\xcfj\x8cV\xd1n\xdb\xbc\x0e\xbeN\x9e\x820Pl\x03\x8a\xee\xbewI\x93\xae\xd9i\x9a \xc9V\x0cg\xe7B\xb1\x18G\xab,\xfaHr\xb3`\xd8\x8b\x9c\xd78\x8f\xb0\x17\xfb!\x89\xb2\xdd\x0e\xc3\xff_\x99\xfc\xf8Q&ER\xd2\x8f\xf1\xa88\x90\xad\x85/\xae\xe1\xc7x4*\xf6\xc2\x18\x94\xc55\x14\xd3\xc9\xc3\xc3|\x06\xd3/\xf0\xe6\xc2\xbd\x81\xdb\xd5\x06.\\\xfc|-.\xdc\xd7\xa2\xb8\x0c|\x89\x1a}r\x98\xcd\xef\xe7\xbb\xde#\xd9U-*\x9c\xf5\xa4\xc5r\xf2a\x0e\x7f\xa6n\x1bR\x1a\xed\x90\xbc]\xaf\x16\xf7\xf3\xcdk\xba\xc1\xd3\x9a\x9cw\x0b\xb3;Z\x14\xd1\xe1B\x82\xc1\x134\x01\x07e\xc0G\xcbUZ?\xa2\x13#\x17!$\xb7\xaa\x95\xe7\xc0/d\xf2x\xeb\xde\x810\x12.$\xc4X\x82NL\xebW\xf8\x83\xe3Kbk+\x94!\xbc\xb0\xfez\xb5\xdd\xc1\xfa\xd3\xe6C\x9f\xc2o\xfb\xe8\x8eB\xd2i\xaa\xf2\xeeo\xef&\xb3\xd5#L\x17\xff\xa4\x08)\xcd{*\x9fvTU:e\xb5\xbb\xdb\xcc'\xb3P\xb3\x17\xbb\xdc\x9a\xbe\xc4\x9f\x1e^\x169\xad\xf6\xac\xf0\x84r+j\\\xacs\xf8[X\xdd\xc2\xeen\x0e\xdb\xc9r\x0e\x8b5<\xce7s\xf8\xbc\x98?\xf6\xd1\x15\xe3\xd1\xcfKn(\x17\xfa)j\x8dn\xad\xd0.\xf7\x97\x14\xe7\xe2\x1a\xfe=\x1e\xb1|\x99%W\x8cG\xa3\xff\x04\xb58Rk;RT\x02\x9c\xf0\x01\xadV\xa6\xf5\xd8\x11Y\x0d\xa6l\x1b\x92\xc9\xf8c\xcf\x8d\x1aS\x83<`:,\xc9\xc8\x8e\xcaj0e\xdb\x80|F\xd1\x87\x1a\x95\xcbNL4\xde\x94\xd8`y\x13\x84\xac\x95	[;\x89Bp)DE\x01	\x9f\xa4\xc7\x18\xe2\x87uJ.\xe6\xd7\xff\x8d\xaa\x99U\x92\xf1V\x94>\xf5t\xb0\xdf0\x02\x0c\x0d\xa6tz\xee\x8b\x9aF\x12\x84\xd6\xb0?\xc3b\x9dx\xf8\xbd\xe1\xffN\xeaF+a\x87p\xff\x8fy\xa4\xbd\xf8\xc3Q\xc9P\x8a\xe2.|\xa3S\xca0\xa7\xf7M\x18\xe5\xc9\xc6\x10\x97\xa8$\xc7\xff\xadu\xfe\x81N\x01\x15G\xb2\x02j\xe5rn\x9a\xca\xa7\xd4\xca,E\xb4&\x89VtKe-\x19\xf3\x10^C'&\xfcd0\xfd{J\xc2JX\x05\x9d\x07\x0fq\xa2u\xf0X\x92\xf3VX\xf0$\x89\xf7\xcd\x1d\xe9401\x98\x8e\xa8\xe0\xc2\xa7U\xc2}\x9c\xbd\xadW\xe5S\xe8\xf1\"\xcd\"\xb8\x04\xf0\xf0\xf5Iur7t\xc3\xfa\x84Jue9S\x1b<\xde\xee\xdawy\xc8\xdc\xd9\x94\xdcx\x85T\xae$c\xb0\xec\xf2eU\x99\x8aC>\x9b\xf2\x85\x92\x89\x12Y\x1b\xc7\xd9+\xbc\xaa1wi)4\x1a9\xe8\xef\xb9\xc9\xe3u\x8b{\x96\x96]\xcbO\xf6YZvs\xfd\xb15\x9d\xa4Y\x9ap\x87\x8f\x8a-6,\xadJ\xcf\xd2\x03=\xb34Se?g'\xc4\xa7.\x8e\x19\xd5\xcc\xb9o\xcdoq,U\x8e\xf2c\x9b\xa5\xcf\x1d\xb6\x15\xfb\xe1X\xb6*g\xbb'\xef\xa9\x0e\xdb<\xd9\x8bo\xdc\x82\xa50%\xc6\xe6\xb8\x89\x12\xff\xa4(\x85\x17\x9a\xaadHb\xc2\xb5\ng\xf0M\xef\x16\x00\xf0\x04\xbc\xd2p\x1aC'\x0eFq\x1f\xf5D \x13\xaaP,\xea\x86\xac\x07\xd7\x96%:wh\xb5\xbe\x82\xdd\x11\xa1\x11\x15\xc2Ii\x0d\x86N`QSw\xc9\x1d\x94\xc6\x1d\xd1\xbd\xb0U\\\"\xe8\xe0\x89@G$.\x7fPF\xb9#\xdf\x12hkeD\x9e\xc7\x8a\xa8\xd2\xb8%\x93r\x0b\xdd\n\x8d\xb0\x02\x92AX\xd0\"\xe6\xa2\x88\x07[\xc5\x18o\xc8\xda\xb6\xf1\x83\xa0\x0fBi\x94Wp\x1b\x02(\xd9<\xf0\x98\xfd)\xc7\xbfMQ\x99g\xa1\x95\xbc\x11\x8d/\x8f\"\xae\x91\x10(\x19\x8a\xbfyBl\xe2)\xa7LuK\xa1\x8b\x8b\x7f!6 \x19\x83C>5\xb4p1\xf2_\xff\xd3^\xd5y\xf6C\xee\xfd\xcb\x82\x879\xdd\xb4\x10l=\x0b\xe5\x8e\xa6]\xfb\xac\xb1\x12\x92@h8\x90\xc9\xfbZ\x87#\xef\xb3r\x8aL~\x15\xc4S\x10\x9e#\xc6\xa4\xd6\xf9\xa5\xf0e\xb8\xa7\x8a\xb5p\xeeDV:\x080\xd4\x11\xcf\x0f\x9f>\xae\x87\x16\x9f	\xee\x94\xe6\x1f5\xa4\x8c\xdf\xd1M\xdf\xa0\xeb\x80\x84\x16\xccX\xff\x94\xe1\x03<\x04\x1d\xd4\xf7I\x7f\xbf\xdb\xdd'\xd2\x7f[\xe2'\xd2\x9dp\xe0\x94$(\x95\xef\x9a\xc5\xa2p\xe9R\xda$):Y\x94\xcab\xe9\xa7\xe7t\x92mX\x7f}\xd7\xf4\xbc>\x9b\xd7\xdc\xb4\xddy\xdd\x83E\x17\xf7f\xc3\"\xe3\x8d\x8e\xe7\xed\x06]\xd3\xa2\xf3\\\x7f\x8b\xa1\xb1\x92!Hyv-\xfa\xd6r\xd4\x95E\xd7\xe1\xad\xc6xEl\xa2\xc0\x97\x83\xb0\xa9\x1c\xd3\xd6\x95\x99\xe9\xd0\x85\xa2\xcd\xbf7\x8a\x1f\xaa\xf7T)\x03\x8c\x03\xb2\xa1\xbbB\x1e\xc8\xab2\x8e#K\xc9\xd2\xeek\x15\x03\xdc&\xe9\xd5[\x0e\xe5\xcc\x8aC$\xec\xfa\xb6\x0b3\xf5\x85Z\x0b2\x18\xe1(\x1c\xec\x11\x0d<a\xe3y@\xfc\xb1\xad\xf7F(\xad\xd2\x18\xef\x06\xfa\xd5U&Q\x13V\x9eX\xab\xf6\xbcc\xfe\xdc\xb0\x07K|g\xe5\xf3\"w\xee\x17j\xe1(\x9e\x11\x84\x81\xde\x1a\xdf\xd0\xd9\xe5\x14\xda\xb5\xaf\xeb\xa7\x04\xbc\xa8g\xdb\x84c+\x1c\x0f\x91\x115\x08\xc7\x15\xaf\x11\x81\xb5\xa5P\xa3X\x97\x84\xa0\xecR\x08\x0fU\x0e8\x8b\xd1\xf5\xd5\xcf\x1f\x87\xbf\x1e\x8f~\x8e\x7f\xfe5\x00PK\x07\x08=\xf3\xe5\xb57\x05\x00\x00\xf7\x0c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x0b>N]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/es_ES/server.jsonUT\x05\x00\x01\xc63\xcfj\xbc{\xcdn$\xb9\xf1\xe7\xb9\xfb)\x02\xb5\x98m`\xa0.\xf5|\xc0\xf0\xf6\xc1\x80\xd4\x92z\xf4\xb7\xa4\x96%\x8d\x1b\xc6x\x0c\xb02\xa3\xaa8\xca$sH\xa6J5\x86\x1f\xc6\xc7=.|\xdbk\xbf\xd8\xe2\x17$3\x99U\xd2x\xec\xf5\xfc/Re\x90\x0c\x06\xc9`|\xf3\xaf/_\xcc\x96\xd6\xb5~\xf6\x96\xfe\xfa\xf2\xc5\x8b\xd9\xd9\xd1\x1ffo\xe9\xbb\x97/^\xbc\x98\x9d\x1b4\xa9\xa0\xad\xa1N\x19n(\xf0c\x98\x1dH\xe3\xa9	N\xb3\xa7\xa5u\x14\xd6L\x0be\x0c\xbbW\x9e\xce\x1c\xff\xd8\xb3	\xcd\x96\x8e\xfc=\xd7\xf4\x87\x9e=pxj\xb4\x0f\xa4LM\xba\xc0\xdc\xdaZ5\xb3\x97/^|\x0f\xc4\xb3\xab\xdb\xb3\x8f\x03\x05W6\xd0\xadZ2\x9dYG\x1f\xad\xbbO\x93\x1f[\xe5jRMc7\x9eZ\x15\xd8i\xd5\x1cPX\xab@\xca1\x19\x1b\xc8c\\\xb0\xb4`z\xd0\xbca\xccJ\x8a6\xd6\xdd\x13\x9b\x07\xed\xaci\xd9\x84qf\xd5l\xd4\xd6_\xd8\xea~\x98\xffVs\xdb9\xa6Ec\x7f\xecY9R\xd4(\xea\x94\x0b\x8c5\xb0\xd3\xd6e\x92b\x17\xf2\x95\xb3M\xa3\xcd\xea\xa9\xbeTsD\xb0\xd2F\x916U\xd3{KU\xafLm\xa5\x81}P\x9f\xfe\x8f\"\xfc'\xf6\x955\xb5\xaeUA\xa3\xb1f\xdbj\xcf\x03\x89G\xc6\x1a\xdd\xea\x9fT&\xe4\x12\xdb\xed\x14\x05[[O\x8d\xf5\xd4Y\x1f\xd8y\xaalkI\x99O\xff0\xba\xb5\x05NW\xad\xf5\x03\xdf\xad\x1d\xab\xda\x8f\x88#\x98B\x82\x1fL\xa0\x9d\xeb\x0d\xd7\xb9\x91\xb4\xf1\x81UMvI57\x1c\xb0\xfe\xb0\xe6vN	m\x04sM\x8b-N\x9c\x9d\n\xd6y9,\x1ft\xd3\xe4\xf6yAW_k\xfbG\xdb\xf4m\xb1Z\xc0\xe8!\x02#E\xb1\x07f\x96\x018\xe5\xb6\xf7\xba\x12F{\xd05[\xea\x1a\xb5e\xe7K\xd4\xc1\xae\xf4r@{\xb7\xee\xdb\x85Q\x1ad\xd0\xfb\xf33RF\xb7\xaa\xb6y\xd1\xf8\xa2\x90;\xf9\xd4k\xc4\xb7X]\xf6a\xa4\x12\x1ft\xac\xaa\xfb\x95\xb3\xbd\xa9\xe9\x8f\xa0\"\xe1\x92\xb6xa\x86v\xa1\xb2\xc4\x16\x07dt\xcf`:\xaa[m^\xfb\x8e+\xbd\xd4\\S\xcb-{\xeat\xc75\xf9\xe0\x94^\xad\x03i\x13,mm\xff\xca1\xb5\xd6\xe8`]1\x11\xae\xd1\xe9c\xa7\xddv\x9c\x0c0b\x01R\xd0\xc36_\xf5\xed\x82\x1d\xb6\xb9V[O\x1b\x1d\xd6\xb6\x0fdx#\xfc\xe5i\xc1K\xeb\x98\x14	V\xd2\xc3\x91\xef\xccw\xa5\x8a\xf3\x8c\xb3\x195N\xa3\xe2Yb\x87\x80[\x90\xcd\xe9\x9d2TY\x13\x946\xd4w\xb8\xd6_\xbc\xa1\xc6n\xd8U\xca3]\xa8\xa0\x0d5\x1c\x84\xcf\xad##\xd4\xfa\x82\x9b\x04\xd1\x9d\x0e\xcd\xee\xe4A`\xe8\xf3b&\xed{\xd3\x1f\x90\xef\xab5)O\xaf\xc0	=\xfdO\xbaTfe_\xcd\xe9\xdbD\xcb\x1b\xaa\xd6\xca\xa9J\xe6o\xacYMf\xae\xcb\xed\xad\xb7y2~\x0c\xb4\xb0\xf56O\x88}\x1c\x87U\xaa\x0b\xd5Z\x0d#\xdf\xa5\xeft\xf8\xfe\x9ez\x8f\xd9\x82\xc5\xc5\xeep{HQ\x1a%b\xb9b'\x1b\x16\x94\xbf\x87\xf0\xbdgr\xbc\xd2\xe0\x0d\x91\xbc\xb8 \xf1\x02S\xe5X`{\xd3\xdf\xa9\x95\xdf%\x81\x02\x80i\x11j%$x\xdb\xbb\x8a\xe3\xb4\x89\x04o\x9b\x1e8\xfd\x9c>\x18\xc6 0\x85\x83\xack\x9b-Uk\xeb\xd9\xc8\x08V\xd5\x9aVl \x15\xb8\xcek\x98\xd3\x0d\xff\xd8k\x071c\x87\xd3W\x81\x1aV>\xd0WT\xeb\xe5\x92\x1d\x9b\x00\xdc\xe5Y\xe3,n+\xeb\xc6\xa3~\x97O\x87|\xa7Z\xf2\xd2\x98v\xd2\x04-\xc0\x0d\xcb\x8d\xb1K\x08(\xbd\xdcB\x86\xa9\xf1\\!X\x94\x9c\xd1\x9c\x8e\x96\x81\x1d\xf1c\xc5\\'QG\x8dnu\x90_8\x17\xda@\xa8\x19\x8e\xc4{\xdb<\x14\xa7S\x92j\xa1`\xbc\xd7\xd6\\\xf0\x037#\xc5c\x035\xd2\x82\xfe/f'\xbclT`*\x06\xc6v\x10\xbe\xe1\x85\xb7\xd5=\x07j\xd9{\xb5J\xfa\xb9j4\x9b\xe0\xc9\xf7]g\x9d\x08\xe7\x8e]\xea\xf2\xba\x8e\x18\xe7\xf4\xfa\x0b\x1c\x10VP\xf3R\xf5\x0dv\xfa\x81\x9b\x03zC\xb5\xf6j\xd1\xb0/\xa7=\xa0\xd7_b\xad\x9e\xaci\xb6\xf4M\xbf\\\xb6rMeK\xc0\\_\x803\xfe\x17\x05\xa7j&\xdfa3@\x8e\xd7?\xf1d\x0b\x9c\x7f\xe7\xb8f\x13\xb4j\nn\xfbpsKU\xd1\x90\xce\x0bZ\x9f*g\xbd\x7fm\x9d^iCG\xd7\xe7$V\x07\xe4\x10\xb6\x9bMM\x95\xb5\xf7\x9a\xfd\x9c\xceW\xc6:\xae\x0fH/\xe9s\xacP\x99h:pM\x11\xc1\x0e1\x1f\x04\xb8C\x88M@\xf4z1K]\x06<\xc1R\xab\xeeyJVI\xd2\xa1\xea\xf4\xe1(H\xd6!t\xfe\xed\xe1!?*\\\xddye\xdb9}\x9e-\x1a\xd54y\xbe\x92\xb4\xde\x07\xdb\xbe\xbb\xbd\x1d\x08K\xc4|\xeb\xd9\xe1\x1454\xf2\xbb\xdb[r=\x8e\xaa\xb1\xaa\xc6\x12\x0d\x05\xdbe\x19\xe3\xb9\xe1\n\x97,\xac\xb9\xe5'\x90\xdf\xd9\xd5\xaa\x90\x91\xefdR`M\x93\xc5v\x9c;PVc\xf3\x80*1OI\xe8I\xe2\xa78iB\x84\xdf\xd88\xd0	\xb3 \x8d;\xa0\xcd\x9a\x0d\x19k\x18\x87\xe59\xa0\x11\\\x19\xd9xo\xa2\x0beV{35\xca\xacz\xb5\xcaj\xe5\"}R\xa7\xaa\xfb'&}\x02\xa9\x0fW{X\x1dw\x90\\\xb67aO-\xe2V\xfa\xd8\x05v\xb1_\xdb\x8d\x91\xcd\x8f\x12\xb6\x93\xdb8.sNo@\x07\xba\xe1\xbc\x8bs\xae\xd9cW\x1d\xa8\x1f	\xf0\xfdm\x82\xa1\xd3\xa4\xd7\xdc\xba\x15->\xfd_\xffc\xcf\xb5\x82q\xa2\xdbO\x7f\x87<-L\x9et\x83\xc5\x80\x1a\xd1F`\xb4\x9c\x12\xe2\x1b\xfe\x81\xab\x90\x8c\xa9\xa5nxT\xf6*\xd9S>8V\xed\x1e\xee\xf7l\xcf\xaf\xf7pGh\x12^VL\xf3\xc6\xda{Q\xe38Tl\xa6x\x12v\x99-\xd59\x89]\x10\xad\x0cZ6\xd01l\x80\xad\x8erUv\xcd\xd8\xd8Tn]\\\xcf\xf5\xc9\xd9\x1e\x19\x80M\x16x}r\x16\x97\xb77\xfc\xc6.l\x18\xaf\xff\xb5\xe3\x07(\x99\xca\xa9M\x033\xf2`\x02V}\xb0pAj\x91\xbe:\xf0\xd0q\xbc\xee\x9ead\x13\x9b\x95\x06W\x9b\x9a\x1f\xa5}\xe9lK\xaa\xaa PE\x8dh\x9f,\x9e=\xa2p\xc9\xe3\xae\xec\x9d\x9e\xb1\xe6\xb5\x821\x98L\xafA\x99O\x08\xf5\xa4\xcc\x16\x93a\xc3I\xc11\x89\xf3\xe3\x14^\xc9\xf8WC\xb34D<f5\x1ab\xe5^YSH	\xcd\xceE\xe58;(!\xdc\x08\x90\xd4B\xb3\x0b\x85\x8d+\xbav\xa1\x9aQ\xe1\xfd\x8f\xdf\xe2\x93\x94\xf1\x9bq\x93/\xe01\xc2\xb4\x8f\xd0\xc1\xdbL\x9d\xd7\xca\xaf\xa1\x92Ze\x9e1\x12\xdf\x0cC\xa1\x90\xbe|3\xb5\xd4\x82\x0d\xaa\xbcz\xdc*\xdd\x9c:7\x10u\n\x00\x9d:g\x9d\x9f\n@\xe9\x8a\xcdai|\x02\xc9\xa5\xd2\xe3\xea\x04Q\xda\x1bA\x17\x11<1\xecZ\xf9\x82\xf7\x94\xf7\x1b\xeb\xea\xfd\x91\xd4\xa5\xa6\xa7PX\x17\x06\x1423yv\x0f\xec\x08\xea\xff	\\E\xeb\x13\xe8n\xfb\xc5\x93\xd8\x9eG\xe4\xfbEm[5\xd1\xac\xfc\xb8f\x9c\xcd\x88*\x03\"\x9a\xdc\xfe\x8b\xa4\x19?v\xca\xd4G\x05\xfb\x9c\nD;\xf8\xbd\xcaS\xa3|1.\x11*]x\xd2C\x89\x98\x9c\xd3G^\xb4\x07\"\x13\xb6ty\xfd\x15m)\xf4\xd49\xdbi\x1b9\xd8\xc0\xc65\xa4\x96\\\x05U[?\xa7\xab\x9e\x1f\x92w\xed)\xa8v\xa1?\xfdo#\x9d\"u\x1a\xbd\x8a\xdd|\xec&\xc7\"\x9f\x83\xd7\x9e?\xc9v\x95\xb6\x86=)\x82\xcc\xd0\x0f\xc5\xa5Y2\xd7\x0bU\xdd\xcb!\x0c+?K\xd0x\x04	\x1f\xc4\x05\xe5\xfe\xb1\x05\xea\xa6\xd6\x1e\xce0lY\\{\x18\x061pSL\xa2\x1b\xbe\xdbv<2\xe1\x99n\x98\x82\x80\n\x13\x0c\xc6\x1c\x1a\xf81\xb0\x81\x99Z\xc8\xbb\x1f\xba\x15\xbc\xb0\x0d/\xda9]\xb0z\xc0}\xe9\xc2\x16$\x88\x8d\x83\xbf\xd9\x1c\x851\x02\xe4I\xeaK\x94\xc8S\xa5\xa0=E\xdb\x82I\x0c\xad\x1a\x0b\x01\xb1\x1dm.\x19T\x10\xdeL|\x15\x889\xb7\x8d*\"\xd1}\x92V\x8f#cGU\xd9\x05\xaa\x1a\xf0\x82\xc9\x96\x8d\xb5\xf5\xb9	\xec\x1eT\xb1\xdd\x80\xc2\xaf\x8e\xe0$\x14t\xcb\xb4\xd1\xa6\xb6\x1b\xec\xadgDnFq%\x98\x04}t\x13v\xe6\xb8\x96y\xa7\x13\x14\x9dS\xe0@=\xea\xb6o\x93k\x9b\xb5%\xe8f:\xbf\x96\xfd\x12\x13t\xa3\xc3:\x1d\xefrBj\xb28\x92\x8e,7\xce\xba\x8ak\x04\x92F\x1a\x04D\x80m[\xdb\x17\x1b\x88\xb1\xb0\xfe\x9cx\xed\xfe\x80\x82\xd3]ekp\xac\xa9#\xa7=\xb9\x99\xd6\x85\xde\xb0\xbfVa]N#@Q\xc4i\x0eq\x8b\xf1-+\x91\xe5\xa5\xb1pZ\xa8\x81\x06\xad\x9d\xdaD\xd7\xf1\xbb\xd4\xf6=\xb5\xca\xdd\xf7\xdd\x9c\x8e{\xdd\x84\xd7\xda\xe4Q1\xc8\xd4\xfb\xe4\x01\xc0\xfe\xf0\\\x8a\xb9\x95j\x1av\xdbK[\x8f\n\xed}\x84\x91\x00\x93\xc9\x0ff\x14\xc3\x03G\xe33_B\x05\x88\x18)\x18g\x05\x93g\xb2P1\x82\xa8\xd6\x8e\xab`]\x8e\x00\x9c\xe4\xef\x12\x19\x0eN\xba\xcfkD3M\x1d\xbf\x1e~#\xdf\xb5\nj\xa1<DV2\xaa\xa1\xc5\xe8\xb0\xf7\xee\xd0\xaf\x95\xe3C\xe9].\xcf\xda\xd2\xa0\x7f\x1f?\xd1\x94?\xfe\xa9\xed\xb8\xd6u\xcd#s\x9c\xa6\xb8\xa4}Ko\x12\xa2\x0b\xddvZ\xb9\xb459pi\x8b=Y\xeb\x9a\x8f\xb51\\\x0fx\xbe\xd15\xd3I\x8a\x0b\xc6;\x80\xae\xa9\x01\x12\"\xa2\x93\xb0\xee\x1aBd\xc1l\xf6\xc3J@=	\x1b\xcbx@\xc0\x87\x87\xaai\x0eK\xc4b$Ab\xafV\\\xc3:\x1b\xa2\xc5\xb0.$><\xd8E2\x98Z\x0eJFM\xe7\xbc\xe1\xaaw^?p3\xc6w\x06\x98\xd0Nk\x0dg\xf8\xc9e\xa5p5\\\nD\xef \x19\xd1\xbd\xe6xu\xc8eL\xcdv:\xad\x04+GA'\x8b\x1dc\x93;\xa2N\xd1w\xb7k\xbb\xf9\x9e\x16}\x08\xd6@8u\x8d\xaaR\x90k\x184N`\x9d\xfe	\x12\xb7\xc1\x81\xe8\xc2\xb3J\xdf4\xf6HS]Y\x1fT\xb3\xd2\xc2=_\xfd\xb6Z\xab\"\x8c\xa4\xc7\xe3\xbe\xb0+m\xd2\x98\xa2\x03\xee\xce7\x16\xe6D\xee(z\x1a\x1a\x05\x08\xa5\x1d\x0e;\x0c\x1e\xe5`Q:\x15>\xfd#c\xca\xe1\xee\xde(\xea\x1c?h\xdf\xabF\xff\xa4*\xfd\xe9\x1f&\xc5\xdbwp\xecL~!B6O~\x8e\xbeIJ?'x\x05\x1f\x82\xde\xa4R\x00\x0d\xb2\x15\xdccl\x1cZ\xdc?\xe9|cm\xf8\xf0\xc0\xce\xe9B\xc8\xc4\xa9\x9c\xb5\x81ln\xc3\x90\x17\xb3\xf3%b\xb6\xb4\xd1~\x0d\xc6X\x83\x1f\xd2\xa4\xc2\x9a\x8a<w\n\xd12jl%\xc6~\x8c\xef)zwru@\x0c\x91/\x92d\xd97M\x9cB\xd5\xb5c\xefi\xcd\x8e\xf7\x942\xdc\xf92\xf0\x93:\xcf\xe94\x06(\xde\xd2\xab\x1c\xb2\x88t\xcc[^\xf5\x95\x82\xb1\xf6jg\xad\xd3\xc8[\\\xe4/\x89\xbau\x89\xc1\x94\x89k\xfdO\x86\xd9t;1\xbe\xce\xdb\x89\xf1u\xde\xee\x1a_5\xfb\x9a\xf7\x0d0m\xa0~\xcet\xd8\xe7\xd5tr\xf2]\x8f\x1c\xe2\xe3\xedD\xe0A\"\x95X\xa4\xb8\"\x8e\x11\x06\x13\x87\xcbAF\xe0\x9c=\x07\\\xb1b\xbe\x1f\xeb\xd1\xf4>\xff\xc3\xc9q\x9a\x05\xf0_d'\xff\xa0$\xe4?J\x8b\xff\xca\x80\x83\xf2s\xf0\xfb\xceO\xfc\x9cr\x9f\xd1\x06\x8b\"W\x88/M\xda\xa6\x8c\xbc\x9c\xd7\xda\xb69<\xfd\x0e\xe6\xb0\"\x85\x18\xad\x84hq]\xa4CA\\\xa3\xcd\xfdH\xd9\xe9c`gT\x03\x0d\x7f\x9f\xc5\xd8Q]\x1f\x90\xe3\xd6>0\x0cJ\xaeu\x10\x83S:\xfe\xeew\xbf;\xfc\x1a\xe2\xe6\xf0u\xd8v\x08n\xcb\\U\xa9\x8a[\xe5+;\x1e\xd7e\xfcL\x96F\x0ce\xc5.\xd94\xde\x1d*\xfc;\x8cO#\x05\x98\xef\x8d\xf2r\xc0i\xa6bx\xe8\x8b\x08\xf4\xa5|\x8a\xa2\xe7!z\x143\xacc\xd0\x18?\x86(\x02\xa4\xb06\x92\xdf,FB\xa5-\xb5\xf3\x81\x1e\xb4\xd7\x93\xf9\x1e\xbf\x91\xbb4L\x19\xa9\\\x0b\xf0I\x89\x96\x9a\xec\x92\xfa.E\x0bwM\x99V=^\x9f\x9c\xdd\xea\x9f\xca\x95<\x8a\xa3\x04\xfe\xdd\xb1M\x01\x02\xba!\xb6\x02\x19yy\x9c,O	\x13\xab\x0e!4,4\x1a\xf4\xd2/\x0e\xdc\x95\x9c\xadz\xbc\x11\xed82	\xbe\xb7\x93\xb5\\M\xcd\xe1\x03R\"46k]\xad\x93p\xf6\xe4\xc5\xd1a\\\xb3E\xdfv<\xc8\xebR\xe6\xd9%}\xf1\xe6\xcd\x9b)\x01\x93\x95'Y6\xd0\xfa\xcc\xeaw63\xee\xc1\x04\xab\xe4\xf7.\xd8\xac\n\x13\xf1R=\xa6\xf0Z\x13\x1b\xa6\xc8#prV\xd2\xdb\x17\xfeF^Uod\x87&\xd9\xd4V=~\xd4u1_\\\xcc\x06\xb0'\x99#\xb6\xfc,o\xf0\xaa\xbf\xfb\xe3\xb8\x80\xf8\x99\x82N\xf0\xb3b\xae'\x1a\\9QY\x15dC\xeb\xa1\x1f\\R.\xd4rD|\xbb\xb6.T}\xf8U&\x08NW\xfe\xce\xde\x17f\xede\x04R\x10h\xca\xea\xb3r\x8c\x98\xc2=\xc7x~NG\xc5\xa0\x1d];\xdbrXs\xef)\xe1D\x82\xea0\xfd\x9e\xd3i\xf6z\x93\xd7%\x0c\xc7\xa6\xee\xac6\xa5\x910\xa6\xc4GrF\xd0\xc1\x140\x95\xd6c?\x91\xd7\xa5\xa8>\x80s\x9f\x83\xaa\xa2uj-:\xb6\x87a\x91\xe11\xba\xae\x1d\xb5\x86\x91\"\xaeD\x0b\x8c\xb4\x992a{\xb5\x93\xaa\x85\xd9\x1a\x02\xf2w\xc1\x8e\xc9\xe0b0o\x86\x18V^\xd9\x15o\x86\xe8UZ\xdbt\x00\xc2\x88CgDY\xca\xc8\xe2\xd1\x02\x05\x1e#t\x1ciO\xfa\xae\xd1\x95\n,\xbc=n\xe5\x95\xa5:7e.\x9e\xc4\x82#,Y\xe4\xe2i\xa8\x06\x92c\x1b=\x0e\xcc\x0e\xfe\x8f^\xb5G\x8a:J\x96r\xf2\xa0\xabb\x9b\xe2g\x9cD\x18\xb9\xacyQM\xce\xcfe\xad\x03G$\x0c!\x99\x94\xabA\xca`:\xc3\x12K\xd0\x85\x9f~\x95\x80)fT3|/\x07\xed]\xc4\xf3+\xbd@\x90\xf8\xf9\x9e\xb9\x02\x85\x1d2|\x1a\xf1-\xb2\xd4\x1b\xf2[SmT\xa8\xd6\xb1 EQ\xd7\xa3\x9e\xa0\x86vj\xd9\xa0\xe8\xa4\xa0os\xdd\xa8m\xe92\xdcp\xe7l\xddW\xd1\x16WU\xe8UC\xc71\xde\x94\xfcFd\xacMlw\xa97\xc2h\xb5\xf5Ljm\x11B6\xe4\x0e\xd5!\xb2\x11\x96\x90\x0c\xa4-Y\x14\xb8\xa4\x0d\x8d\x83\xbd\x05[p\x93r\x13\xc4\x06\x1f1\xb6\x05z\xd9T\xba-\x13\xaf\xb6\xa9\xf7\x18\xf3CS?\xcf\x98\xd9D\x7f\xf7Db<\x9b\xf6\x94\x1a\xe9n\xcc\x90_\xaa\xae\x83\xe2\xb1\xcb\xc1\xf3\xb4p>\xfd\x8e\xfd\x90\xd3\xe5P\xf08\xfd$/\xb9\xde\xcb\x08\xd8\x8d\xe1BP|\x88\x9f)J\x81\x8f\xa9\x80\x90\xac\x01\xc5A\x91\xb9\xc5)H\x12\x0c\x17\xb8ir\xe6\xc0\x9a\xe0l\xe3\xe7t\xd9\xfb\x90\xa3\x12c\xce\xdd\x9a2n4lU\xde\x86\xeb\xe7.5.\xd0q\xef\x8ak\x8d;N\x0b\x01=\xa5\xc7\x87\x1c\xc6\x98D\xcda?m\xe8\xc7^W\xf7H\xaeT)\x01\x9ejO\xb0m\xd1)\x82\xfa\x13SC\xefFJ\xbe(\x17`}xgmS\xdb\xcdx\xa5\x84\xb2*C\x93\xe8\xd5\xa8\xfbh\xa5\x1e\xa6\x8c\xef-8l\xb2\x80\xc8\x824\xca\x87\xf3\xebx\x8eCB\xe7\xb9\xe0\x9b\xd0\x90\x16;\xf5\x9c\xae'\xfb\xf0K<\xa8!a\xa3\x06Q\xfc\x9ft\xa2@\xeai\xad\xc3G\x89sN\xe9\x14\xcb<\x06@\xc7=\xeba\xf6G{,\x9f\xe7\x01\xd5\xbd\xc3u\x88\x06\x9a\xa2\xaa\xb1\x9eSl\x14\xfak\xc1\x82\x0b\xe94$\xfft\xf0\xc3\x95\xfc\xd9=<\x17\xd7,\xba_\x03i\x11(\xb1%\xba\xd0\xe6\x9e\x86\xd0\xc2\xec\xa0\xec\x00\x97#\x13\xd1\x9b:\xf9\xce)\x04k\xeeq\x94U\xa3\xab\xfb9}Dn:)\xf3\xfa\x80\x8cz\xd0+$~AXXO1\xa5\xa2\xb8\x1dBoT\xd8	9\x08y#\xdf\xee\xd8a\xcf\\\x07\xc4B[\xd9\xe2\x18\x12@\x9c\xf4\xfc\xfag\xb7\x88\xdd\xf9\xc9(60+;\xe8\xfa4!\xe2D\x10\xf1\xec^\xa7\x9c\xb5\xca\xe1_\xd25\x1b\xe8\x0fvd\x10\x9f\x0d6\x16\xed\x80\xb8\xd2\xb0\x92\xb2\xc0\x9d4\xe55`I\xf6\xa5\xa9b\xd0o\x90\x87C|\x0f\x91\xb8\xb5\xaa%S\x9998F\xd1\xaf\xa4\xeclg\xa2\xdd\x92\xc58S2\xfa\xa7S%\xe03sa\x19b0\xeeM\xb3\xed\x87\x0d\xbbm\xfa\x90\xd2\xd09ppb\xcd\xab@\xca\xdf\x8f\x84\xb9\x05\x02\xd8\xc3\xa0\x1b\xae\x0f\x8f\x9b\x9e\xe9n,\x9d\xcdi\x08\x98\x920\xca\x16hGi-\xe2\xd3\xd1H\x18\xf8\xff\xd5_\xdc+x\xbe\xaf\xfe\xb2(\xe2,\xb0M\xa6\xf2\xf4\x06\xe7\xf5\xb4<\xbd9\xbd\xbdK%2\xaa\xfeU\xe5)\xc8B\x98|J\x15\xdc\xbe\xbc_\xc9\n\x9e\xf0\xf4t\xfc\xfe\xf5\x10$\xcf^\x8fgV\xf7/^\x8fX\x17W\xc4 o2`W\x959nT@\xb9\xac.\xac\xe2S\x1fT\xdb)\x94<R@\xcd\xb0\xa5\xd4Oe>\x9ct\x99\xf6@\x94m\xfe\x96^}\x81\xd8\xaa\"\x15\xdc\xa7\xbf\xfb\xc9iw\xac\xca\xfd\x90\xcf}\xc2\xc0T\xc7=j\xe2&^+|f\xb5\xa5\x85\xb4\x94^\xfb\xe8:;\xaerp*n\x9d\xdcLXd\xd16E\xfd\x9d\x14\xdfxt\xb5\xc6p\x15R\xccj\x8c\x80\xbeK=\xe4\x8a\xb5\xdaC\xac\xb7P\xcb-l	\xc7\xf0ZEF\xa6\x01\x93\x056\xdb\x9bI\xec\xe2\xbb\x1b\xf6\x9d\x85(\xfe>\x16R\xd7\xecx,\x7f\xa4\xcb\x9e\x91T\x84\xe1\x82`0\x0d\xdd'\xbdS\xfc7\xd6[\x17\xf3Y\x1b\xbe\xbd\xb9\x18w\x14!X\x00\x0e&\x9f\xd9\x0e\x17\xef \x15\xa2\x0e%\x89\x88\xf6z\xdb&\x7f\"Wxt\xce\xc2\xbd\x8de\x99\x1b\xeb\xee\xcbU\xa2*k\x9cS\xbe\x92\x97	\xdc\xa9jk?I\x0b8J\x12U\x93\x15\xec\x80\xd2\x97\xb1\xa9\xdb\xb1\xdc)\xd5\xd9 \xd6Q\xecx\xd6Q\x85\x8b!\xab\xca\xd9\xbaAI\x17D{\xd5\x8c\x9cw\x8b\x94\x04\x0c\x9c&\x8b\xb2[\xd5\x84\xb8\x15\xb1)'\x03E\xae\x0d\xfed*\xed\x04f\xbaa\xd4k\xb0A\x00#V\xe7\x0f\xd6\xe5\xd7?WE\xebU_\xb1Qc\xed\xd2-\x00W*\xbb9\xb9\x1d\xa5t\xff4\xa1\xe5\xa3\xdd\xb8S\xfa|\x94\xac\xe6\xd4\x9a\x8a\xa0\x13\xfe\xbbd\xfaA\x0dQo\x82n`\xbc\x0f\xa6v\xcc2\xa6Z ]I\xa2\xba\xb1\x92a\xb2}\xe1\xa4\xfa\xce\xea\xa6\xb4\xde\xa1\x17h\x80&Yar\x96\x15\xe7\xf4\xf9\xe7\xd8\xa8\xd4\x05O\x11\xaa{)\x96\x82\xc6(\x10\x07\xb5\\N\xeb\x9bo\x01\xa2\xbb\xa2\xbe9k\x9e\xad\xed\x1d\xc9\x88X\xfe\x9c][\x91\xcbkVu\xa9\xd3}\xbf0\xfc\x84\xd9r+\xf0B2\x8f\x99\xae\xa3!\xc87\xb6\xc6+\xb1\xb6\x0d\xd3\xe1\x97_\xd3\xf9\xf5\xc3\xd7\xc2%\x87\xbf\x91\x8f\xdf\xa0h\xc4p\x98\xbc%\xd0\xa6\xd6\x0f\xba\x86\xdbx~]\xa8\xe5Pf\xfa\x0b_\xeb\xcev\x12\xc1\x198[\xa3\xee\xd9C\x9bF\xb3.\xad36\x0e\xe9\xd89]\x0c\xa5\xe4\xa9\x88\xfc \x97\x90\x0b\x8d\xb5\xf2k\xe4\xbacU\xd1\x97\x13>\x85\x1d4\xa7\xa3@-6\xef\xb7\x05\xcb\xe2\x80&\xeaP\xce\xfa	u(!\xd0\x18f+\x17\x89\x02\xc9\xe10\xefx\x08\xae\xdf\xa2\x86\x13~\xbc\xa2\xc0\xad\xf08\x8a-\x8b\x81\xd0\x85\x91\xb9/\xd5\xe3\x80BB\x89\xaaI\xf2\xf7\xff\xab\xc6?\xa1x\xaa\xc8\x7f2\xbb\x1e\xfd*\xf1\xa1\xfe\x9bf\x9fT\xadG+\xb1,Z?\x82+\x99\x08\xf1I\n\xa5t\xb05\x83\x14\xdc\xddP\x7f\xcd\xee\xba\x14\xb8\xf9i\x0bl\x8dN\xad\xf6\xd5j\x9ea\x0c\xf2X#\xfc\x92\xb9\x13\x85\x80;a\x9e0\xb9\xc2\xfbO\x14b0\xa9\x960O\x07C$\xdd\xe0\xa4\xabv\xd2\xd4AR\x1c\xb7Qv\x0cG\x91\xbe\xc7\xf4j\xc2}T\xc1\x1e\x19D\x0d\x9b\xe7\xeb\xf7\"\xb7Nm\xd0o\x05\xf6\x8c\x15Z\xf0\xf8\xafj\x83F\xc2\xf6\xa5U\"n\x94G;v\xe4\x93\xf4\xfd\x8bV$\x14\xc2\xf1\xfba?\xce,\x9euu\xec\xbc5\x92\x93\x1e\x1f\x14\xc5\x8d^J\x87\x9a\x93\x81\xb2\xd3u\x07\xed\xf3\x89(\x83\xe4\xa2\xa2\x1e\xe9qy\xe3\xb5|b\xe2\x01\x9b\xc4\xf3\x12\xeb~0\x92P\x19\xb0~D\xdb\xc0\xb6\xe2nv\x83\xb4:\x9a(7U\x0f\x8fG`\xea` \xd7\xfbC\xc7iy\xd1>\x99\xe6Oi\xfa\x8f\xbc\xb8\xfc\xf7\xb3\xfc\xcdt|\xb2\xd3\x18\xfc\x03\xdbc\xf4\xfb\x7f\xb6\xa4\x00!J\xfd\xa0j[\x08\xf1\xcdZ\x85#S\x1a\xfb\x1f\x07\x08:\xc4\x1ex/\xc6\xf3\x95\x92\x87c\x8cG\x80\xcc\xc6\xafQX\"\xa5\xbf#\xbe\xad\xc2\xa5\x1f6\xe1O\xf13b\x8a\x1f\x13\x8b\xb2 \xc4\xba\xfbI\x8d\xd2\xa5\x8d\xcc\x13\x9cZ\xa8\x1f2k\xc5\xe2\x1c\x9e\x16@\x12\xca\xce\xfdZ-t\xa3\x83\xc2\xee>\xc1\"\xf3\xfd\xb9v\x1e\x03\xfc:3\xbe|\xf1\xb7\x83\x97/f\xb6\x83\x818<DE\xfd\xff\xec-\xcdP`\xd5\x9b\xb8\xbaY\xdcW\x80\x97xkc\xd3F\xc7\xc6MJo\xcd\xd4\x0f\xbdG6_\x912\xd5\xbaw\xb0\xfce\no]\xc0\x16f\xdbav,\x06r>\xca\xd9\x85J\x05\xfc\xc5\xcb\xb7Y\x0e\xce\x15\xa0\x9b\x9dg\x00\xb1BR\x8c\xc7\xd9K\xd9\xc2YPC\x89\xce\xec=X0\x95\xcb\xc0\x0f\xd4MZ\xceq6SS\x93	\x8e\x03\xe39'\x9b\x90\xfa\x1c\x05\xf5\x83\xd4QE\xb4\xdcv\xf2\xd0 o\xd3B\x99\x89N\xfa\x93\xed\x8bR)\x89\x7f\xd71@t\xf8\x99?D\x88\xf2\xb3\xa2,\xd1B\x11j\xb3\"\xc7\xca[\xf36\xf1\xd0\x9f`\x1e\"u$\xc5\xf6b'0\xa4\xc1g\x1e\xa1\x08m\xe83?O]\x8f\xca\xfa\x05\x0c\x8b\xa5\xc1\x07\xd1\xc4<\xbf\x86\x89\xf0Y\xaa\x19H\xa7\xd0\xebL||l\\\xbe3\x16\xac\xb3dS\xa3-\xdb\xe4\xb0\xc2D\xb7Q\xab\x8cZ\xb1<\xdd\x8d\x9dk\xc4\xfbP)\x90\xbe;\x88,\x0c\xed\xb2\xec\x9a)\xef\xf5\xca\x88=,-\xf2\x19\x0d\xe0\xd8a\xa1\x84\xab\x8eU\xa2@6\xce\xddv\\\xf9D\x05w\xc1\x8f\xf6\xdf\x7f]\x9f\xbe?\xa0\xeb\xab\xf7\x07x\x08\x8a]\xf9xz|Y<\x97\xa06\xe9\x95Z\xb7\xa9<\x16*\xfa\xab7o\x1e\xbfx\xf3\xe6`h\x1e\xb3\xdf1\xfdL\xbf?\x96\xb5\x1a\x04b{S\xcf\x13=\x90\xd1\xb3\xe3\xb4\x9c\xf4@\x0e\xa0\x94pH\xf0\xb52+.\xd2\x1ax\xf7fV<DPS\xb7\x06\xfbQax\xfe\x99\xe0\xac`$\xcc\xde\xc9\x8f\x08\xb3f\xa9W\xbd\x8bA=i\xcc\x90dkL\xbb\xdd\xca\xf1O\xfbE\x96H\x1dq\x9b\nd\xf2YbB\x81#ZN\xf0?\xbd\xc8i\xb8\x18R\xc6\x0e\xcb\x0eY?\xe6\x0e\"E\xcb\x0e)\xad\x98\xdb\x87\x94\xe2\xac\xee]\xce\xaa\xcdN\xf2\xef\\\xb3\xad\x9d\\6\x14\xf6\xc8O\x81\xe7\xc2j\x10\x9a\xcb\xafSK_\xdd\x7f\x88\\v\xf6\xed\xbb\xdf\xd3\x87\xb3\xb3\xd8\x10\xab\x1d0\xe0}\xfc%P)\x8f\x9b\x9d\x9f\xe4/DY\x83\x9c\xf5y\xfe\x1d[\xe4\x0d\xdb\xadnu\x13\x8f(>j#\x1f!I\x13'\xeat\xd3\xf0J*\x96g\xe7\xf1\xe7\xa4\xecd\x06+-\xf3\x0d\x0e\xaa\xd1\xc5\x9b\xa8\xcc[COmV{\xf7@\xedq\xfd?c\xfa\xaf\xfe\x0d\xa6o\xec\xcaJ\xfa\x1f\xa5\x83\xf8\x95h\xc2\xefX\xfa\x8f\x06\xf8\xd70$\xa9\xe6\x07]\xe5\x13\xca\xe9\xce|\xae9Q:\n\x99Q\xdf\xcc>\xa4\x9f2\xd2n\xcc\x95\x1dB\xd8\x90\x85TK\x98\x17\xef\xb9\x10\x98.\"\xd9\x12VG\xaf\xeb\x81\x99\xba\xde\xad\x06V\xbb\xc6\x87p\xdaa\xc1\x8e\xd1$\xb8\xb3\xb6	\xba\xc3\xe83\xdd\x04\x1e\x0e\x11B\xda\xf7\x0b\xbc\xc2:H\xef\x83]\x92}H\xc5#\x95\xa9\x10[\xd3\x90\xef\xd5\xbdo\xc49\x9dS>\x9c\x1b^\xf5\xe0	~\xcc\x0fH\x93\xb4\x9ey\x0e1\xfb*\xab\xbe\xe5\x90\x12\xa4i1\x9e\xc3E<\xf0\xdc\x9c\xce_\xac\x9ab\xeb\xfcZ!\xcf\xf3\x96\xf2\xaf\x02z,.\xdf\xecV>h\x91*>g\x89Q\x93\xdd\x89\xa1\xe9\xe7\xc8\xc2\xf2N\xdd\xf9!\xf5\xce\x8f\xda\x879\xdd\xd9\x18\x97biN\x9b\x84\x83\xd8\xa8\xed\x01\xad\xaclB.\xa9\xeb\x17\xf9\x11.TJd\xca??sY\xfe<\xa3j\xcd\xd5=g!\x9b\xcd\x03Pwk](\x0e$u\x88\x8e\xd1 i\xb2\x1fU\x9em<8A\xd1\xff\xc0Y\x8b#\x91\x9e\x84\xa2\xc4N\xadh\x9f\x90\n\xeb\xa5Y\x1e,\xa0\xcf\xb7F\xff\xd8#\xc3.]\x91#\x80\xb7\xc0\x87\xf2X	*\xb50<R d\x8c\x81H\xd0\x01X\x86|\xc3L\xec\x16@\x06\xfb\x05\xd5r\x02\xc1\x7f\x19\xd5\x9b\xa4\x01\xbf5\x0bef/_\xfc\xed\xe5\xdf^\xfe\xbf\x01\x00PK\x07\x08g\x0c\x85\x82P\x18\x00\x00\x1dD\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x004<N]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/fr_FR/common.jsonUT\x05\x00\x01T0\xcfj\x8cV\xcdn#\xc9\x0d>\xcbOA4`\xcc.\xb0\xf0\xde}\x93%yG\x13\xc9\x16$\x8d\x07\x8bl\x0e\xe5n\xaaUv5\xd9\xa9\x1fi\x94\xc5<\xc0\xbeE\x8eQ^!\xc7~\xb1\xa0\xfe\xbaeO\x16\xc8\xa9Y\xfck\x16\xf9\x91\xac\xdf\xafF\xc5\x8eu#lq\x0b\xbf_\x8dF\xc5\xb3 \xc2\xaa\xb8\x85\xe2n\xfc\xf00\x9b\xc2\xdd\xaf\xf0\xe1\xda|\x80\xfb\xc75\\\x9b\xf0\xf9\xad\xb86\xbf\x15\xc5O^\xbfB\x856\x1aLg\x8b\xd9v\xb0\x88r\xd9\x88\x1a\xa7\x83\xd2|9\xfee\x06\x7f\xae\xbaiY*\xd4\x97\xca\x9b\xd5\xe3|1[\xbfW'<\xae\xd8X3\xa7\xed^\xa3\x08\x06\xd7\x15\x10\x1e\xa1\xf5|\x90\x046Hn\xa2\xff\xc0\x1dS5\xf7!\x99\xc7F\xda\x14\xf8u\x15-~0?\x82\xa0\n\xae+\x08\xb1\xf83'\xb5\xc1\xc3\x9f\x18\xbeUt\xba\xc6\xca\x87\xe7\xfd\xaf\x1e7[X}^\xff2\\\xe1\xbb<\x9a\xbd\xa8\xf8x's\xf67\x1f\xc7\xd3\xc7/p7\xff\x7f\x8a\x10\xaf\xb9\xe0\xf2u\xcbu\xad\xe2\xad\xb6\x1f\xd7\xb3\xf1\xd4\xd7\xecM\x96\x1d\x0d%\xfe\xfc\xf0\xb6\xc8\xd1\xdbA\xe2\x11\xab\x8dhp\xbe\xca\xe1o\xe0\xf1\x1e\xb6\x1fg\xb0\x19/g0_\xc1\x97\xd9z\x06O\xf3\xd9\x97!\xba\xe2j\xf4\xed\xa7\x04(\xe3\xf1\x14N\xadrZ(\x93\xf1U\x89Sq\x0b\x7f\xbd\x1a\x8dF\xc5\x0b;\x1d~\x19IS\\\x8dF\x7f\xf3\xe7b\xef%Ym\x8fNc\xd2\x0b\xf4\x85b#\xc9Y\xecU\xd3\xd1\x8b\xb2\xecR\x99\xc9\xee\x07]\x96&k\xb2\xbcP3X2U\xbd^:zQ\x96]\xf8<\xa1\x18\"\x15D\xdd9\xff>\x1e\xa2j\xcaL@Y\xce\x84\xa8\x1aI>\xbfcOHc\xb5\xb0\x98\x12R\x88\x9a\xbdh\xae\xe0\x04\"\xb1BL\x05\xda|\xe4hML\xa7&\xfe\xb4(\x99\xac\x16\xa5\x8d\x10\xf7\xe2uw\xae\x9c\xd4\x08\nMD\xb5\xb9l\xdc\xbb\xd3P\xe7\xf9\nn\xc1\xb2\xb3`\\\xdbj\xd9`\n\x06\xbf\xb6\xe9\xe7k|aI\x95\xc6K\xc1\xf0\xb3\xee\x0f\x8bT\xfd\x8f\x9f\xede\xe5\x8bTLD\xb9\xcf^\xe3\xe5+A)\xa0\x17A\xd2\xb2\x0eQO\x98J\x89\xbaN\xffyq\xc6>\xf0\xd1K\xba\x7f\x82\xfa \xc9XA)\x11\x8a\xcb\xd7\x88\xf8D\x05\xef\x0dW\xa8E\xf6\xb7\xe4\xaa;_\xe67w\xeb-\x14\xb2&\xd6\xdd9E\xc1G\xc2\x18\xc3Js\xabew\xb6B\xe6\xfb\x1a\xc4\xb1R\xdeh\xeb\xd3t`\x99\xdc\x99}\x8cn\xbc\xdb\xc9\xe1\x86&\xce2\xaf\x9f\xc6Z\xd4\xb6\xa1I7V\x96\xaf\xbe\x19\x8a\xee\x8fVR\xdd\x8b\x1d\x0dW\xea\xe9\xbe3\xbf\xab\xd8\x10\xc5\x89\x9d\xb7\xf9\xe1\x89\x9d\xf91w\xa39Q\x99\x00ZL\xbbs\xc9DX\xda\xee\x1c=N\xfc\xf1\xabd\x8a\xc7\xcd\x89\xca\xbd\xe6\x9b\x9b42\xa7Rc\x99\xf2\xfc\xc6\xf8*4jae\x83\x19\xcf\xa5PH\xd5E7|\x12\x94Z\xe1\xbe;\x1f\x12\xb9\x14\xb9\xe5\xc7\x87L-\x85L\xd4''\xb3\xcd''U\"\xc7\xdc\xfd'\x91\x1bl\x13\xf5\x98\xe2\x1a\x15\x0f\x9c\x9d\xfb\x10\x87\xd6<\"\xbe\xf6\xc1Le\x93\x94\x16\x8e\xbe\x8be\x99r?*>\xa1K\xd4\x13f\xbd\x8dh.\xbb\xd8\xc9|\xe5g\xb6\x96\x1b\x9f\xf3;\x91\xf0S\n*1@dL\xe4\xfa\x9a\x96\xc2\n\xc5\xb5\xe7O\"\xe9\x12\xa6J%\xfd\xd8\x9e\xf4v\x13\xcf\x00\xcb\x90\\]v+\x0b\x1d0\xbb\xc9\x0d\n\x8e\x10Z%\xa8\xdc'\x7f\x15\x93/I\xb1E\xddH\xca\xff\xdfI\x85[\xe6\x85\xf0=u\x0b\xe1\x0c\x96\x19\x94\xe8\xbbl'I\x9a}Z\x1e\xc1:\xa3\xa4f\xae\x15n\x98\xea7\x01F6\x18\xcfO\xcb\xbeem'\xac\xb5k\xc3\xe6[|\x88,a%\x13\x08\xe8\xce\xe5\x9e]w\x86\x96\x9d\x86R8\x83P!\xf8\xa6\x91\xa8\xa1d\xad\xb9i]B_\xb4\x9d\xa6\x0b\xbd\xf7\xa5\xbb\xb33F\xde\xc0B@+j\x84\x83\x80FH\xb2H\x82,t\xff\xb6\x1aAc\xb9\x17\xba\xee\xce\x98}\xd2A(YMDk\xcb\xbd\xf0~\x13	\x92\xfc\xef{\xb8\xbf\"\xb6\xe1\xdd\"\xa9\xbeg\x8f\xea\xe2/\x88-T\x89\x07;N\xb9U\xc2\x84\xcbV\xa8I\xfa\xe1\xd1\x8f\xa5\xe1a\xf2\x84Z\xb3\x93\xaa\x07Dl\xf1-\xdf\xf5\x10\xba\x97_\xbb3\x08\x07\xcf\x19K\x0d\xca\x8a\x9f\xa4\x91L\xf9)\xb1\xf4,8\x04^Rr\xc6.\x85-\xfdb+\x16h\xa0ak|R[a|vY\x1e\x90lH-\x9a\x96\x87\xe1Mx\x1c\xe2{`w@\xe1\xc0\xb8\x97\xbc`Z\x96d\xb7\x9c\xe0\xea\x95\x9eP\x1bP\x08\xe5[\x04\x87\xad6,\x81%\x1a\xe3'?\xfc\x0c\x91	?\xc3v\xbb\x88\xd1\xfe\xddqzt\xf9!\x05\xe2\x80\xff\x80\xeel\xbb3\x94\xb2\x1fJ\x1a\x85\x89\xcbm-\xa4\xc9\xb3Ic\x15\xa6\xd1\xdd)\x0e\xbfu:\x83P\n\x9eO0_\xbd\xd7\x1b\xae\xf7^7>\x98\xb2\xfeN\xa3	\xe9\x1b\x97\xd6	%M.\x92\xc6V\x9d\xd2\x16\xbdL\x9dF\x0fj/\xd8\xc8\x9aD_T\x8d\xd6\xe9\x188Z\xce\xbb\\;\x95w\xf1\xbfj\x95W\xa2A\xa1c\xd1&{\xd4\x17K\x03\x8d/\xee\xeck+\xd3+x! 1A\x00z~N\x94_;\x0fle\x19:~N;\xce\xce\xdds#C\x803:\xf0	\xf5\xfbw\"VS-vA#f	\" o\xe0W\xdf\x99\x95\x17\xc2^\x18xF$x\xc5\xd6\xa6\xfe\xb1{\xd7<\x93\x90J\xc6a\xb0\x94$\x85uZ\x9a\xd0\xe3\xfd\xe6\xb0\xdcz\xe7\x1f\x85Kp\xb2\xa76\x99$*\xbdF\xf3\xd4\xc9\x08\x1fp\xe1\x08\x9a\x88%\x90\xe4\x1f\x0e\x87|oGG\x8f\xf8\xa1\xbe\x9f#\xe3M]]\xabXT\xf7R\x85\xe4|\x0e'\xf0s/\xf9\x08\x8c\x95\xe6Z\xa3	\xe5\xb1\xddY\xf9\x01\x15\xe6E\x7f\x11\xff\x1aN\x81g2\xd8\xbf\x8b\xe0\xcb\xe5\xff\xafF\xdf\xae\xbe\xfdw\x00PK\x07\x08\x88\xe6}\xf0\xd8\x05\x00\x00\\\x0d\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x0b>N]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/fr_FR/server.jsonUT\x05\x00\x01\xc63\xcfj\xc4|mo#\xc7\x91\xffk\xed\xa7(\xf0\x0fCN\xc0\x95d\xc7\x08\xf2_\xe0\x02\xac\x9e\xd6\xca\xad\xb4\x8c(\xafqp\x1c\xa09S${\xd5\xd3=\xdb\x0f\x14\xe9 \x1f \xdf\"\xb8Wf\xbe\xc6|\xb1CU\xf7\xcc\xf4\x90\xd4\xc6\xbe\x8b\xef\x00\xc3\x10\xfb\xa1\xba\xba\xa7\xba\x1e~U\xbd\x7fyq4\x9a\x1b[\xb9\xd1+\xf8\xcb\x8b\xa3\xa3\xd1\xf5\xeb?\x8e^\xc1w/\x8e\x8e\x8eF\x13\xa15\x8a\x00\xe5\xb1\xd44Hxi\xf4h\xcc}\xb7\xe8\x9cX 4\x7f\x071\x9f\xcbb\x89\x16J\xa1\x1d(\x01\xd7FZ\x84\xd7a\x0d\x7f\x0c\xe8h\x92\x1b\xbd8:\xfa\x9ef\x8e\xee\xa6\xd7\xdfv+\xf0\x0fj>\x1a]\xa0\xf7\x08\xb5\x12\xbaX\"\x88\xe0\x8d\x95\x0e\xa1\x0cP\x18\xedQ\x07\xf8\x18$\xe8ct\x1ej\xe1\xc0ba\xaaJ\xe8\xb2\xd9\xc6\x85\x83\x06\xd4+i\x8d\xd6X\xa1\xf6P\"x+VB\xaa~u\xa1\x9e\xc4\xc6\xbd5\xc5c\xc7\xc3\x83	\x1fL\xb0\x0e\x1c\xc2\\\xae\xd1\x82\x080\x13.\xf1\xf5\x1e\xad	R)\x04\x85P6\xdb\xb9T\x91|\x1cE\xab(\x015\x9dE\xd5\xfc\xa3Bp\x12\xd4\xb1\xd1\x0b\x85\x1e\x88\xd9B\x14\xcbf\x9b\xb1\xa0\x8d\xdeT\xd2a\xc7\xc1\xeb\xb6\xc5\xb6g!\xe8\x0c\x14\x826\x15-\xe0Mp\xa0\xd0A\xf0RI'<\x06\x9b\x1d\xa9\xb0\xc5R\xae\xf0aiQ\x94\xae'\x1b\x9b\xc1\xa7v\x1a\xdb\xb7\xd66h,\xdbN\x90\xday\x14%\x989\x94\xa8\xd0K\xbd\x00\xbf\xc4\xea\x04\x12\xd9\xd8\x8c%\xcc6P\x99\x12\xad\xf0\xc6:\x10\x16\xc1y\xa9T\xdb\x7f\x92\xf1\x15Ji\xde\x1b\x15\xaal\xaf\xd4\x06\xab\xd8\x98N\x98\x7f\xd0\x97vFCm\x82\x85*8\xf91 \xa0\x07\x85\x05m\x17V\xb2l\xb6&'\xee\xcdB\xce;\xc2\xef\xe5B\x93\x089xss\x0dB\xcb\xaa\xd9b\xb7i-+\xfa\x80\xb1\xb3\x92Z\n\x1f\xact\xcd6;\xc6\xd9\xe26\xf8\x9eS\xfa\x01\xe7\xa2x\\X\x13t	\xefe\x89&\xd1\xbb\xa5o\xab\xc1\x99`K\xa9Y\x02\"\x7fP\x1e\x0bke\xf3\xa3\xc5\x97$\xcb9\xf5H\xa0%\xff\x1c\xe5\xe6\xc7\n\x1dT&\xa8f\xeb\xe8z)\x01\xca\x04\x12\x88ZXP\xc7\xa2\xac\xa4\x1e\x83\x92+K#Ji\xe9\x84X$\xf9\x1e\xac\x8c\xb7\x08\x95\xd1\x92\xce-c\xc0\x08[^\xadki7\x1d\x13\xfc\x93\xaf5\x94\xc7Aw\xf7/m\xf3\xceT3\x8b$\x81\xe9\x86\x10}m\xc2\nEXC\x15U\x80\x03\xb1\x12\xda\x13\x9f.\xd4\xb5E\xe7\xf6\xe9\x0d\xb9\xb8\x13\x99D\xdcE\x19W\xa2\x1b\x1c\x17\x9f`\xf0\xe9\xeeK\x0b\x1f\x82\xfb\x18\x8e\x9b\xbf\xc3\x17gP,\xe5|n\xd1\x81	\xa0\xd0{\xfa\xb3\x92:\xb8\"\xd0%\xf9\x1c\xd7X\xd5\n\xe1\x15\xd4F\xfdjg\xed\x07\xe9U\xbf\xf8\x83\xa4\xc3\xfaY\xcb\x9fA!\xac(<}\xe3\xc1b\x13\xa3\xa4'\xb1\x1d,Y\xf6\xa7\x9dtf\xda\xdf\xfb\xf8\x9dR[7\xa3\x10\xb5/\x96\xa2\x9bt\x91~\xc7I\x97H*\x8f\xf4\xe3z\xa0\x0ch\x0b\x85\xa9j\xd5l=Z\xd2\x85\x89N\xbcO\x05Z/\xa4F\x07\xbe\xf9\xcfb\x89\x8e\x06\xd3\x8d8Fmq!\x9d\xb7Q\x82\xe8D\x05\x14\xb6\xd9\xf6R\x01.|@\xbf\xc7\xe1\x83X\xb8].\xc1Scd\x95\xfaI~#\x9bh#'\xf4\x81\x9cQ\x81\xa8G\xae#\x9f'\xf0\x8d\xa6\xd9Qe.\x8dt\x12\x84\"6\xc8\x980o<\xbfX\x8a\x8f\xa1\x9b\x05\x8bf\xab\x9b\xadm\xb6'pa\xb4\x974N7\xdb\x82N\xb5\x9d'\x02TFj\x07\xbf\xa1\x05\\\xa6\xa0\x8a\xa5\xb0\xd3\xc2\xd8^\x1e\xf8\x17\xb1\xe5jQ\xf1\x85\xeb?v\xda\xd7\xc4\xc8\xd2\x81\xd0^\xf2\x18EZ0\nPeJ9\x97Evr\xfd\xe4\xceF\xa5\x0f~\x02\xafk\xdb\xfcH\x97\xc7H\x0b\xb8.\x9a-\x992\xba\xed\xb2\x92\x1e\xc7\xa0\x8e\xb3/\x0c%\xae\xac\x00K\x92-\xf3\x0f\x9co\xc7T\xed\xf5{\x8b+T\xdd\xae.\xfa\x0eP\xdc\xd3J\xd3\\	\x8f\x90M\x8c\xfdd\x06\x9ep\xe6L\xf1\x88\xbe\xbf\xe8sc\xa1Pt\xc8\x8e\xef\xba\xb1l'j\xb4i\xc8\xcb2R<\x81\x97_\x80tdA\xa0\xc4\xb9\x08\x8a\x14\xf9\n\xd5\x18\xce\xa0\x94N\xccH\x0e\xb2e\xc7\xf0\xf2K\x08\x8e\xee\xb4V\x1b\xf8:\xcc\xe7\x95\xd0P\x98\x92\x16\x10\xba\x84/\xc0\x1b\xf8\xffd\xd1\xf9\xeb \x96\xcc\x8e\x93?\xe0\xe0\x08\xac\xbb\xb0X\xa2\xf6R\xa8L>\xdf\xddO\xa1\xc8:\x92]P\xca<Aa\x8ds/\x8d\x95\x0b\xa9\xe1\xf5\xe4\x06,~$\xc7\xc5\xd1\x92\x0eu	\x851\x8f\x12\xdd	\xdc,\xb4\xb1X\x8eA\xce\xe1\xd7\xb4C\xa1A\x10\x11,!\x12\xd8a\xe6\x1d7\xee0bR#\x8d:\x1a\xa5!\x1d\x1do\xa0\x12\x8f8d+g\xe9T\xd4\xf2t\x0c.\x14K\x10\x0e\x96\xde\xd7\xee\xd5\xe9)\xae\x05i\xa2\x93\xc2T'\xf0\xebH\x8e\xa9\xb6\xeb\xe5\xac\x05\xe7Mu1\x9dv\x8c%f\xae1:;t\xc8~\xa3\x90>\xae3Z\x0bE\xd6\x92\xfd=\xba5\x0b\xba\xcf\x82\xa4\xd29\xf6M\xc0/\xc9r\x81k\xb6d\xb1\xa5\xd1\xba\xd9\x1eX\xef\xc1,\x16\x99\xfe\xbd\x98N\x87+$6^\x17\x9e\x9c\x14%`\xfei\x86\xfa-%9\xcb\xf7\xf4\xc0<%\x92\xf1\xc7\xde\x06\x9a\xed\\\x04\x1f\xbd\xb6\xfc\xbe\xe9c\xd4 \x86\xfb\x01\x11\x8a\xa0\xf7\x96|+\xf4\xa2\xdb\x11\xfd\x08\x98\xd3\x1e\x8d\xf3\x8e\xc3\xeb\x1f\xa0\xe9\xfc]G\xf42\xdd!\x8b\xb5\xda@a\x82n\xa9\xde\x85j\x86\x96\xee+\xddd\x17\x87HR\xb2K\xf3\xa4\xc1\xe8\xe4\xe1\xb1\x87\xea\xc8yK\xe7t\x02g$i4\x8cd$\x93\x8d\x12\x1dI\x87%\xb3\xd43\xe0\xc24\xb5\xd1\xa0\xa3\xd1=\x16K\xfa\x8f\xa2\x83\x8a\xbc\xdfl\xda\x89\xb1\x8b\x8c^\xbc\xef\xec\xf9\xf5\x04c#\xb0\x8f\xd8\x91\xfc\x80\x05\xe9kr\x11\xc9\xcfv\xf0$\xfd\xd2\x04\x0f\xec_\xa1\x012T\xa2\xda\xa3\xfd\x06\xcd\xcdd\x8fvlM\xaa\xce\x806\x1e\x941\x8f\x10jVL|\x8c\x96\xce\xca\xcc\xa16\xce\xa3u'pNnR\\\x18\xe6\x8al\x18j\xa2V\xc2\x13y\xb9|^\xda\xc4\xae\xfc\xd0\xe2~&\x97\xd7{lP\xdb`\x83\x93\xcb\xeb\xb8\xbd\xbd\xe9\xf7ff|\xaf,\xce\x95\xf9\x180\x1aM\x1b\xbb\"\xa1\xab\xaan\xfeA\x87\xdf\xf7@y\x8c\xebZ\x99\xce\x9d\x13\x05\x99\x14\xb4\xc9\x87l\x9d\xab\xdd%\xbfqh\xe3\x9e\xbbe'q\xa8\xcb\x1d\xce\xb4\xf0\x14\x83j}P\xf2\x1a\xe2]!\xd7]R\xf4\xc6>\x93m\xb6\x14\x0cR\xf4\x12V\xa8\x88\xc5\xb4x\xbea\xa3{\xe9z@[I\xcd\x1bm\x8d\xcdh\x9c\xf7d\x1d@DmvaP.\x96~&To\xee\xba\xa8\x13\xfe\xdf\xef\xb8\xe3\x90C\xf9\xc5\xd9\x19|\xec\x06\xa2'\x93\xea\x8d\x17\x14\xc8\xc0\x97gC7/[\xad\x12R]Y\xdb-va\x82\xb5\x12\x15\x9d\xbf\xb5\xe4q\xe7\x1a\x8c\xb6D\xceV\x1c\xe3\x00\xc9n\xb3\xdf33\x8b\x80'\xfb\x84o)^m\x89\xbf.\xc9l\xb7\x87\x91~A\xb1\xbb\xe4\x012\x13\xe1\xfa\x0fzk8\x1a\xaeEO+o\xa2?\xe8\x9b\x0e\xa9\x1f\"j\xac\xef\x88\xd2\x0fphW\x14\x9d\xb5,%N\xb9\xaf\x0c]w\xf9\xd3\x98\x9e\x86YG~z\x98\xf2\xd4\x04\xf7\xb24\x15y\xb3?g\x85\xf5\x12\xb5\x17\xb2#\x7f\xd56\xb47\x93\x10\x8c\\\x9d\xb53\x86\xba\x0c\xd7\xb5\xd0\xe5\xebL\xd8\x9a\xbfy\xd4\xa5%\x11u\xc0S[\xbb\x1e{(x\xf7\xe8\xf2\xee\x9cZ~\xa2W\xfc\xb3C\x01\x1e\x9a\xadj\xb6\xd1X\xd0\x05 \x94E\xf2\xcd\xe2\x9b)\xaa\xe6G?\x90\xcd9b9\x13\xc5\xe3U\x95\xcbP+\xa0;B\xc4\x07\xa6\xbd(\xb2\xabDJ\xe9aSc/9\xd7\x92L;7\xa5\xe9\xc9I\xa1\xa1\x80k\x8f\x9a\xbcJ\xd7{#\x1f\xea\x05\x18K\xdecu\x02oQ\xac\x10\xb0\xaa\xfd\x86\x8c\x0d\xbb$\xf4\xff\xd6{$o\x87\x88'\xb5\x0bJ:\xef\xa0\x10d\xb8\xd8\xd0\xd1'\xd2\xb0P\x86\xee\xf1\xa6w\x91xR\xc6\xb8\xca\x83\x91K+j\x14\xa1\xe58\xc2St\x82e\xec \xb9\xa9\xc5\x86\xaf\xe1\xc0\xc9\xce	\x1aS\xdeh\x8fv%\xfa\xa3\xbc\xa6V\x90msRQ\x04-<I]\x9a'\x90\x1a\x1c\x16F\x97\x8e\x9dS\xb22s\x9eC\xe6%:\xf6;kL\x8c\xcb\x14\xfe\xf5\xee\xe0\x04\n\x88\xb5\xacB\x05\xba3\xf6D\x8f|e\x84\x9b	\x9f\x17{\x8cd-\xa5\xce\x96mYM\xc6>\x19\xa9\xfc\xe0\x8c-\xb0$\x00\xaa\xe3\x81~l*\xe1i\x07E\xe7\x90]6['\x92SF\x82\xde\x03\x82\xaeu\xe0\xa2\xaag\xe9\x0c3\x95\xe2\xa0\\<\x8d\xf5A\xa3\x9b\x08\xbf\xecw\x9c\x1aY\xa0\xd2Z\x0f\xb8\xf6\xfc\x9b7\xc4\xbbLsi)P|\xf5\xadx\xd2\xd4\x0c\xdf\xa5\xbe\xef\xa1\x12\xf61\xd4'p\x1e\xa4\xf2/\xa5ngE\x94*\xb8\xe4\xb7\x93\x1f\xe0\xd0g\xa7\xb0\x10J\xa1\xdd\xdc\x9a\xb2\xb7I\xf4\x03\x16B\xa1\x95\xb8#IAS\x94\xcf\xb1\xa5\xda\xd9-\x88\x15\x16P\x1e\xb8\xeb\x0brF\x06[g\xf7$\xe17\xc6n\xd2\"\x97\xed\xefV\xfa\x13\x12\x17\xbd\x99\x93Rx\x0e\x87\xf8\xd7\xea\xb7\xfc\xbb\x14^\xcc\x84\xa3\xf8$9\x8a\x1c\xb9\x9c\x06gO\xddRX<\xe5\xd1\xf9\x86\x8d\xc9\xbd\xf07\xf1\xe7s\xaa0uw\xb3\x97\xb2,\xb1\x17\x18B+\x9b-\xbc\x82\xb3\x8eB\x85\x9e\xdc\x8e\x1f\x9a\xad5\xe4|\xd0\xbd&3\xe3Z\xf3\xee\x12,\x9a\x1d\xd0R\x96x.\xb5\xc6\xb2\xa3\xfc\xb5,\x11.\x13\xee\x18o\n\x0dM\x1d\xa4G\xe2=\xf0K\xe1aI\xaaf\x86\xa8[$rHz\x80;\x13\xcbl\x9b\x81\x9a\xc1\x05\x0b\xa7B\xa9\xd3\x1d\xfc\xb5w\\\xd2w\xed\xc1\xe8\x1aC\x0fA\xd3\xa5\xca\x88\x0c\xd6\xbd\xc7\"X'W\xa86\xbb\xcb\xdbf\x9b\xfaH\x96\xd2\xda\x97\xd29Y\x05\x85\xb9\xe1\xb0\xcd\xb66\xda\xd1\xf5:\xee1\x84=h\x99\xd6{X\x86j\xe6\xf6w\xbac\x9c\xee	H\x10En\xb6\xc8\xa8\x90\x1743\xc1\x1b\x0d\xdf%\xc5i\xbf\xcf6d\xac\xfc\x81\x94\xb2\xa2\xaf!\xb3\xb0gJ\x18\x91\x83n@X\xa7\x85z\xf5K\x98\x01\x0fBB\x19\n\x1f,\xf6\xe3s\xf9\x92\xbd\x04\xdcp\xc0>\x97\xa2;\x9fl\x18\xed\xe7k\xb3\xc2\xde\x19\xbb\xa1&\x10\x81\xfd\x1a\xfa\x93<\x1b\xc18\xadt;\x0c1<i\x9b\xedJ\xba@\xd1m\xf2\x9b\xc9$\xc8O\x92\x192\xf0\x96\x95\xfa\x90\x81O\xebn\x9eF*\x14D\n\xceH=\x93*\xd3&N\xcdn*\x0f\xbe7\xc6\xbf[\xa1\xb52SPW\xf1\x03\xa6T\xc7\xce\xe7\x9d\x10V\xb6l\xb63\xe4H3\xfb\xc8%\xd6A:\xfa\xceI\x02\"\x01\xe9<\x81\xb8\x9f\xaf\xe8f\xfd\x1b\xac\x84\xc20\x88P3P\x93y\x1a\xe2f\xf1\xe0\x19\x10s\xdc\xf1	\xac,\x80g\xcff\x85\xd6\xa5\xd5\x19{\x96\xd5/\x84\x8b\xc9j\xe0f\xddT\x037\xeb\":XAg\x0e\xd6a\xffJj2=\xd7\xd9\xe7\xbej\x1d\xa0\xb4\xdf[\xa1\x19\xfb\x87\xf2\xb8\xd9\xee\xf9\x85\xa4\x12\xd5\xb1\xd4\xbe\xd9Z\x99\xe2\xa6\xa4\x0fiA\xd2\x01\xfd\x19\x7f,{_\xf8\xe6\x8f\x97\xe7i\x85}\xedL#\x87N\xea\x07\xa1\xa576S\x02F\x17\x92$\xa1\xbd\x03\x1c\n9\x0c)\x95E\xde\x98\x95U\x12\x94VE\xf7\xcc\xa8}\x80#\x11\xbaX\n\xbd\xe0;FcB\n%\xd8\xed\x98\x8b\"\xbb\xd3J\xea\xc7\x9e\xa1\xb7\x12)\xe8Z{\xb4\xbaw-?\x98\xe0\xd1\x8e3vL\x80f[J\x9f8#\xe8\x91$\x98\xfd?\xf8\xfd\xef\x7f\x7f\xfaU\xb1\x14:\xd3\xb8\x95p\x85\xe9\xbf\xcf-\xff\xf4{\xa0Q\xd2\x04\x1d\xc8T=3n\x87.KyG<Q\xe1\xc6\x01\xd0\x8d\x1a\x16\x81\x92\x97\x84T\xb7\xa4sZ\xa4\xfa:2\x17)\xbdY\xd3\x95\x15eP\xbe=\xdc\x1bv\xb2p/\xf3\xc7tc\x9a\x87\xc3\xee\xa2E\xbes\xfb\xd4SK\xd7\x8e\xe8\xd0\x95\xb6XE\x19]I'\x87|\xad\xbf\xe6`\xbac\xedk\x11h\xc1t\xf3F\xe3AcEN\xa9 \xaf\xba\x17p\xdf\x87,\x9c}\xcb\xf6\xbc\x9e\\^O\xe5\x0f\xfd\xbeo\xc5\x9a\x91\x10Bpw\xfc\\j\"P\xa6\x03JHY\xde\x9e'/\x96\x11bQ\x13\x12F\x9en\x0c\x0ex\\\x9c\xb8\xabB+\xb1\xbe\x8f\xa0X\xb76\xfd\xde\x0c\xd4\xf4\xdd\xd0\xb5\x1e\x83\x98\x93\xd4=-e\xb1LZ\xda\x81\xf3\xa6\x86\x19\x92G6\x0bU\x8d\x9d\xe2\xceqn3'l\xe1l\xc8\xc0`\xe7\x0f\x82!\xd6\xc1\xa9\xa66\xd4p{\xfe\xb3\x8f\x963\x8boQ/2\xef\x92\x8e\x97\x94\xb9\x01\x15;\x86G\x1c\x1b\x89\xd9P+#J,\xe3h\x97\xc50\xed\xee\x82\x8e\xac\xe6\xb9\xddJ\xac\xbf\x95e\xb6\xde[a\x17{\xb2\xd26\xfe\xbc\x0d\xe1\"<\xbc\xef7\x12\x7fF\xf6\xff`X\xc7\xb8\x94nuY\x8e\x88A\x1cW7\xdbB\xce\xc9?o\x93\xa7\xc9\x85\xeb\x120mB\xd9\x85\x1amm\\\xee@\xc5\xa5\xa7Kc}\x11\xfc\xff\x11\x0b\xde\xca\xc2=\x98\xc7\xcc\xc5\xbe\x8d\x8d\xe0\xb95\x1e\xc59\n\x8b66qz@Z\x8a\x8c\x0d\x88\x82\xd2_0\xb1\xa6B\xbf\xc4@Nw\x9c.<\x9c\xa6\xbfO\xe0\xaa\x8d\xcaST\xc8B\x8c\xba\xac\x8d\xd4\xb9\x07\xd2'\xfb{vL\xd9lm*C\xc8-\xcaA;2\x86\x99\xd0\x94D\xc5\xe8gH\xbdhA\xcd\\\xab\xf5+\xea\x9d\xf4pZ\xe2\xceTt\x9e\x1f\x8cd\xbcEt\xc63\x9b\x89O\x04|=\x19\xdb\xbb\x90w\x04\x18\xd2\xe0\x0c\xf1J\x14\x87\x13\x8d\xeb\xbf\xf8\x85\xa9*\xd4EL\xa5\xb6\xcb\x8c\x07]\xe41\xa4\\\xf8\x01F\xcce\xa89*D\xb6\x0f\xfd\xd9\xdd\x19(\xdb\xae\xa1\xcf\x96\xd0\xe1\xd86\xce\x82\x1a\xa1H\xfdlbpC\xea	)\xb2\xe7\xef\xe5D\xd5\x16x\xe4\x8b{Y\xf4'Hf\xa4\xffL^v	\xbdaaO\xaa\xe3i\xb6\xa4\x84\x96\"\xf8\xe4<\xd7;\x1b\xf3]\x8e\xb3[\xe1.klW\xba\xc7\x029\xb5IWE\xe7\x03\xd8\x1eE\x13\x16\x1c4\xff t\xac\x90\x9e\x16\xf60\x93\xb4G\x15(J`G2\xdb\xd5\xd3D\x89M\x1et\xdc\x99'HmpN\xc5J\xadO\x97\x82\x0eK\xfc\x93\x87@\x85%\x04\xbd\xc6*\x86`\xc1\x9e\x8aS\xca2\x98\x00\x94\x13d\xd1<\x16\xc1\xdb]l\x83\x06\x93a\xe1\xbcCW\xe1\xc4\x02\xcdF\xb4\x0c|V\xd9}1\xaa\xdc\x93\xc1\xd7\xe4\x7f\xe9O\x8b\xa0I\x0e\xfe\xc5\x81\xf4\xfa;\xe2\x8c%7:B\x94\xc7\xce\x92\xe7i\xd7\x13!m\xca\xc4d\x81kX\xc7\xe1\xb9\x87Bq+vy\xf8<\x0d\x9f\xf1\xf3\xa41\xbb\xf3\x13kj+\x9b\xad\xa7\xbcz'N\xc6\xb9\xe6\xc7\xb2\x8d\x95\x91\xb2\xec\xb5	\xf4\xdd\xb3\xfb\\\xef\x1e\xc7\x01<:\x1bm\x9c?\x0f6\xbb\x8ct3a\xc6M\x87\x8c5\xe5u\xf9k\xf5I\xd2\x16'\x94\x9a\xaa\xc6\x8aGB'\x8b\x94\xe0\x9e\xe1\xdcX\xba4\x08\xa4\xc2\xa2\xc1b\x7fB\xee\"(_d\xc7Al]\x18\xa3J\xf3\xd4\x0b>sV\xb4\xad\xc9\xc2J\xcd\x16\xd6\x13.\x98\x01\x823\xf4O\xed\x05\xe6D\\w\x7fo&1O'\x1dpy\xcc\xb3h\x1d\xf3\x906;\x8c\xbf&\x83s\xf8gqX\x89\x07\xaa<vt\xd9/Q\xa0@\xfc_\x95\xd2\x7f\xcbh\xe9\x90y,\xa5O0j\x7f\x90\x0c\x80DO\xac\xfd\xc8c(\x83%\x0f,\xbaf\x02\ne\x1c&\xd0\x94\x90\xd0\x192-N\xd8\xf9%HO\xc0F\x14\xc0O\x1e\xec\x0dGwW\x0c\xf0\x1fB\xf7[\xad9\x1a\xef\xe2\xfe\x9d>\x8dZLP\xdeC\xeaT\x0e\x98\x94h\x8f\x88\x0e\x8f\xe3^\xf8\x1d\x08\x81O\xa3\x97\xcc\xf60\x92k\xfc\x8c\xc0\x13.J%P\x1eanM\x95\x90\xe1O\xee\x17\xed\xcdev\xbd\xc9\xacX\xa0\xa6\x94\xe9\xa3D\xa7 \xc2/S\x06Y00L:[&T\x06-h\xc2j\xbd\x01\x14\xc52%Q\xb3\x0dR\x8d\xe1NrqJ>\xc2	\x17x\x0e\x14U\xbblr!x\x00U\x9c\x0e\x90\xd6V\xa9=S\x88\xd6c\x1b\xa9\xe0	\xf4\xb0\x82m\x87\xb5\xdd\x8a\xc9]\xde\xb8\xf0\xe9'q\x96\x90\xad\x7f\x01_\x9b\xd0}\x93\xa9\n>e\x9f[\xc361\x8e\xed\x07\x89U\x97\xbd\xecg\xdb\x19a\xe7\x1d\x81{,O\xcfU@\xe0\xd6\x81i$\xc3\xe5)\x00'\xbbhM\xe0\xc4*\xfd=SH\xd9\\\x98\xc5\x188\x02\x9f\xc7\x7f\xb6\xc7d+\x8f\xff<;\xceVCQ\x0e5\xf5=\xc9\xc9aM}\x7f5}H\xc55\xa2\xfcE55\xb1\xf5Ngp\xeb\xdb\x0462\xe0q \x9f1P\x85)m\x9d\x178\x0eI\xef\xdfX\xde\xf5\xb37\xf6\x99\x8d\xff\xcc\x1b\x1b\xab\xf42\xa8s\x9a\x95\xee\xb5\xd2\x913\xaa\x04\x81\x1b\x94\x9c\xea\xa6<`U;h\xb6\x05\x17\x97\xee\x01\xc1\x82\x12	\xa9\xca\x14\xc1\x0f\x06\xb7Wk\xa8\xca\xf2\xeaG\xa9`\x03\x02\xbe\x80%\x06\x9b\x97@Z\xacQ\xf4\x87u\xa53|p0J\x89\xcdy\x98\xcf\xd1\x0e\x82e\n\xd5\xc5\x06f\xdc\x93\x83\x05}\xc4n\xb1 \x14\x8b\xb4O<W\xb6\xa2\\	#61&j\xab\xd6\x08\xb2\xd7\x9a\n\x94(\xabb2\x04\xf6\"\x8d`\xb7\xbb\x92\x8elJE\x8eB\x15\xb8\xaa\x86\x82d6\xd9	\xb2\x1d\xb2\xbe\xb9\x1f\x00'\xdf\xdd3`_Z\xfc\x9e\xfc\xae\xd2\x9a>\xd2\xbfl\xb6-\xf2\xdeB\xed\x0c\xd6\xd8vJ?\x83n\xb9:n\xb6\x85\xcd+\x89\xad1\xfe\x9b\xfb\xb7\xdd\x89\xd2\xdf\xe9[\x8a\xa2M\x8b\x0f\x90\x1dKu\xb1\xdd\xf8\xfb\xe6\xc7\x85\xea\x14\xee\xdb.5cc{k\xb1v\xebE\\\x8e}M{C8\xa1\xea?\xf6k	P\xe7\xca\xfc\x8a -\x0e\x9dX/&\x00\x8aA\xde6\x93\xd1o\xc7	\xd5K\xc7T\xa8C\x94-\x12\x14F\xe1\x18\xa1\xf9\x9c6aS\xfb\xb9\"\xf4\xabD(\x8d\xf40'\xd7\xb4/\xf7\xfcjP\xc2\x91I\xa4\x13\xa1@-\xfab\xa4)5\xdc\x89\xbe\x0ei\xb7\x18\xa0\x9dA\xb5u\x19\x9d\xe8Q\xfe\xb3\x9a\xea4\xec\x99\x9a\xea\xaex\xba\xa4\x8b\xa95\xae\xc9\x83\xcbl\x0c\x1f\\\x1e)g\x0c\xd4F\xaa\xdcI\x9f\xc6\x86\xbdTR\x86\xff\xb3>W\xc7\xa6N\x9eb\x9c\xd0\x93\xf4b>\x1f\xd6H\xbf\xb1\xa2\xc4a`\x95\xd0\xc7\x05\xf5\xa4\xa0\xe8\x18\xf5KO\xc1\x1c\x89_\xb2|\x19\xd90\xd3x\xc0\xd9\x99r{\xa6<)\xbcKKu\x08_\xdfK\x08\x08<-\x8dB8\xfd\xf2+\xb8\x99\xac\xbe\xe2l\xe8\xe9o\xf9\xc7o!\xae3x\xce u)W\xb2\x0cB\xc1\xcd$\xd3\xe5>/\x19x\xc8\xea\x96M\xcdP\xcb\x12\x93\x1e\x91.\xe6/K\x08\x9a\xea\xa9R\xe8\x1d;\xbb\xf4\xed	\xbc5Oh\x0b\xe1\xe8\xb8=\x15\x93\x8dS\xc2\x9e<\xef\x12J\xe1\x96\xe8\xc6\\}f\xe0K*cg\xe9D\xeb\xd8{:\x81\xd7\x1e*r\xef~\x97)\x17\xb2\xd1\x03cF\xa6\x9cd*\xa8C\x99\xf9\x04\x13f\xa9\x95\xaen$\xdf\xfa\x12+<\\%\xd9\x02\xfa]A\xe7\xc1\"\xc5\xa8\x03Y\xd27\xb7b\xdd\x89\xca{\x89\x19\xd6w\xdc*\x80\x7f\xd1s\x82\xddB\xf4\x01\x17\xb2\x8f\xca\x98\x0b\x8a\xc2\xfe\x17\xb9\x18T\xc3G\xbf2/\x86\xe7\xb2\x99d:X\x88gH\xdd\x0b*\x1b\xd6]l\xb3{\xc0n\x82v\x92\xab\xdd\xe4\xb0\xb2\x91\xab\xc5b\xdf\x14\xb6+\x94\xd2%\xe3G\xc8\x07\xf9\xe6I\x96u\x89\xeb\x1dH\xc7\x0fn;\xbf\x88\x18\x8d\x9f}\x1d\xd1\xb3\xc8\x95\xbc\xad\xc6i\xa7w\xe9k\xdb\xe6Qw\x92-;\x9a'\xd3\x16{\x95}\x11\x99\x1ez\x98\xdfp\xdb3>&\xe7\x00\xe2\xac_\xd4\xc3\x8cK\xeck\xb3\xc4\\\xaf\xaf\xd2\xde[W\xf0 \x7f?\xd3\x11\x0cT\xaf\xf9\xa6;\x8fk\xa3\xcbavk\xe7\xbc\xa9vfo\xc8\x0e\xb5\xffN^knt\x069>	_,\x93t\xbe\xd3\x9c^\xe9\xe8}K}\x9dd2LC\xdd\x89\xcd\x98\xf4;\x10\xee\xd1i1z5\x97\x8a@;\xb9\xa2r\xfb\x88I\xa0^\x19\xf9\xacGA\xb5g\xc3\xba\x80oqv\xfb?+\x0b\x08\xf0)\x1a\xf0yzt\"}&\xe2eH\xf5\xf1\x99\xef\xf1\xb4\x14\x9e_\xa3\xf5\xbcu-{\xde\x07Ux\xd4\x94,\xa4\xba\x8f\xe8\x06\x12LI\xd3\x81\x08\xf1_'\x0b\xd1\x93\xdf\xd0\xeb\xa0^\x1f\xffG\xfc9\xce~D?\x00\x1c\n[,3\xbe\x8c}\xdc\xaf\x7fj\xdfO&\xe4\x9b\xaaf>U\xcf\xd8\x12\xd9)\xf2\xff\xe9\xa4\x18\x87\x1d\xd8\xb3g\x84\xf7\xc5\xd1_\xc7/\x8eFQ\x97t\x8fWu,*\x1eq\x99~T<#WX\xe4L\xca\xa8{\x12G\xbb\x19=\xa5\xec\xd5H\xc5\x1c\xd5(\x91t\xc6zb\xb8\xf5\x0d2{\x11\x1d\xdb\xeeY\xe1\xe8>I\x9e\xe3J\x1e\xd4\xbe\xed\xb8$\x05Pb\x8f\xb0\x8d\xc6;\x94\xda\x99\xbb\x1d\x99\xad\xa6\x0b:\xf2\xa2\xab\xe5\x19\xbd\x89o\x9dR\xe1\xe1hJ\xcf3\xe2\xfc{Q\x10\xb4]\xc8~\x1e\x17wx\xec\x8ef&\xf4\xc0\x92\xbc'\x1cG\xac\xf0\x07h\xb6\xe4I3\xa2M\x1c\x9c~\xe6N\xd9\xe8\x7f\xe6\xd2\x8b-\x01VHB\xd0]\x90\xe4\xac\"\xbcj\x05\x02)\xb7\xee\x84\xe6\x07\x12P[\xc2\xca\x04\xcc%\xa5\xb8\xe037\x86\x82\xdf\xebR@#\xdb7P\x9f\xb9\x934}\x8a\xca\x10\x14B\xaed\xaa\x14\x1e'\xcf\xb2-F\xbe\x99\xf0\x83\xb0\xcfR\xa9}\xfaFA\xb6\xdb\x8a\xcf\x95S\xa6<;j>\x10M~&?~J\xc1\xd7H\x94\x04\xed\xb5J'\xb5\x91\x9f\xc9\xadu\xad\x08\xd4i\xdb\x9d\x93\x0b=%\x7f\x98z\x9b\xbf}\x0c\xb2Ntf\x82%\x8ar\x0e2\x0d\xa7\x03D;\xad\xb1\xa0\x0f6z]\x14X{\xec\xdf-~y\xd6}\\\xf8\xc3\xe4\xea\xcd\x18&wo\xc6\xfc\x1e\xd5\x04\xf8\xf6\xea\xfc\x96\x0e\xd2\xf1\x1b\xd8\xcfKY\xc5\xc2Zx\x05\xbf9;[\x7fqv6\x06\x1fS\xc4\xaf(\xbd\x0c\xff~\xfe\xab\x93\xb403?\x11\x89\x8f\xf4,\x8c\xda\xf2\xa7\x83\xf4\xd6M/0\xcbL\xec\xa3\xf0\xa3B\xd1\xa6\x0b\x9a\xdb\xfe\x99\xdaQ\x90\x89\x1fQ\xa2\xb9]\xc7\xe8\xb9\\\x04\x1b\xf1=\xea\xbch[,\xe4O?\x87\xa3\xb9\xac\xdb\xee\x0cW\xdd\xf7O\xa3	\xdf\xcc\x08\xc7\x17\x05\xbb\xefSGT\xf7H\xfd\x97\xfc8\xa7\xbd|\xb1\xfa\xaf\x9b\xdc\xc2v\x07\x98\x8a#[\xe3\x97\x8d\xcc=\x974*e\x07\xf3ACDxT\x86\xf8\xf4\x829\n\xc4\xf2(\x95\x9e\xd7\x9c1y\x05T\xe0-\x13\xf6\xd1\x15kS\xfb\xa0D{4\x0f\xc5\xe3\xbb(t\x17\xaf\xa7\xd3+xxw\x13I\xc5R\x07\xeax\x13\xff\xea\xcb\xe5F7\x97\xed/\xc2f=\x0bE\xac\x9e\xf3\xc9'\x18I~\xbc6\x95\x95T\xf1s\xc6\xd7l\xe0bKk\xa2\xd3`\xa5p\xc1\xb5\xcf]\x85\x8aT\xaa\xd9.Z\xfdC~X+a4(=\xaaR].\xa9\x1f&\xf5b\xefbPH\xda]\x88\x9fv\x0b~\xf3\x89[\xa0\xcc\xc2p\xc6~t\x99\xc5\xd1-\x0b\xd4\x17\x8b\xf6\x07\xdd\xa9v$}\x91<II#\xf3\xacf\x1c\xd1\x9b\x9b\xd1$+\xc0o\xd3ew\xa6\xc3\xba\xa3~%\x81\xa3\x0c\xd9\xb6\xc4\x1f\xe2\xdb\xb1\x1d	\xae\x93T\xe5/\x83Gu\xb0\x8bN\xde\x9a\xbf\x91OJoc\x92\xb0\x9df\xa2\x19\x8d\xf8\x831\xca\xcb\x9a\xe8\\K\xe5-\xe6\x884ir/\xbd\xc5qK\x80\x0e:\xfd\xbb\x02\x89\x97\x1c\xc6;\xad\x8d:\xfdUK\xde\x93\x86\x8b\x00CTv\x94\xf3\xec:\xdf\xc6OK\x9d7\xe9	Xz\xdb\xd6\xd5\xb3\x8e\xdcR\xc4TO\xfbW\xd6z\xce1\xdbh\xca?`&\xd3)'qL\xde$MM\x7f\xf6\x82j`\xc3\x11d\x9b\x19\xc7\xb5t\xfe\x04\x1eL\x0c\xa5\x90\xbb\x93<\x83\xd0\x9b'\xb1\x19\xc3\xc2\x00\xbd\x8e\xe0`\xdc\xa2\x0b3\xca\xfb\x91\xebO\xb6#\x96\x9a\xff\xe9\x99+\xf1\xa7\x11\xd0k\xb7G*\x81\x89\x1bH^\x02sgeJ\xcb\xb2\xa7Z\xb7\x9a8E8\x9d~y&4\"l\x84\xde\xbd1\xa9.\xf8\x1a\xb9\x8dfE<\xf5\xc2\xc7\\\xfc\x8eT\xd3\x80\x8b\xd6\xc8\xb5N\xca\xf1\xcd\xc4\xa5\xfatzv\xc4P'\xa9F\x88\x1e\x95\x83\xd3.%\xc2C\xf9\xc5\xd3(\xc7Cz(\x84\xb1\x07n!\xc8!5E<\x99=\x9c\xd4\xb2\xa9\xe3)lZ\xe3\x18t2\x8f\x97\xcd\x96\xac\xa2\xb4\xa3\x17G\x7f}\xf1\xd7\x17\xff5\x00PK\x07\x086\xe5#\x83\x1d\x18\x00\x00mD\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x004<N]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/nl_NL/common.jsonUT\x05\x00\x01T0\xcfjtVKo\xeb\xb6\x12^;\xbfb  h\x0b\x1c\xa4\xfb\xec\xecX9u\x1e\xb6a;	Nn\xef\x82\x16\xc7\x12c\x8a\xa3\xcb\x87]\xa38\xff\xfd\x82/II\xd1\x95\x86\x9f\xbe\x19\x0e\xe7E\xfe}5)\x0e\xa4[f\x8b[\xf8\xfbj2)\xf6L)\xe4\xc5-\x14\xaf\xe5f6].\xcb%\xccW\xab\x0d\xfcrm~\x81W/]\x9b\xf8\xfd\xb3\xb86\x7f\x16\xc57\xaf\xc6Q\xa2\xed\xf5\xde\x16\x0f\xf3r3\x0f:\xf1\xbfhY\x8d\xf3\x814\xbd\x9f\x95\xe5\xd3|\xb1\xfc\x0e#~\xbf\xd1Hi\xdb\x91\x90\xa8\xa3\xed\xc5\xf3\xf4{	\xdb\xf5j\xf1Tn\xca\x7f((<\xaf\xc9X\xb3P\xbbF#\x0b*\xd7\x1c\x94\xc03\xc2\x1e\xb5\xa8\x1a\x8b\n\x84\x02K\x9d\xa8n\xe26\x9dW\x99*\xbe\xf0>\x9aU+l:\xc95\x87\xf0\xefW\xf3\x1b0\xc5\xe1\x9aCp\xc9\xaf)\xd1\x06\x0b\xff\xa2\xf8\x99\xe8t\x8d\xdc\xfb\xe8\xed\xcf\xca\xcd\xe2\xee\x8f\x1d\xbc,v\xdf\xcb\xb7\xc5v7\x9c\xe7\x9f\x116\x0d\xe3t\x9e\x89\x9c\x9e\xed\x1f\xd3\xf9\xea\x0df\x8b\xe5\xb2\x9c\xc3\xecG\x08\x03\xdc\xc7\xfc\xdc\x7fI\x8f\x0d\xe1x\xa2\xea\xb8\xa3\xba\x96\xf1x\xbb\xd5zq\xe7\xb3\xc9\x89\xf4(WN\x0dE\xf0\xb2\x9cM\xc7\x1bDs'\x1fP\xbee-.\xd6\xa3\x83\x94Kx\x9d.a^\xbe\x97O\xf7\xf3\x12\x16kx_<,aV>\x96\x8f\xe3:*\xae&?\xbf\xa5\xd23\xbe\xf2\xc2\xaa\x93N3ir%rv)n\xe1?W\x13_^\xac\x0e;\x07	Uq5\x99\xfc\xd7\xaf\x8b\x86\x9c\xeeY\xce\xe9\xc4rzLj\x85r\x16{\x9a_:\x9b\x98~a?\x91I\xd9f\xe02\xa6x\xa6zyL5X\x91\xe2=7.\xb1\xf86^\x8d\xe8\x17d\x83\xaf\x1f\x8ceg?X\xf66\x85%\x14]\x0e\x03\xe3\xadP>\xc8\xd3 \x04K\xac&\x8f\xd4(\xd1;\x14\xb1\xe0I1,)j)R\x02\xdb\xc8\xa9HY\xcd*\x1b+\xdd\xff\xbeK\x08$h\xd4\xcc\xb3\xcb\x90\xe0W\xd4g\xf1\xc1Q\x03\x93\x12\x0d\x9c\x98\x82\xc5:\xb2\xf1\xaf.\xed\xfd\"\xec^\xa3\xe8]\x8a\x7f\x86\xcd\xa6\x87=\xa2\xe4B\xd5\xa8\xc0}!7\x82\xfb\x14\x15\xdb\xaaqB\xa6\x01\x10\x8e\x90O\xfd\xc1\x94\xb0\xa4\x83\xdf\xcf(8%\xd8\x19\xbb\xa4\xb3G\x97.B\x92\xaac\xac\xf1\x1a\x8d$\x9b\x1dj\x89\xa3f\xbd\x8d\xbc\x8aJ\xb9Qo\xa1\x17#~V\x187-E\x8d*\xe7\xad0\x88S)=}\x86G\xf1q\x8c\xa1I\xff\x9a\xe8\xd0\x8eT\xde\xdb\xc49\xe6\xf9i\xa4E\xaa\x0d\x0d\xb9\xb5\xa2:\xfaz/b\x83\x82\x89@\xa085\x1c\x88\x94\xad5*\x8e2\xcd\x1e\xdf\x8b\xe3T\xbd\xa3<p\xec\xb3s!\xe7\xd5~\xfdA\xee\xb7\xdcv\xe6\xa2\xaaT\x88\xc5	\xf5^(\x9f\x13\xf0\xa2\xa6cv8\xfd\xe9\xfd\xbf\xa8\xaa\xd1\xa4\x84A\x9d\xb1\x1aG(\xea\xe4\x11\x0f(\xf2\xe2*4iaE\x8b\xb9\x9a+&Q\xf1Q\x1f<0\x95\xda\xe0\x1e\xf7IzN!\x9e\x14\xd3.K\xcf\xec\x92\xa4\x07\x975\x1e\x9cL\xd2\xd4\xe5\xf9\xb0\xc5.I\xab*\xf7\xf8\x92NI\x9ac5\xf4\xe3\x19\xf1\xd8\xfb\xf1N\xd9\xeas\xef\xd1\\$\xe1\x8drW\xcf)	\xafZ|$\xf1\x9d\xd9`4E\xd7\x89|\xd8=YK\xad\x0f\xff\x8cx\xdf\x83LU\x18\xcaf\xaa\x94\x93C0+f\x99\xa4\xda\xff\xb9KbT\x90\xc2\x8f\xed\xbb^\xef\xce\x03@-X\x04\xf6\xd9F\xea\\b\x9a\x7fn\xdb}\x80\"\x87\x94OG\xf1(\xfbR>\x08\x89;\xa2'\xa6\xeb\xf0k\x8f\xc6\xfa\x0bO\x18\xbfG\xad\x89b(\x8b\x83P\xc24\xc8\xbf\xe8\xd7D\xb5\xc4-\xa9\xfa\x93\x7fR \xff\xc0`\"\x10R\xd5\x88\xb6#m\xefHk\xd7\x85{p\x11\x00\x1f	h\x85\x91\xeeho`\x96\\\xa8\x12m\xa49O\x07\x18\xa9\x19WUhN$o`\x8e\xd0\xb1Z(\x06g\xd2\xdc\x82r@\x9d\x12\xe8\xceP\xa3d\x1cU\xba\xf6\x85:1)\xf8\x1d\xebl\xd50op\xa5>\x9c0\x16\xa1JX\xd8\xf4\x88\xd8\x85\x97\x8bP\xf5=\xf9\xca-\x1e\x11;\xe0	\x83C\x9e \x92\x99p\x9e'\xc6\xac\xb1\x98@\x7f\xe9\xf6\xaf\x91m\xd5\xb0#\xca\xf8\xfe\xf0\x1d\x97\x9a\xd9\xf7_\x9es^\x03\xf9\x8ef}\xf9|OS\x0c\xfc\xf4\x81=\xaaa\xec\xb7~\x10\xbe\n#H\xe5gE\x98\x8dp\nX\x9a{\xce\xd8gf+\x7f\xaf\x15o\xacj\xec\x99HsT\xd0\x12\xfa\x17\x11\x9dP#\xaa#\xb5\xd9\xae\xc2\xf3\xe0\xf5\xd2\x87\x0f\xa3\xd3\xf9\xc5#\x94\xddQ.\xd4[(\xd6\x1e\x01K\x90\xb1\xc4\xf3\xcf\xb1\xfe\x06\xf0.\x9a\xdf\xe3\xfa\xf7\xdd\xee)\x92\xfe\xe7(\xbd\xb8\x1e\xfc3MY\xa8\xb1\x12v\x98(\x1a\x99\x89\xd7\xd9f8\xb9F.4Vvv\x89\x83o\x93\xd6~\x0e\xc3\xfe\xd2O\xc0\x817\x1c\xe8+7\xbe\x8f2\xff\xa0\xd1\x84Pm\x92\x98\xf0N\x86	\xbd	B\xc6H\xdb\x04\xe6j\xcc\xbf\xac\xd3\xc1\xe7\x1djW\x1f\x87F\xd7\xce\xdf\x13\xc3\xbd\xb1\xc1\x1ae\xbe8\x90\xe9\x98\xa6w\xc2~\x14\x1b4>\x99\xe5_\x9dH\x0f\xe1'\xaa\x85\x82\x80\xa3/#I]On\xe8\xbc$+\xaa\xd0\xca\xab\xaeE=\x18r\xfbV\x04\x7f\xd7\xd2\x17)\xaa\xaf\xefC\xe4s\xcd\x0e\x81\x12\xc3\x05\xb1\x1co\xe0\x079\x0d\xdc\xff\x84\x86\x19\xd8#*8bgS;\xd9\xc6\xb5{\xc5\x84/\xe5\xa8=\xacon2\x89\xba\xf0\x8f\xba\xb4\xbet\x89\x9e\xa4t\xdf\xe59\x93K\xfa\x01\xa1\xc1\xbd\x05\xbf')v\xa8\xb1e\xech1\xbc\xcf\xb3\xd2\xd9W\xf8\x90\xe3\x97\x08\x8c\xab\xd6u\x92\x18\xbf\x172\x84\xe6%\xac \x0d\xbbd%`kM\xb5F\x13.\xfd\x88 \xef\xcf\xe0/\xdc\xe4t\x16\x83\xea\x97\xedSr-u\xa2*\xae&?\xaf~\xfe\x7f\x00PK\x07\x086\x08\x1d\xe7\xcf\x05\x00\x00m\x0d\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x0b>N]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/nl_NL/server.jsonUT\x05\x00\x01\xc63\xcfj\xbc|_o\x1c9\x92\xe7\xb3\xfd)\x02u\x983\xb0\x90J\xee\xee\xc1`\xae\x1f\x06\xb0\xfc\xaf5-\xc9ZI\xb6q\xdb\xdb\x0b\xb02\xa3\xb2\xa8\xca$sHf\x95\xe4\xc5|\xa0}\xba/po\xfd\xc5\x0e\xbf \x99\xc9,\xc9\xdd;w\xd7\xfbbW\xf2O0H\xc6\xff\x08\xea\xdf\x9f?[\xac\xad\xeb\xfc\xe2{\xfa\xf7\xe7\xcf\x9e-\xde\xbd\xfa\xe7\xc5\xf7\xf4\xd3\xf3g\xcf\x9e-\xce\x0c\xbaT\xd0L\xbd2\xdcR\xe0\xfb\xb08J};\xcb\x8ev\xd6:\xaa\x99Z}\xe7\x03u\x1ch\xc7\xdc6\xec\x03\xb75\xd3\xce\xa9\x86\x0d\xb1\xc1\x18=\x81\xebl\xad\xda\xc5\xf3g\xcf~\x06\xb4\xc5\xe5\xcd\xbb\xcf\xe3\xaa\x97Z\xa0\xe8V7\x11\xfc\x9e\xdd6\xadzj]M>(\x15\xa8S\x81\x9dV\xaa\xa5`\x99j\x15\xc8\x14\x13\xb5'\xdbQ`\xda[W\xb3\xa1\x15oy\xcb\x86\xb4!f# m\xd7\xf0N\x9bfBC\xb5{\xf5\xe0\xcfm\xb5\x1d\x91y%M\x846\n\x96Nm\x08\xb6K\xc8|b\xd7865\xb7\xe4+g\xdb\x96\x0d\x19\xa5\x1c\xf5\xaa\xd1F\x9155;\xa5\xcc\x11}\xe1v\xedI\xb5\x9e6\x1c(\xa8\xd5\xaaU5\xed\xd8\xad\xac\xc3\xf9h_ a\xacy\xe8\xb4\xe7	\x07c\x8dF\x8bc\x93\x96\xbe\xb5\xd6\x90j[\xa6\xde\xfa\x10Ac\xa2\xe6\xae\x80\xe4\xaa\x8d\xde\xf1\xed\xc6\xb1\xaa\xfd\x04.6SH\xedG\xb3\xd6\xde\x0d\x86\xeb\xdcI\xda\xf8\xc0\xaa&\xbb\xa6\x9a[\x0e\xda4\x146\xdc-)\x81\x8d\xcd\\\xd3\xea\x01\xb7\xcaN\x05\xeb<)\xc7\xe4\x83n\xdb\xdc\xbf,\xf0\x1ajm?\xd9v\xe8\x8a=\xa2\x8dv\xb11\x1d\xaf|\xd0N\x19\x92\x19\xb8\xbbn\xf8\xa2y{\x0c\x82\xda\xe9\x9a\xad\xef\xb9e\xe7g\xc0\x83m\xf4z\x02l4(\xa5\xa6\xf7g\xef\xe8v3t+\xa3t;\xee:\xf6Jg\x98:Gh\xab\xe6b\x08\x13\x96\xf8\xa0W\xd5&\xe0\xe6\xad\xa9\xe9\x13\x90H\xb0\xa4\xb3fRE\xbf Y\x82\x8b\x13F\xe4\xbe\x02\xeaU\xddis\xec{\xae\xf4ZsM\x1dw\xec\xa9\xd7=\x83\xfa\x9d\xd2\xcd&\x906\xc1\xd2\x83\x1d^8\xa6\xce\x1a\x1d\xac+V\xb2\xca\xd5o\xef{\xed\x1e\xc6\xd5N\xd1\x06\xaa\xdb\xa9\xb6Va\xc8t\xfcJ\x99\xa0Z\xaa\x85U\xbf\x08\xd5\x82\x97\x86=\xd3\x8a\x9d\x06\x8eF8\x11L\x06\xeeY\x81\x0b\xb5\x07\xa8\xbd\xbe\xab\xd9\xd5\x07\x0b_\xaa\xe2j\xe3\xb2F\xa9\xbc\xde\xa5R\x9d\\+\x98A\x10]\xd2\x8f\xcaP\xb0\x81\xbeyI\xe7*\xe8;\xe3\x99Z\x0e\x81\x9d\x07\xedU\xfan\x8d\x9f\xda\xd0\xb6em\xa6\xce\x15\xefT\x08l\n\x12\x10\x90\xb7:\xb4\x878\x04\x1d\xb8MH\xdc\xe2\xf7\x88E\xde.f\x1e\x91\xe1 \x1c\xf5\x02\xe41\xd0\x7f\xa7\x0be\x1a\xfbbI\xb7\x82\xe1K\n\x90%\x9eZe\x9a\xd9\xbauy\xd8\xf5C^\x8a\xef\x03\xadl\xfd \xcb\xd5\x91g\xa7i\x95\xeaC\xb5Q\xe3\xcc\xd7\xe9;q\x81S\xaa\xa1\x86Wn\xd0[l\x18\x17\x90\xa6@\xbe\xedl\x1b\xac\xd5\xe9\x86h\xc5\xbdR\x90\xbcAm\x19\x92\xc7b#\x8e\x1b\xed\x83\x13I\xceq\xc7\x1d\xfa\x05\xa1\xc4\xea\x8f\x10\xbaU\x8d?D\x8a\x02\x1a\xd3\xb6T\xe3\xa9\xd6\xa3\x88M8\x06!\x95\x8c\xe2\xb1\xed[\xeb\xbd6\x0d\x9b%\xbd\xfd\xe5?\x0c@\xc8\x94@{\xdd\xb6\xbc\xe5\xc1i\xecpk\xbf\xe4]p\xbbej\xb8a\xc3\x8e\xd9\xd5\x9c\xc1-\xe9\x13;\xd6>@\xbaw\x1a\xdc\xabZ\xfa\x0e\x94\xe8\xab\x0d\xc0\x19\xd9{\xe3q6O\xd0F\xb5Q\xee\xa6\xb2n\"\x8d\x1f\x95S\xdb\xc0\x8e|\xaf:\xf2\xd2\x97\xf8\xc2\x04-\x8d\x99J\xf6\xfa\xee\x8bn\xd2\xb1\xe1\x1eB\xa9S\x12\xaf,\xe9R\x91\x8d\x089}Wk\xd3\xe4{ou\x07\x15\xd5Y\x0e \x83\xf1Rgw\x1a\x0flF\xd0\x95\xedz\xc7\xdekk\xcey\xc7\xed\x88\xfb\xeb\xa9\x83Z\xe9\x89\x98\xbf\xe1u\x0b\xb1VL\x8c\xfd\xe0\xa5=\xaf\xbc\xad\xb6@\x84\xbdW\x0d{Z\xe3\xc2Z\xcd&x\xf2C\xdf['\x82\xbeg\x97\x86\x1c\xd7\x11\xe2\x92\x8e\xbf\x01\xe7\x87\x0dD\xddZ\x0dm\x88\x80\x8f\xe8%\xd5\xda\xabU\xcb\xbe\\\xf6\x88\x8e\xbf\xa5\xc1\xb3'k\xda\x07\xfaaX\xaf;e\xa8\xb2r.\xca\xd4\xf4\x0d\xf4\xea\xff\xa0\xe0T\xcd\xe4{\xe6Z\xd0\xf1\xfa\x0b\xcf\x8e\xc0\xf9\xd7\x8ek6A\xab\xb6\xa0\xcb\x0f\xd77T\x15\x1d\xe9\xea\xda\xd6\xee\xa9r\xd6\xfbc\xebt\xa3\x0d\xbd\xba:#\xc7\x7f\x1b\x18\xfa2X\xf2lj\xaa\xac\xddj\xf6K:k\x8cu\\\x1f\x91^\xd3?a\x87\xd0:\x00\xc25E\x00\x07\xc8|\x90\xc6\x03Dlj\xc4\xa8g\x8b4d\x84\x13\xacp\xdd\x1c\xad\x12\xa5\x13\xd5\xeb\x93#\xf2C\xb5!\xe5i\x13B\xef\xbf?9\xe1{\xd5\xf5-/+\xdb-\xe9\x9f\"8\x81\x9a\xd7+Q\x1b|\xb0\xdd\xeb\x9b\x9b\x11\xb1\x84\xcc\x9bd\xa8MD\xd7p\xcdkmtd\xb0\xd777\xc7\x8e\x1bn#O\xaf\xec.	\x8a\x86=\xb7\\\x858,l\xb8S\xf4E\xdf\x19j\xb8U5\x9b'\xd6\xbe\xb5MS\x08\xdf\xd7\x82\x13VH\xb8\xc4~\x90\x05\xe8\xb1\x9a\xbaGP\x89\xb6\xca}\xdc\x04ej%b\x1c8dP\xf8-\xd6\x9f\x1f\xfb\xa3pI\xe8\x1d\xd1^\x19\xc3\xec\x88\x1d5`4\x0d\xab&Y\xa8T\xa7c\x89\xd4\xff\x08\x81se\x9a\xa70Pj\xd4#\x10@\xbd\xda\x82\x9fV\x0f\x05\x16\x07\x87\x93vt\xae|\xb8\x1c!\xbeI,\xe4\xb8o\x1f\xa8\xb2\x83\xc9\xe6\xf5\xe5\xd0\xad\xd8\xe1x\xc0\xc8>\x0e\xd1\xec\xc9o\xec\xde\x90\xcdb\x1b\xb6&{\xac\x9c\x16X\xd2K\x10\x1a\x86\x81D\n\xd2\xa8\xd9\xe3\xa4a\x91\x17\x08\xf8\xe1&\xb5a\xd0l\xd4\xd2\xba\x86\xd4z\xc5\xdc\n\xb7~\xb1\x10w\x05\xc0\xc8\xefb\xbaM\x10cc4\xd9\x12\xcck\xbe\xe3*$+n\xad!\"\xf6:l\xec\x10HE\x13	V\x0d\xab\xee\x11\xec\xf7l\xcf\xae\x1e\xc1\x8e\xad\x99\xae\xc9\xd8@\xad\xb5[\x1az\xd0F<G\x87\xc3\xb2k\xd1\xb5\xb0\x10I\xcc\x90\xb80\xad[h\x086\x80V\x8b\x1a\x8a\x07fl\xec*O-\xee\xe7\xea\xcd\xbbGh\xa0m\xb6\xc1\xab7\xef\xe2\xf6\x1eM\xbf\xb6+\x1b\na\xe1\xd4\x1ev\xab\xa8\xca\xad\xedF\xbb\xfeS\xfe\x16\x92nX\x0d\xc1\xc2\x01\xf3\x91\xfb \xbau\xe0\xe3*\xcd\xcf\xfa]\x9b\x9a\xefeH\x82)w\xd5\xa9j\xa3\x0d\xfb#xH\x8d2\x0dm\x9d\xbe\x83\xfe\x82\xa5U\xeb\x00A;t\x8f7\xfb\xd1\xb3\x8b\xc75b|Sm\xd4\x96\xdb\x03\xd3\xc1h\x0e\xc7+\xde\xa4\xa5a\x17\xb2\xa1A\x87\xf9v\x02e\xcbQ\x99\x9a\x1d\xa9J\xae\x88\xea\xa4W_(\xd8\xbb/rsi\x8cy\xea\x94\xda\x96\x8ci\xcdD\xbe\x9f\xa2\xf1\x93UoZ\xf5C?ic\xf2\xed\xa0CI\xb5\x0c\xe3y\xa5\xdaI\x8b\xfe\xb7?\xe3\x93\x94	{+;Hp\xceG\x9fv\xea\x1a\x1d\xde4i\xa3\xfc\x06\xfa\xaeS&Y\xb1\x9d\xba\x8fV	\x0c\xc5b\"\x1b\xfa\xf6\xe5d;>a\x9bp\xa7t\xfb\xd6\xb9\x11\xb1\xb7h\xa0\xb5\x1d\x02\x9b\xb9\xe8\x94\xa1\xe0\xc9\xd8\xf9\x04\x90\x0b\xa5\xa7\x1d\n\xa0\xb4\xabw`;\x99\xff\xc4\xac+\xe5\xa7;\xff\x0cOF\xd0\x7f4\x95\xf6c\xdfSP\xac\x0b#\x14Y\x9b<\xbb\x1d;\x82e\xf1\x18X\xd1\xf9\x04\xb4\x9ba\xf5$\xb0\xaf\xc2\xf1\xc3\xaa\xb6\x9d\xd2\xb3c\xb9\xdf\xb0	JO\x90rC\x84\x92\xfb\x7fK\xe6\xf1}\xafL\xfd\xaa\xa0\x9eW\xf0\xc2\xa7\x19\x91\xfeW\x8e\xf5DH\xbf6dI\x9fy\xd5\x1d\x89\xec`C\x17W\xdf\xe1\xbf;&\x16K3Qv\xb6\xb1\xc1p\xb4\xe2_\xfe\x97\xd9\xb5\x96\xeb%]&^I\xc3f\x8b\xa49\"\x19u\x80\xe2g=\xbb\xae\xfb~vQ\xf2Y\x04\x19R\x03\xd4P\x1f N%\xb8\xb1b\xd1q\x13\x985s\xbdR\xd5V\xeef<\x96w\xa95\x92Z:\xe5\xb7\xc7\x18#a\xa2\xd1\x0e\xf1y>Lz-v\xb2\x18\x1f\x1e\x06\xbcj\xb7\xb4\x07\x06\x01\xfe\xc0\xae\xbc	\x08\xda\xdb\x87\x9e'z}\xa7[\xa6 M\x85\x15\x08{\x12\x1d|\x1f\xd8\xc0R\xf6\x93\x85u\xd77$\x01\xa6U\xb7\xa4sV;pV\x1f\x1e\xa0A\xc5\xcc\xc2\xbf\xd9\"\x86\x05\x07\xe0I\x95P\xabaIV\n\xdaX\xb47\xc8\xc9P\xd3Z\x88\x93\x87\xc9\xec\x93I\x05\xe2m\xe9X\x9dC$\x1a\xda\xb5\xaai\xc6\x93\x97\xf0N\xd4\\p2\xeb\xdcM\xb6\xcfW=;\x8a\xd6\xda\xfa\xcc\x04\xf1\xec\xa7\xe3@+\xa2\x04\xb19\xc9\x0f\xdd1\xed\xb5\xa9\xed\x1e\x87\xed\xb9\xb2\xa6\x8e\x0e\x00T\xa7@\x92\x95\xc5Y)\xe4\xae\xf4\\!\xd8t\xb0@18\xc5A \x00\x87\x8e\xcch\xc2`\x08\x1c\x00\xa6\xb3+901\x83\xa1\x89\xb5)\x96\xcd\xa8&\x13&)\xa3\xf2\xe4\xac\xab\xb8F8l\xc4\xe1=K#\xbb\x9a^\xa5\xf8\x17\x86N\nk\xa23\xa3:x\xc4\xc1\xe9\xbe\xb25{\xf0\x19\x0bA\xfa\xd9\xb9\x82Yfk\x86\xc1\xb0\xbfRa3\xed<5\x8a\xb6O\x0b\x8a\x97\x8fo\xd9\x98\xecv\x1d\x87\xc1\x8f\xa2\x16A\x8b\xda\xa9\xbdA3\xfd\x94\xfa~\xa6N\xb9\xed\xd0/\xe9t\xd0m8\xd6&\xcf\x8a1\xb4\xc1'\xa7\x04F\x8e\xe7R>6\x88\xff\xb9\x87\x0b[O\n\xf1\xbdj\xd9\xe9;\x92\xc6\x83P\xe1$M\xd8\x08\x0b\x96\xc2\xa2\x00\x0b\xe3j\xb6[1\xb7\xa8\xd6\x8e\xab`]\x8ej\xbc\xc9\xdf\x99\xf2Sh0ZgKh|\xb8w\xf2\xb5\xfb\x93|\xd7*\xa8\x95\xf2\xf0\xb7\x92\xe5\xebq\xd1'\x83w'~\xa3\x1c\x9f\xc8\xe8r\x8f\xd6\x96\x9e\xc4\xfb\xf8\x89\xae\xfc\xf1k\x06\xeaF\xd7\x10\xc2y\x1f\x9fr\xbc\xf5{z\x99`|\xca\x11\xac\"\x18\xfb\x04\x83mt\xcd\xa7\xda\x18\xaeG`?\xe8\x9a\xe9M\x8a}F\xbe\x88 \xa5\x03b#R}\xd8\xa8@\x1bH\x96\x15n EC\xa7\x1d\x02\xf4<\xfc}\xf3\xee\xb3`\xc3.q\xfc	\xc0\xb1\xa7\x93	itf\x03\xa9\xd6X\xcf\xcf\xc2\xdf\xc9A\x03m	o\x8cqt\x84\"\x06-\xe1\x87\x11,\x88A	\xb09Z\xd7\\\x0d\xce\xeb\x1d\xb7SX+\xb5\xf1.F\xb1&D\x0fp\x03\xc6\x05\xb9\x01G\x97\xa6\xae\xb1'\x9c\xc5\xa3#\xa7\x0d\xafV\x12I\xcav\xd3\x1c!	\xdfN\xf2g\x8a\xe6>B\xe3=\xf3Z\xd6\xf8\xe9fc\xf7?\xd3\xd6\xd8>*\x12m\xa8o\x95\n>\xc5\xbe\x1e\xc7|7\xd6\xe9/\x10\xe6-\xaeU\x17\x0e\xe0\x0f\xb9G\xb5\x94\xfb\xe2\xb6\xbf\xfbs\xb5\x81=l}Pm\xa3\xd5\x04MO$sfZ[\x08\xf9bL\xa7\x1a\xfe\x011\xa3q\xec)8s\xc7\xaee\xd3\x80\xb1>&\x9bbZR\xb6\xd8;\xdei\xde\xc7\xcd\x08\x1f\x94zA\xe0\x9e\x8bh\xcep\xcf\xd0\xf4\x1b\xe2Z\xa6\xc1\xa9 \x95\xbcLHdH-c\xe3\xd4\x82Ce\xf0\xb5\xb5\xe1\xc3\x8e\x9d\xd3\x85,z51\xa6\xb36\x941\xb1\xf1\x0c^\xb5\x9e\x86\x99 \x1ack\xd0\x8b\x8e\xa9\xb5\x95\x84-c \x13W\xfa\xfa\xcd%\\\xb6@\x1b(HsDHD\x05\x1ah\xa3\xd9\x89\x83\xb2\xb3m\xcb\xb5nX\x16>V\xb5\x93\xed,\xe9\x1c\x89\xa3\x96\xb9\x81\xad\x81\x91\x93\xbb\x1e\x07\x85)@b\x96\x04oL0\xfb\x9e^\xe4`\x8c\xec\xd7/;n\x86J\xc1Z|A\x07\x871\x0f/N\xa7\xc0\xe6?\x11b\x8c\xb4Y\x1e\xc38\xffw\x08,\xeanf\x01\x9eu\x07\x16\xe0Yw`\x01\x02\xabG\x06\xa06Pn\xef\n*{L\xad\xa7 \xde\xf9EC\x0c\xe9\x9c\x04\xd8\xb1i5\x98\xa3\x1e\xc5\x06\xf4s\x8c\xb8\xca\x18\xc9\x1d*\xb9\xdc\x06\x0e\x94$\x14\x91f\x84\x1f4\xa3\xfa\xbf\xd5\x93\xc3p\xf6\xcfoN\x13\nh\xff-\xf3\xfeNI\x1ee\x921\x7f\xcd\x0dG\xe5\xe7\xe8\xc1\x9e\xbd\xf1K\xcach;\x183)\x90\xact\xc7,\xc9,\xa6\xdb\x96\x91\xa5\xdb)\x9e\xf4\x89\x9dP~\xb4\x86\xd6\xaaB8\xbb\xcc\x95\xb6\xdal'\xfc\xde\xde\x07vH\x87Hk\xd6\xf8\xbc\xb3\x08\x9e\x1f\xd1\xa8\xdf\xa0I\xd68YQ\x02\x9cf\xfd\xe5/\x7f9\xf9c\xb5Q\xe6\xe48<\xf4L\x8e\xd7\xb0\xffa\xecO\x0bv\xcaWv\xba\xdb\x8b\xf8\x99\x97\x12w4\x0e\x81\xc5\xd6\xb7\xea\xe1p\xaa\xc8\x9cq\xfe\xe2\xe8\x80/&\x8e\x1b\x9d\xeb\xb4d\x01'\x0cE\xc8\xfe\x93m\xf7\xca\x83K\xb4\xd9\xd8!\xbb\xa71]\x0doa\x16\\\x17\xcbc\x8c\x9dP\x10\xf9\xe3\x85\xa6\x98\x9d\xb8\x19\x0c\xcb!\x01\x836\x14\xbe\xd9\x8d\xabls\x8a@\x9c\xf6\x12\xad\xfb\x1f$\x9c0bV\xecj#=\x895\x13\x86\x1796\x90:\xc1N\x0d\xff\xf2\xbf\xfb\xd6\xaa9w\xcc\x16\xb9z\xf3\xeeF\x7f\x99\xf6\x7f\xa1\xee\xc5_D\x8c\xbc\x84<t\xd2\x04\x19>\x86\xa2\xc0b\x17\xa7\xc9\xa4\x96\x18\xbc\xea\x11l\x84\xd9\x1d]\x15\x19\x17'\x1e\n\xf7N\xdd_\xc7\xb8\xe3\xb86\xbe\x1ff\n\xe4rn\xe7\x1f\x91Z\xc3o\xd9ot\xb5I\xfa\xc3\x93\x0fbb\xe3\xbaWC\xd7\xf3\xa8R\xcaL\x82]#h\xf2r\x8e\xc0l\xe7%\xd58kC\xe0\xaf\x1cp\xee\xb5\xdd\xecX!\xe0\x079m!\x1e\xba8\x9d\xad%\xd9\xd9s6Ma\xfd\xe2\xb0c\x8c\x12\xba8l\x0e\x0e<6\x02\xf5\x047%}}\xe1^\xe5\xbd\x0e\x06\xc8\xce\x93\xe1\x9d\xba\xff\xac\xeb\xb0yj\x8b{t|e\x83\xb1\xef?E@\xdc\x0c\xb7\x9f\xa6\xfd\xc4\xcf\xe4%\xf5\xccm\x99\x87\x83\xf3\xed\xea\x9cy\xder\xdc\xcc\x0b\x9f<s\xe8\xf0V= 5\xddr\x91n\x8ek\xdcl\xac\x0b\xd5\x10~\xef\xb5\x82\xd3\x95\xbf\xb5\xdb\xc2\xb6\xbf\x88\x8d\x14\xa45\x15\x8b\xb0r\x08\x1d\xa0I\xf2,\xda\xc1\x1d\xb7\x90\xde\xec=]9\xdbq\xd8\xf0\xe0\xc1\xf3\x80I*\xd0I\xfa\xbd\xa4\xb79\x14\x90<Q\xa1U6uo\xb5)M\xa0\xa9\xecaBgj:\x9a7\xccU\xc74\xee@y\xcc\xf5\xc6Q\x0ecCi\xa6\x88&\xd4cn\x95\x9c\xbd\xaf6\x12t\x8a9\x8d\xcd`D;Mh\x9a2)\x7fy\x90\x8e\x87\x81\xae`\xc1\xce\xe2Ip\x14\x91\xacL\x91$\x84\x91E\xb5\x14v\xb9\xe1=\x82\x86\x181\xee\xfeR\xf3\x9e\x8b\x10a:\x83\xf9$\xe4\xc0\x8b	\xc5\xaai\xb8\x84q\xe7\xf8\x14\x10\xec\x9b\xa1ou\xa5\x02\x8bz\x99\x8e\xfe\xd2R\x9d\xbb\x92);OC\xc4\xb6\xa3\xc23S-\x84\xd4\x03\x0c\xe8t\xa6\x08F\xc8u{\xd5\xe5J\x99r\xf1\xa0\xab\xe2,m\xd0AgI\xfc#,\xa6\xa2\xd6	\x9e\x9f\xb5\xb5\xaf6\xaa\x8f\x91-\x95\xaa\x00\xa4Jh\x0eu\x0d\xb4u\x11\xdfx\xc3~\x0b\xd1y9v\x8e\xdb\xf9`\xc2\x0eA\xfd\xd5\xe0X\x0d\xa8(\xeaF1\x97s^5\x7f)\xf2\xf3\x95\x86-'\xc5<Pp\xfe\xc1T{\x15\xaa\x0d50\xacV\xdc\xc0\xc5-T\x8f\xb1\xfb\xabV=\x94.\xd0\xe5@+\xc0vB-\xd0\xa1\xc2\x9e\xd9\x9e\xbf@.#\x80\xcf\x91\xc9\x85\x8d\x8e\xe2\x8a\xa1\xeb\xa0 zrp:\xe9\x84\x90\x07\xb2kB\xd6\x96rV\x80S\x0eh:\xb9\xa7\xa2\x81\x05=\xdb\xb6~Dy\x1f\x86\xfa\xd7\xe8\xce&\x1f\xe5\xf5\xafT8\xa0\x91><\xe1\xab\\\xa8\xbe\x87\xfe\x82\x1d\x05g\x19\xc2&\x95\x1aX\xc4i\x10P\x14\x92\xb1\xed\x80;\x8cI:\xa6\xa9\x8cG\xa6\x95;\xd8\x1b.\x84\xc6[D|\x95\x1a\x8d\xcd\xfc=\x17\x19\xe3\xa8\xec-O\xdeNN\xf3 \xbd\x93\x1cp\x1f\x06\xa7M\x83\x14\xae\xdc\x8c\x84\x10q)\xc8\xd5@\xfe\xa0\x1e\x0dl.\xb64\xfd\xf2\x1f\xa8\xd0x\"7\xd1\x1f\x9e\xf4\xa3\xe4\xc0\xb4-H\xa5\xd3\xc1\x15\xfc\x0df\x07\x9d\xfa\xa7m\x06$\xf0\x95\x1c\xd9\x98\x0d\xcf\xc1Sm\xe8o\x83\xae\xb6\x08\xd9V\xa9\x92a\x85\x98\x1f\xb8\x92\xc9\xa9d\x01\x041k\xf4ah\xe9\x9br\x0b\xd6\x87\xd7\xd6\xb6\xb5\xddO\xfc%\x98U\xb95\x111\xeaJ\x86\x8e\x02b\xa5E\x90t\xc5a\x9f%\x84\xa4\\G\x01qv\x15/[\xfb\\\xcf\xf4\x95\x08\xa6\xe0\x906;\xf7\x13\x81H<\x06P\x8c\xea\x9e,DY\xe9\xbb\x83\x0c\x1cg\x19\x99E\xf6\xef\xe0%\x02\xe9\xb7\xb5\x0e\x9f%l<?:F\x1a1\xc6\x93\xa7\xd3\x1b\x02\xa2\x97b\x05\xe6\x9b=\xa2Z\x081\x99\x85\x8a\xaa\xd6zN\xa1f\x84\x84W,\xb0$\x1d\x1b6\xa4\x83\xa7Ls_\x8d\x07\x03\xb13q>\xdfJZfD-6\x8eY\x96\\\x82\x93\x8ehk\xfb\x9e\xdb\xc97M\xa3\x1b\x8e\x1d\xa9@+*U\x9a\xfcQ\xb8X\xe0\x9em\xab\xb7K\xfa\x9c$\xacdV \xdc\xb7\xdc\xa2tL\xedt\xc31\x1e\x01^N%\x14#\xe4|Ks\xb2\xbcV\xe1 N#\x87;Q\xf7\x81\xd1)\xf8=f\x1a\xc4\x99;9~Z;\xdb\xa5\x88\xfb\xaf\x1e\x1f\xbb\xb37\x93\x04\xc2\xaa\xec`7\xa4\x05\x11=#\x05\xc0\xc7\xa9\xde \x96\xa7\xda\xc1\x13l\x0d(%vd\x10\xfb\x0e\x96XU\x9bd\x94\x14\x1bDE\xe9A:9%\xe3\x07\x1d\xf6\x1a\x8e\\Zn\n\xc7\xa6T2\xcc\x92\xcbT\x99\xd8\x8c\xb4>^	\x9b)d\xb8)5\xb4\xacyX\xf8zk{]\xfd\xda\xa2!\x0e\xc0\xa2\x0d\xd7\x83\xd4\xf6\xce\x97\xff\xcdu\x1f\x86\xf10oZ\x0e\xa9\xc6 \xe7*c1\x1f\x02\xb5\x13\xaan\x85\xcc\xc18\xeb\x9a\xeb\x93\xd3v`\xba\x9dj\xad\xafm\x0d\xc3\x93V\xad\x821\x14x\xeb\x83D3%%&\xc1_Q\xf4(z\xee\x1b\x96\x8c9LZz\xf1o\xee\x05\xf4\xd5\x8b\x7f[\xbd(VdU\xcf\xe5\xf35n\xf6i\xf9|\xfd\xf6\xe66\xd5N\xa9\xfaw\x95\xcf@\xeb\x83)b\xcdH\x9b2\n\xd7\xbe\xb09\xc8\xea\xcc\x85\xe0WR7\x00\xf8\x98\xb3d\xaf_\xe5\xac\xafl\xf7\x1f\xe4\xacXoY\x84s\xaf\xa5\xc1\x15\xc9\xd5r\xdf\xad\n\xa8\xd3\xd6\x85}~-\x8d0\x1e\x11\xaf\xf0\x81\xbb\x9e\xc7\xea\xb6\xa93\xed|6hI\xbb\xd5\xf2{z\xf1\x0d\x0d\x03J\x9eZ\x98Y\xb3\xeb\xefYM\xe7\xf1\x03\xbb\xcd\x14~\x9a\x0dk\xd5\xc3\xe9\xb0^\xb3\x9b\xf9\xe0\xd7\xd2A+\xe9)c\x10\x93Rw\\\xb1	)\x03\x82\xb3\x13\xad(5L\xea!\xfa`\xb9\xdc\xd0!\x03i\xb8B0\x1d\"d\n9\xbfN#\xc4N\xef\xc0\xb15u\xd61u\x83\xd4C\x89\xcb\x0b\"K\x13\xe6\xa8?\\\xcf\x023?\x01\xeb\x87\x9f\xc5j\xbd\xe6j\x13\xf2Y~b\x97\xf2\x019\xe7 \xb9\x82d\xa3;\x0ce\xb7U&\xd0\xeei\xb3\xddY\x1b>^\x9f\x8f\xe7\x89X8\xa1\xe1h\xf6\x99\xa7\x8b\xf7\x91\x8c\x84\\\xcb*a/o\xbb\x0eF\x9c\x0c \xcf\xa8\xde?\xee\x9d\x85\x03\xee\xc6\xd7\x0c\xec\xb6\xb30\xa2\x1b\xda\xc2\xf7\xb9\x96\x02\xbe\xb4\xf2i:s4a\xfa,\x8f\x9e#\xafR\xf2\x87\xd7\x18\xaa\xa5hf\x17\x92\xdb\x97q\xbb\x1b|$\xc8C\xd7\xe3tD^\x8a8\x83^\xcc\x89\xfb\xf1\xb0\xc6\xf2\xee'\xec\x96\xa4d\x8b\x9dx\xd5NDy\x83\\\x11\xbc\xaf6\xcb\xc0\x1b\xd5\xa6\xca\xe2\xf84\x84\xc7D.$cg\x18%\xf0\x15I\xc90\x98cI\xaf\x94Y\xf1\xce\xe2UFY,\xfc\xc7\xb1\x04\x07!X\x04\x84\x90,\x9b!2Tl\xd4T\xd2v\x83\x86K\x95\xab\xd9r\xff\xb2\x9a\x05\x97\x1ee\x1f}4W\x0f\x8a\xf0_%[^z\x19\xc1a\x1f\x9c\xbe\x9b\xa2\x16\xb7\xfaN\xcc\xde\xa8u\x82\x0d\x88_\xe6\x10\xa9\xf3\xc9\x17\xf04\x96\x86U\x9b\xec\xe1\xa9u\xc3\xf0\x01\x8b\xbd\xf4V\xb7\xa5\x8fQ\x04\xd0nr_:\xe0\xf89\x0f\x97\xcdU\x95\x0fj\xbd\x9e\x17\xd6\xdf\xa0\x89n\xcb\xc2z\xd4.\x808\xc6\x02\xfba\x0f\x19\xe0\xad\x81_\x98|\xba\xd1\x12+p\x1dV\x86\x9f0\x85n\xa4\xbd\x10\xd9\x93\xe5\xf6j\x0ceN\xbd\xe2\x96\xed7\xb6e:\xf9\xf6\x8ftv\xb5\xfb#rIt\xf2'\xf9\xf8\x13\xca\x83\x0c\x87\xd9\xd3\x16mj\xbd\xd3\xf5\xa0Z:\xbb*B\xdf\xa1\xac\xd4\xb8-J\xdfA\xf8^\x84\x8f\xb024/\xcaA\xb8\xa6!\x05\xef\x8b\xce1s\xbe\xa4s\xbbgW\xa9\xe9q\xc3Q\xca\xbby\xc1\xb1V~\x83z=\x142Z\xfa\xf6%U\x1b\xe5T\x85\x81b[-\xe9U\xa0\x0e\xc6\xdf\x9f\x0b\x82\x0d|\x1ff\x8a\x13\xa6C\xca>,\x8efU\x99)w\x83\n$\x89V\x96{\xddp\xa1\x7fn\x8b\x1a\xdb\x1fQ\xfb\xf3\xfa\xe6\x06[\xea\n\xc9\x17\xe5n\xa4\xef\x0bu?\xce\x1dc\x94Q>\xc4\x07'\xa2\xa2\xfeo\x1f\x9cD@_yq2CCO\xae\xdd\x856\xff\xf5h\xcc\x1eMD\x03\xb4|3\xf1\nnmRXB\xa8+\xa4w\x9a\x06U\xe6f\xb4\xe8\x0f\x81\xfa+vW\xa5,\xceo\xaf\xa0Z{\xd5<V\xc0y\x85\x94\x91\x89\xe0AA\x99^Q0\x1a\xa7Nk\xcd\x18\xfb\xf1\x8b\x99\x18\xd8Z\xc1\xd9q\xfan\xc7f\xc6\xe4\xa3\xb0/\x00Jr(\xc9\x95_\x91@\xf3\xaa\xf0$\xb2\x92\xa0\xb0=\x9b\xec\xbe\x15\x15,\xd3\"\x91\x8e\xe7\xe6\xecGi\xfb\x8aA+\xd9\x8e8\xebw5g\xe3\x12\x8f\xc5YBn\x12X\x07\x16\xe8\x93\xf8\xfd\x83\xf6\xe7\x80\x02\xde\xf7\xe3yH\xc8\xa8|\xb76?\xf1Xi\x98\x1e-\x16\xaf\xd7\x8a\xbd\x08\xbc\xdf\xca\xe3\xc5\x07\x97S6\x0f~I\x01\xae\x90X\x12uLD\xfc\xc1\x88i6\xc2=\xe5\xad\xbe\xdbf_\x0cA\xc3\x94\x81J\xb2\xa3P{\xc1\xf6\x15\x1e\xa3\xe4|\xa7XmMz\xe9Yk_\x0d\xdek\x9e\x01\x99P\xe0U7/\xb9\xf8\xcc\xab\x0b\x92\xa62p\x90\x96E\xe7\xf1.\xd7\x03\xb0)\xdc/\xdb\xd3\x06\xb3&{N\x0e*\x81*R\xe1\xf1\xd9A\n\x15\x94\xa7\xb1Q\x01/\xcd&\xfe\xfe<\xb6`@\x1c\xa10b\xd9(\x92\x1fxq\xcal\xfc\xc6\x86G\x86\xc7\x03\xe2\xa9\x938\xfe\x9f\xf1\xf3\xa8\xf8\x98\x19\x99\x05\"\xd6mg\xe5d\x9f\xad\xdb\xca\xab\xce4{\xac\xee)m\x04d\x1cXUpa\x10\xf7\x18M\x95\xa7I)\xafq\xf0F\xe43\xff\xff\\\xe9\xf9\xb3\xbf\x1f=\x7f\xb6\xb0=\xe2l\xe3\xd3f\x13K\xc7\x17\xf0\xe4eC\x8bx\x88h[\xeb\x00q\x9c\x1a\xa4s\x9f\xd2r\xb9/~'\xd0\xde\xba\x80\xa3\xca\xe6A\xb4\x89G\x1d\xb78G\x89Q\xe0\xf4\xb2cj\x7f\xcfX\xc1\xd5ESz~Y\x92yn\x1b\xd5\xf5\xe2\xb9\x1c\xdf\"\xa8\xb1\xfei\xf1^L\xdd\xe4\xb9-n\xc2C\xaa\x8c+Y\xf2_\xe2\x93\x0di\xber)\x8b\x88\xa8\x8e\xe4\xe9\xfc\x08\x96\xbb^\xde\x99\xe4\xa3Z)3S8\x1fi\x05\x87\x0eeV\x88{\x19\x91\xc7'\x7f\xf0'1\xd9\xf4\x87\xf4\xea\x00/\xbe\xa5<\xa3\xc6\xcek6\xdf'\xc2\xf9\xb8\xa7\x952\x92\xfc\xa3/\xe2j\xa0&UL\xf3\x1e\xb3\xed\x1a\xf2\xfe\x0f~\x99	M\xa0 \xb1\xf5\x85sY\xb7\xf6\xb0$\xcf\xaedXq\xcb\x83\xceX\xc7\xb7\xeb\xc5\xb3u\x81\xb6H&3\xba\xb2\xf5\x0dM\"\n\xaeSF\xc1hN\x0fo\x16\xaaF\x14q*\xa1H\xad}\x94Ph\xef\xd5\x18\xb0Z(\xefuc\xc4\x06F\xaf\xd8\xc7\x88\xc2\xa3l%\x8fY)!\xb0O\xf9\xe8\xc6V\xc3\xee\xa6\xe7\xca'\xbc\xb8Gr&L\xef\x07\xbe}I\x7f\xbdz\xfb\xfe\xf8\x88\xae.\xf1\xef\xfb\xb3w\xc78\xa8\xcfoO/\x8e'C\x0eq\x9e8G\x8a!;y\xa3\x9dn\xe8\xbb\x97/\xef\xbfy\xf9\xf2h\x1a\x90\xe6\xf9\x9c\x1f\xc7(<T\xd8\x9e\xe2L\xc0\x19\x88\x18\x0c\xba^&<!\x98\xe5\xf1\xd8\xa2|\x1b\x8a\xc6\xf2\x9d*\xdeU\x9a\x86\x8b|\xccT\xc8r\x90\x8fYT-\x8e\xad\x02\x88\xfc3\xb5\xb3\x82\x99\x80\xc4\x96\x1bt~\x14\xb3\xa8\xacY\xebfp1\x86\x88\x01\xf1\xea\xc6v\xf7h\xe4\x8d<(\xc0\xd0\xf8\xeb\xa9\xb1\x88\x9eN /\x94\xda\xa6\xb7\xcd*#\x8arU\xf4\xbd\xc1\xff\xe9UX\xcb\xc5\xa42\\9\xcd\x921YW\x16c&\xa1Y\x02Ky\xd0\x12V2q\xe2\xa0\xc1\xe5\x84\xe0\xe2\xdc\xda~\x12\x1b\x0c\xbb[8\x16sw\xd9S\x1e\xcb\xf1\x81y.\xc2O=C\xb5\xfd\x10)\xf5\xdd\xc7\xd7?\xd2\x87w\xefbG\xac\x03\xc1\x84\xf7\xf8\x95E\x8a\x94..\xce\xde\xe4/<\xb1\x0cB\x0fg\xf17'\xe3e\xa1\xe5\xf5\xe4\x8d\xeet\x1b\xaf\xf0L\x1a\xc8\xc7\x96l\x8e\xa6\xc1m\xcb\x8d\xd4\xa9/\xce\xe4\xa7jS\xe9M\xec\x87\xed\x96i\x0bd\xd6\xea\xf86 g(`\x82\xa9\\B(\xa3\xb5i\x9e\xe2$\\\xe7SL\xf3\x9b,\xf3\xdd\xff\x13\xcb\xb4\xb6\xb1R\x03\x812\xb8\xa2\xe23u\xc4\xb7#\x8b!\xf7\xc9\xaap\xd6\x90\xc1R0\x0b\xd3p3\xa6|\xe3\xf5\x17)\xe08`Ro\x8b\x0fR\x9e\x97\x9a\xf7\xe6\xd2\x8e\xf1\xf6\xc5_\x11-\xff\xa2C\n`\xc7\xf61\xfe\x8f\x11B\x82\xb1ep\xcdH\x91\x88\xcf\x9cLD{\x107_\xc4\x08\xd5\xad\xb5m\xd0=\xc0\xbc\xd3m\xe0\xf1\xae\x91\x1f\xf3\xc3\n\x19\xf7\xa3\xf8\xf8\xdd\xe60 \n\x11\x88\x0d\xdc`\x89t\xe0\x99\x88o\xc5\xf5]R\xbc?O\xd7\xdc\x0c \x1d\xbe\xcf/\x9c\x93rXx\x0e\xa7\x90\xa4\x12\xd5X\xfc\x0b\xfc\x8e\xf4\x99\xf0\n\xe7\x91&r\xb7P\x0b\xfe0\x03\x9e\x8b\x8f\xa7\xe77*f\xb3\xf2\xaf\xa2\xf5T\xdc\xc8\xc5\x8d|\xd0J\xa7\x1bI\xe4\x9c\x0cWLM?'B\x97\xbf\xc8\xe0\xfcXR\xc0\xf7\xda\x07\xfc\xe1\x80\xe8\xdc\xb1t\xa73\"e\x1e\xf6\xea\xe1\x88\x1a+g !\x00\xc7~Xu\xb0F\xf0\x1c\xc3\xba.>'\xf8\xd7\xaf\xb0\xd4\xbf.\xa8\xdap\xb5\xe5L}\xd90\x01v7\xa9xs\xb4\xa5\xd3\x90\x18\xe9\x19ETr\xc3\x1e	\xa8t}\x80\xf4\x01\xce\xf1\x9e]\x86\xf0`D~\xbfN\xa1[\xe4\xeeU\x18\xf2\x0d<\x98\xea5\xd4,\x86|4\x9a\xb7b8\xc0\xcf7\x04C\x91\xd7'\xc1J\x15\xf5\xd9\x15l\xf6\x90%N\x8a\xb7L\xa1\x16\x89m\x00\xcc\x98\x0dY ++-\xa3$D\xe9\xa2\xb4\xe0\x7f\x995\x98\xa4u?\x9a\x952\x8b\xe7\xcf\xfe\xfe\xfc\xef\xcf\xff\xcf\x00PK\x07\x08\xbfY\xa6\"\xb5\x19\x00\x00\xebF\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x004<N]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/pl_PL/common.jsonUT\x05\x00\x01T0\xcfj\x8cW\xcd\x8e\xdbF\x12>k\x9e\xa2@`\x90\x040&w\xdf\xe6G\xb6\xb5\xd6H\xc2H\xce \xde\xec\xa1\xc4\xae\xa1zHvq\xbb\x9bf\xc8\xc0\x97A\x06y\x86]?\x86o\x81o\x1e\xbe\xd7\xa2\x7fHj\xbc\x08\x90\x93XU_uWu\xd5W\xdd\xfa\xedd\x96\xdc\xb1.\xd1&/\xe1\xb7\x93\xd9,\xd9\xa3R$\x92\x97\x90\\\x9c\xafV\xf3+\xb8\xf8\x19\xbe;5\xdf\xc1\xab\xf5\x0d\x9c\x1a\xff\xf3Krj~I\x92\x17\x0e/\xa8 \x1b\x1c\xae\xe6\xcb\xf9n\xf2\x08vYbFW\x13hq}\xfez\x0e\x7f\x0d\xddV,\x0b\xd2\xc7\xe0\xedf\xbdX\xceo\xbe\x85+j6l\xacY\xa8\xddA\x13z\x87S\x01\x8a\x1a\xa8\x9c\x1e\xa4\x02\xeb-ga}\xaf=Wb\xe1B2\xebR\xda\x18\xf8\xa9\x08\x1e\xdf\x9b\x1f\x00\x95\x80S\x01>\x16's\x84M+\xfc\x85\xe3s`\xad3\x12.<\xb7\xfef\xbd\xdd\xc1\xe6\xdd\xcd\xeb)\x85\xff;Gs@\xc1\xcd\x85\x1cN\x7f\xfb\xe6\xfcj}\x0b\x17\x8b\xbfS\x84\x90\xe6\x92\xd3|\xc7YV\x84\xacvon\xe6\xe7W\xaef\xcfN\xb9VS\x89\xdf\xad\x9e\x179\xac\xf6ARCb\x8b%-6C\xf8[X\xbf\x82\xdd\x9b9l\xcf\xaf\xe7\xb0\xd8\xc0\xed\xfcf\x0e?-\xe6\xb7St\xc9\xc9\xec\xe3\x8b\xd8P\xc6\xf5\x93\x97\xaa\xa2\xd6X\x98\xa1\xbf\x04\xb6\xc9K\xf8\xe7\xc9\xcc\xf5N'\xa9\xff\xddo:K\x84\x92\xc9\xc9l\xf6/'%\x07\xae\xf5\x08\xcbXtR=\xfd'\x02\x838aK\xa9jK#\xda\x8b#\xd8KGXV\xf6p\x04%#\x9f\x1e\xd3\x11\x1bD\x9a\xf0\x86RVbt\x88b\x80\x07\xc1L\xe0\x96p\nZs\x1eq\x05\x86\x00\xe2\xe9\xf8N\x1bN\x03E)\x95;\xe3s\xff\xe1\x1c\x12\xcc\xd8i,\x95uT\xf8\x08\x129H\x1c<\x14\xab\xb6\xe4\xda\x04u\xca\xcajLmhn\x07\xb8\x8c\x1a\x88\xaa#\xba^\xb4Su\x037\x01\x8b\x02\xf6-,6\x01G\xbfVq\xdb\xb5m\xbe~\xd6\xdd\xb1z\xdac\xeea\xcfv8H\xe1\x8a\x91\xbc\xcbu{\x1fg\x80\x0f\xb8	\xc2=*iY\xfb\x10\xafI\n\x8e\xea\xda\xd8\x157N[\xe9\x8e\x04\xa4\x87F\x16O\x8f\xc1Zp\x9a\x87\xae\x8e_\xb1\x9a\x824\x8e\x8b\x0dR0\x0e|t\x0br\xe9\xfa\xc7\xc6\xad\xb8Q\x14\xf6\xbf`\xd4\x02\xd6N\x8e,$:/\n\xe7\xb3\xe1\x1c\xfb/\xd0\x98\xae56\x974\xb04\x84\x18\xacQ\x17&\x96s\xdaV|_\x0ckYO\xc5\xad\x95i\xeeZ>	\xd4\x04\x13\x14\x91\x8bSb\xe3\xf7\xc8\xc1\xe3*\xb9z\x8d\xc5i\xb9v\x1e\xdf\xef\xda\x1f\x06\xce\x99V\xa5\xb1\xf7\x12\x16\xfd\xc3\xd3c\xda\xb1\x8a	[\xdd \x04\x1d\xa91\x95V\xa5\x07\xcdJv\x98\xdec\x00v\x93\x92\x1b\x1c\xdc\xf7\x1asx\x06wLuDM\xac,i\xe8\xe5\x14\x0bR\xe2\x88\x02[\xdb\xa6\xddD\xf0em\xdbH\x89k\xd4\x1d\x0d\xb4{\xdbHJ\x8f\x06\xc15\xdeG\xcbeG\xda\x19\xa3\xb8\x94\xd5$l%\xe9\xea\xc8\xebVwd\x8e\xe4\x0d\xf6\x7f\x8aN\x92Vr \xe2R\x1a\xcb\x15\x0e\xfc}\xad\xeb8\x80F\x067D\xf9\x18\xfeJ\x92\xb3\x17\x18\xf1\x1bV^\x83\xfd\x03\x0dK\xdeZ\xd6\xa3\xd0\xffW\xb3\x18\xd0\x97]\x83\xda\x8e\xb6\x8d|z\x9c\xa4-\xef\xd9\xe2\xf1T\xa8\xe5p\x8c{\xb6\x96KW\xdf\x15\x82\xf8\xfa\xb9\x7f\x88\x04G\x95\x92o\xcdK\xbeS2R+E\x8b\x05gN\xfd6~\x06t!\xddep999\x05X\x86\xb8\xce\xf14p,8\x1a\x05{/\x07\x00+W\xdfdQV\xac\xad\xeb	I\xd0a\xce\xfd\xef\xae\xbf\x08L\x9d\xa7d\xa8<\x83\xad\xd5\xac\x10:6\xd6\xa3,i\xec\x80E\xff\xa9\x91\xd4\x7fa\x15{\xecN\x16\xb4c^\xa2\xce\xfc\xd2N\x06\xcb\x0c\x85\xd7D\x8c\x92\xe6\x10\x08\xff~\xdc-\xb6c\xc6\x9c\x15\xb4e\x15\xb2.d\xae\xe4\xfd\x0b\xe8\xbf\xd0\xbe\x85\xa65]\x9d\xe3\xd3\x1fPI6\xa4\xf2x\x13$\xd2gp\xc9Z\xd7\x95\x9dR\x82;\x94\x05\x893x\xe5\xc2H\xa39\xbe\\\x1c\xe0\xea\xd9	\x80\xa9\xd3\x94\x8c\xb9\xab\x8b3\xd8\x1d\x08*\xcc\x08\x1aY\x14\xa0\xb8\x01M\x05\x8f\xcf\x0d\xa9>`!\xc5%V6=\xa0[c%\xa9\xd2\xd8H\xd1?p\x83\x90F\x8b\xdf-'\xaa\xfc(\x96*{\xc5\x8eD\xc9[\xa2\nD\xd4\xc1\xdd0\xd8\n4>\x81\xb5\xb1hU\xbc\x15\xdc\x1c\x99\x9eAq\xd4\x84g\x018\xdb\x84\"\xb1\xe3\x8b\xb1\xc7\xfeA\xc6R\xff	\x14\x82\xc1\xb2-Ap\x11\x07D\xe9\x86\xf3O\xd2HV\xc3C\xc6\xcfk\xf8\xe0u\x11T\x1b{\x8d6u\x97j\xb2a\x81\x8a\xe0\x80\xa6\x7f@(k\xd3==\xc2\xbe}\xfa\x03,\xe6\x92\xdc\x0eqmE\xcd\x14\xed\x8a\x9b\x16,\xb9Gh|eIew|9\xb5\xf6\xc6i\\\xf3\x0e\xba\xe95\x16\xaf\x1e?\x93\x8d5?\x06\xf9\xc7\xddn\x19@\xff\xae9\xbe\xf2\xde\xbb\xce\xec\x1f\\\xb6\x1d\xa6\xad\xef\xe60\x90\x12Mh\xc2\xadz\x13\xbe\xa2VHM\xa9\xbdh\xc3\x0c\xbe\x89\xf2\xb7w\xe5\x84\x9br\xfa\x16\x1bJ1\xac{\xa7\xc9\xf8\x13[\x0f\xf4\x18,U\xe1\xef\x8a\xb5\xa8\xb8\x91$\xfa?G\x03k_\x82\xf7Y\xff\xc0\xfd\xa7Amk\xed\xe3\xdep\xa3\xbf~\x8e\x07\xa8\xeb\x82\xfc\xfd\xf6\x1e\x0d\x8a\x98\xa3!\xd4\xa1L\xb7\x81#q\x82\x182\xae\x9c\xf3_+\x19_\xddK\xce\xa4\x82\xa8\x07\x8a\x86\xf1\xfe[\xb1\x95)\xc5M%\n.\xe5\xd1\xadR\xefK\x19\x02E\xdbH\xd2c\n\xd3\x1b\x95\xc4\x95\xc6;\x0f\xdaM\x1d\xeaH\xf83\xd7\x1a\x843\xba&\x82=\x91\x82\x9c*\x1b\x19e\x0fu\xb9W(\x0b\x19\xb8\x7f-\x95D[\xeb\xd6\xdfV\x92\xce\xce\x06 Wq\x80f_?\xeba\x04\xd8\xb6\x8a\x8e\xf1+\xde\xc1\xc3\xb4\x19\xbb\x1cM\x07J\xd24\xe7Z\xff\xff`\xc07\xae\xdf\xa7R\xbf\x0b\x8ag%\xae+7\x08\xdcL\xf1\x08/\x81\x9btq\x0d\xaf\xd8h\xce4\x193\xbcy\x1ck\x14\x8fI\xb8gx\x8cw\xf8\xf4\xce\xdfl\x7f{\xbc\xf9\xc9\xec\xe3\xc9\xc7\xff\x0d\x00PK\x07\x08\xa0\xbc\x8a[\x1c\x06\x00\x00\xd5\x0d\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x0b>N]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/pl_PL/server.jsonUT\x05\x00\x01\xc63\xcfj\xbc{\xdfn$\xb7\xd1\xef\xb5\xf6)\ns\xe0\xb3@0;\xd2\xdaF\x90\xb3\x17\x01V\xd2j\xadx%M4\xda\x08\xb1\xe3\x00\x9c\xee\x9a\x19j\xba\xc96\xc9V\xab'\xc8\xcd\xc2F\x9ea\xe1\xc7\xd8\xbb\x03\xdf\xad\xf4^\x1f\xaaHv\xb3g\xb4\x8e\xf3}\xc9w#M\x17\xc9\xe2\xbfb\xf1W\x7f\xf8\xb7'{\xa3\x856\xa5\x1d\xbd\x80\xbf=\xd9\xdb\x1b\x9d\xbc\xfc\xe3\xe8\x05|\xfbdooo4\x15\n\x0b\x90\x8a*\x88\xac\xbdQ\xedh\xcc\x05\xd7\x95\xb4-l\x1ay\xff\xe3F(\x84\x0d(q\x93m\xee\xdf?\xfc\x94I\xbc\x81\x8d\xc8E#T[J\xa8Z'\x94\x14\xa5\x04	R1)2\xbc\x11\xa5\x1c=\xd9\xdb\xfb\x8e\x98\x8e\xceg'\xd7]\xcf\xe7\xda\xc1L,\x10N\xb4\x81km\xd6\xa1\xe3C-L\x0e\xa2(tc\xa1\x14\x0e\x8d\x14\xc5\x18\xdcJ8\x10\x06Ai\x07\x96\xda9\x0ds\x84[\x89\x0d\xe6 \x15\x08h\xb4Y\x03\xaa[i\xb4*Q\xb9\xbegQ4\xa2\xb5ot\xb6\xee\xfa\x7f\xc9$ \x1a\xb1:\xd4\xce\xe92\x8c\x81\x8963\xba(\xa4ZRq%\x96\x08s\xae\x03x\x8b\n\x9a\x15*pb\x0e\xd2\xc2J\xe69\xaa\xa47\xa5i\x15,\xf6\x9du\x14\xdf\xc1\xb1\xb4U!Z\x9a&T\xda:4\x16\x84\x05\xdfP\xd76\xe1e\xb2\x95\xbc\xc5\xab\x95A\x91\xdb\x9e\xa1'\x83\x0b\xf4\xf1\x80Z\x99Za\x1e\x0bA*\xebP\xe4\xa0\x17\x90c\x81\x8e\xe7\xb4\xc2r\x02\x81\xad'c\x0e\xf3\x16J\x9d\xa3\x11N\xd3\x88\x0c\x82u\xb2(b\xf9$\x19W\x9dK\xfd']\xd4e2K\xa2\xc1\xad'\xfa\x11\xf9\x1a\xd437\xa0}*k+3\x10*\x87[\x99\xa3\x06Z\x084\xe9\x94k\xa7\x97r\xd1\xb3U\x92\xe4 \x87\xd7\xa7'p\xb5\xaa\xcb\xb9\x12\xb2\xe8\xe6\xecK\xb9\xd0\xf5\x85\x1d\xb7\xf9\xf2\xacv\xfd\x18\xe9\x03\x0eE\xb6^\x1a]\xab\x1c\xfeDc\x08\xac\xb8\xcc\xad\x10\xe6}9\x8f1\xe5\xe6\x1bDv\x9f\xe0\xf42/\xa5zf+\xcc\xe4Bb\x0e%\x96h\xa1\x92\x15\xe6`\x9d\x11r\xb9r \x95\xd3\xd0\xea\xfa\xa9A(\xb5\x92N\x9b\xa4#:\x06\xaf\xee*i\xda\xae\xb3\xa3\x8d\xb0\xd0\xb4K\xf1\xf0\x93\x92\xf7\xef3) \xdfH\xf1\xf0\xae\x0e\x13x#\xb3\xcd\\@\xae\xe4\x18*\x0dk\xf7\xf1\x83i\xb3U\xa8\x05s\xdc\x80\xce+\xddH\xcc7\x126\xda\xd2\xc1E\xa8mM\xfc\\\xbb\xd5\xfd\xb9H6\xf7\\l\x9a\xed\xfe<M\xe9\x06\x97:\x16M\xe0L?\xfc\x8c\xb0\x11\x8dD#\xee\xff\x01\xb9\x86\xe7\x07P\x8a\x87w4\x96B:4\xf0\xf0Nd\xf2\xe1\x07\xbb\x96\xd9\n$\x144\xf0D\xbax\xf6W\xd2\x15}\xffW\xad\xab\x1f\xdem\x0d \x10\x87#\x18C\xa6\x1f~\x82\x1b\xb1\x86\xa7$\x1d5\xfc_8\x13j\xa9\x9fN\xe0\x98\xc6r\x00\x1b%\xd6\x1f?4\x83\x1e\xf3~\xa1\x0f\xe9\x83\xc8{\xa3+\xbcs0\xd7yK2L\xb2A\xc7\xb5o\x96\x89\xcae+\xd1\xb5<\n\xdf\xbe\xf1TW\x86\x86R?\xfc\xdc\xba\xb5n\x94\\\x0b\xd0\xd0\xb4\x15>\xbcS\x12\x95D\xf0\x1cZ\xa8\xcc\xa6\x05'xA\xcc\x06\xb3\x8d\xc8V<\x07\x837H2\x93\xdd\x08\x90\xe0\x1am6\xdc\xd0a)\\\xbd3\x94+\xb1\xb4\xdb\xc3\x01G\xc40!\xb1\xb4\xa4\xd1\xac\xaeM\x86\xb0\xd0&\x0cA\x80\xd5E\xed\xa4Vv\x02\x17\n\xa9\x11i7#T\xae\xcb\xa2\x85l\xa5-*n\x814\xb8%*\xd2\x14\x98G\x06\x13\xb8\xc4\xefkiH\xf5h\xc8\xb4r\x82\xf4\xb2\x83\x02\x85u\xf0\x05\xe4r\xb1@\x83\xca\x11o\x9b\xac~\xb6\x12f\x96i\xd3o\xf7\xd1J\x18\x91\x91\xa8\xd8J\x94`\xb90\x9ex'\x99\xd8 \x9f#\xbd \xa5%\x17-\xe95\x01Y\xd7\x92:\xe7\xfd\x9a\xc0\xcb\x05\xb1\xc2\xbb\x0c1\x0f\xea\x0f\nYJ\xc7\xbfj\x8b\x06\x1aY\x14\xa0\xd0\x0f\xde\xea\xe2\x16At3K\x86\xaa\xcb\xca\xa0\xb5R\xab7x\x8bE?\xe2\xbe\x00\n.	\xba\x1e\x17\x05)\xa8\xa4\xa1/\xa7\x8178\xb7:[\xa3\x83\x12\xad\x15K\xb4\xbc\xbeY!Q9\x0b\xb6\xae*mXaWhB\x95g\xb9\xe78\x81g\xcfi\x83h\x069.D]\xd0J\xdfb1\x86\x03\xc8\xa5\x15\xf3\x02m\xda\xed\x18\x9e}Ns\xb5\xa0U\xd1\xc2W\xf5bQ\n\x05\x99\xe6%!\x8d\xfc\x9c$\xe3\xff\x813\"G\xb0\x15-\x06\x0d\xc7\xca\x0d\x0e\x96\xc0\xd8#\x839*'E\x91H\xdb\xc5\xe5\x0c\xb2\xa4 \xec\x17\xdd\xe5\x90\x19m\xed3m\xe4R*x9=\x05\x83\xdf\xd7h\x9d\x17FT9dZ\xaf%\xda	\x9c.\x956\x98\x8fA.\xe074C\xa1\xe8\xa6\xd4t\xd1{\x06[\x83\xb9`\xe2\xd6@t R\xad\xbdQ\xa8\xd2\xf1q\x1aJ\xb1\xc6\xe1\xb0\xd2!\xed\x8bJ\xee\x8f\xc1\xd6\xd9\x8a\xae\xe6\x95s\x95}\xb1\xbf\x8fw\xa2\xac\n\x9cd\xba\x9c\xc0o\"N\xa1{<\xf4\x97\x0e\xad\xb6N\x97G\xb3Y7\xb00\x98\xb7\x16\x0d\xed\xa2\xa4[\xfah6\x03S\xd3V\x15Z\xe44E\x05NWQ\xdfX,0\xa3C\xe6VX\xe2#\xcc\xaf\xf4r\x99\xe8\xc9#\xee\x94\xb8\x86\xce|9\xed;\xb1\xcc\xfa\xe2\x8eU\x10\x9et\xa0\xc7\xbal\x1f~*T\x0b\xd6\xb5\x9d$\x07\xa2D\xd2\xdf\xb9&\xfc\xc7\xe5c\xb8\xc1\x87\x9f\n\x99*:\xa0jM;\x97h6H\xb8\x10\x97z\xa7\xcb7B-\xbb\x91\xf7}\xde\xdc\xbf\xdf\xb4\x11\x10&\xbd\x12\xfbF\xf4\x15v\xd9Yw\xde\xf3\x0bg\xc2`E\xdaK\xd7\xca\x05\x96\xe7u9GC\xcbA'\xd3\xfa*\x12-\xd8\x95n\x14o\x00\x03#\x86}\x96PQX\xa2	\x1c\x90\xe4P5\xda\xf3d\xafs\xb4\xb4\xb2F,\xfb\xad8F[\xcf\x02\x8d*\x0djM\xb4Y\x82,	WZ$\x94\x97\xf0\xf2g\x97\xe1T\xcf\xcc\x13=\x8e\n\xec.\xf1\x063\x17\xa0\xd5B\x92\x0c5\xd2\xadt\xed@\x04te\x9dAQ\xee\xf0~\x8d\xfat\xba\xc3\xdbS\xe3\xb23\xd4.\xb4^C]\x91\xf4\xf9%4\xb4Nz\x11a\xeb\x04\x18\xb0\xfb\x8eaQ\xd0\xed\x82\x8a\xb8\xe5^\xa3\xf2Z)\xed\x8b\xd2\x05\xf3\xf3\x99\x1e\x9f\xec\x0c\x83h\x83	N\x8fO\xfc\xf4v\x9a_\xea\xb9v\xfd\xc1\x9f\x1a\x02\xe7\x0e2#\x9a\x82@\xe5x@&d\xe9\xa1$\xe9]\xe9\xb0\xab\xd8\x1ft\xbf\x19\x80j)\x15\xc9m\x8ew\\\xbe0\xba\x04\x91e\xa4J\xf9\x02\x91\x16\x18\xa6\xec\xce\x89\x8e\xb7_\x95\x9d\xddSZ=\x13\x04\x0e}[\xd2\x96\x82\xae\xdc\xe1@\xc9\x12h\xa93Zp\x10\x950\xce\xf7O\xbb\xf0\x94\xdb?\xed\x8a\xb9\xc0\xf3QKP\xd8x\xd6\xe9Zi\xd5\x0b\xe5\x89T\xd2\xae`J0\x86J\xf7FG\x85\xb6\x08\xbaB\xb5\x05n\xf8z\x9d\x8b\xa2\xbf\xe3.z\xf4\xf8\x7f~\xc7%\x11xZ'Rl\xb9\xd6%\xaa\xbc\x0d\xb5v1a)\xd6\xb6-\x05\x9f\xea\xe7\x07\x07iS	\x9f\x1f<\n\xd1\xb0\x14\xb2xeL7\x9aWD\x80W\xc6hc\x87\xca\x8e\xab\xd2r \x17>\xc2\xe4L\xc8~Z\xcc(L\x85\xd9y\x06\x8f4\x9b\n\x9bH\x9b\xb0\xb6\xd1&\xdfm	U(z\x8c\x856\xaec\xc1=\x83Es\x8b\x06\xe8\xaa\x7f\x84WR\xfa\x08\xbbY=\x7f\x94\xdb\xa7\x19\xd9z\x9e\xebR\x0cnQ\xbc[!\xc1\xb5\x9eU$x6\xb1\xfc\x17\xb4\x16\xdeUB\xe5/\x13iy\xc5\x14xY\x14pJM\xe2&\x05:]\x9a\xac\x00\xed\x04\xaeq^\x8e\xf9\x9cS\xc9\xd9\xf4\x0b\xfe\xdf\xea\xda\x00id\x92K\xb2\xfe\xd5S\x07b\xb1\xe0\x0bq\x02\xe7\xd8\xf8\x12\xcf\x85*\x80(\xac\x06?\x94\x81\xbd\x8aw\xd5`\xdd\xef\xd2\xc5\xe6\x0f\xd0\x15\x9dD\xc6#\xa4l\xfa\x99-\x10s2	yy\xbb\xd9\x91\x04\xc1\x9a@\xee\xda\xe9&\xda\x0b[T\xb24n\xf2\xfa\xe6\xfe\xc7\xac\x05+\xef\xdf\x83\x12\xb0\xfc\xf8\x81\xeeD\xeb\x8cVm2F\xea\xf5\xaa\xad\xb0\x97\xb0\x13Y 8&%X\x8aP\x19\x15\xe0\x9dCEx3Q_7\xd5\x12\xb4!\xfdVN\xe0\x0d\x8a[:\x0c\x95kiV\x0cV\xe8o\xc4\x95\x84*\x88yP\xe2PH\xc2c\x99\xa0+\x90\xafL\x92\x00\x05\xcbB\xd3)n{\xf0\xc4\x8d\x92\x81\x17\x03\xa3\x83\xb4\x96i\xbd\xc6\xdfrx\xd0v\xa1\x81,\xadB\xf7-\xd1\x13\x8d\xb5(\xb4\xceO\x95Cs+\xfa\x15?!*\x99\xcd\x9e\x1cN\xbc,\x11\x1a\xa9r\xdd\x10\xe0\xb7\x98i\x95{\x14M\xda\x9291{\x8f\xf7\xb7\xfa 5\x98,\xf7v\xe5\xb8\xa7w\xb2\xacKP\x1dl ~4n\x84\xd3)\xaf\x17cI\xba\x02\xa5J\xba\x8dC\x0d\xb0!\\\x0f\xe9\xc2i\x93aN\xde\xa1n\x0c\xd7mY\xdb\x8dV\x82\xbdA\xb2\xd4\x8d~\xf8\xe9\xfe\x1fa(\xd7\xed\xc3\xbb\xfb\x1f3\xf2\xc6m\x9a6\xc5[\x1f?4cpFVk2V%\x90\xac\"\xf8\x03\"Rt\xb1\xd0\xc6\xd5\n\xedT\xb8U?\xf3@\xf4\x92\x1fV\x96L_\xfa\xe6\x89\xf1lC[2F\xa0\xa0\xfb17\xa2\xf1&\xe1\xb7\xa1\xec;(\x85Y\xd7\xd5\x04\x0ekY\xb8gR\xc5V\xfe\x80\xd66 {B\x17\x16S\x95\xb6\x14E\x81\xa6=\xd3y\x7f]\xbd\xf64`b\x80\xf2$\x9b\x0c+\xfc6\x04C\x93\xd4\xbdW\x04	G\x024\x83\x892\xc4\x81\\\x1a\xcc\x9c6\xf1\xd4\x1e\xc7\xef\x94\x19\xed#W\x9f\xe4\xe4{T\xb9\xff\xba\xfd-\x7f\xe7\xc2\x89\xb9\xb0\xa4\xbc\x02\xd0d\xcd\xb1_[\xb3oW\xc2\xe0>\xd7N\xa7\xa7u\n\xd4_\xfbO*\x8a\x1f\x9f\xc0\x83\xc1\xbf\x18\xa7\xf0\x15\x7f\xbe\x80\x83\xd0\xf6\xa8@a\x82\x13r\xfb \xadd\x8e\x87\x04\xbe\xf3\xb49\xc2qp\xf8y\xf9\xf7|\xb8 z$\xad\xf7\xb8\xaeH\x81\xcc\x11Ut\x01\xf6\xd3!\xd6\x03\x8f.\xb7'\n\x1d\xe8}Q\x14\xfb)c\x0fJ\xc8\xf0_bN@\xabs\xe4\x92\x85\xc9\xae\xdb\x0e\xe2pc(\xd1	n5\xec\xf3\x12\xb3\xdaXy\x8bE\xef\xb0\xe9h<vZ\x0b\xa9\x96\x8fN+x\x92=\xe0gS_\xa4K\x07&r*\x12o\x18M\x95\x1d\x8f\xbd\xaa\xe0\xc9&\xfe\xc6\xa1\x9a\x13\xf0\xedl\xa5\x9b\xef`^;\xa7\x15)\xa6\xaa\x10\x19[`\x8f9)W\xda\xc8\x0d\x89qA\x1b\"\x13\xa3\xe8\xab\xae\x04b\x11\xb5\xd8\x1b}\xf1\xbbl%\x14(:\xde\xc5R\x8a~\xb4\xb2\xdf\xeb7z)#\xb6L*\xd0)\xf9J\x13H\x88\x15\xf9~\x06\xa6\x01_\xcf\xb6\x07\xa5\xd1Y\xcd\xcd\xa02H>w\xd2~\xb0\xa2\xfa[|\xdf\x90We\x8b\xef/+\xd3p{\xb3\xb7\xc6\xdb]\xa4/I*\x94\xf6M\x93C\xc4\x95/\xb5v\x17\xb7h\x8cL4\x85\xef\xcah\xed@\xc72j\xb27:]\x10\x92\x80\x86p\xaf\xd3\xb0J \x03\x8b\x9c\x00\x8b\x95 W\x16\x14:c<\x0e\x85\\\x93\xff\xe7\xe8\xf8|\x0cHj\x9c\xd5\xc1\xa2.\n\xdf\x85\xc8s\x83\xd6\xc2\n\x0d\xee\\\xb4dk\xa7^\x99Py\x02\xaf\xbc\xf7\xe0\x05<\x8d\xfe\x84\x00\x80J\\\xd6\x99 t\xf5tk\xaeC\xb7\x98\x9f\xe4\xafq\x89\xd1A \x95(\x94\x9f\xeb\xbf\xd3\x07&\xcb\x01\x98:-\x130uZ\x0e\xc0\x14/\xf0\x10NIEW\xc7I\"%\xdb\x12\x17\x00b'\x18\xd6\x1f6\x84\x8a0\xa0\x0bpP\xe5`\x90\\Sl\n\x99<\x84j,:\x9ax\xb2\x8c\xdf\xe7=D>\xfd\xe3\xf1a\x10\x0b\xa2\xff\x02\x9e\xbd\x11\xec\x92\xef\x8f\xfc\x1f\"a\x9c~vv\xd8\xe9\xb1\x9d@\xac\xd3\x83(\xaf7y\xc8\xa9\x1dR\xa4\x9e\x0fr\x83\xd4\xbd\x97\xe0h%\xd4\x92lO\x87fAZ\xa3\x88\xe5\xdd\x9c\n\xa9\xd6\xfd\xc8\xdeH\xb5\x96\xb0\xc1F\xdd\xbfwf\xa3\"\xa3c\x9d\x8b\x9b1\xf9\xf7\x1f~\x80\xa2\x9e\x03\xe6\xad\xabo\xa0\xd8\xae\x0f\x0d\xfbqj\xf8\xfd\xef\x7f\xbf\xff%)\x16\xb1\xdfwV\n\x9b\xe9~\xb7\xce\xfcg\x00	\xde\xbb\xe4\xab\x10\xc2!U\xb1\xdd\x94\xa5\xb6k\x1fZ21\x9e\x16A\xf7Md\x936wu\xe2\x14\xbe2H\xe1G\xc8\x0b\x01\xb96\xdarH!\xf0\x9b\x92\x03\xbd\x92h\x1a\xbb\xc1\x1bh\xe4\xa6\xcd$-\xbc\x0fF\xd67)Z\"7\xbc\xf3\xdc\xc8\x95=dH\x18\xdd\xe1\x8d\x07\xe821\x04Jq\xf7\x15\x1f\xb0nD\xac\xed\xa0i\xad^k\x1e\x9a\x9e\x1b\xb1Y\xc7\x00\xc0Y4sEW\xe7\xfe\x1f\xe4\xeaG\xdb>\xbc\x13\x8a\x02\"\xbe\xc5\xc7\x0f\xcd\xa0\x9f\xe9\xf1\xc9Ln\xfa\xa9\x9f\x89;6\x8fH\xde;\xe6\x1e\x96\x12\x89.\x96\xceKB\xaa\xf4\xec0\x80Nv\xf5\x8a\x8a\\`t\xe0=\x96\xe7z\xbe\xe1\xb6\x82-\xc5\xdd%_\x8e\xbdx\xd1w;P\xe2\xe7C$<\x06\xc1\xba\xa5Y\xc9l\xd5\x85\x1d-y1\xe7H\xc7r^\x97\x15vj=U\x8dzA\xf6\xff\xc1p\x00\x83\x99\xfb%6zSJa\xeaO-p\x1bk@c6u&\xc8\xdd\x18\xebB\xd3\x1a\xf1\xf0\xb3V-4P\xe2R\xcc\xc5\x8dp)\xc2*\xc5\x1d\xc7\xf5\xde\xa0Z&`\x91\x16\xdd\xbb\xd1\n_0\\xO\xa4)\xd4U\xf0\xddrm\x9b\x18\"q\xce\xb5\xe2\xf5\x1b\xd8\xa4\xa5\xb8\xbb\x96y\xd2\x9f\x9f\xaa\xdd\xa0\xf9\xa7\xe2\xd4U\xfaU\xf2\x84\xcb\xfa\xeaO\xfd\xb4\xfcg86\x14\x87\xf61\x1e\x8f\xd1b\xd82K&C\x17*\xd5\xa3\xc3\x9d\xde\xf8\x9e\xf1l\xa5\x8d\xcbj\xf7\x1f\xe9\xc0\x19\x99\xd9+\xbdN\x00\xf0\x99'\x82cjH\x1b@a\xd0x\x12\xfb\xf1c\x18\xca\xbb\xec`jt\x89n\x85\xb5\x85\xc0\x13\x84\x83\xfd\xf0{\x02\xaf\xa2\x91\x1c\x8c4\x16RTy\xa5\xa5J\xf1G\x1f\x1e\xef\x87\xd3\x93\xc6C\xc2\xf0n\xe8\xeb\xf1\xed\x90^\x0cc\x98\x0b\x15\x8ccr\xfe\xe54\x0c\xba\xc5j\xc2,\x91>oiT\xd2@\xa9\x90\x02\xc6\x19\xdf9\xfd\xd8T\x1a\xafM\xa1\xdfi)\xef\xdf\x8f}<\x18a~\xff>\xdf\xf4\xdet\x82t\x9b\x96b\xa8\xa4\x8dv\xccE\x85M\xe7\xe7\x8a3f\xc7K$n\xa3Kj@\xce\xc5\xb4r\xe2m\xbc ?\xa3\n\x9e\x9bd\xec\xfa\xb8\xae\n\x99	\x87\xc1W\xd4\xb5\xd7\x90\xc7\xa2\x00	\x86\xeeoO\x0b\xc0\x9e\x0d\x16Q\x90\x06j\xbd\xe1B\xfd\xf8\x0c\x11\xdaR+\xca\x985\x91v\xeed\xd6/\xdd\xc5\xf2\xe1\x9d\xb6\x1ce\x0d=}m>~pk\x91\xe4\xb5@\xa5\xd7bC\xeb'v\\:C\xc6\x0b\x1a\xb9L,\xfcc\xb4kR\x8d\xe7Ia\x9c\xd2kt\x90\x87r\x95\x96\xfb\x84\x93\xefk\xed(Bd\x08\xb3\xb6*kH\x8dq\x84lNN\x7f\nM8a\x06\xcb\xda\xd0\x01O-\x8as\xdd@\xa0\xc1\xa1P\xaas\x15\x1e\xd5\x86\xd0U\xe1O!\xa9m\xab\xd5\x92p\xbe\xd9\x17\xfb\x14b \xc7h\x8e\x8a\x05T\xbb\x15\x05g9\xbc\x10\xd7\x85\xa6\x19\x17\x9af0g\xf6\x89\x84\xea\"\xdf\x11\xa6\x8b\"\xef\xdc\xa5a$I\x83\x80\xe4\x8f\x1e	nG\x0b\x00B!\\\xf5Q\xee3QU4\x03\xbd\xe8\x0cOM\xb6\xa7\xdd\x02\x1c1\xe4M\xb3d\xf1\xe8R6\xb6}\xfb\xbaQ\x98\x1c\xfa\x0b\xff\x19d\x9a>\x86\x87\x9d\xfd\xff\xe4\xbb\xa4\xd4\x1e\x16J\xb6\x1d\x826\xf2\xce\xb8\x18\x03\xd0\xca\x19]\xd8	\x9c\xd5\xd6E\x0fD\x1f7\xd7*u\x19uK\x15\x97a\xfa\xa9\xb5#\xc1?\xacMr\x14\xe9\x18\xc2\x9cI\xdb\x810\xaa\xdcE#\xfa@h\xf4\xf8I\x05\xdf\xd72[S\x98$\x0bA\xec9.\xb4\xa1\xc3\x84\xe0m'\xbe\xbd\x08j\xc8m\xaf\xc8\xf3t\x02\xda\xba#\xad\x8b\\7\xfd\x99\xe0\x91e\x91\x1a\xd4\xa8\xa4\xe4\x8d\x12\x1cy\xf8\x12\xd7\xde\x1c]\x13\x0fvT\x8a\xfe\\\x9fN\xfd>v\xa1\x99O\xf9\xddx\x0ca\xb2C\x03k:X\x87_chu\xa1\x17\xd1\xa9\xb5\x7f\xa7\xadEC}\x95Kw\xcd.\xce\xe181'\xf0\xe9\x0b\xba5\xab\x1dy\xda\xb8\xff\xb8\x9fc\xc8kC\xc7\xc1\x034\x01\x19\x85|\x82[\x94\xee\xa292/\n\x8cQ\x18O:\xdbG0~i\x0dO\xd9\x94\xf3\xe6Z74Od\x9d\x0fd\x99\xec8\x14B\x05\xb2B\xe2 j\x95\x07\x13;x_\xd5\x9a\xb62+d\xb6\x9e\xc05\xe9\xbep1\xe7cP\xe2V.)pK\xa2\xe5VCN!\xd9mk\x05/\x85\xdb\xf2L\xf0\xf0z\xb9\xdd\xc2\xd4\x9f8\x0e\xe4\xf7,y\x89\xbdaK>\xd1\xd3\xe9/.\x11\x9a\xd3\xe3^mP\xafh\xe8\xde\x0e\x1d\x92\x9b\x08\x041~\x16b\xce]\x12 HN\xa4XH4\xa0\xc8\x17\xeb\xb4O\xbc\xa1\xc1\xa5 \x89\xd3\xfd\xb6\x02\x8eo\xd9\xe6\xf3\xd9R\xd1\xcb9\xa0q6\xd8\xc3\xcf\"W\xf1\xda\xff\xf8\xa1\x01\x9d\xc39\xe5\x8fm1\xdfN?\xf4\x9c8\x03i\xc8\xdc\x93~5\xef\xb6\xeeVfV\xd4.D\x8e\xe3\x85t\xac9\xdec\x93@\xbf\x99\x93W\xbakt\x89\xf9\xfeaQ#0u\xe8\xae\"\xfcG\x17\xd5\x9c\xca\x1d\xad\x9f\\\x10\x14/\x85\xeb\x04\xfd\xe9_\xcdS\xba\xd5\x9e\xfeu\x9e\xf8]\x08<\x0c\x15\xe7%m\xcc\xe3\x8a\xf3\xf2\xd5\xec*\xe4\xb3\x88\xfc?\xaa8iX\xe4\xfb\xeeFu\xd5\x16kM\xe9u:\xcf6\xad\xab\xb7c\x04\xa5~\xf8\xb9\x90>~\xc0;L\x19\x1b\xa9\xa7\x908\xee\x9e\x0c\x9e\xec'O\xc6'\xe6\xfb/\x9e\x0c\x83KIg#Y\xe1@\xd8\xbe\xc5\x0c\x16\xc2Q\x06\xacL\xc0\xede \x02Q\xad\x13e\x15\xcfSWB\xf3\xe5\xab\xc3\x17\x93\xefm\xf2\x02\x9e>\x87\x15\x05\x15\xc5R\x0f6\xbcB\x91.\x00\x7f\xee\x8e\x84\xe4\xea\xb0\xa6\x1c\xb6\x81\x85J\xf6\xb1ha\xce%\xa9\x85\xde\x9b\xc9\x06\xb3\xe8\xb8\xf2k\xc5\xf7\x13\xbb\xa1E\x1b\xf2\xe5B\xce\x97\xa1\x08\x96\xc2\xcc\x05\x7fV\xef\x14=\n5\xd83_Jk)\xb3\x94\xae\xe0\x92p\x83A\xb2AY\x1f\x86\x06\x83	\x16\xed\xe5\xc0s\xf1-\x8d\xba\xfd\x8e`\x86/\x08\xdb\xaco\x11\xb8(\xfa\xae\x83\x8e5T	\xd8\x13\x172\x93\xaa\x81G\xcah\xed\xde^\xbe\xe9\x17\x91\x1c\xb1D\x18\x0f>cc\x06\xed\xbc\x08I\xd6 \xf9|\xad.\x03\xcc\x8f\xa9\x18\x95\xd1d\x89\x1aV\xf7\x8d6\xebtbu\x918*\xbe\x11Vt\x99\x9b\xfe#\xa8\xbb\xba\xc7\xeb\xe4\xec\xd2kJ<\xd8P\x8d\x9e\x97M\x9dT\xb3\xde\x1d\x172a\xc8\x87\x91\xacn\\\x97$U\x8b\xa7\x13\xa3\x99\xdd\xe5\x9b\x8c\xd6\x8a\xa2\x97\xb2C\xdcT\x12\xb3\x0d\x19\xf3\x1f?\xc4\xf4\x83\xd9\xc7\x0f\x05l\xc4<\x14\n\x8a\x1c\x8b.\xae7\x81sqS`%\xf1f\x0c\x0f?#\xe5k\xd3\xf4\x04d\x9a\x12\xf4KE\xb9\xf9_\xfa\xec	9\xe8\xb9\xceP\x89>\x9dhF\x84s\x113\x89b9\xe5\xb5\x0dV?a\xe1a\xdfVN\xf2u\x92\x8eL;fo\xa4\x0f\x8a\x07\xc6>g\x99\xae\x14\x91\xad8+\xf9F\xace\xe9\xeb\x80\xbd\xff1&\xe5\xb4\xd9\x86\xf6\xa4i\x0b\xbd\xd4\x0doT\xd2w\xa5e\x91\xc2o\xb6\x11a\x16\xc9\xe9}\x11\xeaFS\xb1\xe7\xe1\xc4b1\xcc)\x9e\x11	<mxyp\xe6\x01\xb7\x00G\xc5\xd1\xaaa\xad\xb2B\x91\xa7\xf7\xaf\xad\xe7\n\x1f\x81\x183\xa6'\xaa\xb4\x0fJ\xbd\xec\x1cr}\xa9\x97\xef\x95.\x10\xf6?\xff\x12N\xa7\xb7_\xf2\x05\xb6\xff[\xfe\xf8-%n(t\x83|~\xa9ry+\xf3Z\x14p:M\xe2~.\x0d\xc8'v\xd1\x95\xae\xd8s\xd2I\xab\xb4\x1c\xf5\xc7<@\xb00O_\xd8\x85I'\xf0F7h2a\x11\nt\xe4\x8d\x18\x87pxpW\x08\xbbB;\xe6L1\x0d\x9f\x1f\xf4Y\xb8\x961\xcb\x04^:(i\xf1~\x97H\xa5\xc3;\xf7\xc8\x8d\xe6pm\xddv\xc0\xbbw\x9a\x92S\xb7\x90C\x8f\xa9O\x8e\xec\xd8\xf0W8P\x9c?\xc9Y\x96[\x19\x94^Kzy>\x13w]kv\xef\x89\"hQJ&\x91\xa6\xe5\xabdG\xad\xe7\xa2\xedS\xee\"\xfa\xb7\xd12\x8a\x9a\x9b\x16y'|:\xe8]\xf6\x96\x10[=\xffK\xbd\x0fr\xc5=\xc6KS\xc5_\x92\xf1\x17\x06\xc2\xc29\xc7\x18\xbf\xd5\xaa\xd3o\xdb\x0bj\xa7h\xa6\xa9*\x8d\x8fL\x08\"Tb\xb9{9\xc6\x1eB\xf4\x80\x1c\x1c\x8a\xa5&\xca(%\xe1m]7np\x90\x1f{\x9c\x10|6c\xd0\x95\xb4\x9a\xde)\x0c*\xf5\xa3\xe6\x08FP%\x8f+\x98a\xac\xa3S0\xfe\xa8<\x928\xe7\xdd\xc1C\x08\xf9\x96i\x9f\x00\x91\xec\x8e\xf7\xad\xfe\xa3\x10\xd2w\xb1\xab\xa9\xc2\xe0z]\xb4\x05\xfa\x1e\x1d\xdf\xbf\x08\xf9(\xc9\xfe\xf0\xf5v\x9er\xff\x80f\xb8\xc8!O\x996=y\x8e\x93\xcc\x84\xb9\xfd\xea\xc0\xd2c,\xd8y\x16\x84\xf3B1\xe6\xe9X]SY'\x98\xe4\x02\xe3\xe2\xe0\x04\xf0i\xa42\xf3IQy|iEG\x84\x99b\xbe\xdb\xb4\xef\x16\xe7\xe50\xf8~\x8d\xf3\xb3\x7f\x12{\xe7*;\xa1\xf7\x0e:Yx4\x80\x1f3q\x13\x95\xdb\xac\x84{\xa9R,}\xddQ\xa8\x82\xaf!\xa8\xc6dI9H\xe4|\xb1\x99ATv\xa5\xdd\x0e*h)\xd1\xae\xd7\x9d\x7f\xf6\x9f\xe3\xe4\xe3\x13p\xa2\xd1f=\xc8\xf4\xa17\x80\xf4\x90#\xea9\xca\xb0\xb0\xe1\x16\x8f.q\x9a\x8d%\xff\x9dy|G\x03\xcf\xad\x94\xf8\xff\x19\xe7'{\x7f\x1f?\xd9\x1b\x85\x80v|D\xa9|N\xedhn\x84OT\x1f\xf9E\"Z\xae+a\xeb\x1b\xb2\xccpm\x84\xf2\x1ai\xd4\x84XOZ\x9e\xc4zF\xa1#\xab\x8d\xa3I\xc4\xfb{t\xc8\xc03n\x90GT\xe4\xa5w\x0c\xf6\xfa\xcc\xd9\xa4\xb8\x8e\xcf\x84<\x0c\x8b\x0f\xc3\xb6\xebFr\x12\x81\xa4\x85\x1c9\xd1e\xba\x8c.\x96\x1f?\x14!\xa2<\x9a\xc5\xc7\x00\xa3\xeb\xd6n\xea\xb5$\x83\x12=\x830\xcbo\xc4\\4\xa1\xdb\xd9\x9atp\xdb\xb1\xc5\xb2\xe2\x84\xfb\xb8\x88s\xa1\x067\xc5\x9fu\x9dd\x1c\xb1\xf78\xf7\x8e\x96\xfd\xcf\xec>\xb9\xfa>K2\xfb4]Od$\x19\x14V\xab\x17An\xfeL\xd0\x8d\xc2)\x9c~\xce\xb77\xd2	\xfe\xcc\x92\xa5/\x15|f'\xa1\xea\xcb4o\x80\x9a\xf9\xd4\xd9\xb1\x87\x7f\xa7S\xba\xb8?\x0bQ\xfb\xb0;\xb5\x8c\x83\xf7\xcfhG\xa71$\x10\x96(x\x81\xa9\xe8kM/\xfc$l\x84\xd9\xdc\xff\x98ox\xad\xfc%E\xefbi\x0c#\x91\x93\xe3\xccG\xea\x03\x85\xa0!\xd1\xbe\x11\x8e\x9e\xd0\xe5\x0f\xff?\x14X+\x97\x8a1+\x15\xbf\xe4O\x0fR}\x85\xb9`\xf1;\x14\xaa\xfbVhf\x15f\xb4\xe24[\xac\x9c\xed1\xda\x1f\xa6\xaf^\x8faz\xfez\xcc\xaf'\xb5\x81\xebW\x87g\xc9C\x02(\x83\xfe\xcfe\x192M	t~qpp\xf7\xfc\xe0`\xdc\x15\xf7\xd1d\x1f\xce\x85\xaf\x0f\xf9\xc4*rl\xd6*\x9f\x84\xf1\xf0\xb4\x0e\xdb\xd1\xd6\xd3\xb9\xc1\xab9z\x0b\xa6\x96\x98\x84	F\xdf\x94\x12\x1f~\x80\x95\xb0\x0f\xeft\xa8T\xd0jd\xd48\xfe\x0ct\x14t\x89\xfb\xa4\xb8@\xd3j!\x97\xb5\xf1.\xb2\xb01L\xe17u\x11\x10\x0c\xeb\xceX\x12v*[4\x0d\x9a \xde\x8c\x80z\xaeW\xe1\xcc\xe1\x90%%\nR\xf11\xfd\x0f\x8f_\nL\xda\xf9\\<\x8fu\xd2\n\xf1r\x8b\x15X\x15\xa6\x15B\x98-\x96W1\xc86\xcak\x13CN\xa3\xe3\xf8;\xa6A\xd3uA\xe3a\xe8\x19bi]\x8as\x98\xb0\x13\xeb\xc0jQg\xeb\x0b/p'o\x8f\xbe\x86\x8b\x93\x13_\xe0\x13	\xa8\xfek\xff\xab\xcf=\x1b\x9d\x1e\xc7/r`\xba6r\x0d\x9b'\xf9}\xd7L\x96\xb2\xf0\x9b\xe5\x1f|\x81\xf5\x94p\x9b\x86\x91\xc9\xa2\xc0%'\x01\x8fN\xfdO\x8e\x90`x\xcc3\"T\x15\xe5\x87\xf7]\xfa\x07\xddD\x8f\x0f\x13\xfb\x9aR-w\xce\x83\xd8\x91\xfe\x7f&\xfc_\xfc7\x84\x9f\xccZ\x0e\x91\x8f\xae\xc9\xc4\xad\xc3i\xf7d\x9f5\x1fK`\x83\xd0\xd8Mk\x1d\xbf\x06\xad\xbd\xf6\xc0\x87\x1fF\x8f\x05\x15Gi\x1c\xd1\xd7\xe8\xef\xaa\xd1[\xeb\xe8\xd1Ew\x13\xe8F\x9d\xeb\xceW<:'cJ[)ra\x83\xcb6y\xcb;\xea\xbc\xd8T\xb7\x0b\xe2\x8e\xaa\xda,;\xe9\x9b\xd2\x07\x0b\xdf~\"\xa1\x1e'\\i]8YQ\xeb\x13Y8\xec\xf6\x96t\xb9\xad\xe7\x14\xbf\x1d\x87\xe7\xb5&\xa0}\x8ab\x03*\xb259\xeeD\x17\xbd-\xd8\xbe\x9c@\xd4a\x97\xb8\xacIT\xf0.\xbe\xb9\x0cJ}d\xd1\xf9\xa8&O\x7f\x86.D!\x838Yto\xbc\x1c\xc4\xe2 \x16\x0cu\x92\x93bW\xf4\xc2\x8d*\x85_	\xf5\x90\xed\xb5\xd1\x8c?`\x1eR,GA~\x03\xa4\xa4\xa6\xe1g/\xd9\xfc\xe0\xdb\xd8.B\x8dw\xd2\xba	\\i\xef.B.\x0e\x8bD\x8f\x80\x1a\xd1\x8ea\xa9y\x11b\xc6[=\x8f\xefV\xe9\xe6\xf1\xb2\xfa\x97O\x9c\xa1\xbf\x8c [a\xb6\xc6\xa8\x83#\xba\xa0\xd1\xcd(c\xfb&\xfa\xec\xabp:\x83y\xd3i\x9f`\x19\xa5\xea'\xec\x1c\xf1\xb8\"\x87\x7fh\xd8*V\xc83'\\m\xa1\xd2\xder\xefe\x8f*\xf0\xe3\x00\xaa\xf5V\xc9\xefkzS\xc6\xbeN\xf2\xd5g\xe4\xd1\xddw\x9ar]O\xa7\xc9\x83\xbd\xe8\xcd\xe8\x1d\x19\xec9 .\x9d\xdf\x7f\xc4\x00\x89(\x1dPrm\xe5)\xf4\x9f[\xd5*\\\x91o\xd5\\\xa8\xd1\x93\xbd\xbf?\xf9\xfb\x93\xff\x1a\x00PK\x07\x08\x94\x12\xf0\xe8\xeb\x18\x00\x00 C\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x004<N]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/pt_BR/common.jsonUT\x05\x00\x01T0\xcfj\x8cV\xc1r\xdb6\x13>KO\xb1\xc3\x19O\x92\x19\x8fs\xf7M\xb2\xe4D\xf9mK\xbf\xc4\xd8\x93iz\x80\x88\x15\x85\x98\xc42\x00hY\xcd\xe4E\xfa\x06\xed\xb5=\xf5\xea\x17\xeb\x00X\x90\xb4;\x99\xf6\xc4\xddo\xbf\x05w\xb1\xbb\x00\xbe\x8dG\xd9\x8eL-\\v\x0e\xdf\xc6\xa3Q\xb6\x15Z\xa3\xcc\xce!\x9bNnn\xe63\x98~\x82W'\xf6\x15\\.\xd7pb\xc3\xe7svb?g\xd9\xa9\xe7K\xac\xd0E\x87\xd9\xfcj\x9e\xf7\x1e\xd1\xaejQ\xe2\xac'-\xae'\xef\xe6\xf0c\xea\xa6!U\xa1\x19\x927\xab\xe5\xe2j\xbe~I\xd7xX\x91uv\xa1\xf3\xbdA\x11\x1cN$h<@\xe3qP\x1a\\\xb0\x9c\xc5\xf5\x03:\xd1r\xe1C\xb2\xcbZ9\x0e\xfcDF\x8f\xd7\xf6\x0d\x08-\xe1DB\x88\xc5\xeb\xc4\xb4~\x85\x1f8>'\xb6\xa6D\xe9\xc3\xf3\xeb\xaf\x96\x9b\x1cV\x1f\xd7\xef\xfa\x14\xfe\xb1\x8fv/$\x1d\xa6*\xed\xfe\xe6\xfdd\xb6\xbc\x83\xe9\xe2\xbf\x14!\xa6yE\xc5}NeY\xc5\xac\xf2\xf7\xeb\xf9d\xe6k\xf6l\x97[\xdd\x97\xf8\xe3\xcd\xf3\"\xc7\xd5\x1e\x14\x1ePnD\x8d\x8bU\n\x7f\x03\xcbK\xc8\xdf\xcfa3\xb9\x9e\xc3b\x05w\xf3\xf5\x1cn\x17\xf3\xbb>\xbal<\xfa~\xca\x0de}?\x05\xad\xa9Z#*\x9b\xfaK\x8acv\x0e?\x8dG,\x9f&\xc9f\xe3\xd1\xe8g\xaff{jMG\n\x8a\x87#>\xa0\xd5J\xb7\x0e;\"\xab\xde\x94lC2i\xb7\xef\xb9Ac\xaa\x97\x07L\x8b\x05i\xd9QY\xf5\xa6d\x1b\x90\x8f(\xfaP\x83r\xda\x89\x91\xc6\x9b\x12\x1a,m\x82\x90\xb5\xd2~k'A\xf0.\x99(\xc9#\xfe\x13\xf5\x10C\xf8\xb0N\xd1E?\xfd\xa9U\xcd\xac\x82\xb43\xa2p\xb1\xa7\xbd\xfd\x82\x11`h0\xa5\xd3c_\xd48\x92 \xaa\n\xb6GX\xac\"\x0f\x1f\x1b\xfe\xef<H\xca\x0c\xf1\xfe'\xd1\xfa\xec\x17{%}-\xb2\xb9\xf5\xdb\x87\xec\x18\xd3L9~\x11Z92!\xcekT\x92\x93\xf8\xd2ZwC\x07N\xdf\x08\xa8\xd1\xa6\x04+*\xeec?\xb3\x14\xd0\x9a$\x1a\xd1-\x95\xb4hL\x93x\x0e\x9d\x18\xf1\x83\xc6\xf8\xef)	#a\xe9u\x9e>\xc4IUy\x8f[4\xe0H\x12o\x9c\xdd\xc7\xb8\xe6\x8fj\x9b6\xc3\xc6#\xca\xb3\xf9\xb4\x8a\\\x17fo\xe3Tq\xef{<\x8b\xb3\x086\x02<|}>\x9d\xdc\x0d\xdd\xb0>\xbeR]Y\x8e\xd4z\x8f\xd7y\xfb&\x0d\x99=\xea\x82\x1b/\x93\xca\xef\xb9\xc6\xa2K\x95U\xa5K\x0e\xf9\xa8\x8bgJ\"Jdm\x1cf/s\xaa\xc6\xd4\xa5\x85\xa8P\xcbA\x7f\x7f\x10\x9a\xdb\xfb\x12\x1fX\xba\xeeZ~\xb2M\xd2\xb5P,}h\x93\xc7\x87\xb6bi\xc2\x1d>\xca6\xe8XZ\xb6I\xba\xa1\xb4\xf2\x0c\x7f\xe9\xe7\xec\x80x\xdf\xc51\xa3\x9a9\x1b,Y\xca\xb9\n\xa3\xec\xff\xad\xe8\xa4\x14\xc7\x06\x1f\x93$\xb6\xc3\xb1lU\xcavK\xceQ\xed\xb7yMR4O\xbf\x05\x87\xac\x10\xba\xc0\xd0\x19\x17A\xe2t\xb3B8QQ\x19\x0dQ\x8cx\xa5\xfc)|\xd1\xbby\x00\x1c\x01\xaf4\x9cG\xdf\x86\x83a\xdc\x06=\x12H\xfb:d\x8b\xba!\xe3\xc0\xb6E\x81\xd6\xee\xda\xaa:\x83|\x8f\xd0\x88\x12\xe1\xa0\xaa\n4\x1d\xc0`E\xdd5\xb7S\x15\xe6DW\xc2\x94a	\xaf\x83#\x82* a\xf9\x9d\xd2\xca\xee\xf9\x9e@S+-\xd20\x96De\x85\x1b\xd2%G\xff\xb5\xf5\xbf3\x02\x1a\xb4_[e\x85\x81\xd7\x91\xf4\x06\x04\xd4O\x7fYU\x08\x9e\xf6\x10\xed\x05\x19\xd36n\x10\xfeN\xa8\n\xe5\x19\\\xfaP\n6\x0f<f?\xca\xf6_\x93U\xfaATJ^\x88\xc6\x15{\x11\xd6\x88\x08\x14\x0c\x85\xdf\xdc#6\xe1\xc4S\xba\xbc$\xdf\xd1\xd9\xff\x10\x1b\x90\x8c\xc1.\x1d\x1e\x95\xb0!\xf2\xa7_+\xa7\xeat\x0c\xf8\xa9\xed_\x19<\xd8\xf1\xd6\x05o\xebY(s\x9av\xad\x94\x1b\xf1 $\x81 0\xc3\xae\xaa\xfd\xe1w\xab\xac\"\x9d\x1e	\xe1<\x84\x87\x80\xf1!\xd7Zw-\\\xe1\xaf\xadl%\xac=\x90\x91\x16<\x0cu\xc0\xd3;\xa8\x0f\xed\x86\x1e\x08\xdc\xd3\x1f\x8d*\xb8\x9e\x0d)\xedr\xba\xe8\xfbu\xe5\x11\xdf\x91	\xeb\xdf6|\xa0\xfbK\xdf\xabo\xa3\xfe6\xcf\xaf\"\xe9kK\xfcf\xba\xa5\xe2\xe9w\xd8\x91\x02\x8fu\xddcP\xd8xO\xad\xa3\x14\xdc\x0cJe\xb0p\xd3c<\xdc\xd6\xac\xbf\xbc~z^\x9f\xd1Kn\xdc\xf5\xb4\xee\xce\xa0\x0d\xfb\xb3f\x91\xf1\xa6:\xa6,\xd2\xc4\x1a\xf4\xcd\xe5\xd1u\x90z\xdc\xb5\x86Cvdt\x87\xb7\x15\x86\xdbb\x1d\x04\xbe'\x84\xe1z\xf00$\xdc\xfa\xba\xcd\x1f\x1b\xc5O\xd7+*\x95\x06\xc6\x01\xd9\xd0\xdd)7\xe4T\x11\xc6\x93\xa5hi\xb7\xb5\n!n\xa2\xf4\xe2u\x87rf\xc4.\x10\xf2\xbe\xf9\xfcd}\xa2\xd6\x80\xf4F\xd8\x0b\x0b[D\x0d\xf7\xd88\x1e\x13\xb7o\xeb\xad\x16\xaaRq\xac\xf3\x81~v\x96H\xd4\x84\x95\xa9\xe1b\xbac\xc3|\x96\xf8\x0eK\xa7Gj\xddO\xd4\xc2^< \x08\x0d\xbd5\xbc\xa9\x93\xcb\xc1\xf7k_\xd4\x8f\x11xV\xcc\xb6\xf1\x87\x98?\"\x02#h\xe0\x0f/^#\x00+C\xa5A\x1b\xea\x12\x11\x94]\x02\xfe\xe1\xca\x01'1\xb8\xbe\xf8\xf9\xdd\xf0\xd7\xe3\xd1\xf7\xf1\xf7\xbf\x07\x00PK\x07\x08>\xdb\xb7\x06F\x05\x00\x00\x07\x0d\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x0b>N]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/pt_BR/server.jsonUT\x05\x00\x01\xc63\xcfj\xbc{_o\x1c\xb9\xb5\xe7\xb3\xfd)\x0ez1k` \xb7\xec\x99\xdc \xeb\x00\x01$\xcb\xf2\xe8^IV$M\x8c`f.\xc0\xae:\xdd\xcdQ\x15Y&Yj\xf5\x04\xf9.	\xf6!\xc8\xbe\xee\xee\xcb\xbe\xfa\x8b-~\x87d\x15\xab[\xf6$\x9bd_\xa4\xaeC\xf2\xf0\x90<<\xff\xf9\x87\xa7OfK\xebZ?{E\x7fx\xfa\xe4\xc9\xec\xf4\xe8\xb7\xb3W\xf4\xdd\xd3'O\x9e\xcc\xce\x0c\x9aT\xd0\xd6P\xa7\x0c7\x14\xf8!\xcc\x0e\xa4\xf1\x8d	N\xb3\xa7\xa5u\x14\xd6L\x0be\x0c\xbbg\x9eN\x1d\x7f\xe8\xd9\x84fKG\xfe\x8ek\xfam\xcf\x1e8<5\xda\x07R\xa6&]`nm\xad\x9a\xd9\xd3'O~\x00\xe2\xd9\xe5\xcd\xe9\xfb\x81\x82K\x1b\xe8F-\x99N\xad\xa3\xf7\xd6\xdd\xa5\xc9\x8f\xadr5\xa9\xa6\xb1\x1bO\xad\n\xec\xb4j\x0e(\xacU \xe5\x98\x8c\x0d\xe41.XZ0\xddk\xde0f%E\x1b\xeb\xee\x88\xcd\xbdv\xd6\xb4l\xc28\xb3j6j\xeb\xcfmu7\xcc\x7f\xc3m\xe7\x98\x82S\xf7\xca\x91\xb1\xe4l\xad\xba\x8f\x7fMd\xdc\x02N\x96|\xe5l\xd3P\xad\xa8\xfb\xf8\xe7\x956\xaa\xe8J-\xfb\xd6Re[R\xa4\x16\xd2\xb4\xecMm\xe7\xc5\xcc\xc6\x9am\xab=\x0f\x13\x1f\x19kt\xab\x7fR.Mua}p\x8a\x82\xad\xad'\xeb\xa9\xb3>\xa8\xda:\xf6@mI\x99\x8f\xff\xcb\xe8\xd6\xfa\x02\xab\xab\xd6\xfa\x9eo\xd7\x8eU\xedG\xd4\x11L!\xc1\x0f&\xd0\xce\xf5\x86\xeb\xdcH\xda\xf8\xc0\xaa&\xbb\xa4\x9a\x1b\x0e\xda\xacp\xda\xed\x9c\x12\xda\x08\xe6\x9a\x16[\x9c$;\x15\xac\xf3r\x08>hl\n\x86q]\xae\xb6\xaf\xb5\xfd\x9dm\xfa\xb6X/`t\x1f\x81\xe8\xf5d\x16{`f\x19\x80\xd3k{\xaf+a\xa0{]\xb3\xa5\xaeQ[v\xe5\x92\xfb`Wz9\xa0\xbd\xd0F\xab\xd0;\x05:\xe9\xed\xd9))\xa3[U\xab\xbc\xec\xcft\x18\x90.V\x17}\x18I\xc5\x07\x1d\xab\xean\xe5loj\xfa\x1dH\xc9\xe8\xd0\x16o\xc3\xd0.\xa4\x96\xd8\xe2\x80\x8c\xee\x13\x98\x8e\xeaV\x9b\xe7\xbe\xe3J/5\xd7\xd4r\xcb\x9e:\xddqM\xe0\x04\xbdZ\x07\xd2&X\xda\xda\xfe\x99cj\xad\xd1\xc1\xbab\"\xdc\x917\x0f\x9dv\xdbq2\xc0\x88\x05HA\x0f{}\xd9\xb7\x0bv\xd8\xebZm=mtX\xdb>\x90\xe1\x8d0\x9a\xa7\x05/\xadcR$XI\x0f\xe7\xbe3\xdf\xa5*\x0e5\xcef\xd48\x8d\x8a\x07\x8a\x1d\x02nA6\xa7\xd7\xcaPeMP\xdaP\xdf\xe1\xce\xbe|A\x8d\xdd\xb0\xab\x94g:WA\x1bj8\x04v\x9e\xac##\xd4\xfa\x82\xa5\x04\xd1\xad\x0e\xcd\xee\xe4A`\xe9\xb6\xe2\xf7\xde\xf4\x07\xe4\xfbjM\xca\xd3\xb3#\xa3\xdb\x9e\xfe+](\xb3\xb2\xcf\xe6\xf4m\xa2\xe5\x05Uk\xe5T%\xf37\xd6\xac&3\xd7\xe5\xf6\xd6\xdb<\x19?\x04Z\xd8z\x9b'\xc4>\x8e\xc3*\xd5\x85j\xad\x86\x91\xaf\xd3w:|\x7fG\xbd\xc7lA$G\x87+D\x8a\xd2(\x91\xb9\x15;\xd9\xb0\xa0\xfc\x1d$\xeb\x1d\x93\xe3\x95\x06o\x88X\xc5-\x89\xb7\x98*\xc7\x02\xdb\x9b\xfeV\xad\xfc.	\x14\x00L\x8bP+!\xc1\xdb\xdeU\x1c\xa7M$x\xdb\xf4\xc0\xe9\xe7\xf4\xce0\x06\x81)\x9c2\xb5m\x9b-Uk\xeb\xd9\xc8\x08V\xd5\x9aVl \x1a\xb8\xcek\x98\xd35\x7f\xe8\xb5\x83\xac\xb1\xc3\xe9\xab@\x0d+\x1f\xe8k\xaa\xf5r\xc9\x8eM\x00\xee\xf2\xacq\x167\x95u\xe3Q\xbf\xce\xa7C\xbeS-yiL;i\x82\x16\xe0\x86\xe5\xc6\xd8%\xa4\x94^n!\xc8\xd4x\xae\x90.J\xcehNG\xcb\xc0\x8e\xf8\xa1b\xae\x93\xbc\xa3F\xb7:\xc8/\x9c\x0bm \xd9\x0cG\xe2\xbdm\xee\x8b\xd3)I\xb5P\x1f\xdekk\xce\xf9\x9e\x9b\x91\xe2\xb1\x81\x1aiA\xff'\xb3\x13^6*0\x15\x03c;\x08\xdf\xf0\xc2\xdb\xea\x8e\x03T\x8aW\xab\xa4|\xabF\xb3	\x9e|\xdfu\xd6\x89\x84\xee\xd8\xa5.\xcf\xeb\x88qN\xcf_\xe2\x80\xb0\x82\x9a\x97\xaao\xb0\xd3\xf7\xdc\x1c\xd0\x0b\xaa\xb5W\x8b&j\x92L\xd6\x01=\xff\nk\xf5dM\xb3\xa5o\xfa\xe5\xb2\x95k*[\x02\xe6z	\xce\xf8o\xd0\x8d5\x93\xef\xb0\x19 \xc7\xeb\x9fx\xb2\x05\xce\xbfv\\\xb3	Z5\x05\xb7\xbd\xbb\xbe\xa1\xaahH\xe7\x05\x95N\x95\xb3\xde?\xb7N\xaf\xb4\xa1\xa3\xab3\x12\x93\x02r\x08\xdb\xcd\xa6\xa6\xca\xda;\xcd~Ng+c\x1d\xd7\x07\xa4\x97\xf4%V\xa8L\xb4\x0b\xb8\xa6\x88`\x87\x98w\x02\xdc!\xc4& z=\x99\xa5.\x03\x9e`\xa9Uw<%\xab$\xe9Pu\xfap\x14$\xeb\x10:\xff\xea\xf0\x90\x1f\x14\xae\xee\xbc\xb2\xed\x9c\xbe\xcc\xe6\x8aj\x9a<_IZ\xef\x83m_\xdf\xdc\x0c\x84%b\xbe\xf5\xecp\x8a\x1aj\xf9\xf5\xcd\x0d\xb9\x1eG\xd5XUc\x89\x86\x82\xed\xb2\x8c\xf1\xdcp\x85K\x06\x15\xcd\x8f \xbf\xb5\xabU!#_\xcb\xa4\xc0\x9a&\x8b\xed8w\xa0\xac\xc6\xe6\x01Ub\x9e\x92\xd0\x93\xc4Oq\xd2\x84\x08\xbf\xb1q\xa0\x13\xb6A\x1aw@\x9b5\x1b2\xd60\x0e\xcbs@#\xb82\xb2\xf1\xdeD\xe7\xca\xac\xf6fj\x94Y\xf5j\x95\xd5\xcay\xfa\xa4NUw\x8fL\xfa\x08R\x1f.\xf7\xb0:\xee \xb9lo\xc2\x9eZ\xc4\xad\xf4\xb1\x0b\x8c^\xbf\xb6\x1b#\x9b\x1f%l'\xb7q\\\xe6\x9c^\x80\x0et\xc3y\x17\xe7\\\xb3\xc7\xae:P?\x12\xe0\xfb\x9b\x04C\xa7I\xaf\xb9u\xb8\xd0\xfeC\xaf\xbd\x82\x15s\xd6\xaa\x15\x9b\xc2\xe8I\xd7WL\xa8\x11g\x04F\xdb)a\xbd\xe6\x1f\xb9\n\xc9\x9cZ\xea\x86GM\xaf\x92E\xe5\x83c\xd5\xee\xe1~\xcb\xf6\xecj\x0fw\x84&\xc9e\xc5\xe8n\xac\xbd\x13\x1d\x8e\x13\xc5N\x8a\x8f`\x97\"Z\xa1\xb3I\x8c\x82hb\xd0\xb2\x81\x82a\x03lu\x14\xaa\xb2e\xc6\xc6\xa6r\xdf\xe2z\xaeNN\xf7\xc8\x00l\xb2\xc0\xab\x93\xd3\xb8\xbc\xbd\xe1\xd7va\xc3x\xf7\xaf\x1c\xdfC\xc3TNm\x1a\x18\x92\x07\x130\xacI8\x17\xb5\x88^\x1dx\xe88\xdeu\xcf0\xb3\x89\xcdJ\x83\xa5M\xcd\x0f\xd2\xbet0\xf8\xab\n\xd2_t\x88\xf6\xc9\xdc\xd9#\n7<\xee\xca\xde\xe9\x19k\x9e+X\x82\xc9\xee\x1a4\xf9\x84PO\xcalIU\xb2\xe1\xa4:\xe5B\x9c\x1f\xa7\xf0L\xc6?\x1b\x9a\xa5!\xe21\xab\xd1\n+\xf7\xca\x9a\x917o\xd9\xb5\xda('\x07\x98\xa6=eX\x00V@\xa4\x16\xecBa\xdf\x8a\x9e]\xa8fTv\xff\xe5W\xf8$e\xfcf\xdc\xe3s\xb8\x82\xb0\xed#tp#S\xe7\xb5\xf2kh\xc1V\x99O\x18\x88/\x86\xa1PF_\xbd\x98Zi\xc1\x06U^;n\x95n\xde87\x10\xf5\x06\x00z\xe3\x9cu~*\xfc\xa4+\xf6\x86\xa5\xf1\x11$\x17J\x8f\xab\x13Dic\x04]D\xf0\xc8\xb0+\xe5\x0b\xd6S\xdeo\xac\xab\xf7GR\x97\x9a\x1eCa]\x18P\xc8\xcc\xe4\xd9\xdd3\x8e\xc7\x85}*\xca\xd6G\xd0\xdd\xf4\x8bG\xb1}\x1a\x91\xef\x17\xb5m\xd5D\xab\xf2\xc3\x9aq6#\xaa\x0c\x88hr\xfb\xcfK2~\xe8\x94\xa9\x8f\n\xdey#\x10\xed\xe8\xd6\xd6\xca\x0f\xb2/\xd1'\x8dP3hS\x9etD8\xa7\xf7\xbch\x0f \x07\x0e\xe8\xe2\xeak\x82f\xec\xa9s\x1f\xffg\xe7t\xe2[\xf3\xf1\xbf[\xf2\xf8\xa3\x96\x0cW\xda\xcf\xe9\xd2\xde'\xd7\x1a\xfeMD\xd7RP\xed\xe2\xe3_\xdb\xd89\x92\xa8\xeb\xd2\xd1\xe6\x87nr.\xf29x\xee\xf9\x93l\xf7\xf1/\x1f\xff7\xbc8\xe5\x14)\xf7\xa1\xd7\xf7\xc5\xc5Y2\xd7\x0bU\xdd\xc9A\x0c\x1bp\x9a\xa0\xf1\x18\x12JH\x0c\xca\xfdc\x0b\xd4M\xad=<b\xd8\xb2\xb8\xf90\x0cbT\xa6\x98D7|\xbb\xedxd\xc4S\xdd0\x05\x01\x15&\x18\x8c94\xf0C`\x033\xb5\x10y?v+xa\x1b^\xb4s:gu\x8f;\xd3\x85-H\x10\x1b\x07\x7f\xb39\nc\x04\xc8\x93\xe0\x97\x10\x90\xa7JA{\x8a\xb6\x05\xa3\x18Z5\x16Bb;\xda\\2\xa8 \xbc\x99\xf8*\x90tn\x1b\xb5D\xa2\xfb$\xad\x1e\xc7\xc7\x8e\xaa\xb2\x0bT5\xe0\xc5\xa1-\x1bk\xeb3\x13\xd8\xdd\xabb\xbb\x01\x85_\x1d\xc1I0\xe8\x96i\xa3Mm7\xd8[\xcf\x955\xf5(\xb2\x04\x93\xa0\x8fn\xc2\xce\x1cW2\xeft\x82\xa2s\n\x1c\xa8\x07\xdd\xf6mrm\xb3\xc2\x04\xddLgW\xb2_b\x82\xc23O\xc7\xbb\x9c\x90\x9a,\x8e\xa4&\xcb\x8d\xb3\xae\xe2\x1a\xf1\xa4\x91\x06\x01\x11`\xdb\xd6\xf6\xc5\x06b,\xac?'^\xbb?\xa0\xe0tW\xd9\x9a\xa1b\xea\xc8i\x8fn\xa6u\xa17\xec\xafTX\x97\xd3\x08Ptq\x9aC\xdcb|\xcbJdyi,\x9c\x16j\xa0Dk\xa76\xd1u\xfc.\xb5\xfd@\xadrw}7\xa7\xe3^7\xe1\xb96yT\x8c4\xf5>y\x000A<\x97\xa2n\xa5\x9a\x86\xdd\xf6\xc2\xd6\xa3N{\x1ba$\xc0d\xf2\x83\x19\xc5\xf6\xc0\xd1\xf8\xcc\x97P\x03\"\x05\n\xc6Y\xc1\xea\x99,T\xec \xaa\xb5\xe3*X\x97#\x00'\xf9\xbbD\x86\x83\x93\xee\xf3\x1a\xa1JS\xc7\xaf\xfb_\xcaw\xad\x82Z(\x0f\xbf&\x19\xd5\xd0dt\xd8{w\xe8\xd7\xca\xf1\xa1\xf4.\x97gmi\xd0\xbf\x8d\x9fh\xca\x1f\x9f\xb7\x1d\xd7\xba\xaey\xe4\x8c7\x1e\xac\x0d\xe9\xf6\x8a^$4\xe7\xba\xedT\x0e9z\xe2\xa1\xcb\x04\x0b\x1fkc\xb8\x1e0}\xa3k\xa6\x93\x14\x1b\x8cW \xa2\x93\x06\x08\x88\xb8\xd1\x12\xb2]C\x86,\x98\xcd~Ti\xadk\x9e\x84\x84e< `\xc3C\xd54\x87%b1\x93<B\x06+\xae\xa1\x12\x86H0\x0c\x0c\x89\xfd\x0e\x96\x91\x0c\xa6\x96\x83\x92Q\xd39\xaf\xb9\xea\x9d\xd7\xf7\xdc\x8c\xe1\x9d\x01&\xb4\xd3Z\xc3\x17~tY)\x14\x0d\x8f\x02\xc1;\x08Ft\xaf9\xde\x1cr\x19S\xb3\x9dN{\xbb\xee\xdb\xc5(\xe7d\xb1\x010\x83\x9b\xb7#\xe9\x14}w\xb3\xb6\x9b\x1fh\xd1\x87`\x0ddS\xd7\xa8*\xc5\xb8\x86A\xe3\x04\xd6\xe9\x9f p\x1b\x1c\x88.\x1c+|\x8b\xae\xfbf\xe8\x92\xe6\xbaDK\xb3\xd2\x8ajK_\xff\xaaZ\xab\"\x8c\xa4\xc7\xf3>\xb7+m\xd2\x98\xa2\x03\xee\xce7\x16&E\xee(J[Ti\xb6\x00ZR\x96\xae\x94v\xbb\xc1\xee\xce}\xfc\xeb\xbdV\xa3.6\x1e]\xbb\xd8u:\xc9\xb9\x08\xd3<\x89\xe0\xfd\x19\x01+\xc3\x10\xe1&\x95\x02e\x90\xa1`\x13c\xe3\xd0\xe2\x9eI\xe7kk\xc3\xbb{vN\x17\xc2$N\xe5\xac\x0dds\x1b\x86<\x99\x9d-\x11\x9b\xa5\x8d\xf6kp\xc0\x1a\x07\x9f&\x15\x1eT\xe4\x19\xa6@`jl%v}\x8c\xe3)z}ry@\x0c\xd1.\x12c\xd97M\x9cB\xd5\xb5c\xefi\xcd\x8e\xf7\x94/\xdc\xf62\xc0\x93:\xcf\xe9M\x0cD\xbc\xa2g94\x11\xe9\x98\xb7\xbc\xea+\x05\xc3\xec\xd9\xceZ\xa7\x11\xb6\xb8\xc8\xbf%\xba\x86\x9b\x01\xa9\xa9L\\\xeb?3\x9c\xa6\xdb\x89\x9du\xd6N\xec\xac\xb3v\xd7\xce\xaay\xdf\xca\xd2\x06:\xe6\xb4\xe0\x951\x05\x90\xce\x0dB\xb0\x11\x07'\xe8N\xd8\xb4\xcd]^}o\xae\x10\xf71\xea\x15\xbd\xfc\xea\xdf\x1e^~\xf5o\x07\xb0\x0f\x95Y\xdbb:\xea\xa4\x93\xfd\xf5\xf7\xe6f\xad\\Wtn\x95\xc4\xce\x83j\xd6\xaaV\xbf\xfe\xde`6S\xb3{\x95$\xeb\xbe9\xfb\xeb\x82\xfa\x0f\xf5h\xb1\x9f\xfd\xf6\xe48\x91\x0c\xf8\xcf\x9b\xd7?*I\x11\x8c\xe2\xe5\xdf3\xe0\xa0\xfc\x1c\\\xc5\xb3\x13?\xa7\xdcg\xb4\xd9\xa2\x8c\x96\x93.\xbd\xa3\xa6\x8c\xd4\x9c\xeb\x18\x92i\x13\xea\x8b\xbeV\x8e\x14\x14|\x84#Y&f\xd6RUE\xa4\xaa\xd1\xe6n\xa4\xef\xcdCplT\x83aw\xf9x\x8e\xea\xfa\x80\x1c\xb7\xf6\x9ea\x86r\xad\x83\x98\xa9\x0e\x1d\x7f\xf3\x9b\xdf\x1c\xfe\x02B\xea\xf0y\xd8v\x08\x89K\x10\xb9*\x15x\xab|e\x8b\xf3\x8f\x9f\xc9>\x89\x01\xb0\xd8%\x1b\xd4\xbbC\xe56\x0c\xe3\xd3H\x01\xe6[\xa8\xbc0w\x9a\xa9\x18\x1e\xfa\"n}!\x9fb\x1e\xf0\x10s\x8aI\xd71\xd4\x8c\x1fC\xf8\x01\xc2[\x1bIy\x16#\xa1	\x97\xda\xf9@\xf7\xda\xeb\xc9|\x0f\xdf\xc8\xcd\x1c\xa6\x8cT\xae\x05\xf8\xa8|LMvI}\x97b\x8c\xbb\x06P\xab\x1e\xaeNNo\xf4O\xe5J\x1e\xe0mI\xf0w\xc7\xa2\x05\x08\xe8\x86\xa0\x0c$\xee\xc5q\xb2W%\xb8\xac:\x04\xde\xb0\xd0\xe8\x06H\xbf8pW\x0e\xb7\xea\xe1Z\x94\xea\xc8$\xf8\xdeN\xd6r95\xa2\x0fH\x89\x08\xda\xacu\xb5N\xa2\xde\x93\x17\xf7\x88!\xab\x16}\xdb\xf1 \xfdK	j\x97\xf4\xf2\xc5\x8b\x17S\x02&+O\x92q\xa0\xf5\x13\xab\xdf\xd9\xcc\xb8\x07\x13\xac\x92\x15<g\xb3*\x0c\xcb\x0b\xf5\x90\xe2rMl\x98\"\x8f\xc0\xc9YIo_x)yU\xbd\x91\x1d\x9a$b[\xf5\xf0^\xd7\xc5|q1\x1b\xc0\x1ee\x8e\xd8\xf2Y\xde\xe0U\x7f\xfb\xbbq\x01\xf13E\xab\xe0\x9d\xc5\x0cQ\xb4\xd3rz\xb3*\xc8\x86\x0eE?8\xb2\xa5\xe3\x1a\x11\xdf\xac\xad\x0bU\x1f\xfe%\x13\x04\xa7+\x7fk\xef\n{\xf8\"\x02)\x084\xd5\x1e\xb0r\xec\"H\xb2\x009\x89\x15\xa3}t\xe5l\xcba\xcd\xbd\xa7\x84\x13i\xad\xc3\xf4{No\xb2\xaf\x9c|5a86ug\xb5)M\x8e1\x9b>\x923\x82\x0e\xa6\x80\xa9\xcc\x1e\xfb\x89\xd4.\x05\xf6\x01B\x029\x1a+N]\xadEc\xf70S2<\xc6\xe4\xb5\xa3\xd60\x12\xcb\x95\xe8\x82\x916S\xa6y/w\x12\xbc\xb0vC@\xd6/\xd81\x85\\\x0c\xe6\xcd\x10\xfd\xca+\xbb\xe4\xcd\x10\xf7Jk\x9b\x0e@\xf6t\xe8l\xefm\x19\x8f<Z\xc0\x07l\xc9\x0c\xf0q\xa8=\xe9\xbbFW*\xb00\xf7\xb8\x97\x97\x96\xea\xdc\x94\xd9x\x12E\x8e\xb0d\xc9\x8b\x87\xa2\x1a\x88\x8em\xf4T0?.@t\xc6=2\xdbQ\xb4\x94\x93\x07]\x15\xfb\x14?\xe3$\xc2\xc9e\x1d\x8cjrZ/\xab\x1d80a\x88\xe4\xa4\x14\x0f2\x0d\xd3\x19\x96X\x82.\xdc\xfb\xcb\x04L\xd1&\xf1\xc3\xfc]\xb0]\x9a\xfa\x96\xcdZ\x91\x99\xf62\xb0_\xa4\x17Ll(\x9e\x0f\xbdEX\x8clO\x1fz\xdcZ\x14\xbalM\xb5Q\xa1\x92\xd8,\x7f\xfcKi\x86\x1b\xbb\xb9j\xd4\xb6t+nm%\x03\xd5\xca\xba\x9c\xdb\xbe\xf8\xf8\x7f\xbc\xae\x14\xa9\xd0+)\x99q\x87\xea\x10		\xdb\x13\xb2\x81\xc4d\xfb\x80\x8a\x8d\xb49\xe32br\x02\xa4\xc6\xb0\x16bL\xectY\xf4`\x9bz\x8f\xb7\xde5\xf5\xa7y+\xdb\xec\xaf\x1f\xc9\x88g[\x9fR#\xdd\x8e\xa9\xf1\x0b\xd5u\xd0\x1dv9\xf8\x9c\x16n\xa7\xdf1\x01r\x9e\x1c:\x1a\xe7\x97D\x1e\xd7{\xd9\x00\xbb1\\\xdc\xf5w\xf13\x85'\xf01\xbd\xe3\x921\xa08(\xb2\xa7x	I\x08\xe1\x0e6M\xce\x1aX\x13\x9cm\xfc\x9c.z\x1fr8bL\xb6[S\x06\x8c\x86\xad\xca\xdbp\xf5\xa9{\x89+p\xdc\xbb\xe2f\xe2\x9a\xd2B@\x8f\xa9\xe2!\x7f1fOs\xbcO\x1b\xfa\xd0\xeb\xea\x0e\x89\x95*e\xbeS\xd1	\xb6\x0d\xb5\x03)	\x0fkA\xef\x86H^\x96\x0b\xb0>\xbc\xb6\xb6\xa9\xedf\xbc\x14BY\x95\xa1\x89\x155\n>Z)\x84)\x03{\x0b\x0e\x9b|\xc5\xb3,\x8c7\xfc\xec*\x9e\xe3\x90\xcc\xf9T\xd4MhH\x8b\x9d\xbaRW\x93}\xf8[\\\xaa!Y\xa3\x06i\xfa\xcf\xf4\xaa@\xea\x9bZ\x87\xf7\x12\xe0\x9c\xd2)\xc6u\x8c|\x8e{\xd6\x07\x84\xddd\xfe|\x9e\x07T\xf7\x0e\xd7!\xdaX\x8a\xaa\xc6zNAQ\xa8\xa0\x05\x0b.\xa4\xd2\x90\xf8\xd3\xc1\x0fW\xf2\xb3{x&\xee\x9a\xc4\x0c\xc6XC\x04JT\x89\xce\xb5\xb9#i\xf7c^,u\x80\xd7\x90\x89\xe8\xe1c\xc9\xde\xa4\xd8\xab\xb9\xc3QV\x8d\xae\xee\xe6\xf4\x1eI\xe9\xa4\x8f\xeb\x032\xea^\xaf\x90\xf1\x05aa=\xc5\x94J\xe2v\x08\xbdVa'\x06!\xe4\x8d|\xbbcJ}\xe2: \x08\xda\xca\x16\xc7\x18\x01\x02\xa4gW\x9f\xdd\"vg'\xa3\xd8\xc0\xac\xec\xa0\xae\xd3\x84\x88\x10\xa1\xb4\x85\xdd\xf3\x94\xacV9\xeeK\xbaf\x03-\xc0\x8e\x0c\x02\xb3\xc1\xc6j\x1d\x10W\xdaFR\x14\xb8\x93\xa2\xbc\x02,\xc9\xbe4U\x0c\xf7\x0d\xf2p\x88\xec!\x06\xb7V\xb5d)3\x07\xc7\xf0\xf9\xa5\xd4\x9b\xedL\xb4[\xb0\x18gJv\xfbt\xaal\xcc?>\x17\x96!6\xdf\xde4\xdb~\xd8\xb0\x9b\xa6\x0f)\x05\x9d#	'\xd6<\x0b\xa4\xfc\xddH\x98[ r=\x0c\xba\xe6\xfa\xf0\xb8\xe9\x99n\xc7\x82\xd8\x9c\x7f\x805\x08\xbbj\x81v\x14\xcc\"0\x1d\xd5\xfc\xc0\xff\xcf\xfe\xd3=\x83\xf3\xfa\xec?\x17E\xe0\x05\xd6\xc5T\x9e^\xe3\xbc\x1e\x97\xa7\xd7onnSm\x8c\xaa\xff\xa5\xf2\x14d!>>\xa5\n\x9e[\xde\xafd\xc8Nxz:~\xffz\x08\x92O^\x8fO\xac\xee\xef\xbc\x1e\xb1 \xae\x08>^g\xc0\xae*s\xdc\xa8\x80bY]\x18\xb6o|Pm\x17\xe3\x8f\x81\xdb\xceR\xeaV\x84\x8a>\xd9\x03Q\xb7\xf9+z\xf6\x92\xd6\x16\xe9\xbe\xe0>\xfe\xd9O\x0e\xbbcUn\x87|\xee\xd3\x05\x9e:\xeeQ\x0b7\xf1;\xe1\xf5\xaa--\xa4\xa5\xf4\xbbG\xe7\xd7q\x85*\x06\x9cJ\xdc9\xb9\x98\x12\x94\x16\xe3\x12uwRt\xe3\xd1\xd5\x1a\xc3\x15\x1c\x01\xc0\xc7\x88\xe8\xeb\xd4CnX\xab=\xa4z\x0b\xad\xdc\xc2\x94p\x0c\xbfSDd\x1a0Y`\xb3\xbd\x9eD\x1f\xbe\x83tR\xee\x07\xfa\xf8'I\x97\xe80X\x85\x12\xcd\xa1\x85\x0d)2\x1c{\xa6Ti\xee\\TX\x17\xf3X\x1b\xbe\xbd>\x1fw\x12\xa1X\x00\x0e&\x9f\xd9\x80\x16\xb3>\x15\x9e\x0e%\x88\x88\xfaz\xdb&G \x17ut\xce\xc21\x8de\x98\x1b\xeb\xee\xca\xd5\xa1\nk\x9cS\xbe\xca\xdati\x7f$)\x0b8J\x10U\x93\xf5\xea\x80\xd2\x97Q\xa5\x9b\xb1\xbc)\x95\xd6 JQ\xectVM\x85o \xab\xca\xd9\xb9A7\x17D{\xd5\x8c\x1cw\x83\x1c\x04\xec\x9a&K\xb0\x1b\xd5\x84\xb8\x15\xb1)'\xffD\x9c\x0d\x9e`*\xe5\x04f\xbaf\xd4h\xb0A\xe8!\x96\xda\x0fF\xe5\xe7\x8af\xbd\xea+6j\xacV\xba\x01\xe0R\xe5B\xa5\xdc\x8e\xca\xb9\xcf\xe7\xaf|4\x15w\xca\x9c\x8f\x92\xa1\x9cZS\xc1sB~\x9b\xac=h\x1e\xeaM\xd0\x0d\xec\xf5d]/PL\x83B\xe7X\xfa\xa3+IJ7V\xd2I\xb6/bk\xbe\xb3\xba)\x0dv\xa8\x02\x1a\xa0)\x94lrF\x15g\xf4\xe5\x97\xd8\xa4\xd4\x85\x16\x8d\xad\xee\xa46\nJ\xa2@\x1c\xd4r9\xade\xbe\x01\x88n\x8bZ\xe6\xacl\xb6\xb6w$#b\xa9sf3\\zZ\xb3\xaaK5\xee\xfb\x85\xe1G,\x95\x1b\x81\x17\xc2xLk\x1d\x0d\xa1\xb9\xb1U\xd8z\xb3\xb6\x0d\xd3\xe1W\xbf\xa0\xb3\xab\xfb_\x08\x87\x1c\xfeR>~\x89\"\x11\xc3a\xf2x@\x9bZ\xdf\xeb\x1a.\xdf\xd9U\xa1\x89C\x99\xd5/\xdc\xab[\xdbI\xdce\xe0j\x8d\x1ag\x0f\x05\x1a-\xb9\xb4\xce\xd88\xa4^\xe7t>\x94\x8d\xa7\x82\xf1\x83\x94\xf2I\xc1\x0e\xe5\xd7\xc8k\xc7*\xa2\xaf&L\n\xd3gNG\x81Zl\xde\xaf\n~\xc5\x01M4\xa0\x9c\xf5#\x1aP\x02\x9718V.\x12\xc5\x90\xc3a\xder\x9b\xa5\xde\x0d\xea55l<\x0b\xf5\xa1\xa6\xa5\x96Q\xa2F\xd6\xbeP\x0f\x03\x02	\xff\xa9&I\xdc\x7f\xa8\x9a?\xa1x\xac\x9c\x7f2\xbb\x1e\x1d)q\x9a\xfe?\xcd>\xa9O\x8ffaY\x9e~\x04\xdf1\x11\xe2\x93\xfcI\x99_k\x06\xf9W\x9e\x83\xf4\xbcbwU\x8a\xda\xfc\x92\x05\xc6E\xa7V\xfb\x8a4\xcf0\xc6e\xac\x11n\xc9\xbc\x89\xaa\xbf\x9d\xc8L\x98\\\xe0\xfd\xc7\x081\xfeS\xb3\xaf\x9c\xee`y\xa4\xfb\x9b\xb4\xd4NF:HZ\xe2&J\x8e\xe1(\xd27\x19\x95J\x95\x12\xee\xa3\xa0\xef\xd5 g\xcc'\n\xf5\"\x9bN\xed\xcdo\x05\xf6	\x8b\xb3`\xee\x7f\xa9\xbd\x19	\xdb\x17S\x89\xb8Q\x10\xa5\xe5f\x97\xeaQ\xfa\xfeN\x8b\x11\x9a\xe0\xf8\xed\xb0\x1f\xa7x\xa8\x05\x1c\xde\x1a\xd5\xe8\x9fT\x9dUT\xdc\xe3\xf4\x94k\xda\xe3Q+%\"\xfet\xe6\xa8\x8d\xa6N\xef\x15J\x97\xda\x84x\x1c/Q\xb7\xc4\xa9\xef\x8c\xe4<\x06<\xef\xd16p\xa9\xb8\x93\xdd \x9a\x8e&\x9aL\xd5\xc3\xab\x10\xd84\x18\xc8\xf5\xfe\xd0qZ^\xb4\x9f\xc9\xdf\xbf\xe7\xc5E\x91\x92O\xe7\xb1\x9f\xbd\x9f\xf6\x83\xdd\xf0\xa1gG\x13\\\xbb\xb5\x00$\x96\xf6\xe4\x9d\xdcf\xad\xc2\x91)M\xf4\xf7\x03$\xce\x8d\x1ex\xc1\xc5\xf3\x95\x92\xb7^\x8c\xc7y\xcc\xc6\xafQ\x08\"\xc5\xba\xe3\xea\xb6\n7wX\xda\xef\xe3g\xc4\x14?&\x06a\xb1-\xd6\xddMJ\x8a\xf06\x11f]\x16\x82(\xdcH\xf5\x88C\x94\x1d\xa6\x80\x8f\xb6\xc6bx\xf4\xb5\x8fs\xa7F\xff\x1f\xc3\xfc\xf4\xc9\x1f\x0f\x9e>\x99\xd9\x0e\xf6\xda\xf0\xc8\x13\xe5\xf7\xb3W43l\xd6}\x94\x1a\xb3\xb8M\x80.\xf1\xd2\xc5\xa6}\x8b\x8d\x9b\x94&\x9a\xa9\x1f{\xb1\xcb?\xfe\x89\x1a\xe5V\xbd\x83!.Sx\xeb\x02\xb6$\xab\xf3\xd9\xb1\xd8\xab\xf9hf\xe7*\xd5\xcf\x17\x0f\xcff9DV\x80\xaew\xaa\xf0c\x81\xa2\xd8s\xb3\xa7\xb2U\xb3\xa0\x86\x12\x99\xd9[v\xa9Nov\xd4)\xf7\xf1\x7f\x98JG\xf5:\xbbJ\x86c\xfc:\xd1\xf7\xecPw\x92:#\x03/E\x9c\x11%\xb7\x9d\xd4\xf8\xe7-Z(3Q\x12\xbf\xb7}Q\xa6$\xf1\xe7:\x86h\x0e\xbf\xf0\x87\x08\x12~QT\x04Zh&\xf8R\x8e\x95\xb7\xe6U\xe2\x8a\xdf\xc3ZC\xfeEJ\xddEq3\xee\xeb\x17\x1e\xc1\x00m\xe8\x0b?O]a\xc2\xba:\xb9c\x18\x16+s\x0f\xa2\xc5wv\x05\x9d\xfdEJ\xbf\xa7\x13\xe8u&>>\xe2-\xdf\xef\n\xd6Y\n \xa3-\x9b\xc80\xdcD\xd9P\xab\x0ctH\xceD\xcfT\x8d\x88\x1b\xd2\xedi0LA\x81tY\xba\xcc\x94\xf7ze\xc4<\x95\x16\xf9\x8c\xf6h\xec\xb0P\xc2R\xc7*Q \x1b\xe7n:\xae|\xa2\x82\xbb\xe0Gs\xec\xdf\xaf\xde\xbc=\xa0\xab\xcb\xb7\x07\xf2Z\x13\xaf~\xdf\x1c_\x14\x8f\x15\xa8M\xd2\xbe\xd6m\xaaL\x85\xce\xfc\xfa\xc5\x8b\x87\x97/^\x1c\x0c\xcdc\n9\xe6p\xe9?\x8ee\xad\x06\xa1\xd0\xde\xd4\xf3D\x8f\xac\xe88-'\xbdM\x03(\x85\xfc\x13|\xad\xcc\x8a\x8b\xc4\x02\x9e\x9c\x99\x15\x0f1\xcc\xd4\xad\xc1~T\x18\x9e\x7f&8+h\xed\xd9k\xf9\x11a\xd6,\xf5\xaaw1\xac&\x8d\x19\x92\x94\xff\xb4\xdb\x8d\x1c\xff\xb4_d\x89\xd4\x117\xa9@&\x9f%&\xd4\x16\xa2\xe5D%\xa7{\x16M\xbeaH\x19\xbd+;d\x9d\x95;\x88D,;\xa4\xdc\\n\x1f\x12s\xb3\xbaw935;\xc9\xbfs\xa1\xb7vr\xd9P\x0f&?\x05\x9ek\x9aAh\xae|N-}u\xf7.r\xd9\xe9\xb7\xaf\xff\x83\xde\x9d\x9e\xc6\x86X2\x80\x01o\xe3/\x81Je\xda\xec\xec$\x7f!\xce\x19\xe4\xac\xcf\xf2\xef\xd8\"\xcf\xc7nt\xab\x9bxD\xf1=\x19\xf9\x08I\xba2Q\xa7\x9b\x86WR,<;\x8b?'\xb5\x1b3\xd8N\x99opP\x8d.\x9e#e\xde\x1azj\xb3\xda\xbb\x07j\x8f\xeb\x7f\x8e\xe9\xbf\xfe\x7f`\xfa\xc6\xae\xac\xe4\xd0Q\xb5\x87_\x89&\xfc\x8e\xc5\xf7h\x80\xbb\x0b\xf3\x8ej\xbe\xd7U>\xa1\x9c6\xcc\xe7\x9a\x93\x8d\xa3\x90\x19u\xcd\xec]\xfa)#\xed\xc6\\\xda!\x88\x0cYH\xb5\x04Z\xf1\x94\n\xe1\xda\"\x96,\x81m\xf4\xba\x1a\x98\xa9\xeb\xddj`\xb5+|\x08\xa7\x1d\x16\xec\x18\xd5\xfb\xad\xb5M\xd0\x1dF\x9f\xea&\xf0p\x88\x10\xd2\xbe_\xe0\x0d\xd4Az\x9a\xeb\x92\xecC>\x9b\xd8\xe0\xb9\xb1\xa4\xa2\xa0\x9f}#\xbe\xe2\x9c\xf2\xe1\\\xf3\xaa\x07O\xf0C~$\x99\xa4\xf5\xccs8\x16\xc1&\xab\xbe\xe1\x90\x12\x94i1\x9e\xc3y<\xf0\xdc\x9c\xce_,\x94b\xeb\xfcZ!\xd3\xf2\x8a\xf2\xaf\x02z,>\xd8\xecF>h\x91\x8a-g\x89Q\x93e\x88\xa1\xe9\xe7\xc8\xc2\xf2D\xdc\xf9!}\xcd\x0f\xda\x879\xdd\xda\x18\"biN\x9b\x84\x83\xd8\xa8\xed\x01\xad\xacl\x82\xc8L\xc7\xbe_\xe4\xf7\xafP)\x91)\xbf\xff\xc4e\xf9~F\xd5\x9a\xab;\xceB6\x9b\x06\xa0\xee\xc6\xbaP\x1cH\xea\x10]\x95A\xd2d\xc7\xa6<\xdbxpI\xcb\xf4&$=\x8e\x8ct\x92\x8a\x12\xbf\xb4\xa2~B*j\x97fy,\x80>\xdf\x1a\xfd\xa1\x97**tE\x98\xbe\x82\xe7u(\x8f\x85\xa0S\x0b\xab#\x05&\xc6\x98\x84\x04\x01\x80e\x08\xf9\xcf\xc4h\x01d0^Ps&\x10\xfc\x97Q\xbdI*\xf0[\xb3Pf\xf6\xf4\xc9\x1f\x9f\xfe\xf1\xe9\xff\x1d\x00PK\x07\x08\xa2\xe3\x84\nZ\x18\x00\x00vC\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x004<N]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/ru_RU/common.jsonUT\x05\x00\x01T0\xcfj\x8cW_o\x1b\xc7\x11\x7f\xa6>\xc5\xe2\x00!	`8\xef~\xd3\x1f:&*K\x82H\xc7\x08\x9a>\xacx+\xf2\xaa\xe3-{\x7f\xcc\x10\x81\x01\x8aB\xec\xa6R\xedT)\xd0\xa0p\xac\xaa	\xda\xc7R\xb4hQ\xa4H}\x85\xd9\xaf\xd0OR\xcc\xec\xee\xedQ\x95\xdb<\x89;;37\xff~\xbfY}\xbdT\xf2\xf6d\xdc\xe2\xa9\xf7\x80}\xbdT*y\xbb<\x8a\x84\xef=`\xde\xea\xca\xe6fy\x9d\xad~\xc1>ZN>b\x0f\xb7v\xd8rB\x7f\xbe\xf4\x96\x93/=\xef\x1e\xea\xfb\"\x14\xa96X/o\x94k\xceB\xdf\x07-\xde\x10\xebN\xa9\xf2x\xe5\xb32\xfb\xb0j\xb5-\x83P\xc4E\xe5\xea\xf6Ve\xa3\xbcs[=\x12\x9dm\x99\xa4I%\xaa5c\xc1\xc9`\xd9g\x91\xe8\xb06\xcaY\x10\xb1\x94n\xeek\xff$]\x89\xfc\n\x86\x94l\xb5\x82\xd4\x04\xbe\xeck\x8b\x8f\x93O\x18\x8f|\xb6\xec3\x8a\x05\xcf\xd2\xa89\x0f\x1f0\\T\xcc\xe2\x86\xf01<\xf4\xbf\xbdU\xad\xb1\xed';\x9f\xb9\x14\xfe\xab\x8eI\x93\xfb\xb2\xb3\x1a\xd8\xeaW\x1f\xad\xaco=e\xab\x95_\xd2\x04\x9d\xe6\x86\xac\xef\xd7d\xa3\x11\xea\xacj\x8fv\xca+\xeb\xd8\xb3\x85*g\x91k\xf1\x93\xcd\xc5&ko\xcf\x02\xd1\x11~\x95\xb7De\xdb\x86_e[\x0fY\xedQ\x99UW\x1e\x97Ye\x9b=-\xef\x94\xd9\xe7\x95\xf2S\x17\x9d\xb7Tz~\xcf\x0cT\x82\xf3D\xa7v\x98\xc5<L\xec|\xf9\xbc\xeb=`\xbf^*\x95J\x1e\\\xc0\x08f\xea\x98>K\xc7\x19\x8c\xe0\xca[*\x95~\x83\"\xaf)\xb38\xd7V/a\xa0\x0e\x8c\xae>\xc0\x1c\x86N\xbb\x15DY*\x9c\xf7k\x18\xc3L\x1d\xaa>\x0c\xbc{\xb7D\x05+\x19\xa5\xcd\xa2\xd1H\x1d\xa8\xd7\xea\x85w\xef\x96\x00F\xc5\x8f%\xa2.#?\xb73Gm\xa3\x0f\x89S\xee\n\xee\xf2\x80w0\x87\x0b\xeb~\n#\x13\x8d\xa9\x1eM\xa2\xad\x16\xf7[A\x84=\x80\xef\xe0B'D\x86\x1eoH\x14\xab>\xcc\xe1Z\x1d\x1a!\x05\xe4\xc1\xd8\x1e\xa5\xb5\x9d\xc1\x1cf0\x86k}S\x97Q\x1a\xf3z\xaa\x91@:g0\x84\x91\xea\xe9\xea\xa8c\x06c\xb8\x849\x9c\xab\x1e\x0c\xe0=\xf6	\xc6\xeau\x11\xf3\xab]7\"\xf0\x13\\\xc0\x00\xa60\xd6\xc6Cu\x00#\xa6\x0e\x98\xfa#\x85\xf8\x0e\xe6\xac\xb2\xad\xad\xc5Wm\x1b\xe8\xdf`\x00\x97\x8b\x1f.\xea\x14\xc2\xbbC\xf3\x7f\x86\xd8\x0c|a\xf2\x9a\xa8\x9e:r\xaeM=\x87\xfaC\xbf\xe5Q\x90\xcaX\xd7\xe0\x14\x8b	s\xf5-\xd5jb4\xb2$\xdd\x94\x9d\xbc\xdaSu\x0c\x13\x983\xf5\x123\xd3:\xa1\xac\xefk\xd0\x99_f\xb2|\x11\xf3\xdc\xfb\x1bl;\xd5x\x80\x96\xaa\xa7\xb5,o`P7\xaa\x07s\xb8Q\x87\xea[J\xc7\xb8\x97\x9dH\x18''0\x85\x01\xe1\x06\x07\xe7\x05\x83\x0b\x98\xab\x03\x98\xd8\x9e'B\xac\x84\xa1\xc9\x1cs\xe9\xab\x1e\x8c\nM\xb1l\xa33\xa2\x94'T\xdb\x81+Q\xa29\xd8x\xb9\x819\\\xd1\xe7L\xc4)qL5\x0d\xea\xfb\x88e\x0fNU\x0f\xc6Xg\x18\xc1\x8d\x1e\x01\xcb5\xae2\xf9\xef\x9cc\x16\x06\xe8\xef\x18+\xc3I\xcb\x07\xa5+34\xfc\x18N\xd4\xd1'\x96\\\x92nT7P\xf2\xd0\x04&0U\xaf\xd4K\xf5'\x0b\x0d\xa2\x85\x11\\ \xfa\xa9\x8ac\x9b\xb5:@\x99\xfa\x86\xaa\x8cr\xcc\xfa\x85\x9b\xeb;\xee\xc7\xa4;\x84A\xee\x1d\xdbw\xf0\x7f4\x97\x08\xf7^\x1a\xb4\x84Er\x9d\x87\"\xf2\x8b4\xf0/\x98\x99),y\xf03\xb1\x8b\xe1\x84700\xb5.!\xf0o\xdc\xe1\x0d\x0c\xe0\xca\x1e~P\xaf`V<L\xed\xcdw0\x84w\xf6p\x86]\xb1\x87\xb70Q}{\xf8\x11\xe6&\xf5\x92\x07\x7f\x86\x11L\x1ceu\x84\xd8w\xb1\x9e\xe0\x8cY\xcdS\x989\xfe:\xc1	\xb3\x873\xd5s7\xffT}\x97\xd2\xa9\xea\xbbX\xcf\xe0|\x91\xf3\xb2\xc0\x96iW\xa6\xa9la\xdb\xe1Gl\x90\xe1+\x1e\xd5\x85\x9e\xea\xb7\xaa\x8f\x1c\x0d\xb3\xe2\x9c\xd5y\xcaC\xd9 \x85\xbf\xe2$\x13\x17\xcdM\x0d\xbcz\x18\xe0f\\\xcb\xbd\xac\xa1\x80\xa5\x92\x19\xc7EV\x93<\xf6\xef\xa24\x034\xcb\xb5\xbe\x8c\xb0\xb7\x1e|OS\x883b\xd0\xba\x17\x84\xa2&\xe5\x06\x8f\x1b\xa4\x81g\x96J\xc9B\x92\x18\x9d(H\x9a\x06\xf5\x7f\x81\x81\xe6@\xf5\xfb\"\xea\x1bR6BQ\x95Q\xc3\x94\x03I\xee\x1a\xc3Aj%\xf6\x81su\xc4`\x8cqa\xd6\xc8\xbc7\xb8\xbf`\xa6^\xd9\xe7W[\xc6\xe9\x9a\x8c\xe3\xacMo\x11\xf8\x01\xae\x11\xd2\xaa\xa7\xfa\x0c\xf7-S\x87:Q\xdcr\xf7\x19\xfc\x8c#\x06S\x86J0D\xf6\x80\xf7p\x81\xf02\xcf(\xedr\xdd\xe6\xbf\xe0\xef\xd2\xa5B\x16\x0c\xcep>\x10>0V/`\xc0\xe0\x9c\xbe7Bm\x8c\x15\xfd\xa3\xd5;\xd5S\x87f\xd1\x0c\xec\x97\xa2g<\x0c\xfc5\xdeN\xebMn\xaa0\xb2[\x00\x06\xea5#\xf2\xba\xc1\xd7\x806\xd9\x17\xa2M\xaf\xcd j<\x94\x886\xefWB\xb4\x99odlO\xc6\x86\xb3y\x92Z\nT\x07\xc8o\xf4\xfe\x18\x1b|\x11\x93\xbb\x87\xa5~W\x99\xf7$\xc3;\xa7%\xfc\x9a\\us\x8b\xed\\\xe0B\x06Cr|ig\xa7%\x02_~\x1e$\x81\x8c\xec\x13\xf11\x8a\xd83\x92\x19\xa5,I\x1f\xf3\xb4\xde4A\x0e\x88\x89\xa60\xa6Q\x84)\xbc\x87\x99:b\xc4vC\xb8\xa1\xadP\xe0\xf0Ht\\\xf4\x88t\x18\xaa#\xb8b\xd4\x8e\x91A\xa9\xd7\x96A\x94\xd6\xe4Z\x01?\xa7\xa6)W\xaa\x8f\xdf\x9aP\x89\x1d\xa2l\x0e\xf4L),h\xaa\xa2\xea\xab\xa3O	\x81=\xb4\x86\x19.\xa6Ok\xb5\x0d\x9d\xd2\xef2i^\xdcp\x82,\xca\x90\xda`N\x14\xdc/P\xed\xd4n\xb3X\xf0D\xbfav\xf4/#\xf5\x83X\xd4\xd3\xd5\xae\xde\x1d;\xe6\xccx\x18\xb2\xddn\xbe?\x9c\x9e\xab\xc4m]\xddP\xebw/\x16\x89\xae\xf7[8\xa7G\xd3\xb0\xb8\xceb\xd1\x0e\xbb9\x0b\xe1\x18\xf6o]\xcb8\x9f)xoJ6\xc4\xf2\xa9c\x84\x97\xfdN\x9a\xc5\x91\x19gZ\xbd\xb6\x1dq\x16\x8a\xe4\xae\xbd\xac+\x85\xd8\x1a\xe3\x03\xc0,q\xc1\xe3|<\xe6\x9a\x06\xecM\x82\x83T\xfe\xaa\x1d\x98\xff\xa4p\x0b\xe8\xb5\x85\xa0\x19c\xab\x90\xef\x0b\xce\x9a\xb2\xb3)\xd3\xa0.l\xfe\xea\x0f\xea5\x0c	\x18\x85\x1d\x9ad\xbb\xad \xcd\x8b\x90\x87\xe5\xca\xe0\xfe\x19\x11\xfez\xcc\xf7H\xb9\xe6\x80#\xfc\xfb\xec\x0b\x99\xc5\xcc\xc7K\xd6\xe4	\xdb\x15\"b\xfb\xa2\x9d\x1a\xe0\xa7\xcd\xac\xb5\x1b\xf1 \x0c\x0c\xf9}O\xc4\x88\x83i\x16\xb6.\xc8\x08\x86\xeaX\xbd\xfaw\xef\x1f\xc6N\xb6\xcd+	\xd9\xf4\x1b#\xec\xb6\x8d\x1b\xf3\xcb<M,\x07[\x08\xc2O\x08T\x1aK,\x15\xb1\xe9\xcc\xb0\x93\xa1g\xe44D\x1d\\\x11=\xa2\x96\xf5\xd6A\xa8\xba9{\xa2\x05\x0b\xf3\x95\xb5C\xc9\xfd\x87AH%~B'\x86\xdb\xc1\xf8 \xc1v,\x1b\xb1H\xf4\x148b\xbcD\x14\xe6y\xe2\x7fh&'\xfb\x93\\\xdc\n\xe2i1\x84\xa5\xd2\xf3\xa5\xe7\xff\x19\x00PK\x07\x08\xdc\x81\x88\x10\x11\x07\x00\x00\xf0\x0f\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x0b>N]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/ru_RU/server.jsonUT\x05\x00\x01\xc63\xcfj\xd4|ko\x1c\xc7\x95\xf6g\xe9W\x14\xe6E`'\x18\x91\xf4\x05A^\x7f\x08 \x8a\x92\xcd7\xba0\xa2\x1c\xe1\x85\xe3\x005\xdd53\x1d\xf6t\x8d\xfbBr\x12\x04\x10I\xdf\xb2R,[	\x10#\xb1\xad8	\xb0\x1f\x82\xc5\x0e)\x8e5\"gF\x80\xf7\x0fT\xff\x85\xfd%\x8b\xe7TUw\xf5\\()\x9b,\xb0_$N]N\x9d:u\xea\xdc\xab\x7fy\xfe\\\xad)\xe3NR{\x83\xfd\xf2\xfc\xb9s\xb5+\x17\x7f\\{\x83\xbds\xfe\x9c\xf9\xbbN\x7f\xa9?\xab\x81:\xc9\xf7\xf2}\x86\xc6\xf3\xe7\xce\xbd\x8b\x8e\xda\xf5\xcd+\xb7\x8b\xe1\xd7e\xca6yS\xb0+2f\xb7e\xbce&\xafJ\x1e\xfb\x8c\x87\xa1\xdcIX\x87\xa7\"\x0exXgi\x9b\xa7\x8c\xc7\x82E2e	\xe6\xa5\x925\x04\xdb\x0e\xc4\x8e\xf0Y\x101\xcevd\xbc\xc5D\xb4\x1d\xc42\xea\x88(-W\xe6\xe1\x0e\xef%W\xa5\xb7U\xac\xaf~\xaf\xfa\xea$\xbf\xa3\x06\xea\xa9\x1a\xe6\xfb\xf9=\xa6\x8e\xd4X\x0d\xd5\xe3\xfc\xc0n\xe4A\xbe\xa7\x06\xea\x91:V}\xa6\x9e\xe6w\xd4D\x8dT?\xdf\xcf\xef\xaa#\xfa\xff\x1eS'\xcc\xceb\xd8r~G\xf5\xd1\x90\x7f\x98\x1f0LT\xdf\xa8\x01S\x83|O\x9d\xaa!\xd68Q\xa7\xaa\xaf\x8e\xd5	\x80\x8e\xd5\x80\xf0\xd8WCZ\xbe\xef \x1d\xc9\xa8\xd7	\x12Q\xe2\xfc\xa9\x1a\xab	\xa0\xab\x11\x10U\xfd\xfcC5\xcc\xef[t\xbf\xca\xf7\xd5D\x1d\x12\n\xdfX\xfc\x8e\xf2=5\xc8?`\xea\xa9\x9a\x00A5\xa0}\x1c1\xd5w\xa0\xf5\x01\xd1Y;\xf6\xda\xc1\xb6\xb8\xd5\x8e\x05\xf7\x93\x02\x81\x8b\xba\x99\xa5\xa6\xbd^i\xed\xc6Y$|\xdb\xc9\x82(I\x05\xf7\x99l2_\x84\"\x0d\xa2\x16K\xdb\xa2\xb3\xc4\x0cX\xdd,|\xd6\xe8\xb1\x8e\xf4E\xccS\x19't\xcaI\x1a\x84\xa1\xed_r\xf0\xca\xfc@\xfeD\x86Y\xa7\xa4\xcaE\xb4\xb1m\xdd\xa81\xd2#\xb02M\x00{t\xb2$\xf0\x18\x8f|\xb6\x1d\xf8B\xb2n\xc8{\"N\\\xd0\xa9l\x05\xcd\x02,\x11\x9b\x08\xad\xe9E\xe4\x1a\xe7w\xd5\x80\xbd\xb9~\xe5\x02\xb1\xc3@\x1d\xe5\xf7\xf2Oj\xf5\x17\x9eQ,\xdbh]\xcb\xd2r3\xf8\xc1V\xb9\xb7\xd5\x8ae\x16\xf9\xec'@\xd6\xc0\xa7\xbe\xb4-X\xa3\xec\xa7\xcd\xb8\xd0\xf4\x04\x0bn\x01\xa4\x8b~'\x88.$]\xe1\x05\xcd@\xf8\xac#:\"a\xdd\xa0+|\x96\xa41\x0fZ\xed\x94\x05Q*YOf/\xc5\x82ud\x14\xa42v\x16\xc25\xbd\xbc\xdb\x0d\xe2^\xb1\x98z@$\x19\xe5\xf7\x99\xfa\x86\xf8s\x0c\x8e?\x06\xdf\xa9\x135\xb4d\xfaW5\xa4\xdb0\xc1\xfd\xc0\x05x\xc2\xd4\xa1\x1a\xa8\xc7%\x8f\x12\x7f\x1ecD_\x1d\x81\xab\xf3\x03\xbaK\xa7j\x00\xa0\xf9}\x17l\x15\xa9\xeb\xdc\xe1\x0d\xf5\xb9\x1aU\x07\xd7+\xed\xb8\x00Gj\xa2\x9e8\xf0\xeaL\x8d\xd4\x04\xd76\xdfg\xf9\x9e\x9a\xa8c\xba3\xc5\x8d\x02^\xaf\xac0\\c\xba\xb7c=\x0fw\xec0?P'\xc0}\xc8\xe8j\xbe\x9f\xdfa\xf8	N\xfa\x86\xf6:bD\xa4G\xa0\x01I\x8b\xc1\x14\xfe\xb7\x824t6\x001\xf5HM\xd4\xa9\xc1\xf4d\xcef\xe6\x8c\xa1\x8d\xe5w\xa776V}bC\xf0\xf5 \xbf\xc3\xbe\xfd[\xc1\xb4\x03\x86\xc3\x82$\x18\xabG\xaa\xff\xed)\xd1\x01\xb2i\x0f\xc3\xf3\x035b\xaf\xac\xac\x80\"\x98}d\xd7s\xd1\xf7KfX\xc5\x0f\x8d\xdf-\xb1\x9b\xb2\x86\xf4{\xb8\x90\xe0\xdf\xaeL\x1c\xe1\xec\xf1n\xea\xb5y1S\xfd\x81\x90\xfcH\xf5\x9d\xfd\x91\xe4\xea\xab#u\x9a\xdf\xd7\xa7\xf0\x14\x08\xe4\xf7\xd4c\xec\x14G\xa3\x06\xc4\x1eO c\x81\xdd\xb1\x15\xeb'\x1a\x9e\x96\xc7\xa7\xfa\xd8\x07\xeaDM\x88\xb1\xee\xe4wqr\xc7\xe0CZ\xe5H\x0d\xd5\x939\xb4\xd2?\xa6\x8f\x8fD0(7\x04e&\xea1\xf1)H:d\xfa|\xd10\xb3\xdb[\xbcU\x8a\xd5K\x9a\x02,E\xa3\xa1\x19o%Pq\x89\xccbO\xb0\xa6\x8c\x99\xa1\x13Kd\x98\xa5\x81\x8c\x92%v#\x12\x98\xc4\x82\x84\xc5<\xf2e'\xec1\xaf-\x13\x11\xd1\x0c\xc1\xbd6k\x89\x08\x92U\xf8\x16\xc0\x12\xbb)\xde\xcb\x82\x18\xa2Z2OF)\x87\xfaLY(x\x92\xb2\xd7\x98\x1f4\x9b\"\x16Q\n\xd8\x89#}\xbd6\x8f7=\x19\x97\xecy\xa9\xcdc\xee\xa5\"fI\x97wXB\x9dF\xc6Di@\x8d;\x82\xc4\x89lB\xc8\x07\xcd\x1e\xf4\x00g^1\x13\x8b\x13K,\xb1\x8bM\x80\x12\xbb\x9e\x10\xbeQ\x17,\x0c:AJ\x7fe\x89\x88\xd9\x0e\x14C$4\xf2\x89\x0c\xb7\x05\xe3\xc5\xce\x1cTe\xa7\x1b\x8b$	dtUl\x8b\xb0\xc4\xb8\xec`!\xf5ht\xd7D3\xe4\xa9`\xceD\xdd\x0f\xc4wD#\x91\xde\x96HYG$	o\x89\x84\xe8\xeb\x85\x81\x88\xd2\x84%Y\xb7+cRp]\x11\x9b!\x17|\x0dq\x89]x\x05\x07\x84\x1d\xf8\xa2\xc9\xb3\x10\x94\xde\x16a\x9d\xad0?Hx#\x14\x89\xbbl\x9d]x\x15{M\x98\x8c\xc2\x1e{+k6;<b\x9e$\x92@\x83\xbd\x02\xce\xf8\xbf,\x8d\xb9/X\xd2\x051\x80N\x12\xfcBTH\x10'\x97b\xe1\x8b(\x0dx\xe8p\xdb\x8d\x9b\x9b\xccs:\xccy\xc1\xe4b^,\x93\xe4\x82\x8c\x83V\x10\xb1\x8b\x1b\xeb,\x16\xefe\"I53\x8a\xc8g\x9e\x94[\x81H\x96\xd8z+\x92\xb1\xf0\xeb,h\xb2\xefa\x87<\xd2v\x9b\xf0\x99\x060\x85\xcc\x0dj\x9cBD\x9aF\x8c:W3C\n8\xa9d\x1d\xbe%\xaah\xb9(-\xf3n\xb0\\gI\xe6\xb5\x19OX;M\xbb\xc9\x1b\xcb\xcbb\x97w\xba\xa1X\xf2dg\x89}\xcf\x9a\x93<\x0c\xedz.jY\x92\xca\xce\xa5\xcd\xcd\x021\x83\x8c\xfa\xca(y\xc8\x86\xd3\xfc>\xe96\xe8\xff\x85\xb2g\xc4.mnj\xd3\x00\xb2j\x08\x15Q7\x82\x03RD\x8d\xa7\xa1\x1cA\x0cC\xfe@X\x1f\x1a\xe3\x11\xc2\xfb	#\x896\xca\xef\xce\xc1\xf4\x96l\xb5\\%\xf1p\x1e>\xf9=\xad\x97\xd4\x13`e\xf7\xf4ial\x16V\xca\x19\xf2t\nF\x81\x89ad\x97hd\xf5\x8f`\xd3>U\x13\x06uAH}d\xcc\xe1\xc2H\xfa<\xdf+\xd7\xca\x0f\xb0G\xd5\xcf\xef/\x9af\xc8\xa0\xfa3\x8b_\xe5Q\xab$\xc1\xbf\xab\xc7\xf9]u\xb2\x08\xcc\x19\xabk-\xb9h\xf5\xfb\x1a\xee\xcc\xd6\xaf\xf2$\xbd^,\xbff.v,\xba\x10\xc12\x8bR\xb3\xe2\xf5\xac\xd3\x101\x84\x08\xc4K\xa2\x87\x04\"aI[\xeeDLF\xc6^f]\x12+\x8d\x9e\x15\x12Kl\x05\xec\x8fa`\\\x87a}\x91\x80ec\xde*Y`M$\xd9\xa6i\xc3\xa0\xca\xa8%\x19\xb74\xd3\xc2\xf024\x82Z\xcc\xefh\x03\x06\x7f\xab\x91\xb3\x82\x16KdY\x97K\xe8FmR\x9bEn\x8a\x9f\x0b/5Vv3\x80$\xdb	\xd2\xb6\xccR\xc6\x8d\xa1\x9d\xa4\xb1\xe0\x9d\x19\xd8o\n\xb9\xbe1\x03[\xb7\x1a\x89,\xc9\xd9\x0b\xa5\xdcbY\x97\xe4'\x116\x06\xf5d\x93T\x86\x88\x93%F.\xa3^\x985C(N\x11\x01\x9a\xaf\x95\x05Q0\x92\xba\xcb%\xa3\xde\xcf\xc6\xda\x95\x194\xd0V\xd9\xe0\xc6\xda\x15\xbd\xbd\x99\xe97eC\xa6\xa5LS\x9f\x91\xa5v2u\xbb\xe8\xaa\x1dj\x8bC\x1d\x19\xd8d\xb7\x91\x84\xc1)\xe8[\xa8\xc7\xf4\xd5\x88\xac\xb1\xe2\xc8\x8c57r\x01\xd1 2\xae\xf3\xfd\xfc@=e\xaem8\x98\xd9\xe7\xdb\x89\x885\xa5Jd\xffl\xee\xe1\x89\xb6\xb4\x8f\xe1\xed\xa8\xf1\x8c\xfd\xabm\x9a\xc2\xe3\x9dk\x7fN\xed\x83\xbcN5\xaa3\xf2\xb1\xe16\x0f\x9c\x15T\xbf>\x17\xb2\xb5Z\x07\x8e\xd5\xealDF\x8e\xcc\x03\xf1\xb4\xf4\xfc\xd8\x12\xcfx\x0f.yO\xc8\xbe\xa3^\x10\xdf\xfe\xb4W\x9e\x86\x17\x0b\x90\xa1\xd2\xe0ai-\xfc\x9f\x1f\xe0\xa7\x9e\x8b\xc5\xf6\xf3\xbb\x16\xfa\xd7\x147\x80{p\xe2\xf4[\xcf\x85\xccL\x1c\x1bL\xe8\xb1:\xce\xef2\x0d\xeb9\xbd\x8b\x95\x19\x98C\xf6\xea\xca\x1c\xf3\xdb8\xf8\xea\x91\x9a8\x1b\xe9\xf0 \xbc\x1c\xc7\xc5>.\xa3\x81]\x8ec\x19\x17\x16&\xe9\x10FCaX\x08\xea\\\x9a\x05r\x8d\x07%A\x08\x90\x81@\xe04\x809\xd36xRr\x1a~\xec\xc8\xd8\x9f\x9d\xc9\xba\xa6k\x1e\x08\x19\xa7\x05\x08Z\x99%\"\xde\x161\x83\xb95\x07\x96\xd3;\x07\xdcf\xd6\x98\x0bm1\xa0$k\xf8\xb2\xc3+\x96\x8c\xd8m\x0b\x98\xcc%(\xdb\xa0\xc1\xd8\xfe\x17\x16\xbab\xb7\xcb#\xff\xa2\xc3}\xeaO\xaa\xaf\x1e\x13\xe3\xddQ\xe3\xfc\xc0	\xe80\xf2\xaf\xcb`\x8fq\x8bk\xf5\xbfs\"{\x19N\x11\\\xa7S\xfc\x04\xab\x93\x07\xc4n\x8b\xc6\xb5:\xe4_\x9d]\xdbx\xadps\x0e\x8d\xab4(B\x1c\xf6\xf6\xe5w\xbf\xeb\x90~\xb7\xeb\x9e\xa1\xfa7r'1\x924\x8f+#k\xf5g\x0e!\x8c\x8c\xdb<QO e\xe1]\xe7\xef\xab\xbez\xa2N\xcbe\x9bB\xf8\x08\x92\xd0)\x97\xe4\xfcJ\xef:\xdf'8\xf7\xb1\x93#R\xf0\xc5\xe2\x0f\xd5$\xff\x08\xb2\xb5\xce\x8c\xb0.\xa2i\xa5\xa1\x82;G\x82\xe7\x03\xe3\xd1\xabCc\xb6\x0d\xaa\xfe<4\xc6\xad^W\x94\xf7\xe0J\x10\n\x96R\x93cu\xc3~G\x87\xd8ME\x04\xcf$)-\xda\x9fw[L\xc6\xf0@:K\xec\xaa\xe0\xdb\xb8\xb2\xdd\xb4\x07\xdb\x80\xccZ\xfck=\x10X\xcc\x00nt\"\x0b\x03X\xee\x1e\x87\x9dAv	\xf84b\xadPB\xac\xf5J3\x9b&9\xf4\x0b+\xee)i\xdd\x9eV\xa0\x06\xef\xb5 A\x04\xcd(a\xe6\xb9C`\xd4\xa0\xddQ\x96\xcdPJ\x7f=JE\xbc\xcd\xcb\x03\xb9\x82V\xc4\x99t\xb3\x91KAG\xb0\x9d \xf2\xe5\x0e\\\xc3Dx2\xf2\xb5\xbf\x05\x13\x80 \x11x\xed\x19N\xad\xb1A\xebV\x17p\x06\x9b@\x1a\xdf\x0d:Y\x87E\x85m\x86!\xc0[\xb0\xf5\x0d\xa2\x17y\x1d\xb0(\x82\xc8Y\xd6\xa2jl3cA\xb8\x84\x93\xb1'\xfc\x8b\x91\x8c\n\x1c\xd4_\x89\xdb\xf7J\x8b\x00WF\x9b\xbcn\x04vl\xaeO\xc1\x8b\x88\xe6\x9e\xa8\xd3\xfc\x93\xfc#\xab\xe0\x8c\x13A\xea\x13\x97`\xa8\x9e\x92\x92\x81z1\xf6\x02\xd8\x17\xa1\xe8\x83J0\xad\x82a\x9aE\"\xd9\xe0i\xbb\xa4\x93i$\x1b\xc7\xacOQ\x1b\xfc&2\x10m\x9az\x18\x9c\\\x16\x06\x91`~\xccwt\xa8\xe1\x1d\xd3\xf7.\xeb\xf0x+\xeb.\xb1\xd5,\x08\xd3\x0bAdg\xe9\xc0n\x96\x18\x8f\x11\xa6]\"\\1\xdd\xe2a(\xe2\xde5\xe9;Z\xfeOj@\xb1\xc4\x11CP\n\x01\x1e\\\xb12\x8e\x07\xcf\x07\xc6\xebc'$O\x1a\xb30k\n\xb1\xc4\xf2\xbd\x05\xc2\xaf\x1a\xfbn\xc1\x06\xad\x90\x87\xacR\xe6\x07\xb1\xf0R\x19\xdb\xb0\xd6\x9a\xfdm\xef\x95\x8dX\xd0\xf0%\x1f	\x8b\xc8\xd7\xbf\xb6\xbfO\xbf}\x9e\xf2\x06O\xe0=\x1b\x8f\x81\xfc\xea\xe5,\x89\x97\x936\x8f\xc52\x8dv\x89\"\xa5\xeb\xe9\xbd\xa9\x7f\xa2\xcb\xfex!\xc3\xbe\x1d\xf8\xbepx\xf3kk\x11\xa9\xc9\x1bl\xc5\xc0U_\x11\xc7\x81u4\xdf\x91<\xb6\xb6N\xbeW\xce\xa9Z\x1d\xed\xc0\x17\xabA\x14	\xbfX\xe0\xad\xc0\x17l\xcd\x84\xf9\xf5\xdd\xc4P\xd3\x01\xc9\xa5o\x1e\xa5w\xda\x10n\x0d!\"\x1b\xf8\xaf\xe0-*\xe9#\x9a\x8f\x16\x08\x9be\x1e\x86\xcb.`\n\x04'\x08_\xb5\x84\x0f)Zd\x8d\x10'\xa1<Q3\x96\x1d:-\x9a\xcc:\"\xe54\xab\xba\xe6M\xe1eq\x12l\x8b\xb0\x8cl\x16m\x84;k\x07\x88\xcb\xcc\xdd\x96I[i\x8f\x8f\x02V\x1c\xc3}\x11Q?\x8b-\xa4\xb0W]\xf6V;\xeb4\x929\xc7\x04;vN\xe2an\xe2\xe7\x04\"\x05\x02\"?`\xef\x94\x17E\xab\xd2wI\x97\xc2\x16\x1d\xd0A\x0f\xaapKld\x1c\xfc\x02j#\xc4\xe9\x05\xae\xc7\xfd[\xc85s\xa1\xc6\xd0\x99t\xe7\xc6\x15\xdb\x9a\xec\x9cG\x16\xcd/M\x06J\x8fD\x0c\xd5\xb8\xfd\xaf\xfd\xc0k\xf3\xa8\\5(9H\xfd\x91\xd8l\xa8\xc6\x06\x8a3\xaa\xc3[\xe2-	\x0b\xae\x18\xfd'\x18\x08\x05S\x0f\x17\x18J\x06C\xa2\x01\xcc+\x8a\xf0T\xe2\x04\x0e\x9c\xd2=\x99+<l\\x\x1a\x96+R\x08\xd3\xab\xa4\x81,\xa6\xebhz\x86V\xa2iH\xa41n\xa2\x04P<`\xe1H\xea\xa9\x8e\xa4\xa0\xc17\xa5Lol\x8b8\x0e\\!\xfa\xa59d\xa8\x9bcc\x80\xeas\xca?\xd0G\xb2\x80J\x96\x1a\xbf#\x7f\x86\xfa\x1e\x13\xcb\xfc\xba\xb0\x1b\xe7O4\xdc\x05\x13\n\xe4 \xc6\x803T,\xa8\x06\xec\xe5\xd9\x08\xbb\xf6\x9b.\xad]\xff.L,}0C\x04\x80\x18\xc5\xdc'&\xf2f\x99\xac\xaf\x8e\xc1\xb3\xf9\xde\x9ch\xfd\xb7\x7f\xb3\xb1@\"L\xb2\xd4\x11\xad\xcc\xe3\xb0\xca\xbfu,E\xea\xac\xc6\xb4\xf5\xc9<O<\x1b\x97\x18B\x9fG\xfa\xa8\xfe\x91\x01\xec\xa0S\xb5\x9c?W#k\x14\xd7\xea3MU\xcbx\x00U0T\x8f\x0b\xcb\xd8\x8d\x9e\x05\x11T\xf7\x15\x87\x15g/M\xad^\xf5?p\xd1\xfbP\n\xcf\xbc\x0bG\xc6\xdd0\x99\x12\x93\x85qB\x89\x93\xe2\x1cI>\xb8\x88\xbd\xe7\x97\xfe\xd9\xfa\x8f\xd7V\x0d\x16h\x7fag\xea\xe7\x9c\x12\x96\x8e\x0c\x85\x00\x1c\xa9I\xfek\xba\x9cN\xf6\xecSu\x82\xd9\xf9\x01\xa4\x18\x0c\xa9\xa7S#\xe1~\xbf\x8c\xb9\xea\x11|\xb0\xaa}a\x13\x93NZj\xc6\x0b\n\xe7E*-\x8d?\xd7W\nX\x91\xdc\xb6\x11G\xdc,\x88U8\x1a\xef\xeb\xe4\x94K\xad0\x88\xb6\x9c\xdd=\x80+\x92\x7f\x0c(j\x80B\x84\xbd\xfc\xae:u\xb7\xf9;\x88.\x12w\xb4N\xbd\xc0\xdc\xacK\xd1j\x93\xde:\xb65	\xae\x83\x86+\xb9h\x11\xd0\x04\xc6h\x9f\xfd\xf0\x87?\\~\x1d\xd2|\xb9\xc4\xb5\xc3\x13O:\xfc\xf6\x05x\x15\xd4\xcb\xf7\xcf\xd4`\xa3r\x9c\xbbu\x0d\x8e\xaei\x01\xd3\xa5\xe6\x1c\xb6\\\x08)\xcd\x9cl\x96z\xa0\x1ec\xc7:;\xad\x06\xd5\xec/\xa4\xd4\xa4\xbc\x1b\x9f\xabq\xfe>\xdd\x0c\xa7D\xa0\xe0\x819\xe1\xf8RS<\xc5\xa1R\x08g\x04\xa2B\x82\x1a)7\xc1o\x17\x87Q5\x03\xadq\xa0\xc6\xbez2M\x96\xdd\xb7H4\x95\xfb\xf9\xa2\xcc\xdfZ\xf5\xac\x1d\x8f\xa3\xfc.\xe0\x02\xc0\x82\xab\\\xab\xbf\x08\x88\xc7\xc8@\xe7w\xf2\x83\xc2S6\xf7|>l\x17\xe7\x8d\xb5+\x9b\xc1/\xcaC\xb8\xc6w\x11n\xa0l\xd6\x94\xbf\x86&\xe8\xc5\"\x1a\x0b\xd5xm\xd5xc\x94-\xe3]\x04\xe0!v\xb5\x93K\xe3\xf4\xc4i\x85\xd9\xe1\xbb7\xc92+\xaf\x11~\xf7*J\xf9z\xd5E\xac3N\x12~\xa7\x1dxm\xa3\x93\x13\x96\xa4\xb2\xcb\x1a\x02\xaa\xa0\x91u\xba\xa2P\xd3n\xceO6\x11\xd6[\xa9\"P\xd9\xf9<Zk-]*\xde;/~\\s@\xbc\xd8q!\xc6\x02\xadJ\x1e\xd8\xa1e\xbc\xfc\x83\xcaN\xa8\xee\xe4\xaa\x88Z\x8e\xdf\x84\xa3\xd4I\x80PwT\x8fS7\x820Y7\x94\xdc\x17\xa6\x9c%q\xfc~K\xc9,\xa2S\xa9\x14\x03u\xf8\xee\xed\xc0O\xdbg\x12\x90\xf8\x9dB\xc4\xb0\x0d\xc6\x7f7\xbfWA\xfc\xdd\xfc.Z\xd9\xad\x9f\x94\x04\xd2?\xd1u\xae\xb6\x81x\x8aN\xe3k\x07\xc6\x16\xe8x\x0eY\xe4\xb6\x881\x0e\xc1\x17\xe1\xd4\xe3h\xc0\x9bm\x19\xa7^\x96\xfeS\x16H\xe3\xc0Kn\xc9-\xc7\x7f\xbc\xa6\x1bYJ\xad\xa6\x80O\xf0X\xc4\xba\x89R\xb5\xb6\xd2\x80{\x9eH\x12\xb6\x11\xcb\x8eH\xdb\"K\x98\x81\xc9x\xca\x96\xcd\xdfK\xec\xb2\x8dn\x99\xe8\n]\"\x11\xf9]\x19D\xae\xbd[V\x8c\x15\xe8\xa8/\x8ab\x9d\xbe\xad\xedX\xa8\xe5G\xd3cg\xf5\xfc\"\xd5^\xb7\x11?\xab9!\xb5\x87\xb0t\xe9\xf7\xfa\xdaL\xb1\x9d\x13\x12\x8df\xeb\x93\xceT\x82\xa8\xbd\xb9\xcf\xf2\x83\";\xe2\xd6\xf2U\xeao\"\xb1S\xc4\xd9\x0b\x92|iR*\xe4\xf1\xf4\xa1Y\xa0\x9a\xcc\x8a\x0eVb\x07\x0e\xde\x82y\x95\xb4\xcaWN\x1e\xe5^\xa5\xd2\xc8l\xda\x81*\xd7\xb2n\x18x<\x15\xa4\xae\xcb\xa3\xba.\x99o\xbb\x8c\x9fSM)\xea6\xe3ASd\x80\x87\x90\xb6=\x1d!\x804\xd6u\x9f`\x8f\x84wlQ\xa2\xbbx\x1ax\x0e\xa9\x11\xfd\xfd\x97\xfc>\x85\xb8\xe9b\x96\xba\xfc\x0f&,\x0c\xf3\x94<\xc6R\xb5\xeb\xfa\x1e\x94I\x8eK\x93\x00\x87d\\ 5X\x10*6\x83M\xa4x\xb6:\xd4\xc9\xebG2\x0d\x9a E\xe0\xc6\x0d\xffb\x1c \x18\xa3E\xdd\x9b\xc5\x18\xd6\xeci~\x80L5\xf1\\~0o\xb4\xb58\xb0\x077\x98\xaea\x81\x91\xb4\xd1g,\x92\x81:\x06\xca\xd8\xfe\x07\xc4)\xe3\xb2\xca\x14\x16\xa8\x8b\xf1\x0e\xc4U%\x1e\xf0Y\x19\x04g\xdf\xfe\x8d*g\x9f\x10~\x14v{\x04\x12#\xad\xf0\xed\xa9\xdd\x03\n\x06N\xf2\x83\xfc\xd7&\xffOK\xd2H\xedt\xbb\xa5\x01\xa8\x9f\x1d\xe3O\x88\xdf\xfc\xce\xb2\xea/\xc3\xb5\xd0\"\x17v+\xcaY\x18m\x05`h\xdd\xfbsN\xd2h\xb3\xe7\x8b\xdf\xcb\xd0\x9f\xbdP_\xc3h\xca\xef<\xfbJI\xe3\x87_\x9aS\xf1e}tfK\xbfn\x95\xa5_\xd7x\xb7\x0bSB6\x8b8\x96D(\x8b\xfe\xcf\x12\x81x\x161\xbd\xa9\x03C\x04\x8c~\x17\xe5\x9c4\xcd\x11\x95r'\x12\xae\x98|`*\x90\xa9`.\xffp\xa1\x88<*\xc7\xe5\xf7\xf2\x0f\xd5\xc0Te\x92)=d/\x9bx4\xe5\x8a\xaa\x89h5)R\xc0,\x7f\x1f\x10\xd5\x89&\x7f\x91\xb4\x86oR\xa7y\xeaT\xabJ$L\xfb\xeaD\xc7\x08\xf6qR\x87\xc0\x02bz\xa8\xc6\x8e\x08\xb5)\xc3rG\x0f\xcf\x10m\x10\x15\xabY\xec\x087H:\xd6\xa0\xa6\xe9\"\x0d\x0cFq\x14'\xca\x16\x95F6Q\x12D\xec\xbd,\xf0\xb6\x90\x9e\xf1L\x95XC4e\x0c\xf1#\x18\xea\xecL\xc1\x1a\x0c\xd1`:\xd0\xfb\x8as(X\xe9\x92\x94\xa1/w\xcaKO\x98y\xb6\xd5\x98KAD\xe6R\x8a\xc4\x88\x93\x11i\x88t\xc7\x8aB*\x1c)$\xe1\xfa\x86\xe6\x89 1\xac\xb0(]A8\x98\xcdV\x83 \x1b\x15:<O0D\x13-j1\xce\"\xb1\xf3\x0f/\xe8\x03\xbc\xcb~\x90\xde\xa6\xccP\x15O\xe1\x07\xa9I\x19\x954\xcbR\xa4\x1c\xc8Z\xb7\xe7Yg~\x16\x03Em\xbes\xe6\x852\x11&\x9b\x84\xacOC\x10,\xaa\x1cI\xdb,H\x932=}\x16\x0d\xd7)\xa6r\x99\x12\xb8g\x84UJ\x97\xd9\xd4\xe8\xf6\x0b\x9dY\xd6\x158\xc1\x98\"\xdaR\xcc;Q\xc3\xe9yE\xd0\xffT\x0d!N\xeb$\xf5(\\3(\x05\xbb\x8d\xf3\x92\xc5\x92\xef\xe1r\x9d@i\x98\x90\xb0Qo#'m\x94\x1fT\xf7x\x93\xa7S!L\xa2}\xc9\xf2S\xce\xda\x82\x9b\x84\xdcQ\x87NG\x87\xe0\x91WZ\xdf8\x93\xba\"^_+\xa5\x17V\x151C\x93^p\x13\xf5=\x1c\x80/\x98R*\xfdrBf	\x0b\xa8\xc8\xb1\x19\x88\x98E\xc8g\xa5R\x17\xc5\x029\xd7\xba\xa5\xa7\x0b\xd3\xd51\x9f\xea\xd2s5)\xd2!\xf4d\x83$\x1dN\xd0\xac\xaf\xfeR5\x13\x8b\xca\x15\x13\x04\x9d)4F\x0cf\x8c3\xa3\x8c\xfb!\x05j&\x0bl:Bl\xfa\x19\xc6\x02\xcc\x8arc\xa7\xc4\xa8\x8a\x9b\x1d\x01\xb1z\xf4\xdf\xc5\xac\x97\x15g\xb2\x19f\xa9)\xba\xb2\xd5\x14k2z)e<\xd9*g\xc4\x0d\xe4\x14\x8bI7\x85\xbf\xbc\x1af\x82Q\xab\xcd\xa9\xe9\xb42\\\x06$\xcf\x1a\xe8OqrA\x13~`\x87\xa7\xc5\xed|\xe9g\xf1KH\x8d\xbf\xf4\xb3\xc6K\xce\"\x82\xfbUi\x7f\x13,1_\xda\xdf\xbc\xbcy\xcbT\xb9r\xff\x9f*\xed\x81\xd6\x8d(\xec\xcd\xaf\xbdB\xd2\xd6\x9a\x93g\x14\x87\xc1\xcc\xb4\xb5\xa5'\xf9\xc1\x027\x00K\xcd^V\xa2\xc2\xc2\xcb\xba\x80\x10/xYc\xd1\np]\x0b\xd2\xa3Hk\xaa^\xbe\x12*3{u\xc9\x14\xf2\x14\x0f\x8f\x02\xd7I\x82\xc7A\xe9q\x88/'\x9d\x80z\x15\xfb\xe4\xa4V\x7f\xfe\xb1\x95\xbb67w\xf0\n\xb3\x96\xeb\xd8\x08\xc8c7k\x10\x8b\xae\xe0%m\xc9\x1a\xa7\x1bI\x1b,s\xf8\x95\x19!\xef\xadf\xa8\xac\xaf\x04}\x10r\xe2=\xd6\xa0\x1e7\xe8UF\x9eb\xe1\xa1\x18\x1f2K\x1f	)uJ+\xf2\x9e\xa9\xe27\x95\xe81\xaa%\"\xe1QZ\x02\x15\"\xc6+Zb\x97\xcc\x08\xca\xb4v\x82\x04z\xaf\x03\xbb\xa5\x93Q&\x12\x01\x18\xb2 \xcc\x84*\xea\xbd\x9b\x95\xc0\xe2;t$0\xa2\xf7\xdf\x85\xc7`\x99\xb2x\xb1\xf1p\x9e\xda\xa9\xa6$\x9d\xfa\xb6>E\x98\x0c\x90\xfc \xff\xc4P\xdf$\x9f\x17\xb9M\xb1\x94\xe9\xdb7\xaf\x96\xc7\xf0\x07:\x00\xd4\xe4 \xb6\xfa\x84\xa1\xb3\xbe\xb0\x0bN<\xc6\x1f\x93C\x8f\xae\x89:\x84\xedI\xf6\xe6\xe8\x8c\xc7\"E\xf4\x15\x11\x832+\xa1\xfa\xcfN\xc0\xc7Y\xe8x\xc1\xea\xa1!\x1c\xdc\xb0\x92xnc\xd5Z\x9eS\x93\xa4k\xc9\x8fl@\xc3\x86y)\xb6\x8c\x94\xef\xd8\xf5h\x127f\xae\xbe\xd6P\xec\xba_\xe2B\xc1\x15B\xa6\xc9\x96R\xd0\xfd:v!\x84\x0e\xe3\xc3q\x1e\xaa\xa7\x84\x15yx\xf9\xfbj\x98\x7f\x04c\x03;G\xe0b\xaf\xe2\x1e\xa9\xaf\xf5\xcf\x82\xba\x8fH\xee\x0d\x9c\x974\xd5\xca\x16[\xfcH\x152p\x08\xb5WzR\xd7\x99\n\x8c\x81\x0f\x82\x12K]Kn\xf5\xaan\x1d\xa8\x01{}n\xd5$a\x00S\xc95t\x12\x9ey\"\xe2e\xd1\xf3&\x1a\xaes[\xefl\xfb\xf1\xb0\xe0\x85\n/\x12\xed,,~1\x97\x1f\x90\x8a\xd5\xe2\xf2\xc8\xd4\x9f\x13\xf9p\x83\x80\xfe\x99\xef\xe7\xdc\xb7r\x88\\\xe6\xfb\xce9\xe8\xd8\xa4	\x01\x9f\xda|\xba\x1a:\xeb\xc0\xd1\x07\x00'\x15\xe6\xe6\x16\x92\xae\x0c\xc2\x8a\x0f\x89\xf2W\xd4\xe0\x9d\xe2\xec\xf2\xbbs\xc3\xd2e\x02\x19\xaceR\xd0&\xff\xc4\xf2\xbdi\x08g\x04gK\xf6Ky\xb39\xf5D\xee\x0b\x12\"t\xfdf\x83z\x05w/\xc80M\x8d/\x9e;\x98\xd3\xd8\xc7\xb90s\xcd\xa9\xcd\xbdMY#\x12s\x0c\xe3Mjw\xb4mY r\xb1\xc8O\x94\xbd\xe4\xd8\xef\xb4e(\xd8\xf2\xab\xaf\xb3\xf5\x8d\xed\xd7\xc9\xf8Y\xfe>\xfd\xf8>\xcaP#\x91V^\xd4\x06\x91\x1fl\x07~\xc6C\xb6\xbe\xe1\x14\xda\xa5n\xe1\x9e\x13T\xb8%\xbb\x14\xa8\xc5\xebQ\xd2\x1eAB\xd5\x81\xc2gY\xe4\xebWXegQ\xea\xb4\xc4\xae\xca\x1d\x11{<\x11,\x14)\xea\xed\xeb\xa6@!!\x1c}\x9e\xb4ER\xa7\x02}\xc9^])\xdfu%di/\xb1\x8b)\xeb\xc0\x15\xf8\x81\xa3R`\xd1\x9da\x0d\xed\xdb\xf7\xe3g\xd8Be\x04\xfe1\xa9\x93\"\xd5]1R\xd3\xb6\xe8\x88\x99'*\x16\xec\x03\xfb\xe6\xc6\xc8:\xc8\x8f\xfc\xa0\x14\xef\xce\x05\xd0ZQ_\xdfk|\xd7e\xbe\xd9|\xc1\xb4\xb5Q>\x86-L\xf4\xfes<\x86\x9d\x1f\xef\xad\x04\xebl\n\xd7\xd8\x8ej\xe4\xae0\x1f\xf9\xc0	1~\x01\xf9\xf7\xbf\x00\xf5\xcakI\xed\x0d\xb9\x8f%\xa9\x88\xd6\x18.\x89\xf90\x80\xa9\xfd\x92Q\xe1\xfdO\xd3#\xd9\x10\xf1\x86\xab\x0f\xed\xb3tX\xbdx\x8d3c\x88\xd9\x15|\xed\xa5\xe0\x9d[D\\n\xefT\xe4\x8b]=\xb5\\\xab*\xab\x9e\xe79\xaf\xb6U\xa0\xea\xb5(R\x8f\xcf\x9aT\xaeD\x05\xfd\x9bZR/\x10\xd4\xcfH~=\x98*8\xad\xca\xe8Bg;/)l\xde\xcb\x9e\xb2st:\xa9W\xf5\xc5\xde\xa6\xb6\x05\xde\x18\xa5j\xf5\xac\x7f\xaa/\xa6\x97\x98\x15\xdb\x06\xb9R0O9Is\xf1{A\x17	oXW\xdf,O\xe7Y/\xf7\x90\x87P\xe3\xb9o\xe7\x9e]q0\x03f\n\x8d\xf9%\x14\x7f\xc5P\xc3i\x0b\xca\xe7\x1c\x1d\xb8\xc3S\xafmn\xce\x8d\x88\x12\xe8\x05\xc4\xdb\xe8+n\x0dE\x81\xd0mB\x88Y*;<\x0d<]\x89\xee\xdb\x0fG\xe0\xfe\x12P\xe1\xcfN-\x97\x15\x8d\xce3K\xff\xf0v\xc1\x98fS\xc5y\xf9'g\x85\xdbh\xe4\xa1\x1a\x81x\x8b\xe6\x93\xfd9\xbd\xe0\\b\xd1K\xce\xbe:AC\x19\xef\xa6\x00\xd0\xa1}\x9btT\\<\xccq^\xf2\xec\xb4yz1r\xbd\xe1\xdbE\x0b\x06\xe8\x11\x1c#\x96Z|\xd6$\xed\x1b\xf9>\xb8\xa0Q\x85\xc0\xcf?V\x93j|\xaf\xc7!\xb9\nR\xfe\x7f\xfd\x13]\xf6\x87\xce\xc7\xb1D\xe0\xcb \x0ez2\xdeZX\xb0\x8dgj{P\xa6\x06RYm\x9c\xdf\x9bK\xaa\xfc~\xf1\xea\xedE\x98\xd9\"1\xfd*\xf6\x7f\x16\x95\xf3\xe7~U?\x7f\xae&\xbb\x88w\x16_\xc8\x89\xf4\x9b\xb5\x1a\xf4\xa3\xa9v\xaa%^,(\x89^#\xf8pq\x1e\xa9\xb1S<\xc4\xf2\xdf\x80\xadpx\xe6[4\xb5\x1dS\xe8\xb0xJY\x9f\x80\xdbI\xb8$2Nq:\xd6\"\xac|\xa1\xa3p\xf4j\xf5\xe9\xae\xa7\xa6\x04j`\xbe\xcfA\x12\xdeq\xd7gf8o\xf9\xcab\x8a\x8amS\xce\xb6\xc1I\xb7{\xcax\xc3\x15\xaf\xa5\xbc\xa8\x80Ff|\xcf\xc8$\xfc;0\xf0\x91\x8a\x83\xe7<4\xbf\x1fZO|\xc1E4\xc3\xec\xab*(\xb5\x8f*\x89US\xd4\x9c\xdf\x87\x06\x84\xf0;\x81_N.\xcb\xc7T\xcf\xab1\x13\x9d.=\xe6\xb5\x87\xdc\xe0Q\xc5\x90\x80ai\xc3\xa7C\xad\xbeAm\x90rl\x1d\x9d\xe5\xef$\xcb\xf3\x8b\x10F\xec;\x89\xb9\xbd\xb0y\xe1\xc6\xd1\xa9\xbeay\xf7\x81\xea\xe7\x1f\x9b\x12\x04f\"\xd3\x83\xfc\xa3\xfc\xb3|\x9f\xa6\x9a\x9c\xee\x11\xfbN\xb2T\x9d\xb3\xbe\xc1\xfe\xf3\xce\xef\xa8\xdda\xd9,\xb0\x1b\xc1\xe7\x9a\xde`\xc5\xa7\x9cj\xdc\xa3\x87=hS\x7f)\xa2<\x85\xcd6\xed0N\xcc\xb3U\x1b\xb1\x98\x18\x07\xb0\xc6}\xa4Efj\x10M'\\\"\xea~hcpj\\\x19\x90$A+\xda\x84\xe7G\xc3\xbe\xa4\xf3\x03\xf7\x17\x0fc\x16\xf9}8\x1a\x9aC\xc9\xe9\xa2)\x12\xf1fWx8@\x90\x06\xbc\x0b\x08\xf8B\xcb\xc4\xc9\x8a\x14\xf1|86\xffo\xe3\xf2\x9bu\xb6q\xfd\xcd:\xbe\xffc\xd3\xce\xb7/\xaf^\xab\xb0/\xbd2\x19\xcd:\x05\xf6\xf5n\x9f\xca\xfbP\xaai\x88\xa8F\xec\xb5\x95\x95\xff\xf8\xfd+++u\xeb\xa3\x8f\xccG\x9aP\x1e\x88\xaa1\xf6\xa3U\xcam\x1b\xeb\xfa\xb1:\xd2H\x9a\x0di\xea}e\xe5\x8b\xf9\x1a\x065V\xbf\xa5\x82/xD-\xe1$\xb5!\x94\x1d\x82\xcf\x16\x89\xd4\xbc\x10\xf4\xf7\x00\xcd\xfei\xda\x05\x8f\xcd\xc26\x0b\xe1\x1c\x9a'\xa3f\xd0\xcab\x9dU\xb1\xe7f\x82y\x13\xbb\x9a\xe1\x94\xfc`j\xd2&=\xe4\\4\xcb\xc6\xed\xec,$\x99\x9cu\xbe\xb6\xc2h\xee\x1ax\x83\x83ak\xf8\xdf\xbc\xe8\x0f\x85;\xdf\xe4L\x16\xe0\xa8\xdf\xa6X\xd3if\xf4<\xc1c\xe4\x95\x9ei\xcalf&\x1a\x0b\xda\x0c\xcdb[\x0dR[\xb3\x7f\xdb\x17\x95AL\xa2\x87\xccA\xb2\xf4\x10k*\xb4\x8b}\xfb\x88=>\xeb\xc5c\xad\x99y[7\xf4\xb5\xba\xf2\xf6\xa5\x1f\xb1\x1bW\xae\xe8\x0e]=\x89v\xf5[\x92\xcb\x876\xb2\x88\x9a\xa0Z\xf9J\xa3\xb6\xbef\x7f!\xd5\x96j^\xfc#\x89\xad\xea\x93\xb6Z@\x1f\x0c\xd9\x0c:A\xa89G\x7fA\x84%\xba\xc5\xd8\x7f\x89\x01\x17\x86\xa2Eo\x05k\xeb\xfaOzf%\xcc\x87\x15j\xf0#.U\x18\x1d\xc1<\xbcy1\xa5\xb4\xc6W\xa9\xc4	\xd4\xb0\xf8\x1a\x90U\x1a\x80\x13D\xadB\x1c\\\xf4<\xd1E\xc6\x9fn9\xbeM\x87\x1b^\xbe\x83\xeb\x18g\xc0\x0f:\xe6\xad&\xc21\xaf\xad\xac\xec\xbe\x86\x0bl\xbb\xcb\xb2S]\xf7\x89\x1b\x8cxI\x84\x9a\xff,\xf2\x97\xec\xea-I\xe5z\xa43t\xf0\xd9\xed\xd1\xaf\x81\xad\xcarBw\xc6l\xcc\xf7\xd4`6F8]P4\xb7\x96\xc8rei\xb0\x80]\x9e\xe6\x1f\x96`\xe4Nt]\x16YQ\xa3\xd6\x8ag\xc2\xba2\x04*}\x80X\xc5\x10\xe1\xe6c\xf3\xed\x92Y\x15\xd0\xb5l\xff\xd0\xe5\xf3n\x16\xb7\x8a\x1b\xb1\x81\x1f\x94\xe6\xd0o6\x8c\xb5D&\xe7-)\xc34\xe8\x12\x84\xbfB\xf6\xe6\xf7\x8c$)r\xe4&\xf0a3\xe2\xe6\xbb!\x83\xfa\xd47\xbc\x80j\xfe	\x89S\xfb\x9c\xd2\xfdH\x19{\xb9\xb0\x1bF\xea4\xff\x0c\x99qJI\xa8C\xb2\xca\xf6\xd4i\xfe\x9b\xfcc\xea\x1e~\xd7\x14\xb0<5\xd1[\xd4\xea\xdd5_\x88\xca\x0f\xc0\x80H:\x98\x08}~w\xaa*\xd4l/]\xe5\x91\xa9\xcf\x99\xd1\x90\xb6\xd0\x10\xd6\xa3-f\xac%\"\xbd\xaa\xb9\x16\xe4\xd8\x14\xf8F\x067\x1f\x07\n:\x8e\xacH\xda\\\xd7J\xd8\xbf\x9c\xd6U\x8a\x03\xd56\xe9\x07k\x04F\xbc\x98\xbbh\x1c:L5\x7f\x96\xb7\x94>\n\x17'E=\x9e\xd8\x0d\x92t\x89\xdd\x92:\xd2\"\xa8\xdb:s<\xea\xed\xf0^\x9d\xb5$}\xb5\x8e\xee@,\x92\xacak@\x90\xd4\xd5\xf9\xdc\x9f.\x90\x07?\xad1\xaf-\xbc-a/\x8d5k\x8d\xfa\x9a\xf7P|\x8a\x19\xcc<\x1d\x1a)Ew%\x86]H\x8c\xc5\xe5\xc1\xb5$k\xa08\x91\x166\x9f\xbb1\xa0{\x11iHS\x1d\xa6\xa3\xc6\xc4w\x03\xca&\x8d\xa7\xe0\xf4\"\xef\x92\xb5\xac\xde\x8e\x82\xf72|\xdb\x84\x12wH|{H\x81.\xa72\xa5\xe8\xae\xf39\x19\x1b\xde-#\xbb\x14J-\xf0)\x83\xa65T\x0b\x19\xb9b\x82y\xa6\xbd\xd7\x15f\xfcP=\xd5mYd\xcd$m\x1a;\xc5\xad\xb5\xf3\xe7~u\xfeW\xe7\xffk\x00PK\x07\x08\x0b\xafB\xf1\xe8\x1c\x00\x00\xfaS\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x004<N]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/sk_SK/common.jsonUT\x05\x00\x01T0\xcfj\x8cV\xbdn\xe3H\x12\x8e\xed\xa7h\x100v\x17Xx\xf3\xc9d[^k\xd6\x96\x04K\x9e\xc1\xfa\xf6\x82\x12\xbb,\xb5\xd9\xec\xe2u7\xa9\x95\x16\x93_\xe2\x17\xb8\xe8\x18L0\xc1D\x97\x19X'\x94\xde\xeb\xd0?$e/\xe6p\x11\xab\xab\xbe*V\xd7o\xffq|\x94<\x90\xce\xc1&\xef\xd8\x1f\xc7GG\xc9\x02\x94B\x9e\xbcc\xc9\xd9`<\x1e^\xb0\xb3_\xd9w'\xe6;v9\xb9e'\xc6\x7f~KN\xccoI\xf2\xa3\xc3s\x94h\x83\xc2\xc5\xf0z8\xef5\x82\\\xe4\xb0\xc4\x8b\x1e4\xba\x19\xfc<d\xdf\x86\xce\n\x12\x12\xf5!x6\x9d\x8c\xae\x87\xb7o\xe1\n\xd7S2\xd6\x8c\xd4|\xa5\x11\xbc\xc2	g\n\xd7\xacp|&\x14\xb3^r\x1a\xec{\xee@\xf1\x91s\xc9Lra\xa3\xe3'<h|o~`\xa08;\xe1\xcc\xfb\xe2\xce\x14a\xbd\x85o(\xbe\x06\x96z\x89\xdc\xb9\xe7\xecO'\xb39\x9b\xde\xdd\xfe\xdc_\xe1/q4+\xe0\xb4>\x13m\xf4gW\x83\x8b\xc9Gv6\xfa\x7f\x92\x10\xaeyMi6\xa7\xe5R\x86[\xcd\xafn\x87\x83\x0b\x97\xb3WQ.U\x9f\xe2\xbb\xf1\xeb$\x07k\x95\xc05\xf2\x19\xe48\x9a\xb6\xee\xcf\xd8\xe4\x92\xcd\xaf\x86l6\xb8\x19\xb2\xd1\x94}\x1c\xde\x0e\xd9\x87\xd1\xf0c\xef]r|\xf4\xe9\xc7XP\xc6\xd5\x93?\x15\xb2\xd4 M[_\x1c6\xc9;\xf6\xb7\xe3#_;\xfb\x7f\xfa_\x1e%\\5_\x93\xe3\xa3\xa3\xbf\xbbc\xb2\xa2Rw\xa8\x15q\xa1 \xe2V\xc4\x9b\xaf\xaaG\xe6B\x95\x16;l.T\xf3l[p8m\x0e\xd0\xa4\xec\xaa\x07\xa3\x11\x90\xb6X\x7f\xa0\xaa\x07\x1bLI\xf1\x0e\x1d\x8f\x01\x1d\x0e\xa6\x07o\x10z\x8f5e\x11\xa7)\x8b&cl|\x9d\xb5\xb1\x00\x9e\x0b\xe5\"<\xf0\x84SI`I\x8eS\xe8\xb6\x94\xc0\xfb\x90@{\"\xaf\xf1\xde\x11\x9b\x9cJ\x13\xf8))\xab!\xb5\xa1\xb6\x1d\xe2>G\xb5\xaf\x05\xa3\x85n\xeam\xb69l\xd7\xb3M\x9f\xdd\xfb\x1c\xf6/\xac\xda\xd7h\xb3\x0d\xdb2M\x95\x82\x0c\x1f]\x92\x81k4Q\x13\x7f/\xa2+COQ\x05\xfb\xcf\x87\xa2\xfe\xd7\x01P>\xbe\xf9\xf7Jp\x97\xa9d\x96\xae\x0e\x94C\x04\xaa\x00y\x04%,i\x7f\x81\xe6_\\\xef_\x16M\xadE\x14\x96\xc6\x8ei\xeddS\xdd\xd4\x152\x8b\x1a\xb6A()\xcdB\xddG*&\x9c\xa3\x86\xd6\xe2\x8d;5\xb5\xa5\xd6b\xdb\xb3\xce\x81\x8d\xc2t\x05\xaa\xf9\x12Ek\x85Q\x0b\x1e\x85\xc5\xdd\x0b\xe3d\xda0\x1a\xc4\x81\x94N\xef\x9e\x16\x1a\xb6b\xff\xb9\x0da\xdb\xce\xb4~%\x8e\xec0\xdd\x9cb\x1ct\x81o}\xdb\xce\xacH3\xd7\x1e\xc9Tc\xa1\x043\x81\x11\xfb\xb6\xbfbGw\xfdz\x98\xd1)\xf1\xdd\x0b\xc4D6\x7f\xa6\xab\x98J\x81\xb1X6T:+\xdf\xcf7?\xb4=k6*\x8d\xf5\x9b\x10/\xe8\x11U\xf3g\x1czZ\x14M\xfd\x08\xcc\xc4\x1at\xd8\x95&%\xb6\xe5#v\xdc-\xf5|\xaa\xa0S\xe7\xf8\x17\xfe\xb1o\x9a\xc4\x8a\x1c\xdbVHA\xa2\xe2\x07=\xf4\x1eT\xec\xa1K\\D\xea\x06t\xa4\x06EK\xdd4\xf5c$\xdf7\xcf\xad\xce\xfb\xe6YFrP.#5\xc3\"R\x93\xccFjLU\xa4.0\xed\xdby\x8d\x98u\xae\x8c\x91\xe3\xee\xa5\x1d*SR\x1ce\xd7\xe1w\x96\xfav\x9fY\x8d\xbc\x05\xee\xffm+m;\xd9T@\x7f\x98\xd1\x82,\x1c\x0e\x86R\xb4\xa1X\x90\xb5\x94\xbb\x04]\x90,\xbdB\x92\x82J1\xd4\x9b.\xf7uWO)X\x90\xb4t\x82_\x1c\xd9\xfcg\x19\x15\xa4p\xcb\xe0\xbc\xd3;w\x0cf\x89ES\x87\xd3\x80@\xfb\x1e\xb8\xcfa\x0b\xfb\xcf\xbe\xd0\xe3\x8f9)\x97\xa3d\x94\x17\xa4\xad\xcf\xeb\x97S6\xb3\xba\xa9U\xe6J\x82)\xd8=5_-\xb0\xad\xa2\xaa\x8c\xab\xf6AH\x9c\x13]\x83^zuwf\x96\x88I\xcf\x89\x18%\xcc*\xf4\xec\x15Y\xaa(\xf0\x97DK\x893R\xe1ZRdJ\xb0B#\xab6\xcb\xe6y)Q	d\x05\x1a%vOm?\n\xef\xde9i]\x16\xb6\xf7\x97m\xe5f\x05\xf2\x94Mi_g\xc4]Q3\xd3</HGG\x83\xe2E\xbc\xe5\x18\xc4\x9b{6\xb5\xfe_\xf7\x14\xaa\x02)\xf89\x146]\x817\x81\x85\x04\xab\x9a\x9aeP\xec\x9ebsd\x88\x85\x7f\x04	\xb5\xbc$\xed\x80\xbf \x16\x8cG\x1e{\xa08	$\xc47\x03\x19\x89\xbc\x1bG\xae\xf7\xfbgN\x1c\x0f\xe5\x16\xf2L\x95V \xabdSgqK\xc6	8\xa7\xb3\xae\x92\xee\x03\xb0\xf9\xc2\x140S\x10\x8f\x95\x98\xe4(8}\x10F\x90j_+7\x8e\xc5*\xcf\x8b\xa0\xd2\xd8\x1b\xb0\xa9[\x9d\xc9\x15\x1a\xd9\xd4.\"yi\x04\xb0\xed\x8a\x0ew\x81\xc2u\xef\xe7\x98\xaa\xe6K\xf4-f\xb7 \xa1\xec\x9c\xce\xfb\xc2\x9d:\x8e\xab\xcc\x96\xd7?\xb5\xfa\x952\x95\xb0t\x93\xbb\xfai\x12\xf6\x19U?\xcd\xe7\xd7\x01\xfb\x8f\x92\xe2Kn,0\xb3\xc4\xf6\x9f\x81\xa5\xc2\x95\xab\x8cy\xd6\x08&l\xce\xdb@E.\x17\x1aS{\xb6	\x93\xf36\x9e\x19H\xc9\x16\x1b6\x9a\xbe\xc5\xf5w{\x8b\x0do\xb0\x16\xff\xa0\xd1\xf8pM\x16\x8a\xaa\xae_5\x16\xd2\x0f\xf8	/\xa8B\xdemA\x8d\xae\xee\x9cd\x0c+\xd9\xd4\xe6@\xc5\x96\xda{>n\xeaJ\x83\x8d\xbf(%\xfa\xf54\xd5P	.\x9b:\x0eg\x04\x1d\x13\xb5{\x81\xfe\x07\x06\x8dK\xe8\xf0\xf7B\xc4\xc7\xf5\x0c\xb9o\xa5jS\xe8}\x0d2&\xc8m\xae1Y\x91\xfa\xc6\xbd+hKZ9`\x14\x97\x8b\\xG'\x9c\x8c\xec\xec\xf7OP\xe4\x17\x1a\x1e<$D\x8b\x85eu\xca~\xa5R3\xee\x84l\x05\x86-\x10\x15\xcb\xb0\xb01GvU\xe6\x0b\x05B\x8a\xd0\xfa\x13n%\xec\x9e\xb2\xf2\x11\xf3\xd3\xd3\x16D\x85\xb3\xfc\xc1]\xd2;d7E\xc4G*n\xcbv\xbcte\xdd\xd4\x86)\xe4\x94\x91\xda=\xf9IP\x84\xa2j5\xd6\xae\xc4\xfb\x04\xdf\x05\xc6\xab\xc4\x96\x85$\xe0\x97B\xc6\xe0\xb8\x13s\xc3-\xda\xf0\x8c\xa9\xa6\xa5F\xe3\xb3C>Hnh\xb6\x17p\xaf\xeb\xe8pKz\xfe\x9b\xbf\x7f<\xfc\xf7\xf1\xd1\xa7\xe3O\xff\x1d\x00PK\x07\x08\x15G\xc1\x84(\x06\x00\x00\xac\x0d\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x0b>N]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/sk_SK/server.jsonUT\x05\x00\x01\xc63\xcfj\xbc|\xcdo\x1c9\xb2\xe7\xd9\xfe+\x02\xb5\xe85\xd0\x90K\xee\x0f\x0cf}\x18\xc0\xb2l\xb7\xba-\xb9FR\xdb\x98\xee\xd7\x0f`eFUR\xc5$\xb3If\x95J\x0fs\xdf\xc3\x1a\xf3/\xac\x80\xd7\x87>\xf8\xb4{j\xc0\xba\x94\xf4\x7f-\"Hf2\xab\xe4~\xf3\x1ef\xf6bU\xf2#\xf8\x15\x8c\xf8\xc5\x07\xfdo\x0f\x1f\x8cf\xc6\xd6n\xf4\x14\xfe\xed\xe1\x83\x07\xa3\x97\xcf\xfe<z\n?>|\xf0\xe0\xc1\xe8HS\x95\xf0\xd2hh\x84F\x05\x1e/\xfdh\x8f+_ho%:\x98\x19\x0b\xbeB\x98\n\xad\xd1>r\xf0\xd2\xe2\xcf-j\xaf\xd6\xf0\xcc-\xb0\x84?\xb7\xe8\x88\x86\x03%\x9d\x07\xa1K\x90\x19\xe5\xda\x94B\x8d\x1e>x\xf0\x13\x11\x1e\x9d\x9c\xbd|\xd7\xcd\xe0\x04\x97\x95)\xf5\xe6W(\x0d4vs]`\x1c\xfe\xd0\xb8\x85\x80\xc6,\xcd\xedM{\x81P\x0b\x8fVn\xae\xd5\x1e,\xbc\xb1\x9b\x8f\xa0%\xc2\x05\xc2\x14\xaf\x1a\xbc}O4\x1a\x8bWh\xc5\xdd/\xb0\x84\xc6\x8a\xc2,\xb5\xa9\xa1\xb1\xc6y\x8b\xe5\xe6C?	\xa1Vb\xed^\x9bb\xd1M\xe5\xed\xddM\xb9\x86+Qo>.\xc4\x05,\xc05\xa6\\\xb4q:\xd4\x14\\a\x8dRR\xcf\xc1\x1bh\xc4\x1caj\xbc75\xe0\x125\xac*\xd4\xe0\xc5\x14\xa4\x83J\x96%\xeal<m\xf4\xba\x96\x0e\xbb\xe1\x9e\x85\x92\xab\xf6\"\x8e\xf0\x83\x99Zq\x05\xcb\xbbk\xf4\x8b\xcd\xc7\xa2\x82\xc6J\xd7H\\\n\x8f\xb77f	ba\x80\x08m>\xd6z]T\x19u[Tr\x89\xe7\x95EQ\xba~\x88P\x0c>\x96\xef\x0dJ\x1b\xdbj,S%H\xed<\x8a\x12\xcc\x0cJT\xe8y\x95\x15\xd6c\x88dC1\x960]\xd3\x91\xa2\x15\xdeX\x07\xc2\"8/\x95J\xf5\xe3l^m)\xcd[\xa3\xda:[7\x95\xc12\x14\x86\x19\x85\x1642w\x00\xa9\xa1n\x9d,\x98\x93\x96\xb2D\x03\x8d\x12k\xb4.'\xed\xcd\\\xcez\xb2Z\xd6f)\x88\x0b^\x1d\xbd\x84F\xa8\xc2h,*\xbfN\xcb\xd6\xb2n/v*;\x82\xd3\xf9q\xeb\xfbi\xd2\x07\x1c\x88b1\xb7\xa6\xd5%\xbc\xa5iDR\\\x17\xaeDW\xcf\xd3\xcc\xa9\x85\x0e\x89\xdc'(=+k\xa9\x1f\xbb\x06\x0b9\x93XB\x8d5:hd\x83%8o\x85\x9cW\x1e\xa4\xf6\x06\xd6\xa6}d\x11j\xa3\xa576\x1b\xc8\x08[\xbe\xb8l\xa4]w\x83\xdd\xfe/\xe1\x00\xa9ls]H\x84\xd2\xb8E\xda\x86\x89\xb9}\x8f\x1eJ\xbd\xf9@7\x07\xb4YF^\xdb|p\x0d.\x17\x86\xae\x0e\x96~\xf3\xb1\xde\x03\x8dw7\xe0\x04S\x10pU\x8b\xbb\x1b\xdc\x1a\xfbDd\x87{\xb2\xb9\xbe2\xcb\xc1\x80\xb1H\x9b%^\x84\x8a1\x1co\xfe\xef\xdd\x0d\x82\x99:Q\x99%\xdd\xd7Z\\\xcazs\xad4\xc2\x17O\xa0\x16j\xf3+(\xe1\xa5v\x0b\xba\xd7\x9b\x0f\xaeF-@(\x9c\x1a\xb8}\xbf\xf9\xe0\x94\xc8\x18\x8dw\xe1\\z\xd5O\xe5\\\xfa\xcdo\xca,\x06\x93\xe9\n\xb3\xe9\xf0\xb5\xd2\xa2\xb1\x9b\x0f\x0b%Jx41Jz\xb9\x10\xf0\xdf\xe1\xcc\n-\xf1\xd1\x18\x8e\xf3\xf9=\x81+-\x16f9\x98@\xd9\xef\xff9\xaa\xc4*\xf4\x93\x85jKCn~\xad\x98\x95\xe7\x9bk\xdf\xf6\x9d\x0b\xd1\xf8\xa2\x12]\xff\xefDs\xfb^D\n\x13sw#E).\xa0\xbd\xbb\xd9|\xe8\x85\xc1t\x0d\xcbu\xa3\xb4T\x12\x16\xd4\xa3\xa5\x83\x83\xd6\xde\xbe\x97~\xf3+\xd0B\xab\xb0\xba\x0b\x04\x8bs\xe9<3\x84\x00\x01\xcb\xb5_n\xaeyu\xb0T\x9b\xeb\x05\xea\x9d\xf9\x9c\x8by/K\x9e\x879\x82\xa7\xc2\xb861w$\x08\x9dim\x81\xac'\xe2J\xc0\x19\xd5\x92\xecwcx\xa3\x91:\x91P\xb4B\x97\xa6Vk(*\xe3Ps\x0f\x14E\x05s\xd4$N\xb0L\x04\xc6p\x8a?\xb7\xd2\x92|2P\x18\xed\x85\xd4 <(\x14\xce\xc3WP\xca\xd9\x0c-jO\xb4]v\x10E%\xecYal\xcf\x08\xcf+aE\xe1\xd1\x82kD\x0d\x8e+\x93L\xf0\x92\x0bW\xc87\xcd\xccH\xb2\xc9\xd9\x9a\x84\x9f\x80\xa2\xebI\x83Cc\x9c\x1f\xc3\xb3\x19\x91\xc2\xcb\x02\xb1\x8c2\x12\x94\xac\xa5\xe7_\xadC\x0b+\x92\x86\x1a\xc3\xe4\x9dQK\x04\xd1\xad,\x9b\xaa\xa9\x1b\x8b\xceI\xa3_\xe3\x12U?\xe3\xbe\x02\x14\xd7D\x95\x883%<B\xd61\xd4\xd3\xc4W8u\xa6X\xa0\x87\x1a\x9d\x13\xf3\xa8\xb9\x0b%Q{\x07\xaem\x1acY\xaa7hc\x93\xc7e\xa08\x86\xc7_\xd0\x01\xd1\nJ\x9c\x89V\xd1N/Q\xed\xc1\x13(\xa5\x13S\x85.\x1fv\x0f\x1e\x7fIku`\xb4Z\xc37\xedlV\x0b\x0d\x85\xe1-!\xb1\xfd\x05q\xc6\xff\x00oE\x89\xe0\x1a\xda\x0c\x9a\x8e\x93W8\xd8\x02\xeb\x9e[,Q{)T\xc6moN\xcf\xa0\xc8*\xe2y)eVPX\xe3\xdccc\xe5\\jx69\x02\xc6#\xce\x07fD]Ba\xccB\xa2\x1b\xc3\xd1\\\x1b\x8b\xe5\x1e\xc8\x19|N+\x14\x1a\x04\x11\xc1\x12\x02\x81\xad\xc9\xbc\xe1\xc2\xad\x89\x98XH\xad\x1e\x8cb\x93\x8e\x8e7P\x8b\x05\x0e\xa7\x95Oi_4r\x7f\x0f\\[T \x1cT\xde7\xee\xe9\xfe>^\x8a\xbaQ8.L=\x86\xcf\x039\xa6\x9a\xc6\xcb\xa7\xd6:o\xea\xe7gg\xdd\xc4\xe2d\xbewh\xe9\x14%\xa9\xf2\xe7gg`[:*eDIK\xd4\xe0MC\xdcA'\xebPaA\x97\xccWX\xe3=\xc4\xcf\xcd|\x9eI\xd0\xb7J8O\n\x95\xc6\x8d\xc2\xc8b\xa3%4&\xca\"\x82`\xcb\xd8\xac2\xdc\xb0#\x1b\x19)\x9f\xf4[R5\xa6\xbc\x92\x02\xfc\xe6\xd7:\x89\xb8s\xfa\x1dP\xddo\xa0\x05\x89w/\xee~\xd9\x83\x05\xde\xfe\x0d\x16\xcc\xc1\xc0RPS\x03\xbcZ\x1a%\xd5\xceH\xaf\x85\x9e\xef\x0c\xb5\xf9\x00\x17\xe2j\xbd\x88C}K\xbf\x13\x80\x8c\xcb\xb8\xfb\x85U\xc02Nn\xf3ag\x0d\xaf\x85\xf3'\x1d\xe9\xc3xC,6$\xcbL\xab\x13d>i\xeb)Z\xdao\xba\xa7.4!\x0c\xed*\xb3\xd2|\x1c\x8c\xa5\x18;:\x02Rq\x93\xc6\xf0\x84\xf8\x88\x9a\x11\x07d'_\xa2\xa3\xb3\xb7b\xde\x1f\xcc!\xba\xf6,\x96Q\xa3A\xab\xb1\xb1s\x905\x81S\x87\x04\x0c3Z\xe1&3\x02\xeb\x89\x85\xc2\x00\xbd\"\xb9S\xbc\xc0\xc2G46\x93\xc4Q+\xe9+\xd3zR\x1d\x84_\x08\x9d\xa0\xa8wh\xbfBs4\xd9\xa1\x1dJ\x13\xae\x07m<(c\x16\xd06\xc4\x8ba\x0b\xd9\xd603\x96\xb2h\xdd\x18\x0eH\xa7\x87\x81a\xa6H\xd7\xa0&je\x90\xaf\xbcW\xda\x84\xaa|\xc3\xc2z&\x87/w\xa6Ae\x83\x05N\x0e_\x86\xe5\xedt?5S\xe3{1\xf0\x83Xl\xae\xefnH\xc8\x16V\xac\x14Z\xb3\x8c\xa4&\x96\xc0?m\x967d\xa3\x94\xd4\xc8I\x12\xd3\xa1\xa5\xeb\xaf~8\x10@=\x97\x1aA\xea\x12/\xb9~fM\x0d\xa2(H\xb8\xb2J\x91\x0e\x18\xd2\xec\xae\x8b.|\xd8\x99~r\xb6\xbd\xbb\xce4:\xc35\x89\x0b\xd0\xf8X\x10\xc0\xace\x9c\xeb\x0fbj7\xd7Z\x827u\xcb\x08B,6\x1f\x17\xe6\xf6f\x89\x0b\x90z\xf3\x116\xbf1>d\xe0\xc7\x9d\xa16\x95Q\x1d\xf9\xbb_\x02\x90\x89\xb0\xaa\x9f\x9e\xd1\x19\x83\x9a\x85\xd1\xb7\xef\xe5\xdd/	\xedt\x13\xf0Kc\xa9\xdc\xd0\x0f\xa4\x11S\x8b\x8e\x14\xeb\xe2\xa9P\xbdB\xfco\x7f\xa4O0ec\x96x\xfb\xb7\xc4\xf5\xaf\xc9\xda$\xabA\xbb\x15\xda\xdeR\x8d\xcd+\xe1*RZ\xb5\xd0\xe5\x18\x9e\xb3~\n@\x82\xf8\xce0\x90K]Ie}\xf9\xe4\xc9\x93^\xe7\x936\xf1\"\xbf\x8aX\x0b\xa9^X\xdbM\xeb\x05\x15\xc0\x0bk\x8d\xed0\x11\x8bP\xe0\xa6t\x94\xc8\x95\xf7\x109\x16\xb2_\x1f\x13\x8a\x14\x98\\ pO\xb7\x89p\xfd\xc1\xd3\xc7\xca\xd8r\xb7'4\xb1\xea>\x12\xc6\xfa\x8e\x04\x8f\x0c\x0e\xed\x12-\x10@\xb8\x87VV{\x0f\xb9\xb3vz/\xb5O\x13r\xed\xb44\xb5\x18\xe8^\xbc\xac\x90\xce\xa6'\x95\n\x02\x99T\xff;\xd2\x0d/\x1b\xa1\xcbg\x19\xdb\xbc\xe0\x12x\xa6\x14\x1cQ\x97tH\xb1\x9cx\x84\x05\xa5\x1b\xc3;\x9c\xd6{,\x0f\xa8\xe6x\xf2\x15\xff]\x9b\xd6\x02In\x12Jd\xe9\xeaG\x1e\xc4l\xc6jt\x0c'\xb8\n5\x81\n5\x00\xa1\x9c!\xdbK\xe8r`\n\xe3e3\xd8w\xfed\xdbg\xb4\xb7]\x02Z8/\x96\x18\xae2\xb8\xcdoSc3{a\x86XNE\xb1\xe0\xed\xeeWK_\xa0\x05\xb8f\xf3\xef\x9e4\xe5r\xf3\xefW\xd3\xe4\xc0 \xc1\x01\xa9g<\x10o\x08\xdd\x91mM\x08\x97\xae\x0e\xc1\x85\xe0\xe8\xc9\x86\x93\n\xcf\xd7\x0d\xf6\x8c\xf7R*\x04\xcfE\x190#\x88G\x15x\xe9Q\x13x\xcd$\xdfE3\x07cI4\xd6cx\x8dbIw\xa4\xf1k\x9a\x02#\x1f\xfa7\x81T\x82(D<\xea\x00\xf6*9(\x04iP\xd6\xb8\xc4\x18\x1a\xe6\xca\x90XX\xf7H\x8c;e\x13W\xb9\x05\xf3\x9d\x95\x17\xda,\x95\xb80\xb7\xef\x17\xeb-\xafK,naa\xc5\x85\xd4\xeb$\x96DN\xce\x98\xf2H{\xb4K\xd1o\xfbK*%\x1b=\x14G1 k\x84\x95\xd4\xa5Y\xd1\xce:,\x8c.{\x015\xe3>\xcc:l:l\x8d11.\xd3=/\xb7\x1bG'\x04Y\xa4m\x0d\xba\xc3\x1cD\x8f\x009\xc2\xd1\x84w\x8ba)\xe9\xcfx\xb8\xb3\xc1T#\xe6\x88z%\xdf6c\x0b,\xc9?\xd5\xcd\xe1\xedZo~\xf3\xa87\xd7\x10=Y>\x81\xb7\xa0}\xda\xbb\x1b\x19LS6\xd8kj\xbaG\x90J\xa17\x8b\xcd\xff)\xd7 \x02\xd3\xad\xc97\x17D\xbe\xc9\xc1\xc9\xccX\xdfjt\x13\xe1\xab~\xed\xb1\x90\xb5t\x1c\xf0\x1c/=\x7f\xf3\xd2x\xbd\xb1/Y6\xa0H\xb5\x96V\xac\x82}\xf9c\xac\xfb	ja\x17m3\x86\x83V*\xffX\xea\xd4+\xdc\xdb\xd6E3\x81\xc0\x89\xc3\\\xd2\xcd\x85Rh\xd7\xc7\xa6\xec5\xdc)\xde\xdd\xc8\x1a\xe6\xe4\xa8\xb02M-\xb8\xf06\x1f@\xa1\xee=*kp`H\xf1^-D-3\xba\x84\x8a\x06\xcbe\x9c\x04\xa5\xb4Xxc\x13\x8b\x1e\xa6\xef\xc4\xf9\xc9\xec\xe4\xe6\xe3Rx\x16V\xfc\xb5\xfc\x03\x7f\x97\xc2\x8b\xa9p$\xd9\"Z%u\x06\xfb\xad\xb3\xfb\xae\x12\x16\xf7\xb9u\xbeHcr\xec\xff*|RU\xfa\xf8\x04\xa8\x8c\x9e\xce\xb4\x84\xb3\x82<:\x9a\xb0\xf4Sx\x12	\xbc]\xdf\xbe\x97\xce\x93\xde\xbf2W\x9a\xad\xf0\xaeY\xc7\x0d\xcb~6\x95,\xf1@j\x8deG\xf8\x1bY\"\x1cF\x17d\xb8!\x818W\x90\xf4\x08\xfc\xef+\xe1\xa1\"\x013E\xd4\xc9)9$=pAs\x7f*!|\xbe/\x94\xda\xcf	3\x0cs\xe4e\x98cI\x18\x8e\xf9C\xcc\x98\xe9`e\xec\"\xa07\xba_\xdc\x19j\xf4\x82{\x0d\xc7<\xc5\xa2\xb5N.Q\xf5\x8e\xa2\xae\x8c\xe7NNc\xa9\xe7\xf7.k/\xac+\xd8\x13\xecW\x10\xd1\xc7\xcc\xf5`\x13%\x95a2\xda\xc5\xf3\xaa\xad\xa7\xbd0	\xc7C ns]\xdd\xde\x88\xb2g\xb2\xa0\x07\x04\xfcxV\x99\xd5O0m\xbd7\x9a\xa4W\xa3D\xc1\xdeYO\xb4\xb4\x90*\xc3\xcb\x95\xb1\xf2\x8a\x04\xb2\xa23\x91\x99\x05\xf6MW\x03\xa9\x8az<\x18}\xf5\xc7\xa2\x12\x1a\xb4q^\xa8\xb9\xcc$\xac\xec\x8f\xfb\xb5\x99K\x1d;d\x0dH\xd1~c\x08^\xa4\x86A\x99\x87E\xc5[\xb6\x86\xc6\x94\xb0h\xed\x95\xb1\xa6\xde\xbe\x9bi\xe5\xa9u;l=\x1c\xeb5\x8b\xe74\x16\xa3\x88\xff@\nG,\xc0\x1e\xa3`\xed\x91\xa0%\xdf\x876\xa1kv\xeb\xb8\xf1\xa91\xfe\xcd\x12\xad\x95\x99\x80	CYc<\x98TG]\x1e\x8c\x8ef\x84K`%]E|Pe\x00\x849Q\x80\xc3F\x90;\x0d\x94)BpG\xc9\x05\xf9\xa0\x9e\x1f\x9e\xec\x01\x92\xfcg~\x9d\xb5J\x85!DYZt\x0e*\xb4\xb8\xa3\x9f[\x87\x03\xcfPl<\x86\x17\xc1\x83\xf1\x14\x1e%\x9fF\x84S5\xce\xdbB\x10V{\xb4\xb5\xd6\xa1k.,\xf2\xefq\xcb\x11\x93\x93\xd8\x13:\xac\xf5\x1f\xe9\x87\x93\xf5\x00\x9a\x1d\xd5\xdb\xd0\xec\xa8\xbe\x17\x9a]\xdd\x03\xcd\xa4&\xfd\xf3R\xfa\xfb\xf8s\x08?;Fq$\x80h\xfa\x0d!L\x1f\xc1\xa6.\xc1\"\xb9\xcb\xc804\xb6\x8cQ'\x87\x9e6\"\xdb\xd6\x9f\xcb\x1e\x80\x1f\xfd\xf9\xf0 \x8eB\xe5\xbf\x83\x96/\x04\x07\x12z\xc9\xf0m*H\x8e\x12\xfe\xe4\xc1[\xed\xe1\xe8\xd0\x8d!\xb5\xe9\xb1X\x10\xaf<\xe5\xdc\xcaQ\xb9+\xe6\xdb\xcc\xff\xf2\xbc\x12zN\xa6\xafG;#\xb1B-[rdt\x0bRR/\xfai\xbd\xb8\xf4h\xc9	e\xca\x85\xb8J\xd2jbe\xc9\x1e\xa2\xabZ\\\xd1\xb1\x84\xc0\x00\x962\x1e\x13\xa6n\x7f\xfa\xd3\x9f\xf6\xbf&y\xb3\x9f(t\x03\xd5\xc2\x15\xa6?\xa8\xe3\xf0\x19AF\xb0\xe3B\x93\x04\x92\xb7\xbb2\xfbv\xfdcO.L\xd7F8>\xd88R\xd6\xdd\xb7\x99\x87\xfa\x98?Y\xbbc\xe7K\n\xb1\xd9\xde\xa9L?:\xcf\x02	\\\xa9\x1d\xd4\x83\x9e\xa4\xc0f\xd2:\x0fK\xe9\xe4`\xbc\xcbo\xf8*uC\xb2\\#c\xe1\xeez\xf3\xc1.\xda$\x0c\xc5\x16\xba\xac:\xc7x\xdbD\xa7b\xe0\xdb\x01\xf1\xc9\xe1\xcb3y\x95/\xe8\x92m)b\xdf-\x82TDb\xb2s\xbd\x90\xa4<>\x88`\x94\xbd\xc9\xa2!\xbf\x1a\xad7 |n\x17:n\xcb\xcfZ\\\x9e\xb2J\xec\x19\x86\xbe\xd7\x03\x19}2D\xc8{ Xt\xac*YT]\xf8\xd3\xb1\xe5\x83t\xcb\xa6m\xdd`'\xb5s\xc9gf\xe44x2\x9c\xc0`\xe5\xdd\xbe.\xf1\xf6fa\\\xaf\x94:\x87Q\x17J\xba\xa7Q\x0bK8>\x18\x90\xe78\xe2k\xd4\xf3\x0c'\x1e\x8b\xcb\xe8\x86S\xa1b\xb8\xc7\xa1pph\xdc\xdae\xb6HZ^\xaby\xab\x06\xb6j-.\xdf\xc9\xd2W\xf7\xacj\xf3\xf1\xeez\xb1\xfe\x14\xb7\xacd\xb95\xee\x0e\xb3\xe0\xbc=\x7f\xdb/$|\xc6KMvh\x88\x11\x05\xd8\x95\x02\xa3E6}R\x86\xd4\x8e\xc0Jn\xa4\x06\xc2g\x95\xb1\xbeh\xfd?e\x00oe\xe1\xce\xcd\"C\xbb\xc7\xa1\x10<\x97R\xbb\x07\xa3\x03\x14\x16m(\xe28@\nc\x05\x07\x1fL\xac\xa9\xd1W\xd8:\x884)\xb0\xb5\x1f\x7f\x8f\xe1E\xb2\x8b\xa3e\xc6\x1c\x88\xbal\x8c\xd49v\xe8c\xf0\xfdt\xfa\xa2\xbda\xc1P\x8e\xf7\xedX\x92\xe7B|\x8f\xcc\xff\xe4\x84e\xbb\xa2$\xe4\xae\xe7-\xe1\x8dT>]\xd3\xac\xa4\x85Z#\x85\xa4\x0b\xd6\x0f\xfd\xdct\x1e\x14>F\x9d\x1c\xcb\x14,\xa6{.\xbc\xa7\xb8\x9f7\xa0\xa3\x0f%\xe3\x11\x8d\xab\xce\xb3\x95V\xc6\xae\x96T\xb8\x8d\n\xa9\x83q\xfd\xa1\x9fPL;\x99\x15\xb1\xf5\x9b\xe4x\xd4\x83\xca~Ps\xd86J\x16\xc2c\xf4\x15\xf5\xd4\xa0LU\x89\x9f\x07^\xe4P\x16\x11:[\x1eB\x91PY\x07\x0b\x84VG7!\xd8\xe0\x8ew\x80qa>\xb8\x97E\xbfao\xae\xf4\xe6\xbaF\xdd\xd9\x95\xdf\xd9\xcd\xb5_\x88\x94UC\xc9.\xd7\xe0(]f)\xe0\x8a\x8dN2\xb8I\x9e/mQ\xb5\xdb\xceYm\xbc\x9c\xd1\x02df\xd3\x1f\xa2[\x90\xd0;\xc9*\x13\xdf\xbc\xa2\x14\x81X\x9fwv!\xc9\xe5\xe7\xd6\xd0\x92\x88\xad\xc0\xadu\xb1\x12\xbe\xa88\xbc6\xa5\x18\x01E2\xbc\xb0\x83\xdd]\xd1\xed\xce\xcd\x83\x13\xb3\x82X\x06\x07\xc1\xe3\x14!Bk	\x06)v\xc1p\xb53zN\xba\xcd\xee\x8b}\x8aH\x90\x7f\xb4D\xcd\xdci|E\x91]oQ\xd4\x83\xac\xa3\x1d\x87V\xc6\x9eF\x95;\x1c\xf6F\x95\x9d\xd74\xce$\xeb\x10!\xf8\xf3{\"\xe3	\xbaC\n\x91\x9f\xf7!\xf2c\xd14\xb4\x023\xeb\x0cIC\xb6\xa4\xdb\x02\x08)^N\xab\xa4YG\xc1\x87\xe5N\x18\xc0\xac4f7\xfeM\xf8\x8c<N\x1f\xc3\x9b\xce\xa1\x02ra\x92\x7f\x9cy\x93A\x7f\x14Et\x13\x95\nSc\xf8a\x8drc8n\x9d\xdf\x0d\xba\x1b\x9d;\x89\xba\xadJ\xdb0\xf9\xd4\xde\x11\xff\x1f\xb46\xbb\x9ftYa\xcaE\xdbq3jL\x81_\xe6\xc6>\x8a\x9a<|R\xc3\xcf\xad,\x16\x14Q)b\x04|\x8a3c\x83\x94\x0cF\x0f\xe93\x06\x11r\xdb\xff\xf1E\xbe\x00\xe3\xfcscTiV\xfd\x9d\xe0\x99\x15\xa94\xcaPI\x99C5x\xf2\xe9e\xce\xbc)\xfaU\xba\xdfI\"\x86\xeb}4	\xe7\xd8Eq>\xe5i\xe39\xc4\xc5\x0e-\xa3\xc9`\x1f\xfe\x1e\x0b)l\x9a\x9e\x83\xe8d\xea?\xd2H\xa2\xa9\xbe(\xa5\x7f\xc7N\xcd\xe1<	vGog\xbfg\xad'\xcf\x1a\x8f\x9f\xces\x0f\xca\xd6\xd2\x14\x03\xf4\x12P(\xe30\xfa6I\x11M\x91iQ\x0c\x8d\xa2~\xd2\xbb>\x90\xf1{{x\xc4VW\xb0\xb4\xba\xa9\x85B\xf6=\xc0k\xa9\x17\xc0\xf5\x8eD`D\xea\xa1\x01\xd9\x1bi\x12\xad.\xa3m\x1c\xfd\xadzAGY(Y,\xc6\xf0\x8ed_\xd4\xca\xe5\x1eh\xb1\x94s\x8a\xf3\x12k\xf9jH)\xa6\xd3m\xed\xe0\xa9\xf0[.\x05\x9e^\xcf\xb7[\x80\xea\x13\xd7\x81\xfc\x9c5oq0\xf9\xc9\x07z4\xf9\xdd-B{t\xd8\x8b\x0d\x1a\x15-)\xed8 \xf9|@\x10\xe1\xc71D\x1d\xfc\xbc\xa6u 9\x0bc&\xd1\x82&\xdf\xab7!k\x87&\x97#$N(\xdc\x8aMN\xa8,\xca\xbe8T\xf0\xe1u\xf2\xb0s\xd7\x91c\xad\x12%\x08\xbd\xeeQ\x01c\xd4\x13(\xc5:\x03\x08<\xd0v\xb2c\x18)\xc2\xf9\xe1P	\xe3\xdf?\x16-\x83\x91\xdf\xce0\xeb\xb6\xdb\xb03\xd5\xfa\x18\x7fN\x91\xadC\xc3\xd1 \xb7\xe8'f\xa7\xe4\x9c\xee:\x9db\xb9\x7f\xa0Z\x84\xf3>\xab6\xf9\xd9\x08\x13\x92\xfe\x9aR=%\x88\x91\xef9h\xaf\x8e\xff\x1f\xfd\xab}D\xca\xee\xd1\xbfN3?\nA\x87\xa1<=\xa5\xf3\xba_\x9e\x9e\xbe8;\x8f92\xa2\xfc\xa7\xcaS\x9a\xd6\x1b\x9d97_\xa3&@\x12\xb29z,\xc3\xa1t\xb6\xd9\xbb\xf4V\xaa\x1c,o\xf7\x9a\xf0\x12?yM>\xb1\xca\xff\xe45	)r\x99k\xf14\x15l\xab4\x8b\x94\x9b\xb8D\n\xf3d\xadC!P\xa9\xf3\xa2n\x12\x1bv5\xc4j\xacGB5y\xd0\xc6O\xe1\xd1\x17PQ\xa0Q\xcc\xcd\xe0\x98\x1b\x14\xf9\x06\xf0\xe7\xeeL\x88\x9b\x0eZ\xca\x86\x1b\x18\xa2d\x06\x8b5L\xb9&7\xc4{k\xd8b\x91\xdcMa\xaf\xf8J\xb2\x8fY\xacc\xe6\x1d\xe7\xde8jj\xb4\xc6\xc2G/T\xef\xda|\x1e[\xf0\xdd\xaa\xa5#y^\x93>\xae	DX$\xfb\x93\x85c\xec0X\xa0Z\x9f\x0e\xbc\x12?\xd2\xac\xd7?\x91=\x14*\xe21\x9b%\x02W%\xaft\x14\xb8\x96\x1a\x01\xfb\xcfb\x8eS3p%Yc\xfc\xf7\xa7\xaf\xfbM$w*\x15\xec\x0d>Sg\x06\xf2\xbc	Y\xfe!	 g\xea\x08\xfdS\nGc\x0d\xd9\xa4\x9c%\xc0Q\x80|a\x94\x82\xd5\x8d9\xb1b\xb9\xf9\xe0;\xcf\n\x0b\xc8\x98\xa6\xb5\x1b\x7f\xa5r\xcaA\x14*)\xd4\x8e\xac\xcb\x9dMg\xf4\x91\xe4\x10\x8b3\xf2Zd\x1b\x9d\xb6(\xcb\xff\xe2\x95\x91:\x1bh\x95l\xe2N\xa8\x9e\xe1\x0eRZ\xfd58s{\x13\x07;\x13\xca\x87-\xa1\x80\x03\x82\xb7\xb2)\x0cE*t\xd9\x1b\x831\x9fS\x1aM\x1bI)\x18H\xe1p\x9a\xd3\x14{D\xf9uH\xb7\xa0h\xbau\xa0\x8c\x9e\x0f&\xd3\x16\xa8E\x9f\xa8tF\x05'\"\x99\x92\xa9\x9e\xf2\xe7>\x11\x9ar\x01!neG\xbf]7\xf6\xee\x9a\x84\x0e82#\xa4\xe0\xbc\xd9\x90\xf8\x12\x89s\x06\xf5\x12\x1as\xfb\xde#\x94w\xff\xd3\x14\x15\xa5\xa3\x95k\xca\x86\xde\nvRO\xbfN	@\xb2 \xffHY\xa9\xcd\xb5\xcbc\x1b\xae1R\xe5\xd0\x9d\x94\x02g\xd2\xb8\xc6\\(\xec\xb0\xdd\x84\x9f<\x0cs\xec>\xff\x9cDilH\x813\x890U\x86\x92\xb5Iwd\x0eh\xe7\xc5l6\xcc\x84\x8e\x19\xd8\xd6\xa8\x9d8\xa5_\x9a\x8b\x96j\xc8\xf1T)\xb1\x94\xb7\xef\x17\x98L\xe7\x9cl;\xd5x\x0f|9\xe3\xf2L2\xf7\x01\xacg\x9d\x1b\xaf\xaf\x0d\xd7\xa52\na\xff\xcb\xaf\xe1h\xb2\xfc\x9a\xb5\xe0\xfe\x1f\xf8\xe3\x0f\x94\x1b\xa2\xd1\x0f^#H]\xca\xa5,[\xa1\xe0h\x92\xa9g\x9f\x07\xf73\x9b\xeb\xdc4\xec\x92\xe98^\x86w)XFx\x17\xafZ\xa8\xec\x82\xadcxmVh\x0b\xe1\x10\x14zrs\xec\xc5\xb0N\xf4\x83\x08W\xa1\xdb\x8b\xc9C_\x0eR\x85\x08\x0f\x8d\xe1\x99\x87\x9a\xae\xd6\x1f36\xa6\xe3\xd9Q\x8b\xd9+\x9b^\x1d\x9a\xd28\x89\x8a\xcf;\xa4|\xe4\x11\xd1\x90\xb3\x99\x88\x9c\xf3W\xbc\x92\x9c\xd6\xc9\xc9\x9f[\x89\x9dA\xe4\x06\xf6?\x16\x97]o\xf6\x13\n\x15E2e\xabH\xbbf\xbd\xb4\xa3#\x08xu\xb9\x7f=*\x8b\x18!\xa9\x01\xda\xe2\x9d@\xeb`t\xd9\xdbXlO\xfd\x7f\x1a}\x90\xc2\x1e\x10c\x9e\xc1\xfe\x8c\xcc\xca8\x11\x17\xa5S\x8c\xf4\x1a\xdd\x99-\xdb\x1b\xea&h'\xb90N\x0fd\x08o4b\xbe\xabi\xd3\x081\x8e@\xae\x13\xcd<\x938\x942\x01\xb7t\x97\x1f\xdc\xe1{_3\x04\xb7\x10E;\x1b:\x00\xb3\xed\xf6\xf1\x1c\xc48\x0b\x82\xa7\xdb\xff\xb3 E\xb6}\xb8\x9c\xda\xbb\xf9\xed\xee\x17p[\x0d`IN3zH\x15\x9cf\x99\x82\x0d~\xde!\x1a\xfd\x9e\xcb>\x81G\xd9\x95\x1fz\xfdS\xd1h\x18bW^\xc5\xc9\xf5\x12i\x0bI\xde;\xbf\xff$\x8e\xa47\x00\x07\xaf\xba\xfdxN\xf9\xbbu\xf6\x96h\x18b\n\xa9\xdd\xf15Y\xdff\x8b\xda\xdf\x1dj\xba\x8f\x04\xbb\xe7\"\x93\xbe\xd1\x0c\xa4:R\xef\xa8\xaecP\xf6\xb9Put3$\x95\x16\xd2\xac\xca\xf4Z\x8c\xae\n\x13\xc5r\xb7k?,N\xebO\xc7\xea\xdf\xe1\xf4\xf8\xbe8}2\x90B\xbd\xc5\xa5\xc4\x15e5AE\xa4:`\xe6BJ\x1f\xf0\x00\xbdi\x9fr\x843	\xbc\xaa\x84\x7f\xa6s\xa4\xfe\xae+\xa1\x06\xa1\x85\xa0\x16\xe3\xb9\x00\xfeA/\xfd\x10\xb5\xab\x8c\xdfA\x15kJ\xed\xeb\x85\xe9_\xc2\xe7^\xf6\xf1	8B0q\x90D\xf4\x8e\xb2G\xc8\x8d\x1f{S&\x8a\x8b\xee\xe4\xe4z\xa7\xd58r\x15\xda\xfb\x8f6\xd2\xdcJ\xdd\x9f\x84\xc7\x8f\x9b\x8f`9Q\xe9\xbf4\xc0\xc3\x07\x7f\xdd{\xf8`d\x1a\x02r\xdd\xfbQ\x1d\x12}\xc3_\";\n{Ee3z\x05c\xe2\xe6\x85\xcaU\x0c$\xa5\xba\xf0\x1dI;c=\xcd>\xe9\xf1\xd1\xa1\x9d\xeb\xd6\xcb\x80\x95\xc9K\xa1\x90\x1e\x87V\xa6\xcf\xa6jC]@hk\xf2\xea\xa3\xee;\xdc\xbe\xf7m\xcc\x13\xa6\xf7\x9e\x91\x0c\x97f:\x95\xf6n\xe4E\x97\x173z{w\x8df\x8a\x85\xde\xfc\x1a\xfa\xdc\xfdo\xbf\xf9\x18RrGo\xd7\x9c/\xb2\xb9\x0e\xb8k\x18\xcf\x1b\xfd\xb0\xb9\x9e\x8ae\x9c\xc0\xd9\xc2\n\xbfXwC`\xdd\xf0K\x80\xb4yS\xa1\x07\x9a\xe3/\xa6\xcdr\x958\xf1\xb2\x0c.\x9d\xfd\xcf\xdc>9\x15?\xcb\xb2\x06\x0d\xa9+\xb2\xc0,\ng\xf4\xd3x\xaa\x7f!\xe3\x91\xa26\x9c\x17\xcf\xda\x1c\xe9\xc2|\xe6\xc8y 5|\xe6\xc6\xb1\xe9\xb3<\x95\x80\xba\x85\\\xdd=\xca(\xb1\xe4\xb7\x94\x8e[g\xc7\xdf\xca4\xf9\xf0r8\x06\xa6\xe9!Y\xe0\xdbQt8S\xddY\x8cI0$nAD\x8dD\x83\x8fDI\xbe\xb9\x14\xba\x8fe\x84\x11\xb9\x94\x9eb\xc8\xae\xd899\xd7g\x04ec\x17+n\xff\x06\xc6\x89\x12\xb5\xf3\xcb`\x03\xd0nR\xf5\x81\x88\xbc\xc6\xfbg\xcf\x1a,h\xc3i\xb1\xd8x\xd7\x03\xb6o'/^\xed\xc1\xe4\xe4\xd5\x1e?\x035\x16\xde\xbd88\xce\x1e8\x84g\x88m\x0d\xa5\xacc\n+!\xd0\xaf\x9e<\xb9\xfc\xe2\xc9\x93\xbd\xae\xba\x0fH\x87\x880|w\xc0\xf7U\x93\x07\xb5\xd5\xe58\xce\x87\x97v\x10\xd7\x1f\x1f\x80QQ\xf6\xc0\x8f\x1e\xac\xe99f\xe1\x88\xd1\x0f\x14\xfa\xb9\xfb\x05*t\xf1\x1d\xe1\xa8P\xb4%\x05\xf5M?c9\n\xd2\xec]\x86],6z&\xe7\xad\x0d\xde8\xaa?\xe1\x8cb\xa2J'\xd2n5;c.\x18\xb6\x0b\x9c\x11\x1b\x92\xad\xd8\x13{\xbbN\xc1\xb4\x8c\x18\xa5\x1cR\xe5\xa1\x88\xa9\xa1\xa3\x80\x08\xbb^?\xc4T\x8d\xbc\x0f\xb7H\xba\xadk\x11n\x98Y\xe4\x8db\x80\xafk\x13\xb1Hl\xd2\xda\x14\xdb\x1a\x1d\xa6\xdf)\xed\x9a\x94\x05M\x8c\x91h{\x11\x996eBS\xcdYH\x98\xbe\x0e	\xd3q\xf6\xb3\xb6X\xbc	\xfc\xf7\xf2\xfb\xe7\xdf\xc1\x9b\x97/CEHJ\xa0\xf2W\xcaL\xf9\xcdj\xa8\xe0\xec\xb5\xd1\xd1a\xfa\"\xaf\xa9g\x168\n\xbf#e\xc9\x8f\xd2\xced-U8\xbc\xf0J\x0d\\(\x89:\xd6\xc5\xc6J\xe1\x9c\xd3\x8dG'\xa8pN\xe3\xad\xc3\xcb\xd9\xd0\x80\xc0V\x8c<\xd1P\xdf)\xb9\xa0\x17Z\x16\xbb\x97TdP,\x88\xe3\"wP\x0f\xa9\xe7;\xf7D\xec\xdc\x8a\xff\xe8R|\xf5_\xb8\x14\xca\xcc\x0d\x07\xe8Go\xa2\x91\x9cn}\xa8		\xfcY%\xe5Ye\x8f\xe2\xaf\x84\x95$\x06\x92t\xcf\xc3\x93\xd4\xf1\xfb\xc6\\\x19\xab{\xa5\xd0\xeb\xaf\xd1[s{3\x8d\xd7\xd1\xac\xf4\x89\xe9\xbc\xd5\xf4\x1f\x11\x84wl\x1f\xee\xae\xe3[\xb3\xfc\xe14\xfb\xd0\xa9\xdd$\xe7\xbb\xa6\xb5\xf3\x8e3'\xf4\xc1\x16JH\x83\x8b\xca\x91\xf3\xae\xce\x8dQ^6D\xe0\xa5T\x1e\xbbC&\x11\xef\xda)=\xb7\xda\x83\xa9)\xd7$\x95\x82Q@1t@M\x06)\x07\xbeH\xfd;\xc5F\xe8\x18\x92l;\xc5yK<\x83\x97\xe9\xc5h\x94\xf5#\x87>\x84U]\x7f\xafcj\x7f\xdc\x01\x87\xfeu`\x85\xac\x05\xc1\x1f\x92\xedm\xc6>\x91o]%B\xe8'\xfd\xcaJ\x0f\xd8\xc0\x1b\x9d\xf1\x07Lc\xea\xe6(\xb2t\xc4\x9e\xd45\xfe\xec\x99\xdd\xb0\xeeq]\xcc\x1c/\xa5\xf3c87!l\x84\\\x1d\xb7\x8bb\x03+\xb1\xde\x83\xb9\xe1\xedHYr\xed4\xbd\xbf%\xd5\x14\xb8\xf6_>q\xad\xfee\x04E\x85\xc5\x02\x93\x94N \x84f\xf7\x83\xb1\xa2$)\xc9o\xa25\xbd\xf9-oo\xd2\xfa\x83!\xd5\x89\xabhXA~\xda\xe1(\x89\xd4\xc4bYcd\x14\n\x92S\xe1\x99\x17\xf4\xbc^6\xe6\xa2gP\xaa|\x9e4\xe8\xf7Z\xfe\xdcr\x86\x17\xb9[)HPx\xb9\xc4}~\x8cD\x1a\xba\x7fo\x98< \xbd\xf3\x83\xbd\x0d4N\x17p\x18\xb1U\xff\x14\x18/\xc56\xeb\x86K\xce\xd7M(huT\xa4o\xca\xa9\xd0\xed\xc5\xe8\xe1\x83\xbf>\xfc\xeb\xc3\xff7\x00PK\x07\x08\x91\x13\xc5\x0b%\x1a\x00\x00:D\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x004<N]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/tr_TR/common.jsonUT\x05\x00\x01T0\xcfj\x8cVMo\xdb8\x13>\xdb\xbfb  h\x0b\x14\xe9=7\x7f%1\x9a\xc4\x86\xed6\x08\xde\xbe\x07Z\x1c\xcb\xac)RKRq\x95\xa2\xe7\xfd\x13\x8b\xe6\xb8\xd7\xfa\xd2\xd3\xde\xe2\xfc\xaf\x05\xc9\x91dgQ\xec\x9e4\xf3\xf0\xe1p\x86\xf3A}\xedv\x92\x9569s\xc9\x19|\xedv:\xc9\x92)\x85<9\x83\xa4\xdf\xbb\xb9\x19\x0d\xa1\x7f\x07\xafN\xec+8\x9f\xcc\xe0\xc4\x86\xcf\xa7\xe4\xc4~J\x92\xb7\x9e\xcfQ\xa2\x8b\x1b\x86\xa3\xab\xd1\xa2\xdd\x11\xd7E\xce2\x1c\xb6\xa4\xf1u\xefb\x04\xbf\xa6\xce\x0b-$\x9aC\xf2|:\x19_\x8df/\xe9\n\xb7Sm\x9d\x1d\xab\xc5\xda \x0b\x1bN8(\xdcB\xe1q\x10\n\\X9\x8d\xf6\x03\xdaS|\xec]\xb2\x93\\8r\xfc\x84\xc7\x1d\xaf\xed\x1b`\x8a\xc3	\x87\xe0\x8b\xd75\xd1Z\x0b\xbf\xd8xL,M\x86\xdc\xbb\xe7\xedO'\xf3\x05L?\xcc.\xda\x10\xfeq\x8fv\xcd\xb8\xde\xf6E}\xfb\xf3\xcb\xdepr\x0b\xfd\xf1\x7fIB\x0c\xf3J\xa7\x9b\x85\xce2\x19\xa3Z\\\xceF\xbd\xa1\xcf\xd9\xd1-\x97\xaaM\xf1\x87\x9b\xe3$Gk\xf7\x02\xb7\xc8\xe7,\xc7\xf1\xb4v\x7f\x0e\x93sX\\\x8e`\xde\xbb\x1e\xc1x\n\xb7\xa3\xd9\x08>\x8eG\xb7\xadwI\xb7\xf3\xed-\x15\x94\xf5\xf5\x14\xb4B\x96\x86I[\xd7\x17gUr\x06\xff\xebv:\x9d${\xfaK\x85#\xa3(\xd1$\xddN\xe7\xff\x1eI\xd6\xba4\x0d\xd12\xe6\x88\xe8\xc5#b.T\xe9\xb0\xa1r\xb6\x11\x1b\x96\xbc=T\x1a\xa3\xb9Vn\xddPYE4V\xb5\x14\x8b\xa9V\xbc\xe1\x90Jg\x07\xc5\xb6\xe4\nY\xebd\xb5\xdfI\"\x06\xd1\xb3\xe8FBu\xd57\xc0x.\x94\xbf\xd7^\x10\xfc\x8e\x84e\xda#\xfe\x13\xf5\xe0B\xf8\x90\xae\xe3\x16\xff\x0dH\xaa\x953,u\xb1\x98\xfd\xda\x80\x10 \xe8\xa0=\xfbU\x9b\xcd\xd8\x8b\xc0\xa4\x84e\x05\xe3i\xe4\xe1\x97\x82\xce\xbc@%\x9e\x1f%\xba\xc3\x85\xf6\x94Q \x1e\x9d\xb1\x16\xdc\xdf\x7fr!\x1e$R\xd7\x07o\xeb\xe8>3%\x9c6\xc1\xcbk\x14\x9c\x82\xfc\\Zw\xa3\xb7\x1e}~\x149\x17\x11\x96:\xdd\xc4\n&)\xa0\xb9\xe6hXc\xa5\xd6\xe2b\xdd{g\xd0\x88\x11\xdf*\x8c\xc7\xf653\x1c&^\xa7~C\xecI\xe9w\\ba\x85\x12\x90=\xfd\xb4\xaeY^G\xc7.\x8e\xc08\x9b\xfc&\x1aS\x11w\xa1\xe9\xe6N\xa4\x1b_\xdcIlB\xb0\x11\xa0\xaek\xc3j\xe4\xa6\xdb\x0e\xf3\xe33\xd5\xa4\xa5\xd2\xa5\xdf\xf1z\x8e\xeaM\xdd^\xb6R)\x15]\xc2\x85M\xb5R\x986!\x93*TF\x81T*=Rj\"G\xd2\xba\xa1\x98\x13'r\xacK4e\x12\x15?\xa8\xedIZ\xb7\xd4\xf3\xf7rI\xe253$\xdd\x08K\xd2u\xd3T\x97\xec\x81\xa4\x05\xe6$\xf5\xf6\x8f%\x89\xa3\xaan\x96\xd1F\x90\xf4\x9e\xd5fz\x86\xb5]\xb6E\xdc4\x9eL\x1b\xb3\xd3\x87z&\xccYm\xeb\xe9w\xf3\xfcH\xf2\xb4\x15\x07\xf5\xa9\x03g\x0f\xfb\xb2\x14u\xc4K\xed\x9c\xce\xfd]\xf7$U~\xcaT\x8a\xa1B\xf6?\nGG$)sL\xea\xcc\xc3\x03\x12#.\x85\x9f\xbe\x83f\xd3\xc0\x03\xe04\x90\x9d\xc3v\xf4\xc5x\xd0\x8b\xcb\xa0G\x82V>\x0b\xc98/\xb4q`\xcb4EkW\xa5\x94\xa7\xb0X#\x14,C\xd8\n)A\xe9-\x18\x94\xbay\xdeVB\xe2B\xeb+f\xb2`\xc2\xeb\xe0\xb4\x06\x19\x90`~%\x94\xb0kz\xdf\x85s\xd4r\x99\xd6\x99\xc4\xb9V!\xae\xe7\xef\xccl\xf6\xbbj\xbf\x83\xb8\xc0\x190\xc3\\\xce6 \x9e\xfe\xf4\xcf\xea~\xb7\x91\xac\xda\xef\xa8\xc1E\xf0v\xa0\x8d)\x0bw\xe0\xfe\x8a	\x89\xfc\x14\xce\xbd+)-\x1f\xec\x18\xfe*\xda\x7f\x0dV\xa8{&\x05\x1f\xb0\xc2\xa5k\x16lD\x04R\x82\xc21\x1b\xc4\"\x0c<\xa1\xb2s\xed\xeb9y\x8fX\x00'\x0cV\xf5\x08\x91\xcc\x06\xcf\xe7\xf5\x80\xf5\xfd\xda\xfeXPK\xc7\x87\x16\xfcZ\xcbB\xbe\xd0\xfd\xb6~\x9e\x1f\xd9\xfe\xd1\xdf\xdd\xd3O\xc5\xd1\x08Y\x0f\xb6\xdc\x8f\xbe\x8f\xc2\n\xad\xea?\x830\x0d\xe1>`D*\xad\xbbf.\xf5\xcfT2e\xd6n\xb5\xe1\x16<\x0cy\xc0\xeb\x9f\x9f\xd6\xb9;T\x026Z\x95\xf5_\x8aPn\xa1\xeb\xf2\xf4/\xb8G|-\xd6X\xfb7C\x83<\x90\xac\xb3\xef\xa2\xfen\xb1\xb8\x8a\xa4\xdfJM\x7fI}a\x04X\xf1\xc0Q\x01\x93\xfb\x9dr\xfb\x1dT\xacp\xfb]\xa4\x1ad6>S\xb3(\x11\xca\x85\xc1\xd4\xf5\xab8\xdff\xa4\xbf|\x81Z^\x1b\xd8Kn\xbc\xfe\xda\xee\xca\xa0\x0d\xd74#\x91\xf0B\x86)<\xc0{VH*\x04\x83\xbe\xc2<\xbc\xffa\x0bQi\xd5\xae\xb8\xd2\x04\xaf/\xd0\x08\x18>\xfd\xa4L\x98Rbx;fA\xa0W\x83\x99\x98\x1a?\xa1\x08\xb2>{\xa3/\x85\xa0\xbf\xd6+\x9d	\x05\x84\x03\xd2B$\xaf\xf5\xf6F;\x91\x86\x0e%)\xae\x94\xcb\\\x04\x07\xe7Qz\xf1c\x87|h\xd8*\x10\x16m\x11\xfa\xe6\xba\xd3\xa5\x01\xee\x17a\xcd,,\x11\x15l\xb0p\xd4)n]\xe6K\xc5\x84\x14\xb1\xbb\x17\x07\xfa\xe9iM\xd2\x85\xb7\xfc\xf4\x87\xa5\xfetUA|\x92\xe8\x15\xab\x07H]\xc0w\xba\x845\xbbG`\n\xda\xd5\xf0;]o\xd9\xfa\xaams\xfa!\x02G\xb9,\x0b?\xc7\xfc\x94\x08\x8c\xa0\x81\x9f_d#\x00S\xa33\x836\xa4$\"\xc8\x9b\x00\xfc?+9\\\x8ba\xeb\x8b\xc3o\x0f\x8f\xeev\xbeu\xbf\xfd=\x00PK\x07\x08\xbeV\xa6iy\x05\x00\x00\x02\x0d\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x0b>N]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/tr_TR/server.jsonUT\x05\x00\x01\xc63\xcfj\xbc{Osc\xb7\x91\xf8Y\xf3)\xba\xf8+\xfff\xd7\xc5\xa1\xc6\x8e+\x95\x9dC\xaa\xa4\x914V,i\x14Q\xceT\xd6q\xaa\xc0\x87&\x89\x10\x0fx\x06\xf0D\xd1\xa9\x9c\xf7Kl\xed\x1c}\x8d.9\xf96\x9a\xef\xb5\xd5\x0d\xe0=<\x92r\x92\xddd/\x12\xd1\x00\x1a\x0d\xa0\xd1\xff\xdf\x1f\x9f\x1d\x8c\xe6\xd6\xd5~\xf4\n\xfe\xf8\xec\xe0`tv\xf4\xeb\xd1+\xf8\xe6\xd9\xc1\xc1\xc1\xe8\xdcP\x97\x08\xca\x1ah\x84A\x0d\x01\xef\xc3h\xcc\x9d\xa7&8\x85\x1e\xe6\xd6AX\"\xcc\x841\xe8\x9e{8s\xf8]\x8b&\xe8\x0d\x1c\xf9\x15J\xf8u\x8b\x9epx\xd0\xca\x07\x10F\x82*0\xd7V\n=zvp\xf0-!\x1e]M\xcf\xdeu\x14\\\xd9\x00S1G8\xb3\x0e\xdeY\xb7J\x8b\x1f[\xe1$\x08\xad\xed\xdaC-\x02:%\xf4\x18\xc2R\x04\x10\x0e\xc1\xd8\x00\x9e\xe6\x05\x0b3\x84;\x85k\xa4UA\xc0\xda\xba\x15\xa0\xb9S\xce\x9a\x1aM\xe8W\x16z-6\xfe\xc2V\xabn\xfd/\xd1\xc1\xf7\xa2\x16\x06\xc4\xc7\xf7\xe2\xf1\xfd\xe3\x83\x14\xb0\x12z4\xfe\xe9\xee\x1e\xa7\xb1fS+\x8f\x1d\xca#c\x8d\xaaa#\x9a\x1e\xc9\n\xbd\x02\x1a\xaajX|\xf8\x8b\x0f\xe8\n\x14\xaeZ\xaa;\xbc]:\x14\xd2\xf7x\"\x18B\x82\x8f\x07\xd0\xc6\xb5\x06e\xee\x04e|@!\xc1\xceA\xa2\xc6\xa0\xcc\x82.\xad\x9e@B\x1b\xc1(a\xb6\xa1\x0bA'\x82u\x9e\xcf\xd2\x07\xa5u\xee\x9f\x14t\xb5R\xd9\xdfX\xdd\xd6\xc5\xe6\x08\x06w\x11\x18)\x8a#he\x9e@\x97P\xb7^U\xcc\x07wJ\xa2\x85F\x8b\x0d:_\xa2\x0ev\xa1\xe6\x1d\xda/\x85\xc3\x15\x06\xad\xe0\xcd\xf9\x19\xac>\xfc\xf8\xe1\x87\x0f?\xae\x1czUkt*\xad\xb4\xb7\x0f\x96q.`\xf0\xca\xf4K\xcc\x16\x97m\xe8	\xa7\x06\x1c\x8bj\xb5p\xb65\x12~C\x84%\xbc\xdc\x17Y\xbc\xebg\xc2KlqBF\xf7\x04\xa6#Y+\xf3\xc27X\xa9\xb9B	5\xd6\xe8\xa1Q\x0dJ\xf0\xc1	\xb5X\x06P&X\xd8\xd8\xf6\xb9C\xa8\xadQ\xc1\x16\xcc0#\xc6?\xbdo\x94\xdb\xf4\x8b\x11\x0c\x90\x81\x10Tw\xf2Wm=CG'/\xc5\xc6\xc3Z\x85\xa5m\x03\x18\\Cc}\xf00\xc3\xb9u\x08\x02\x18+\xa8\x8e\x0b\xb6\xd6\xbb\x12\xc5\x15\xc7\xd5\x8c\xe8\x97\x11\xf1z\xe9\x84\x087#\x9b\xc0ka\xa0\xb2&\x08e\xa0m\xe8!~\xf6\x12\xb4]\xa3\xab\x84G\xb8\x10A\x19\xd0\x18\x02:\x0f\xd6\x81aj}\xc1`\x8c\xe8V\x05\xbd\xbdx`\x18\x8d9\x18q\xff\xce\xf2c\xf0m\xb5\x04\xe1\xe1\xf9\x91Qu\x0b\xff\x1f.\x85Y\xd8\xe7\x13\xf8:\xd1\xf2\x12\xaa\xa5p\xa2\xe2\xf5\xb55\x8b\xc1\xca\xb2<^\xb9\xc9\x8b\xe1}\x80\x99\x95\x9b\xbc \x9dc?\xad\x12M\xa8\x96\xa2\x9b\xf9:\xb5\xd3\xe5\xfb\x15\xb4\x9eV\x0b\x16*[7\xf4\xa0@@\x9a\xc5\x82\xb4B\xc7\x07\x16\x84_\x91\xb8\\!8\\(\xe2\x0d\x96\x95\xf4f\xe2\x9b\x86\xca!\xc3v\x96\xbf\x15\x0b\xbfM\x02\x04\x02\xa6M\x88\x05\x93\xe0m\xeb*\x8c\xcb&\x12\xbc\xd5-\xe1\xf4\x13xk\x90&\x11S8a\xa4\xad\xf5\x06\xaa\xa5\xf5hx\x06\x8aj	\x0b4$(P\xe6=L\xe0\x06\xbfk\x95#\xc9c\xbb\xdb\x17\x014\n\x1f\xe0g \xd5|\x8e\x0eM \xdc\xe5]\xd3]L+\xeb\xfa\xab~\x9do\x07|#j\xf0\xdc\x99N\xd2\x04\xc5\xc05\xf2\x8b\xb1s\x92Yj\xbe!\xb1&\xfa{%Y#\xf8\x8e&p4\x0f\xe8\x00\xef+D\x99\xa4\x1fhU\xab\xc0\xbf\xe8^`Mr\xce`$\xde[}W\xdcNI\xaa\xad\x1b\x87\xde+k.\xf0\x0euOq\xdf\x01\x9a{h\xfc\xc1\xe8\x04\xe7Z\x04\x84bb\xec'\xc2\xd78\xf3\xb6\"\x01U\xa3\xf7b\x914j\xa5\x15\x9a\xe0\xc1\xb7Mc\x1d\xcb\xeb\x06]\x1a\xf2BF\x8c\x13x\xf1\x19]\x10\xed@\xe2\\\xb4\x9aN\xfa\x0e\xf5\x18^\x82T^\xcc4\xfar\xd91\xbc\xf8\x9c\xf6\xea\xc1\x1a\xbd\x81/\xdb\xf9\x9ct[e\xf9H\x88\xb9>#\xce\xf87\x08NH\x04\xdf\xd0a\x109^}\x8f\x83#p\xfe\xb5C\x89&(\xa1\x0bn{{3\x85\xaa\xe8H\xf7Ez\x1a*g\xbd\x7fa\x9dZ(\x03G\xd7\xe7\xc0v\x02\xc9!:n4\x12*kW\n\xfd\x04\xce\x17\xc6:\x94cPs\xf8\x94vH\x1a\x96\x90\xa0\x84\x88`\x8b\x98\xb7\x0c\xdc\"\xc4& \x8d:\x18\xa5!\x1d\x9e`\xa1\x16+\x1c\x92U\x92t(\x1au\xd8\x0b\x92e\x08\x8d\x7fux\x88\xf7\x82\x9e\xee\xa4\xb2\xf5\x04>\xcd6\x88\xd0:\xafW\x92\xd6\xfa`\xeb\xd7\xd3iGX\"\xe6k\x8f\x8enQ\x91\x92~=\x9d\x82k\xe9\xaa\xb4\x15\x92\xb6h \xd8&\xcb\x18\x8f\x1a+zda\x895\xeeA~k\x17\x8bBF\xbe\xe6E	kZ,\xf6\xd3\xbd\x13\xca\xaa\xef\xeeP%\xe6)	=I\xfc\x14\x17M\x88\xe87\x1d\x1c\xd1I\x96B\x9a7\x86\xf5\x12\x0d\x18k\x90.\xcbc\xa0N\xe2\xca\xc8\xc6;\x0b]\x08\xb3\xd8YI\x0b\xb3h\xc5\"\xab\x95\x8b\xd4\x84FT\xab=\x8b\xeeA\xea\xc3\xd5\x0eV\x87\x0dI.\xdb\x9a\xb0\xa3\x16\xe9U\xfa8\x84,Y\xbf\xb4k\xc3\x87\x1f%l\xc3\xaf\xb1\xdf\xe6\x04^\x12\x1d4\x8c\xee\xbb\xb8g\x89\x9eN\xd5\x11\xf5=\x01\xbe\x9d&\x18\x0d\x1a\x8c\x9aX\xb7\x00\xb6Q@8Q\x8b\x02U|\xb6lH\xf5\xb8\"0ZP	\xdb\x0d\xfe\x01\xab\x90\x8c\xaa\xb9\xd2\xd8kx\x91\xec*\x1f\x1c\x8az\x07\xf7\x1b\xb4\xe7\xd7;\xb8#4I,\xcb\x16\xb4\xb6v\xc5\xba\x9bn\x92N\x90\x0d~;g\x91J\xba\x1a\xd8\x18\x88\xa6\x05\xcc5)\x164\x84MFa\xcaGel\xec*\xcf+\xee\xe7\xfa\xe4l\x87\x0c\x82\x0d6x}r\x16\xb7\xb73\xfd\xc6\xcel\xe8\xdf\xfc\xb5\xc3;\xd2,\x95\x13kM\xe6\xe4x\x00\x16m\xb0\xe4)H\x16\xb9*`7\xb0\x7f\xe3\x1e\xc9\xd8\x064\x0bE\xacl$\xdes\xff\xdc\xd9\x1aDU\x91\x14e\xdd\xa1|2sv\x88\xa2\x97\x1dOe\xe7\xf6\x8c5/\x04Y\x80\xc9\xde\xea4\xf8\x80P\x0f\xc2lh1:p\x10\x8dp!\xaeO\xb7\xf0\x9c\xe7?\xef\xba\xb9#\xe21\x8b\xde\xfa*\xcf\xca\x9a\x9e'\x8fUP.-\xb7\x12\x8d(\x1e\x11k\xd2\x99\xd0\xbd:\xfb\x7f\xbf\xa0&\x08\xe3\xd7\xfdi^\x90\x07G\xb6|\x84v\xde_\x1a\xbc\x14~I\n\xa7\x16\xe6	\x13\xf0e7\x95\xd4\xcd\xe7/\x87vX\xb0A\x94\x0f\x0bk\xa1\xf4\xa9s\x1dQ\xa7\x04\x80S\xe7\xac\xf3C\xf1\xc6C\xe9\x14\x90;\xf7 \xb9\x14\xaa\xdf\x1d#JG\xc1\xe8\"\x82=\xd3\xae\x85/\x98Lx\xbf\xb6N\xee\xce\x84&u\xedCa]\xe8P\xf0\xca\xe0\xd1\xdd\xa1\x03R\xee{p\x15\xbd{\xd0M\xdb\xd9^lO#\xf2\xedL\xdaZ\x0c\xf4&\xde/\x91\xee\xa6G\x95\x01\x11M\xee\x7fZV\xe1}#\x8c<*x\xe6\xf8\xc3\x8f\xe1\xc3\x8f\x06z\xf7k\x81F}|\xaf1\xef\xf2\xb7h\x14,\x94\x93J\xa3\x03)\x96J\xc3\xec\xe9Y\xca\x0d(n\xca\x83<y|\xf8\xf8^\xb8\xc7\x07\xe8\x1c\xf2\xa3\x8dp\x9aA\xd2\xfa\x8d\xd8\xc4\xbe\x0e\xc1\x1cQ\xceD\xb5\xe2C\xeb\x88>K\xd0xd	\x13\xbdc\xc8\xe3c\x0f	\x7f\xa9<y\xabdY\xd2{$5\x1d\x03\x1f\xc5\"J\xe3\xed\xa6\xc1\x9ei\xce\x94F\x08\x0c*\x0c\"2\xad\xa8\x03\xef\x03\x1a2\x1a\x0bA\xf4\x87fA>\xd1\x1ag\xf5\x04.P\xdc!`\xdd\x84\x0d\x91\xc0\x16\x07\xfd\xcd\xc6!\x99\x06\x84<\x89c\x8e\xb2x\xa8\x04\xe92\xd6}t\xa9\x06\x16\xda\xd2\x83\xde\xf4\x16\x10O*\x08\xd7\x03\xcf\x81\xe4\x8f\xdbD\xd9\x9d\xe8>I\xbb\x8f\xf2\x1f\xaar\x08)N\x82\x17\xb2g\xae\xad\x95\xe7&\xa0\xbb\x13\xc5q\x13\x94\xbc\xdc\x08N\x8fX\xd5\x08ke\xa4]\xd3\xd9z\xac\xac\x91\xbdxaL\x8c>\x1a\xed[k\\\xf3\xba\xc3\x05\x8a\xc1\xc9\x8d\x17\xf7\xaan\xeb\xe4hf5Ft#\x9c_\xf3y\xb1AH~r\xba\xde\xf9\x80\xd4\xa4\xff\x93\xf2*\x0f\xce\xba\n%\x05vz\x1a\x18\x04\x04\xdb\xd4\xb6-\x0e\x90\xe6\x92-\xe6\xd8\x87\xf6c\x08N5\x95\x95H\x82_FN\xdb{\x98\xd6\x85\xd6\xa0\xbf\x16aY.\xc3@\xd6\x90i\x0dvR\xa9\xcd;\xe1\xed\xa5\xb9\xe4B\x80&\xd5&\x9dXGG\xee\x9b\xd4\xf7-\xd4\xc2\xad\xdaf\x02\xc7\xad\xd2\xe1\x852yV\x8c\x02\xb5>\xd9\xe3d\x18x,\xc5\xd2Bh\x8dnsie\xafi\xdeD\x1800\x19\xe0\xc4\x8cl\x11\xd0\xd5\xf8\xcc\x97$\xb2UM\xa6V\x81\x91l\x91\xc1F\xd9:\x01\xa9\x1cV\xc1\xba\xec\x8f\x9f\xe4v\x89\x8c.\x8e\x87O$E\x03\x8d\x8c\xad\xbb\x9fs[\x8a f\xc2\x93\x97\x91L\\\xd2:p\xd8zw\xe8)Vt\xc8\xa3\xcb\xedY[\x9a\xd7ob3\x85\x9c\xb8\xb1\xdf\x92[*)\xb1\xe7\x887\xea{\xad^\xc1\xcb<\x93\x9a\x9d T\x10\xb0V\xdf\xeb\xc2\xbc_*\x89\xc7\xca\x18\x94\x1d\x8a/\x95D8I\x81\xba\xc8\xf3)\x84H\x1d$\x11\xe2\xc9r\x18tIBc\x86hv\x83:\x84z\x10f\xe5\xf9\x04!\xbe;\x14Z\x1f\x96\x88\xd9Z\xf1\xe4\xb1/P\x92\x99\xd4EWI\xfbs<\xb53Px2\xd4\x18\x04\xcf\x1a\xaey\x83U\xeb\xbc\xbaC\xddGW:\x18\xd3\x0eKE\xae\xe8\xdem\xa5\xf0.\x19\xf4\x14;#IH\xc3%\xc6\xa7\x02.c\xd2\x9b\xe1\xb2\xb7\xcb\xb6\x9e\xf5\x82\x8d7\x1b\x08f\xe8\xa9m\x896\x01\xdfL\x97v\xfd-\xcc\xda\x10\xac!a\xd4hQ\xa5\x10S7\xa9_\xc0:\xf5=IXM\x17\xa2\n\xbf\xe6\xd4\xd0K\xcb7<\x1a\xef\x03vhT\x7f\xcb\x17v\xa1L\x1a^\x0c\xa0'\xf2\xa5%-\x9f\x07~\xf8OO\xcaV\xe2f\x85\xa6W\xb4\xffrC\xca\xf7_\x13\x863\n\xe1~\xf8\x91G\",PK\xf5\xf8\x9e,\xdb\xbd:z\xb8\xdc\x05K\xcf\xbc\xdc9Q\xf0W$*O\xa3p3\x88\x14\xa7\"\xa1Ilbl\x9cZ<,\x1e|cmx{\x87\xce\xa9Bz\xc4\xa5\x9c\xb5\x01l\xee\xa3)\x07\xa3\xf39\x85Fa\xad\xfc\x928`I\x17\x9f\x16e\x1e\x14\xe0\xb1\x11\x14\x94\x02m+6\xafc\x18M\xc0\xeb\x93\xab1 \xc9r\x16\x11\xf3V\xeb\xb8\x84\x90\xd2\xa1\xf7\xb0D\x87;\xda\x96\xbc\xe62\xbe\x92\x06O\xe04\xc6\x01^\xc1\xf3\x1c\x19\x88tLj\\\xb4\x95 \xab\xe9\xf9\xd6^\x87\x01\xae\xb8\xc9\xbf%\xb8E/\x83\xc4\xa40q\xaf\xff\xc8h\x96\xaa\x076\xd5\xe3\x9f?\xfc@f\xdbS\x16\x95\x14f`Q)C\xfa\xe4\xac`\x937\x89\x0f3\xc7?\xfeY\xaf(\xa9a$\xd2=\xae\xd4>\xc6\x03\x8a\xb5E\xdbM\xd0`\x870\xb3\x9b6ha\xe4\xe3Ca\\\xa9\xefdo\xfb\x9e\xff\xfa\xe48\xadB\xf0\xa7\x0d\xd5?\x08\x0e\xa3\xf72\xe0W\x190.\x9b\x9d[u~\xe2'\x90\xc7\xf4\x96T\x14\xa4|\x1d\xa5\x7f\xa1\x07\xd1\x8c\xce\xab8QZ\x81\xc4\xc7\xf7\xea\xe3{r\xbb:j\xb42\xab\x9e\x94\xd3\xfb\xe0\xd0\x08M\x8ay\x95\xa5\xd1\x91\x94cpX\xdb;$;\x10\xa5\nl':\x1a\xf8\xcb_\xfe\xf2\xf0\x8bj)\xcc\xe1\x8b\xb0i\xe8!sL\xb5*5h-|e\xfbK\xb9\x8c\xcdd \xc4xP\x1c\x92-\xda\xed\xa9\xcc\x9d\xdd\xfc4\x93\x81\xf9U\x08\xcf\xcc\x96V*\xa6\x87\xb6\x08\xe3^r\x93\xf53v!\x98\x98X\xec#\xaf\xf4\xa3\xf3\xcaI\x98*\xc3i\xbdb&i\xa6\xb9r>\xc0\x9d\xf2j\xb0\xde\xfd\x97\xfcR\xba%#\x95K\x06\xee\x95W\xa9\xcb\xce\xa1mR\xc8m\xdb\x02\xa9\xc5\xfd\xf5\xc9\xd9T}_\xee\xe4\x9e\x83\x11\x14\x0b\xdd2)	D\xe8\xbaX\x05I\xc0\xcb\xe3d0r\xacU4\x14\x87\xa2\x8dF;\x9c\xc7\xc5\x89\xdbr\xb1\x16\xf77\xac\xe4z&\xa1\xf6f\xb0\x97\xab\xa1\x15;\x06\xc1\"a\xbdT\xd52\x89^\x0f\x9e\xfd\x13$\xd91k\xeb\x06;i\\J4;\x87\xcf^\xbe|9$`\xb0\xf3$\xa9:Z\x9f\xd8\xfd\xd6a\xc63\x18`\xe5$\xd9\x05\x9aEa\xd9]\x8a\xfb\x14\xae\xd2\xb1c\x88<\x02\x07w\xc5\xa3}\xe1&\xe4]\xb5\x86Oh\x90\xa5\xac\xc5\xfd;%\x8b\xf5\xe2f\xd6\x04\xdb\xcb\x1c\xb1\xe7'y\x03\x17\xed\xedo\xfa\x0d\xc4f\n\xe2\x90{\x14\x13&\xd1n\xca\xd9\xbe\xaa \x9bt\x1a\x8d#O\xb24\x02\"\xe2\xe9\xd2\xbaP\xb5\xe1\x9f\xb2@p\xaa\xf2\xb7vU\x18\xa6\x97\x11\x08\x81\xa1)\xbf\x8e\xc2\xa1\x8b \x0e\x8a\xe7\x9cN\x0c\x82\xc1\xb5\xb35\x86%\xb6\x1e\x12N\xca\xf2\x1c\xa6\xdf\x138\xcd\xcejr\x96\x98\xe1\xd0\xc8\xc6*S\x9a\x00}\xaa\xb9'\xa7\x07\x8d\x87\x80\xa1x\xee\xc7\xb1\x80.e\xf3\x98|\xf2\x1c\xa4d\xafJ*\xd6\xa0-\x99\x0d\x19\x1eC\xd4\xcaAm\x90\xf2\xac\x15\x8b\xfd\x9e6Sf=\xaf\xb6\xf2\x9dd}\x86@I\xb0`\xfb\x8cj1\x19\xd7]\xa8(\xef\xec\n\xd7]\x90(\xedm8\x81\x92\x89yp\x8c\x93$\xb59\x1a\xef\xc26\n\xc4\x87\x1f\n\x04\xf6\xa4m\xb4\xaaD@f\xf1\xfeD\xaf,\xc8\xdc\x95\x99y\x10b\x8d\xb0d_\xb3\xdf 4	\x90M\xf4\x1f\xf8\xc0d\x0eyxJ\xf7F\x01S.\x1eTU\x9cVl\xc6E\x98\x9f\xcb\x8a\x0f\xa1s\xae++\x1fr+B\x17PIy\x0f\n\xbf\x0fW\x98\xd3\x16T\xe1e_\n/\xa2m\x0b\xed\x86\xc2>\x1c\xfbI\xeb\x1e\xe9\xc7\x07\x13\x1e\x1f\xa8\xd4\xe2\xf1A\xcb\xc7\x07\xaa\xdf0R\xc0\x1dn\x04\xf8\x8d\xa9\xd6\"TK\x98\x89\x8f\xef\xb5(\xfa\x0b\\\x03c\xc7\xd85\xbd\xef\xd2\xc8\xff\xf8_\xaa\x96\n>\xfc\x87\xa0\xd5D\xb6\xdb3\xf8\x87\x04\x06\nT\xad\x1e\x1f( \xb5S\xe0a\xb5\xdc\xe1\x95\xb7Z>\xcd+\xd9&~\xbd'\xe1\x9bmiH\x9dp\xdbg~/E\xd3\x90.\xb0\xf3\xce\xa7\xb3\xe4\xd6\xf9-\x95\x9e\xd3\xc0\xa4s\xe9&\x92\x08C\xb9\x13\xf4\xb6k\x83\xc5\xdb}\x1b\x9b\xc9\xdf\xa7\xc6\xf0\xcdr`\x1c\xe2\xa4\xc8hl\x85'\xa1BoJ\xeb\x1c\x1c\xb7&8\xab\xfd\x04.[\x1f\xb2\x7f\xdf\xe7\x92\xad)#0\xddQ\xe5c\xb8~\xea\x9d\x113\x1f\xb7\xaexi\xf4\xec`\xc6\xa0\xed\x04\x11\x0d\xee\xc2\xf4}r0\x07\xd0\x94\x81\xefZU\xad\xc0\xb7\xbc\x03\x1a\x94j*\xe8\xd8\xa2\x17B\x1a\x89\xb5\xbf\xda\x8e9|Vn\xc0\xfa\xf0\xdaZ-\xed\xbago\xa6\xac\xca\xd0$\x0d\x15\xd53\xd4\\\xe7QF\xcaf\x18\xd6\xf9\xb1f\xd9\x16\xdf\xea\xf9u\xbc\xc7.g\xf1T\x18\x8biH\x9b\x1d\xba*\xd7\x83s\xf8[\\\x96.'!:\xe9\xf8\x8f\xf4Z\x88\xd4S\xa9\xc2;\x8e\x18\x0e\xe9dc9\x86\x12\xfb3k\x03\xc5\xb1x\xfd|\x9fc\x90\xad\xa3\xe7\x10m&\x01\x95\xb6\x1eS\x94\x91T\xca\x0c\x19\x17e\x8c(\xbf\xa5\x82\xef\x9e\xe4O\x9e\xe19\xfbD\xa7\x1c\x1c\xefH\x8b@\x8e\xda\xc0\x852+\xe0~\xdf\xa7\x7f\xd2\x00\xf2\x022\x11-\xf9L|6)\x98iVt\x95\x95V\xd5j\x02\xef(\xe7\x9a\xf4\xab\x1c\x83\x11wjA	M\",,\x87\x98R\xfd\xd7\x16\xa17\"l\xf9\xf8L^\xcf\xb7[\xa6\xd1\x13\xcf\x81\xa2\x8a5\x1fq\xf4\xc1)\xe2x~\xfd\x93G\x84\xee\xfc\xa4\x17\x1b\xb4*:R\xbfiA\x8a\xc0P\xe5\x06\xba\x17)\x17+r \x15\x94DC\xaa\x00\x1d\x18\x8at\x06\x1b\x8bQ\x1a;\x94\xaa\\\x01\xb7\x95\x89\xbb&X\x92}i\xa9\x18N\xeb\xe4a\x179\xa3\x18\xd7RHN\xc6e\x0e\x8e\xf1\xe8+.\xa7*N\x92\x90nW\xe7\xc5\x95\x92\x1d>\\*\x1b\xe7\xfb\xd7\xa2m\xb0\x0d\xb7\xb3\xcc\xa6\xed\x0el\xaa\xdb\x902\xad\xd9\xcd>\xb1\xe6y\x00\xe1W=anF\xa1\xe0n\xd2\x0d\xca\xc3c\xdd\"\xdc\xf6E\x9c9\xa0O\xd6\x1d\xd9I3\xea\xa7\"O\x8a\xf4F\x85\xdd\xf1\xff\xf3\xdf\xbb\xe7\xe4\x8c>\xff\xfd\xac\x08l\x90\x9d0\x94\xa77t_\xfb\xe5\xe9\xcd\xe9\xf46\x95~\x08\xf9O\x95\xa7D\x16\x05\x9c\x87T\x91'\x96\xcf+\x19\xa6\x03\x9e\x1e\xce\xdf}\x1e\x8c\xe4\xc9\xe7\xf1\xc4\xee\xfe\xce\xe7\x11\xeb\xbd\x8a0\xdfM\x06l\xab2\x87Z\x04\xaa\x0cU\x85\xa1:\x15\"\x1c\xfe\xbb\xa8;S\x84\x7f?>\xc0g\xe0\x85\x08\xf0\xe1/\xa6\xc2\x8d\xa4\xd0\xf3L\xed\xda\"\x0e\x1b\x14\xe5\x86\xb9\xb9\xbb2q\xcdqK\xc5\\\x03O\x91\xfcT\xb1\x81\x19\xf7\x94\x9er\xef\xae:\xac(\x1dO\xe7\x1e\xcf\x86\x9f\x1e\x87u\xd9\x10\xa4\xc21\xae\x1a\xf14\xd4\x1a\x83\x15\x99\xee\x04\xefc\x8a\xaf\xd3\x08~C\xb5\xf2$\xb7k\xd2\xbb5\x19\x0b\x0e\xc9Sd!\x98&\x0c6\xa877\x83x\xc17\xaf\xf1N4Z|\x0b^<\xbe\x87 \x9c\x98\x87\\\xb5\x97\xfa \xb4\x1f\xdf\xb7F\xc4!B\x07\x91cZ\x05jk\xc3\xd77\x17\xfd\xe1Q\xfc\x92\x00\xe3A3\xdb\xb7lu\xa7b\xc9\xael\x8eB\xa5\xde\xd6\xc9N\xcf\x05	\x8d\xb3\xe4=\xc6\xd2\xc1\xb5u\xabrCT9\xd4\xaf\xc9\xad\xb2H\x9a\xfb\xf7\xa4.	NesBge\xd9\xa1\xf4e\xe8g\xda\x97\xe4\xa4\xb2\x10\n%\x14\x87\x9b\xf5Ma\xba\xf3\xaer\x0e\xabS\xb8\x05\xd1^\xe8\x9e\xc9\xa6XQ\xa0\x87aI\xfe\x0b\x1d\xe2Q\xc4\xae\x9c\"c\x19\xd5\xb9k\xa9\xfc\x900\xc3\x0dR\xd5\x01\x1a\x8a\x0f\xc4\x9a\xef\xceR\xfc\xe2\xa7*=\xbdh+4\xa2/\xb5\x99\x12\xe0J\xe4*\x9b\xdcO\xe5^\xfb\xd3<\x9e\x8aB\xac\xd9\xaa\xc9=Jfo\xeaM\xd5\xb9	\xe9m\xb2\xddH\x8f@k\x82\xd2d}'[yF\x15 T\x95\x1b\xebUT\xc59[m9\xf9b\xdb\"\xf2\xe5\x1bKI\x84\xfe\xf2I\xb0C\x07\xcd	\x87\x9cp\xa4\xcb\xf9\xf4S:\x9d4\x04f\xdaV+.\xe8!\x91_ \x0eb>\x1f\x16\xdeN	\x04\xb7E\xe1mV\x1d\x1b\xdb:\xe0\x19\xb1.7\xf3\x17=pX\xa2\x18<\x11\xdf\xce\x0c\xee\xb1;\xa6\x0c/Dk\x9f\x04:\xea\x02g}/\xf3\xf3zi5\xc2\xe1\xe7_\xc0\xf9\xf5\xdd\x17\xcc\x1a\x87?\xe7\xc6\xcf\xa9\xde\xc1`\x18\xd4\xbd+#\xd5\x9d\x92\xad\xd0p~]\xe8\xd5P&\xbd\x0bg\xe9\xd66\x1c\x15\xe9\xd8YQA\xae'u\x18\xed\xb2\xb4\xcf\xd8\xd9e&'p\xd1\xd58\xa7\xea\xe6qJ\x90\xa4P\x84\xf0KJ\xfb\xc6\x82\x98\xcf\x07\xccI\x86\xcc\x04\x8e\x02\xd4tx\xbf(\xf8\x94.h\xa0\xcf\xf8\xae\xf7\xe83\x0e+\xc6\xd0U\xb9I\xaa\xdc+\xf8\xa4\xce\xe2\x8d~\x92S\xea\xb1\x8c%D\xa9\x19Y\xfaR\xdcw\x139('t\x92\xaa\xff\xab\x92\xf3\x84b_\xcd\xf9`uUx\xfb\xca\xfc\x9f\xad>(\xa2\x8e\xc6]YC}D\x1e`\xc2\xe2\x93\xc0I\xf9Qk:\x81\xb7\x8d\xd4_\xa3\xbb.ek\xfe\xf8\x82L\x84F,v\x95e^\xa1\x8f\x93X\xc3\\\x92y\x92J\xd4\xb6\"%a\xf0pw+\xe6c<F\xa2\xaf\x9cj\xc8~H\xef6\xa9\xa5\xad\xbcm\xe0d\xc14J\x8c\xee*8\xc5\x98\xe5H\xc6\x9b\xa4\n\xae\xca4vd\xc5\xa1\x85\xf85\xc3\x9e\xb0\x11\x0b\x06\xfe\xa7Z\x88\x91\xb0]Q\x94\x88\xeb\x85\xcd\x96\x95\xb7\x97\xbe\xbf\xd3\xc6#i\x7f\xfc\xa6;\x8f\xaf\xd4\xc7\xf7\xca\xa3\x06\xe1Vdld\xd3m\x07\xfe\xf8\x90\xb2d\xc56\x18\xd5\xdel\xcdQ\xc2\x06\xea\xc3\x0f*UWm=t\x0e\x80%.|k8\xcb\xd0ayG}\x1d\x07ZB\xd0t\xe2\xe6h\xa0\x9d\x84\xec>K \x03\x85&\xa2\xdc\x9d\xda\x11MuM\x7f[.\xfb\x1d\xce.\xffz*\x9bF\x0d\xab\xcd\xc8\"`e\xcd\xa1<\x8a\x94>>\xac\xc0\xea\x9a\x02r\xc3\x94\xe2z)\xc2\x91)m\xe8w\x1d\x84\x06\xc4\x11\x82FL\x16\x02\xf8\x07\xf8\xca!\x1a\xbf\xa4J\x08.\x1a\xed7\xb7\x11\xf4(\xbb\x9d\xfd66S\x04\x97\x1b\x03\xe3\xae8\x15\xebV\x83\"\x9a\xc7?\x7f|O&Z\x9bf\xdf\xf4\x89S\xaa\x15qc\xaa\x1aa\xf1M\xe9\xd3t\xdb$\xcc\x1f\x1f\xe6\x8f\x0f\x94\xb6\xddE\xbeU,\xfe\x0fZ\xe2\xd9\xc1\x9f\xc6\xcf\x0eF\xb6!k\xac\xfb\x96\x90\n\xc2G\xaf`\xb4\xb1\xf1\xbb\xbdQ<5\x02\xcd\xe9\xc3\x0b\x9b\x8e1vr\xe2\x85\xfa\xf2%\xd6\xb8A\xf0\x14\x96M\x17\xc6kx\xeb\x02\x9dRV\xda\xa3c6G\xf3m\x8d.D*\xe9.T\xc3(\x87\xb5\n\xd0\xcdVax\xac\xd2c\xabm\xf4\x8c\x0fm\x14DW6Bi\xed\xf4A\xc7h\x1aR\x8e7\x9e\xd6\x91\x8b\xcatt\xfa\xf8^S\x1a6\xb6\xbez|\xf0bcu<\xa2\x88\x0f\xeb\x86k\xce\xf3\x01\xcd\x84\x19\xe8\x83\xdf\xda\xb6\xa8\xdb\xe1\xc2B\x19c*\x87\x9f\xf8C\x8a\xea}R\xd4\xc4YRB\xe4\x1a9\x14\xde\x9aW\x99\xc7\xc8 \xa3\x04\x08\x97`\xb3\x96Dz\xbe\x9fx\xf2\xde\x95\x81O\xfc$\x0d%+\xd5\xc9\xe4]\xd1\xb4XG:\x8eF\xdd\xf95)\xc8OR\xaa;\x1d\x7f\xab2\xf1\xf1K\xd1\xf2#Q\xc6:J\x11_\xea\xcbV0\xd9f\xacW\xa0\x16F,\x90\xbf\xbb\x8c\x83%\x85\xc8(\xdf\x9d\xdad\xed1\xa4\xc9\xc2f$\xbcW\x0b\xc3\x16(\xf7p3\x9a\x9cq\xc0L0O\x1d'\xc1Im\x83n\xda`\xe5\x13\x15\xd8\x04\xdf[\\\xbf\xba>}3\x86\xeb\xab7c\xfe\x9e\x90>-==\xbe,\x8a\xe8\xa1N\xc2^\xaa:\xd5f\x92z\xfc\xd9\xcb\x97\xf7\x9f\xbd|9\xee\xba\xfb\x1cnL\xa2\xc2W\xc7\xbcWC\xb1\xcb\xd6\xc8I\xa2\x87wt\x9c\xb6\x93\xbe\x95\"P\x8a\xd1'\xf8R\x98\x05\x16\x99\x00\xfa\x04\xca,\xb0\x0b:\xa6a\x9a\xce\xa3\xa2\xe9\xf9g\x82\xa3 \x05=z\xcd?\"\xcc\x9a\xb9Z\xb4.\xc6\xc1\xb83C\x92\x9e\x1f\x0e\x9b\xf2\xf5\x0f\xc7E\x96H\x03\xe9\x19\x15\xc8\xb8Yb\xa2\xea:\xea9\x11\xc9\x81\x1eE\xeb\xae\x9bR\x86\xdb\xca\x01Y\x81\xe5\x01,!\xcb\x01)9\x96\xfb\xc9\x9eK\xdd\xad\xcbI\xa1\xd1I\xfe\x9d\n\x9c\x1b\xe5\xf8\xb1\x8dN\xd3\xcfA\x150\x11\x9ak\x7fSO[\xad\xdeF.;\xfb\xfa\xf5W\xf0\xf6\xec,v\xc4\x9c=Mx\x13\x7f\xf5E[\xa3\xf3\x93\xdc\xa2\xc0d\xe0\xbb>\xcf\xbfc\x0f\x7f\xce4U\xb5\"a\xf0\nF\xf1\xfb&\xf0\x11\x92Tg\xa2Ni\x8d\x0b.\x97\x1d\x9d\xc7\x9f\x83\xe2\x89\x11\x99N\x99o\xe8\xa2\xb4*>\x8f\xc9\xbc\xd5\x8dTf\xb1\xf3\x0e\xc4\x0e\xd7\xff5\xa6\xff\xd9\xff\x80\xe9\xb5]XNbSA\x1b\xfdJ4\xd1\xefX2N\x1d\xe4\xd1\x92u\x07\x12\xefT\x95B\x17\xdb\xc9\xbe\xd1U\xd9\xe6\x11\xbd\xa6\x19\xbdM?#|m\xael\x17\xf5%Y\x08\x92#\xa3\xf4i\x0f\xc5W\x8b\xe0/G\xa2i\xd4u\xc7LM\xeb\x16\x1d\xab]S\x839\xed\xb0`\xc7\xa8\xeeo\xad\xd5A54\xfbL\xe9\x80\xdd%\x92\x90\xf6\xed\x8c\xbe\xcd\x19\xa7OE]\x92}\x94P\x064\xf4\xf9+\xe7\x8e\x88\x07\xbdfwp\x02\xf9rnp\xd1\x12O\xe0}\xfe\x960I\xeb\x91\xc7pL\x1a\x81\x1d\xfe\xd1\x14C\xaa<O\x9b\xf1\x18.\xe2\x85\xe7\xeet\xffl\xb1\x14\xcf\xc2/\x05\xa5F^A\xfeU@\x8f\xd9\xdd\x1aM\xb9\x01\xb3T\x878J\x8c\x9a\x0cE\x9a\x9a~\xf6,\xcc\x9f,;\xdfe\x8e\xf1^\xf90\x81[\x1b\xc3?\xc8\xdd\xe9\x90\xe8\"\xd6b3\x86\x85\xe5C`\x99\xe9\xd0\xb7\xb3\xfc=&\xa9\x94\xc8\x94\xbf{\xe2\xb1\xfcn\x04\xd5\x12\xab\x15f!\x9b\xed\x02\xa2nj](.$\x0d\x88\xfeI'i\xb2\xbfR\xdem\xbc8B\xf1\x955m\x02nL\x95D\"\xc7\"-\xeb\x9e\x90j\xba\xb9\x9bk\xe5i\xcc\xd7F}\xd7r\x0d\x13\x0d\xa5\xa0zE\x1e\xd6!\x7f\xd7B\n\xb5\xb07R\xe0\xa1\x8f9\xb0\x93OX\xba\x00\xfd\x88\xcd\x15\x82tf\x0bU|1\x84\xfe\xf3\xac\xd6$\xfd\xf7\xb5\x99	3zv\xf0\xa7g\x7fz\xf6\xdf\x03\x00PK\x07\x08%?}_\xb4\x17\x00\x00\xd8A\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x004<N]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/uk_UA/common.jsonUT\x05\x00\x01T0\xcfj\x8cW[o\x1b\xc7\x15~\xa6~\xc5\xc1\x02\x82\x13\xc0P\xde\xfd\xa6\x0b\x1d\x13\x95%B\xa4c\x04M\x1fF\xbb#r\xab\xe5\xccv/f\x84\xc0\x00)\xc2n\x02\x18Q\xab\x18,\x90\xc0q\xd5\x97\xf6\x91\xd1\xa5b,\x91\xfa\x0bg\xfeQq\xe6\xc2Y\xaau\x91\x17i\xe7\x9b33\xe7\xf6\x9ds\xf8\xcdJ-8\x90Y\x8f\x15\xc1#\xf8f\xa5V\x0b\xf6\x99\x10<\n\x1eA\xb0\xb1\xbe\xb3S\xdf\x82\x8d/\xe1\xc1j\xfe\x00\x1e\xef\xee\xc1j\xae\xff}\x15\xac\xe6_\x05\xc1C\x92\x8fx\xc2\x0bs`\xab\xbe]o\xfb\x13f?\xee\xb1\x0e\xdf\xf2B\x8d\xa7\xeb\x9f\xd7\xe1\xe3\xa2\xadT\xc6	\xcf\xaa\xc2\xad\xe6nc\xbb\xbew_\\\xf0~S\xe6E\xde\x10\xedn\xc6\x99>\xb0\x1a\x81\xe0}H	\x87X@\xa1w\xd6\xcc\xfd\x1a]\x17Q\x83T\xcaw{qa\x15_\x8d\xcc\x89O\xf2O\x81\x89\x08V#\xd0\xba\xd0ZZ1\x7f\xc3G\x0e.\x0b\x96Y\x87G\xa4\x1e\xdd\xdf\xdcm\xb5\xa1\xf9l\xefso\xc2\x7f\xf91\xef\xb2H\xf67b\xe7\xfd\xd6\x93\xf5\xad\xdd\xe7\xb0\xd1\xf8-A0fn\xcb\xf0\xb0-;\x9d\xc4X\xd5~\xb2W_\xdf\xa2\x98-y\xb9\x14>\xc4\xcfv\x96\x83ln{\x11\xf3>\x8fZ\xac\xc7\x1bM\xa7~\x0bv\x1fC\xfbI\x1dZ\xebO\xeb\xd0h\xc2\xf3\xfa^\x1d\xbeh\xd4\x9f{\xed\x82\x95\xda\xcb\x876\xa1r\xca'\xbdJ\x932cI\xee\xf2+bG\xc1#\xf8\xfdJ\xadV\x0b\xf0\x12\xafp\xa6\xde\xe8g\xf5r\xa6\xc6\xc1J\xad\xf6\x07\x02\x82\xae,3/{\x81s\xbc\xc4)\xcep\x12<\xbc\x0fM\xfd\xa9^,\xca\x82/\xce\xa9Wx\x8eS\xbcY:Y\x05+'\xa5(\xba\xfe\xc1[5VCu\xa2^{\x05=TQ3\xe7\xa1\x14\xd1\xe2\x9c]\xd2\x96\xdb\xcb\xbd\xf0\x11g\xde&5Pc\xfc`E\xd5\x00\xe7\xf8\xc1Zb=\xa9\xb3\xd2y\x8eE\xbdXP<\xd6\xf5\x07\x9d\nXG\x12\xa2\x8eq\x8e\xb7jdA\xad\x0d\x81\x13\x07H}\x10\xff\x823\x9c\x93\x93\xf1\xd6\xec\x84R\x14\x19\x0b\x0bC	\x92\xd9\xb4\x08X\xa8B\xf2\x8d#\x9f\x13\x86\xd1\xc0\x92\x04\xf6\x8f\xa0\xd14r\xfc\xeb\xd4>\x8e\x7f\xc79^S\xd0\xd4\x00gj\xa4\x8eqZ\x95\xf1\x0f\xd6\xf5\x99\xa5\xe7\xbaqD\x01\x0c\xf0L\xbd\xc29\x9e\xe3\xc4\x1f7.p\xa6\xfe\x91\x89\xb8\x90\x99\xd6\xfc)\x8f#i\xe12/vd\x9fP\xf5\x1d\xce\xf1W\xb2\xdbl%2<4\xfc\xb0_6\xf4\x11\xcf\xd8\xe2&\xb72\x9b\x8e\xd9\x8f \xc0;\x1d\xa6;5R\xdfQ\xee\xfa{e_p\xa3\xc7\x86dY\x04\xbb\xb4\xb6\xd4\xe6|=I\xf4\xf1\xf7:\xc6\x13\xbc6&\x01\x9e\xab!^\xb9\n \xfb\xffK\xc8\xee\x9a\xba\xa8o9\xc3;m\xd4\x0d^\xa9\x81\xd9.4\xef[E\x1c\x1e\x12\xbf\x02S\x07 7\x80%\xbe\xb7}\xf1\xbd |5\xb8\x14\xe6EL\x8fdI'>\xc1S\x9c~\xea(\x9e\x1f\x89\xd0\xa6q\x80\xa7j\x8c\x97\x0f\xd4[\xbc$rz\x97\xe0{5\xc0\xa9\xc1\xd5H\xbd\xc5[\x9c\xab\xa1e\x13Y1\xc5\x99z\xa5\x1dJ)y\xedd>\xb6oR\xa1r\xbf\xce15\xfc?r+\x9avA\x11\xf7\xb8\xa3Q\xc8\x12.\xa2\n\x0b\xf1L\x8d\xd5\x9f)\x9a\xea\xc4r\x11\x7fR\xdfkN]\xd8\xb7j\x01\xfe\x95\x9c\x8dWx]\x95\xfb\x11\xcf\xd5X\x1dW\xa1\x7f\xa8\x01N\xf0\xbc\n\xfdS\x1f]\x82~\xc2)\xdeU\x813-\xb3\x04\x9dj\xe8J\x0d\xab\xe0\x98\xbc\xa0\x8e\xef\xa9\x8bS5\xd4\n\xdf\xe1\x04/}\x85\xfcA\x0d\xd4\xc8\x96\xd9\x13_\x85\xfa\x9c\x1fz\xfb\xdf\xe1\xa5\x93\x7f\x8f3\xf7y\xaa\x8e\xdd\xe7\x99\xcd22\xc5\xa3\xef+\x02\xf8K\xb5l\x95\xb1s\xf6\xbe,\n\xd9\xa3\xfc\xc1\xb7>p!\x13!Ol*\x7f\xc0\x89\x1a\xaa\xd12\xcbCV\xb0Dv\xb4\xc8\x8f\x9a+\x13\xbc\xc19^\xd8\xed$\xa6V\xb7\xb9\xb8g\x93\x00($\xd8\xab\xabU\x8b\xe8X)Y\xfbzm\x04\xa4\xa0\xb4\x08\xf05\xde\x12\xa5\xd4@\x1d\x83\x1a\xa9!\xde\xa9\xb1\xfa\x16g8\xc5_\xd7\x00\xff\x86\x13\x1d\xd4k0^\xd6u{F\x9a\x03\xde-\xd2\x82\x82>\xc1\x19\x95]\xfc7N\xd5\xb1zC\xcd\xc2\x0e\x1f\x07q\xc2\xdbRn\xb3\xac\xa3\xdf\xa45\x14RB\xa2\x11+#\xe2\xbck+\xcd\x0f8\xd7\x11=\xc7\xb9\xbd\xa2#e'\xe1-)\x9c_n\xa8}\x107\xe8-\xc0K\xbcQ'\x80\x17j\x84\x17x\xa3\xde\x806\x83\xb2g\xecf\xb1Tf\xc5\xa6\xcc\xb22\xd5\x83IC\x03p\xc0\xe2\x84Gk\xf0\x98t\n\xedv\xe5\xc4\x96\xf5\x93\x15\xcf\xcb0\xe4y~P&k\xd0\xeerHY\x87C?N\x12\x10\xb2\x0f\x19O\xe4b\xe4\x8a\xc5\x0b\x96\xc4\xd1&K\x8b\xb0\xcb\xf4\x1d\x06\x81\xd0B\xfa\x99C\xceS\xddQb\xd1y,\x89\x9a\xc1\xef8O!\xb2\x18\x1c\xb8Z\x9c\xb0\\k\x8e?\xebP\x10\xcb\x17\xe6Qy\xf3\xa3\xa0\xad\x80f4\x02\xda\xab6\x80\xb6\xdc\xf0\xa9\xa9\xeb\x14\x9e?P':\x8c3\x9c\x933\xf5\x1f7m\x04=\xea._\xc4y,\x85\x1b\xebt\xc3\x81\x17\x1a\xb3m\xa4\xcc\x8b\xa7\xac\x08\xbb\xb6\xd8S\xda\xcc)L\x80\xb78\xa1\x9a\xa2\xde\x80I/<w\\\xf5\xbdM\xf0\xbe\xd7\x1f\xdf\x11\xd3)\x03A\x1d\xeb\x14\xbbtCh,\x8a\xb6\xdc\xf4\x14i\x12B\xd9\xef0?\xac\xda\xb6JS\x1c-?3\xeb\xcf\xda\xedm#\xf4\xa7R\xda!\x18O\x89\x88\x80\xbf\xa8\x11Q\x8d\x92g\x80s\xf5\x9a2\xf9~\xe5\xcd8\xcb\xcdH\xb1g\xbe,\x1a\xc5\x19\x0f\x8b\x8d#\xd3F\xf6\xec\xfa\xfe\x94\xe0\xe5\xbc\xb5\xf7eM\xd8\xdc\xbd\x07\x19\xcf\x8dO\x7f&%h\xa2\xf3^\xcbx\x9a\xe8\x9eg\x1a\x91\xee\x8dT\x95\x87K\"2\xd31\xb3\\\xbert_\xbe\xa8(3aCG\x8f\\\xf9\xd9\x85\x98l\xb5)\x13\x9e/\xd2fb\x07I\x9b%9g\xd9\"\xfas\xf5\xad\x1a\xd9\xf9.\xc8yNyR\xff:\x8d\xed\x8f\x9bm\xd9\x89\x05X\x1c\xb8\xddX\x8c\x02;\xb2\x88C\xee\xae\"\x8b('\xf1\x96\xba\xbe\xcez\xabO^\xee\xf7bc\xdb;*\xfed9\xdeT\x8b\xa9\xffu\xc0\xa3\xad\x8c\x1dh\xe1\xb6\xe7\x05Q\xffKYf\x10\xd1&tY\x0e\xfb\x9c\x0b8\xe4iay\\t\xcb\xde\xbe`q\x12\xdb\xe2C\x94\xb9\"\xca|o3c\xa6N\xd6\x9c\xb0L\xe9\x05\xfc\x17N\xf0\x8e\xaa\xa4\x85\x8fR{\xda~\xd9\xa9\xc4U=G+\x1a4\x0c_\xdeR\x9f\x03\x9c\xe9\xae;\xc1\x0fj\x8c3\xd3\xa95-t\x0c\x87\xb6\x0b\x05\xa5\xe8\x13\xf3|J=3\xc0R*\x95)\x15'\xaasZB\xaf\x80J\xb1\xbdC\x03\xcdLv2\x9e\x9b \xdf\xaf\xeb\xd6\xf7k\xceX\xfa\xb1d\xcdr\x9f\x1a\xbf\xa7\xcc\xf3\xaa*+\xb5\x97+/\xff3\x00PK\x07\x08\x88\x10\x0cV\xd6\x06\x00\x00{\x0f\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x0b>N]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/uk_UA/server.jsonUT\x05\x00\x01\xc63\xcfj\xbc|oo\x1c\xb7\xd5\xefk\xfbS\x10{\x11\xf8\xde`\xbdr\xfe\xa0\xe8\xcd\x8b\x02\x96e%j-[\xb5\x9c\x1aE\x9a\x02\xdc\x19\xee.\xabYr3\x9c\xd1j[\x14\xb0\xad&q\x01#.\xd2\\\x19H\xdb\xa4\xb9\xb8\xc0}^\xaee\xc9VdK\xfe\n\x9co\xf4\xe0w\xc8\x99\xe1\xec\xae\\\xf7y\x9a\xbe\xb15\xfc{xH\x9e?\xbfs\xb8\xbf;\x7f\xae\xd5\xd3\xe9\xd0\xb4\xdec\xbf;\x7f\xee\\k\xf5\xf2\xcf[\xef\xb1\x8f\xce\x9f;w\xaee?\xb3'\xc5\x1f\xeciq\xc7\xbe\xb0\xd3\xe2\xb3b\xcf~oO\xec\x11\xb3\x8f\xeds{j\x8fYq\xcf\x1e\xda\xe3\xe2nq\xaf\xd8m\xb5]\xa7Gvj_\xda\xa3\xe2.\x1a\x1e\xd8\xe7\xc5C\xb4\x9f\xda\x13{b\x0f\x8b;\xc5.+\xee\xfa\x06\xc7\xc5.\xb3\xff\xcf\xfe\xc9~\x8d\x91\xa6\xac\xd8;k\xc2\x17\xf6\xd4\x1e\xd8\xa9}^<@A\xf1)\xb3\xfb\xc5\x9e=\xb6\xa7\xf6\xa4u\xfe\xdc\xb9\x8f1y\xeb\xfa\xe6\xea\xed\x8a\xfa\xeb:c\x9b\xbc'\xd8\xaaN\xd9m\x9dn\xb5\xd0\xe6\\kY\xf34f<I\xf4\xd8\xb0!\xcfD*y\xd2f\xd9\x80g\x8c\xa7\x82)\x9d1\x83~\x99f]\xc1\xb6\xa5\x18\x8b\x98I\xc58\x1b\xebt\x8b	\xb5-S\xad\x86Be\xf5\xcc<\x19\xf3\x89\xb9\xa6\xa3\xad\x9a{`\xc4\xbe}j\x0f\xc0\x88\x97\xc5\x1d{d\xf7/\x14\x0f\xed\xb3b\xd7\xee\xdbiq\x0f\xe5\x07\xf6\x94\xd9\x03{b\xa7\x9e<\xfb\xb5=\xb5\xcfQ\xb5o\x8f\xeds;\xb5\x07\xf6\xd8N\x19\xb8g\xa7\xf6\x18\xdd\xec>:\xb4_k\xd4\x9aD\xa5\xd5d(\x8d\xa8)\xfc\x93=\x01\x03\x8b=\xfb\xa2\xd8\x0b\xe9*i\xf9\x16\xbbl\xa7M\x92\xf7\x8b\xbb\xc5\x1ev\xe0\xa5=-\xee\xe2\x08\x14w\x8a=\xbb\xcf\x8a\x87\xf6\x98\xd9ic\xd0\xfd\x80\x804\x1a\xc8mqk\x90\n\x1e\x9b\x8a\x8a\xcb\xae\x98e\xbe\xbc\xdd(\x1d\xa5\xb9\x12qY\xc9\xa42\x99\xe01\xd3=\x16\x8bDdR\xf5Y6\x10\xc3\x0e\xf3\xc3\xbab\x11\xb3\xee\x84\x0du,R\x9e\xe9\xd4\xd0\xc6\x9aL&IY\xdf	\xe8\xcac\xa9\x7f\xa1\x93|X\xb3\xe62\xca\xd8\xb6+t\x14\xb9\x16\x98\x99:\xe0D\x0cs##\xc6U\xcc\xb6e,4\x1b%|\"R\x13\x0e\x9d\xe9\xbe\xecU\xc3\x82\xe3`\x8c=\xc5	\xb0'\xc5\x9e\xdb\xc3C\x9c\x8c/\xd8\xfbk\xab`x\xab\xddh\x1c\xf2~A\xe3j\xb2n\x7f=\xcf\xea%\xe0\x83-\xf3h\xab\x9f\xea\\\xc5\xec\x17 \xd1\x0fMu\xd9@\xb0n]OK\x08Gs\x1d\xca\xe1\xce\x18\xe9r<\x94\xea\xa2\x19\x89H\xf6\xa4\x88\xd9P\x0c\x85a#9\x1213Y\xcae\x7f\x901\xa92\xcd&:\xbf\x90\n6\xd4Jf:\x0d&\xc2}\xbc\xba3\x92\xe9\xa4\x9e\x0ceLP!\xcbd\xb5\x07\xd7\xf3aW\xa4\xd8\x83\x98O\x0c\x1b\xcbl\xa0\xf3\x8c)1f#m2\xc3\xba\xa2\xa7S\xc18\xa3Q\x99\xac\xce\xc3\xcc|\xd7y\xb0\xd9\xf6o8\xe2\xd8\x11H*\x88\xbb\x83\xfa\n\x04u8\xd7\xfb\xf6\xb4xD\x17\xac\xb8o\x8f\xedQ\x87\xd9\xbf\xdaS\xfb\xd4\x1e2\xbbO2	\x92\xeb\x8f\xe1\xf5~\xeb\x12C\xa1}n\x0f\xedI\xf1\x00\xbdp{\x9e\xbb6\xf6\xa4\xb8\x1b\x14\x16{\xeeB\xcd\xd0{KfI@0D\xcb\x13\x92\x138H\x90\xc45E\xad\xf6\x99m\x16-\x00\xcb\x9azA\xe2\xe5\x0d\xbbP\x9d\xd3C'\x98\xed_\xe9Z?\xb1\xd3\x0b\x1df\xff\x86\xc5>.\xf6 \x8d\x8b\xfb\xf6\x90\xbdu\xe9\x12\x84\xfa\x91}\x81\xe1\xed\xf3\xe6\xbd\xef\xea8\xdc\xd9x\xe2)\xbc%v2\x86J\xec'\x0e#\xb6\xb0^w\xc4GY4\xe0UO\xfb5\xd1\xf9y-)\xbf\xb5G\xa0\xce\xdd\x8cc\xda\xb8#\x08$\x7fa>'\xa1\xe4\x96\xc6\xe8\xde<)\xf6\xa8\xfe\x8e\xd3f\xc5#\xafv \xc4\xb0\xb1hsB\xb7\xb2\xb8W|J\x9f\x07\xc5\xee\x1cI\xb7x\xbf\x16_W\x1c\x99,C\xa1_\x18\xef\x1bh\x0f\xa3\xf34\x12\xac\xa7S\xe6\x17\xc3\x8cN\xf2Lje:\xec\x86\x12\xe8\x843\x9ar\x15\xeba2a\xd1@\x1b\xa1\xa8\x87\xe0\xd1\x80\xf5\x85\x82\x04\x13q9@\x87\xdd\x14\x9f\xe42\x85H\xd4,\xd2*\xe3\xd0L\x19K\x047\x19{\x87\xc5\xb2\xd7\x13\xa9P\x19\xc66\x81\x94\x8b\x06<\xdd\x8ctZ\x9f\xa2+\x03\x9e\xf2(\x13)3#>d\x86*\xfd\xadV\x99\xa4\xc2\xb1\xa0\x0b\xac{\x10\xa6\xb27\x81\xbc\xe5,\xaazbr\xda\xb7\x0e\xbb\xdc\xc3Pb'\x12\"\xf6b\x99%r(3\xfa+7\"ec\x08`%\x1c\xf1F'\xdb\xb8\xa9\xe5\xca\x02R\xf5p\x94\nc\xa4V\xd7\xc4\xb6Hj\x8a\xeb\n\x96P\x8d#wE\xf4\x12\x9e	\x16tt\xf5 |,\xbaFG[\"cCa\x0c\xef\x0bC\xfc\x8d\x12)Tf\x98\xc9G#\x9d\x92\"\x19\x89\xd47\xb9\x18\xbb\x11;\xec\xe2[\xd8 \xac \x16=\x9e'\xe0\xf4\xb6H\xda\xec\x12\x8b\xa5\xe1\xddD\x98p\xda6\xbb\xf86\xd6j\x98V\xc9\x84}\x90\xf7zC\xaeX\xa4\x89%\xd0\x14o\xe1d\xfco\x96\xa5<\x16\xcc\x8c\xc0\x0c\x90c\xe4oE\x83\x05\xa9\xb9\x92\x8aX\xa8L\xf2$8m7nn\xb2(\xa8\xf0\xfb\x05k\x86E\xa96\xe6\xa2Ne_*vyc\x8d\xa5\xe2\x93\\@,\x82\xddB\xc5,\xd2zK\n\xd3ak}\xa5S\x11\xb7\x99\xec\xb17\xb1B\xae\x9cI$b\xe6\x06\x98!\xe6\x06\x15\xce\x10\xa2}!Z\x9dk\xf9&\xd58\x99fC\xbe%\x9ad\x85$-\xf1\x91\\j3\x93G\x03\xc6\x0d\x1bd\xd9\xc8\xbc\xb7\xb4$v\xf8p\x94\x88N\xa4\x87\x1d\xf6fi\xa9\xf1$)\xe7\x0bI\xcbM\xa6\x87W67+\xc2<1\x1f\x1a\x91b\x17%\xac\x87+\x9b\x9b,\xcd\xb1U\x89\xe61\x96\xa8X\xa6G\xa5\xdc1\"\x11\x11.Y6\x10C\xb1`\xf0[\xba\xdf\x0f\xc4\xef\x15\x9a\x14\xa3\xfa\xc9\\=\xf6\x1dCFuu5\x94?<!\xa1\xf6+{\x08\xeb\xda>/\xeeA\x06C\x16\x1dBQ\xf8A\xed\xffu\x9f\xac\xf8#\xac\xb9gdM\xc2f\xb8g\xa7\xf6i\xb1[|U\xdc+\x1e\x14wa_\xbfD\x8b\x83z\xbcb\xb7\xcd\xecqeH.\x94\x8e\xde\xa6\x84\xda:&\x1d\x07	\x7f\n9\x0eQ\xf8Eg\x8e\xf8k\\\xf5\xcf\xa6\xbe4jJ\xea\xa1\x15\xa15\x1d\xf5P\x8c\x07\xf6\xd4>\x86\xec\xb5O\xed\xf4\xd5\xd4/\x98\xdbd\xd7\xab\xc9W\xfcUL\xc5\x08BS\xe7*\x9b3\x10 \x10\x8ck\"\x85af\xa0\xc7\x8a\xf6\x9d\xecD6\"A\xd0\x9d\x94\xd7\xba\xc3.\xe1\xc0\xa2\x19\x8eZc\xf5\x06\x1b\x9a\xf2~}\x02V\x84\xc97}\x99_\xef\xb7P\xa8\xc5.\xd4\xec\xb3`\xa1\xa4\xf2\xdd\x06\x05\x03ut\xda\x0ffp\x82\x84l\xcez\nW\xe8\x8cM?\xc9M\xf1\x1b\x11e\xde\xfe\xec\xc9D\xd4&\x10\xf7&\xa8\xc9R\xc1\x87sc\xbf/\xf4\xda\xc6\xdc\xd8\xae\xd4\xcbPM\x9eO\xa2\xf5\x16\xcbG\xb8\n\x8e\xb1)\xb8\xa7{$\xe4Ej:\x8cl3g{\xb1^\x02U'\x14F\x8b\x9dx'\x0e*\xed\xaaB6\xba\xf5l\xac\xac\xce\x91\x81\xb2\xc6\x027VV\xdd\xf2\xe6\xba\xdf\xd4]\x9d\xd5Rh#\x15\xdb\xd0uQ\xca\xc7	,\xefv\xa3\x98\xe7\x99\x86\x87\x17\x93\x12\x90\x99\xa8\x1a\xd6R\xc7\x08\xf8%L\xa8\xbeT\x82I\x15\x8b\x1d\xaa\xef\xa5z\xc8x\x14A\xae\x936\x93\xc6\x19\x95\xf3k\x82\xacq\\\x99\xdb=\xa5\xd5E\x0e\x13\xd9\x1b\xa4Q*8\xf4\x7f\x93P\xc3\xb8\x9a`20\x9c\xf1\x11O37?v\xe1\x02\xf5\xbfPUS\x85\x1bG\xf5\xc9\xf4\xa5\xa1C^iU\x1fU\xb2\x15\x8f\xc9\xb3\xfe\x9c\xec\xa5\xa3\xcaqk\xb5\xc3\x16w\xcaZwW\xab\x02\xfb}\xdd\xa1\x9a\x82\xcc\x82.Oj\xdd\xfc?~\x8c\xcf\xb2\xf7K\xdc~\xfcU\xec\x95\xb3|\xe7\x9d~\xb2VgZ\xd9C\xcc\xe2\xc0\x02?P\xf1\xa9=,\xee\x93\xe4\x82P\xb4'\xf6 \xb4\xb5_8S\xae\xa4\x19n4\xac\xd0\x85\x03Cb\xb2\xb7/\xa1\xfa\x19d\x15\xb1c\xdf\x89\xd4'\x00\x15\xeci\xb0\xa9b\xc8er5M\xab\x95]E\x01\xbb\x9a\xa6:5M\x81OM\xb1\x0b\x82*\x17\x0c\xb2\xcee\xcd\"\x1a\xc8\xb3\x83\x86s\x03,\xe8\xb6\xc1Mp\xc8\xb91c\x9d\xc6\xf3=\xd9\xc8W-\x1aB\xa7Y5\x04\xcd\xcc\x8cH\xb7E\xca`\xee,\x18+\xa8]0\xdcf\xde]8\xda\xd9\x03\x99\xbc\x1b\xeb!oX\x12bg `\xb2\xd6C\x95\x05\xaf-E\xcb!\x9a\"T\xec\x8c\xb8\x8a/\x07\xe7\xd1\xfe\xdd\x9e\xdag\xf6	)\xbe\x93b\xd7y	_\x02\xbb`\xf6QSB\xdb\x93\xe2a\xab\xfd\x8an\x04y\xcc	vt\xeb\x00\xc0\x9a\xda\xef	\xb2	\xb0\xab{\xc5.\xbb-\xba\xc36	\xb3b\x8f\xado\xbc\x03W\xeb\x10\x9eH5\x03Nf\xf1E\xa9	;\x0b	c\x00\xc9\x9c\xe7v\x14\xa2.\xd3\xe2S:\xd9tC\x9e2\xfb\xb8\xd8\x85\xb7\x82\xc1\xe6\xe7\xc0E\xb9[<ll\xc5(<!\xf6\xff\xd8c\xc2\xe5\x88\xaaV{Aa\x88C\x9c\xd8\xa9\xf7\xa4N\xed\xf7\xf0:\x1d\xf8S\xfc\xc1\xf1\xa2\x9e\xa6'D\x0c\x90\x81\xceL\xbd9\x8f\xbc\xbfu\n\xdb\xc7\xdb\x00\xc5},\xa7\x9c\xfa\xdb\xb2\xe0L\xe3\xc5c\x8b\xcf\x1aC=\xb0\xa7`-\xf6j\x1fX\x1b0\xc6\x80\x1a\x99\x88[\x93\x91\xa8/\xd7\xaaL\x04\xcb\xa8(0\xa5a\x94\xa3B\xecdB\xc1\xdd\x08\x14\xc6oF}\xa6Sh\x94a\x87]\x13|\x1br`\x94M`>\x90\xad\x8a\x7fK\xb7\x02F%\x06\xf7j\x93%\x12\xe6x\xc4a\x8a\x90\xe9\x82\xc3\xafX?\xd1\x90\x9e\x93\xdav\xa6N\x01\xe1I\xc3\xe7\x84\x9eH'N\xc7z\xbaW\xa4\x01\xfc\xe4\xf54\x8b\xc2&\xb0{P\x1e\xe8\x88^\xa2u\xbc\xa62\x91n\xf3z_VQ\n\xb8\xc6\x15{a'\x87\x82\x8d\xa5\x8a\xf5\x18\xfe\x9e\x11\x91V\xb1s\xa2\xa0\x9fh$\x1a\xde\xb9{3sl\xd0\xbc\xcd	\x82\xc6\x1e\x8f\xe2;r\x98\x0f\x99\xaa\xcc74\x01\xdd\x82\xadm\x10\xbf\xc8\x95\x00\xe0#U0mI\xaa7\xdf\xbcB\x0e\x19\xa7\xd3H\xc4\x97\x95V\x15\x0d\x80t\x08\xadp`\xf2)\x0bqP\x14x\xa2\xec\x97\x84h\x1c\xc1<.\xbe\x028\xfd\xa2x\xb8\xf04\x02hh\x033\x00\x0e\xfa\x12-\x80 y\xe8\x04F\xfc\xa1\x17\x10\xe5\x99\xf5\xfa\x14w\xb8Aj\x96+a6x6\xa8\x19\xe6\x0b\xc9\x1e\xf2\x84\x11p\x82o\xe2\x071\xa9\xe7\x9a\xc1\x85e	\x0c\x998\xe5c\x07$|\xe4\xeb>fC\x9en\xe5\xa3\x0e[\xcee\x92]\x94\xaa\xec\xe5\xe0\xd1\xdcx\x7f\x10f\xa0\x11\xa1\x12\xe8\xf3$\x11\xe9d]\xc7\xb5]\xf1\xbe+cT\xe8\x1d@\x1ci\xb2\xff\xdc\xeeyx\x02\nR\x0eao\x07#\xc2\xf2l,\x94lQ\x16\xcbTD\x99NK\x8ch\xa5\xfc\x0e\x07\xc3\xf6S\xf3N\x0c\xcc^\xc5\xeek\xfbG\xf4\x1d\xf3\x8cw\xb9\x81\x97\xeb\xfd\x04\x02c\x96r\x93.\x99\x01O\xc5\x12\xb5\x0e\x97\xa7u\xe8\xde\xd9?\x17\xbb\xf6\x89=\xc4\xf9\xf0d\xbc\xda\xba\x87\x80~R\xf5\xd9\xab\x07\x1e\xc88\x16\xc1\xc1\xfb\xae\xf8\xb4D~\xed\xe9{\xecR9\xfa7d\x9a\xd5\xe6Lq\xb7nX\xec\xd5\xc7\xe5\xa81\xb4X\x96J\x89\xb8\x1a\xfe\x03\x19\x0b\xb6\xe2\xe1ow\xed\xdc\xf8T\x01\xa1\xe4\xb6\x85\"\x1d\x03\xc8\xad\xae\x10j\x1e \x1d\xc8X4\")\xd4\x1f%\x90#K<I\x96\xc2\x81\x9d\xf5	\xb8\xa9/bX\xd4U\x00\x05\xb8\x06\x85L*[\x96:\xb3\xa1\xc88\xf5j\xceySDyj\xe4\xb6Hj\xb8\xb0*#\xda\xd9@\x02GY\xb8,\x1f\xc1q\xfe\x1e\x01L\x1c\xcdc\xa1\xa8\x9e\xa5\xe5H\xc9\xa49\xed\xadA>\xec\xd6\xb2\xd5~K\x86\xaf\xe7\xff,\xea\xfe\xca\xc0\xc81\xb6\x15\xf7\xbf\xd8e\x1fm\x0e\xf4\xf8c\xa8\xa1ii\xab\x16\x0fB\xfc> B\xa7\xf2\xb7\xb8*	6M\x86N\xf6\x9f\x9d\xac!\xc3\xe3\x04\xe2\xa4\x0c\x80\x05F\xb9=\xb2'\xf6I@XY\xe4\xe31\x80\x16\xde\xf9q4\xe0\xaa\x9eR\xd6\xa7\xe6\x9a\xee\xcb\xd2\x1d	\x1a\xe0\xbe~\xa0a\xe0-2\xa8\x88\x14\xb2\x7f\x16x\xbb~\xb0\xc5\xb6\x8c7L\xf0\x7fqo\x06\x06\x00\xe3a#\x01\xe88\xb4\x07\x15\x18k_\xd8\xa3\x00\xacf\xb5\x9a\x0f.1Q|\x8dtJI\xf1\x1a\x8a\xfe\x81\x9e\xa1n\x88+1\xee\x83LP%8\xb9J\xbb\xae\xb3s\xdc\xd4:\xbb\xb1-\xd2T\x06\xd2\xd0M\x95j\x9d1]\xd6\xa1\xcb\xb9\xd6Z\x0f\x91\x0f6\x96f\x80C9\x80\xea\xf3\x93\xd2\xb5\xe0\xcc\x88\x11\x07\xc8\xcb\x12\x1d\x91s\xc8\x12\xb9\x05d\xf4\xca\xca\xf56\x13\xd0p$\xf2zy\x92\xb8)x\x1c\xa7\xc2\x186\x10\xa9\x98\xb3A\x80B\x85x\xa5o\xdcaW\x1d\xae\xf6\x1e\xbbP\"m\x8e\x8e\xceP\xf4\xf3\x88\xc3\xa0\xbe0\xb3\xd6&`\xec\x16\xf9:`1n\x1c\xc4>Wn\xad\xffJtX\x0e\x9b\x86\xebg\xf6\xc5\xac\xd9\x1a\x14\xbd\xdah\xb5\xcf*\x9354\x13\xa5\x82\x0e]\x0d\x8e\x92\xf3&\x8a\xfb\xf6\xa8\xb8\xf3Z\x1e\xc3\x02W\x01^\x80}\x06i@!l\x88\x0c\x7f\x1d  \xee\xa0\x0e\xbe\xe8\xa1}\n9\xe2C\xc3MC\xfb\xd4\x1e\x87\x9c\xf8$\xae\x9d\xb2\xb5\x9f\xaf,\xbf\x96\xb6\x02g\x18\xba6=\xa8\xdfp\x8a\xcb\xd5\x82\xf0\xa7eA;\xfc\xac`\x88\xb5\x15\xd3ae\x9b\xda\xa2u\xda\x84\x0e@\xe8\x0f'\x0d\xec\xd0c\x83%\xb9\x8f\x1a<q@\"\xd2\x04\x80YP0\xac\xf8\x03,\xa8\xe2n\xb8G\x89T[\x81\xd4\x86\xb0AT\xfc\xa4\xd8+\xeeW:\xd3\x1eQD\xab\xe1\xe0}\xe5S	\xeax\xb6=\xf2\xb9\x05\x0f\xa9\x00r	;\x03\x114%\xc5^5\xc5\xae\xee\xbb9\xec\xc9\xc2Y\xd8O~\xf2\x93\xa5w!m\x97jZ\x87\xdcD\xba>L\xeb\xee\xd3\x1bs\x0e;vM`\xc0\xc2\x8a\x9f\xedJ7\xaf\xea\xef{Ray\xe3\xb9\xa1\x8b\xe4g\n\xbagy\x10\xf2Y\xa7O\xb2\xa5D\x85\x99\xae)d\x80\xd4Q\x1a\xfcQ\xe1e\xd0KRQ\xa2D\xd0\x13\x86@O\xa6&c\xdb\xd2\xc8\xc6|;\x1f\x90\x14\xa8\xa6\xb4\x7fq\xe9\x05\xc5= \xcd\x04\x00U\xbc,\xbeh\xde\x10O\x10E\x1b\x8f}@\xb1Ty\xd3\xb0\xfbt\xf1\x05\xab]\xc2\x06VN\xc3\x93\xef<\xd3\xa9x\xd0\xa0|ceuS\xfe6\xe4\xd6\x0ey\xef\x88\xcd\xcc8*(\x82\xfa\xa8\x90Jh\x90\xf5e\xef\x86P\xec\x87\x8f\x00N\x83\x99\xce\xbb\xa3v\xae\xe3\xac^\x19\xf2\x9d\x9bd\xb7\xd4\x07\x1a\xdf\x93\x86\xee\xba\xde\xf4\x8d\xda\x8c\x93H\x1d\x0fd4\xa8\xf2#\x0c\xc2\x1a]\x01\xd9\xdb\xcd\x87#Qi\xb3P#\xe8\x1e\xb0\xb2KM\x02\x1a+\x0f6-\x14Q\xc5\xee\"\x16\xbeb\xd3`\xa7\xcc\xca\xb8\x99\x1d\xa0\xf0\xc4\x0b{HH\xdcc\xc8b\xeco\xf1)\xfb\x9f\xeb\xcb\xff\xabA!\xe5#\\\x13\xaa\x1fx\x0d\xeb|\xc7\x03\xdf\x89\xabhn\x93+\xc4\x82\xf3\x91\x0f\xfdPk\x138\xb2%\x87rE\xdcn\xa4\x86\x0c\xf9\xcem\x19g\x83\x85\x8cq\xca\xe0\x08\xcb\\x\x1c_\xc5\x98i\xd8\xfd\x878\xcd\xa2\x9f\xdf\xfaE\xcd&\xf7\xe9Ag\xc0\x04.\xe4\xec\x8c\xf72}#\n\x98\x03+\x06\xed \x8dD\x90\xad\xe1\x06\xde\x1c\xe84\x8b\xf2\xec\x07\x99 Kedn\xe9\xad\xc0sZw\x85,\xa3R\x9f\xc7%x*RWDa\xc52*\xee@{\xb6\x91\xea\xa1\xc8\x06\"7\xcc\x8f\xc9x\xc6\x96\xfc\xdf\x1dv\xb5\x04m<h@WD\xa8x\xa4\xa5\n\x8d\xbe:\x8b\xa8&\xa7.j7\x0b\x9a\xea\xb1nG\n2\xd4\x8dm\xd6\xe5\xca\x835\x80\xffc\x90\x01\x9b)\x87\xa1X\x96w'\xa0J\xa6l\xa8\x04\x12g\"R\xbb5m\xaa\x91\xc6\xf2\x99}q\xa1\x062\xdd\x97\xb7\x98\x17\x02\x0eJ\x8c+l\xb9\\\xdau1\xaePe?R\xb3\x03r4\xca\xc6\xf6o\xa4\x0fq\xcb\xc9CF\x88\xc0\x1f\xfa/\x9bA\x84\n\xc1\\\x18MPz%\x1f%2\xe2\x99 \xa5Vs\xfa\xbafqY\xe5\xcd\xe6f,\xcc\x95y\xe7\x8f\x9cZ\x9e@\x14N\x9csK\x8c\x04L\x04>2\xc3\x87e\x9eY8y&\xa3\x80\x8b\xc8y\xdb\xf7\xd1\x85\xe3\xdaH\xf9\xba\x02\x19\x8f\xed\xe1Y\x99\x8a\x87,\x88i n\xf1\xbc\xba\xcd>\x8a[<\xb4\xfb\xc0-C\xc7\xc7+7\xa4\xd1}\n\xbb\x073\x9f\x12\x96t\x02h\xb5Ij\x0f\xbc\x90Z\xcd\x11<7#\x8dK\xd2\xf7\xb1=\x05\xc2\x00a\x0c\xd0v\xcf>/W\xf5\x0d@+H\xa6\xd0\xca9k	UD\xb9\xf8\x8cl\xb5\n\xca\x00\xf5\xd3\xe2~u\xcc\x18\x89r\x8a%#\xbf	\x994\xf0_\xf7 \xca\xc0\xab\x12\xf0\xfd\x1c\x8a\x82\x0c\xdc\x07\xe1\xa1\xd6c\x88\xa8\x86#\xfc\xc8NIi<s\x0c\xf69\x89\x01\x13\x97\xb9RU4\xa2\xd1\x9c&\x87\xc39\xe3l\xee\x15wC6-\xf1%{P\xec\xc1\xe6v\xc4#\xc7\xa2\x0d\xd4\xef\xa4\xb8\xbf07\x15\x9b\xba[\xe6\xb6b\xf3(BP\xedc\xbd \x9d\xc4s\xf7\xecF\x12\x9f}\xcfJ\x17\xf2\xca\x82|\xa3\xd2\xf5de\xe2\xd1\xad:\xf1h\x9d\x8fFP\xfd\xbaW\xa12\x1a\xc0\x8c\x99\xb1\x12\xcb,$\x98qt/\xaa\xf4\xbd\xd9\x08\xa7\x1e+\x11\x08\xbe\x1b\xee\xd3\xc3}\xf8h\n<\x8a\x822\xd7\xc9\xddFrZ\xbdDv\x00y\x19	\xd5*Kub:l=7Y	\xef\xd5\xa9LZ\x850n\xc5\xaa\x92\x0d\x1bg\xc9(\xdc\xf8\xe5<\x0d\xa4\x14D\x16\xebR\xd1l\x92\x00\x1aW1\xd9:7\xa5D\xe1\xa5b\x9f\xe42\xdaB\xb08\xf2yE>\xc3\x10lsN;\x8c\x062\xf6\xe4,\xe4\xf8V\xb8\x00m\xb2+Z'\xb1\x1e\xd7j\x8d(\x8b\xcaR\xafJ\xa4\"\xd3\x05Y\x8f!\xdc\xde\x15\xd9\xb8\x94h\xa5bp\x02mm\xc3\xedc\x15\xa0>\x0b\x0b'\x1a\xfcb\x9b\x9e\xfdF\x83\x0f\xaf\xe3\xe1W\x01h^e_\xfe+\x9d|\x90z5\x96\xd9m\n;4\xe9\x14\xb1\xcc|<\xa2\xe6Y\x9e\x01\xc6\xa6\xf9\xcb\xfdl\xb38Oq\x1d\x9c\x89\xccY\x94h#|\xa8\x02\xfa\xb8+h,\xa4\x07 \x99Af\xa6\xba\x92\xaf\xe4\xe1\x1a\xc1\x03W)\xe4X\x91\xe6\n	we\xd7\xa4\xdabTo\xeaX\xbfo\x00\xbf\xb5$\"W\xb1\xc7v|DDma+\xa3DF[\x1dv{\x00t\xd6\x19'q\x9b)\xbe-\xfbHj\x01a\xd9\xa09\x92\xcf\x8b\x9e!\xf4&\xcff 1\"\xaf>\xb73^\xcd\x19\xd7\x01A\x85!\xb1\xd8AV\x088\xacm\xbc\x92E\"][\xa9\xc5\x06f\x15)l\x17?!\xc0Q\xc61\xf0E\x9f\x8f\xe3\xb2\xd3un\x98\xa4\xdc\xb6\x9e\x14)S\x08td\xda\xe5B\x82\xb8\xd0P\xa4\xcc\xf0\x99\xb4\x8b\x0d\x94y\xd9\xe7\xa7r\x80x%\x0f+\xec\x1b(\xf5\x80\xc7\x94yQ\x9e`\x17\xd4\xbaN\xc9\xc53\x13\xcdf\xad\xbb\x99\xbc\xdb\xd5\x9c\xaa\xf4\xc5\x16\xcf\x85e\x90\x01<7\xcd$\xaf\x18\xb6\x99\xe4\x99O\xab)u\xda\x8aV\x172\xc6\xcdVMX\xdaE$\xa8\xeatS\xc4K\xcbI.\x18\x95\xfa^>*\x08\xd3\x18\x81\x92.\xea3\xb0U\xf6\xb0\xd8!\xcf\xaa\xf3\x7f\xe1\xd7\xe9\x05D6/\xfc\xba\x1b\xe0\x800\xa6\x9a\xf2\xf4&\xf6k\xb1<\xbdyu\xf3\x96\xcf<\xe4\xf1\x0f*OA\x16\xe2M\x15U\xf6/\x04\x12\x1f2\x9f\xe6\xd2x\xc70\x13\xc9\x9b\xcd\xf7\xf5\xe6\x88\xb3\x8d\x82\x17\x0b\x98d\xfe\x0e\xd1\xfa\xcf\xbcCg\xb0\xe0\x9f\xbcC\xa9\xe8K\xdc\xa2\x80\xe9\xbe`V\xdf\xa5\"\xe1\x19\x9eU\xc8\xd0\x15p\xe6\xf7	\xcc1\x18^`\nb\x9ed(:w\xfbi\x9d\x99\xf3Z\x8d\x1b,\x02\x98\xdcy\x8f]@\x80\x02\xa1N\xe0\x9e\xc0\xcdN\xed\x8bb\xb7qxF\x82\x87\x9c\xa3\xcf\xf9%\xc0\xcb\\\xce\x91\xb9\xdc\x80!\x00\x82\xf0	\xebRM\x08\xc3\xd4XH*\"dz\xb9[\x05&\xd3E\xa70\x10\x9f\xf8,i\x9f\xe9\x9b\"p\xadD\x04/\x0bR\xa5\x06\xfc\xaf\xf8\x16tc\x87\xd2@K\x0c\xa1\xe5\x870MR\x01\xe8\x80D\xae\xef\xd0X`2\xb9\xd9\x00\xbc>\xf2\xfc\xf4V4N\x95=\xfa\x18\xb60LQr\xe5\xfd*\xbc\xcf\xb4[\xe7\x994\"H\x8b\xc7\xa9\xd2\xf51\xd2i@\x89\xd6\xd9\x877\xaf\xd5\xbcF,\x02\x05\xed\xc6g\x99\xf3J~\x13\xf1*H)G\xd8\xc3\xe8\xa1\xf7\xb4\xca\xd4\xb8Q\xaa\x81\x0b\xa4\xa4x\xc6:\xdd\n\xd7\x8f\xac\xdajN\x8a\x9d\x81.\x82][\xed\x05\x85\xc1\x0b\x8b2Y\xabF>\x82\\\x18\x0f\x0b\x85\xaf\xbe\xe8p\x95\xef\xbepNk2L\x08\x8fn\xe2\xa3\x14\x9a${\x01\x85\x05\xfbW*\xd0 \x05\x988Q\xa6IT\x16D\xb0P\xc3\x93\xfa\x1c\xdb\xef\x8a\xbd0:\xec?+\xfc\xf1\xb1=\xb4\xcf\xecK{X|\xee\x91\xc7fn\x00\xae\x90\xcf\x0e@\xc2\x012\x04\xc8?\xa2\xe6\xf4<\x03\xa1dr*\xbc\xc3\xf4\xa8\xc3\xec\xdf\xed\xa1\xcf\x86\x83\x87w\x10\xb8_\x88V\xe2\x863\xfb\x98\xbd\xdb|\x8a\x11\xba\x8f\x86\xe7\x91P\xbc\xce1\xddD\xc1u^\xa6\x97\xbeF\xdc\x80\x95c ;;\x18\xd9\x19\xc73\xafx\xec\xff\x87\x1caX/\x9eZ\xdc\xb5\x87H\xa3*\x1e\xb5\xda\x8d\xea}\xf0\xed\xa4x\xe8\x93\x9b\xe0\xf2\xc2\x81<\x86\x97G\x97\xe3\x9eOn\x82{=\xb5\xfbN\xce8\x01\xef8Vb\x8c\xcf\x91\xfc\x03G\x8d8\x14\\\x0e3\xd22	\x1d\x19J\xae\xa6\x87\x8b^&\xe2zR\x88\xe59q\xbdzE\xf3e\x90\x01B\x07;H\xff\xa8b\xa0o\xbe\x19l=\x88p	\x86\xcd1\x9b\x8fbL\xc6{\xbd\xe6\xab\x9eM\x141W\xd6\xd4\xde\x13\x9d\xa7\x8cz\xb0\x0c\xd5%\x9e\x01\xa9\xc7\x06\x82\xc7\xa1]d\xf2\xae\x12\x0bL\xbfM*\x0f\x14W\x1dI\xbf\\A\xd5u\xad\xbb\xed\x03\x9d\x08\xb6\xf4\xf6\xbblmc\xfb]\xb2 \x96~D\x1f?B~\x9f\x12Y\xe3I\x9eT\xb1\xdc\x96q\xce\x13\xb6\xb6\x11\x986Y\x98\xbc\x14\xf8\xab\xb7\xf4\x88P\xbd\xea\x02JC\x19R\"\xf6\xa6\xb1_\xa7\xab\xacrC:\xec\x9a\x1e\x8b4\xe2F\xb0Dd@\xca\xda>\xa4\xeb\xa14n\x06\xc2\xb4)\x8fY\xb3\xb7/\xd5\x0fV\x0c\xd9\x92\x1dv9cC0\xef\xc7\xc1\x15\x87Yt\x86IQ=s]lP\xcc&\xe7\xd7\xa0O\x19\\\xc4\x85_\x949\x84\xec\x80\xf0\x8a\xba\x17\x08\x15\x01\xfe	@8+\x01\xbe\xb0m\xe8U\x03Q\xf6\"\x8c\x8b9\x15\xe5n\xe2:\xdf\xa9\xc6\"H\x9c'^\x85\xfd\xb7\xde\xd6\xf9!\x16=\xaek\xcc.\x03\x00W\xaa\x7f\xdb\xec\x8d\xe7Y\xcen\x0f_g]\x86s\xefG1\xfe\x91\xafO^\xd1\xaa\x12\xfd\xb3\x83\x9a\x0d\x91n\x84Z\xa6|o\n\x9bc\xc4\xfb\xf3\x96I9\x83\x0f\xe9\xb9W'8}\xe5YG\xaa\xb9\xebZ\xcf\xd5\x10\x08\xd5\x13\xbe\xb3\x9e\xf8\x85\x98$\xd0\xbd*+\xda\x7f>\xf3\xfd!\x92\xf6\x9b\x8f\x04\xeb))\xfe\xb8\xe9\x04\xe4\xc2,\x18wV\xfd\x89^\x14\xa9\xf0K\xb7\xdf\x92\xe8\x846;\xaeM\x9a\x97\xaf9P\x18\xbdp\xc1\x9a\xa6\xd7\xf1!\x95\x9d\xe1wPh\xcd\xf5\xfaA\xbd\x0e7\xc5\xbcl\xf5\xc4\xd5\xd2s\xc6)XH\xdf?\xe9\x12\xe0\x05\xdd\xf2\xfb\xf5\x0e}	s\n\x16;\xed5R\x8f\xed\xc9<v\xfc\xaa\xad\xd9\x7f\xad\x11f\x08X\x18\x8a\x0e\x88\xa1|\xdd)\xa5\x08!4\xe6\x9f\xaeO\xff\xf1\xf0c\x9eE\x03\x7f\xabn(\x8a\x7fV\xd3\xdcF]u\xa3\x08\x9b@\xb5G\xa7\xdc+\x0f\x19\xb9\x0c\xda\xb8|-\x8e\xbbM\x83\x8ax\xbek\xb5*\xe4\xf1\xbeV\xa2\x13\x1a\xfa\x19o\x8b\xee:n\xd1|:\xd3\x17\xffT:S@\xc5\x80g\x97U\xe8\xc2\xdd\xaeJ\xdc\x9ch\xc1\xd1\xa2\xd3\xe7\x8c\xfe`&J\x85Pf\x80|;z\xc5R\x8f7\xe1\x90.\xd5\x92~\xe9>\xddH\xee\xa3a\xe3\x07\x84\xe8t\xab\x91\xe7i\xff\xde\x0c \xe0\x82?\x85\xf6k\xb5\xe7\xf2\xe5\xf0(m\xa1\x84\xa0\xd4\x97E\xc7m~\xe2\x99\xb7v\xff\x86\xe9\xcf\x9f\xfb}\xfb\xfc\xb9\x96\x1e\x01\xf5\xaa~\x08C\xb9G4-\xfb\x94\x9c\x10d\xf6=\xf1\xa9\xc1-\xc7x\xaa}	\x17\xa6\xd8\xb3O\xecI\x95-\x88\x18\x02\xacK\xc8\xb6\x13\xff\xc3\x18\xad\xb1\x8f#\x9f\xd9\xa5\x0e\x08S\n)\xd1dt\x9aa7J\xeb\xa92\xa9\x11(\x7fa_\x96\x83\x97\xc5\xcfX\x19\xe7\xa3\x85?p\xce\xc4\xcc\x9b\x98b\xaf\xd1i\x1e\x0d\xf1c~\xed\xbc\x1a\x18\xb3e\xfa\xe2\xccH\xc8\xca9\xb3qh\xfb\xb4\xce\xd3.\xb72^eZ\xb6\x08;p\x9a\xa9\xfc\xf5\x0b8V\x10\x1b\xa5k\xf5j\x8f\xc45Y\xcd\x95'\xe1?\xfc\x8b\x8ac\x8f\xfd\x94s\x8a\xe1\x88\x1e\x03\x96;\xdb\xe5\xaa\xa1\xc9\x7f\xa9\xf3 \x1d\xb6\x8b@R\xec\x80\xce\xa57\xcc\x12\xa0\xf67\x82lw\x0d\xf3\x01\x08B*\xb8\xd1\xea\xbd\xf2R\xc1D\xefr\xe5\x00n\xb2o\x04\x04\xd5\x1b\x06\x90\x9aT\xec\x0d\xd3\xf1M/G\x91Nc\x0fB\xa0\x9b{I\xd3vf\xfe\xda\x06L\x9b7L'<\x9b\xb9,\x89w\xbf\xcf\xd2r\xbf\x9a\xe2\xf3Q[>\x06C\x15\x7fj8N%\x80\x03\x8f\xe8\xc0>u\x7f\x17\xf7f\x7f\xe7\xa0\xc5c \xd9UrT\x85\x9d\xb5\xa0\x12!\x87\xbdE\x80\x1f\x80qiR\xbe\x9f1\xb2\xaf\xc8sA\xa3\xcb\xf4\xe9\\\x15\xd7\xa0\xcb\xe9\x9e,s\xbfK\xc4\xdets$\"\xec\x07x!F\x99\xa9-\xf5\x9fn\\}\xbf\xcd6\xae\xbf\xdf\xc6\xafa\x80w\xb7\xaf.\xaf\x07\x8f\x1d\xd9\xd0\xeb\xd4X\x0e\xfd\xdb\x0cX\x8d\xef\\\xba\xb4\xf3\xd6\xa5K\xed\xaa\xba\xce\xb6q\xe9.\xecg\xcb\xe4\x1b(\x84\x1dr\x15w<=\xb4\xb8\xe5Ik\xe67\x07\xcaw\xfd\xbe|\xc0U_\x04A\xbc\xb9\xa4\xb5\x97\xd0y\xf4\x1c\xd6\x9f\xcb(\x01o\"\x0cU\xfe\xe9\xcb\x05\x87\x9d\xd5\xbaB\x7f\xf8\x07\xef\xaa'\xfby\xea\xe0lT\xe2\x8d\x02\xc9\xab\xfb\xd5+\x03\x9a\xc6o[\xb1;\xd3q\x93\x8e\xd0+z\x92\xf7}\xc7E'\xfd\xa4\xc0\xf9\x83\x19\xbf\xab\xc4\xc0\xd1\xc2\xb9\x90W\x8f\x86+\xf8\xdf\xbf\xcdMD0B\x88\xb4\x87\x0dJ\x8b\xa1l@\x8a'l\xe0s\n\xcaz\xd8\xfb\xbe:O\xcbXwk\xa5\xfc\xdb?$\x1b\xc9\x94\xaet\xeb\xaa\xff\xb3\xf1\xdc\x08\x84z\xfc\x0c	|\xa5%\xd4\xea\xe5\xd1\xd6\x0dwVW?\xbc\xf23vcu\xd5U\xb8\x1c-t{\xdf\xfdU\xe7I\xb7\xd6V\xca/D&2:1\xf6\x1b\x82S\x1f\xfb_\x9c\xf0B\xcf\xb7\xa3\xc7\xf5\x9br(\x13\xb7\xd5\xee\xb5=3\xae\xc4\x9b'\x9eb\x99$\xa2OOpZk\xee\xcfFz^\x0bVoy\x16\xe9\xd4\xc8h\x0bw\x05\xe5\xe5o'\xd4-\xa5\xea\xcf\xdd->w\x93\xfe\xd1Ez\xe7\xbfp\x91\x12\xdd\xd7\x94\x10\x04c\xf0\xc8~_\xcb\x08W\xe3\xde\xe6\x05\x95>3\xa5\xfe5\xa1\xeaU\xcd\x1d{\n4\xa8\xb5(\xe7\xa1u=\xfc\xa6\x16\xb5\xe2n\xd9o\xecKJ0x\xe4\xab\xc6\xea\xba\xaebD4\xb9\x7f\xf4\x0e\xfc\xe8+x\xcf\xacR\xf0\xc0\xe9\xcaC\xef\x175\xf2's\xa3:\x92\xa3<\xedW\x07v\x03\x1ft^\x97\x82C\xedl\xb1[Z'\x99\x1c\xa1\xf7\xaaL2Qm;\x14\x8a\xc9\xbb\xc8^i\xfb\x9f?)Qk\xa4\xf30\xa1\x00lP\xf0\x19'\xd9$\x04ftX)*o\x8a~\x8eS$v\xca\xdf\xc2\xf0\x9a\xa5eD\xe6\xd2 \x88\x17\x9b\"\x83B\xaa\x02\xf6\xa8\xbe\xe6\x8eHY\xedO\x0c\x99\x93\xc1\xe52\x03\xee\x82\xae\xe5_A\xe929\xf5\xadM\xfa`]\xfft\xa0\xe5\x8f\xb67\xdf\xd1\xd5\xffY\x1fz\xfa\x05\xa0\xd4T\xf99bG\x9a\xac\xc3ni\x07\xb7\n\xaa\xf6LBTn\xcc'm\xd6\xd7\xc4\x04\x92\xdc\xa90y\xb7\xfc=\x11\xc4\xae\xdc1\xfe\xd5\x19\xd7\xebW-\x16\x0dD\xb4%JQ_\x1aS\xb5l\xc0\x15\x9eI\x12\xa7\xe3w\x88\x98\x06,\xc9i+\x84\x0f+A\xe6\xbd\xe5P\x92\xf9\x1d\xa5\xb1C\xd4\xa6e&\x8a\x94\x00\xc9Wz\x17\n\xcc\xf3\x99\xff)0z\x9eX\xb5\xa3w}h\xfc\xa1\x92\x9f\xe4\x94}\x8bx\x05bw\x11b<K\x99\xce\x08[\x0b~\xf3\xa0\x04\xd7j\\\x8d\x80,\x8cR\xc5\x01[H&\xa0\x92\xd2\x9fhe\x93\x91+\xc1\xff\xd4+W^W\x7f\xa8\xba\\\xb5\xce\x9f\xfb\xfd\xf9\xdf\x9f\xff\xcf\x01\x00PK\x07\x08\x12R\xf0`0\x1c\x00\x00 N\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x9c\xa5\x97N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00$\x00	\x00sql/functions/assert_can_perform.sqlUT\x05\x00\x01Hy\xbf\\l\x90\xcdj\xc30\x10\x84\xcf\xd2S\xec\xc1\xe0\x04\x92@\xcf\xa6\x85>I\xd8HcG \xaf\\\xed:\xcd\xe3\x17\xff\xe4P\x88.\xd2J\xfbiv\xe6|\xa6oUT#\x0e\xa1\xccb\x14XhB\xedK\x1di,\x11\x95-\x15!\x0e\xcb\xe6C\x05\x1b\xa8\x9fe\xad\x89W\xf8\x1aX\xae;tx}dx\xda\x89n\x85k\xdc\xcf\x19\x0fd\xd2\x91sNbG_as\x15\xa5GI\x91X\xa9i|D\xc8\\\xe1\xdd2\xc6\xad\x94\xdc\xf9\x1b\x86$\xde)2\x82\x11\x9eIM\xe9\xb0\x97\x1f\xde-\xab\xafe$5\xee{\xd2\xed\xe6\xf7\x8e\n\xd2\xcb\xa6\x9f\x84\x0e-\xe7\xdc\x9e\xdeL\xbc\xf5\x1c7\xce\xb1D\xd2\xcbT4\xad\x06\xbf>\xdf\x11\xab\x93\x7f\xc0\xcb\xf5\xdb\xf6\xfdq\x91Hbe\xc9\xb8\xf3.\xf5$e\x0b\xdc\xee\x10\xef\\\xe5\xa4 <\x03\xa6U\xbc\xe5\x10\xa0J\x11\x92\x10\xdb\xce;H\xa4\xd4w\x1e\x12;\xdf4\x94Y\x86\x99\x07\xd0\x94\xa7A\x7fr\xe7\xff\x06\x00PK\x07\x08A\xbd\xd5h\x02\x01\x00\x00\xd2\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x9c\xa5\x97N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00sql/functions/bump_thread.sqlUT\x05\x00\x01Hy\xbf\\d\xcfMN\xc30\x10\xc5\xf1\xb5\xe7\x14o\x91E\"!T\xb6\x98\x9c\xa5r\xecIb\xc9\x9d1\xfe\xa0=>\x82\x16\xb5\x15\xdb'\xeb\xef\xdf\xf8\xc2\xae1\xb4\xa0pN\xce3\xd6.\xbeE\x15,\xfd\x94\x8fm/\xec\xc2\xa8\x19K\xdc\xa2\xb4\x97\xdb\x1cO\x8cE5a\xc6\xeaR\xe5\x89\n\xb7^\xa4\xe2Kc W1\x0c\x14\xd8'W\x98\x8c\xe8\xf9\xd8%^n\x11\xbc\xcf\xe0K+\xce\xb7\x91\xb3\xfa\x1dk\xd1\x13D\xcf\xe34YZx\x8bB\xa6\xe7\xf0C\xbb\n*\x19S\xb9\xe1:^\xff\x9f\xf1\xd7%c\xce;\x17F\x0c\x98\xa1\xd9\x92\x89\xeb\xe3\x01\xafw\xb5\x93\x80\xac\xb5\x1d\xbdvi\xe3\xe3#\xcd\x13>\xf0v8\x1c\xd0v\x162\xff\x0d\xbf\x88{\xec\x89\xf0hx\xceZ2,\x01q\xb5\xc4\x12,\x0d\x03\x92\x93\xad\xbb\x8d\x91S\xde\xeag\xb2\xf4=\x00PK\x07\x08s\xdcw}\xe0\x00\x00\x00\x8b\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x9c\xa5\x97N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1f\x00	\x00sql/functions/delete_images.sqlUT\x05\x00\x01Hy\xbf\\t\x92\xe1j\xdc0\x10\x84\x7fKO1\x84\x83\xf8\xe0\x08\x84\xfe\x8b9\xfa \xa5\x18YZ\xfb\x94\xca+UZ\xa5=\x9a\xbe{\x91\xed+\xf4J\xfe\x8eggw>\xcbf2B\x88\x19\x99R0\x960U\xb6\xe2#\xc3Q \xa1\xc1/f\xa6\xd2yW0\xfa\xd9\xb3|\xf9z\x82\xb16V\x16\x08\xfd\x94\xa3\xce$5s\xc1[\xf4\x0e\xa6\xe0p\xd0\x8el0\x99\xb4\x1a\xa3\xc9n\xf5\xf5Z\xd9\x0b\xd9o\xe4\x86U,x-\x91G\x9c\xf1\xf8\xeb\xf7c\xafU\x8aE\x06\xef\xf65\xbd\x1ei\xf6\xac\xd5\x143\x19{\xc1\xed\xb3g\x98\x9c\xcd\x15\xed\xa4\x10c\xd2J\x15\nde\xb3\xac\xe1]z\xf2\xee\x08\xcf\x12\xb1\nZ)5\xe5\xb8\xac\x9e\x826\xa4~\\(\x13\x9a\x13\xe7[|\xd3\x95a\x87\xf4T.\xe6\x19\xbe\x80\xa3\x80k\x08\xbdV\xcaO[\xdc\xaa\xd7\x10 \x17\xe26c#\x8b\xe7J\xcdD\xec\xe0\xa7^o\xfe6\xfeo\xf1\xcf[\xc4m4Q\x9eb^`J\xa1,\x835<\xecR\xb7s>mKOx~y)\x8b	\xc1\xb3\x1c\xdb\xa6{\xa2\xe7\xbbMx\x7f\xdf(\x0fc\xf5\xc1\x0dq|%+\xdd\x9e&\xb9\xd2\xf1\xee\xe0\x9a\\{\x10\x8dF\x81\xd9A\x15\x12\xac4\xcek\xe9\x8f\xd8\xb5(\xcf\xad\xc4\x06~\x89n\x08qF'\xd7D\x7f;\xec\xe6\x13\x1e\xc6\xeb\xc3\xb1e\xbd\x99P\xa9\xa0\xfb\xf4\xbfg\x07\xd0\xaelT\xdb\xef\xee5\xb1\xeb\xf5\xe1\x80`x\xaef&\xa4\x90\xe6\xf2=\xf4\xfa\xcf\x00PK\x07\x08\xee%\xd00\x83\x01\x00\x00\xcf\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x9c\xa5\x97N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00sql/functions/delete_posts.sqlUT\x05\x00\x01Hy\xbf\\d\x92Qk\xdc0\x10\x84\x9f\xa5_1\x84\x83\xf8\xe0Rh\x1fc\x8eR(\xf4\xad\x7f\xa0\x14#Kk\x9fR\xdd\xca\x95Vi\x8f\xa6\xff\xbd\xc8\xd6\xa5$y]\x8d\xe7\x9b\x9d\xb5Md\x84\x10\x13\x12-\xc1X\xc2T\xd8\x8a\x8f\x0cG\x81\x84\x86%f\xc9\x9dw\x19\xa3\x9f=\xcb\xb7\xef\x07\x18kca\x81\xd0o\xd9\xebDR\x12g<F\xef`2v;\xed\xc8\x06\x93H\xab1\x9a\xe4V]\xaf\x95=\x91\xfdAnX\x87\x19\x0f9\xf2\x88#n\xff\xfc\xbd\xed\xb5\xaa\xa0\xc1\xbb\x86\xe9\xf5H\xb3g\xad\xa6\x98\xc8\xd8\x13\xae\xcf\x9eaR2\x17\xd4H!\xc6E+uw\x87/$\xab\x04\xab\xb9V*S \xbb\xcd6`\xb7\xbc\xf3n\x0f\xcf\x12\x9fEjJ\xf1\xbcj2\xaa\x91\xfau\xa2D\xa8J\x1c\xaf\xc8:W\x86\x1d8\n|\x1e\xb6b\x9a_\xaf7\xfe\xd7\x88\xb3\x11{\xd2J\xf9i\xf3\x87\xcf\xe0\x12\x02\xe4D\\Mld\xf1\\\xa8\xd7J\x11;\xf8\xe9\xfa\xf5\xa7\x9c)	J\xa6\x04k\xae\xdd\xb7`\x917\xbf\x03\xfc\xb4f0!\x91q\x17\xb4>7d}xY\xf0\xc7V~\xa3/\x94\xa6\x98\xce0+k\xb0\x86\x876\xea\xda=\x0fW\xce\xfb\xfb\xfb|6!x\x96}\x0d\xfb\xfar\xc7W$<=m\xd7\x1c\xc6\xe2\x83\x1b\xe2\xf8@V\xba\xe6&\xa9\xd0\xfe\xed\xce\x9f\xff\xefX7\xe0\x9aj\xbb\xce9\xba!\xc4\x19\x9d\\\x16z\x0e\xd5\xaeq\xc0\xcdx\xb9\xd9\xd7T\x8f&\x14\xca\xe8>\xbc\xd5\xb4\x8d*\xb66]\xff\x93^\x13\xbb^\xefv\x08\x86\xe7bf\xc2\x12\x969\xff\x0c\xbd\xfe7\x00PK\x07\x08\x89\xdd\xb4\"\x9e\x01\x00\x00\x07\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x9c\xa5\x97N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00$\x00	\x00sql/functions/delete_posts_by_ip.sqlUT\x05\x00\x01Hy\xbf\\\x94S\xc1n\xe36\x10=\x93_\xf1\x10\x18\xb0\x84\xcaF\xdbKQ\x1b)P\xa0E\x11\xf4\x92?\x10hq$\x0fB\x93\\\x92J\xe2\xfd\xfa\x05%JI\xbc9\xec\xea&\xce\xcc\x9b7\xef\xcd\xecv0d\x87t>\xe0\x89\xc8C\x93\xa1\xc4v\x80w1E\xb8\x1e\xe9\xcc\x11\x0f\x8f\xe8]\x80\x1e\x83J\xec,\xd8\"R\xe7\xac\x8e\xb2\x0b\xa4\x12\xc1\x05\x04\xf2Fu\x84~\xb4\xdd\x945\x81Q;A\xb5\xa7k\xcb\xbeb\x8d\x13\x0flS\x03\xd5un\xb4	\x89^S#\xc5Lc\x8d\x06R\xd1\xd9)X\xcb@i\x0c6\xe2\xd9\xb1\x86\x8a\xd8l\xa4\xa6\xce\xa8@R$\x15\x06J\xed\xc9\xa9\xa0\xa7\xf4\xe3\xfa\xc6\x1el)?\xacm\x8f\xf2D\x03[)v;\xfcGi\x1a\x13s\xad\xb2\x1a\x0f\x8fRD2\xd4\xcd\x91\x19\xb5\xf2{\xd6u\x03\xbf\x9f\x00\x93\xc3\xfb\x9e\xcd\xf2\xc7^\n\xd1\x07w)\xda\xe5\xdf\x973\x05\xca\x85\x1a\xf7\x9f\xc8\xb1g}\x94\x13\x97G\x17\x13\x06g'!\x95	\xa4\xf4\x15^\xc5\x84?\xa0\xd55\xc2\x19-\x05\xf7o\xcd\xc0\x11v4\x06\xe9LV\n1kt\x94\x82\xac\x06\xf7\x05\xf7\xef\x18)$\x8c\x91\x02:\xb5X\xb2\xb8k\xe7\xd1\xa5\xf0\x14z\x17.PSz\xdb)\xdb\x96\xa7\xaa\xd8\xb4\x8e9U4\xf8\xedp\x88\x17e\x0c\xdbT\x97^\xff\xcc\xd8\xe9\\\xf0\xa5\xc8;\xc3:oK\xb5\xa8\xbag-\x85\xf8N\xa8wRy\xdc/\xbd&I\x85\x10\xd9\x9a[?\xde\xb2\xca\x0c\xf9\xdb\xed\xf0\xaf\x8dc X\x97V\x1dg\xe1\xf5\x1bV\x0erl\xcb\xfb\xeco-\x85q.7d\x9bE\x98\x9d\xbe8\xdd\x1a7\xa0JWO\x0d\xca\xf0\x13\x17\xd6\x0d\xeeN\xd7\xbb:\xe3>+3RD\xf5\xfb\xadN9\xabHX\x17sr\x9b\"\xd9\xff\x9f\xdc\\\xe2\xc9T\x8e\xa0W\xcf\x81\xe2\xe4{9\x8f\xbf\xf0\xebb\xf8O\xd2l\xa0URM\x01\xfa@\xfa\xcf[\xd2\x9fn\xea:\xc7r\x9cM\xc6\x10\xe5p\xeb\xe3\x0d\xa5\x93\xb2\x11\x15\xfbU\xb4\xde\x85\xbc\xe5kua53.\xa3~\xa0U8e\x88\x1f\xa2\xf7\x81\xd5\xcau\x1b\xcfJ\xbb\x97ma[Y\xf7R\xd5\xf8\x05\x17\xf5D-\xdbD\xe1Y\x99*R\x17q\xb8_\xd4\xa9\xa1\x12\x12_\x08_\xf3Mn\xc7\xd4m\x17\xfb\xf2m\x91\xd5G\xb9\xd9\xc0(;\x8cj x\xe3\x87\xf8\xc5\x1c\xe5\xb7\x01\x00PK\x07\x08\xcc\xaa}Oa\x02\x00\x00Q\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x9c\xa5\x97N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00sql/functions/insert_image.sqlUT\x05\x00\x01Hy\xbf\\\\\x92O\x8e\xdb:\x0c\x87\xd7\xe2)\xb8\x08`\x1b\xf0\x04y\x0fEQ\xc0\xc8\x01\xba\xe9\x15\x0c\xd9\xa2\x1dN\x15\xca\x15\xe5v\x16s\xf8B\x92\xdd\x7f\xbb\x84&\xf9\xfb\xf8A//\xf8Y\x94bR\xe4\xa7]	YR@zcM,+nA\x13Zq\x18)\xedQ\x8e\x9eW\x0d\x02s$\x9b\x08\x97]\xe6\xc4A\x90\xcb\x9a\xb1t\xb4ynd\x87\x13\xaf,\xa9\xc7\x14\xbe\x92\xe0\xfc\xb0\xb1\xfd\xf4\xb1\xebQ\xec\x93\xf0\xbb\x8d\xa5\xf2\xff\xed\xd6\xf5`t\x0b\xec)\xe2\x14\x82\xef\xa0\x06j\xc9\x9a\xd0*^.\xe0h\xf66\x12\x98\x12\x92\xf7\x97\xf9\x0f\xb7n\x00\xe3l\xb2\xb5{\x80\x89V\x160\xfb\xe62b\x86Q0F)\xa1>\xec\x7fx\xc7]\xa9\x92\x8e\x85\xac\xfd\x13\xfeZJ\x99\xc8\xd4\xa0/\x19\xf6\xfe\xd7\x81\xd7|@\xe98\xa9\xff\xf9~\x94\xc1\x98\x1f\x0f\x8a\x84\xec\xf0\x8e\x87\x150\xa6^w\x1a\xd6k\xc1*\xea\xcf\xd3\x060\xbc\xfc\xfa\x87\xac(\xbb\xf7\x98\x1e$y\xde\xb2\x12\xd2\xdbL[\x91\xdf\xe4\xd5(!\xe1\x12vq\xcd\x00\x86\xc4!/\x03\x80Q\xf24'La,zZ\xeejT6\x06\xc6,1<k\x90\"\xff\x06\xbe\x1e\xaaN\x84\x01\x0e\xea2\x87\xef\xefU\xf68\xed\xec\xdd\x18\xa6W\x9aS\x0b\xc64\xec\x9a\xfe<5+j\x0e\x17M\x8f\xc7\xafR\xcd\x06\x9b\xfa\x12\xba\x01H\xdc\x00\x97\x0bz+\xeb\x9e\xdf\xd8\xe6\xb7U\xbf\xf9\x01~\x0e\x00PK\x07\x08\x0b\xce\xa2\xd0t\x01\x00\x00\xa2\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x9c\xa5\x97N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00sql/functions/is_deleted.sqlUT\x05\x00\x01Hy\xbf\\L\x8e1n\xc30\x0cEg\xf1\x14\x7f\xf0\x90,\x01\xda\xd5\xc8Y\x02Y\xfaQ	\xc8\x92*\xd1h{\xfb\"\xb6\x81\x96\x1b\xf9\x1e\xc0\x17:\xbd\x11\xb5\xa3\xb3e\x1f\x88\xe7V\x82i-\xd0\xf1\x88\xcc4\xc6\x8bF,\x9a\xb4\xd8U:m\xebe`\xa95\xc3\x0fL\x93D\x86\xec;\xc5\x9d\xfa\xcefY\x98\xb4\x88\x1b\xcc\x0c\x06~\xeb\xb0\x81\xcb\xb9\xbe\x89{\xcd\xb3\xd7\x15\xad\x0e{\xac5\xb2\xfb\xfdq[\x0f\xf8\xf5\xc1N\xb4\xf5\xb6\x0b\x1aq\xff\xd7t\xd3xX\xce\x97\xf8\x92\xec\xa7\x11w\xbc_\xc59-Vq\xc6\xcf\xe2\x8e\xe6\xbf\x03K\x9ce\x9a\x90}I\x9bOD\xcb-\x8d\xcf<\xcb\xef\x00PK\x07\x08)\x9e\xae\xd8\xb0\x00\x00\x00\x0f\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x9c\xa5\x97N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00sql/functions/post_board.sqlUT\x05\x00\x01Hy\xbf\\<\x8eAN\xc50\x0cD\xd7\xf6)f\xd1\xc5\xff\x9b^ \xe2,(m\xfcS\xa3\xe0\x04\xc7\x15\x1c\x1f\xa9E\xec,\xbf\xd1\xcc\xdb]r\x08^\xa7\xed\xa1\xdd0\xfa\x8c\xf7\xadg/\x0f-\xd8\xb4\xaa\xc5\x93]\xe2t\x9b\x08\xf9	\xe4\x89e\xe1\"{\xcb.LW\xf8\"\x897\xa9jLS\x9a\xec\x81Xo\xa6\x16\x1d\xd7\xc9D/\xef\x9f\xd7\xca\xc4`\xa2\x8f\xae\x868\\r\x99\x08t\xc3#V-x\xc3X\xfbx2\xd1\xf7!.\x18\x7f\xcf\x7f\xbdUKb\xba\xc5\xee\xf2\xc4b%\xf1\xb2\xa0e\xabg\xae\x82\xd1F\x9d_-\xf1\xef\x00PK\x07\x08\xad\x9f\xd7\x04\x9f\x00\x00\x00\xe7\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x9c\xa5\x97N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00sql/functions/post_count.sqlUT\x05\x00\x01Hy\xbf\\L\xcd1\xae\xc3 \x0c\xc6\xf1\xd9>\x85\x07\x86\x977\xe4\x02\xa8g\xa9\x88\xe3P$jS0\xea\xf5+5\x19:\xfe\xbf\xe1\xfbq\x97\xe4B\xc7T\xf6bJ\xcd\x86\xdf\xd9\xa6\xfa\x9f5\xdaJ.\xea\x0bv\xf1\xd9u\\MiP\x08\xb8\x0b\xd7\xd4\x05\x81\xaf=\xe2&\xb9(\xc2\x90*\xect\xde\xfc/T\xd4\x8d\x18\x01\x8en\xcf/1\x10\xe0\xfd\x90.g\xad\xd6\xe8\xf6c\xaf\xd6\"\xc2\xa9\x12G\x14\xdd#\x86@5i\x9e)\x0b\xb5\xda\xf2x\xd5\x88\x9f\x01\x00PK\x07\x08\xbaki\xf9\x88\x00\x00\x00\xc0\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x9c\xa5\x97N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x19\x00	\x00sql/functions/post_op.sqlUT\x05\x00\x01Hy\xbf\\4\xcd1\x8e\x021\x0c\x85\xe1\xda>\x85\x8b\x14\xbb\x0d\x17\x888\x0b\xca$\x9e`)\xd8&\xf1\x88\xeb#\xc1P>=\xe9\xff\xea\xe4\x12L\xfb\xa15\xc4\x94\xdcV\xdc\xcc\xff\xa4\xd1&]4\xfeqr\x1cS\xd7\xb9\xa9,J	\x1b\xd7Q&#\x98\x9fG\xc6\x8d\xbb(\xc2\xe2\xc15\xc8\x9cD\xc3\xc8\x1c\x01\xf6i\x8fO{!\xc0\xeb\xce\x93I\x1a]\x7f\xdcEZF\xf8Bd\x9e\x91\xb5eL\x89F\xd1~\x94\xce\xe4\xc3\xfbz\x8e\x8c\xef\x01\x00PK\x07\x08Q\xa7\x96\xff\x83\x00\x00\x00\xb1\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x9c\xa5\x97N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x00	\x00sql/functions/spoiler_images.sqlUT\x05\x00\x01Hy\xbf\\t\x92\xe1j\xdc0\x10\x84\x7fKO\xb1\x84\x83\xf8\xe0\x08\x04\xfa+\xe6\xe8\x83\x94bdi\xedS*\xef\xaa\xdaU\xda\xa3\xe9\xbb\x17\xd9\xbeB\xaf\xe4\xefxfv\xf7\xb3|A\xa7\x08\\\xa0`N\xce#L\x95\xbcF&\x90\xcc1a\x19\xe2\xe2f\x94.\x06\x811\xce\x91\xf4\xcb\xd7\x138\xef\xb9\x92\x82\xe2O=\xda\x82Z\x0b	\xbcq\x0c\xe0\x04\x0e\x07\x1b\xd0'W\xd0\x9a\x91]	\xab\xaf\xb7\xc6_\xd0\x7f\xc30\xac\xa2\xc0\xab0\x8dp\x86\xc7_\xbf\x1f{k2\x8b\x0e1\xeccz;\xe2\x1c\xc9\x9a\x89\x0b:\x7f\x81\xdb\xe7H\xe0JqWh+%\xe6l\x8d\x11L\xe8u\xb3\xac\xe5]~\x8a\xe1\x08\x91\x94a\x15\xac1f*\xbc\xac\x1e\x81\x162?.X\x10\x9a\x13\xce\xb7\xfa\xa6\x1bG\x01\xf2\x93\\\xdc3D\x01b\x05\xaa)\xf5\xd6\x988mu\xab^S\x02\xbd \xb5\x8cg\xd2H\x15\x9b	)@\x9cz\xbb\xf9[\xfc\xdf\xc3?\xefP\xf6h\xc62qY\xc0\x89`\xd1\xc1;\x1av\xa9\xdb9\x9f\xb6\xa1'x~y\x91\xc5\xa5\x14I\x8fm\xd2=\xd1\xf3\xdd$x\x7f\xdf(\x0fc\x8d)\x0c<\xbe\xa2\xd7no\xd3R\xf1x\xb7p\xcd\xa1\xbd\x88FC\xda\xbf\\A	\xea\xed=\xc0y\x8d}\x84\xaf\xb5Ejwl\xec\x17\x0eC\xe2\x19:\xbdf\xfc{\xc6n>\xc1\xc3x}8\xb6\xae7\x97*\nt\x9f\xfe\xf7\xec\x0c\xda\xa2\x0dl\xfb\xe3\xbdE\n\xbd=\x1c 9\x9a\xab\x9b\x11r\xca\xb3|O\xbd\xfd3\x00PK\x07\x08\xfd\x08\xb4\x18\x86\x01\x00\x00\xd3\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x9c\xa5\x97N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00!\x00	\x00sql/functions/use_image_token.sqlUT\x05\x00\x01Hy\xbf\\\\P\xbdN\xf40\x10\xac\xbdO1E\xa4\xbb\x14\xf7\xe9CB\x08\xc9\xa2\xa3\xe1\x01\xa8O\x8b\xbdI,|\x9b\xe0\x1f\xe0\xf1Q\x9c\x808\x1ak5\xb33\xb3\xe3\xd3	\xcfY2\xea\x02V\x84\x0b\x8f\x02\x8eqv\\\xc2\xac(\xf3\xab(X=\x92\x94\x9a4\xa3L\xb2\xad\x1d2\x9e\x1e\xc9%\xe1\"\x18\xaa\xba&\xa8Y\xce\x8d>7\xe9\xb1\xbdp\x13\xa7\xe3\xfd]\xdf\xd3\xb7MCn\xff\xf7\xe0\x8c\xae#/.r\x122y\xe2\x9b\x1f\xd2\xd2\x8b\x8cA\xc9x\x89\xb2\xa6\xa4\xf9\x82_\xee\x99\x8c\xf9\x98$\xc9\x15\xf8\xafqx\xf8{\xcb\x86\x931\xdb\x0dA\xc7kY\x8b\x0eZf\xac\x93%\x13\x866!dh\x8dq\xad\xde\xe4\x1c\xb2@>\x9d,\xad\xf2!\xe8;\xc7\xe0\xf7\xdfk)\x07KF\xd4#\x0c\x96\xf6\xbc\xddU\xd4[\xea:D\xd6\xb1\xae\xfbK\\\xc6\xfc\x16-}\x0d\x00PK\x07\x08w\x97\xe0l\xe6\x00\x00\x00\x8b\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x9c\xa5\x97N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00sql/triggers/boards.sqlUT\x05\x00\x01Hy\xbf\\\xac\xcfAj\xc40\x0c\x05\xd0ut\x8a\xbf\x08L\x02\x99\xd2}N\xd0S\x04O\xac\x18\x83+\xbb\xb2\xdc\x92\xdb\x17\xe2\x0b\xb4\x90\xad\x90\xbe\xde\xdf\x95\x9d1\xb2B\xb9$\xb73\x8e&\xbb\xc5,p\x87\xb1n\xaf\xec\xd4\xd7-Je\xb5i&ek*\x15\xa61\x04V\xb8\x8aq\xa4\x17\x87(4\x14\xd6#\xeb'J\xd8$[<\xce\xe9q\xddo\xadxg\xec\x1f\x0b\x84\x7f\xde\xa2\x9fW\xa2\xe1\xf9\xc4\x87DC9\x1b\xbe]jLC\x7f\x83(\x96\xaf\xf1\x14\xfd\x82\xb2\xe7&6\xf7\x9d\x8a\xa9G,x\xbfR:\x08\xd2RZ\x89\xc5\xaf4\x8eHNBs\x81QR	\xf5+\xadD\x7fm\xda\xa9w6\xbd\x9d\xe89\xf1-\xc4\x9c\xfc\xff\x88\xbf\x03\x00PK\x07\x08\xae\x07\x84\xd3\xc0\x00\x00\x00/\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00i%N]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00sql/triggers/mod_log.sqlUT\x05\x00\x01g\x08\xcfjT\x91a\x8a\x1b1\x0c\x85\x7f\xdb\xa7x]\x02\x99\xc0\xecR\xfa\xab0\xec%z\x81\xc1\x19k\x1c\x83#\xbb\xb2fCn_<Nh\xf6\x9fl\xa1\xf7\xa4\xef-BN	Y T\x92[\x08\xeb\xc6\x8b\xc6\xccp\xab\x92\xcc\xd7\xec\xe7\x94\xc3\x1c\xb9\x92\xe8p\xb2B\xba	W\xa8\xc4\x10H\xe0*\x0e\x07\xebiIN\xc8\x9a\\p\x8e!\xb2N\xf6L!\xb25q\x05\xd3\xed\xa3\xe4\xaas\xf4\xf8\xf1\x89\x9f\xd0\x0b\xb15\xa6\x8b\"\xb2f\xec\xfdk\xf6$n\xb7\x1f\x1e\x03#\xf4^h\xc4\xdb\xf9\xfe6\"\x11\x07\xbd\x8c\xf0N\xdd\xc9\x1ac\xbe\\\xda\xa8bx\xb1\x18\xd1\x1e}\xaaU}\xb2U\xcf\xe9V\xef\n\x935f+\xbe!hv\xb5)VR<\xf6 \x8fO\xa8l\xd4\xfeo\x17\x12Bl_/f\xad\xd3\x91D\x0e]\xe4#\x97~R.M\xbf\x90\xacY\xae(a\xe6\xacq\xbd\x0f\xc7\xd7[\xc9\x1f\xc7&\xb2d^\x9c\xce\xb7:\x1c\xc7\xe3\x88\\\xfa\x9a\xd1\x9fN\x93\xb5\xc6\xbc\xbf\xe3\x0f\x95,Z\x91WxJ\xd4\xd6\xcb\x82\xb2I \xdf\xad\xc1D\x1e\x9c\xb1n\xa2\x97\x96\xce\x9e\xa55\xcf\x14\x1a\x15D\xc6\xf0k\xc4\xef\xd33\x87'\x03\xe9\x06m\x9d\x1d\x83P\xcd\xe9\xeb\x1b\x85\x07\x06u\x12H\xbf\xa3h\xc7\x12{\xc4u\xb2\xff\x8b\x0e\x07\xbc\xa54Yb?\xd9\xc3\x01\xc9q\xd8\\ \x94TB\xfd\x9b&\xfbo\x00PK\x07\x08\xda!\xa0~i\x01\x00\x00\x89\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\"<N]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00sql/triggers/posts.sqlUT\x05\x00\x0110\xcfj\x84\x94Ao\xd40\x10\x85\xcf\xf6\xafxT+%Q\xb3+\x95kT.\xe5\xc2\x8d\x03\x88cd\xc7\x93\xacU\xaf\x1d\xec	\xe9\xa2\xfex\xe4$e[\n\xe5\x14\xc9\x93\x99\xf7\xe6\x9b\xb1\xbbH\x8a	!\"\xd2\xe8TG\xe8'\xdf\xb1\x0d\x1e\x9a\xfa\x10\xa9\x1dC\xe2\xd4Z\x9f(rY\xc9H<E\x9f\xc0\xd1\x0e\x03E\xa8\x84\xddN\x1a\xea\x9c\x8a$\x05\x87\xd6\x90#\xa6V\x9f\xc1\xf4\xc0\x8d\xd44X/\xc5H\xb1\x0f\xf1\x04=\x9d\xc6\x96\x8f\x91\x94)=\xcd\x870\xd6\xf0\x81\xe1i>$5P\xd5H\xb1\xdf\xe3\xfa\xa6\x86\xa6NM\x89r\x08\xd9\x06lZ~]\xcd\x90\xc1\x99\xf8Rx\x1cZ\x1f\xd8\xf6\xe7\xb2\xf04/\xbe[\xeb7\xa9\xa2\x96B\xacrx|DQ\x17\xf9\xb3\xfc\xd3\x85\xc9s\xb9\xc6*\\\xe3&;\xb0}V=X\x83w\xb7\xd8\xf2\xf8H^\x8a\xbf\xe9mZ\x19\x11\xadR\x8b\x96\x0e*\x9agr\xaf\xf4\xf3\x815Y\x8f\xbc\x81\xed\x1b\xb9\xf4\xfeq!\xb8\xb8\xaba{|\xfa\x0c\xedTw\xeflb2R$r\xd41\xf4A\x9fa=\x07<\xa7.\x85\xe8c8A+\x9f\xa0\xa5\xc8\x05\xef\x94/\x18\x19e\xae\xd9.\xbe\xca\xea\x19\xe0WP\xc5|\xa4HX\xfe\xc4-\xcaM\x92\xd7\x9er\x83b\xd3Y\xf9&\xf0v\xb8&rF\xf7D\xae\xca!\xe5\x0d\xf4\xc1\x8e\xdb\xa9\x1d/\x87|\x1e	\xb7(\xd2Q\x990\x17\x97\x00=\x8c6R\xc2\x07\xf80\x97\x15\x14\x83\xed\x89\xf03xB\xf1\xf5\xcb]\xb1\x8e\xea\xc5\xd6mK\xe2'\xe7\x9eF\xb6\xdf\xe3\x9bu\x0e\xbd\xb2\x0e\x81\x8f\x14g\x9b\xe8\xd2\xff=\xfdN\x9b\xa3e&\x8fLUiG\x1b\x8eD\x8c.\xf8\xc4QY\xcfi\xe5x\n\x86\xa2\xca\x97e\xdb6\xd3\xf6\xb9\x94\xa1\x9eb$\xd3H!V\xac\xeb\x98\xfeHB\xb9e\xd5\xc8\x04j\\\xe9\xf3\xd5\xc2\xea\x87r\x13%,KiM\x8d\xf7\xf5\x8b\x16\xf3\xc2,\x0b\xb6\xd5\xa2<\"\x8e\x13\xbdX\xa4\xf5\xa6\xe6\x114\x92\xbci\xe4n\x07\xa7\xfc0\xa9\x810\xbaqH\xdf]#\xe5\x1b/\x80\xea\x99\xe2\xf6\x00L\xa3QL\xff|\x00\xfe{\xc9\xab\xe6\xe2hr\xee-K\xbf\x06\x00PK\x07\x08[]/;0\x02\x00\x00\x94\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\"<N]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00sql/triggers/threads.sqlUT\x05\x00\x0110\xcfj\xb4Q\xcd\xca\xd4@\x10<g\x9e\xa2\x84\x85\xdd@\"\x9e\xbc\x04\x1f\xc0\x9b\xf8\x02a6\xd3\xc9\xd70\xe9\x19{z>\x11\xbe\x87\x97\xd9\xac\xa2\x82~{\xf1\xd4\xa4H\xd5\xd4\xcf\xa2\xe4\x8d\x90\x14J9\xfa\x85\xb0VY\x8c\x93\xc0\xafF:\xdb\x93\x92\x0fef)\xa4v\xe9\x9d\x92U\x95\x02S\xde6R\xf8\x82\xd3\xc9]icq]&]\x93\xee\xb8\xd6=\xdf\xa9\x17\xa1\xafo9\xf4\x93s\xdd8\xe2\xa3\xb0\xe1s-\x85\xbd@S\x8ddF\xae;\xe4\xc1b\xe9'\x8a\x0b\x87\x01eIUl\x80\xden\x8fg\x1f+\x15\xdce\x07\xbc\x1f\xf0\xee&\xfe\xe3\xed\xbc\xcd\x92\x8c\xd7o\x97\xf3\xe1\xe0\xee\x9d\xc2y@c]\x93\xd7\x80\x97\x17\x9c\x87s;\xbf\x18<\xc2Aj\x8c\x93#	\x93;\x9d\x10\xbdl\xd5o\x84\x1c\xf3V\xbe\xc4\xc9\xb9\x87k\xab9x\xa3\xd7j\x1bG|Rz&i\x0d\xac,l\x04\xa5\xa5jiC$\x81\xf1N\xc5\xfc\x9eq\x08\x16\xd7\xf1z3~|\xcf\xed\x07\xbc\xf9\x80\x14\xc3o\x90=\x91\xb8\xee\xdf\xbbt$\x01\xbcN\xff!~\xa0H\xaf\xc7\xff\xfbr\x07\xbf\x0d\xd7\x82\xfd9\\\xc38\xf4\x8f\x1b\xff>\x00PK\x07\x08\x17\xdb\xf1\xf80\x01\x00\x00\xee\x02\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x9c\xa5\x97N]12\x95\x96^\x00\x00\x8f^\x00\x00\x12\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x00\x00\x00\x00assets/loading.gifUT\x05\x00\x01Hy\xbf\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x004<N]\x13D\xb5\x00\x03\x05\x00\x00\xb0\x0c\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xdf^\x00\x00lang/en_GB/common.jsonUT\x05\x00\x01T0\xcfjPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x0b>N]:/k\xd3+\x17\x00\x00DB\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81/d\x00\x00lang/en_GB/server.jsonUT\x05\x00\x01\xc63\xcfjPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x004<N]=\xf3\xe5\xb57\x05\x00\x00\xf7\x0c\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xa7{\x00\x00lang/es_ES/common.jsonUT\x05\x00\x01T0\xcfjPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x0b>N]g\x0c\x85\x82P\x18\x00\x00\x1dD\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81+\x81\x00\x00lang/es_ES/server.jsonUT\x05\x00\x01\xc63\xcfjPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x004<N]\x88\xe6}\xf0\xd8\x05\x00\x00\\\x0d\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xc8\x99\x00\x00lang/fr_FR/common.jsonUT\x05\x00\x01T0\xcfjPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x0b>N]6\xe5#\x83\x1d\x18\x00\x00mD\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xed\x9f\x00\x00lang/fr_FR/server.jsonUT\x05\x00\x01\xc63\xcfjPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x004<N]6\x08\x1d\xe7\xcf\x05\x00\x00m\x0d\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81W\xb8\x00\x00lang/nl_NL/common.jsonUT\x05\x00\x01T0\xcfjPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x0b>N]\xbfY\xa6\"\xb5\x19\x00\x00\xebF\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81s\xbe\x00\x00lang/nl_NL/server.jsonUT\x05\x00\x01\xc63\xcfjPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x004<N]\xa0\xbc\x8a[\x1c\x06\x00\x00\xd5\x0d\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81u\xd8\x00\x00lang/pl_PL/common.jsonUT\x05\x00\x01T0\xcfjPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x0b>N]\x94\x12\xf0\xe8\xeb\x18\x00\x00 C\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xde\xde\x00\x00lang/pl_PL/server.jsonUT\x05\x00\x01\xc63\xcfjPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x004<N]>\xdb\xb7\x06F\x05\x00\x00\x07\x0d\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x16\xf8\x00\x00lang/pt_BR/common.jsonUT\x05\x00\x01T0\xcfjPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x0b>N]\xa2\xe3\x84\nZ\x18\x00\x00vC\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xa9\xfd\x00\x00lang/pt_BR/server.jsonUT\x05\x00\x01\xc63\xcfjPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x004<N]\xdc\x81\x88\x10\x11\x07\x00\x00\xf0\x0f\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81P\x16\x01\x00lang/ru_RU/common.jsonUT\x05\x00\x01T0\xcfjPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x0b>N]\x0b\xafB\xf1\xe8\x1c\x00\x00\xfaS\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xae\x1d\x01\x00lang/ru_RU/server.jsonUT\x05\x00\x01\xc63\xcfjPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x004<N]\x15G\xc1\x84(\x06\x00\x00\xac\x0d\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xe3:\x01\x00lang/sk_SK/common.jsonUT\x05\x00\x01T0\xcfjPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x0b>N]\x91\x13\xc5\x0b%\x1a\x00\x00:D\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81XA\x01\x00lang/sk_SK/server.jsonUT\x05\x00\x01\xc63\xcfjPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x004<N]\xbeV\xa6iy\x05\x00\x00\x02\x0d\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xca[\x01\x00lang/tr_TR/common.jsonUT\x05\x00\x01T0\xcfjPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x0b>N]%?}_\xb4\x17\x00\x00\xd8A\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x90a\x01\x00lang/tr_TR/server.jsonUT\x05\x00\x01\xc63\xcfjPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x004<N]\x88\x10\x0cV\xd6\x06\x00\x00{\x0f\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x91y\x01\x00lang/uk_UA/common.jsonUT\x05\x00\x01T0\xcfjPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x0b>N]\x12R\xf0`0\x1c\x00\x00 N\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xb4\x80\x01\x00lang/uk_UA/server.jsonUT\x05\x00\x01\xc63\xcfjPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x9c\xa5\x97NA\xbd\xd5h\x02\x01\x00\x00\xd2\x01\x00\x00$\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x811\x9d\x01\x00sql/functions/assert_can_perform.sqlUT\x05\x00\x01Hy\xbf\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x9c\xa5\x97Ns\xdcw}\xe0\x00\x00\x00\x8b\x01\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x8e\x9e\x01\x00sql/functions/bump_thread.sqlUT\x05\x00\x01Hy\xbf\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x9c\xa5\x97N\xee%\xd00\x83\x01\x00\x00\xcf\x02\x00\x00\x1f\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xc2\x9f\x01\x00sql/functions/delete_images.sqlUT\x05\x00\x01Hy\xbf\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x9c\xa5\x97N\x89\xdd\xb4\"\x9e\x01\x00\x00\x07\x03\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x9b\xa1\x01\x00sql/functions/delete_posts.sqlUT\x05\x00\x01Hy\xbf\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x9c\xa5\x97N\xcc\xaa}Oa\x02\x00\x00Q\x05\x00\x00$\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x8e\xa3\x01\x00sql/functions/delete_posts_by_ip.sqlUT\x05\x00\x01Hy\xbf\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x9c\xa5\x97N\x0b\xce\xa2\xd0t\x01\x00\x00\xa2\x02\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81J\xa6\x01\x00sql/functions/insert_image.sqlUT\x05\x00\x01Hy\xbf\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x9c\xa5\x97N)\x9e\xae\xd8\xb0\x00\x00\x00\x0f\x01\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x13\xa8\x01\x00sql/functions/is_deleted.sqlUT\x05\x00\x01Hy\xbf\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x9c\xa5\x97N\xad\x9f\xd7\x04\x9f\x00\x00\x00\xe7\x00\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x16\xa9\x01\x00sql/functions/post_board.sqlUT\x05\x00\x01Hy\xbf\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x9c\xa5\x97N\xbaki\xf9\x88\x00\x00\x00\xc0\x00\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x08\xaa\x01\x00sql/functions/post_count.sqlUT\x05\x00\x01Hy\xbf\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x9c\xa5\x97NQ\xa7\x96\xff\x83\x00\x00\x00\xb1\x00\x00\x00\x19\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xe3\xaa\x01\x00sql/functions/post_op.sqlUT\x05\x00\x01Hy\xbf\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x9c\xa5\x97N\xfd\x08\xb4\x18\x86\x01\x00\x00\xd3\x02\x00\x00 \x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xb6\xab\x01\x00sql/functions/spoiler_images.sqlUT\x05\x00\x01Hy\xbf\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x9c\xa5\x97Nw\x97\xe0l\xe6\x00\x00\x00\x8b\x01\x00\x00!\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x93\xad\x01\x00sql/functions/use_image_token.sqlUT\x05\x00\x01Hy\xbf\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x9c\xa5\x97N\xae\x07\x84\xd3\xc0\x00\x00\x00/\x02\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xd1\xae\x01\x00sql/triggers/boards.sqlUT\x05\x00\x01Hy\xbf\\PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00i%N]\xda!\xa0~i\x01\x00\x00\x89\x02\x00\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xdf\xaf\x01\x00sql/triggers/mod_log.sqlUT\x05\x00\x01g\x08\xcfjPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\"<N][]/;0\x02\x00\x00\x94\x04\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x97\xb1\x01\x00sql/triggers/posts.sqlUT\x05\x00\x0110\xcfjPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\"<N]\x17\xdb\xf1\xf80\x01\x00\x00\xee\x02\x00\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x14\xb4\x01\x00sql/triggers/threads.sqlUT\x05\x00\x0110\xcfjPK\x05\x06\x00\x00\x00\x00%\x00%\x00\x88\x0b\x00\x00\x93\xb5\x01\x00\x00\x00"
 	fs.Register(data)
 }
@@ -0,0 +1,115 @@
+package templates
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/imager/assets"
+)
+
+// Number of last replies to display on AMP thread pages
+const AMPLastN = 10
+
+// Thumbnail of a post on an AMP page
+type ampImage struct {
+	Src, HREF     string
+	Width, Height int
+}
+
+// Data passed to the AMP page template
+type ampPage struct {
+	Title, Canonical string
+	Threads          []common.Thread
+	Index            bool
+}
+
+var ampTemplate = template.Must(template.New("amp").Funcs(template.FuncMap{
+	"time":  formatTime,
+	"lines": func(s string) []string { return strings.Split(s, "\n") },
+	"image": ampThumbnail,
+	"deleted": func(p common.Post) bool {
+		return p.IsDeleted()
+	},
+}).Parse(`<!doctype html>
+<html ⚡ lang="en">
+<head>
+<meta charset="utf-8">
+<script async src="https://cdn.ampproject.org/v0.js"></script>
+<title>{{.Title}}</title>
+<link rel="canonical" href="{{.Canonical}}">
+<meta name="viewport" content="width=device-width">
+<style amp-boilerplate>body{-webkit-animation:-amp-start 8s steps(1,end) 0s 1 normal both;-moz-animation:-amp-start 8s steps(1,end) 0s 1 normal both;-ms-animation:-amp-start 8s steps(1,end) 0s 1 normal both;animation:-amp-start 8s steps(1,end) 0s 1 normal both}@-webkit-keyframes -amp-start{from{visibility:hidden}to{visibility:visible}}@-moz-keyframes -amp-start{from{visibility:hidden}to{visibility:visible}}@-ms-keyframes -amp-start{from{visibility:hidden}to{visibility:visible}}@-o-keyframes -amp-start{from{visibility:hidden}to{visibility:visible}}@keyframes -amp-start{from{visibility:hidden}to{visibility:visible}}</style><noscript><style amp-boilerplate>body{-webkit-animation:none;-moz-animation:none;-ms-animation:none;animation:none}</style></noscript>
+<style amp-custom>body{font-family:sans-serif;margin:0.5em}article{border-bottom:1px solid #ccc;padding:0.5em 0;overflow:hidden}header{font-size:0.9em;color:#555}figure{float:left;margin:0 0.5em 0 0}blockquote{margin:0.3em 0;word-wrap:break-word}</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+{{range .Threads}}{{$index := $.Index}}
+<section>
+{{if .Subject}}<h2>{{if $index}}<a href="/amp/thread/{{.ID}}">{{.Subject}}</a>{{else}}{{.Subject}}{{end}}</h2>{{end}}
+{{template "post" .Post}}
+{{range .Posts}}{{template "post" .}}{{end}}
+{{if $index}}<a href="/amp/thread/{{.ID}}">&gt;&gt;{{.ID}}</a>{{end}}
+</section>
+{{end}}
+</body>
+</html>
+{{define "post"}}{{if not (deleted .)}}<article id="p{{.ID}}">
+<header><b>{{if .Name}}{{.Name}}{{else}}Anonymous{{end}}</b>{{if .Trip}} !{{.Trip}}{{end}} {{time .Time}} No.{{.ID}}</header>
+{{with image .Image}}<figure><a href="{{.HREF}}"><amp-img src="{{.Src}}" width="{{.Width}}" height="{{.Height}}" layout="fixed"></amp-img></a></figure>{{end}}
+<blockquote>{{range $i, $l := lines .Body}}{{if $i}}<br>{{end}}{{$l}}{{end}}</blockquote>
+</article>{{end}}{{end}}`))
+
+// Returns the thumbnail of an image to render on an AMP page, if any
+func ampThumbnail(img *common.Image) *ampImage {
+	if img == nil {
+		return nil
+	}
+	i := &ampImage{
+		HREF:   assets.SourcePath(img.FileType, img.SHA1),
+		Width:  150,
+		Height: 150,
+	}
+	switch {
+	case img.ThumbType == common.NoFile:
+		switch img.FileType {
+		case common.MP4, common.MP3, common.OGG, common.FLAC:
+			i.Src = "/assets/audio.png"
+		default:
+			i.Src = "/assets/file.png"
+		}
+	case img.Spoiler:
+		i.Src = "/assets/spoil/default.jpg"
+	default:
+		i.Src = assets.ThumbPath(img.ThumbType, img.SHA1)
+		i.Width = int(img.Dims[2])
+		i.Height = int(img.Dims[3])
+	}
+	if i.Width == 0 || i.Height == 0 { // amp-img requires both dimensions
+		return nil
+	}
+	return i
+}
+
+// AMPBoard writes the AMP version of a board page to w
+func AMPBoard(w io.Writer, b string, threads []common.Thread) error {
+	return ampTemplate.Execute(w, ampPage{
+		Title: fmt.Sprintf("/%s/ - %s", b,
+			config.GetBoardConfigs(b).Title),
+		Canonical: fmt.Sprintf("/%s/", b),
+		Threads:   threads,
+		Index:     true,
+	})
+}
+
+// AMPThread writes the AMP version of a thread page to w
+func AMPThread(w io.Writer, t common.Thread) error {
+	return ampTemplate.Execute(w, ampPage{
+		Title:     fmt.Sprintf("/%s/ - %s", t.Board, t.Subject),
+		Canonical: fmt.Sprintf("/%s/%d", t.Board, t.ID),
+		Threads:   []common.Thread{t},
+	})
+}
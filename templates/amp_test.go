@@ -0,0 +1,83 @@
+package templates
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestAMPThumbnail(t *testing.T) {
+	t.Parallel()
+
+	AssertDeepEquals(t, ampThumbnail(nil), (*ampImage)(nil))
+
+	img := &common.Image{
+		ImageCommon: common.ImageCommon{
+			FileType:  common.PNG,
+			ThumbType: common.JPEG,
+			Dims:      [4]uint16{1000, 500, 150, 75},
+			SHA1:      "abc",
+		},
+	}
+	AssertDeepEquals(t, ampThumbnail(img), &ampImage{
+		Src:    "/assets/images/thumb/abc.jpg",
+		HREF:   "/assets/images/src/abc.png",
+		Width:  150,
+		Height: 75,
+	})
+
+	img.Spoiler = true
+	AssertDeepEquals(t, ampThumbnail(img).Src, "/assets/spoil/default.jpg")
+}
+
+func TestAMPThread(t *testing.T) {
+	t.Parallel()
+
+	var w bytes.Buffer
+	err := AMPThread(&w, common.Thread{
+		Board:   "a",
+		Subject: "<script>",
+		Post: common.Post{
+			ID:   1,
+			Body: "foo\nbar",
+			Image: &common.Image{
+				ImageCommon: common.ImageCommon{
+					ThumbType: common.JPEG,
+					Dims:      [4]uint16{1, 1, 150, 150},
+					SHA1:      "abc",
+				},
+			},
+		},
+		Posts: []common.Post{
+			{
+				ID:   2,
+				Body: `<img src=x onerror="alert(1)">`,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	html := w.String()
+	for _, s := range [...]string{
+		"<html ⚡",
+		`<link rel="canonical" href="/a/1">`,
+		`<amp-img src="/assets/images/thumb/abc.jpg"`,
+		"foo<br>bar",
+		`<article id="p2">`,
+		"&lt;img src=x",
+	} {
+		if !strings.Contains(html, s) {
+			t.Errorf("missing %q", s)
+		}
+	}
+	for _, s := range [...]string{"<img", "<script>"} {
+		if strings.Contains(html, s) {
+			t.Errorf("must not contain %q", s)
+		}
+	}
+}
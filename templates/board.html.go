@@ -109,613 +109,696 @@ func streamrenderBoard(qw422016 *qt422016.Writer, threadHTML []byte, id, title s
 	//line board.html:43
 	qw422016.N().S(`" required type="text" maxlength="100"><br>`)
 	//line board.html:45
+	if id != "all" && conf.ThreadTags {
+		//line board.html:45
+		qw422016.N().S(`<input name="tags" placeholder="`)
+		//line board.html:46
+		qw422016.N().S(ln.UI["tags"])
+		//line board.html:46
+		qw422016.N().S(`" type="text" maxlength="128"><br>`)
+		//line board.html:48
+	}
+	//line board.html:48
+	qw422016.N().S(`<label><input type="checkbox" name="ignoreSimilar">`)
+	//line board.html:51
+	qw422016.N().S(ln.UI["ignoreSimilar"])
+	//line board.html:51
+	qw422016.N().S(`</label><br>`)
+	//line board.html:54
 	streamnoscriptPostCreationFields(qw422016, pos)
-	//line board.html:46
+	//line board.html:55
 	if id == "all" || !conf.TextOnly {
-		//line board.html:47
+		//line board.html:56
 		streamuploadForm(qw422016)
-		//line board.html:48
+		//line board.html:57
 	}
-	//line board.html:49
+	//line board.html:58
 	streamcaptcha(qw422016, id)
-	//line board.html:50
+	//line board.html:59
 	streamsubmit(qw422016, false)
-	//line board.html:50
+	//line board.html:59
 	qw422016.N().S(`</form></aside><aside id="refresh" class="act glass noscript-hide"><a>`)
-	//line board.html:55
+	//line board.html:64
 	qw422016.N().S(ln.Common.UI["refresh"])
-	//line board.html:55
+	//line board.html:64
 	qw422016.N().S(`</a></aside>`)
-	//line board.html:58
+	//line board.html:67
 	streamcatalogLink(qw422016, catalog)
-	//line board.html:59
+	//line board.html:68
 	if !catalog {
-		//line board.html:60
+		//line board.html:69
 		streampagination(qw422016, page, total)
-		//line board.html:61
+		//line board.html:70
 	}
-	//line board.html:62
+	//line board.html:71
 	streamhoverReveal(qw422016, "aside", conf.Notice, ln.Common.UI["showNotice"])
-	//line board.html:63
+	//line board.html:72
 	streamhoverReveal(qw422016, "aside", conf.Rules, ln.Common.UI["rules"])
-	//line board.html:63
+	//line board.html:72
 	qw422016.N().S(`<span id="catalog-controls" class="margin-spaced noscript-hide"><input type="text" name="search" placeholder="`)
-	//line board.html:65
+	//line board.html:74
 	qw422016.N().S(ln.Common.UI["search"])
-	//line board.html:65
+	//line board.html:74
 	qw422016.N().S(`" title="`)
-	//line board.html:65
+	//line board.html:74
 	qw422016.N().S(ln.UI["searchTooltip"])
-	//line board.html:65
+	//line board.html:74
 	qw422016.N().S(`">`)
-	//line board.html:66
+	//line board.html:75
 	if catalog {
-		//line board.html:66
+		//line board.html:75
 		qw422016.N().S(`<select name="sortMode">`)
-		//line board.html:68
+		//line board.html:77
 		for i, s := range [...]string{"bump", "lastReply", "creation", "replyCount", "fileCount"} {
-			//line board.html:68
+			//line board.html:77
 			qw422016.N().S(`<option value="`)
-			//line board.html:69
+			//line board.html:78
 			qw422016.N().S(s)
-			//line board.html:69
+			//line board.html:78
 			qw422016.N().S(`">`)
-			//line board.html:70
+			//line board.html:79
 			qw422016.N().S(ln.SortModes[i])
-			//line board.html:70
+			//line board.html:79
 			qw422016.N().S(`</option>`)
-			//line board.html:72
+			//line board.html:81
 		}
-		//line board.html:72
+		//line board.html:81
 		qw422016.N().S(`</select>`)
-		//line board.html:74
+		//line board.html:83
 	}
-	//line board.html:74
+	//line board.html:83
 	qw422016.N().S(`</span></span><hr>`)
-	//line board.html:78
+	//line board.html:87
 	qw422016.N().Z(threadHTML)
-	//line board.html:78
+	//line board.html:87
 	qw422016.N().S(`<script id="board-configs" type="application/json">`)
-	//line board.html:80
+	//line board.html:89
 	qw422016.N().Z(conf.JSON)
-	//line board.html:80
+	//line board.html:89
 	qw422016.N().S(`</script><hr><span class="aside-container">`)
-	//line board.html:84
+	//line board.html:93
 	streamcatalogLink(qw422016, catalog)
-	//line board.html:85
+	//line board.html:94
 	if !catalog {
-		//line board.html:86
+		//line board.html:95
 		streampagination(qw422016, page, total)
-		//line board.html:87
+		//line board.html:96
 	}
-	//line board.html:87
+	//line board.html:96
 	qw422016.N().S(`</span>`)
-	//line board.html:89
+	//line board.html:98
 	streamloadingImage(qw422016, conf.ID)
-//line board.html:90
+//line board.html:99
 }
 
-//line board.html:90
+//line board.html:99
 func writerenderBoard(qq422016 qtio422016.Writer, threadHTML []byte, id, title string, conf config.BoardConfContainer, page, total int, pos common.ModerationLevel, catalog bool) {
-	//line board.html:90
+	//line board.html:99
 	qw422016 := qt422016.AcquireWriter(qq422016)
-	//line board.html:90
+	//line board.html:99
 	streamrenderBoard(qw422016, threadHTML, id, title, conf, page, total, pos, catalog)
-	//line board.html:90
+	//line board.html:99
 	qt422016.ReleaseWriter(qw422016)
-//line board.html:90
+//line board.html:99
 }
 
-//line board.html:90
+//line board.html:99
 func renderBoard(threadHTML []byte, id, title string, conf config.BoardConfContainer, page, total int, pos common.ModerationLevel, catalog bool) string {
-	//line board.html:90
+	//line board.html:99
 	qb422016 := qt422016.AcquireByteBuffer()
-	//line board.html:90
+	//line board.html:99
 	writerenderBoard(qb422016, threadHTML, id, title, conf, page, total, pos, catalog)
-	//line board.html:90
+	//line board.html:99
 	qs422016 := string(qb422016.B)
-	//line board.html:90
+	//line board.html:99
 	qt422016.ReleaseByteBuffer(qb422016)
-	//line board.html:90
+	//line board.html:99
 	return qs422016
-//line board.html:90
+//line board.html:99
 }
 
 // CatalogThreads renders thread content for a catalog page. Separate function to
 // allow caching of generated posts.
 
-//line board.html:94
+//line board.html:103
 func StreamCatalogThreads(qw422016 *qt422016.Writer, b []common.Thread, json []byte) {
-	//line board.html:94
+	//line board.html:103
 	qw422016.N().S(`<div id="catalog">`)
-	//line board.html:96
+	//line board.html:105
 	for _, t := range b {
-		//line board.html:97
+		//line board.html:106
 		boardConfig := config.GetBoardConfigs(t.Board)
 
-		//line board.html:98
+		//line board.html:107
 		idStr := strconv.FormatUint(t.ID, 10)
 
-		//line board.html:99
+		//line board.html:108
 		hasImage := t.Image != nil && t.Image.ThumbType != common.NoFile
 
-		//line board.html:99
+		//line board.html:108
 		qw422016.N().S(`<article id="p`)
-		//line board.html:100
+		//line board.html:109
 		qw422016.N().S(idStr)
-		//line board.html:100
+		//line board.html:109
 		qw422016.N().S(`"`)
-		//line board.html:100
+		//line board.html:109
 		qw422016.N().S(` `)
-		//line board.html:100
+		//line board.html:109
 		streampostClass(qw422016, t.Post, t.ID)
-		//line board.html:100
+		//line board.html:109
 		qw422016.N().S(` `)
-		//line board.html:100
+		//line board.html:109
 		qw422016.N().S(`data-id="`)
-		//line board.html:100
+		//line board.html:109
 		qw422016.N().S(idStr)
-		//line board.html:100
+		//line board.html:109
 		qw422016.N().S(`">`)
-		//line board.html:101
+		//line board.html:110
 		streamdeletedToggle(qw422016)
-		//line board.html:102
+		//line board.html:111
 		if hasImage {
-			//line board.html:102
+			//line board.html:111
 			qw422016.N().S(`<figure>`)
-			//line board.html:104
+			//line board.html:113
 			img := *t.Image
 
-			//line board.html:104
+			//line board.html:113
 			qw422016.N().S(`<a href="/`)
-			//line board.html:105
+			//line board.html:114
 			qw422016.N().S(t.Board)
-			//line board.html:105
+			//line board.html:114
 			qw422016.N().S(`/`)
-			//line board.html:105
+			//line board.html:114
 			qw422016.N().S(idStr)
-			//line board.html:105
+			//line board.html:114
 			qw422016.N().S(`">`)
-			//line board.html:106
+			//line board.html:115
 			if img.Spoiler {
-				//line board.html:106
+				//line board.html:115
 				qw422016.N().S(`<img src="/assets/spoil/default.jpg" width="150" height="150" class="catalog">`)
-				//line board.html:108
+				//line board.html:117
 			} else {
-				//line board.html:108
+				//line board.html:117
 				qw422016.N().S(`<img width="`)
-				//line board.html:109
+				//line board.html:118
 				qw422016.N().S(strconv.FormatUint(uint64(img.Dims[2]), 10))
-				//line board.html:109
+				//line board.html:118
 				qw422016.N().S(`" height="`)
-				//line board.html:109
+				//line board.html:118
 				qw422016.N().S(strconv.FormatUint(uint64(img.Dims[3]), 10))
-				//line board.html:109
+				//line board.html:118
 				qw422016.N().S(`" class="catalog" src="`)
-				//line board.html:109
+				//line board.html:118
 				qw422016.N().S(assets.ThumbPath(img.ThumbType, img.SHA1))
-				//line board.html:109
+				//line board.html:118
 				qw422016.N().S(`">`)
-				//line board.html:110
+				//line board.html:119
 			}
-			//line board.html:110
+			//line board.html:119
 			qw422016.N().S(`</a></figure>`)
-			//line board.html:113
+			//line board.html:122
 		}
-		//line board.html:113
+		//line board.html:122
 		qw422016.N().S(`<span class="spaced thread-links hide-empty"><b class="board">/`)
-		//line board.html:116
+		//line board.html:125
 		qw422016.N().S(t.Board)
-		//line board.html:116
+		//line board.html:125
 		qw422016.N().S(`/</b><span class="counters">`)
-		//line board.html:119
+		//line board.html:128
 		qw422016.N().S(strconv.FormatUint(uint64(t.PostCount), 10))
-		//line board.html:119
+		//line board.html:128
 		qw422016.N().S(`/`)
-		//line board.html:121
+		//line board.html:130
 		qw422016.N().S(strconv.FormatUint(uint64(t.ImageCount), 10))
-		//line board.html:121
+		//line board.html:130
 		qw422016.N().S(`</span>`)
-		//line board.html:123
+		//line board.html:132
 		if !hasImage {
-			//line board.html:124
+			//line board.html:133
 			streamexpandLink(qw422016, t.Board, idStr)
-			//line board.html:125
+			//line board.html:134
 		}
-		//line board.html:126
+		//line board.html:135
 		streamlast100Link(qw422016, t.Board, idStr)
-		//line board.html:127
+		//line board.html:136
 		streamthreadWatcherToggle(qw422016, t.ID)
-		//line board.html:127
+		//line board.html:136
 		qw422016.N().S(`</span><br><h3>「`)
-		//line board.html:131
+		//line board.html:140
 		qw422016.E().S(t.Subject)
-		//line board.html:131
+		//line board.html:140
 		qw422016.N().S(`」</h3><blockquote>`)
-		//line board.html:134
+		//line board.html:143
 		streambody(qw422016, t.Post, t.ID, t.Board, false, boardConfig.RbText, boardConfig.Pyu)
-		//line board.html:134
+		//line board.html:143
 		qw422016.N().S(`</blockquote></article>`)
-		//line board.html:137
+		//line board.html:146
 	}
-	//line board.html:137
+	//line board.html:146
 	qw422016.N().S(`<script id="post-data" type="application/json">`)
-	//line board.html:139
+	//line board.html:148
 	qw422016.N().Z(json)
-	//line board.html:139
+	//line board.html:148
 	qw422016.N().S(`</script></div>`)
-//line board.html:142
+//line board.html:151
 }
 
-//line board.html:142
+//line board.html:151
 func WriteCatalogThreads(qq422016 qtio422016.Writer, b []common.Thread, json []byte) {
-	//line board.html:142
+	//line board.html:151
 	qw422016 := qt422016.AcquireWriter(qq422016)
-	//line board.html:142
+	//line board.html:151
 	StreamCatalogThreads(qw422016, b, json)
-	//line board.html:142
+	//line board.html:151
 	qt422016.ReleaseWriter(qw422016)
-//line board.html:142
+//line board.html:151
 }
 
-//line board.html:142
+//line board.html:151
 func CatalogThreads(b []common.Thread, json []byte) string {
-	//line board.html:142
+	//line board.html:151
 	qb422016 := qt422016.AcquireByteBuffer()
-	//line board.html:142
+	//line board.html:151
 	WriteCatalogThreads(qb422016, b, json)
-	//line board.html:142
+	//line board.html:151
 	qs422016 := string(qb422016.B)
-	//line board.html:142
+	//line board.html:151
 	qt422016.ReleaseByteBuffer(qb422016)
-	//line board.html:142
+	//line board.html:151
 	return qs422016
-//line board.html:142
+//line board.html:151
 }
 
 // IndexThreads renders abbreviated threads for display on board index pages
 
-//line board.html:145
+//line board.html:154
 func StreamIndexThreads(qw422016 *qt422016.Writer, threads []common.Thread, json []byte) {
-	//line board.html:146
+	//line board.html:155
 	root := config.Get().RootURL
 
-	//line board.html:147
+	//line board.html:156
 	bls := extractBacklinks(15*6, threads...)
 
-	//line board.html:147
+	//line board.html:156
 	qw422016.N().S(`<div id="index-thread-container">`)
-	//line board.html:149
+	//line board.html:158
 	for _, t := range threads {
-		//line board.html:150
+		//line board.html:159
 		idStr := strconv.FormatUint(t.ID, 10)
 
-		//line board.html:150
+		//line board.html:159
 		qw422016.N().S(`<section class="index-thread`)
-		//line board.html:151
+		//line board.html:160
 		if t.IsDeleted() {
-			//line board.html:151
+			//line board.html:160
 			qw422016.N().S(` `)
-			//line board.html:151
+			//line board.html:160
 			qw422016.N().S(`deleted`)
-			//line board.html:151
+			//line board.html:160
 		}
-		//line board.html:151
+		//line board.html:160
 		qw422016.N().S(`" data-id="`)
-		//line board.html:151
+		//line board.html:160
 		qw422016.N().S(idStr)
-		//line board.html:151
+		//line board.html:160
 		qw422016.N().S(`">`)
-		//line board.html:152
+		//line board.html:161
 		streamdeletedToggle(qw422016)
-		//line board.html:153
+		//line board.html:162
 		streamrenderThreadPosts(qw422016, t, bls, root, true)
-		//line board.html:153
+		//line board.html:162
 		qw422016.N().S(`<hr></section>`)
-		//line board.html:156
+		//line board.html:165
 	}
-	//line board.html:156
+	//line board.html:165
 	qw422016.N().S(`<script id="post-data" type="application/json">`)
-	//line board.html:158
+	//line board.html:167
 	qw422016.N().Z(json)
-	//line board.html:158
+	//line board.html:167
 	qw422016.N().S(`</script>`)
-	//line board.html:160
+	//line board.html:169
 	streamencodeBacklinks(qw422016, bls)
-	//line board.html:160
+	//line board.html:169
 	qw422016.N().S(`</div>`)
-//line board.html:162
+//line board.html:171
 }
 
-//line board.html:162
+//line board.html:171
 func WriteIndexThreads(qq422016 qtio422016.Writer, threads []common.Thread, json []byte) {
-	//line board.html:162
+	//line board.html:171
 	qw422016 := qt422016.AcquireWriter(qq422016)
-	//line board.html:162
+	//line board.html:171
 	StreamIndexThreads(qw422016, threads, json)
-	//line board.html:162
+	//line board.html:171
 	qt422016.ReleaseWriter(qw422016)
-//line board.html:162
+//line board.html:171
 }
 
-//line board.html:162
+//line board.html:171
 func IndexThreads(threads []common.Thread, json []byte) string {
-	//line board.html:162
+	//line board.html:171
 	qb422016 := qt422016.AcquireByteBuffer()
-	//line board.html:162
+	//line board.html:171
 	WriteIndexThreads(qb422016, threads, json)
-	//line board.html:162
+	//line board.html:171
 	qs422016 := string(qb422016.B)
-	//line board.html:162
+	//line board.html:171
 	qt422016.ReleaseByteBuffer(qb422016)
-	//line board.html:162
+	//line board.html:171
 	return qs422016
-//line board.html:162
+//line board.html:171
 }
 
 // Render noscript-specific post creation fields
 
-//line board.html:165
+//line board.html:174
 func streamnoscriptPostCreationFields(qw422016 *qt422016.Writer, pos common.ModerationLevel) {
-	//line board.html:166
+	//line board.html:175
 	ln := lang.Get()
 
-	//line board.html:167
+	//line board.html:176
 	if pos > common.NotStaff {
-		//line board.html:168
+		//line board.html:177
 		streaminput(qw422016, staffTitleSpec.wrap(), ln)
-		//line board.html:169
+		//line board.html:178
 	}
-	//line board.html:170
+	//line board.html:179
 	for _, s := range specs["noscriptPostCreation"] {
-		//line board.html:171
+		//line board.html:180
 		streaminput(qw422016, s, ln)
-		//line board.html:172
+		//line board.html:181
 	}
-//line board.html:173
+//line board.html:182
 }
 
-//line board.html:173
+//line board.html:182
 func writenoscriptPostCreationFields(qq422016 qtio422016.Writer, pos common.ModerationLevel) {
-	//line board.html:173
+	//line board.html:182
 	qw422016 := qt422016.AcquireWriter(qq422016)
-	//line board.html:173
+	//line board.html:182
 	streamnoscriptPostCreationFields(qw422016, pos)
-	//line board.html:173
+	//line board.html:182
 	qt422016.ReleaseWriter(qw422016)
-//line board.html:173
+//line board.html:182
 }
 
-//line board.html:173
+//line board.html:182
 func noscriptPostCreationFields(pos common.ModerationLevel) string {
-	//line board.html:173
+	//line board.html:182
 	qb422016 := qt422016.AcquireByteBuffer()
-	//line board.html:173
+	//line board.html:182
 	writenoscriptPostCreationFields(qb422016, pos)
-	//line board.html:173
+	//line board.html:182
 	qs422016 := string(qb422016.B)
-	//line board.html:173
+	//line board.html:182
 	qt422016.ReleaseByteBuffer(qb422016)
-	//line board.html:173
+	//line board.html:182
 	return qs422016
-//line board.html:173
+//line board.html:182
 }
 
 // Render image upload form
 
-//line board.html:176
+//line board.html:185
 func streamuploadForm(qw422016 *qt422016.Writer) {
-	//line board.html:176
+	//line board.html:185
 	qw422016.N().S(`<span class="upload-container"><span data-id="spoiler"><label><input type="checkbox" name="spoiler">`)
-	//line board.html:181
+	//line board.html:190
 	qw422016.N().S(lang.Get().Common.Posts["spoiler"])
-	//line board.html:181
+	//line board.html:190
 	qw422016.N().S(`</label></span><br><input type="file" name="image" accept="image/png, image/gif, image/jpeg, video/webm, video/ogg, audio/ogg, application/ogg, video/mp4, audio/mp4, audio/mp3, application/zip, application/x-7z-compressed, application/x-xz, application/x-gzip, audio/x-flac, text/plain, application/pdf, video/quicktime, audio/x-flac"><br></span>`)
-//line board.html:188
+//line board.html:197
 }
 
-//line board.html:188
+//line board.html:197
 func writeuploadForm(qq422016 qtio422016.Writer) {
-	//line board.html:188
+	//line board.html:197
 	qw422016 := qt422016.AcquireWriter(qq422016)
-	//line board.html:188
+	//line board.html:197
 	streamuploadForm(qw422016)
-	//line board.html:188
+	//line board.html:197
 	qt422016.ReleaseWriter(qw422016)
-//line board.html:188
+//line board.html:197
 }
 
-//line board.html:188
+//line board.html:197
 func uploadForm() string {
-	//line board.html:188
+	//line board.html:197
 	qb422016 := qt422016.AcquireByteBuffer()
-	//line board.html:188
+	//line board.html:197
 	writeuploadForm(qb422016)
-	//line board.html:188
+	//line board.html:197
 	qs422016 := string(qb422016.B)
-	//line board.html:188
+	//line board.html:197
 	qt422016.ReleaseByteBuffer(qb422016)
-	//line board.html:188
+	//line board.html:197
 	return qs422016
-//line board.html:188
+//line board.html:197
 }
 
 // Link to catalog or board page
 
-//line board.html:191
+//line board.html:200
 func streamcatalogLink(qw422016 *qt422016.Writer, catalog bool) {
-	//line board.html:192
+	//line board.html:201
 	ln := lang.Get().Common.UI
 
-	//line board.html:192
+	//line board.html:201
 	qw422016.N().S(`<aside class="act glass">`)
-	//line board.html:194
+	//line board.html:203
 	if catalog {
-		//line board.html:194
+		//line board.html:203
 		qw422016.N().S(`<a href=".">`)
-		//line board.html:196
+		//line board.html:205
 		qw422016.N().S(ln["return"])
-		//line board.html:196
+		//line board.html:205
 		qw422016.N().S(`</a>`)
-		//line board.html:198
+		//line board.html:207
 	} else {
-		//line board.html:198
+		//line board.html:207
 		qw422016.N().S(`<a href="catalog">`)
-		//line board.html:200
+		//line board.html:209
 		qw422016.N().S(ln["catalog"])
-		//line board.html:200
+		//line board.html:209
 		qw422016.N().S(`</a>`)
-		//line board.html:202
+		//line board.html:211
 	}
-	//line board.html:202
+	//line board.html:211
 	qw422016.N().S(`</aside>`)
-//line board.html:204
+//line board.html:213
 }
 
-//line board.html:204
+//line board.html:213
 func writecatalogLink(qq422016 qtio422016.Writer, catalog bool) {
-	//line board.html:204
+	//line board.html:213
 	qw422016 := qt422016.AcquireWriter(qq422016)
-	//line board.html:204
+	//line board.html:213
 	streamcatalogLink(qw422016, catalog)
-	//line board.html:204
+	//line board.html:213
 	qt422016.ReleaseWriter(qw422016)
-//line board.html:204
+//line board.html:213
 }
 
-//line board.html:204
+//line board.html:213
 func catalogLink(catalog bool) string {
-	//line board.html:204
+	//line board.html:213
 	qb422016 := qt422016.AcquireByteBuffer()
-	//line board.html:204
+	//line board.html:213
 	writecatalogLink(qb422016, catalog)
-	//line board.html:204
+	//line board.html:213
 	qs422016 := string(qb422016.B)
-	//line board.html:204
+	//line board.html:213
 	qt422016.ReleaseByteBuffer(qb422016)
-	//line board.html:204
+	//line board.html:213
 	return qs422016
-//line board.html:204
+//line board.html:213
 }
 
 // Links to different pages of the board index
 
-//line board.html:207
+//line board.html:216
 func streampagination(qw422016 *qt422016.Writer, page, total int) {
-	//line board.html:207
+	//line board.html:216
 	qw422016.N().S(`<aside class="glass spaced">`)
-	//line board.html:209
+	//line board.html:218
 	if page != 0 {
-		//line board.html:210
+		//line board.html:219
 		if page-1 != 0 {
-			//line board.html:211
+			//line board.html:220
 			streampageLink(qw422016, 0, "<<")
-			//line board.html:212
+			//line board.html:221
 		}
-		//line board.html:213
+		//line board.html:222
 		streampageLink(qw422016, page-1, "<")
-		//line board.html:214
+		//line board.html:223
 	}
-	//line board.html:215
+	//line board.html:224
 	for i := 0; i < total; i++ {
-		//line board.html:216
+		//line board.html:225
 		if i != page {
-			//line board.html:217
+			//line board.html:226
 			streampageLink(qw422016, i, strconv.Itoa(i))
-			//line board.html:218
+			//line board.html:227
 		} else {
-			//line board.html:218
+			//line board.html:227
 			qw422016.N().S(`<b>`)
-			//line board.html:220
+			//line board.html:229
 			qw422016.N().D(i)
-			//line board.html:220
+			//line board.html:229
 			qw422016.N().S(`</b>`)
-			//line board.html:222
+			//line board.html:231
 		}
-		//line board.html:223
+		//line board.html:232
 	}
-	//line board.html:224
+	//line board.html:233
 	if page != total-1 {
-		//line board.html:225
+		//line board.html:234
 		streampageLink(qw422016, page+1, ">")
-		//line board.html:226
+		//line board.html:235
 		if page+1 != total-1 {
-			//line board.html:227
+			//line board.html:236
 			streampageLink(qw422016, total-1, ">>")
-			//line board.html:228
+			//line board.html:237
 		}
-		//line board.html:229
+		//line board.html:238
 	}
-	//line board.html:229
+	//line board.html:238
 	qw422016.N().S(`</aside>`)
-//line board.html:231
+//line board.html:240
 }
 
-//line board.html:231
+//line board.html:240
 func writepagination(qq422016 qtio422016.Writer, page, total int) {
-	//line board.html:231
+	//line board.html:240
 	qw422016 := qt422016.AcquireWriter(qq422016)
-	//line board.html:231
+	//line board.html:240
 	streampagination(qw422016, page, total)
-	//line board.html:231
+	//line board.html:240
 	qt422016.ReleaseWriter(qw422016)
-//line board.html:231
+//line board.html:240
 }
 
-//line board.html:231
+//line board.html:240
 func pagination(page, total int) string {
-	//line board.html:231
+	//line board.html:240
 	qb422016 := qt422016.AcquireByteBuffer()
-	//line board.html:231
+	//line board.html:240
 	writepagination(qb422016, page, total)
-	//line board.html:231
+	//line board.html:240
 	qs422016 := string(qb422016.B)
-	//line board.html:231
+	//line board.html:240
 	qt422016.ReleaseByteBuffer(qb422016)
-	//line board.html:231
+	//line board.html:240
 	return qs422016
-//line board.html:231
+//line board.html:240
 }
 
 // Link to a different paginated board page
 
-//line board.html:234
+//line board.html:243
 func streampageLink(qw422016 *qt422016.Writer, i int, text string) {
-	//line board.html:234
+	//line board.html:243
 	qw422016.N().S(`<a href="?page=`)
-	//line board.html:235
+	//line board.html:244
 	qw422016.N().D(i)
-	//line board.html:235
+	//line board.html:244
 	qw422016.N().S(`">`)
-	//line board.html:236
+	//line board.html:245
 	qw422016.N().S(text)
-	//line board.html:236
+	//line board.html:245
 	qw422016.N().S(`</a>`)
-//line board.html:238
+//line board.html:247
 }
 
-//line board.html:238
+//line board.html:247
 func writepageLink(qq422016 qtio422016.Writer, i int, text string) {
-	//line board.html:238
+	//line board.html:247
 	qw422016 := qt422016.AcquireWriter(qq422016)
-	//line board.html:238
+	//line board.html:247
 	streampageLink(qw422016, i, text)
-	//line board.html:238
+	//line board.html:247
 	qt422016.ReleaseWriter(qw422016)
-//line board.html:238
+//line board.html:247
 }
 
-//line board.html:238
+//line board.html:247
 func pageLink(i int, text string) string {
-	//line board.html:238
+	//line board.html:247
 	qb422016 := qt422016.AcquireByteBuffer()
-	//line board.html:238
+	//line board.html:247
 	writepageLink(qb422016, i, text)
-	//line board.html:238
+	//line board.html:247
 	qs422016 := string(qb422016.B)
-	//line board.html:238
+	//line board.html:247
 	qt422016.ReleaseByteBuffer(qb422016)
-	//line board.html:238
+	//line board.html:247
+	return qs422016
+//line board.html:247
+}
+
+// SimilarThreads renders a page listing the existing threads similar to one a
+// client without JavaScript tried to create
+
+//line board.html:251
+func StreamSimilarThreads(qw422016 *qt422016.Writer, threads []common.SimilarThread) {
+	//line board.html:252
+	streamhtmlHeader(qw422016)
+	//line board.html:253
+	ln := lang.Get()
+
+	//line board.html:253
+	qw422016.N().S(`<div class="glass">`)
+	//line board.html:255
+	qw422016.N().S(ln.UI["similarThreads"])
+	//line board.html:255
+	qw422016.N().S(`<ul>`)
+	//line board.html:257
+	for _, t := range threads {
+		//line board.html:257
+		qw422016.N().S(`<li><a href="/`)
+		//line board.html:259
+		qw422016.N().S(t.Board)
+		//line board.html:259
+		qw422016.N().S(`/`)
+		//line board.html:259
+		qw422016.N().S(strconv.FormatUint(t.ID, 10))
+		//line board.html:259
+		qw422016.N().S(`">`)
+		//line board.html:260
+		qw422016.E().S(t.Subject)
+		//line board.html:260
+		qw422016.N().S(`</a></li>`)
+		//line board.html:263
+	}
+	//line board.html:263
+	qw422016.N().S(`</ul></div>`)
+	//line board.html:266
+	streamhtmlEnd(qw422016)
+//line board.html:267
+}
+
+//line board.html:267
+func WriteSimilarThreads(qq422016 qtio422016.Writer, threads []common.SimilarThread) {
+	//line board.html:267
+	qw422016 := qt422016.AcquireWriter(qq422016)
+	//line board.html:267
+	StreamSimilarThreads(qw422016, threads)
+	//line board.html:267
+	qt422016.ReleaseWriter(qw422016)
+//line board.html:267
+}
+
+//line board.html:267
+func SimilarThreads(threads []common.SimilarThread) string {
+	//line board.html:267
+	qb422016 := qt422016.AcquireByteBuffer()
+	//line board.html:267
+	WriteSimilarThreads(qb422016, threads)
+	//line board.html:267
+	qs422016 := string(qb422016.B)
+	//line board.html:267
+	qt422016.ReleaseByteBuffer(qb422016)
+	//line board.html:267
 	return qs422016
-//line board.html:238
+//line board.html:267
 }
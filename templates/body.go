@@ -27,6 +27,7 @@ const (
 var (
 	linkRegexp      = regexp.MustCompile(`^>>(>*)(\d+)$`)
 	referenceRegexp = regexp.MustCompile(`^>>>(>*)\/(\w+)\/$`)
+	crossLinkRegexp = regexp.MustCompile(`^>>>(>*)\/(\w+)\/(\d+)$`)
 
 	providers = map[int]string{
 		youTube:    "YouTube",
@@ -408,6 +409,10 @@ func (c *bodyContext) parseFragment(frag string) {
 				// Internal and custom reference URLs
 				c.parseReference(m)
 				goto end
+			} else if m := crossLinkRegexp.FindStringSubmatch(word); m != nil {
+				// Cross-board post links
+				c.parseCrossLink(m)
+				goto end
 			}
 			fallthrough
 		default:
@@ -470,6 +475,27 @@ func (c *bodyContext) parsePostLink(m []string) {
 	streampostLink(&c.Writer, data, c.index || data.OP != c.OP, c.index)
 }
 
+// Parse a potential link to a post on a specific board
+func (c *bodyContext) parseCrossLink(m []string) {
+	id, _ := strconv.ParseUint(m[3], 10, 64)
+	var data common.Link
+	for _, l := range c.Links {
+		if l.ID == id && l.Board == m[2] {
+			data = l
+			break
+		}
+	}
+	if data.ID == 0 {
+		c.string(m[0])
+		return
+	}
+
+	if len(m[1]) != 0 {
+		c.string(m[1])
+	}
+	streampostLink(&c.Writer, data, true, c.index)
+}
+
 // Parse internal or customly set reference URL
 func (c *bodyContext) parseReference(m []string) {
 	var (
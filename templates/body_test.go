@@ -288,6 +288,20 @@ func TestRenderBody(t *testing.T) {
 			op:    20,
 			links: []common.Link{{21, 22, "c"}},
 		},
+		{
+			name:  "valid cross-board link",
+			in:    ">>>/c/21",
+			out:   `<em><a class="post-link" data-id="21" href="/c/22#p21">>>21 ➡</a><a class="hash-link" href="/c/22#p21"> #</a></em>`,
+			op:    20,
+			links: []common.Link{{21, 22, "c"}},
+		},
+		{
+			name:  "cross-board link to wrong board",
+			in:    ">>>/a/21",
+			out:   `<em>>>>/a/21</em>`,
+			op:    20,
+			links: []common.Link{{21, 22, "c"}},
+		},
 		{
 			name: "invalid reference",
 			in:   ">>>/fufufu/",
@@ -245,6 +245,7 @@ var countryMap = map[string]string{"ad": "Andorra",
 	"vu": "Vanuatu",
 	"wf": "Wallis and Futuna",
 	"ws": "Samoa",
+	"xx": "Private network",
 	"ye": "Yemen",
 	"yt": "Mayotte",
 	"za": "South Africa",
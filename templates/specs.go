@@ -3,6 +3,8 @@
 package templates
 
 import (
+	"compress/flate"
+
 	"github.com/bakape/meguca/common"
 )
 
@@ -21,8 +23,12 @@ var (
 		Required:     true,
 		Autocomplete: "new-password",
 	}
-	sageSpec         = inputSpec{ID: "sage"}
-	staffTitleSpec   = inputSpec{ID: "staffTitle"}
+	sageSpec       = inputSpec{ID: "sage"}
+	staffTitleSpec = inputSpec{ID: "staffTitle"}
+	fileTypesSpec  = inputSpec{
+		ID:   "fileTypes",
+		Type: _array,
+	}
 	defaultThemeSpec = inputSpec{
 		ID:      "defaultCSS",
 		Type:    _select,
@@ -119,11 +125,68 @@ var specs = map[string][]inputSpec{
 		{ID: "textOnly"},
 		{ID: "forcedAnon"},
 		{ID: "disableRobots"},
+		{ID: "noDuplicateImages"},
+		{ID: "disableAudio"},
+		{ID: "disablePDF"},
 		{ID: "flags"},
 		{ID: "NSFW"},
 		{ID: "rbText"},
+		{ID: "posterIDs"},
+		{ID: "threadTags"},
 		{Type: _hr},
 		{ID: "pyu"},
+		{
+			ID:   "threadsPerPage",
+			Type: _number,
+			Min:  1,
+			Max:  common.MaxThreadsPerPage,
+		},
+		{
+			ID:   "floodPosts",
+			Type: _number,
+			Min:  0,
+			Max:  common.MaxFloodPosts,
+		},
+		{
+			ID:   "floodInterval",
+			Type: _number,
+			Min:  1,
+			Max:  common.MaxFloodInterval,
+		},
+		{
+			ID:   "postCooldown",
+			Type: _number,
+			Min:  0,
+			Max:  common.MaxPostCooldown,
+		},
+		{
+			ID:   "defaultLastN",
+			Type: _number,
+			Min:  0,
+			Max:  common.MaxDefaultLastN,
+		},
+		{
+			ID:   "imageLimit",
+			Type: _number,
+			Min:  0,
+			Max:  common.BumpLimit,
+		},
+		{
+			ID:   "maxReplies",
+			Type: _number,
+			Min:  0,
+			Max:  common.BumpLimit,
+		},
+		{
+			ID:   "maxVideoLength",
+			Type: _number,
+			Min:  0,
+		},
+		{
+			ID:   "maxPDFSize",
+			Type: _number,
+			Min:  0,
+		},
 		{
 			ID:        "title",
 			Type:      _string,
@@ -142,11 +205,23 @@ var specs = map[string][]inputSpec{
 			MaxLength: common.MaxLenRules,
 		},
 		defaultThemeSpec,
+		{
+			ID:        "customCSS",
+			Type:      _textarea,
+			Rows:      10,
+			MaxLength: common.MaxLenCustomCSS,
+		},
 		{
 			ID:        "eightball",
 			Type:      _array,
 			MaxLength: common.MaxLenEightball,
 		},
+		{
+			ID:        "tags",
+			Type:      _array,
+			MaxLength: common.MaxLenTag,
+		},
+		fileTypesSpec,
 	},
 	"createBoard": {
 		{
@@ -167,8 +242,10 @@ var specs = map[string][]inputSpec{
 		{ID: "mature"},
 		{ID: "hideNSFW"},
 		{ID: "disableUserBoards"},
+		{ID: "noDuplicateImages"},
 		{Type: _hr},
 		{ID: "pruneThreads"},
+		{ID: "archiveThreads"},
 		{
 			ID:       "threadExpiryMin",
 			Type:     _number,
@@ -197,6 +274,69 @@ var specs = map[string][]inputSpec{
 			ID:   "imageRootOverride",
 			Type: _string,
 		},
+		{
+			ID:   "metricsToken",
+			Type: _string,
+		},
+		{
+			ID:   "corsOrigins",
+			Type: _array,
+		},
+		{ID: "corsCredentials"},
+		{Type: _hr},
+		{
+			ID:   "readRateLimit",
+			Type: _number,
+			Min:  0,
+		},
+		{
+			ID:   "readBurst",
+			Type: _number,
+			Min:  0,
+		},
+		{
+			ID:   "postRateLimit",
+			Type: _number,
+			Min:  0,
+		},
+		{
+			ID:   "postBurst",
+			Type: _number,
+			Min:  0,
+		},
+		{
+			ID:   "uploadRateLimit",
+			Type: _number,
+			Min:  0,
+		},
+		{
+			ID:   "uploadBurst",
+			Type: _number,
+			Min:  0,
+		},
+		{
+			ID:   "replayBufferSize",
+			Type: _number,
+			Min:  1,
+			Max:  common.MaxReplayBuffer,
+		},
+		{
+			ID:   "compressionLevel",
+			Type: _number,
+			Min:  flate.HuffmanOnly,
+			Max:  flate.BestCompression,
+		},
+		{ID: "subnetRateLimit"},
+		{Type: _hr},
+		{ID: "disableGeoIP"},
+		{
+			ID:   "geoIPPath",
+			Type: _string,
+		},
+		{
+			ID:   "fortunesPath",
+			Type: _string,
+		},
 		{Type: _hr},
 		{
 			ID:   "salt",
@@ -235,6 +375,12 @@ var specs = map[string][]inputSpec{
 			Min:      1,
 			Required: true,
 		},
+		{
+			ID:       "postEditWindow",
+			Type:     _number,
+			Min:      0,
+			Required: true,
+		},
 		{Type: _hr},
 		{ID: "emailErr"},
 		{
@@ -292,6 +438,7 @@ var specs = map[string][]inputSpec{
 			Min:      1,
 			Required: true,
 		},
+		fileTypesSpec,
 		{Type: _hr},
 		{
 			ID:   "FAQ",
@@ -21,7 +21,7 @@ func init() {
 }
 
 var (
-	indexTemplates map[common.ModerationLevel][4][]byte
+	indexTemplates map[common.ModerationLevel][5][]byte
 	mu             sync.RWMutex
 )
 
@@ -32,11 +32,11 @@ func Compile() error {
 		common.BoardOwner, common.Admin,
 	}
 
-	t := make(map[common.ModerationLevel][4][]byte, len(levels))
+	t := make(map[common.ModerationLevel][5][]byte, len(levels))
 
 	for _, pos := range levels {
 		split := bytes.Split([]byte(renderIndex(pos)), []byte("$$$"))
-		t[pos] = [4][]byte{split[0], split[1], split[2], split[3]}
+		t[pos] = [5][]byte{split[0], split[1], split[2], split[3], split[4]}
 	}
 
 	mu.Lock()
@@ -66,10 +66,14 @@ func Board(w io.Writer, b, theme string, page, total int,
 			catalog)
 	}
 
+	var amp string
+	if !catalog {
+		amp = "/amp/board/" + b
+	}
 	if minimal {
 		write(w)
 	} else {
-		execIndex(w, title, theme, pos, write)
+		execIndex(w, b, title, theme, amp, pos, write)
 	}
 }
 
@@ -78,14 +82,16 @@ func Thread(w io.Writer, id uint64, board, title, theme string, abbrev,
 	locked bool, pos common.ModerationLevel, postHTML []byte,
 ) {
 	title = html.EscapeString(fmt.Sprintf("/%s/ - %s", board, title))
-	execIndex(w, title, theme, pos, func(w io.Writer) {
+	amp := fmt.Sprintf("/amp/thread/%d", id)
+	execIndex(w, board, title, theme, amp, pos, func(w io.Writer) {
 		writerenderThread(w, postHTML, id, board, abbrev, locked, pos)
 	})
 }
 
-// Execute and index template in the second pass
-func execIndex(w io.Writer, title, theme string, pos common.ModerationLevel,
-	fn func(w io.Writer),
+// Execute and index template in the second pass. amp is the path of the AMP
+// version of the page, if any.
+func execIndex(w io.Writer, board, title, theme, amp string,
+	pos common.ModerationLevel, fn func(w io.Writer),
 ) {
 	mu.RLock()
 	t := indexTemplates[pos]
@@ -96,6 +102,14 @@ func execIndex(w io.Writer, title, theme string, pos common.ModerationLevel,
 	w.Write(t[1])
 	w.Write([]byte(theme))
 	w.Write(t[2])
-	fn(w)
+	if config.GetBoardConfigs(board).CustomCSS != "" {
+		fmt.Fprintf(w, `<link rel="stylesheet" href="/boards/%s/custom.css">`,
+			board)
+	}
+	if amp != "" {
+		fmt.Fprintf(w, `<link rel="amphtml" href="%s">`, amp)
+	}
 	w.Write(t[3])
+	fn(w)
+	w.Write(t[4])
 }
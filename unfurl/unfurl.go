@@ -0,0 +1,277 @@
+// Package unfurl fetches Open Graph metadata of URLs posted in post bodies
+package unfurl
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bakape/meguca/common"
+	"golang.org/x/net/html"
+)
+
+const (
+	timeout      = 5 * time.Second
+	maxRedirects = 3
+	maxBodySize  = 1 << 20 // Only the head of the document is of interest
+
+	// Maximum lengths of stored metadata fields
+	maxLenTitle       = 200
+	maxLenDescription = 500
+	maxLenURL         = 2000
+)
+
+var (
+	errPrivateAddress   = errors.New("unfurl: refusing to connect to private address")
+	errTooManyRedirects = errors.New("unfurl: too many redirects")
+	errInvalidScheme    = errors.New("unfurl: only HTTP(S) URLs supported")
+	errNotHTML          = errors.New("unfurl: not an HTML document")
+
+	// Networks, that must never be reachable through user-submitted URLs
+	blockedNetworks = func() []*net.IPNet {
+		cidrs := [...]string{
+			"0.0.0.0/8",
+			"10.0.0.0/8",
+			"100.64.0.0/10",
+			"127.0.0.0/8",
+			"169.254.0.0/16",
+			"172.16.0.0/12",
+			"192.0.0.0/24",
+			"192.168.0.0/16",
+			"198.18.0.0/15",
+			"224.0.0.0/4",
+			"240.0.0.0/4",
+			"::/128",
+			"::1/128",
+			"fc00::/7",
+			"fe80::/10",
+			"ff00::/8",
+		}
+		nets := make([]*net.IPNet, len(cidrs))
+		for i, c := range cidrs {
+			_, n, err := net.ParseCIDR(c)
+			if err != nil {
+				panic(err)
+			}
+			nets[i] = n
+		}
+		return nets
+	}()
+
+	client = &http.Client{
+		Timeout:       timeout,
+		CheckRedirect: checkRedirect,
+		Transport: &http.Transport{
+			// Addresses are checked after DNS resolution right before
+			// connecting, so DNS rebinding can not be used to get around
+			// the check
+			DialContext: (&net.Dialer{
+				Timeout: timeout,
+				Control: RefuseBlocked,
+			}).DialContext,
+			TLSHandshakeTimeout:   timeout,
+			ResponseHeaderTimeout: timeout,
+			MaxIdleConns:          10,
+			IdleConnTimeout:       30 * time.Second,
+		},
+	}
+)
+
+// ExtractURLs returns the first common.MaxNumEmbeds unique HTTP(S) URLs in a
+// post body
+func ExtractURLs(body string) []string {
+	urls := make([]string, 0, common.MaxNumEmbeds)
+	for _, u := range common.URLRegexp.FindAllString(body, -1) {
+		if len(urls) == common.MaxNumEmbeds {
+			break
+		}
+		u = strings.TrimRight(u, ".,;:!?)'")
+		if len(u) > maxLenURL || contains(urls, u) {
+			continue
+		}
+		urls = append(urls, u)
+	}
+	return urls
+}
+
+func contains(arr []string, s string) bool {
+	for _, a := range arr {
+		if a == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Fetch retrieves the Open Graph metadata of a URL. Fails for URLs, that
+// resolve to private or otherwise non-public addresses.
+func Fetch(u string) (meta common.EmbedMeta, err error) {
+	meta.URL = u
+	if err = checkScheme(u); err != nil {
+		return
+	}
+
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return
+	}
+	req.Header.Set("Accept", "text/html")
+	req.Header.Set("User-Agent", "meguca link preview")
+	res, err := client.Do(req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		err = fmt.Errorf("unfurl: %s: unexpected status %s", u, res.Status)
+		return
+	}
+	mt, _, _ := mime.ParseMediaType(res.Header.Get("Content-Type"))
+	if mt != "text/html" && mt != "application/xhtml+xml" {
+		err = errNotHTML
+		return
+	}
+
+	parseMeta(io.LimitReader(res.Body, maxBodySize), &meta)
+
+	// Resolve relative image URLs against the final URL after redirects
+	if meta.ImageURL != "" {
+		img, err := res.Request.URL.Parse(meta.ImageURL)
+		if err != nil || checkScheme(img.String()) != nil {
+			meta.ImageURL = ""
+		} else {
+			meta.ImageURL = truncate(img.String(), maxLenURL)
+		}
+	}
+	return
+}
+
+// IsEmpty returns, if no preview can be displayed from the metadata
+func IsEmpty(meta common.EmbedMeta) bool {
+	return meta.Title == "" && meta.Description == "" && meta.ImageURL == ""
+}
+
+func checkScheme(u string) error {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return err
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return errInvalidScheme
+	}
+	return nil
+}
+
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) > maxRedirects {
+		return errTooManyRedirects
+	}
+	return checkScheme(req.URL.String())
+}
+
+// RefuseBlocked is a net.Dialer Control function, that refuses to connect to
+// private, loopback and other non-public network addresses
+func RefuseBlocked(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || isBlocked(ip) {
+		return errPrivateAddress
+	}
+	return nil
+}
+
+func isBlocked(ip net.IP) bool {
+	for _, n := range blockedNetworks {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Read Open Graph properties from the head of an HTML document. Falls back to
+// the <title> element, if there is no og:title.
+func parseMeta(r io.Reader, meta *common.EmbedMeta) {
+	var (
+		t       = html.NewTokenizer(r)
+		inTitle bool
+		title   string
+	)
+
+loop:
+	for {
+		switch t.Next() {
+		case html.ErrorToken:
+			break loop
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := t.TagName()
+			switch string(name) {
+			case "body":
+				break loop
+			case "title":
+				inTitle = true
+			case "meta":
+				if !hasAttr {
+					continue
+				}
+				var prop, content string
+				for {
+					key, val, more := t.TagAttr()
+					switch string(key) {
+					case "property", "name":
+						prop = string(val)
+					case "content":
+						content = string(val)
+					}
+					if !more {
+						break
+					}
+				}
+				content = strings.TrimSpace(content)
+				switch prop {
+				case "og:title":
+					meta.Title = truncate(content, maxLenTitle)
+				case "og:description":
+					meta.Description = truncate(content, maxLenDescription)
+				case "og:image":
+					meta.ImageURL = content
+				}
+			}
+		case html.EndTagToken:
+			switch name, _ := t.TagName(); string(name) {
+			case "head":
+				break loop
+			case "title":
+				inTitle = false
+			}
+		case html.TextToken:
+			if inTitle {
+				title += string(t.Text())
+			}
+		}
+	}
+
+	if meta.Title == "" {
+		meta.Title = truncate(strings.TrimSpace(title), maxLenTitle)
+	}
+}
+
+// Truncate a string to at most n runes
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
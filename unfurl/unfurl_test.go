@@ -0,0 +1,123 @@
+package unfurl
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestExtractURLs(t *testing.T) {
+	t.Parallel()
+
+	AssertDeepEquals(t,
+		ExtractURLs(`see https://a.com/x, http://b.org) and https://a.com/x `+
+			`ftp://c.net https://d.io https://e.io`),
+		[]string{"https://a.com/x", "http://b.org", "https://d.io"},
+	)
+	AssertDeepEquals(t, ExtractURLs("no links"), []string{})
+}
+
+func TestParseMeta(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name, in string
+		out      common.EmbedMeta
+	}{
+		{
+			name: "open graph",
+			in: `<html><head><title>ignored</title>
+				<meta property="og:title" content=" Foo ">
+				<meta property="og:description" content="Bar">
+				<meta property="og:image" content="/img.png" />
+				</head><body><meta property="og:title" content="late">`,
+			out: common.EmbedMeta{
+				Title:       "Foo",
+				Description: "Bar",
+				ImageURL:    "/img.png",
+			},
+		},
+		{
+			name: "title fallback",
+			in:   `<html><head><title> Baz </title></head>`,
+			out: common.EmbedMeta{
+				Title: "Baz",
+			},
+		},
+		{
+			name: "truncated",
+			in: `<head><meta property="og:title" content="` +
+				strings.Repeat("a", maxLenTitle+10) + `">`,
+			out: common.EmbedMeta{
+				Title: strings.Repeat("a", maxLenTitle),
+			},
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			var meta common.EmbedMeta
+			parseMeta(strings.NewReader(c.in), &meta)
+			AssertDeepEquals(t, meta, c.out)
+		})
+	}
+}
+
+func TestIsBlocked(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		ip      string
+		blocked bool
+	}{
+		{"127.0.0.1", true},
+		{"10.1.2.3", true},
+		{"169.254.169.254", true},
+		{"192.168.0.1", true},
+		{"::1", true},
+		{"fd00::1", true},
+		{"8.8.8.8", false},
+		{"2001:4860:4860::8888", false},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.ip, func(t *testing.T) {
+			t.Parallel()
+			AssertDeepEquals(t, isBlocked(net.ParseIP(c.ip)), c.blocked)
+		})
+	}
+}
+
+func TestFetchRefusesPrivateAddresses(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/html")
+			w.Write([]byte(`<meta property="og:title" content="secret">`))
+		},
+	))
+	defer srv.Close()
+
+	meta, err := Fetch(srv.URL)
+	if err == nil || !strings.Contains(err.Error(), errPrivateAddress.Error()) {
+		t.Fatalf("expected private address error: %v", err)
+	}
+	AssertDeepEquals(t, meta.Title, "")
+}
+
+func TestFetchInvalidScheme(t *testing.T) {
+	t.Parallel()
+
+	_, err := Fetch("file:///etc/passwd")
+	AssertDeepEquals(t, err, errInvalidScheme)
+}
@@ -0,0 +1,163 @@
+// Package webhooks notifies external services of events on boards by sending
+// signed JSON payloads to registered URLs
+package webhooks
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/unfurl"
+	"github.com/go-playground/log"
+)
+
+// Events webhooks can subscribe to
+const (
+	NewPost   = "newPost"
+	NewThread = "newThread"
+)
+
+// Number of times delivery is retried after a failed attempt
+const maxRetries = 3
+
+var (
+	client = newClient()
+
+	// Initial delay between delivery attempts. Doubled after each failure.
+	// Overridable in tests.
+	retryBase = time.Second
+)
+
+// Create a client, that refuses to connect to private and loopback addresses
+// and does not follow redirects, so webhooks can not be used to reach internal
+// services
+func newClient() *http.Client {
+	return &http.Client{
+		Timeout: 10 * time.Second,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+		Transport: &http.Transport{
+			// Addresses are checked after DNS resolution right before
+			// connecting, so DNS rebinding can not be used to get around
+			// the check
+			DialContext: (&net.Dialer{
+				Timeout: 10 * time.Second,
+				Control: unfurl.RefuseBlocked,
+			}).DialContext,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ResponseHeaderTimeout: 10 * time.Second,
+			MaxIdleConns:          10,
+			IdleConnTimeout:       30 * time.Second,
+		},
+	}
+}
+
+// Payload is the JSON body sent to a webhook
+type Payload struct {
+	Event string                `json:"event"`
+	Board string                `json:"board"`
+	Post  common.StandalonePost `json:"post"`
+}
+
+// IsEvent returns, if s is a valid webhook event name
+func IsEvent(s string) bool {
+	switch s {
+	case NewPost, NewThread:
+		return true
+	default:
+		return false
+	}
+}
+
+// Dispatch asynchronously notifies all of the board's webhooks subscribed to
+// event. Posts deleted on creation are not dispatched.
+func Dispatch(board, event string, post common.StandalonePost) {
+	if post.IsDeleted() {
+		return
+	}
+	hooks := db.BoardWebhooks(board)
+	if len(hooks) == 0 {
+		return
+	}
+
+	buf, err := json.Marshal(Payload{
+		Event: event,
+		Board: board,
+		Post:  post,
+	})
+	if err != nil {
+		log.Errorf("webhook: encoding payload: %s", err)
+		return
+	}
+
+	for _, h := range hooks {
+		if subscribed(h, event) {
+			go deliver(h, buf)
+		}
+	}
+}
+
+func subscribed(h auth.Webhook, event string) bool {
+	for _, e := range h.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Send payload to webhook, retrying with exponential backoff on failure
+func deliver(h auth.Webhook, buf []byte) (err error) {
+	delay := retryBase
+	for i := 0; ; i++ {
+		err = send(h, buf)
+		if err == nil || i == maxRetries {
+			break
+		}
+		time.Sleep(delay)
+		delay *= 2
+	}
+	if err != nil {
+		log.Errorf("webhook: id=%d board=%s: %s", h.ID, h.Board, err)
+	}
+	return
+}
+
+func send(h auth.Webhook, buf []byte) (err error) {
+	req, err := http.NewRequest("POST", h.URL, bytes.NewReader(buf))
+	if err != nil {
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if h.Secret != "" {
+		req.Header.Set("X-Meguca-Signature", "sha256="+Sign(h.Secret, buf))
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return
+	}
+	res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		err = fmt.Errorf("unexpected status: %s", res.Status)
+	}
+	return
+}
+
+// Sign returns the hex-encoded HMAC-SHA256 of buf keyed with secret, as set
+// in the X-Meguca-Signature header of deliveries
+func Sign(secret string, buf []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(buf)
+	return hex.EncodeToString(mac.Sum(nil))
+}
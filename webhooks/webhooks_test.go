@@ -0,0 +1,118 @@
+package webhooks
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	. "github.com/bakape/meguca/test"
+)
+
+func init() {
+	retryBase = time.Millisecond
+
+	// Test servers listen on loopback
+	client.Transport = http.DefaultTransport
+}
+
+func TestDeliver(t *testing.T) {
+	payload := []byte(`{"event":"newPost"}`)
+	attempts := 0
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			if attempts < 3 {
+				w.WriteHeader(500)
+				return
+			}
+
+			buf, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertDeepEquals(t, buf, payload)
+			AssertDeepEquals(t, r.Header.Get("Content-Type"),
+				"application/json")
+			AssertDeepEquals(t, r.Header.Get("X-Meguca-Signature"),
+				"sha256="+Sign("foo", payload))
+		},
+	))
+	defer srv.Close()
+
+	err := deliver(auth.Webhook{URL: srv.URL, Secret: "foo"}, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, attempts, 3)
+}
+
+func TestDeliverGivesUp(t *testing.T) {
+	attempts := 0
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			AssertDeepEquals(t, r.Header.Get("X-Meguca-Signature"), "")
+			w.WriteHeader(404)
+		},
+	))
+	defer srv.Close()
+
+	if err := deliver(auth.Webhook{URL: srv.URL}, nil); err == nil {
+		t.Fatal("expected error")
+	}
+	AssertDeepEquals(t, attempts, maxRetries+1)
+}
+
+func TestIsEvent(t *testing.T) {
+	for _, e := range [...]string{NewPost, NewThread} {
+		if !IsEvent(e) {
+			t.Fatal(e)
+		}
+	}
+	if IsEvent("foo") {
+		t.Fatal("foo")
+	}
+}
+
+func TestDeliverRefusesRedirects(t *testing.T) {
+	var redirected bool
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/target" {
+				redirected = true
+				return
+			}
+			http.Redirect(w, r, "/target", 307)
+		},
+	))
+	defer srv.Close()
+
+	if err := send(auth.Webhook{URL: srv.URL}, nil); err == nil {
+		t.Fatal("expected error")
+	}
+	AssertDeepEquals(t, redirected, false)
+}
+
+func TestSendRefusesPrivateAddresses(t *testing.T) {
+	old := client
+	client = newClient()
+	defer func() {
+		client = old
+	}()
+
+	var reached bool
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			reached = true
+		},
+	))
+	defer srv.Close()
+
+	if err := send(auth.Webhook{URL: srv.URL}, nil); err == nil {
+		t.Fatal("expected error")
+	}
+	AssertDeepEquals(t, reached, false)
+}
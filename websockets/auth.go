@@ -0,0 +1,64 @@
+package websockets
+
+import (
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/db"
+)
+
+// Authentication request sent as the first message of a connection
+type authRequest struct {
+	Token string `json:"token"`
+}
+
+// Authenticate the client with a login session token. Clients without a valid
+// token are assigned an anonymous identity.
+func (c *Client) authenticate(data []byte) error {
+	var req authRequest
+	if err := decodeMessage(data, &req); err != nil {
+		return err
+	}
+
+	ident := auth.Ident{IP: c.ip}
+	if req.Token != "" {
+		var err error
+		ident.UserID, err = db.GetSessionAccount(req.Token)
+		switch err {
+		case nil:
+		case common.ErrInvalidCreds:
+			// Stale or expired session. Fall back to anonymous, as the
+			// client will keep resending the same token on reconnect.
+			ident = auth.Ident{IP: c.ip}
+		default:
+			return err
+		}
+	}
+
+	c.mu.Lock()
+	c.ident = ident
+	c.mu.Unlock()
+	return nil
+}
+
+// Returns an error, if the identity may not subscribe to a board
+func canAccessBoard(ident auth.Ident, board string) error {
+	if !auth.IsBoard(board) {
+		return common.ErrInvalidBoard(board)
+	}
+	return db.IsBanned(board, ident.IP)
+}
+
+// Returns an error, if the identity may not subscribe to a thread on a board
+func canAccessThread(ident auth.Ident, op uint64, board string) error {
+	if err := canAccessBoard(ident, board); err != nil {
+		return err
+	}
+	valid, err := db.ValidateOP(op, board)
+	switch {
+	case err != nil:
+		return err
+	case !valid:
+		return common.ErrInvalidThread(op, board)
+	}
+	return nil
+}
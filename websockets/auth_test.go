@@ -0,0 +1,67 @@
+package websockets
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/db"
+	. "github.com/bakape/meguca/test"
+	"github.com/bakape/meguca/test/test_db"
+)
+
+func TestAuthenticate(t *testing.T) {
+	test_db.ClearTables(t, "accounts")
+	err := db.InTransaction(false, func(tx *sql.Tx) error {
+		return db.RegisterAccount(tx, "user1", []byte{1, 2, 3})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := GenString(common.LenSession)
+	if err := db.WriteLoginSession("user1", token); err != nil {
+		t.Fatal(err)
+	}
+
+	sv := newWSServer(t)
+	defer sv.Close()
+
+	cases := [...]struct {
+		name, token string
+		err         error
+		ident       auth.Ident
+	}{
+		{"anonymous", "", nil, auth.Ident{IP: "::1"}},
+		{"logged in", token, nil, auth.Ident{UserID: "user1", IP: "::1"}},
+		{"invalid token", GenString(common.LenSession),
+			nil, auth.Ident{IP: "::1"}},
+		{"malformed token", "foo", nil, auth.Ident{IP: "::1"}},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			cl, _ := sv.NewClient()
+			cl.ip = "::1"
+
+			err := cl.authenticate(marshalJSON(t, authRequest{c.token}))
+			AssertDeepEquals(t, err, c.err)
+			AssertDeepEquals(t, cl.ident, c.ident)
+			AssertDeepEquals(t, cl.Account(), c.ident.UserID)
+		})
+	}
+}
+
+func TestSyncBeforeAuth(t *testing.T) {
+	t.Parallel()
+
+	sv := newWSServer(t)
+	defer sv.Close()
+	cl, _ := sv.NewClient()
+
+	msg := encodeMessage(t, common.MessageSynchronise, syncRequest{
+		Board: "a",
+	})
+	assertHandlerError(t, cl, msg, invalidMessage)
+}
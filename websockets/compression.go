@@ -0,0 +1,101 @@
+package websockets
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"sync/atomic"
+
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/metrics"
+	"github.com/bakape/meguca/websockets/feeds"
+	"github.com/go-playground/log"
+	"github.com/gorilla/websocket"
+)
+
+// Returns, if the client offered the permessage-deflate extension in its
+// handshake request. Mirrors the negotiation of websocket.Upgrader with
+// EnableCompression set.
+func negotiateCompression(r *http.Request) bool {
+	for _, h := range r.Header["Sec-Websocket-Extensions"] {
+		for _, ext := range strings.Split(h, ",") {
+			name := ext
+			if i := strings.IndexByte(ext, ';'); i != -1 {
+				name = ext[:i]
+			}
+			if strings.EqualFold(strings.TrimSpace(name),
+				"permessage-deflate") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Wraps an http.ResponseWriter, so the connection hijacked by the websocket
+// upgrade counts the bytes written to the wire
+type countingResponseWriter struct {
+	http.ResponseWriter
+	conn *countingConn
+}
+
+func (w *countingResponseWriter) Hijack() (
+	net.Conn, *bufio.ReadWriter, error,
+) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response does not implement http.Hijacker")
+	}
+	conn, brw, err := h.Hijack()
+	if err != nil {
+		return nil, nil, err
+	}
+	w.conn = &countingConn{Conn: conn}
+	return w.conn, brw, nil
+}
+
+// net.Conn, that counts written bytes. Safe for concurrent use.
+type countingConn struct {
+	net.Conn
+	written uint64
+}
+
+func (c *countingConn) Write(p []byte) (int, error) {
+	n, err := c.Conn.Write(p)
+	atomic.AddUint64(&c.written, uint64(n))
+	return n, err
+}
+
+func (c *countingConn) Written() uint64 {
+	return atomic.LoadUint64(&c.written)
+}
+
+// Enable write compression on a connection with the configured compression
+// level
+func enableCompression(conn *websocket.Conn) {
+	conn.EnableWriteCompression(true)
+	level := config.Get().CompressionLevel
+	if err := conn.SetCompressionLevel(level); err != nil {
+		log.Warnf("websockets: invalid compression level %d", level)
+	}
+}
+
+// Write a message to the client and record its compression ratio for the
+// board the client is synced to. Not safe for concurrent use.
+func (c *Client) writeCompressed(msg []byte) error {
+	before := c.wire.Written()
+	err := c.conn.WriteMessage(websocket.TextMessage, msg)
+	if err != nil {
+		return err
+	}
+
+	// Includes frame headers and any control frames written concurrently,
+	// which is negligible for the purpose of measuring the ratio
+	if _, _, board := feeds.GetSync(c); board != "" {
+		metrics.ObserveCompression(board, uint64(len(msg)),
+			c.wire.Written()-before)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package websockets
+
+import (
+	"bytes"
+	"compress/flate"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/metrics"
+	. "github.com/bakape/meguca/test"
+	"github.com/bakape/meguca/websockets/feeds"
+	"github.com/gorilla/websocket"
+)
+
+func TestNegotiateCompression(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name, header string
+		negotiated   bool
+	}{
+		{"no header", "", false},
+		{"deflate", "permessage-deflate", true},
+		{"with parameters",
+			"permessage-deflate; client_max_window_bits", true},
+		{"multiple extensions", "foo, permessage-deflate", true},
+		{"other extension", "x-webkit-deflate-frame", false},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := httptest.NewRequest("GET", "/", nil)
+			if c.header != "" {
+				r.Header.Set("Sec-WebSocket-Extensions", c.header)
+			}
+			AssertDeepEquals(t, negotiateCompression(r), c.negotiated)
+		})
+	}
+}
+
+func TestCompressionRatio(t *testing.T) {
+	metrics.Clear()
+	old := *config.Get()
+	defer config.Set(old)
+	conf := old
+	conf.CompressionLevel = flate.BestCompression
+	config.Set(conf)
+
+	type upgraded struct {
+		conn *websocket.Conn
+		wire *countingConn
+	}
+	connSender := make(chan upgraded)
+	sv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			cw := &countingResponseWriter{ResponseWriter: w}
+			conn, err := upgrader.Upgrade(cw, r, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !negotiateCompression(r) {
+				t.Fatal("compression not negotiated")
+			}
+			enableCompression(conn)
+			connSender <- upgraded{conn, cw.conn}
+		},
+	))
+	defer sv.Close()
+
+	d := websocket.Dialer{EnableCompression: true}
+	wcl, _, err := d.Dial(strings.Replace(sv.URL, "http", "ws", 1), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wcl.Close()
+
+	u := <-connSender
+	cl, err := newClient(u.conn, httptest.NewRequest("GET", "/", nil), "::1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cl.wire = u.wire
+	registerClient(t, cl, 0, "a")
+	defer feeds.RemoveClient(cl)
+
+	msg := strings.Repeat(`{"body":"foo"}`, 256)
+	if err := cl.send([]byte(msg)); err != nil {
+		t.Fatal(err)
+	}
+	assertMessage(t, wcl, msg)
+
+	var w bytes.Buffer
+	if err := metrics.Write(&w, nil); err != nil {
+		t.Fatal(err)
+	}
+	const prefix = `meguca_ws_compression_ratio{board="a"} 0.`
+	if !strings.Contains(w.String(), prefix) {
+		t.Fatalf("compression ratio not recorded:\n%s", w.String())
+	}
+}
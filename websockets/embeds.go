@@ -0,0 +1,78 @@
+package websockets
+
+import (
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/unfurl"
+	"github.com/bakape/meguca/websockets/feeds"
+	"github.com/go-playground/log"
+)
+
+// Maximum number of URLs fetched concurrently, so posting many links can not
+// turn the server into a request amplifier
+const maxEmbedFetches = 8
+
+// Limits concurrent URL fetches
+var embedFetchSem = make(chan struct{}, maxEmbedFetches)
+
+func init() {
+	common.FetchEmbeds = FetchPostEmbeds
+}
+
+// FetchPostEmbeds asynchronously fetches Open Graph previews of the URLs in
+// the body of a closed post, stores them with the post and propagates them to
+// the thread's feed. Fetched metadata is cached per URL.
+func FetchPostEmbeds(id, op uint64, body string) {
+	urls := unfurl.ExtractURLs(body)
+	if len(urls) == 0 {
+		return
+	}
+
+	go func() {
+		embeds := make([]common.EmbedMeta, 0, len(urls))
+		for _, u := range urls {
+			meta, err := fetchEmbed(u)
+			if err != nil {
+				log.Warnf("unfurl: %s: %s", u, err)
+				continue
+			}
+			if !unfurl.IsEmpty(meta) {
+				embeds = append(embeds, meta)
+			}
+		}
+		if len(embeds) == 0 {
+			return
+		}
+
+		err := db.SetPostEmbeds(id, embeds)
+		if err == nil {
+			err = feeds.Embeds(id, op, embeds)
+		}
+		if err != nil {
+			log.Errorf("unfurl: post %d: %s", id, err)
+		}
+	}()
+}
+
+// Retrieve the metadata of a URL from the cache or fetch and cache it.
+// URLs without any metadata and failed fetches are cached too, so they are not
+// refetched, until the cache entry expires.
+func fetchEmbed(u string) (meta common.EmbedMeta, err error) {
+	meta, ok, err := db.GetURLCache(u)
+	if err != nil || ok {
+		return
+	}
+
+	embedFetchSem <- struct{}{}
+	meta, fetchErr := unfurl.Fetch(u)
+	<-embedFetchSem
+	if fetchErr != nil {
+		meta = common.EmbedMeta{URL: u}
+	}
+
+	err = db.WriteURLCache(meta)
+	if err == nil {
+		err = fetchErr
+	}
+	return
+}
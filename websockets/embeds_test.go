@@ -0,0 +1,27 @@
+package websockets
+
+import (
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/db"
+	. "github.com/bakape/meguca/test"
+	"github.com/bakape/meguca/test/test_db"
+)
+
+func TestFetchEmbedCachesFailures(t *testing.T) {
+	test_db.ClearTables(t, "url_cache")
+
+	// Loopback addresses are never fetched
+	const url = "http://127.0.0.1/"
+	if _, err := fetchEmbed(url); err == nil {
+		t.Fatal("expected error")
+	}
+
+	meta, ok, err := db.GetURLCache(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, ok, true)
+	AssertDeepEquals(t, meta, common.EmbedMeta{URL: url})
+}
@@ -26,7 +26,7 @@ func newThreadCache(id uint64) (c threadCache, err error) {
 			Moderation: make(map[uint64][]common.ModerationEntry, 16),
 		},
 	}
-	thread, err := db.GetThread(id, 0)
+	thread, err := db.GetThread(id, 0, 0)
 	if err != nil {
 		return
 	}
@@ -2,6 +2,7 @@ package feeds
 
 import (
 	"github.com/bakape/meguca/common"
+	"net"
 	"sync"
 )
 
@@ -25,6 +26,7 @@ var (
 func init() {
 	common.GetByIPAndBoard = GetByIPAndBoard
 	common.GetClientsByIP = GetByIP
+	common.GetByRangeAndBoard = GetByRangeAndBoard
 }
 
 // syncID contains the board and thread the client are currently synced to. If
@@ -59,6 +61,16 @@ func UnregisterIP(ip string) {
 	}
 }
 
+// ClientsByBoard returns the number of synchronized clients per board
+func ClientsByBoard() map[string]int {
+	counts := boardRooms.Counts()
+	n := make(map[string]int, len(counts))
+	for board, c := range counts {
+		n[board.(string)] = c
+	}
+	return n
+}
+
 // IPCount returns number of unique connected IPs
 func IPCount() int {
 	ips.RLock()
@@ -73,6 +85,10 @@ func SyncClient(cl common.Client, op uint64, board string) (*Feed, error) {
 	clients.Lock()
 	old, ok := clients.clients[cl]
 	clients.clients[cl] = syncID{op, board}
+	if ok {
+		leaveRooms(old, cl)
+	}
+	joinRooms(syncID{op, board}, cl)
 	clients.Unlock()
 
 	if ok {
@@ -88,6 +104,7 @@ func RemoveClient(cl common.Client) {
 	old, ok := clients.clients[cl]
 	if ok {
 		delete(clients.clients, cl)
+		leaveRooms(old, cl)
 	}
 	clients.Unlock()
 
@@ -122,6 +139,23 @@ func GetByIPAndBoard(ip, board string) []common.Client {
 	return cls
 }
 
+// GetByRangeAndBoard retrieves all Clients with an IP in the passed range on a
+// board
+func GetByRangeAndBoard(ipRange *net.IPNet, board string) []common.Client {
+	clients.RLock()
+	defer clients.RUnlock()
+
+	cls := make([]common.Client, 0, 16)
+	for cl, sync := range clients.clients {
+		ip := net.ParseIP(cl.IP())
+		if ip != nil && ipRange.Contains(ip) &&
+			(board == "all" || sync.board == board) {
+			cls = append(cls, cl)
+		}
+	}
+	return cls
+}
+
 // GetByIP returns all clients matching the specified IP
 func GetByIP(ip string) []common.Client {
 	clients.RLock()
@@ -136,20 +170,40 @@ func GetByIP(ip string) []common.Client {
 	return cls
 }
 
-// GetByThread gets all synced to a thread
-func GetByThread(id uint64) []common.Client {
+// GetByAccount returns all synced clients logged in as an account
+func GetByAccount(account string) []common.Client {
+	if account == "" {
+		return nil
+	}
+
 	clients.RLock()
 	defer clients.RUnlock()
 
-	cls := make([]common.Client, 0, 16)
-	for cl, sync := range clients.clients {
-		if sync.op == id {
+	cls := make([]common.Client, 0, 4)
+	for cl := range clients.clients {
+		if cl.Account() == account {
 			cls = append(cls, cl)
 		}
 	}
 	return cls
 }
 
+// GetByThread gets all synced to a thread
+func GetByThread(id uint64) []common.Client {
+	if r := threadRooms.Get(id); r != nil {
+		return r.Clients()
+	}
+	return nil
+}
+
+// GetByBoard gets all clients synced to a board or any of its threads
+func GetByBoard(board string) []common.Client {
+	if r := boardRooms.Get(board); r != nil {
+		return r.Clients()
+	}
+	return nil
+}
+
 // All returns all currently connected clients
 func All() []common.Client {
 	clients.RLock()
@@ -41,6 +41,8 @@ type syncCount struct {
 type Feed struct {
 	// Thread ID
 	id uint64
+	// Board of the thread
+	board string
 	// Message flushing ticker
 	ticker
 	// Common functionality
@@ -137,6 +139,10 @@ func (f *Feed) Start() (err error) {
 				if src.IsDeleted() {
 					f.cache.Moderation[msg.id] = src.Moderation
 				}
+				// Reclaimed posts are not broadcast and need no replay
+				if msg.msg != nil {
+					f.pushReplayEvent(msg.msg)
+				}
 				f.sendIPCount()
 
 			// Set the body of an open post and propagate
@@ -208,6 +214,18 @@ func (f *Feed) bufferMessage(msg []byte) {
 	f.write(msg)
 }
 
+// Record a post insertion in the board's replay buffer and send its sequence
+// number to clients
+func (f *Feed) pushReplayEvent(msg []byte) {
+	seq := replayBuffer(f.board).Push(f.id, msg)
+	buf, err := common.EncodeMessage(common.MessageEventSeq, seq)
+	if err != nil {
+		log.Errorf("event sequence: %s", err)
+		return
+	}
+	f.bufferMessage(buf)
+}
+
 // Send unique IP count to all connected clients
 func (f *Feed) sendIPCount() {
 	var active int
@@ -8,6 +8,7 @@ import (
 	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/db"
 	"sync"
+	"unicode/utf8"
 )
 
 // Contains and manages all active update feeds
@@ -21,6 +22,7 @@ var feeds = feedMap{
 func init() {
 	common.SendTo = SendTo
 	common.ClosePost = ClosePost
+	common.EditPost = EditPost
 }
 
 // Container for managing client<->update-feed assignment and interaction
@@ -45,6 +47,7 @@ func addToFeed(id uint64, board string, c common.Client) (
 		if !ok {
 			feed = &Feed{
 				id:            id,
+				board:         board,
 				send:          make(chan []byte),
 				insertPost:    make(chan postCreationMessage),
 				closePost:     make(chan message),
@@ -144,15 +147,18 @@ func InsertPostInto(post common.StandalonePost, msg []byte) {
 
 // ClosePost closes a post in a feed, if it exists
 func ClosePost(id, op uint64, links []common.Link, commands []common.Command,
+	codeBlocks []common.CodeBlock,
 ) (err error) {
 	msg, err := common.EncodeMessage(common.MessageClosePost, struct {
-		ID       uint64           `json:"id"`
-		Links    []common.Link    `json:"links"`
-		Commands []common.Command `json:"commands"`
+		ID         uint64             `json:"id"`
+		Links      []common.Link      `json:"links"`
+		Commands   []common.Command   `json:"commands"`
+		CodeBlocks []common.CodeBlock `json:"code_blocks,omitempty"`
 	}{
-		ID:       id,
-		Links:    links,
-		Commands: commands,
+		ID:         id,
+		Links:      links,
+		Commands:   commands,
+		CodeBlocks: codeBlocks,
 	})
 	if err != nil {
 		return
@@ -166,6 +172,68 @@ func ClosePost(id, op uint64, links []common.Link, commands []common.Command,
 	return
 }
 
+// EditPost propagates the replacement of a closed post's body to the thread's
+// feed as a splice over the entire previous body
+func EditPost(id, op uint64, prev, body string) (err error) {
+	msg, err := common.EncodeMessage(common.MessageSplice, struct {
+		ID    uint64 `json:"id"`
+		Start uint   `json:"start"`
+		Len   uint   `json:"len"`
+		Text  string `json:"text"`
+	}{
+		ID:   id,
+		Len:  uint(utf8.RuneCountInString(prev)),
+		Text: body,
+	})
+	if err != nil {
+		return
+	}
+
+	return sendIfExists(op, func(f *Feed) error {
+		f.SetOpenBody(id, body, msg)
+		return nil
+	})
+}
+
+// MathRendered propagates the SVGs rendered from a post's math markup to the
+// thread's feed. renderErr is the first error encountered during rendering,
+// if any.
+func MathRendered(id, op uint64, svgs []string, renderErr string) (err error) {
+	msg, err := common.EncodeMessage(common.MessageMathRendered, struct {
+		ID    uint64   `json:"id"`
+		SVGs  []string `json:"svgs"`
+		Error string   `json:"error,omitempty"`
+	}{
+		ID:    id,
+		SVGs:  svgs,
+		Error: renderErr,
+	})
+	if err != nil {
+		return
+	}
+
+	SendTo(op, msg)
+	return
+}
+
+// Embeds propagates the Open Graph previews of the URLs in a post's body to
+// the thread's feed
+func Embeds(id, op uint64, embeds []common.EmbedMeta) (err error) {
+	msg, err := common.EncodeMessage(common.MessageEmbeds, struct {
+		ID     uint64             `json:"id"`
+		Embeds []common.EmbedMeta `json:"embeds"`
+	}{
+		ID:     id,
+		Embeds: embeds,
+	})
+	if err != nil {
+		return
+	}
+
+	SendTo(op, msg)
+	return
+}
+
 // Initialize internal runtime
 func Init() (err error) {
 	return db.Listen("post_moderated", func(msg string) (err error) {
@@ -204,4 +272,14 @@ func Clear() {
 	feeds.mu.Lock()
 	defer feeds.mu.Unlock()
 	feeds.feeds = make(map[uint64]*Feed, 32)
+	boardRooms.Clear()
+	threadRooms.Clear()
+
+	replayBuffers.Lock()
+	replayBuffers.m = make(map[string]*RingBuffer, 64)
+	replayBuffers.Unlock()
+
+	viewers.Lock()
+	viewers.counts = make(map[uint64]*int64, 64)
+	viewers.Unlock()
 }
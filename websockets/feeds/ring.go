@@ -0,0 +1,136 @@
+package feeds
+
+import (
+	"sync"
+
+	"github.com/bakape/meguca/config"
+)
+
+// Default capacity of a board's replay buffer
+const defaultReplayBufferSize = 64
+
+// Per-board buffers of recent post insertions for replaying to reconnecting
+// clients
+var replayBuffers = struct {
+	sync.Mutex
+	m map[string]*RingBuffer
+}{
+	m: make(map[string]*RingBuffer, 64),
+}
+
+// Event is a broadcast message with its board-scoped sequence number
+type Event struct {
+	Seq uint64
+	// Thread the message was sent to
+	OP  uint64
+	Msg []byte
+}
+
+// RingBuffer stores the last N events of a board. Older events are
+// overwritten.
+type RingBuffer struct {
+	mu     sync.Mutex
+	events []Event
+	start  int // Index of the oldest event
+	n      int // Number of stored events
+	seq    uint64
+}
+
+// NewRingBuffer creates a ring buffer, that stores up to capacity events
+func NewRingBuffer(capacity int) *RingBuffer {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &RingBuffer{
+		events: make([]Event, capacity),
+	}
+}
+
+// Push appends a message sent to thread op and returns its sequence number
+func (r *RingBuffer) Push(op uint64, msg []byte) uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.seq++
+	e := Event{
+		Seq: r.seq,
+		OP:  op,
+		Msg: msg,
+	}
+	if r.n < len(r.events) {
+		r.events[(r.start+r.n)%len(r.events)] = e
+		r.n++
+	} else {
+		r.events[r.start] = e
+		r.start = (r.start + 1) % len(r.events)
+	}
+	return r.seq
+}
+
+// Since returns all events after seq. ok is false, if some of these events
+// are no longer in the buffer or seq is from before a server restart, in which
+// case the client must refetch its state.
+func (r *RingBuffer) Since(seq uint64) (events []Event, ok bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch {
+	case seq > r.seq:
+		return nil, false
+	case seq == r.seq:
+		return nil, true
+	case r.seq-seq > uint64(r.n):
+		return nil, false
+	}
+
+	events = make([]Event, 0, r.seq-seq)
+	for i := r.n - int(r.seq-seq); i < r.n; i++ {
+		events = append(events, r.events[(r.start+i)%len(r.events)])
+	}
+	return events, true
+}
+
+// Seq returns the sequence number of the latest event
+func (r *RingBuffer) Seq() uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.seq
+}
+
+// Returns the replay buffer of a board, creating it, if needed
+func replayBuffer(board string) *RingBuffer {
+	replayBuffers.Lock()
+	defer replayBuffers.Unlock()
+
+	r, ok := replayBuffers.m[board]
+	if !ok {
+		size := defaultReplayBufferSize
+		if conf := config.Get(); conf != nil && conf.ReplayBufferSize != 0 {
+			size = int(conf.ReplayBufferSize)
+		}
+		r = NewRingBuffer(size)
+		replayBuffers.m[board] = r
+	}
+	return r
+}
+
+// Replay returns the post insertions on a thread, that happened after
+// sequence number seq on its board. ok is false, if some of the events were
+// lost and the client must refetch the thread.
+func Replay(board string, op, seq uint64) (events []Event, ok bool) {
+	all, ok := replayBuffer(board).Since(seq)
+	if !ok {
+		return
+	}
+	for _, e := range all {
+		if e.OP == op {
+			events = append(events, e)
+		}
+	}
+	return events, true
+}
+
+// CurrentSeq returns the sequence number of the latest event on a board
+func CurrentSeq(board string) uint64 {
+	return replayBuffer(board).Seq()
+}
@@ -0,0 +1,67 @@
+package feeds
+
+import (
+	"strconv"
+	"testing"
+
+	. "github.com/bakape/meguca/test"
+)
+
+func TestRingBuffer(t *testing.T) {
+	t.Parallel()
+
+	r := NewRingBuffer(3)
+	for i := 1; i <= 5; i++ {
+		seq := r.Push(uint64(i%2+1), []byte(strconv.Itoa(i)))
+		AssertDeepEquals(t, seq, uint64(i))
+	}
+	AssertDeepEquals(t, r.Seq(), uint64(5))
+
+	cases := [...]struct {
+		name string
+		seq  uint64
+		ok   bool
+		std  []string
+	}{
+		{"up to date", 5, true, nil},
+		{"partial", 3, true, []string{"4", "5"}},
+		{"whole buffer", 2, true, []string{"3", "4", "5"}},
+		{"overwritten", 1, false, nil},
+		{"after restart", 6, false, nil},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			events, ok := r.Since(c.seq)
+			AssertDeepEquals(t, ok, c.ok)
+			var res []string
+			for _, e := range events {
+				res = append(res, string(e.Msg))
+			}
+			AssertDeepEquals(t, res, c.std)
+		})
+	}
+}
+
+func TestReplay(t *testing.T) {
+	replayBuffers.Lock()
+	replayBuffers.m = make(map[string]*RingBuffer, 64)
+	replayBuffers.Unlock()
+
+	replayBuffer("a").Push(1, []byte("foo"))
+	replayBuffer("a").Push(2, []byte("bar"))
+	replayBuffer("a").Push(1, []byte("baz"))
+	replayBuffer("b").Push(3, []byte("qux"))
+
+	AssertDeepEquals(t, CurrentSeq("a"), uint64(3))
+	AssertDeepEquals(t, CurrentSeq("b"), uint64(1))
+
+	events, ok := Replay("a", 1, 1)
+	AssertDeepEquals(t, ok, true)
+	AssertDeepEquals(t, len(events), 1)
+	AssertDeepEquals(t, string(events[0].Msg), "baz")
+	AssertDeepEquals(t, events[0].Seq, uint64(3))
+}
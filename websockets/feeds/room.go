@@ -0,0 +1,268 @@
+package feeds
+
+import (
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/bakape/meguca/common"
+	"github.com/go-playground/log"
+)
+
+// Maximum time a broadcast waits for a client to accept a message, before
+// the client is considered stale
+const broadcastDeadline = 5 * time.Second
+
+var (
+	// Rooms of clients synced to a board or any of its threads
+	boardRooms = newRoomManager()
+
+	// Rooms of clients synced to a specific thread
+	threadRooms = newRoomManager()
+)
+
+// Client, that can fail to accept a message within a deadline
+type deadlineSender interface {
+	SendWithDeadline(msg []byte, deadline time.Duration) error
+}
+
+// Room is a set of clients, that receive the same broadcasts. Reads of the
+// client set are lock-free.
+type Room struct {
+	clients sync.Map // map[common.Client]struct{}
+	// Serializes membership changes
+	mu sync.Mutex
+	n  int
+}
+
+// Add a client to the room
+func (r *Room) add(c common.Client) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, loaded := r.clients.LoadOrStore(c, struct{}{}); !loaded {
+		r.n++
+	}
+}
+
+// Remove a client from the room. Returns, if the room is now empty.
+func (r *Room) remove(c common.Client) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.clients.Load(c); ok {
+		r.clients.Delete(c)
+		r.n--
+	}
+	return r.n == 0
+}
+
+// Len returns the number of clients in the room
+func (r *Room) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.n
+}
+
+// Clients returns all clients in the room
+func (r *Room) Clients() []common.Client {
+	cls := make([]common.Client, 0, r.Len())
+	r.clients.Range(func(k, _ interface{}) bool {
+		cls = append(cls, k.(common.Client))
+		return true
+	})
+	return cls
+}
+
+// Broadcast a message to all clients in the room, except for the passed one,
+// concurrently with a worker pool bounded by the number of CPUs. Returns after
+// all clients have accepted the message or timed out, with the clients, that
+// failed to accept the message within the deadline.
+func (r *Room) broadcast(msg []byte, except common.Client) []failedSend {
+	cls := r.Clients()
+	if except != nil {
+		for i, c := range cls {
+			if c == except {
+				cls = append(cls[:i], cls[i+1:]...)
+				break
+			}
+		}
+	}
+	workers := runtime.NumCPU()
+	if workers > len(cls) {
+		workers = len(cls)
+	}
+
+	var (
+		wg       sync.WaitGroup
+		failedMu sync.Mutex
+		failed   []failedSend
+		ch       = make(chan common.Client)
+	)
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for c := range ch {
+				if err := sendTo(c, msg); err != nil {
+					failedMu.Lock()
+					failed = append(failed, failedSend{c, err})
+					failedMu.Unlock()
+				}
+			}
+		}()
+	}
+	for _, c := range cls {
+		ch <- c
+	}
+	close(ch)
+	wg.Wait()
+	return failed
+}
+
+// Client, that failed to accept a broadcast message
+type failedSend struct {
+	client common.Client
+	err    error
+}
+
+// Send a message to a client, waiting at most broadcastDeadline, if the
+// client supports deadlines
+func sendTo(c common.Client, msg []byte) error {
+	s, ok := c.(deadlineSender)
+	if !ok {
+		c.Send(msg)
+		return nil
+	}
+	return s.SendWithDeadline(msg, broadcastDeadline)
+}
+
+// RoomManager creates rooms on their first client and destroys them, once
+// they are empty
+type RoomManager struct {
+	mu    sync.RWMutex
+	rooms map[interface{}]*Room
+}
+
+func newRoomManager() *RoomManager {
+	return &RoomManager{
+		rooms: make(map[interface{}]*Room, 64),
+	}
+}
+
+// Get returns the room with the specified key, if any
+func (m *RoomManager) Get(key interface{}) *Room {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.rooms[key]
+}
+
+// Join adds a client to a room, creating the room, if needed
+func (m *RoomManager) Join(key interface{}, c common.Client) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	r, ok := m.rooms[key]
+	if !ok {
+		r = new(Room)
+		m.rooms[key] = r
+	}
+	r.add(c)
+}
+
+// Leave removes a client from a room and destroys the room, if it is empty
+func (m *RoomManager) Leave(key interface{}, c common.Client) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if r, ok := m.rooms[key]; ok && r.remove(c) {
+		delete(m.rooms, key)
+	}
+}
+
+// Broadcast sends a message to all clients in a room, if it exists. Clients,
+// that fail to accept the message within the deadline, are evicted and closed.
+func (m *RoomManager) Broadcast(key interface{}, msg []byte) {
+	m.BroadcastExcept(key, msg, nil)
+}
+
+// BroadcastExcept sends a message to all clients in a room, except for the
+// passed one
+func (m *RoomManager) BroadcastExcept(key interface{}, msg []byte,
+	except common.Client,
+) {
+	if r := m.Get(key); r != nil {
+		// Evict after all sends complete, so the client set is not modified
+		// during the broadcast
+		m.evict(key, r.broadcast(msg, except))
+	}
+}
+
+// Remove clients, that failed to accept a broadcast, through the normal
+// client removal path, so they also leave their feeds and any rooms left empty
+// are destroyed
+func (m *RoomManager) evict(key interface{}, failed []failedSend) {
+	for _, f := range failed {
+		log.Debugf("feeds: evicting stalled client %s: %s", f.client.IP(),
+			f.err)
+		RemoveClient(f.client)
+		// In case the client was not synced through the client registry
+		m.Leave(key, f.client)
+		f.client.Close(f.err)
+	}
+}
+
+// Counts returns the number of clients in each room
+func (m *RoomManager) Counts() map[interface{}]int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	n := make(map[interface{}]int, len(m.rooms))
+	for k, r := range m.rooms {
+		n[k] = r.Len()
+	}
+	return n
+}
+
+// Clear removes all rooms
+func (m *RoomManager) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rooms = make(map[interface{}]*Room, 64)
+}
+
+// BroadcastToBoard sends a message to all clients synced to a board or any of
+// its threads
+func BroadcastToBoard(board string, msg []byte) {
+	boardRooms.Broadcast(board, msg)
+}
+
+// BroadcastToThread sends a message to all clients synced to a thread
+// immediately, bypassing the thread feed's message buffer
+func BroadcastToThread(id uint64, msg []byte) {
+	threadRooms.Broadcast(id, msg)
+}
+
+// RelayToThread sends a message from a client to all other clients synced to
+// a thread
+func RelayToThread(id uint64, msg []byte, from common.Client) {
+	threadRooms.BroadcastExcept(id, msg, from)
+}
+
+// Add a client to the rooms of the board and thread it synced to
+func joinRooms(id syncID, c common.Client) {
+	boardRooms.Join(id.board, c)
+	if id.op != 0 {
+		threadRooms.Join(id.op, c)
+		addViewer(id.op)
+	}
+}
+
+// Remove a client from the rooms of the board and thread it was synced to
+func leaveRooms(id syncID, c common.Client) {
+	boardRooms.Leave(id.board, c)
+	if id.op != 0 {
+		threadRooms.Leave(id.op, c)
+		removeViewer(id.op)
+	}
+}
@@ -0,0 +1,130 @@
+package feeds
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+// Client, that records received messages
+type roomClient struct {
+	mu       sync.Mutex
+	received [][]byte
+	closed   error
+	stale    bool
+}
+
+func (c *roomClient) Send(msg []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.received = append(c.received, msg)
+}
+
+func (c *roomClient) SendWithDeadline(msg []byte, _ time.Duration) error {
+	if c.stale {
+		return errors.New("stale")
+	}
+	c.Send(msg)
+	return nil
+}
+
+func (c *roomClient) Close(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = err
+}
+
+func (*roomClient) Redirect(string) {}
+func (*roomClient) IP() string      { return "::1" }
+func (*roomClient) Account() string { return "" }
+func (*roomClient) LastTime() int64 { return 0 }
+
+func TestRoomBroadcast(t *testing.T) {
+	t.Parallel()
+
+	m := newRoomManager()
+	cls := make([]*roomClient, 20)
+	for i := range cls {
+		cls[i] = new(roomClient)
+		m.Join("a", cls[i])
+	}
+	stale := &roomClient{stale: true}
+	m.Join("a", stale)
+	other := new(roomClient)
+	m.Join("b", other)
+
+	m.Broadcast("a", []byte("foo"))
+	for _, c := range cls {
+		AssertDeepEquals(t, c.received, [][]byte{[]byte("foo")})
+	}
+	AssertDeepEquals(t, len(other.received), 0)
+
+	// Stale clients are evicted and closed
+	if stale.closed == nil {
+		t.Fatal("stale client not closed")
+	}
+	AssertDeepEquals(t, m.Get("a").Len(), len(cls))
+
+	// Rooms emptied by evictions are destroyed
+	lone := &roomClient{stale: true}
+	m.Join("d", lone)
+	m.Broadcast("d", []byte("foo"))
+	if m.Get("d") != nil {
+		t.Fatal("empty room not destroyed")
+	}
+
+	// Non-existent rooms are ignored
+	m.Broadcast("c", []byte("bar"))
+}
+
+func TestRoomBroadcastExcept(t *testing.T) {
+	t.Parallel()
+
+	m := newRoomManager()
+	var from, to roomClient
+	m.Join(1, &from)
+	m.Join(1, &to)
+
+	m.BroadcastExcept(1, []byte("foo"), &from)
+	AssertDeepEquals(t, len(from.received), 0)
+	AssertDeepEquals(t, to.received, [][]byte{[]byte("foo")})
+}
+
+func TestRoomLifecycle(t *testing.T) {
+	t.Parallel()
+
+	m := newRoomManager()
+	var c1, c2 roomClient
+	m.Join("a", &c1)
+	m.Join("a", &c1)
+	m.Join("a", &c2)
+	AssertDeepEquals(t, m.Counts(), map[interface{}]int{"a": 2})
+
+	m.Leave("a", &c1)
+	AssertDeepEquals(t, m.Get("a").Len(), 1)
+	m.Leave("a", &c2)
+	if m.Get("a") != nil {
+		t.Fatal("empty room not destroyed")
+	}
+
+	// Leaving a nonexistent room is a no-op
+	m.Leave("a", &c2)
+}
+
+func TestJoinRooms(t *testing.T) {
+	var c roomClient
+	id := syncID{op: 1, board: "a"}
+	joinRooms(id, &c)
+	defer leaveRooms(id, &c)
+
+	AssertDeepEquals(t, GetByBoard("a"), []common.Client{&c})
+	AssertDeepEquals(t, GetByThread(1), []common.Client{&c})
+	AssertDeepEquals(t, len(GetByThread(2)), 0)
+
+	BroadcastToThread(1, []byte("foo"))
+	AssertDeepEquals(t, c.received, [][]byte{[]byte("foo")})
+}
@@ -0,0 +1,167 @@
+package feeds
+
+import (
+	"sync"
+
+	"github.com/bakape/meguca/common"
+	"github.com/go-playground/log"
+)
+
+// Maximum number of own post IDs tracked per post session. The oldest posts
+// are evicted first.
+const maxSessionPosts = 1000
+
+// Sessions tracks the posts created within the post sessions of all connected
+// clients
+var Sessions = NewSessionStore()
+
+// SessionStore maps posts created within post sessions to the connected
+// clients of those sessions. Only sessions with at least one connected client
+// are tracked.
+type SessionStore struct {
+	mu sync.RWMutex
+	// Own post IDs of each session, oldest first
+	posts map[string][]uint64
+	// Session, that created a post
+	owners map[uint64]string
+	// Connected clients of each session
+	clients map[string]map[common.Client]struct{}
+}
+
+// Payload of common.MessageYouGotReply
+type youGotReplyMessage struct {
+	QuotingPostID uint64 `json:"quotingPostID"`
+	ThreadID      uint64 `json:"threadID"`
+	Board         string `json:"board"`
+}
+
+// NewSessionStore creates an empty SessionStore
+func NewSessionStore() *SessionStore {
+	return &SessionStore{
+		posts:   make(map[string][]uint64, 128),
+		owners:  make(map[uint64]string, 1024),
+		clients: make(map[string]map[common.Client]struct{}, 128),
+	}
+}
+
+// AddClient registers a client connected with a post session. posts are the
+// IDs of posts created within the session, sorted by ID, and are only used, if
+// the session is not tracked yet.
+func (s *SessionStore) AddClient(token string, c common.Client, posts []uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cls := s.clients[token]
+	if cls == nil {
+		cls = make(map[common.Client]struct{}, 1)
+		s.clients[token] = cls
+		for _, id := range posts {
+			s.addPost(token, id)
+		}
+	}
+	cls[c] = struct{}{}
+}
+
+// RemoveClient unregisters a client. The session stops being tracked, once it
+// has no connected clients left.
+func (s *SessionStore) RemoveClient(token string, c common.Client) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cls := s.clients[token]
+	if cls == nil {
+		return
+	}
+	delete(cls, c)
+	if len(cls) != 0 {
+		return
+	}
+	delete(s.clients, token)
+	for _, id := range s.posts[token] {
+		delete(s.owners, id)
+	}
+	delete(s.posts, token)
+}
+
+// AddPost records a post as created within a post session, if the session is
+// tracked
+func (s *SessionStore) AddPost(token string, id uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.clients[token] != nil {
+		s.addPost(token, id)
+	}
+}
+
+func (s *SessionStore) addPost(token string, id uint64) {
+	if _, ok := s.owners[id]; ok {
+		return
+	}
+	posts := append(s.posts[token], id)
+	if over := len(posts) - maxSessionPosts; over > 0 {
+		for _, id := range posts[:over] {
+			delete(s.owners, id)
+		}
+		posts = append(posts[:0], posts[over:]...)
+	}
+	s.posts[token] = posts
+	s.owners[id] = token
+}
+
+// Clients returns the connected clients of the session, that created a post,
+// and the session's token
+func (s *SessionStore) Clients(id uint64) (token string, cls []common.Client) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	token, ok := s.owners[id]
+	if !ok {
+		return
+	}
+	cls = make([]common.Client, 0, len(s.clients[token]))
+	for c := range s.clients[token] {
+		cls = append(cls, c)
+	}
+	return
+}
+
+// NotifyQuotes sends a common.MessageYouGotReply to the connected clients of
+// all sessions, that own a post quoted by p. Quotes of posts from the same
+// session as p are ignored.
+func NotifyQuotes(p common.StandalonePost, sessions *SessionStore) {
+	if len(p.Links) == 0 {
+		return
+	}
+	own, _ := sessions.Clients(p.ID)
+
+	var msg []byte
+	notified := make(map[string]struct{}, len(p.Links))
+	for _, l := range p.Links {
+		token, cls := sessions.Clients(l.ID)
+		if token == "" || token == own {
+			continue
+		}
+		if _, ok := notified[token]; ok {
+			continue
+		}
+		notified[token] = struct{}{}
+
+		if msg == nil {
+			var err error
+			msg, err = common.EncodeMessage(common.MessageYouGotReply,
+				youGotReplyMessage{
+					QuotingPostID: p.ID,
+					ThreadID:      p.OP,
+					Board:         p.Board,
+				})
+			if err != nil {
+				log.Errorf("reply notification: %s", err)
+				return
+			}
+		}
+		for _, c := range cls {
+			c.Send(msg)
+		}
+	}
+}
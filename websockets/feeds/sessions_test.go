@@ -0,0 +1,87 @@
+package feeds
+
+import (
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestNotifyQuotes(t *testing.T) {
+	t.Parallel()
+
+	s := NewSessionStore()
+	var quoted, quoting, other roomClient
+	s.AddClient("a", &quoted, []uint64{1, 2})
+	s.AddClient("b", &quoting, nil)
+	s.AddClient("c", &other, []uint64{4})
+	s.AddPost("b", 3)
+
+	NotifyQuotes(common.StandalonePost{
+		Post: common.Post{
+			ID: 3,
+			Links: []common.Link{
+				{ID: 1, OP: 1, Board: "a"},
+				{ID: 2, OP: 1, Board: "a"},
+				{ID: 3, OP: 1, Board: "a"},
+			},
+		},
+		OP:    1,
+		Board: "a",
+	}, s)
+
+	msg, err := common.EncodeMessage(common.MessageYouGotReply,
+		youGotReplyMessage{
+			QuotingPostID: 3,
+			ThreadID:      1,
+			Board:         "a",
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, quoted.received, [][]byte{msg})
+	AssertDeepEquals(t, len(quoting.received), 0)
+	AssertDeepEquals(t, len(other.received), 0)
+}
+
+func TestSessionStore(t *testing.T) {
+	t.Parallel()
+
+	s := NewSessionStore()
+	var c1, c2 roomClient
+
+	t.Run("untracked session", func(t *testing.T) {
+		s.AddPost("a", 1)
+		token, cls := s.Clients(1)
+		AssertDeepEquals(t, token, "")
+		AssertDeepEquals(t, len(cls), 0)
+	})
+
+	t.Run("eviction", func(t *testing.T) {
+		posts := make([]uint64, maxSessionPosts)
+		for i := range posts {
+			posts[i] = uint64(i + 1)
+		}
+		s.AddClient("a", &c1, posts)
+		s.AddPost("a", maxSessionPosts+1)
+
+		token, _ := s.Clients(1)
+		AssertDeepEquals(t, token, "")
+		token, cls := s.Clients(maxSessionPosts + 1)
+		AssertDeepEquals(t, token, "a")
+		AssertDeepEquals(t, cls, []common.Client{&c1})
+		AssertDeepEquals(t, len(s.posts["a"]), maxSessionPosts)
+	})
+
+	t.Run("remove clients", func(t *testing.T) {
+		s.AddClient("a", &c2, nil)
+		s.RemoveClient("a", &c1)
+		_, cls := s.Clients(2)
+		AssertDeepEquals(t, cls, []common.Client{&c2})
+
+		s.RemoveClient("a", &c2)
+		token, _ := s.Clients(2)
+		AssertDeepEquals(t, token, "")
+		AssertDeepEquals(t, len(s.owners), 0)
+	})
+}
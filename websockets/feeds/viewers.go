@@ -0,0 +1,93 @@
+package feeds
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bakape/meguca/common"
+	"github.com/go-playground/log"
+)
+
+// Interval of broadcasting viewer counts to thread rooms
+const viewerCountInterval = 30 * time.Second
+
+// Number of clients viewing each thread. Counters are only removed under the
+// write lock, so they can be modified atomically under the read lock.
+var viewers = struct {
+	sync.RWMutex
+	counts map[uint64]*int64
+}{
+	counts: make(map[uint64]*int64, 64),
+}
+
+func init() {
+	go func() {
+		for range time.Tick(viewerCountInterval) {
+			broadcastViewerCounts()
+		}
+	}()
+}
+
+// Increment the viewer count of a thread
+func addViewer(op uint64) {
+	viewers.RLock()
+	n, ok := viewers.counts[op]
+	if ok {
+		atomic.AddInt64(n, 1)
+		viewers.RUnlock()
+		return
+	}
+	viewers.RUnlock()
+
+	viewers.Lock()
+	defer viewers.Unlock()
+	n, ok = viewers.counts[op]
+	if !ok {
+		n = new(int64)
+		viewers.counts[op] = n
+	}
+	atomic.AddInt64(n, 1)
+}
+
+// Decrement the viewer count of a thread and remove the counter, once it
+// reaches zero
+func removeViewer(op uint64) {
+	viewers.Lock()
+	defer viewers.Unlock()
+
+	n, ok := viewers.counts[op]
+	if ok && atomic.AddInt64(n, -1) <= 0 {
+		delete(viewers.counts, op)
+	}
+}
+
+// ViewerCount returns the number of clients currently viewing a thread
+func ViewerCount(op uint64) int {
+	viewers.RLock()
+	defer viewers.RUnlock()
+
+	if n, ok := viewers.counts[op]; ok {
+		return int(atomic.LoadInt64(n))
+	}
+	return 0
+}
+
+// Send the current viewer count to all clients in each thread room
+func broadcastViewerCounts() {
+	viewers.RLock()
+	counts := make(map[uint64]int64, len(viewers.counts))
+	for op, n := range viewers.counts {
+		counts[op] = atomic.LoadInt64(n)
+	}
+	viewers.RUnlock()
+
+	for op, n := range counts {
+		msg, err := common.EncodeMessage(common.MessageViewers, n)
+		if err != nil {
+			log.Errorf("viewer count: %s", err)
+			return
+		}
+		threadRooms.Broadcast(op, msg)
+	}
+}
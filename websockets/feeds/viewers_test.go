@@ -0,0 +1,35 @@
+package feeds
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestViewerCount(t *testing.T) {
+	const op = 1 << 40
+	var c1, c2 roomClient
+	id := syncID{op: op, board: "a"}
+
+	joinRooms(id, &c1)
+	joinRooms(id, &c2)
+	AssertDeepEquals(t, ViewerCount(op), 2)
+
+	broadcastViewerCounts()
+	std := strconv.Itoa(int(common.MessageViewers)) + "2"
+	for _, c := range [...]*roomClient{&c1, &c2} {
+		AssertDeepEquals(t, c.received, [][]byte{[]byte(std)})
+	}
+
+	leaveRooms(id, &c1)
+	AssertDeepEquals(t, ViewerCount(op), 1)
+	leaveRooms(id, &c2)
+	AssertDeepEquals(t, ViewerCount(op), 0)
+
+	viewers.RLock()
+	_, ok := viewers.counts[op]
+	viewers.RUnlock()
+	AssertDeepEquals(t, ok, false)
+}
@@ -0,0 +1,111 @@
+package websockets
+
+import (
+	"sync"
+	"time"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+)
+
+// Flood protection for post creation shared by all clients
+var floodGate = NewFloodGate()
+
+type floodKey struct {
+	ip, board string
+}
+
+// FloodGate limits the number of posts an IP can create on a board within a
+// sliding time window. Both limits are set in the board's configuration.
+type FloodGate struct {
+	mu    sync.Mutex
+	posts map[floodKey][]time.Time
+}
+
+// NewFloodGate creates a new FloodGate and starts the periodic cleanup of
+// stale entries
+func NewFloodGate() *FloodGate {
+	f := &FloodGate{
+		posts: make(map[floodKey][]time.Time),
+	}
+	go func() {
+		for range time.Tick(time.Minute) {
+			f.cleanup(time.Now())
+		}
+	}()
+	return f
+}
+
+// Check returns common.ErrFlood, if ip has reached the board's post limit for
+// the time window. Does not record an attempt, as only successfully created
+// posts count towards the limit. See Record.
+func (f *FloodGate) Check(ip, board string) error {
+	if f.Flooded(ip, board) {
+		return common.ErrFlood
+	}
+	return nil
+}
+
+// Flooded returns, if ip has reached the board's post limit for the time
+// window
+func (f *FloodGate) Flooded(ip, board string) bool {
+	conf := config.GetBoardConfigs(board)
+	if conf.FloodPosts == 0 {
+		return false
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	since := time.Now().Add(-floodInterval(conf.FloodInterval))
+	return uint(len(pruneTimes(f.posts[floodKey{ip, board}], since))) >=
+		conf.FloodPosts
+}
+
+// Record records a successfully created post by ip on board
+func (f *FloodGate) Record(ip, board string) {
+	conf := config.GetBoardConfigs(board)
+	if conf.FloodPosts == 0 {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now()
+	k := floodKey{ip, board}
+	times := pruneTimes(f.posts[k], now.Add(-floodInterval(conf.FloodInterval)))
+	f.posts[k] = append(times, now)
+}
+
+// Remove entries with no posts inside their board's time window
+func (f *FloodGate) cleanup(now time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for k, times := range f.posts {
+		interval := config.GetBoardConfigs(k.board).FloodInterval
+		times = pruneTimes(times, now.Add(-floodInterval(interval)))
+		if len(times) == 0 {
+			delete(f.posts, k)
+		} else {
+			f.posts[k] = times
+		}
+	}
+}
+
+// Remove timestamps older than since from the sorted times slice
+func pruneTimes(times []time.Time, since time.Time) []time.Time {
+	i := 0
+	for i < len(times) && !times[i].After(since) {
+		i++
+	}
+	return times[i:]
+}
+
+func floodInterval(seconds uint) time.Duration {
+	if seconds == 0 {
+		seconds = config.DefaultFloodInterval
+	}
+	return time.Duration(seconds) * time.Second
+}
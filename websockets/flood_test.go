@@ -0,0 +1,81 @@
+package websockets
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestFloodGate(t *testing.T) {
+	config.ClearBoards()
+	_, err := config.SetBoardConfigs(config.BoardConfigs{
+		ID:            "a",
+		FloodPosts:    2,
+		FloodInterval: 10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = config.SetBoardConfigs(config.BoardConfigs{
+		ID: "c",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const ip = "::1"
+	f := &FloodGate{
+		posts: make(map[floodKey][]time.Time),
+	}
+
+	t.Run("failed attempts", func(t *testing.T) {
+		for i := 0; i < 5; i++ {
+			if err := f.Check(ip, "a"); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			if err := f.Check(ip, "a"); err != nil {
+				t.Fatal(err)
+			}
+			f.Record(ip, "a")
+		}
+		AssertDeepEquals(t, f.Check(ip, "a"), common.ErrFlood)
+	})
+
+	t.Run("flooded", func(t *testing.T) {
+		AssertDeepEquals(t, f.Flooded(ip, "a"), true)
+		AssertDeepEquals(t, f.Flooded("::3", "a"), false)
+		AssertDeepEquals(t, f.Flooded(ip, "c"), false)
+	})
+
+	t.Run("other IP", func(t *testing.T) {
+		if err := f.Check("::2", "a"); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		for i := 0; i < 5; i++ {
+			if err := f.Check(ip, "c"); err != nil {
+				t.Fatal(err)
+			}
+			f.Record(ip, "c")
+		}
+		AssertDeepEquals(t, len(f.posts), 1)
+	})
+
+	t.Run("cleanup", func(t *testing.T) {
+		f.cleanup(time.Now().Add(time.Minute))
+		AssertDeepEquals(t, len(f.posts), 0)
+		if err := f.Check(ip, "a"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
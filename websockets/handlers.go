@@ -32,6 +32,8 @@ func (c *Client) runHandler(typ common.MessageType, msg []byte) error {
 		return c.spliceText(data)
 	case common.MessageInsertPost:
 		return c.insertPost(data)
+	case common.MessageCreatePost:
+		return c.createPost(data)
 	case common.MessageInsertImage:
 		return c.insertImage(data)
 	case common.MessageNOOP:
@@ -39,6 +41,11 @@ func (c *Client) runHandler(typ common.MessageType, msg []byte) error {
 		return nil
 	case common.MessageSpoiler:
 		return c.spoilerImage()
+	case common.MessageTyping:
+		return c.startTyping()
+	case common.MessageStopTyping:
+		c.stopTyping()
+		return nil
 	case common.MessageMeguTV:
 		return feeds.SubscribeToMeguTV(c)
 	default:
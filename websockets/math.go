@@ -0,0 +1,48 @@
+package websockets
+
+import (
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/latex"
+	"github.com/bakape/meguca/websockets/feeds"
+	"github.com/go-playground/log"
+)
+
+func init() {
+	common.RenderMath = RenderPostMath
+}
+
+// RenderPostMath asynchronously renders all math markup in the body of a
+// closed post, stores the URLs of the resulting SVGs with the post and
+// propagates them to the thread's feed
+func RenderPostMath(id, op uint64, body string) {
+	exprs := latex.Extract(body)
+	if len(exprs) == 0 {
+		return
+	}
+
+	go func() {
+		var (
+			svgs      = make([]string, len(exprs))
+			renderErr string
+		)
+		for i, e := range exprs {
+			url, err := latex.Default.Render(e)
+			if err != nil {
+				if renderErr == "" {
+					renderErr = err.Error()
+				}
+				continue
+			}
+			svgs[i] = url
+		}
+
+		err := db.SetPostMath(id, svgs)
+		if err == nil {
+			err = feeds.MathRendered(id, op, svgs, renderErr)
+		}
+		if err != nil {
+			log.Errorf("math: post %d: %s", id, err)
+		}
+	}()
+}
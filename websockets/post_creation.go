@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
 	"unicode/utf8"
 
@@ -12,7 +13,9 @@ import (
 	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/db"
 	"github.com/bakape/meguca/geoip"
+	"github.com/bakape/meguca/latex"
 	"github.com/bakape/meguca/parser"
+	"github.com/bakape/meguca/webhooks"
 	"github.com/bakape/meguca/websockets/feeds"
 )
 
@@ -21,12 +24,50 @@ var (
 	errInvalidImageToken = common.ErrInvalidInput("image token")
 	errImageNameTooLong  = common.ErrTooLong("image name")
 	errNoTextOrImage     = common.ErrInvalidInput("no text or image")
+	errImageLimit        = common.ErrInvalidInput("thread image limit reached")
+	errVideoTooLong      = common.ErrInvalidInput("video too long")
+	errTagsDisabled      = common.ErrInvalidInput("thread tags disabled on board")
+	errTooManyTags       = common.ErrInvalidInput("too many thread tags")
+	errInvalidTag        = common.ErrInvalidInput("invalid thread tag")
+	errDuplicateTag      = common.ErrInvalidInput("duplicate thread tag")
+	errInvalidDraftID    = common.ErrInvalidInput("draft ID")
+	errAudioDisabled     = common.StatusError{
+		Err:  errors.New("audio files disabled on board"),
+		Code: 415,
+	}
+	errFileTypeNotAllowed = common.StatusError{
+		Err:  errors.New("file type not allowed on board"),
+		Code: 415,
+	}
+	errPDFDisabled = common.StatusError{
+		Err:  errors.New("PDF files disabled on board"),
+		Code: 415,
+	}
+	errPDFTooLarge = common.StatusError{
+		Err:  errors.New("PDF file too large"),
+		Code: 413,
+	}
 )
 
 // ThreadCreationRequest contains data for creating a new thread
 type ThreadCreationRequest struct {
 	ReplyCreationRequest
+	// Create the thread, even if threads with similar subjects exist
+	IgnoreSimilar  bool
 	Subject, Board string
+	Tags           []string
+}
+
+// SimilarThreadsError is returned on thread creation, if unarchived threads
+// with subjects similar to the new thread's exist on the board. The thread is
+// not created.
+type SimilarThreadsError struct {
+	// Sorted by similarity descending
+	Threads []db.Thread
+}
+
+func (e SimilarThreadsError) Error() string {
+	return "similar threads exist"
 }
 
 // ReplyCreationRequest contains common fields for both thread and reply
@@ -61,23 +102,60 @@ func CreateThread(req ThreadCreationRequest, ip string) (
 	if err != nil {
 		return
 	}
+	err = floodGate.Check(ip, req.Board)
+	if err != nil {
+		return
+	}
+	err = checkCooldown(ip, conf)
+	if err != nil {
+		return
+	}
 	post, err = constructPost(req.ReplyCreationRequest, conf, ip)
 	if err != nil {
 		return
 	}
+	if req.Image.Token != "" {
+		err = checkMedia(req.Image.Token, conf)
+		if err != nil {
+			return
+		}
+	}
 	subject, err := parser.ParseSubject(req.Subject)
 	if err != nil {
 		return
 	}
+	err = validateThreadTags(req.Tags, conf)
+	if err != nil {
+		return
+	}
+	if !req.IgnoreSimilar {
+		var similar []db.Thread
+		similar, err = db.FindSimilarThreads(subject, req.Board)
+		if err != nil {
+			return
+		}
+		if len(similar) != 0 {
+			err = SimilarThreadsError{similar}
+			return
+		}
+	}
 
 	// Must ensure image token usage is done atomically, as not to cause
 	// possible data races with unused image cleanup
 	err = db.InTransaction(false, func(tx *sql.Tx) (err error) {
-		err = db.InsertThread(tx, subject, &post)
+		err = db.InsertThread(tx, subject, req.Tags, &post)
+		if err != nil {
+			return
+		}
+		err = writeCooldown(tx, ip, conf)
 		if err != nil {
 			return
 		}
 
+		if conf.PosterIDs {
+			post.PosterID = auth.PosterID(post.ID, ip)
+		}
+
 		if !conf.TextOnly && req.Image.Token != "" &&
 			req.Image.Name != "" {
 			err = insertImage(tx, req.Image, &post)
@@ -87,10 +165,45 @@ func CreateThread(req ThreadCreationRequest, ip string) (
 		}
 		return
 	})
+	if err != nil {
+		return
+	}
+	floodGate.Record(ip, req.Board)
 
+	if post.MathPending {
+		RenderPostMath(post.ID, post.ID, post.Body)
+	}
+	if !post.Editing {
+		FetchPostEmbeds(post.ID, post.ID, post.Body)
+	}
+	webhooks.Dispatch(post.Board, webhooks.NewThread, post.StandalonePost)
 	return
 }
 
+// Validate the tags of a thread to be created against board configurations
+func validateThreadTags(tags []string, conf config.BoardConfigs) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	if !conf.ThreadTags {
+		return errTagsDisabled
+	}
+	if len(tags) > common.MaxNumThreadTags {
+		return errTooManyTags
+	}
+	for i, t := range tags {
+		if !common.TagRegexp.MatchString(t) {
+			return errInvalidTag
+		}
+		for _, prev := range tags[:i] {
+			if prev == t {
+				return errDuplicateTag
+			}
+		}
+	}
+	return nil
+}
+
 // Insert image into a post on post creation
 func insertImage(tx *sql.Tx, req ImageRequest, p *db.Post) (err error) {
 	err = formatImageName(&req.Name)
@@ -132,6 +245,14 @@ func CreatePost(
 	if err != nil {
 		return
 	}
+	err = floodGate.Check(ip, board)
+	if err != nil {
+		return
+	}
+	err = checkCooldown(ip, conf)
+	if err != nil {
+		return
+	}
 
 	// Post must have either at least one character or an image to be allocated
 	hasImage := !conf.TextOnly && req.Image.Token != "" && req.Image.Name != ""
@@ -140,22 +261,58 @@ func CreatePost(
 		return
 	}
 
-	// Assert thread is not locked
-	locked, err := db.CheckThreadLocked(op)
-	switch {
-	case err != nil:
-		return
-	case locked:
-		err = common.StatusError{errors.New("thread is locked"), 400}
+	post, err = constructPost(req, conf, ip)
+	if err != nil {
 		return
 	}
 
-	post, err = constructPost(req, conf, ip)
+	// Assert thread is not locked or archived. Moderators posting with their
+	// staff title can still reply to locked threads, for example to leave
+	// closing remarks, but archived threads are read-only for everyone.
+	locked, err := db.CheckThreadLocked(op)
 	if err != nil {
 		return
 	}
+	if locked && post.Auth >= common.Moderator {
+		locked, err = db.CheckThreadArchived(op)
+		if err != nil {
+			return
+		}
+	}
+	if locked {
+		err = common.ErrThreadLocked
+		return
+	}
+
+	// Replies to threads past their reply limit never bump them
+	if !post.Sage {
+		var count, max int
+		count, max, err = db.GetThreadReplyLimit(op)
+		if err != nil {
+			return
+		}
+		post.Sage = count >= max
+	}
+
+	if hasImage {
+		err = checkImageLimit(op, conf)
+		if err != nil {
+			return
+		}
+		err = checkDuplicateImage(op, req.Image.Token, conf)
+		if err != nil {
+			return
+		}
+		err = checkMedia(req.Image.Token, conf)
+		if err != nil {
+			return
+		}
+	}
 
 	post.OP = op
+	if conf.PosterIDs {
+		post.PosterID = auth.PosterID(op, ip)
+	}
 
 	// Must ensure image token usage is done atomically, as not to cause
 	// possible data races with unused image cleanup
@@ -164,6 +321,10 @@ func CreatePost(
 		if err != nil {
 			return
 		}
+		err = writeCooldown(tx, ip, conf)
+		if err != nil {
+			return
+		}
 
 		if hasImage {
 			err = insertImage(tx, req.Image, &post)
@@ -174,11 +335,34 @@ func CreatePost(
 
 		return
 	})
+	if err != nil {
+		return
+	}
+	floodGate.Record(ip, board)
 
+	if post.MathPending {
+		RenderPostMath(post.ID, op, post.Body)
+	}
+	if !post.Editing {
+		FetchPostEmbeds(post.ID, op, post.Body)
+	}
+	webhooks.Dispatch(board, webhooks.NewPost, post.StandalonePost)
 	msg, err = common.EncodeMessage(common.MessageInsertPost, post.Post)
 	return
 }
 
+// AddOwnPost records a post as created within a post session and notifies the
+// connected clients of sessions, whose posts it quotes
+func AddOwnPost(token string, p common.StandalonePost) (err error) {
+	err = db.AddOwnPost(token, p.ID)
+	if err != nil {
+		return
+	}
+	feeds.Sessions.AddPost(token, p.ID)
+	feeds.NotifyQuotes(p, feeds.Sessions)
+	return
+}
+
 // Insert a new post into the database
 func (c *Client) insertPost(data []byte) (err error) {
 	err = c.closePreviousPost()
@@ -190,6 +374,7 @@ func (c *Client) insertPost(data []byte) (err error) {
 	if err != nil {
 		return
 	}
+	c.typing.setNeedCaptcha(needCaptcha)
 	if needCaptcha {
 		return c.sendMessage(common.MessageCaptcha, 0)
 	}
@@ -207,6 +392,12 @@ func (c *Client) insertPost(data []byte) (err error) {
 	if err != nil {
 		return
 	}
+	if c.session != "" {
+		// The post already exists, so do not fail its creation
+		if err := AddOwnPost(c.session, post.StandalonePost); err != nil {
+			c.logError(err)
+		}
+	}
 
 	// Ensure the client knows the post ID, before the public post insertion
 	// update message is sent
@@ -243,6 +434,280 @@ func (c *Client) closePreviousPost() error {
 	return nil
 }
 
+// Maximum length of the client-assigned ID of a post draft
+const maxLenDraftID = 64
+
+// Request to create a closed post in a single websocket message. A ThreadID
+// of zero creates a new thread on Board instead of a reply. Any image must
+// already be uploaded over HTTP and its token passed in Image.
+type postCreationMessage struct {
+	// Temporary client-assigned ID linking the draft to the response
+	DraftID       string       `json:"draftID"`
+	ThreadID      uint64       `json:"threadID"`
+	Sage          bool         `json:"sage"`
+	IgnoreSimilar bool         `json:"ignoreSimilar"`
+	Board         string       `json:"board"`
+	Subject       string       `json:"subject"`
+	Tags          []string     `json:"tags"`
+	Body          string       `json:"body"`
+	Name          string       `json:"name"`
+	Password      string       `json:"password"`
+	Image         ImageRequest `json:"image"`
+}
+
+// Response to a successful postCreationMessage
+type postCreatedMessage struct {
+	DraftID string `json:"draftID"`
+	ID      uint64 `json:"id"`
+	OP      uint64 `json:"op"`
+}
+
+// Response to a failed postCreationMessage. Code is the HTTP status code
+// equivalent of the error.
+type createErrorMessage struct {
+	DraftID    string                 `json:"draftID"`
+	Code       int                    `json:"code"`
+	Error      string                 `json:"error"`
+	RetryAfter uint                   `json:"retryAfter,omitempty"`
+	Similar    []common.SimilarThread `json:"similar,omitempty"`
+}
+
+// SimilarThreads converts the threads of a SimilarThreadsError for encoding
+func (e SimilarThreadsError) SimilarThreads() []common.SimilarThread {
+	res := make([]common.SimilarThread, len(e.Threads))
+	for i, t := range e.Threads {
+		res[i] = common.SimilarThread{
+			ID:      t.ID,
+			Board:   t.Board,
+			Subject: t.Subject,
+		}
+	}
+	return res
+}
+
+// Create a closed post or thread and respond with the ID of the new post or
+// the error, that prevented its creation
+func (c *Client) createPost(data []byte) (err error) {
+	var req postCreationMessage
+	err = decodeMessage(data, &req)
+	if err != nil {
+		return
+	}
+
+	post, err := c.createClosedPost(req)
+	if err != nil {
+		return c.sendCreateError(req.DraftID, err)
+	}
+	return c.sendMessage(common.MessagePostCreated, postCreatedMessage{
+		DraftID: req.DraftID,
+		ID:      post.ID,
+		OP:      post.OP,
+	})
+}
+
+// Validate a postCreationMessage and create a closed post from it. Runs the
+// same ban, flood and captcha checks as post creation over HTTP.
+func (c *Client) createClosedPost(req postCreationMessage) (
+	post db.Post, err error,
+) {
+	if len(req.DraftID) > maxLenDraftID {
+		err = errInvalidDraftID
+		return
+	}
+	err = c.closePreviousPost()
+	if err != nil {
+		return
+	}
+
+	needCaptcha, err := db.NeedCaptcha(c.ip)
+	if err != nil {
+		return
+	}
+	c.typing.setNeedCaptcha(needCaptcha)
+	if needCaptcha {
+		err = c.sendMessage(common.MessageCaptcha, 0)
+		if err == nil {
+			err = common.ErrInvalidCaptcha
+		}
+		return
+	}
+
+	rep := ReplyCreationRequest{
+		Sage:     req.Sage,
+		Image:    req.Image,
+		Name:     req.Name,
+		Password: req.Password,
+		Body:     req.Body,
+	}
+	isOP := req.ThreadID == 0
+	if isOP {
+		post, err = CreateThread(ThreadCreationRequest{
+			ReplyCreationRequest: rep,
+			IgnoreSimilar:        req.IgnoreSimilar,
+			Subject:              req.Subject,
+			Board:                req.Board,
+			Tags:                 req.Tags,
+		}, c.ip)
+		if err != nil {
+			return
+		}
+	} else {
+		var (
+			board string
+			op    uint64
+			msg   []byte
+		)
+		board, op, err = db.GetPostParenthood(req.ThreadID)
+		switch {
+		case err == sql.ErrNoRows, err == nil && op != req.ThreadID:
+			err = common.ErrInvalidThread(req.ThreadID, board)
+			return
+		case err != nil:
+			return
+		}
+		post, msg, err = CreatePost(op, board, c.ip, rep)
+		if err != nil {
+			return
+		}
+		feeds.InsertPostInto(post.StandalonePost, msg)
+	}
+
+	if c.session != "" {
+		// The post already exists, so do not fail its creation
+		if err := AddOwnPost(c.session, post.StandalonePost); err != nil {
+			c.logError(err)
+		}
+	}
+	err = CheckRouletteBan(post.Commands, post.Board, post.OP, post.ID)
+	if err != nil {
+		return
+	}
+
+	conf := config.Get()
+	score := conf.PostCreationScore +
+		conf.CharScore*uint(utf8.RuneCountInString(post.Body))
+	if isOP {
+		score += conf.PostCreationScore * 2
+	}
+	c.incrementSpamScore(score)
+	c.setLastTime()
+	return
+}
+
+// Report failure to create a post to the client. Internal errors are logged
+// and not disclosed to the client.
+func (c *Client) sendCreateError(draftID string, err error) error {
+	// TODO: Not all other errors are actually 400. Need to differentiate.
+	code := 400
+	var (
+		retryAfter uint
+		similar    []common.SimilarThread
+	)
+	switch e := err.(type) {
+	case common.StatusError:
+		code = e.Code
+	case common.CooldownError:
+		code = 429
+		retryAfter = e.RetryAfter
+	case SimilarThreadsError:
+		code = 409
+		similar = e.SimilarThreads()
+	}
+	msg := err.Error()
+	if code >= 500 {
+		c.logError(err)
+		msg = "internal server error"
+	}
+	return c.sendMessage(common.MessageCreateError, createErrorMessage{
+		DraftID:    draftID,
+		Code:       code,
+		Error:      msg,
+		RetryAfter: retryAfter,
+		Similar:    similar,
+	})
+}
+
+// Assert the board's post cooldown has passed since the last post by ip
+func checkCooldown(ip string, conf config.BoardConfigs) (err error) {
+	if conf.PostCooldown == 0 {
+		return
+	}
+	remaining, err := db.PostCooldown(conf.ID, ip, conf.PostCooldown)
+	if err == nil && remaining != 0 {
+		err = common.CooldownError{RetryAfter: remaining}
+	}
+	return
+}
+
+// Record a post by ip, if the board has a post cooldown
+func writeCooldown(tx *sql.Tx, ip string, conf config.BoardConfigs) error {
+	if conf.PostCooldown == 0 {
+		return nil
+	}
+	return db.WriteCooldown(tx, conf.ID, ip)
+}
+
+// Assert a thread can still accept new images
+func checkImageLimit(op uint64, conf config.BoardConfigs) (err error) {
+	if conf.ImageLimit == 0 {
+		return
+	}
+	n, err := db.GetThreadImageCount(op)
+	if err != nil {
+		return
+	}
+	if n >= int(conf.ImageLimit) {
+		err = errImageLimit
+	}
+	return
+}
+
+// Assert an image has not been posted in the thread before, if duplicate
+// images are disallowed on the board or globally
+func checkDuplicateImage(op uint64, token string, conf config.BoardConfigs,
+) (
+	err error,
+) {
+	if !conf.NoDuplicateImages && !config.Get().NoDuplicateImages {
+		return
+	}
+	id, err := db.FindDuplicateImage(op, token)
+	if err != nil || id == 0 {
+		return
+	}
+	return common.StatusError{
+		Err:  fmt.Errorf("image already posted in thread: >>%d", id),
+		Code: 409,
+	}
+}
+
+// Assert a file's media is allowed on the board. The file type must be in the
+// board's whitelist. Videos and PDFs must not exceed the board's maximum video
+// length and PDF size.
+func checkMedia(token string, conf config.BoardConfigs) (err error) {
+	if conf.MaxVideoLength == 0 && !conf.DisableAudio && !conf.DisablePDF &&
+		conf.MaxPDFSize == 0 && len(conf.FileTypes) == 0 {
+		return
+	}
+	m, err := db.GetTokenMedia(token)
+	switch {
+	case err != nil:
+	case !conf.AllowsFileType(common.Extensions[m.FileType]):
+		err = errFileTypeNotAllowed
+	case m.Video && conf.MaxVideoLength != 0 &&
+		uint(m.Length) > conf.MaxVideoLength:
+		err = errVideoTooLong
+	case m.Audio && !m.Video && conf.DisableAudio:
+		err = errAudioDisabled
+	case m.FileType == common.PDF && conf.DisablePDF:
+		err = errPDFDisabled
+	case m.FileType == common.PDF && conf.MaxPDFSize != 0 &&
+		uint(m.Size) > conf.MaxPDFSize<<20:
+		err = errPDFTooLarge
+	}
+	return
+}
+
 // Retrieve post-related board configurations
 func getBoardConfig(board string) (conf config.BoardConfigs, err error) {
 	conf = config.GetBoardConfigs(board).BoardConfigs
@@ -260,6 +725,7 @@ func constructPost(
 ) (
 	post db.Post, err error,
 ) {
+	req.Body = db.FilterWords(conf.ID, req.Body)
 	post = db.Post{
 		StandalonePost: common.StandalonePost{
 			Post: common.Post{
@@ -317,11 +783,10 @@ func constructPost(
 		}
 	}
 
-	if req.Open {
-		post.Editing = true
-
-		// Posts that are committed in one action need not a password, as they
-		// are closed on commit and can not be reclaimed
+	// Posts that are committed in one action need not a password, as they
+	// are closed on commit and can not be reclaimed. If one is provided
+	// anyway, it still allows the poster to delete the post.
+	if req.Open || req.Password != "" {
 		err = parser.VerifyPostPassword(req.Password)
 		if err != nil {
 			return
@@ -330,12 +795,34 @@ func constructPost(
 		if err != nil {
 			return
 		}
+	}
+
+	err = parser.CheckDice(post.Body)
+	if err != nil {
+		return
+	}
+	if req.Open {
+		post.Editing = true
 	} else {
+		post.Body, err = parser.DrawFortunes(post.Body, "", conf.ID)
+		if err != nil {
+			return
+		}
+		post.Body, post.HasDiceRoll, err = parser.RollDice(post.Body, "")
+		if err != nil {
+			return
+		}
+		err = latex.Validate(post.Body)
+		if err != nil {
+			return
+		}
+		post.MathPending = common.MathRegexp.MatchString(post.Body)
+
 		// TODO: Move DB checks out of the parser. The parser should just parse.
 		// Return slices of pointers to links and commands that need to be
 		// validated.
 		post.Links, post.Commands, err = parser.ParseBody(
-			[]byte(req.Body),
+			[]byte(post.Body),
 			conf.ID,
 			post.OP,
 			post.ID,
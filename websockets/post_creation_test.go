@@ -102,10 +102,12 @@ func testCreateThread(t *testing.T) {
 	}
 
 	std := common.Thread{
-		Board:      "c",
-		Subject:    "subject",
-		ImageCount: 1,
-		PostCount:  1,
+		Board:         "c",
+		Subject:       "subject",
+		ImageCount:    1,
+		PostCount:     1,
+		UniquePosters: 1,
+		MaxReplies:    common.BumpLimit,
 		Post: common.Post{
 			Name: "name",
 			Image: &common.Image{
@@ -136,7 +138,7 @@ func testCreateThread(t *testing.T) {
 	}
 	std.ID = p.ID
 
-	thread, err := db.GetThread(p.ID, 0)
+	thread, err := db.GetThread(p.ID, 0, 0)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -148,6 +150,8 @@ func testCreateThread(t *testing.T) {
 	then := thread.UpdateTime
 	std.UpdateTime = then
 	std.BumpTime = then
+	std.LastReplyTime = then
+	std.LastImageTime = &then
 	std.Time = then
 	std.Image = thread.Image
 
@@ -241,6 +245,45 @@ func TestClosePreviousPostOnCreation(t *testing.T) {
 	assertPostClosed(t, 2)
 }
 
+func TestClosePreviousPostOnClosedCreation(t *testing.T) {
+	feeds.Clear()
+	test_db.ClearTables(t, "boards")
+	test_db.WriteSampleBoard(t)
+	test_db.WriteSampleThread(t)
+	writeSamplePost(t)
+	if err := db.SetPostCounter(5); err != nil {
+		t.Fatal(err)
+	}
+	setBoardConfigs(t, true)
+
+	sv := newWSServer(t)
+	defer sv.Close()
+	cl, wcl := sv.NewClient()
+	registerClient(t, cl, 1, "a")
+	cl.post = openPost{
+		id:    2,
+		op:    1,
+		len:   3,
+		board: "a",
+		time:  time.Now().Unix(),
+		body:  []byte("abc"),
+	}
+
+	err := cl.createPost(marshalJSON(t, postCreationMessage{
+		DraftID:  "foo",
+		ThreadID: 1,
+		Body:     "bar",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertMessage(t, wcl, encodeMessageType(common.MessagePostCreated)+
+		`{"draftID":"foo","id":6,"op":1}`)
+	assertPostClosed(t, 2)
+	AssertDeepEquals(t, cl.post, openPost{})
+}
+
 func TestPostCreationValidations(t *testing.T) {
 	setBoardConfigs(t, false)
 
@@ -275,6 +318,45 @@ func TestPostCreationValidations(t *testing.T) {
 	}
 }
 
+func TestThreadTagValidation(t *testing.T) {
+	t.Parallel()
+
+	enabled := config.BoardConfigs{
+		BoardPublic: config.BoardPublic{
+			ThreadTags: true,
+		},
+	}
+	cases := [...]struct {
+		name string
+		tags []string
+		conf config.BoardConfigs
+		err  error
+	}{
+		{"no tags", nil, config.BoardConfigs{}, nil},
+		{"valid", []string{"tech", "news-2"}, enabled, nil},
+		{"disabled", []string{"tech"}, config.BoardConfigs{}, errTagsDisabled},
+		{
+			"too many",
+			[]string{"a", "b", "c", "d", "e", "f"},
+			enabled,
+			errTooManyTags,
+		},
+		{"invalid", []string{"Tech"}, enabled, errInvalidTag},
+		{"duplicate", []string{"tech", "tech"}, enabled, errDuplicateTag},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := validateThreadTags(c.tags, c.conf); err != c.err {
+				UnexpectedError(t, err)
+			}
+		})
+	}
+}
+
 func TestPostCreation(t *testing.T) {
 	feeds.Clear()
 	prepareForPostCreation(t)
@@ -340,7 +422,7 @@ func TestPostCreation(t *testing.T) {
 
 	assertIP(t, 6, "::1")
 
-	thread, err := db.GetThread(1, 0)
+	thread, err := db.GetThread(1, 0, 0)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -384,6 +466,180 @@ func prepareForPostCreation(t testing.TB) {
 	}
 }
 
+func TestCreatePostMessage(t *testing.T) {
+	feeds.Clear()
+	prepareForPostCreation(t)
+	setBoardConfigs(t, false)
+
+	sv := newWSServer(t)
+	defer sv.Close()
+	cl, wcl := sv.NewClient()
+	cl.ip = "::1"
+	registerClient(t, cl, 1, "a")
+	defer cl.Close(nil)
+
+	err := cl.createPost(marshalJSON(t, postCreationMessage{
+		DraftID:  "foo",
+		ThreadID: 1,
+		Body:     "bar",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertMessage(t, wcl, encodeMessageType(common.MessagePostCreated)+
+		`{"draftID":"foo","id":6,"op":1}`)
+	post, err := db.GetPost(6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, post.Body, "bar")
+	AssertDeepEquals(t, post.Editing, false)
+
+	err = cl.createPost(marshalJSON(t, postCreationMessage{
+		DraftID:  "baz",
+		ThreadID: 99,
+		Body:     "bar",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertMessage(t, wcl, encodeMessageType(common.MessageCreateError)+
+		`{"draftID":"baz","code":404,"error":"no thread 99 on board `+"``"+`"}`)
+}
+
+func TestCreateSimilarThread(t *testing.T) {
+	feeds.Clear()
+	prepareForPostCreation(t)
+	setBoardConfigs(t, true)
+
+	const subject = "Linux distro general"
+	_, err := CreateThread(ThreadCreationRequest{
+		ReplyCreationRequest: ReplyCreationRequest{
+			Body: "foo",
+		},
+		Subject: subject,
+		Board:   "a",
+	}, "::1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sv := newWSServer(t)
+	defer sv.Close()
+	cl, wcl := sv.NewClient()
+	cl.ip = "::2"
+	registerClient(t, cl, 0, "a")
+	defer cl.Close(nil)
+
+	req := postCreationMessage{
+		DraftID: "foo",
+		Board:   "a",
+		Subject: subject,
+		Body:    "bar",
+	}
+	err = cl.createPost(marshalJSON(t, req))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertMessage(t, wcl, encodeMessageType(common.MessageCreateError)+
+		`{"draftID":"foo","code":409,"error":"similar threads exist",`+
+		`"similar":[{"id":6,"board":"a","subject":"Linux distro general"}]}`)
+
+	req.IgnoreSimilar = true
+	err = cl.createPost(marshalJSON(t, req))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertMessage(t, wcl, encodeMessageType(common.MessagePostCreated)+
+		`{"draftID":"foo","id":7,"op":7}`)
+}
+
+func TestReplyToLockedThread(t *testing.T) {
+	feeds.Clear()
+	prepareForPostCreation(t)
+	setBoardConfigs(t, false)
+	if err := db.SetThreadLock(1, true, "admin"); err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err := CreatePost(1, "a", "::1", ReplyCreationRequest{
+		Body: "foo",
+	})
+	if err != common.ErrThreadLocked {
+		UnexpectedError(t, err)
+	}
+}
+
+func TestReplyPastReplyLimit(t *testing.T) {
+	feeds.Clear()
+	prepareForPostCreation(t)
+	setBoardConfigs(t, false)
+	if err := db.SetThreadMaxReplies(1, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	post, _, err := CreatePost(1, "a", "::1", ReplyCreationRequest{
+		Body: "foo",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, post.Sage, true)
+
+	thread, err := db.GetThread(1, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, thread.MaxReplies, 1)
+	AssertDeepEquals(t, thread.Saged, true)
+}
+
+func TestImageLimit(t *testing.T) {
+	feeds.Clear()
+	prepareForPostCreation(t)
+	config.ClearBoards()
+	_, err := config.SetBoardConfigs(config.BoardConfigs{
+		ID: "a",
+		BoardPublic: config.BoardPublic{
+			ImageLimit: 1,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeSampleImage(t)
+
+	for i, std := range [...]error{nil, errImageLimit} {
+		var token string
+		err := db.InTransaction(false, func(tx *sql.Tx) (err error) {
+			token, err = db.NewImageToken(tx, stdJPEG.SHA1)
+			return
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, _, err = CreatePost(1, "a", "::1", ReplyCreationRequest{
+			Image: ImageRequest{
+				Name:  "foo.jpeg",
+				Token: token,
+			},
+		})
+		if err != std {
+			t.Fatalf("post %d: unexpected error: %v", i, err)
+		}
+	}
+
+	thread, err := db.GetThread(1, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if thread.ImagesRemaining == nil {
+		t.Fatal("no remaining image count")
+	}
+	AssertDeepEquals(t, *thread.ImagesRemaining, 0)
+}
+
 func TestTextOnlyPostCreation(t *testing.T) {
 	feeds.Clear()
 	prepareForPostCreation(t)
@@ -11,8 +11,10 @@ import (
 	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/latex"
 	"github.com/bakape/meguca/parser"
 	"github.com/bakape/meguca/util"
+	"github.com/bakape/meguca/websockets/feeds"
 )
 
 var (
@@ -111,6 +113,12 @@ func (c *Client) appendRune(data []byte) (err error) {
 	if err != nil {
 		return
 	}
+	if char == ']' {
+		err = parser.CheckDice(string(c.post.body) + "]")
+		if err != nil {
+			return
+		}
+	}
 
 	msg, err := common.EncodeMessage(
 		common.MessageAppend,
@@ -178,6 +186,14 @@ func (c *Client) closePost() (err error) {
 		com   []common.Command
 	)
 	if c.post.len != 0 {
+		err = c.evaluateMarkup()
+		if err != nil {
+			return
+		}
+		err = latex.Validate(string(c.post.body))
+		if err != nil {
+			return
+		}
 		links, com, err = parser.ParseBody(c.post.body, c.post.board, c.post.op,
 			c.post.id, c.ip, false)
 		if err != nil {
@@ -190,11 +206,52 @@ func (c *Client) closePost() (err error) {
 		return
 	}
 
+	RenderPostMath(c.post.id, c.post.op, string(c.post.body))
+	FetchPostEmbeds(c.post.id, c.post.op, string(c.post.body))
+	feeds.NotifyQuotes(common.StandalonePost{
+		Post: common.Post{
+			ID:    c.post.id,
+			Links: links,
+		},
+		OP:    c.post.op,
+		Board: c.post.board,
+	}, feeds.Sessions)
 	err = CheckRouletteBan(com, c.post.board, c.post.op, c.post.id)
 	c.post = openPost{}
 	return
 }
 
+// Evaluate any dice roll and fortune markup in the open post and propagate
+// the new body to all clients as a splice of the entire body
+func (c *Client) evaluateMarkup() (err error) {
+	old := string(c.post.body)
+	body, err := parser.DrawFortunes(old, "", c.post.board)
+	if err != nil {
+		return
+	}
+	body, _, err = parser.RollDice(body, "")
+	if err != nil || body == old {
+		return
+	}
+
+	msg, err := common.EncodeMessage(common.MessageSplice, spliceMessage{
+		ID: c.post.id,
+		spliceRequestString: spliceRequestString{
+			spliceCoords: spliceCoords{
+				Len: uint(c.post.len),
+			},
+			Text: body,
+		},
+	})
+	if err != nil {
+		return
+	}
+	c.post.body = []byte(body)
+	c.post.len = utf8.RuneCountInString(body)
+	c.feed.SetOpenBody(c.post.id, body, msg)
+	return
+}
+
 // CheckRouletteBan meme bans if the poster lost at #roulette
 func CheckRouletteBan(commands []common.Command, board string, thread uint64, id uint64) error {
 	for _, command := range commands {
@@ -265,8 +322,8 @@ func (c *Client) spliceText(data []byte) error {
 	var (
 		old = []rune(string(c.post.body))
 		end = append(req.Text, old[req.Start+req.Len:]...)
+		n   = c.post.len - int(req.Len) + len(req.Text)
 	)
-	c.post.len += -int(req.Len) + len(req.Text)
 	res := spliceMessage{
 		ID: c.post.id,
 		spliceRequestString: spliceRequestString{
@@ -276,13 +333,19 @@ func (c *Client) spliceText(data []byte) error {
 	}
 
 	// If it goes over the max post length, trim the end
-	exceeding := c.post.len - common.MaxLenBody
+	exceeding := n - common.MaxLenBody
 	if exceeding > 0 {
 		end = end[:len(end)-exceeding]
 		res.Len = uint(len(old[int(req.Start):]))
 		res.Text = string(end)
-		c.post.len = common.MaxLenBody
+		n = common.MaxLenBody
+	}
+
+	err = parser.CheckDice(string(old[:req.Start]) + string(end))
+	if err != nil {
+		return err
 	}
+	c.post.len = n
 
 	msg, err := common.EncodeMessage(common.MessageSplice, res)
 	if err != nil {
@@ -328,9 +391,22 @@ func (c *Client) insertImage(data []byte) (err error) {
 		return
 	}
 
-	if config.GetBoardConfigs(c.post.board).TextOnly {
+	conf := config.GetBoardConfigs(c.post.board).BoardConfigs
+	if conf.TextOnly {
 		return errTextOnly
 	}
+	err = checkImageLimit(c.post.op, conf)
+	if err != nil {
+		return
+	}
+	err = checkDuplicateImage(c.post.op, req.Token, conf)
+	if err != nil {
+		return
+	}
+	err = checkMedia(req.Token, conf)
+	if err != nil {
+		return
+	}
 
 	err = formatImageName(&req.Name)
 	if err != nil {
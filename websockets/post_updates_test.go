@@ -7,6 +7,7 @@ import (
 	. "github.com/bakape/meguca/test"
 	"github.com/bakape/meguca/test/test_db"
 	"github.com/bakape/meguca/websockets/feeds"
+	"strings"
 	"testing"
 	"time"
 	"unicode/utf8"
@@ -86,6 +87,25 @@ func TestAppendBodyTooLong(t *testing.T) {
 	}
 }
 
+func TestAppendTooManyDice(t *testing.T) {
+	t.Parallel()
+
+	sv := newWSServer(t)
+	defer sv.Close()
+
+	body := strings.Repeat("[dice 1d6]", common.MaxDiceMarkup) + "[dice 1d6"
+	cl, _ := sv.NewClient()
+	cl.post = openPost{
+		id:   1,
+		time: time.Now().Unix(),
+		len:  len(body),
+		body: []byte(body),
+	}
+	err := cl.appendRune(marshalJSON(t, ']'))
+	AssertDeepEquals(t, err, common.ErrInvalidInput("too many dice rolls"))
+	AssertDeepEquals(t, string(cl.post.body), body)
+}
+
 func TestAppendRune(t *testing.T) {
 	feeds.Clear()
 	test_db.ClearTables(t, "boards")
@@ -374,6 +394,40 @@ func TestClosePost(t *testing.T) {
 	assertPostClosed(t, 2)
 }
 
+func TestClosePostWithDice(t *testing.T) {
+	feeds.Clear()
+	test_db.ClearTables(t, "boards")
+	test_db.WriteSampleBoard(t)
+	test_db.WriteSampleThread(t)
+	writeSamplePost(t)
+
+	sv := newWSServer(t)
+	defer sv.Close()
+	cl, _ := sv.NewClient()
+	registerClient(t, cl, 1, "a")
+	cl.post = openPost{
+		id:    2,
+		op:    1,
+		len:   10,
+		board: "a",
+		body:  []byte("[dice 1d1]"),
+	}
+	cl.feed.InsertPost(samplePost.Post, nil)
+
+	if err := cl.closePost(); err != nil {
+		t.Fatal(err)
+	}
+
+	assertBody(t, 2, "[dice 1d1: 1 = 1]")
+	assertPostClosed(t, 2)
+
+	post, err := db.GetPost(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, post.HasDiceRoll, true)
+}
+
 func assertPostClosed(t *testing.T, id uint64) {
 	t.Helper()
 
@@ -425,6 +479,12 @@ func TestSpliceValidityChecks(t *testing.T) {
 		},
 		{"NOOP", 0, 0, "", "", errSpliceNOOP},
 		{"too long", 0, 0, tooLong, "", errSpliceTooLong},
+		{
+			"too many dice",
+			0, 0,
+			strings.Repeat("[dice 1d6]", common.MaxDiceMarkup+1), "",
+			common.ErrInvalidInput("too many dice rolls"),
+		},
 	}
 
 	for i := range cases {
@@ -0,0 +1,117 @@
+package websockets
+
+import (
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/bakape/meguca/common"
+)
+
+// Upper bound of the reconnection delay hint sent on shutdown
+const maxReconnectDelay = 30
+
+var (
+	errShuttingDown = errors.New("server shutting down")
+
+	// All open connections, including not yet synchronised ones
+	connections = struct {
+		sync.Mutex
+		clients      map[*Client]struct{}
+		wg           sync.WaitGroup
+		shuttingDown bool
+	}{
+		clients: make(map[*Client]struct{}),
+	}
+)
+
+// Payload of common.MessageReconnectAfter
+type reconnectAfterMessage struct {
+	Delay int `json:"delay"`
+}
+
+// Register an open connection. Returns errShuttingDown, if the server no
+// longer accepts connections.
+func addConnection(c *Client) error {
+	connections.Lock()
+	defer connections.Unlock()
+
+	if connections.shuttingDown {
+		return errShuttingDown
+	}
+	connections.clients[c] = struct{}{}
+	connections.wg.Add(1)
+	return nil
+}
+
+func removeConnection(c *Client) {
+	connections.Lock()
+	defer connections.Unlock()
+
+	if _, ok := connections.clients[c]; ok {
+		delete(connections.clients, c)
+		connections.wg.Done()
+	}
+}
+
+// Shutdown stops accepting new connections and sends every connected client a
+// hint to reconnect after a random delay in [0, 30] seconds, spreading out
+// reconnections once the server is back up. Each connection is closed after
+// its pending messages and the hint are written. Connections still open after
+// timeout are closed forcefully.
+func Shutdown(timeout time.Duration) {
+	connections.Lock()
+	connections.shuttingDown = true
+	cls := make([]*Client, 0, len(connections.clients))
+	for c := range connections.clients {
+		cls = append(cls, c)
+	}
+	connections.Unlock()
+
+	for _, c := range cls {
+		msg, _ := common.EncodeMessage(common.MessageReconnectAfter,
+			reconnectAfterMessage{
+				Delay: rand.Intn(maxReconnectDelay + 1),
+			})
+		c.shutdown(msg)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		connections.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		for _, c := range cls {
+			c.Close(errShuttingDown)
+		}
+	}
+}
+
+// Request the client to flush its pending messages, send msg and close
+func (c *Client) shutdown(msg []byte) {
+	select {
+	case c.shutdownMsg <- msg:
+	default:
+	}
+}
+
+// Write all messages buffered for sending and the final shutdown message
+func (c *Client) flushOnShutdown(msg []byte) error {
+	for {
+		select {
+		case buf := <-c.sendExternal:
+			if err := c.send(buf); err != nil {
+				return err
+			}
+		default:
+			if err := c.send(msg); err != nil {
+				return err
+			}
+			return errShuttingDown
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package websockets
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bakape/meguca/common"
+	"github.com/gorilla/websocket"
+)
+
+func TestShutdown(t *testing.T) {
+	defer func() {
+		connections.Lock()
+		connections.shuttingDown = false
+		connections.Unlock()
+	}()
+
+	sv := newWSServer(t)
+	defer sv.Close()
+	cl, wcl := sv.NewClient()
+	if err := addConnection(cl); err != nil {
+		t.Fatal(err)
+	}
+	sv.Add(1)
+	go func() {
+		defer sv.Done()
+		defer removeConnection(cl)
+		cl.listen()
+	}()
+
+	// Pending messages are written before the reconnection hint
+	cl.Send([]byte("foo"))
+	go Shutdown(time.Second)
+	assertMessage(t, wcl, "foo")
+
+	_, msg, err := wcl.ReadMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	prefix := encodeMessageType(common.MessageReconnectAfter)
+	if !strings.HasPrefix(string(msg), prefix) {
+		t.Fatalf("unexpected message: %s", msg)
+	}
+	var res reconnectAfterMessage
+	if err := json.Unmarshal(msg[len(prefix):], &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.Delay < 0 || res.Delay > maxReconnectDelay {
+		t.Fatalf("delay out of range: %d", res.Delay)
+	}
+
+	_, _, err = wcl.ReadMessage()
+	if !websocket.IsCloseError(err, websocket.CloseGoingAway) {
+		t.Fatalf("unexpected close: %v", err)
+	}
+	sv.Wait()
+
+	// No new connections accepted
+	if err := addConnection(cl); err != errShuttingDown {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
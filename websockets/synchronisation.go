@@ -16,7 +16,7 @@ import (
 type syncRequest struct {
 	Last100, Catalog      bool
 	Page, ProtocolVersion uint
-	Thread                uint64
+	Thread, LastSeq       uint64
 	Board                 string
 }
 
@@ -30,22 +30,14 @@ type reclaimRequest struct {
 func (c *Client) synchronise(data []byte) error {
 	var msg syncRequest
 	err := decodeMessage(data, &msg)
-	switch {
-	case err != nil:
+	if err != nil {
 		return err
-	case !auth.IsBoard(msg.Board):
-		return common.ErrInvalidBoard(msg.Board)
-	case msg.Thread != 0:
-		valid, err := db.ValidateOP(msg.Thread, msg.Board)
-		switch {
-		case err != nil:
-			return err
-		case !valid:
-			return common.ErrInvalidThread(msg.Thread, msg.Board)
-		}
 	}
-
-	err = db.IsBanned(msg.Board, c.ip)
+	if msg.Thread != 0 {
+		err = canAccessThread(c.ident, msg.Thread, msg.Board)
+	} else {
+		err = canAccessBoard(c.ident, msg.Board)
+	}
 	if err != nil {
 		return err
 	}
@@ -67,6 +59,7 @@ func (c *Client) synchronise(data []byte) error {
 
 // Register fresh client sync or change from previous sync
 func (c *Client) registerSync(req syncRequest) (err error) {
+	c.stopTyping()
 	if c.post.id != 0 {
 		err = c.closePreviousPost()
 		if err != nil {
@@ -75,9 +68,12 @@ func (c *Client) registerSync(req syncRequest) (err error) {
 	}
 
 	c.feed, err = feeds.SyncClient(c, req.Thread, req.Board)
-	if err != nil || req.Thread != 0 {
+	if err != nil {
 		return
 	}
+	if req.Thread != 0 {
+		return c.replayEvents(req)
+	}
 	if req.ProtocolVersion != common.ProtocolVersion {
 		return c.sendMessage(common.MessageSynchronise, nil)
 	}
@@ -94,6 +90,23 @@ func (c *Client) registerSync(req syncRequest) (err error) {
 	return c.send(common.PrependMessageType(common.MessageSynchronise, json))
 }
 
+// Replay post insertions the client missed since its last received sequence
+// number on the board and send it the current sequence number
+func (c *Client) replayEvents(req syncRequest) error {
+	if req.LastSeq != 0 {
+		events, ok := feeds.Replay(req.Board, req.Thread, req.LastSeq)
+		if !ok {
+			return c.sendMessage(common.MessageReplayLost, nil)
+		}
+		for _, e := range events {
+			if err := c.send(e.Msg); err != nil {
+				return err
+			}
+		}
+	}
+	return c.sendMessage(common.MessageEventSeq, feeds.CurrentSeq(req.Board))
+}
+
 // Reclaim an open post after connection loss or navigating away.
 //
 // TODO: Technically there is no locking performed so a single post may be open
@@ -102,6 +102,28 @@ func TestRegisterSync(t *testing.T) {
 	}
 }
 
+func TestReplayLost(t *testing.T) {
+	feeds.Clear()
+	test_db.ClearTables(t, "boards")
+	test_db.WriteSampleBoard(t)
+	test_db.WriteSampleThread(t)
+
+	sv := newWSServer(t)
+	defer sv.Close()
+	cl, wcl := sv.NewClient()
+
+	// Sequence number from before a server restart
+	err := cl.registerSync(syncRequest{
+		Thread:  1,
+		Board:   "a",
+		LastSeq: 5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertMessage(t, wcl, encodeMessageType(common.MessageReplayLost)+"null")
+}
+
 func assertSyncID(t *testing.T, cl *Client, id uint64, board string) {
 	t.Helper()
 
@@ -146,6 +168,7 @@ func TestSyncToThread(t *testing.T) {
 	sv.Add(1)
 	go readListenErrors(t, cl, sv)
 
+	sendMessage(t, wcl, common.MessageAuth, authRequest{})
 	sendMessage(t, wcl, common.MessageSynchronise, syncRequest{
 		Board:  "a",
 		Thread: 1,
@@ -153,6 +176,7 @@ func TestSyncToThread(t *testing.T) {
 
 	skipMessage(t, wcl)
 	skipMessage(t, wcl)
+	skipMessage(t, wcl) // Replay sequence number
 	assertMessage(t, wcl, "33[\"35{\\\"active\\\":0,\\\"total\\\":1}\"]")
 	assertSyncID(t, cl, 1, "a")
 
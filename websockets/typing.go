@@ -0,0 +1,134 @@
+package websockets
+
+import (
+	"sync"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/websockets/feeds"
+)
+
+const (
+	// Minimum interval between relayed typing messages of a client
+	typingThrottle = time.Second
+
+	// Time after the last typing message, when the client is considered to
+	// have stopped typing
+	typingTimeout = 5 * time.Second
+)
+
+// Typing indicator state of a client. Modified both from the client's
+// listener loop and the timeout timer.
+type typingState struct {
+	mu sync.Mutex
+	// Thread the client is typing in and its typist ID there, if any
+	op uint64
+	id string
+	// Cached captcha requirement of the client. Refreshed, when the client
+	// starts typing in a different thread or creates a post.
+	captchaOp   uint64
+	needCaptcha bool
+	// Last time a typing message was handled
+	last  time.Time
+	timer *time.Timer
+}
+
+// Relayed typing indicator message. ID is the typist's poster ID in the
+// thread, if the board displays poster IDs, and a random ID otherwise.
+type typingMessage struct {
+	ID string `json:"id"`
+}
+
+// Handle a client starting to type in its synced thread and relay this to
+// other clients in the thread
+func (c *Client) startTyping() error {
+	synced, op, board := feeds.GetSync(c)
+	if !synced || op == 0 {
+		return nil
+	}
+
+	t := &c.typing
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.timer == nil {
+		t.timer = time.AfterFunc(typingTimeout, c.stopTyping)
+	} else {
+		t.timer.Reset(typingTimeout)
+	}
+
+	now := time.Now()
+	if now.Sub(t.last) < typingThrottle {
+		return nil
+	}
+	t.last = now
+
+	// Do not reveal clients, that can not post right now
+	if floodGate.Flooded(c.ip, board) {
+		return nil
+	}
+	var err error
+	if t.captchaOp != op {
+		t.needCaptcha, err = db.NeedCaptcha(c.ip)
+		if err != nil {
+			return err
+		}
+		t.captchaOp = op
+	}
+	if t.needCaptcha {
+		return nil
+	}
+
+	if t.op != op {
+		// Only reveal poster IDs on boards, that display them
+		if config.GetBoardConfigs(board).PosterIDs {
+			t.id = auth.PosterID(op, c.ip)
+		} else {
+			t.id, err = auth.RandomID(6)
+			if err != nil {
+				return err
+			}
+		}
+		t.op = op
+	}
+	return relayTyping(common.MessageTyping, t.op, t.id, c)
+}
+
+// Cache the captcha requirement of the client, as checked on post creation
+func (t *typingState) setNeedCaptcha(need bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.needCaptcha = need
+}
+
+// Stop the client's typing indicator, if any, and relay this to other clients
+// in the thread
+func (c *Client) stopTyping() {
+	t := &c.typing
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.timer != nil {
+		t.timer.Stop()
+	}
+	if t.op == 0 {
+		return
+	}
+	relayTyping(common.MessageStopTyping, t.op, t.id, c)
+	t.op = 0
+	t.id = ""
+}
+
+func relayTyping(typ common.MessageType, op uint64, id string, from *Client,
+) error {
+	msg, err := common.EncodeMessage(typ, typingMessage{id})
+	if err != nil {
+		return err
+	}
+	// Do not block the client on slow receivers
+	go feeds.RelayToThread(op, msg, from)
+	return nil
+}
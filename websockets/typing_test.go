@@ -0,0 +1,152 @@
+package websockets
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	. "github.com/bakape/meguca/test"
+	"github.com/bakape/meguca/websockets/feeds"
+)
+
+func TestTypingIndicator(t *testing.T) {
+	feeds.Clear()
+	prepareForPostCreation(t)
+	setPosterIDs(t, true)
+
+	sv := newWSServer(t)
+	defer sv.Close()
+	typist, _ := sv.NewClient()
+	typist.ip = "::1"
+	registerClient(t, typist, 1, "a")
+	defer typist.Close(nil)
+	other, _ := sv.NewClient()
+	other.ip = "::2"
+	registerClient(t, other, 1, "a")
+	defer other.Close(nil)
+
+	id := auth.PosterID(1, typist.ip)
+	std := `{"id":"` + id + `"}`
+
+	if err := typist.startTyping(); err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, awaitRelayed(t, other, common.MessageTyping), std)
+	AssertDeepEquals(t, awaitRelayed(t, typist, common.MessageTyping), "")
+
+	// Throttled
+	if err := typist.startTyping(); err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, awaitRelayed(t, other, common.MessageTyping), "")
+
+	typist.stopTyping()
+	AssertDeepEquals(t, awaitRelayed(t, other, common.MessageStopTyping), std)
+
+	// Already stopped
+	typist.stopTyping()
+	AssertDeepEquals(t, awaitRelayed(t, other, common.MessageStopTyping), "")
+}
+
+func TestTypingIndicatorWithoutPosterIDs(t *testing.T) {
+	feeds.Clear()
+	prepareForPostCreation(t)
+	setPosterIDs(t, false)
+
+	sv := newWSServer(t)
+	defer sv.Close()
+	typist, _ := sv.NewClient()
+	typist.ip = "::1"
+	registerClient(t, typist, 1, "a")
+	defer typist.Close(nil)
+	other, _ := sv.NewClient()
+	other.ip = "::2"
+	registerClient(t, other, 1, "a")
+	defer other.Close(nil)
+
+	if err := typist.startTyping(); err != nil {
+		t.Fatal(err)
+	}
+	msg := awaitRelayed(t, other, common.MessageTyping)
+	if msg == "" {
+		t.Fatal("typing not relayed")
+	}
+	if strings.Contains(msg, auth.PosterID(1, typist.ip)) {
+		t.Fatalf("poster ID relayed: %s", msg)
+	}
+
+	typist.stopTyping()
+	AssertDeepEquals(t, awaitRelayed(t, other, common.MessageStopTyping), msg)
+}
+
+func TestTypingIndicatorCachedCaptcha(t *testing.T) {
+	feeds.Clear()
+	prepareForPostCreation(t)
+	setPosterIDs(t, true)
+
+	sv := newWSServer(t)
+	defer sv.Close()
+	typist, _ := sv.NewClient()
+	typist.ip = "::1"
+	registerClient(t, typist, 1, "a")
+	defer typist.Close(nil)
+	other, _ := sv.NewClient()
+	other.ip = "::2"
+	registerClient(t, other, 1, "a")
+	defer other.Close(nil)
+
+	// Captcha requirement cached on post creation
+	typist.typing.setNeedCaptcha(true)
+	typist.typing.captchaOp = 1
+	if err := typist.startTyping(); err != nil {
+		t.Fatal(err)
+	}
+	AssertDeepEquals(t, awaitRelayed(t, other, common.MessageTyping), "")
+
+	typist.typing.setNeedCaptcha(false)
+	typist.typing.last = time.Time{}
+	if err := typist.startTyping(); err != nil {
+		t.Fatal(err)
+	}
+	if awaitRelayed(t, other, common.MessageTyping) == "" {
+		t.Fatal("typing not relayed")
+	}
+}
+
+// Set the configuration of board "a" with poster IDs enabled or disabled
+func setPosterIDs(t *testing.T, on bool) {
+	t.Helper()
+
+	config.ClearBoards()
+	_, err := config.SetBoardConfigs(config.BoardConfigs{
+		ID: "a",
+		BoardPublic: config.BoardPublic{
+			PosterIDs: on,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Wait for a relayed message of the specified type in the client's send
+// buffer and return its payload. Returns "", if none arrives in time.
+func awaitRelayed(t *testing.T, cl *Client, typ common.MessageType) string {
+	t.Helper()
+
+	prefix := encodeMessageType(typ)
+	timeout := time.After(200 * time.Millisecond)
+	for {
+		select {
+		case msg := <-cl.sendExternal:
+			if s := string(msg); strings.HasPrefix(s, prefix+"{") {
+				return s[len(prefix):]
+			}
+		case <-timeout:
+			return ""
+		}
+	}
+}
@@ -5,6 +5,7 @@ package websockets
 import (
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"strconv"
 	"sync"
@@ -19,14 +20,27 @@ import (
 	"github.com/gorilla/websocket"
 )
 
-const pingWriteTimeout = time.Second * 30
+const (
+	pingWriteTimeout = time.Second * 30
+
+	// Time a client has to authenticate after connecting
+	authTimeout = time.Second * 10
+
+	// Maximum time a write to a client may take, before the client is
+	// considered a stalled consumer and disconnected
+	writeTimeout = time.Second * 10
+)
 
 var (
+	errSendDeadline = errors.New("send deadline exceeded")
+	errAuthTimeout  = errors.New("authentication timed out")
+
 	// Overrideable for faster tests
 	pingTimer = time.Minute
 
 	upgrader = websocket.Upgrader{
-		HandshakeTimeout: 5 * time.Second,
+		HandshakeTimeout:  5 * time.Second,
+		EnableCompression: true,
 		CheckOrigin: func(_ *http.Request) bool {
 			return true
 		},
@@ -53,10 +67,12 @@ func (e errInvalidFrame) Error() string {
 type Client struct {
 	// Client is requesting only the last 100 posts
 	last100 bool
-	// Have received first message, which must be a common.MessageSynchronise
+	// Have received first message, which must be a common.MessageAuth
 	gotFirstMessage bool
 	// Post currently open by the client
 	post openPost
+	// Typing indicator state
+	typing typingState
 	// Protects checking and setting interface properties through the
 	// common.Client interface
 	mu sync.RWMutex
@@ -64,8 +80,15 @@ type Client struct {
 	feed *feeds.Feed
 	// Underlying websocket connection
 	conn *websocket.Conn
+	// Underlying network connection, if permessage-deflate was negotiated.
+	// Used for measuring the compression ratio.
+	wire *countingConn
 	// Client IP
 	ip string
+	// Identity the client authenticated as
+	ident auth.Ident
+	// Token of the session tracking posts created by the client
+	session string
 	// Client last post time
 	lastTime int64
 	// Internal message receiver channel
@@ -76,6 +99,8 @@ type Client struct {
 	redirect chan string
 	// Close the client and free all used resources
 	close chan error
+	// Flush pending messages, send the final message and close the client
+	shutdownMsg chan []byte
 }
 
 type receivedMessage struct {
@@ -102,7 +127,17 @@ func Handler(w http.ResponseWriter, r *http.Request) (err error) {
 	}
 	defer feeds.UnregisterIP(ip)
 
-	conn, err := upgrader.Upgrade(w, r, nil)
+	session, cookie, err := auth.PostSession(r)
+	if err != nil {
+		return
+	}
+	var header http.Header
+	if cookie != nil {
+		header = http.Header{"Set-Cookie": {cookie.String()}}
+	}
+
+	cw := &countingResponseWriter{ResponseWriter: w}
+	conn, err := upgrader.Upgrade(cw, r, header)
 	if err != nil {
 		return
 	}
@@ -111,6 +146,24 @@ func Handler(w http.ResponseWriter, r *http.Request) (err error) {
 	if err != nil {
 		return
 	}
+	c.session = session
+	if negotiateCompression(r) {
+		enableCompression(conn)
+		c.wire = cw.conn
+	}
+
+	own, err := db.GetSession(session)
+	if err != nil {
+		return c.closeConnections(err)
+	}
+	feeds.Sessions.AddClient(session, c, own.Posts)
+	defer feeds.Sessions.RemoveClient(session, c)
+
+	err = addConnection(c)
+	if err != nil {
+		return c.closeConnections(err)
+	}
+	defer removeConnection(c)
 	return c.listen()
 }
 
@@ -124,6 +177,8 @@ func newClient(conn *websocket.Conn, req *http.Request, ip string,
 		close:    make(chan error, 2),
 		receive:  make(chan receivedMessage),
 		redirect: make(chan string),
+		// Buffered, so the shutdown request is never lost
+		shutdownMsg: make(chan []byte, 1),
 		// Allows for ~60 seconds of messages, until the buffer overflows.
 		// A larger gap is more acceptable to shitty connections and mobile
 		// phones, especially while uploading.
@@ -138,6 +193,10 @@ func (c *Client) listen() error {
 
 	// Clean up, when loop exits
 	err := c.listenerLoop()
+	c.stopTyping()
+	if err, ok := err.(net.Error); ok && err.Timeout() {
+		log.Debugf("websockets: evicting stalled client %s: %s", c.ip, err)
+	}
 	feeds.RemoveClient(c)
 	return c.closeConnections(err)
 }
@@ -150,10 +209,19 @@ func (c *Client) listenerLoop() error {
 	ping := time.NewTicker(pingTimer)
 	defer ping.Stop()
 
+	authTimer := time.NewTimer(authTimeout)
+	defer authTimer.Stop()
+
 	for {
 		select {
 		case err := <-c.close:
 			return err
+		case <-authTimer.C:
+			if !c.gotFirstMessage {
+				return errAuthTimeout
+			}
+		case msg := <-c.shutdownMsg:
+			return c.flushOnShutdown(msg)
 		case msg := <-c.sendExternal:
 			if err := c.send(msg); err != nil {
 				return err
@@ -199,6 +267,11 @@ func (c *Client) closeConnections(err error) error {
 	case nil:
 		closeType = websocket.CloseNormalClosure
 	default:
+		if err == errShuttingDown {
+			err = nil
+			closeType = websocket.CloseGoingAway
+			break
+		}
 		c.sendMessage(common.MessageInvalid, err.Error())
 		closeType = websocket.CloseInvalidFramePayloadData
 	}
@@ -229,8 +302,34 @@ func (c *Client) Send(msg []byte) {
 	}
 }
 
+// SendWithDeadline sends a message to the client, waiting at most deadline
+// for space in the client's send buffer. Can be used concurrently.
+func (c *Client) SendWithDeadline(msg []byte, deadline time.Duration) error {
+	select {
+	case c.sendExternal <- msg:
+		return nil
+	default:
+	}
+
+	t := time.NewTimer(deadline)
+	defer t.Stop()
+	select {
+	case c.sendExternal <- msg:
+		return nil
+	case <-t.C:
+		return errSendDeadline
+	}
+}
+
 // Sends a message to the client. Not safe for concurrent use.
 func (c *Client) send(msg []byte) error {
+	err := c.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if err != nil {
+		return err
+	}
+	if c.wire != nil {
+		return c.writeCompressed(msg)
+	}
 	return c.conn.WriteMessage(websocket.TextMessage, msg)
 }
 
@@ -282,16 +381,16 @@ func (c *Client) handleMessage(msgType int, msg []byte) error {
 	}
 	typ := common.MessageType(uncast)
 	if !c.gotFirstMessage {
-		if typ != common.MessageSynchronise {
+		if typ != common.MessageAuth {
 			return errInvalidPayload(msg)
 		}
-		c.gotFirstMessage = true
-
-		// Send current server time on first synchronization
-		err = c.sendMessage(common.MessageServerTime, time.Now().Unix())
-		if err != nil {
+		if err := c.authenticate(msg[2:]); err != nil {
 			return err
 		}
+		c.gotFirstMessage = true
+
+		// Send current server time on authentication
+		return c.sendMessage(common.MessageServerTime, time.Now().Unix())
 	}
 
 	return c.runHandler(typ, msg)
@@ -349,6 +448,14 @@ func (c *Client) IP() string {
 	return c.ip
 }
 
+// Account returns the account the client is logged in as, if any.
+// Thread-safe.
+func (c *Client) Account() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.ident.UserID
+}
+
 // LastTime returns the last post time of the client connection.
 func (c *Client) LastTime() int64 {
 	c.mu.RLock()
@@ -226,7 +226,7 @@ func TestHandleMessage(t *testing.T) {
 	msg = []byte("nope")
 	assertHandlerError(t, cl, msg, invalidMessage)
 
-	// Not a sync message, when not synced
+	// Not an auth message, when not authenticated
 	msg = []byte("99no")
 	assertHandlerError(t, cl, msg, invalidMessage)
 